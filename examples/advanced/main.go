@@ -21,7 +21,14 @@ func main() {
 		log.Fatalf("Failed to create webview2: %v", err)
 	}
 
-	wv.Browser().ExecuteScript("alert('Hello from webview!');")
+	err = wv.Browser().Bind("greet", func(name string) string {
+		return "Hello, " + name + "!"
+	})
+	if err != nil {
+		log.Fatalf("Failed to bind a function: %v", err)
+	}
+
+	wv.Browser().ExecuteScript("greet('webview').then(alert);")
 
 	if err := wv.Run(); err != nil {
 		log.Fatalf("Failed while running webview: %v", err)
@@ -5,5 +5,4 @@ go 1.16
 require (
 	github.com/jchv/go-winloader v0.0.0-20210323001710-152514a7f070
 	golang.org/x/sys v0.0.0-20210514084401-e8d321eab015
-	golang.org/x/tools v0.1.1
 )
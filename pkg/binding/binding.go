@@ -0,0 +1,191 @@
+// Package binding exposes Go functions to page script.
+//
+// A Registry checks the signatures of the bound functions and calls them with the JSON encoded
+// arguments of a web message, Script and SettleScript generate the script side: the window.<name>
+// function returning a Promise, and the statement which settles it with the result.
+package binding
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sync"
+)
+
+var (
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+	validName = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+	ErrInvalidName      = errors.New("invalid binding name")
+	ErrNotAFunction     = errors.New("bound value is not a function")
+	ErrInvalidSignature = errors.New("unsupported function signature")
+	ErrUnknownFunction  = errors.New("unknown function")
+	ErrArgumentCount    = errors.New("wrong number of arguments")
+)
+
+// Call is a single call of a bound function, as posted by the script returned from Script.
+type Call struct {
+	Kind   string            `json:"__webview2"`
+	ID     int64             `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// ParseCall decodes a web message into a Call. It returns false if the message wasn't posted by a binding.
+func ParseCall(message []byte) (*Call, bool) {
+	var c Call
+
+	if err := json.Unmarshal(message, &c); err != nil {
+		return nil, false
+	}
+
+	if c.Kind != "call" || c.Method == "" {
+		return nil, false
+	}
+
+	return &c, true
+}
+
+// Registry holds the bound functions.
+type Registry struct {
+	mu    sync.RWMutex
+	funcs map[string]reflect.Value
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		funcs: map[string]reflect.Value{},
+	}
+}
+
+// Bind registers fn under the given name, replacing any previously bound function.
+//
+// fn may accept any number of JSON decodable arguments, and may return nothing, a value,
+// an error, or a value and an error.
+func (r *Registry) Bind(name string, fn interface{}) error {
+	if !validName.MatchString(name) {
+		return fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		return fmt.Errorf("%w: %T", ErrNotAFunction, fn)
+	}
+
+	if err := checkSignature(v.Type()); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.funcs[name] = v
+
+	return nil
+}
+
+// Unbind removes the function registered under the given name.
+func (r *Registry) Unbind(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.funcs, name)
+}
+
+// Names returns the names of all the bound functions.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.funcs))
+	for name := range r.funcs {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// Call invokes the bound function, decoding the parameters into its argument types and
+// encoding its result.
+func (r *Registry) Call(c *Call) (result json.RawMessage, err error) {
+	r.mu.RLock()
+	fn, ok := r.funcs[c.Method]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFunction, c.Method)
+	}
+
+	t := fn.Type()
+
+	if len(c.Params) != t.NumIn() {
+		return nil, fmt.Errorf("%w: %s expects %d, got %d", ErrArgumentCount, c.Method, t.NumIn(), len(c.Params))
+	}
+
+	args := make([]reflect.Value, t.NumIn())
+
+	for i := range args {
+		arg := reflect.New(t.In(i))
+
+		if err := json.Unmarshal(c.Params[i], arg.Interface()); err != nil {
+			return nil, fmt.Errorf("failed to decode argument %d of %s: %w", i, c.Method, err)
+		}
+
+		args[i] = arg.Elem()
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			result = nil
+			err = fmt.Errorf("%s panicked: %v", c.Method, p)
+		}
+	}()
+
+	out := fn.Call(args)
+
+	var value interface{}
+
+	switch len(out) {
+	case 1:
+		if t.Out(0) == errorType {
+			err, _ = out[0].Interface().(error)
+		} else {
+			value = out[0].Interface()
+		}
+	case 2:
+		value = out[0].Interface()
+		err, _ = out[1].Interface().(error)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	result, err = json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the result of %s: %w", c.Method, err)
+	}
+
+	return result, nil
+}
+
+func checkSignature(t reflect.Type) error {
+	if t.IsVariadic() {
+		return fmt.Errorf("%w: variadic functions can't be bound", ErrInvalidSignature)
+	}
+
+	switch t.NumOut() {
+	case 0, 1:
+	case 2:
+		if t.Out(1) != errorType {
+			return fmt.Errorf("%w: the second return value must be an error", ErrInvalidSignature)
+		}
+	default:
+		return fmt.Errorf("%w: too many return values", ErrInvalidSignature)
+	}
+
+	return nil
+}
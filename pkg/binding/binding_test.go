@@ -0,0 +1,201 @@
+package binding
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBindSignature(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   interface{}
+		err  error
+	}{
+		{"noResult", func() {}, nil},
+		{"value", func(a int, b string) string { return "" }, nil},
+		{"error", func(a []int) error { return nil }, nil},
+		{"valueAndError", func(m map[string]int) (int, error) { return 0, nil }, nil},
+		{"notAFunction", 42, ErrNotAFunction},
+		{"nil", nil, ErrNotAFunction},
+		{"variadic", func(a ...int) {}, ErrInvalidSignature},
+		{"secondNotError", func() (int, int) { return 0, 0 }, ErrInvalidSignature},
+		{"tooManyResults", func() (int, int, error) { return 0, 0, nil }, ErrInvalidSignature},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := NewRegistry().Bind(test.name, test.fn)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Bind() = %v, want %v", err, test.err)
+			}
+		})
+	}
+}
+
+func TestBindName(t *testing.T) {
+	for _, name := range []string{"", "1a", "a-b", "a.b", "a b", "window['x']"} {
+		if err := NewRegistry().Bind(name, func() {}); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Bind(%q) = %v, want %v", name, err, ErrInvalidName)
+		}
+	}
+
+	for _, name := range []string{"a", "_", "$", "a1", "camelCase", "$_a9"} {
+		if err := NewRegistry().Bind(name, func() {}); err != nil {
+			t.Errorf("Bind(%q) = %v", name, err)
+		}
+	}
+}
+
+func TestParseCall(t *testing.T) {
+	tests := []struct {
+		message string
+		ok      bool
+		method  string
+		params  int
+	}{
+		{`{"__webview2":"call","id":1,"method":"add","params":[1,2]}`, true, "add", 2},
+		{`{"__webview2":"call","id":2,"method":"none"}`, true, "none", 0},
+		{`{"__webview2":"drag","hit":"caption"}`, false, "", 0},
+		{`{"__webview2":"call","id":3}`, false, "", 0},
+		{`{"method":"add","params":[]}`, false, "", 0},
+		{`"call"`, false, "", 0},
+		{`not json`, false, "", 0},
+	}
+
+	for _, test := range tests {
+		c, ok := ParseCall([]byte(test.message))
+		if ok != test.ok {
+			t.Errorf("ParseCall(%s) ok = %t, want %t", test.message, ok, test.ok)
+			continue
+		}
+
+		if ok && (c.Method != test.method || len(c.Params) != test.params) {
+			t.Errorf("ParseCall(%s) = %q with %d params, want %q with %d", test.message, c.Method, len(c.Params), test.method, test.params)
+		}
+	}
+}
+
+func TestCall(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	r := NewRegistry()
+
+	for name, fn := range map[string]interface{}{
+		"add":     func(a, b int) int { return a + b },
+		"join":    func(s []string, sep string) string { return strings.Join(s, sep) },
+		"nothing": func() {},
+		"ok":      func() error { return nil },
+		"fail":    func() error { return errFailed },
+		"both":    func(fail bool) (string, error) { return "value", map[bool]error{true: errFailed}[fail] },
+		"panic":   func() { panic("boom") },
+		"channel": func() chan int { return make(chan int) },
+	} {
+		if err := r.Bind(name, fn); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		params string
+		result string
+		err    error
+		errMsg string
+	}{
+		{"value", "add", `[1, 2]`, `3`, nil, ""},
+		{"slice", "join", `[["a", "b"], "-"]`, `"a-b"`, nil, ""},
+		{"noResult", "nothing", `[]`, `null`, nil, ""},
+		{"nilError", "ok", `[]`, `null`, nil, ""},
+		{"error", "fail", `[]`, ``, errFailed, ""},
+		{"valueAndNilError", "both", `[false]`, `"value"`, nil, ""},
+		{"valueAndError", "both", `[true]`, ``, errFailed, ""},
+		{"panic", "panic", `[]`, ``, nil, "panic panicked: boom"},
+		{"unknown", "missing", `[]`, ``, ErrUnknownFunction, ""},
+		{"tooFew", "add", `[1]`, ``, ErrArgumentCount, ""},
+		{"tooMany", "add", `[1, 2, 3]`, ``, ErrArgumentCount, ""},
+		{"badArgument", "add", `["1", 2]`, ``, nil, "failed to decode argument 0 of add"},
+		{"badResult", "channel", `[]`, ``, nil, "failed to encode the result of channel"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var params []json.RawMessage
+			if err := json.Unmarshal([]byte(test.params), &params); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := r.Call(&Call{Kind: "call", ID: 1, Method: test.method, Params: params})
+
+			switch {
+			case test.err != nil:
+				if !errors.Is(err, test.err) {
+					t.Fatalf("Call() error = %v, want %v", err, test.err)
+				}
+			case test.errMsg != "":
+				if err == nil || !strings.HasPrefix(err.Error(), test.errMsg) {
+					t.Fatalf("Call() error = %v, want %q", err, test.errMsg)
+				}
+			case err != nil:
+				t.Fatalf("Call() error = %v", err)
+			}
+
+			if string(result) != test.result {
+				t.Errorf("Call() = %s, want %s", result, test.result)
+			}
+		})
+	}
+}
+
+func TestRebindAndUnbind(t *testing.T) {
+	r := NewRegistry()
+
+	if err := r.Bind("f", func() int { return 1 }); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Bind("f", func() int { return 2 }); err != nil {
+		t.Fatal(err)
+	}
+
+	if result, err := r.Call(&Call{Method: "f"}); err != nil || string(result) != "2" {
+		t.Fatalf("Call() = %s, %v, want 2", result, err)
+	}
+
+	if names := r.Names(); len(names) != 1 || names[0] != "f" {
+		t.Fatalf("Names() = %v, want [f]", names)
+	}
+
+	r.Unbind("f")
+
+	if _, err := r.Call(&Call{Method: "f"}); !errors.Is(err, ErrUnknownFunction) {
+		t.Fatalf("Call() after Unbind = %v, want %v", err, ErrUnknownFunction)
+	}
+
+	if names := r.Names(); len(names) != 0 {
+		t.Fatalf("Names() = %v, want none", names)
+	}
+}
+
+func TestScripts(t *testing.T) {
+	tests := []struct {
+		script string
+		want   string
+	}{
+		{Script("add"), `window["add"] = function () {`},
+		{Script("add"), `window.__webview2_bindings.call("add", `},
+		{UnbindScript("add"), `delete window["add"];`},
+		{UnbindScript(`a"b`), `delete window["a\"b"];`},
+		{SettleScript(7, json.RawMessage(`{"a":1}`), nil), `window.__webview2_bindings.settle(7, null, {"a":1});`},
+		{SettleScript(7, nil, nil), `window.__webview2_bindings.settle(7, null, null);`},
+		{SettleScript(7, nil, errors.New(`bad "input"`)), `window.__webview2_bindings.settle(7, "bad \"input\"", null);`},
+	}
+
+	for _, test := range tests {
+		if !strings.Contains(test.script, test.want) {
+			t.Errorf("script %q doesn't contain %q", test.script, test.want)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package binding
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// shim installs the object that keeps track of pending calls. It's safe to run it more than once.
+const shim = `(function () {
+	if (window.__webview2_bindings) {
+		return;
+	}
+
+	var pending = {};
+	var seq = 0;
+
+	window.__webview2_bindings = {
+		call: function (method, params) {
+			var id = ++seq;
+			var promise = new Promise(function (resolve, reject) {
+				pending[id] = { resolve: resolve, reject: reject };
+			});
+
+			window.chrome.webview.postMessage({ __webview2: "call", id: id, method: method, params: params });
+
+			return promise;
+		},
+		settle: function (id, error, result) {
+			var call = pending[id];
+			if (!call) {
+				return;
+			}
+
+			delete pending[id];
+
+			if (error !== null) {
+				call.reject(new Error(error));
+			} else {
+				call.resolve(result);
+			}
+		}
+	};
+})();
+`
+
+// Script returns the script that exposes a bound function as window.<name>.
+func Script(name string) string {
+	quoted, _ := json.Marshal(name)
+
+	return fmt.Sprintf(`%s
+window[%[2]s] = function () {
+	return window.__webview2_bindings.call(%[2]s, Array.prototype.slice.call(arguments));
+};
+`, shim, quoted)
+}
+
+// UnbindScript returns the script that removes window.<name>. Calls that are still pending are left to settle.
+func UnbindScript(name string) string {
+	quoted, _ := json.Marshal(name)
+
+	return fmt.Sprintf("delete window[%s];", quoted)
+}
+
+// SettleScript returns the script that resolves or rejects the promise of a call.
+func SettleScript(id int64, result json.RawMessage, err error) string {
+	if err != nil {
+		message, _ := json.Marshal(err.Error())
+		return fmt.Sprintf("window.__webview2_bindings.settle(%d, %s, null);", id, message)
+	}
+
+	if len(result) == 0 {
+		result = json.RawMessage("null")
+	}
+
+	return fmt.Sprintf("window.__webview2_bindings.settle(%d, null, %s);", id, result)
+}
@@ -0,0 +1,194 @@
+// Package bootstrapper downloads and runs the Evergreen WebView2 runtime bootstrapper,
+// a small installer which fetches and installs the runtime matching the machine.
+// The bootstrapper only runs once its Authenticode signature is verified to be the one of Microsoft.
+//
+// The webview2 package decides when the runtime is missing, see WithEnsureRuntime.
+package bootstrapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// URL is the permanent link to the Evergreen bootstrapper.
+const URL = "https://go.microsoft.com/fwlink/p/?LinkId=2124703"
+
+// Args install the runtime without showing any UI.
+var Args = []string{"/silent", "/install"}
+
+// Publisher is the name of the certificate the bootstrapper must be signed with.
+const Publisher = "Microsoft Corporation"
+
+// ErrUntrusted is returned by Install when the signature of the bootstrapper can't be verified.
+var ErrUntrusted = errors.New("untrusted bootstrapper")
+
+// Phase is the step of the installation a Progress reports.
+type Phase int
+
+const (
+	PhaseDownloading Phase = iota
+	PhaseInstalling
+	PhaseDone
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhaseDownloading:
+		return "downloading"
+	case PhaseInstalling:
+		return "installing"
+	case PhaseDone:
+		return "done"
+	}
+
+	return fmt.Sprintf("Phase(%d)", int(p))
+}
+
+// Progress reports the progress of the installation.
+type Progress struct {
+	Phase Phase
+	// Downloaded and Total count the bytes of the bootstrapper while downloading, Total is -1 if unknown.
+	Downloaded, Total int64
+}
+
+// Options configure Install. The zero value downloads the bootstrapper from URL.
+type Options struct {
+	// Path runs a bootstrapper shipped with the application instead of downloading one.
+	Path string
+	// URL is where the bootstrapper is downloaded from, it defaults to URL.
+	URL string
+	// Client downloads the bootstrapper, it defaults to http.DefaultClient.
+	Client *http.Client
+	// Args are passed to the bootstrapper, they default to Args.
+	Args []string
+	// OnProgress is called as the installation progresses, on the goroutine calling Install.
+	OnProgress func(Progress)
+}
+
+// Install runs the bootstrapper, downloading it first unless Options.Path is set, and waits until it exits.
+// It verifies the signature of the bootstrapper first, and returns ErrUntrusted if it isn't signed by Publisher.
+// Cancelling the context stops the download or kills the bootstrapper.
+func Install(ctx context.Context, opts Options) error {
+	path := opts.Path
+
+	if path == "" {
+		dir, err := ioutil.TempDir("", "webview2")
+		if err != nil {
+			return fmt.Errorf("failed to create a temporary folder: %w", err)
+		}
+
+		defer os.RemoveAll(dir)
+
+		path = filepath.Join(dir, "MicrosoftEdgeWebview2Setup.exe")
+
+		if err := Download(ctx, opts, path); err != nil {
+			return err
+		}
+	}
+
+	if err := verify(path); err != nil {
+		return fmt.Errorf("refusing to run %s: %w", path, err)
+	}
+
+	opts.progress(Progress{Phase: PhaseInstalling})
+
+	args := opts.Args
+	if args == nil {
+		args = Args
+	}
+
+	if out, err := exec.CommandContext(ctx, path, args...).CombinedOutput(); err != nil {
+		if len(out) > 0 {
+			return fmt.Errorf("the bootstrapper failed: %w: %s", err, out)
+		}
+
+		return fmt.Errorf("the bootstrapper failed: %w", err)
+	}
+
+	opts.progress(Progress{Phase: PhaseDone})
+
+	return nil
+}
+
+// Download downloads the bootstrapper from Options.URL to dst, reporting the progress.
+func Download(ctx context.Context, opts Options, dst string) (err error) {
+	url := opts.URL
+	if url == "" {
+		url = URL
+	}
+
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create the request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download the bootstrapper: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download the bootstrapper: %s", resp.Status)
+	}
+
+	f, err := os.Create(dst)
+	if err != nil {
+		return fmt.Errorf("failed to create the bootstrapper: %w", err)
+	}
+
+	defer func() {
+		if closeErr := f.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("failed to write the bootstrapper: %w", closeErr)
+		}
+	}()
+
+	counter := &progressWriter{opts: opts, total: resp.ContentLength}
+	counter.report()
+
+	if _, err := io.Copy(f, io.TeeReader(resp.Body, counter)); err != nil {
+		return fmt.Errorf("failed to download the bootstrapper: %w", err)
+	}
+
+	if counter.total >= 0 && counter.downloaded != counter.total {
+		return errors.New("failed to download the bootstrapper: truncated response")
+	}
+
+	return nil
+}
+
+func (o Options) progress(p Progress) {
+	if o.OnProgress != nil {
+		o.OnProgress(p)
+	}
+}
+
+// progressWriter counts the downloaded bytes.
+type progressWriter struct {
+	opts              Options
+	downloaded, total int64
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.downloaded += int64(len(p))
+	w.report()
+
+	return len(p), nil
+}
+
+func (w *progressWriter) report() {
+	w.opts.progress(Progress{Phase: PhaseDownloading, Downloaded: w.downloaded, Total: w.total})
+}
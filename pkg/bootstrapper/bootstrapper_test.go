@@ -0,0 +1,150 @@
+package bootstrapper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDownload(t *testing.T) {
+	body := bytes.Repeat([]byte("MZ"), 64*1024)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		_, _ = w.Write(body)
+	}))
+
+	defer srv.Close()
+
+	var progress []Progress
+
+	dst := filepath.Join(t.TempDir(), "setup.exe")
+	opts := Options{URL: srv.URL, Client: srv.Client(), OnProgress: func(p Progress) { progress = append(progress, p) }}
+
+	if err := Download(context.Background(), opts, dst); err != nil {
+		t.Fatalf("Download() = %v", err)
+	}
+
+	got, err := ioutil.ReadFile(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, body) {
+		t.Errorf("downloaded %d bytes, want the %d of the response", len(got), len(body))
+	}
+
+	if len(progress) < 2 {
+		t.Fatalf("reported %d progresses, want the start and the downloaded chunks", len(progress))
+	}
+
+	first, last := progress[0], progress[len(progress)-1]
+	total := int64(len(body))
+
+	if first != (Progress{Phase: PhaseDownloading, Total: total}) {
+		t.Errorf("first progress = %+v, want nothing downloaded of %d", first, total)
+	}
+
+	if last != (Progress{Phase: PhaseDownloading, Downloaded: total, Total: total}) {
+		t.Errorf("last progress = %+v, want all %d downloaded", last, total)
+	}
+}
+
+func TestDownloadStatus(t *testing.T) {
+	for _, status := range []int{http.StatusNotFound, http.StatusInternalServerError, http.StatusNoContent} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+
+		err := Download(context.Background(), Options{URL: srv.URL, Client: srv.Client()}, filepath.Join(t.TempDir(), "setup.exe"))
+		srv.Close()
+
+		if err == nil || !strings.Contains(err.Error(), strconv.Itoa(status)) {
+			t.Errorf("Download() of a %d response = %v, want an error with the status", status, err)
+		}
+	}
+}
+
+func TestDownloadTruncated(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "1024")
+		_, _ = w.Write([]byte("MZ"))
+	}))
+
+	defer srv.Close()
+
+	if err := Download(context.Background(), Options{URL: srv.URL, Client: srv.Client()}, filepath.Join(t.TempDir(), "setup.exe")); err == nil {
+		t.Fatal("Download() of a truncated response succeeded")
+	}
+}
+
+func TestDownloadCancel(t *testing.T) {
+	release := make(chan struct{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "1024")
+		_, _ = w.Write([]byte("MZ"))
+		w.(http.Flusher).Flush()
+
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+
+	defer srv.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	opts := Options{
+		URL:    srv.URL,
+		Client: srv.Client(),
+		OnProgress: func(p Progress) {
+			if p.Downloaded > 0 {
+				cancel()
+			}
+		},
+	}
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- Download(ctx, opts, filepath.Join(t.TempDir(), "setup.exe"))
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Download() = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Download() didn't stop when the context was cancelled")
+	}
+}
+
+func TestInstallUntrusted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "setup.exe")
+	if err := ioutil.WriteFile(path, []byte("MZ"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	var phases []Phase
+
+	err := Install(context.Background(), Options{Path: path, OnProgress: func(p Progress) { phases = append(phases, p.Phase) }})
+	if !errors.Is(err, ErrUntrusted) {
+		t.Fatalf("Install() of an unsigned bootstrapper = %v, want %v", err, ErrUntrusted)
+	}
+
+	if len(phases) != 0 {
+		t.Fatalf("Install() of an unsigned bootstrapper reported %v, want it not to run", phases)
+	}
+}
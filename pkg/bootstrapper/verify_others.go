@@ -0,0 +1,11 @@
+//go:build !windows
+// +build !windows
+
+package bootstrapper
+
+import "fmt"
+
+// verify can't check Authenticode signatures outside of Windows, so nothing is trusted.
+func verify(path string) error {
+	return fmt.Errorf("%w: Authenticode signatures are only verified on Windows", ErrUntrusted)
+}
@@ -0,0 +1,132 @@
+package bootstrapper
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const cmsgSignerInfoParam = 6
+
+var (
+	crypt32                 = windows.NewLazySystemDLL("crypt32")
+	crypt32CryptMsgGetParam = crypt32.NewProc("CryptMsgGetParam")
+	crypt32CryptMsgClose    = crypt32.NewProc("CryptMsgClose")
+)
+
+// signerInfo is the beginning of CMSG_SIGNER_INFO, which identifies the certificate of the signer.
+type signerInfo struct {
+	Version      uint32
+	Issuer       windows.CertNameBlob
+	SerialNumber windows.CryptIntegerBlob
+}
+
+// verify checks the Authenticode signature of the file with WinVerifyTrust, and that it's signed by Publisher.
+func verify(path string) error {
+	pathPtr, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+
+	file := &windows.WinTrustFileInfo{
+		Size:     uint32(unsafe.Sizeof(windows.WinTrustFileInfo{})),
+		FilePath: pathPtr,
+	}
+
+	data := &windows.WinTrustData{
+		Size:                            uint32(unsafe.Sizeof(windows.WinTrustData{})),
+		UIChoice:                        windows.WTD_UI_NONE,
+		RevocationChecks:                windows.WTD_REVOKE_WHOLECHAIN,
+		UnionChoice:                     windows.WTD_CHOICE_FILE,
+		StateAction:                     windows.WTD_STATEACTION_VERIFY,
+		FileOrCatalogOrBlobOrSgnrOrCert: unsafe.Pointer(file),
+	}
+
+	err = windows.WinVerifyTrustEx(windows.InvalidHWND, &windows.WINTRUST_ACTION_GENERIC_VERIFY_V2, data)
+
+	data.StateAction = windows.WTD_STATEACTION_CLOSE
+	_ = windows.WinVerifyTrustEx(windows.InvalidHWND, &windows.WINTRUST_ACTION_GENERIC_VERIFY_V2, data)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUntrusted, err)
+	}
+
+	publisher, err := signer(pathPtr)
+	if err != nil {
+		return fmt.Errorf("%w: failed to read the signer: %v", ErrUntrusted, err)
+	}
+
+	if publisher != Publisher {
+		return fmt.Errorf("%w: signed by %q instead of %q", ErrUntrusted, publisher, Publisher)
+	}
+
+	return nil
+}
+
+// signer returns the name of the certificate the file is signed with.
+func signer(path *uint16) (string, error) {
+	var (
+		encoding uint32
+		store    windows.Handle
+		msg      windows.Handle
+	)
+
+	err := windows.CryptQueryObject(
+		windows.CERT_QUERY_OBJECT_FILE,
+		unsafe.Pointer(path),
+		windows.CERT_QUERY_CONTENT_FLAG_PKCS7_SIGNED_EMBED,
+		windows.CERT_QUERY_FORMAT_FLAG_BINARY,
+		0,
+		&encoding,
+		nil,
+		nil,
+		&store,
+		&msg,
+		nil,
+	)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer windows.CertCloseStore(store, 0)
+	defer crypt32CryptMsgClose.Call(uintptr(msg))
+
+	var size uint32
+
+	r, _, err := crypt32CryptMsgGetParam.Call(uintptr(msg), cmsgSignerInfoParam, 0, 0, uintptr(unsafe.Pointer(&size)))
+	if r == 0 {
+		return "", err
+	}
+
+	// CMSG_SIGNER_INFO points into the rest of the buffer, which is kept aligned for its fields.
+	buf := make([]uint64, (size+7)/8)
+
+	r, _, err = crypt32CryptMsgGetParam.Call(uintptr(msg), cmsgSignerInfoParam, 0, uintptr(unsafe.Pointer(&buf[0])), uintptr(unsafe.Pointer(&size)))
+	if r == 0 {
+		return "", err
+	}
+
+	info := (*signerInfo)(unsafe.Pointer(&buf[0]))
+
+	cert, err := windows.CertFindCertificateInStore(
+		store,
+		windows.X509_ASN_ENCODING|windows.PKCS_7_ASN_ENCODING,
+		0,
+		windows.CERT_FIND_SUBJECT_CERT,
+		unsafe.Pointer(&windows.CertInfo{Issuer: info.Issuer, SerialNumber: info.SerialNumber}),
+		nil,
+	)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer windows.CertFreeCertificateContext(cert)
+
+	name := make([]uint16, 256)
+	n := windows.CertGetNameString(cert, windows.CERT_NAME_SIMPLE_DISPLAY_TYPE, 0, nil, &name[0], uint32(len(name)))
+
+	return windows.UTF16ToString(name[:n]), nil
+}
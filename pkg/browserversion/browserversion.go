@@ -0,0 +1,111 @@
+// Package browserversion parses and compares WebView2 runtime versions, like "88.0.705.50"
+// or "89.0.774.18 beta" as returned by GetBrowserVersionString, with the semantics of CompareBrowserVersions.
+// The discovery package uses it to pick a runtime, RuntimeInfo and BrowserVersion of the webview2 package report them.
+package browserversion
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalid is returned for the strings which aren't versions.
+var ErrInvalid = errors.New("invalid browser version")
+
+// Version is a runtime version, made of four numbers and the channel of the preview runtimes.
+type Version struct {
+	Major, Minor, Build, Patch int
+
+	// Channel is empty for the stable runtime, or e.g. "beta", "dev" or "canary".
+	Channel string
+}
+
+// Parse parses a version made of four numbers separated by dots, optionally followed by a space and the channel.
+func Parse(s string) (Version, error) {
+	var v Version
+
+	numbers := strings.TrimSpace(s)
+
+	if i := strings.IndexByte(numbers, ' '); i >= 0 {
+		v.Channel = strings.ToLower(strings.TrimSpace(numbers[i+1:]))
+		numbers = numbers[:i]
+	}
+
+	parts := strings.Split(numbers, ".")
+	if len(parts) != 4 {
+		return Version{}, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+
+	for i, dst := range []*int{&v.Major, &v.Minor, &v.Build, &v.Patch} {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("%w: %q", ErrInvalid, s)
+		}
+
+		*dst = n
+	}
+
+	return v, nil
+}
+
+// MustParse is like Parse, but panics if the version is invalid. It's meant for constants.
+func MustParse(s string) Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// String returns the version in the format Parse accepts.
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d.%d", v.Major, v.Minor, v.Build, v.Patch)
+
+	if v.Channel != "" {
+		s += " " + v.Channel
+	}
+
+	return s
+}
+
+// IsZero reports whether the version is 0.0.0.0, which EdgeUpdate reports for the uninstalled runtimes.
+func (v Version) IsZero() bool {
+	return v.Major == 0 && v.Minor == 0 && v.Build == 0 && v.Patch == 0
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is older than, the same as or newer than other.
+// The channels are ignored, like CompareBrowserVersions does.
+func (v Version) Compare(other Version) int {
+	for _, d := range [...]int{
+		v.Major - other.Major,
+		v.Minor - other.Minor,
+		v.Build - other.Build,
+		v.Patch - other.Patch,
+	} {
+		switch {
+		case d < 0:
+			return -1
+		case d > 0:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// Compare parses and compares two versions, with the semantics of CompareBrowserVersions.
+func Compare(a, b string) (int, error) {
+	va, err := Parse(a)
+	if err != nil {
+		return 0, err
+	}
+
+	vb, err := Parse(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return va.Compare(vb), nil
+}
@@ -0,0 +1,97 @@
+package browserversion
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Version
+	}{
+		{"88.0.705.50", Version{88, 0, 705, 50, ""}},
+		{" 88.0.705.50 ", Version{88, 0, 705, 50, ""}},
+		{"0.0.0.0", Version{}},
+		{"89.0.774.18 beta", Version{89, 0, 774, 18, "beta"}},
+		{"90.0.810.0 dev", Version{90, 0, 810, 0, "dev"}},
+		{"90.0.815.0 Canary", Version{90, 0, 815, 0, "canary"}},
+		{"90.0.815.0   canary ", Version{90, 0, 815, 0, "canary"}},
+	}
+
+	for _, test := range tests {
+		got, err := Parse(test.s)
+		if err != nil {
+			t.Errorf("Parse(%q) = %v", test.s, err)
+			continue
+		}
+
+		if got != test.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", test.s, got, test.want)
+		}
+	}
+
+	for _, s := range []string{"", "88", "88.0.705", "88.0.705.50.1", "88.0.705.x", "88.0.-705.50", "88..705.50", "beta 88.0.705.50"} {
+		if _, err := Parse(s); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Parse(%q) = %v, want %v", s, err, ErrInvalid)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	for _, s := range []string{"88.0.705.50", "89.0.774.18 beta", "0.0.0.0"} {
+		if got := MustParse(s).String(); got != s {
+			t.Errorf("MustParse(%q).String() = %q", s, got)
+		}
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParse of an invalid version didn't panic")
+		}
+	}()
+
+	MustParse("invalid")
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"88.0.705.50", "88.0.705.50", 0},
+		{"88.0.705.50", "88.0.705.51", -1},
+		{"88.0.705.51", "88.0.705.50", 1},
+		{"88.0.705.50", "88.0.706.0", -1},
+		{"88.1.0.0", "88.0.999.999", 1},
+		{"87.9.9999.9999", "88.0.0.0", -1},
+		{"100.0.0.0", "99.0.0.0", 1},
+		{"89.0.774.18 beta", "89.0.774.18", 0},
+		{"89.0.774.18 canary", "89.0.774.18 dev", 0},
+		{"90.0.815.0 canary", "89.0.774.18", 1},
+	}
+
+	for _, test := range tests {
+		got, err := Compare(test.a, test.b)
+		if err != nil {
+			t.Errorf("Compare(%q, %q) = %v", test.a, test.b, err)
+			continue
+		}
+
+		if got != test.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+
+	if _, err := Compare("88.0.705.50", "invalid"); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Compare() with an invalid version = %v, want %v", err, ErrInvalid)
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	if !MustParse("0.0.0.0").IsZero() || MustParse("0.0.0.1").IsZero() {
+		t.Error("IsZero() only holds for 0.0.0.0")
+	}
+}
@@ -0,0 +1,84 @@
+package com
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+
+	"github.com/mattpodraza/webview2/v2/pkg/hresult"
+	"golang.org/x/sys/windows"
+)
+
+// call calls the COM method in the VTBL slot fn, where the first argument is the object itself.
+// The arguments follow the calling convention of the architecture, the wrappers passing structs by value
+// are generated per architecture in zwebview2_*.go.
+//
+//go:uintptrescapes
+func call(fn uintptr, args ...uintptr) hresult.HRESULT {
+	var a [9]uintptr
+
+	n := uintptr(copy(a[:], args))
+
+	var r uintptr
+
+	switch {
+	case len(args) > len(a):
+		panic("com: too many arguments")
+	case n <= 3:
+		r, _, _ = syscall.Syscall(fn, n, a[0], a[1], a[2])
+	case n <= 6:
+		r, _, _ = syscall.Syscall6(fn, n, a[0], a[1], a[2], a[3], a[4], a[5])
+	default:
+		r, _, _ = syscall.Syscall9(fn, n, a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8])
+	}
+
+	// Only the lower 32 bits of the return register hold the HRESULT.
+	return hresult.HRESULT(uint32(r))
+}
+
+// Error is returned by the method wrappers when a COM method fails.
+type Error struct {
+	// Method is the method that failed, e.g. ICoreWebView2::Navigate.
+	Method  string
+	HRESULT hresult.HRESULT
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s failed: %s", e.Method, e.HRESULT)
+}
+
+// Unwrap returns the HRESULT, so errors.Is and hresult.From see it.
+func (e *Error) Unwrap() error {
+	return e.HRESULT
+}
+
+func boolToUintptr(b bool) uintptr {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// coTaskMemString converts a string allocated by the callee and frees its memory.
+func coTaskMemString(s *uint16) string {
+	if s == nil {
+		return ""
+	}
+
+	defer windows.CoTaskMemFree(unsafe.Pointer(s))
+
+	return windows.UTF16PtrToString(s)
+}
+
+// Pointer converts an address handed over by Windows into a pointer, e.g. the result of a system call,
+// or an argument of a window procedure or of an exported function called by the runtime.
+//
+// go vet reports unsafe.Pointer(addr) as a possible misuse of unsafe.Pointer, because a uintptr doesn't keep
+// the memory it points to alive. Pointer is the only place the module makes the conversion, it's safe as long as
+// the memory outlives the pointer: Windows and COM own most of these addresses, but some point to the Go heap,
+// e.g. the strings passed to the Go loader, and the caller who passed them must keep them alive with
+// runtime.KeepAlive until the call returns. Go never moves heap memory, so the address stays valid until then.
+func Pointer(addr uintptr) unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&addr))
+}
@@ -0,0 +1,155 @@
+package com
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/mattpodraza/webview2/v2/pkg/hresult"
+	"golang.org/x/sys/windows"
+)
+
+// IID_IUnknown is the interface ID of IUnknown, which every COM object answers for.
+var IID_IUnknown = windows.GUID{Data1: 0x00000000, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+
+type (
+	// unknown implements IUnknown for the COM objects implemented in Go, i.e. the handlers and EnvironmentOptions.
+	// It counts the references of the object it's embedded in, which stays reachable, even if Go doesn't refer to it anymore,
+	// until the last reference is released.
+	unknown struct {
+		refs int32
+		// iid is the interface the object implements besides IUnknown.
+		iid windows.GUID
+	}
+
+	// Handler is the base of the COM objects implemented in Go, i.e. the WebView2 handlers and event handlers.
+	// All of them are IUnknown followed by a single Invoke method taking two arguments, so they share a VTBL.
+	Handler struct {
+		VTBL *HandlerVTBL
+
+		unknown
+		invoke HandlerInvoke
+	}
+
+	// HandlerVTBL is the VTBL shared by all the handlers.
+	HandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+
+	// HandlerInvoke is called with the arguments of Invoke, e.g. the sender and args of an event handler,
+	// or the error code and result of a completed handler. It returns an HRESULT.
+	HandlerInvoke func(a uintptr, b unsafe.Pointer) uintptr
+)
+
+var (
+	handlerVTBL     *HandlerVTBL
+	handlerVTBLOnce sync.Once
+
+	// pinned keeps the COM objects implemented in Go with outstanding references reachable.
+	pinned   = map[unsafe.Pointer]struct{}{}
+	pinnedMu sync.Mutex
+)
+
+// pin keeps the object reachable while COM holds references to it.
+func pin(obj unsafe.Pointer) {
+	pinnedMu.Lock()
+	pinned[obj] = struct{}{}
+	pinnedMu.Unlock()
+}
+
+// unpin leaves the object to the garbage collector once COM released all the references.
+func unpin(obj unsafe.Pointer) {
+	pinnedMu.Lock()
+	delete(pinned, obj)
+	pinnedMu.Unlock()
+}
+
+// NewHandler creates a handler for the interface with the given IID. It starts with a single reference,
+// which belongs to the caller, who must Release it after handing the handler over to the WebView.
+func NewHandler(iid windows.GUID, invoke HandlerInvoke) *Handler {
+	handlerVTBLOnce.Do(func() {
+		handlerVTBL = &HandlerVTBL{
+			BasicVTBL: BasicVTBL{
+				QueryInterface: windows.NewCallback((*Handler).QueryInterface),
+				AddRef:         windows.NewCallback((*Handler).AddRef),
+				Release:        windows.NewCallback((*Handler).Release),
+			},
+			Invoke: windows.NewCallback((*Handler).Invoke),
+		}
+	})
+
+	h := &Handler{
+		VTBL:    handlerVTBL,
+		unknown: unknown{iid: iid},
+		invoke:  invoke,
+	}
+
+	h.AddRef()
+
+	return h
+}
+
+// QueryInterface is the QueryInterface from COM, it only answers for IUnknown and the IID of the handler.
+func (h *Handler) QueryInterface(iid *windows.GUID, object *unsafe.Pointer) uintptr {
+	return h.queryInterface(unsafe.Pointer(h), iid, object)
+}
+
+// queryInterface hands out the object the unknown is embedded in, obj, for IUnknown and the IID of the object.
+func (u *unknown) queryInterface(obj unsafe.Pointer, iid *windows.GUID, object *unsafe.Pointer) uintptr {
+	if object == nil {
+		return uintptr(hresult.E_POINTER)
+	}
+
+	if iid == nil || (*iid != IID_IUnknown && *iid != u.iid) {
+		*object = nil
+		return uintptr(hresult.E_NOINTERFACE)
+	}
+
+	u.AddRef()
+	*object = obj
+
+	return uintptr(hresult.S_OK)
+}
+
+// AddRef is the AddRef from COM, it returns the new reference count.
+func (u *unknown) AddRef() uintptr {
+	refs := atomic.AddInt32(&u.refs, 1)
+
+	// The unknown is a field of the object, so pinning it keeps the whole object reachable.
+	if refs == 1 {
+		pin(unsafe.Pointer(u))
+	}
+
+	return uintptr(refs)
+}
+
+// Release is the Release from COM, it returns the new reference count.
+// The object is left to the garbage collector once the count drops to zero,
+// releasing it again leaves the count at zero.
+func (u *unknown) Release() uintptr {
+	for {
+		refs := atomic.LoadInt32(&u.refs)
+		if refs <= 0 {
+			return 0
+		}
+
+		if atomic.CompareAndSwapInt32(&u.refs, refs, refs-1) {
+			if refs == 1 {
+				unpin(unsafe.Pointer(u))
+			}
+
+			return uintptr(refs - 1)
+		}
+	}
+}
+
+// References returns the current reference count of the object.
+func (u *unknown) References() int32 {
+	return atomic.LoadInt32(&u.refs)
+}
+
+// Invoke is the Invoke of the handler interfaces.
+func (h *Handler) Invoke(a uintptr, b unsafe.Pointer) uintptr {
+	return h.invoke(a, b)
+}
@@ -0,0 +1,174 @@
+package com
+
+import (
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/mattpodraza/webview2/v2/pkg/hresult"
+	"golang.org/x/sys/windows"
+)
+
+func isPinned(u *unknown) bool {
+	pinnedMu.Lock()
+	defer pinnedMu.Unlock()
+
+	_, ok := pinned[unsafe.Pointer(u)]
+	return ok
+}
+
+// vcall calls a method through its VTBL function pointer, the way COM does.
+// The arguments may be pointers converted to uintptr, uintptrescapes keeps what they point to alive and on the heap.
+//
+//go:uintptrescapes
+func vcall(method uintptr, args ...uintptr) uintptr {
+	r, _, _ := syscall.SyscallN(method, args...)
+	return r
+}
+
+func TestHandlerReferences(t *testing.T) {
+	h := NewHandler(IID_ICoreWebView2ExecuteScriptCompletedHandler, func(uintptr, unsafe.Pointer) uintptr { return 0 })
+	this := uintptr(unsafe.Pointer(h))
+
+	if refs := h.References(); refs != 1 {
+		t.Fatalf("a new handler has %d references, want 1", refs)
+	}
+
+	if !isPinned(&h.unknown) {
+		t.Fatal("a new handler isn't pinned")
+	}
+
+	if refs := vcall(h.VTBL.AddRef, this); refs != 2 {
+		t.Fatalf("AddRef() = %d, want 2", refs)
+	}
+
+	if refs := vcall(h.VTBL.Release, this); refs != 1 {
+		t.Fatalf("Release() = %d, want 1", refs)
+	}
+
+	if !isPinned(&h.unknown) {
+		t.Fatal("the handler is unpinned with a reference left")
+	}
+
+	if refs := vcall(h.VTBL.Release, this); refs != 0 {
+		t.Fatalf("Release() = %d, want 0", refs)
+	}
+
+	if isPinned(&h.unknown) {
+		t.Fatal("the handler is still pinned without references")
+	}
+
+	// A reference released once too often leaves the count at zero, rather than below.
+	if refs := vcall(h.VTBL.Release, this); refs != 0 || h.References() != 0 {
+		t.Fatalf("Release() past zero = %d with %d references, want 0 with 0", refs, h.References())
+	}
+
+	if refs := vcall(h.VTBL.AddRef, this); refs != 1 || !isPinned(&h.unknown) {
+		t.Fatalf("AddRef() after an over-release = %d, pinned %t, want 1, pinned", refs, isPinned(&h.unknown))
+	}
+
+	vcall(h.VTBL.Release, this)
+}
+
+func TestHandlerQueryInterface(t *testing.T) {
+	iid := IID_ICoreWebView2ExecuteScriptCompletedHandler
+
+	h := NewHandler(iid, func(uintptr, unsafe.Pointer) uintptr { return 0 })
+	defer h.Release()
+
+	this := uintptr(unsafe.Pointer(h))
+
+	tests := []struct {
+		name string
+		iid  *windows.GUID
+		hr   hresult.HRESULT
+	}{
+		{"IUnknown", &IID_IUnknown, hresult.S_OK},
+		{"handler", &iid, hresult.S_OK},
+		{"other", &IID_ICoreWebView2, hresult.E_NOINTERFACE},
+		{"nil", nil, hresult.E_NOINTERFACE},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			before := h.References()
+			object := unsafe.Pointer(&before)
+
+			hr := hresult.HRESULT(vcall(h.VTBL.QueryInterface, this, uintptr(unsafe.Pointer(test.iid)), uintptr(unsafe.Pointer(&object))))
+			if hr != test.hr {
+				t.Fatalf("QueryInterface() = %s, want %s", hr, test.hr)
+			}
+
+			if test.hr.Failed() {
+				if object != nil || h.References() != before {
+					t.Fatalf("a failed QueryInterface returned %p with %d references, want nil with %d", object, h.References(), before)
+				}
+
+				return
+			}
+
+			if object != unsafe.Pointer(h) || h.References() != before+1 {
+				t.Fatalf("QueryInterface() returned %p with %d references, want %p with %d", object, h.References(), h, before+1)
+			}
+
+			vcall(h.VTBL.Release, this)
+		})
+	}
+
+	if hr := hresult.HRESULT(vcall(h.VTBL.QueryInterface, this, uintptr(unsafe.Pointer(&IID_IUnknown)), 0)); hr != hresult.E_POINTER {
+		t.Fatalf("QueryInterface() without an out pointer = %s, want %s", hr, hresult.E_POINTER)
+	}
+}
+
+func TestHandlerInvoke(t *testing.T) {
+	var (
+		gotA uintptr
+		gotB unsafe.Pointer
+	)
+
+	h := NewHandler(IID_ICoreWebView2ExecuteScriptCompletedHandler, func(a uintptr, b unsafe.Pointer) uintptr {
+		gotA, gotB = a, b
+		return uintptr(hresult.E_FAIL)
+	})
+
+	defer h.Release()
+
+	arg := new(int)
+
+	if hr := hresult.HRESULT(vcall(h.VTBL.Invoke, uintptr(unsafe.Pointer(h)), 42, uintptr(unsafe.Pointer(arg)))); hr != hresult.E_FAIL {
+		t.Fatalf("Invoke() = %s, want %s", hr, hresult.E_FAIL)
+	}
+
+	if gotA != 42 || gotB != unsafe.Pointer(arg) {
+		t.Fatalf("Invoke() passed %d, %p, want 42, %p", gotA, gotB, arg)
+	}
+}
+
+func TestEnvironmentOptions(t *testing.T) {
+	o := NewEnvironmentOptions()
+	this := uintptr(unsafe.Pointer(o))
+
+	if refs := o.References(); refs != 1 || !isPinned(&o.unknown) {
+		t.Fatalf("new options have %d references, pinned %t, want 1, pinned", refs, isPinned(&o.unknown))
+	}
+
+	var object unsafe.Pointer
+
+	hr := hresult.HRESULT(vcall(o.VTBL.QueryInterface, this, uintptr(unsafe.Pointer(&IID_ICoreWebView2EnvironmentOptions)), uintptr(unsafe.Pointer(&object))))
+	if hr != hresult.S_OK || object != unsafe.Pointer(o) {
+		t.Fatalf("QueryInterface() = %s, %p, want %s, %p", hr, object, hresult.S_OK, o)
+	}
+
+	hr = hresult.HRESULT(vcall(o.VTBL.QueryInterface, this, uintptr(unsafe.Pointer(&IID_ICoreWebView2ExecuteScriptCompletedHandler)), uintptr(unsafe.Pointer(&object))))
+	if hr != hresult.E_NOINTERFACE {
+		t.Fatalf("QueryInterface() for a handler = %s, want %s", hr, hresult.E_NOINTERFACE)
+	}
+
+	if refs := vcall(o.VTBL.Release, this); refs != 1 {
+		t.Fatalf("Release() = %d, want 1", refs)
+	}
+
+	if refs := vcall(o.VTBL.Release, this); refs != 0 || isPinned(&o.unknown) {
+		t.Fatalf("Release() = %d, pinned %t, want 0, unpinned", refs, isPinned(&o.unknown))
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the expected outputs in testdata")
+
+func generateFile(t *testing.T, idl string) map[string][]byte {
+	t.Helper()
+
+	src, err := ioutil.ReadFile(idl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := parse(string(src))
+	if err != nil {
+		t.Fatalf("parse(%s) = %v", idl, err)
+	}
+
+	sources, err := generate(f, "com", filepath.Base(idl))
+	if err != nil {
+		t.Fatalf("generate(%s) = %v", idl, err)
+	}
+
+	return sources
+}
+
+// compare checks the generated sources against the files run would write them to, for all the architectures,
+// so a file which is no longer generated is reported as well.
+func compare(t *testing.T, out string, sources map[string][]byte, fix string) {
+	t.Helper()
+
+	for _, arch := range append([]string{""}, archs...) {
+		path := archPath(out, arch)
+		got, generated := sources[arch]
+
+		want, err := ioutil.ReadFile(path)
+
+		switch {
+		case os.IsNotExist(err) && generated:
+			t.Errorf("%s is generated but missing, %s", path, fix)
+		case os.IsNotExist(err):
+		case err != nil:
+			t.Fatal(err)
+		case !generated:
+			t.Errorf("%s is no longer generated, %s", path, fix)
+		case !bytes.Equal(got, want):
+			t.Errorf("%s is out of date, %s\n%s", path, fix, diff(string(want), string(got)))
+		}
+	}
+}
+
+// diff returns the first line which differs, which is enough to find the change in the generator.
+func diff(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+
+	for n := 0; n < len(wantLines) || n < len(gotLines); n++ {
+		var w, g string
+
+		if n < len(wantLines) {
+			w = wantLines[n]
+		}
+
+		if n < len(gotLines) {
+			g = gotLines[n]
+		}
+
+		if w != g {
+			return fmt.Sprintf("line %d:\n-\t%s\n+\t%s", n+1, w, g)
+		}
+	}
+
+	return ""
+}
+
+func TestGolden(t *testing.T) {
+	idls, err := filepath.Glob(filepath.Join("testdata", "*.idl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(idls) == 0 {
+		t.Fatal("no IDL in testdata")
+	}
+
+	for _, idl := range idls {
+		t.Run(filepath.Base(idl), func(t *testing.T) {
+			sources := generateFile(t, idl)
+			out := strings.TrimSuffix(idl, ".idl") + ".go"
+
+			if *update {
+				for _, arch := range append([]string{""}, archs...) {
+					path := archPath(out, arch)
+
+					if src, ok := sources[arch]; ok {
+						if err := ioutil.WriteFile(path, src, 0o644); err != nil {
+							t.Fatal(err)
+						}
+					} else if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+						t.Fatal(err)
+					}
+				}
+
+				return
+			}
+
+			compare(t, out, sources, "run go test -update")
+		})
+	}
+}
+
+// TestWebView2 makes sure the generated code of the com package is up to date with WebView2.idl and the generator.
+func TestWebView2(t *testing.T) {
+	compare(t, "../../zwebview2.go", generateFile(t, "../../WebView2.idl"), "run go generate in pkg/com")
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		idl  string
+		err  string
+	}{
+		{"missingUUID", "interface I : IUnknown { HRESULT M(); }", "missing uuid"},
+		{"missingBase", "[uuid(76eceacb-0462-4d94-ac83-423a6793775e)] interface I { HRESULT M(); }", `expected ":"`},
+		{"returnType", "[uuid(76eceacb-0462-4d94-ac83-423a6793775e)] interface I : IUnknown { void M(); }", "unsupported return type void"},
+		{"enumValue", "typedef enum E { A = B } E;", `unsupported value "B"`},
+		{"structField", "typedef struct S { UINT32* p; } S;", `unsupported field "*"`},
+		{"comment", "/* interface", "unterminated comment"},
+		{"attribute", "[uuid(", "unterminated attribute list"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parse(test.idl)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("parse() = %v, want an error containing %q", err, test.err)
+			}
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		idl  string
+		err  string
+	}{
+		{
+			"unknownBase",
+			"[uuid(76eceacb-0462-4d94-ac83-423a6793775e)] interface I : IMissing { HRESULT M(); }",
+			"unknown base interface IMissing",
+		},
+		{
+			"interfaceByValue",
+			"[uuid(76eceacb-0462-4d94-ac83-423a6793775e)] interface I : IUnknown { HRESULT M([in] IStream s); }",
+			"interface IStream passed by value",
+		},
+		{
+			"structByValue",
+			"typedef struct S { UINT32 X; } S; [uuid(76eceacb-0462-4d94-ac83-423a6793775e)] interface I : IUnknown { HRESULT M([in] S s); }",
+			"S passed by value isn't supported",
+		},
+		{
+			"structField",
+			"typedef struct S { VARIANT V; } S;",
+			"unsupported type VARIANT of field V",
+		},
+		{
+			"outNotPointer",
+			"[uuid(76eceacb-0462-4d94-ac83-423a6793775e)] interface I : IUnknown { HRESULT M([out] UINT32 v); }",
+			"out parameter v isn't a pointer",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := parse(test.idl)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = generate(f, "com", "test.idl")
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("generate() = %v, want an error containing %q", err, test.err)
+			}
+		})
+	}
+}
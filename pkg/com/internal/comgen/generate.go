@@ -0,0 +1,606 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	gotoken "go/token"
+	"strings"
+	"unicode"
+)
+
+const docsURL = "https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/"
+
+// predeclared are the interfaces written by hand in the com package, which the IDL refers to.
+var predeclared = map[string]bool{
+	"IUnknown": true,
+	"IStream":  true,
+}
+
+// scalars maps the IDL scalar types to Go types.
+var scalars = map[string]string{
+	"BOOL":                   "int32",
+	"int":                    "int32",
+	"INT":                    "int32",
+	"INT32":                  "int32",
+	"LONG":                   "int32",
+	"UINT":                   "uint32",
+	"UINT32":                 "uint32",
+	"ULONG":                  "uint32",
+	"DWORD":                  "uint32",
+	"BYTE":                   "uint8",
+	"INT64":                  "int64",
+	"UINT64":                 "uint64",
+	"double":                 "float64",
+	"HRESULT":                "hresult.HRESULT",
+	"HWND":                   "windows.Handle",
+	"HCURSOR":                "windows.Handle",
+	"RECT":                   "RECT",
+	"POINT":                  "POINT",
+	"EventRegistrationToken": "EventRegistrationToken",
+}
+
+// handWritten are the structs of scalars written by hand in the com package.
+var handWritten = map[string]bool{
+	"RECT":                   true,
+	"POINT":                  true,
+	"EventRegistrationToken": true,
+}
+
+// imports are the packages the generated code may refer to, only the ones used are imported.
+var imports = []struct {
+	name, path string
+	std        bool
+}{
+	{"fmt", "fmt", true},
+	{"unsafe", "unsafe", true},
+	{"hresult", "github.com/mattpodraza/webview2/v2/pkg/hresult", false},
+	{"windows", "golang.org/x/sys/windows", false},
+}
+
+// archs are the architectures the wrappers taking structs or 64-bit integers by value are generated for,
+// in files suffixed with the architecture, because each calling convention passes them differently.
+var archs = []string{"amd64", "386", "arm64"}
+
+// initialisms are the words of the method names spelled the Go way.
+var initialisms = map[string]string{
+	"Id":   "ID",
+	"Json": "JSON",
+	"Uri":  "URI",
+	"Url":  "URL",
+}
+
+type generator struct {
+	file       *file
+	interfaces map[string]*iface
+	enums      map[string]bool
+	structs    map[string]*structDecl
+	// buf is the file being written, portable points to the code for all the architectures
+	// and perArch to the files of each one.
+	buf      *bytes.Buffer
+	portable *bytes.Buffer
+	perArch  map[string]*bytes.Buffer
+}
+
+// generate returns the formatted sources keyed by architecture, the empty key holds the code for all of them.
+func generate(f *file, pkg, source string) (map[string][]byte, error) {
+	g := &generator{
+		file:       f,
+		interfaces: map[string]*iface{},
+		enums:      map[string]bool{},
+		structs:    map[string]*structDecl{},
+		portable:   &bytes.Buffer{},
+		perArch:    map[string]*bytes.Buffer{},
+	}
+
+	g.buf = g.portable
+
+	for _, arch := range archs {
+		g.perArch[arch] = &bytes.Buffer{}
+	}
+
+	for _, i := range f.interfaces {
+		g.interfaces[i.name] = i
+	}
+
+	for _, e := range f.enums {
+		g.enums[e.name] = true
+	}
+
+	for _, s := range f.structs {
+		g.structs[s.name] = s
+	}
+
+	for _, e := range f.enums {
+		g.enum(e)
+	}
+
+	for _, s := range f.structs {
+		if err := g.structDecl(s); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := g.iids(); err != nil {
+		return nil, err
+	}
+
+	for _, i := range f.interfaces {
+		if err := g.iface(i); err != nil {
+			return nil, err
+		}
+	}
+
+	sources := map[string][]byte{}
+
+	for arch, buf := range g.perArch {
+		if buf.Len() == 0 {
+			continue
+		}
+
+		src, err := g.source(pkg, source, buf.String())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", arch, err)
+		}
+
+		sources[arch] = src
+	}
+
+	src, err := g.source(pkg, source, g.portable.String())
+	if err != nil {
+		return nil, err
+	}
+
+	sources[""] = src
+
+	return sources, nil
+}
+
+// source formats a file out of the body, importing the packages it uses.
+func (g *generator) source(pkg, source, body string) ([]byte, error) {
+	g.buf = &bytes.Buffer{}
+
+	g.printf("// Code generated by comgen from %s. DO NOT EDIT.\n\n", source)
+	g.printf("package %s\n\n", pkg)
+	g.printf("import (\n")
+
+	for n, imp := range imports {
+		if n > 0 && imp.std != imports[n-1].std {
+			g.printf("\n")
+		}
+
+		if strings.Contains(body, imp.name+".") {
+			g.printf("\t%q\n", imp.path)
+		}
+	}
+
+	g.printf(")\n\n%s", body)
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format the generated code: %w", err)
+	}
+
+	return src, nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(g.buf, format, args...)
+}
+
+func (g *generator) enum(e *enum) {
+	g.printf("// %s implements %swebview2-idl#%s\n", e.name, docsURL, strings.ToLower(e.name))
+	g.printf("type %s int32\n\n", e.name)
+	g.printf("const (\n")
+
+	for _, v := range e.values {
+		g.printf("\t%s %s = %d\n", v.name, e.name, v.value)
+	}
+
+	g.printf(")\n\n")
+}
+
+// structDecl generates the Go struct of an IDL struct, which only holds scalars and enums.
+func (g *generator) structDecl(s *structDecl) error {
+	g.printf("// %s implements %swebview2-idl#%s\n", s.name, docsURL, strings.ToLower(s.name))
+	g.printf("type %s struct {\n", s.name)
+
+	for _, f := range s.fields {
+		typ := scalars[f.typ]
+
+		switch {
+		case g.enums[f.typ]:
+			typ = f.typ
+		case typ == "":
+			return fmt.Errorf("struct %s: unsupported type %s of field %s", s.name, f.typ, f.name)
+		}
+
+		g.printf("\t%s %s\n", f.name, typ)
+	}
+
+	g.printf("}\n\n")
+
+	return nil
+}
+
+func (g *generator) iids() error {
+	g.printf("var (\n")
+
+	for _, i := range g.file.interfaces {
+		guid, err := goGUID(i.uuid)
+		if err != nil {
+			return fmt.Errorf("interface %s: %w", i.name, err)
+		}
+
+		g.printf("\t// IID_%s is the interface ID of %s.\n", i.name, i.name)
+		g.printf("\tIID_%s = %s\n", i.name, guid)
+	}
+
+	g.printf(")\n\n")
+
+	return nil
+}
+
+func goGUID(uuid string) (string, error) {
+	hex := strings.ToUpper(strings.ReplaceAll(uuid, "-", ""))
+	if len(hex) != 32 {
+		return "", fmt.Errorf("invalid uuid %s", uuid)
+	}
+
+	data4 := make([]string, 8)
+	for n := range data4 {
+		data4[n] = "0x" + hex[16+2*n:18+2*n]
+	}
+
+	return fmt.Sprintf("windows.GUID{Data1: 0x%s, Data2: 0x%s, Data3: 0x%s, Data4: [8]byte{%s}}",
+		hex[0:8], hex[8:12], hex[12:16], strings.Join(data4, ", ")), nil
+}
+
+func (g *generator) iface(i *iface) error {
+	base := "BasicVTBL"
+
+	if i.base != "IUnknown" {
+		if _, ok := g.interfaces[i.base]; !ok {
+			return fmt.Errorf("interface %s: unknown base interface %s", i.name, i.base)
+		}
+
+		base = i.base + "VTBL"
+	}
+
+	url := docsURL + strings.ToLower(i.name)
+
+	g.printf("type (\n")
+	g.printf("\t// %s implements %s\n", i.name, url)
+	g.printf("\t%s struct {\n\t\tVTBL *%sVTBL\n\t}\n\n", i.name, i.name)
+	g.printf("\t// %sVTBL implements %s\n", i.name, url)
+	g.printf("\t%sVTBL struct {\n\t\t%s\n", i.name, base)
+
+	for _, m := range i.methods {
+		g.printf("\t\t%s uintptr\n", m.goName())
+	}
+
+	g.printf("\t}\n)\n\n")
+
+	// The wrappers of the inherited methods are generated as well, because the structs don't embed their bases.
+	var chain []*iface
+
+	for c := i; c != nil; c = g.interfaces[c.base] {
+		chain = append([]*iface{c}, chain...)
+	}
+
+	for _, c := range chain {
+		for _, m := range c.methods {
+			if !g.archSpecific(m) {
+				if err := g.wrapper(i, c, m, ""); err != nil {
+					return fmt.Errorf("%s::%s: %w", c.name, m.name, err)
+				}
+
+				continue
+			}
+
+			for _, arch := range archs {
+				g.buf = g.perArch[arch]
+
+				if err := g.wrapper(i, c, m, arch); err != nil {
+					return fmt.Errorf("%s::%s: %s: %w", c.name, m.name, arch, err)
+				}
+			}
+
+			g.buf = g.portable
+		}
+	}
+
+	return nil
+}
+
+// archSpecific tells whether m takes a struct or a 64-bit integer by value, which the calling conventions pass
+// in a different number of words: by reference on amd64, on the stack word by word on 386,
+// and packed into up to two registers on arm64.
+func (g *generator) archSpecific(m *method) bool {
+	for _, p := range m.params {
+		if p.out || p.pointers > 0 {
+			continue
+		}
+
+		if _, ok := byValue[archs[0]][scalars[p.typ]]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// wrapper generates the method calling the VTBL slot of m on the receiver. The wrapper takes Go strings and bools,
+// returns the out parameters as results and turns a failed HRESULT into an *Error.
+// The arguments are passed the way the calling convention of arch expects them, if it isn't empty.
+func (g *generator) wrapper(recv, declaring *iface, m *method, arch string) error {
+	var (
+		name     = m.goName()
+		method   = declaring.name + "::" + m.idlName()
+		params   []string
+		converts []string
+		vars     []string
+		args     = []string{"uintptr(unsafe.Pointer(i))"}
+		results  []string
+		returns  []string
+		zeros    []string
+	)
+
+	for _, p := range m.params {
+		pname := goIdent(p.name)
+
+		if p.out {
+			v, err := g.outValue(p)
+			if err != nil {
+				return err
+			}
+
+			vars = append(vars, fmt.Sprintf("%s %s", pname, v.storage))
+			args = append(args, "uintptr(unsafe.Pointer(&"+pname+"))")
+			results = append(results, v.typ)
+			returns = append(returns, fmt.Sprintf(v.result, pname))
+			zeros = append(zeros, v.zero)
+
+			continue
+		}
+
+		typ, arg, err := g.inValue(p, arch)
+		if err != nil {
+			return err
+		}
+
+		if typ == "float64" {
+			g.printf("// %s isn't wrapped, floating point arguments can't be passed through a syscall.\n\n", name)
+			return nil
+		}
+
+		if typ == "string" {
+			converts = append(converts, p.name)
+		}
+
+		params = append(params, fmt.Sprintf("%s %s", pname, typ))
+		args = append(args, fmt.Sprintf(arg, pname))
+	}
+
+	g.printf("// %s calls %s.\n", name, method)
+	g.printf("func (i *%s) %s(%s) (%s) {\n", recv.name, name, strings.Join(params, ", "), strings.Join(append(results, "error"), ", "))
+
+	// The string conversions may return early, before any out parameter is declared.
+	for _, c := range converts {
+		g.printf("%sPtr, err := windows.UTF16PtrFromString(%s)\n", goIdent(c), goIdent(c))
+		g.printf("if err != nil {\nreturn %s\n}\n\n", strings.Join(append(zeros, fmt.Sprintf("fmt.Errorf(\"%s: invalid %s: %%w\", err)", method, c)), ", "))
+	}
+
+	for _, v := range vars {
+		g.printf("var %s\n", v)
+	}
+
+	if len(vars) > 0 {
+		g.printf("\n")
+	}
+
+	g.printf("if hr := call(i.VTBL.%s, %s); hr.Failed() {\n", name, strings.Join(args, ", "))
+	g.printf("return %s\n}\n\n", strings.Join(append(zeros, fmt.Sprintf("&Error{Method: %q, HRESULT: hr}", method)), ", "))
+	g.printf("return %s\n}\n\n", strings.Join(append(returns, "nil"), ", "))
+
+	return nil
+}
+
+// outValue describes how an out parameter is stored and returned.
+type outValue struct {
+	// storage is the type of the variable passed to the method by reference.
+	storage string
+	// typ is the type of the result, result formats the variable into it and zero is returned on failure.
+	typ    string
+	result string
+	zero   string
+}
+
+func (g *generator) outValue(p *param) (outValue, error) {
+	pointers := p.pointers - 1
+
+	switch {
+	case pointers < 0:
+		return outValue{}, fmt.Errorf("out parameter %s isn't a pointer", p.name)
+	case (p.typ == "LPWSTR" || p.typ == "LPCWSTR") && pointers == 0:
+		return outValue{storage: "*uint16", typ: "string", result: "coTaskMemString(%s)", zero: `""`}, nil
+	case p.typ == "BOOL" && pointers == 0:
+		return outValue{storage: "int32", typ: "bool", result: "%s != 0", zero: "false"}, nil
+	case (g.enums[p.typ] || g.structs[p.typ] != nil || scalars[p.typ] != "") && pointers == 0:
+		typ := p.typ
+		if scalars[typ] != "" {
+			typ = scalars[typ]
+		}
+
+		zero := "0"
+		if handWritten[typ] || g.structs[typ] != nil {
+			zero = typ + "{}"
+		}
+
+		return outValue{storage: typ, typ: typ, result: "%s", zero: zero}, nil
+	case (g.interfaces[p.typ] != nil || predeclared[p.typ]) && pointers == 1:
+		typ := "*" + p.typ
+		return outValue{storage: typ, typ: typ, result: "%s", zero: "nil"}, nil
+	case pointers == 1:
+		// Interfaces missing from the IDL.
+		return outValue{storage: "unsafe.Pointer", typ: "unsafe.Pointer", result: "%s", zero: "nil"}, nil
+	}
+
+	return outValue{}, fmt.Errorf("unsupported out parameter %s of type %s", p.name, p.typ)
+}
+
+// inValue returns the Go type of an in parameter and the format of the expression passing it to the syscall,
+// which may span several arguments for the structs and 64-bit integers passed by value on arch.
+func (g *generator) inValue(p *param, arch string) (typ, arg string, err error) {
+	pointers := p.pointers
+
+	switch {
+	case (p.typ == "LPCWSTR" || p.typ == "LPWSTR") && pointers == 0:
+		return "string", "uintptr(unsafe.Pointer(%sPtr))", nil
+	case p.typ == "BOOL" && pointers == 0:
+		return "bool", "boolToUintptr(%s)", nil
+	case strings.HasSuffix(p.typ, "Handler") && pointers == 1:
+		// The handlers are implemented in Go.
+		return "*Handler", "uintptr(unsafe.Pointer(%s))", nil
+	case p.typ == "LPCWSTR" || p.typ == "LPWSTR":
+		typ = "uint16"
+		pointers++
+	case g.enums[p.typ] || g.structs[p.typ] != nil:
+		typ = p.typ
+	case scalars[p.typ] != "":
+		typ = scalars[p.typ]
+	case g.interfaces[p.typ] != nil || predeclared[p.typ]:
+		if pointers == 0 {
+			return "", "", fmt.Errorf("interface %s passed by value", p.typ)
+		}
+
+		typ = p.typ
+	case pointers == 1:
+		// Interfaces missing from the IDL and other opaque types, e.g. VARIANT.
+		return "unsafe.Pointer", "uintptr(%s)", nil
+	default:
+		return "", "", fmt.Errorf("unsupported type %s", p.typ)
+	}
+
+	if pointers > 0 {
+		return strings.Repeat("*", pointers) + typ, "uintptr(unsafe.Pointer(%s))", nil
+	}
+
+	if arg, ok := packed[typ]; ok {
+		return typ, arg, nil
+	}
+
+	if _, ok := byValue[archs[0]][typ]; ok || g.structs[typ] != nil {
+		arg, ok := byValue[arch][typ]
+		if !ok {
+			return "", "", fmt.Errorf("%s passed by value isn't supported on %q", typ, arch)
+		}
+
+		return typ, arg, nil
+	}
+
+	return typ, "uintptr(%s)", nil
+}
+
+// packed are the formats of the arguments passing the structs which fit in a 32-bit word by value,
+// which every calling convention passes the same way.
+var packed = map[string]string{
+	"COREWEBVIEW2_COLOR": "uintptr(%[1]s.A) | uintptr(%[1]s.R)<<8 | uintptr(%[1]s.G)<<16 | uintptr(%[1]s.B)<<24",
+}
+
+// byValue are the formats of the arguments passing the structs and 64-bit integers by value, per architecture.
+var byValue = map[string]map[string]string{
+	// Structs larger than 8 bytes are passed by reference in the x64 calling convention.
+	"amd64": {
+		"RECT":                   "uintptr(unsafe.Pointer(&%s))",
+		"POINT":                  "uintptr(uint32(%[1]s.X)) | uintptr(uint32(%[1]s.Y))<<32",
+		"EventRegistrationToken": "uintptr(%s.Value)",
+		"int64":                  "uintptr(%s)",
+		"uint64":                 "uintptr(%s)",
+	},
+	// Everything goes on the stack in 32-bit words, the low word first.
+	"386": {
+		"RECT":                   "uintptr(%[1]s.Left), uintptr(%[1]s.Top), uintptr(%[1]s.Right), uintptr(%[1]s.Bottom)",
+		"POINT":                  "uintptr(%[1]s.X), uintptr(%[1]s.Y)",
+		"EventRegistrationToken": "uintptr(%[1]s.Value), uintptr(%[1]s.Value>>32)",
+		"int64":                  "uintptr(%[1]s), uintptr(%[1]s>>32)",
+		"uint64":                 "uintptr(%[1]s), uintptr(%[1]s>>32)",
+	},
+	// Structs up to 16 bytes are packed into registers in the AAPCS64.
+	"arm64": {
+		"RECT":                   "uintptr(uint32(%[1]s.Left)) | uintptr(uint32(%[1]s.Top))<<32, uintptr(uint32(%[1]s.Right)) | uintptr(uint32(%[1]s.Bottom))<<32",
+		"POINT":                  "uintptr(uint32(%[1]s.X)) | uintptr(uint32(%[1]s.Y))<<32",
+		"EventRegistrationToken": "uintptr(%s.Value)",
+		"int64":                  "uintptr(%s)",
+		"uint64":                 "uintptr(%s)",
+	},
+}
+
+// idlName returns the name of the method as generated by MIDL.
+func (m *method) idlName() string {
+	if m.prop != "" {
+		return m.prop + "_" + m.name
+	}
+
+	return m.name
+}
+
+// goName returns the name of the VTBL slot and the wrapper of the method.
+func (m *method) goName() string {
+	name := m.name
+
+	switch {
+	case m.prop == "get":
+		name = "Get" + name
+	case m.prop == "put":
+		name = "Put" + name
+	case strings.HasPrefix(name, "add_"):
+		name = "Add" + strings.TrimPrefix(name, "add_")
+	case strings.HasPrefix(name, "remove_"):
+		name = "Remove" + strings.TrimPrefix(name, "remove_")
+	}
+
+	words := splitWords(name)
+	for n, w := range words {
+		if initialism, ok := initialisms[w]; ok {
+			words[n] = initialism
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+// splitWords splits a camel case name into words, keeping the runs of capitals together, e.g. DOMContentLoaded.
+func splitWords(name string) []string {
+	runes := []rune(name)
+
+	var (
+		words []string
+		start int
+	)
+
+	for n := 1; n < len(runes); n++ {
+		if !unicode.IsUpper(runes[n]) {
+			continue
+		}
+
+		prev := runes[n-1]
+		nextLower := n+1 < len(runes) && unicode.IsLower(runes[n+1])
+
+		if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+			words = append(words, string(runes[start:n]))
+			start = n
+		}
+	}
+
+	return append(words, string(runes[start:]))
+}
+
+// goIdent makes sure the parameter name doesn't clash with a keyword, the receiver or the locals of the wrappers.
+func goIdent(name string) string {
+	if gotoken.IsKeyword(name) || name == "i" || name == "err" || name == "hr" {
+		return name + "Value"
+	}
+
+	return name
+}
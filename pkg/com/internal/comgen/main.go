@@ -0,0 +1,68 @@
+// Command comgen generates the COM interface structs, VTBLs, IIDs, enums, structs and method wrappers
+// of the com package from WebView2.idl. go generate runs it in pkg/com:
+//
+//	go run ./internal/comgen -idl WebView2.idl -o zwebview2.go
+//
+// The wrappers passing structs or 64-bit integers by value go to a file per architecture, e.g. zwebview2_386.go.
+// The tests generate the IDL files of testdata and compare them with the Go files next to them,
+// go test -update rewrites those after a change to the generator.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	var (
+		idl = flag.String("idl", "WebView2.idl", "the IDL file to read")
+		out = flag.String("o", "zwebview2.go", "the Go file to write")
+		pkg = flag.String("package", "com", "the package of the generated file")
+	)
+
+	flag.Parse()
+
+	if err := run(*idl, *out, *pkg); err != nil {
+		fmt.Fprintln(os.Stderr, "comgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(idl, out, pkg string) error {
+	src, err := ioutil.ReadFile(idl)
+	if err != nil {
+		return err
+	}
+
+	f, err := parse(string(src))
+	if err != nil {
+		return fmt.Errorf("%s: %w", idl, err)
+	}
+
+	sources, err := generate(f, pkg, filepath.Base(idl))
+	if err != nil {
+		return fmt.Errorf("%s: %w", idl, err)
+	}
+
+	for arch, code := range sources {
+		if err := ioutil.WriteFile(archPath(out, arch), code, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// archPath returns the file the code for arch is written to. The wrappers depending on the calling convention
+// go to files suffixed with the architecture, e.g. zwebview2_386.go.
+func archPath(out, arch string) string {
+	if arch == "" {
+		return out
+	}
+
+	return strings.TrimSuffix(out, ".go") + "_" + arch + ".go"
+}
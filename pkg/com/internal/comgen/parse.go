@@ -0,0 +1,572 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type (
+	// file holds the declarations of an IDL file that comgen knows how to generate.
+	file struct {
+		interfaces []*iface
+		enums      []*enum
+		structs    []*structDecl
+	}
+
+	iface struct {
+		name    string
+		base    string
+		uuid    string
+		methods []*method
+	}
+
+	method struct {
+		name string
+		// prop is "get" or "put" for the [propget] and [propput] methods, which MIDL prefixes with get_ and put_.
+		prop   string
+		params []*param
+	}
+
+	param struct {
+		name     string
+		typ      string
+		pointers int
+		out      bool
+	}
+
+	enum struct {
+		name   string
+		values []enumValue
+	}
+
+	enumValue struct {
+		name  string
+		value int64
+	}
+
+	structDecl struct {
+		name   string
+		fields []field
+	}
+
+	field struct {
+		name string
+		typ  string
+	}
+)
+
+type tokenKind int
+
+const (
+	tokenIdent tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenAttr
+	tokenPunct
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+func tokenize(src string) ([]token, error) {
+	var (
+		tokens []token
+		line   = 1
+	)
+
+	for i := 0; i < len(src); {
+		c := src[i]
+
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(src[i:], "//"), c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"':
+			j := i + 1
+			for j < len(src) && src[j] != '"' {
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+
+			if j >= len(src) {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+
+			tokens = append(tokens, token{kind: tokenString, text: src[i+1 : j], line: line})
+			i = j + 1
+		case c == '[':
+			depth, j := 0, i
+			for ; j < len(src); j++ {
+				if src[j] == '[' {
+					depth++
+				} else if src[j] == ']' {
+					depth--
+					if depth == 0 {
+						break
+					}
+				}
+			}
+
+			if j >= len(src) {
+				return nil, fmt.Errorf("line %d: unterminated attribute list", line)
+			}
+
+			tokens = append(tokens, token{kind: tokenAttr, text: src[i+1 : j], line: line})
+			line += strings.Count(src[i:j], "\n")
+			i = j + 1
+		case isIdentByte(c):
+			j := i
+			for j < len(src) && isIdentByte(src[j]) {
+				j++
+			}
+
+			kind := tokenIdent
+			if c >= '0' && c <= '9' {
+				kind = tokenNumber
+			}
+
+			tokens = append(tokens, token{kind: kind, text: src[i:j], line: line})
+			i = j
+		default:
+			tokens = append(tokens, token{kind: tokenPunct, text: string(c), line: line})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func parse(src string) (*file, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	f := &file{}
+
+	var attrs string
+
+	for !p.eof() {
+		t := p.next()
+
+		switch {
+		case t.kind == tokenAttr:
+			attrs = t.text
+			continue
+		case t.kind != tokenIdent:
+			// Stray punctuation, e.g. the braces of the library block.
+		case t.text == "interface":
+			i, err := p.parseInterface(attrs)
+			if err != nil {
+				return nil, err
+			}
+
+			if i != nil {
+				f.interfaces = append(f.interfaces, i)
+			}
+		case t.text == "typedef":
+			for p.peek().kind == tokenAttr {
+				p.next()
+			}
+
+			switch p.peek().text {
+			case "enum":
+				p.next()
+
+				e, err := p.parseEnum()
+				if err != nil {
+					return nil, err
+				}
+
+				f.enums = append(f.enums, e)
+			case "struct":
+				p.next()
+
+				s, err := p.parseStruct()
+				if err != nil {
+					return nil, err
+				}
+
+				f.structs = append(f.structs, s)
+			default:
+				if err := p.skipStatement(); err != nil {
+					return nil, err
+				}
+			}
+		case t.text == "import":
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		case t.text == "importlib", t.text == "cpp_quote", t.text == "midl_pragma":
+			if err := p.skipParens(); err != nil {
+				return nil, err
+			}
+		}
+
+		attrs = ""
+	}
+
+	return f, nil
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.eof() {
+		return token{kind: tokenPunct}
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+
+	return t
+}
+
+func (p *parser) expect(text string) error {
+	t := p.next()
+	if t.text != text {
+		return fmt.Errorf("line %d: expected %q, found %q", t.line, text, t.text)
+	}
+
+	return nil
+}
+
+func (p *parser) ident() (string, error) {
+	t := p.next()
+	if t.kind != tokenIdent {
+		return "", fmt.Errorf("line %d: expected an identifier, found %q", t.line, t.text)
+	}
+
+	return t.text, nil
+}
+
+// skipStatement skips everything up to the next semicolon outside of braces.
+func (p *parser) skipStatement() error {
+	depth := 0
+
+	for !p.eof() {
+		switch t := p.next(); t.text {
+		case "{":
+			depth++
+		case "}":
+			depth--
+		case ";":
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("unexpected end of file")
+}
+
+func (p *parser) skipParens() error {
+	if err := p.expect("("); err != nil {
+		return err
+	}
+
+	for depth := 1; depth > 0; {
+		if p.eof() {
+			return fmt.Errorf("unexpected end of file")
+		}
+
+		switch p.next().text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		}
+	}
+
+	if p.peek().text == ";" {
+		p.next()
+	}
+
+	return nil
+}
+
+var uuidRegexp = regexp.MustCompile(`uuid\(\s*([0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12})\s*\)`)
+
+// parseInterface parses an interface definition, or skips a forward declaration and returns nil.
+func (p *parser) parseInterface(attrs string) (*iface, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().text == ";" {
+		p.next()
+		return nil, nil
+	}
+
+	i := &iface{name: name}
+
+	if m := uuidRegexp.FindStringSubmatch(attrs); m != nil {
+		i.uuid = m[1]
+	} else {
+		return nil, fmt.Errorf("interface %s: missing uuid", name)
+	}
+
+	if err := p.expect(":"); err != nil {
+		return nil, fmt.Errorf("interface %s: %w", name, err)
+	}
+
+	if i.base, err = p.ident(); err != nil {
+		return nil, err
+	}
+
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	for p.peek().text != "}" {
+		if p.eof() {
+			return nil, fmt.Errorf("interface %s: unexpected end of file", name)
+		}
+
+		m, err := p.parseMethod()
+		if err != nil {
+			return nil, fmt.Errorf("interface %s: %w", name, err)
+		}
+
+		i.methods = append(i.methods, m)
+	}
+
+	p.next()
+
+	if p.peek().text == ";" {
+		p.next()
+	}
+
+	return i, nil
+}
+
+func (p *parser) parseMethod() (*method, error) {
+	m := &method{}
+
+	if p.peek().kind == tokenAttr {
+		for _, attr := range strings.Split(p.next().text, ",") {
+			switch strings.TrimSpace(attr) {
+			case "propget":
+				m.prop = "get"
+			case "propput":
+				m.prop = "put"
+			}
+		}
+	}
+
+	ret, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+
+	if ret != "HRESULT" {
+		return nil, fmt.Errorf("unsupported return type %s", ret)
+	}
+
+	if m.name, err = p.ident(); err != nil {
+		return nil, err
+	}
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	if p.peek().text == "void" {
+		p.next()
+	}
+
+	for p.peek().text != ")" {
+		param, err := p.parseParam()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", m.name, err)
+		}
+
+		m.params = append(m.params, param)
+
+		if p.peek().text == "," {
+			p.next()
+		}
+	}
+
+	p.next()
+
+	if err := p.expect(";"); err != nil {
+		return nil, fmt.Errorf("%s: %w", m.name, err)
+	}
+
+	return m, nil
+}
+
+func (p *parser) parseParam() (*param, error) {
+	param := &param{}
+
+	if p.peek().kind == tokenAttr {
+		for _, attr := range strings.Split(p.next().text, ",") {
+			if strings.TrimSpace(attr) == "out" {
+				param.out = true
+			}
+		}
+	}
+
+	var words []string
+
+	for {
+		t := p.next()
+
+		switch {
+		case t.text == "*":
+			param.pointers++
+		case t.kind == tokenIdent:
+			if t.text != "const" {
+				words = append(words, t.text)
+			}
+		default:
+			return nil, fmt.Errorf("line %d: unexpected %q in a parameter", t.line, t.text)
+		}
+
+		if next := p.peek().text; next == "," || next == ")" {
+			break
+		}
+	}
+
+	if len(words) < 2 {
+		return nil, fmt.Errorf("parameter without a name")
+	}
+
+	param.name = words[len(words)-1]
+	param.typ = strings.Join(words[:len(words)-1], " ")
+
+	return param, nil
+}
+
+func (p *parser) parseEnum() (*enum, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	e := &enum{name: name}
+
+	var value int64
+
+	for p.peek().text != "}" {
+		valueName, err := p.ident()
+		if err != nil {
+			return nil, fmt.Errorf("enum %s: %w", name, err)
+		}
+
+		if p.peek().text == "=" {
+			p.next()
+
+			t := p.next()
+			if t.kind != tokenNumber {
+				return nil, fmt.Errorf("enum %s: line %d: unsupported value %q", name, t.line, t.text)
+			}
+
+			if value, err = strconv.ParseInt(t.text, 0, 64); err != nil {
+				return nil, fmt.Errorf("enum %s: %w", name, err)
+			}
+		}
+
+		e.values = append(e.values, enumValue{name: valueName, value: value})
+		value++
+
+		if p.peek().text == "," {
+			p.next()
+		}
+	}
+
+	p.next()
+
+	// The typedef name follows the closing brace.
+	if err := p.skipStatement(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (p *parser) parseStruct() (*structDecl, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	s := &structDecl{name: name}
+
+	for p.peek().text != "}" {
+		var words []string
+
+		for p.peek().text != ";" {
+			t := p.next()
+			if t.kind != tokenIdent {
+				return nil, fmt.Errorf("struct %s: line %d: unsupported field %q", name, t.line, t.text)
+			}
+
+			words = append(words, t.text)
+		}
+
+		p.next()
+
+		if len(words) < 2 {
+			return nil, fmt.Errorf("struct %s: field without a name", name)
+		}
+
+		s.fields = append(s.fields, field{name: words[len(words)-1], typ: strings.Join(words[:len(words)-1], " ")})
+	}
+
+	p.next()
+
+	// The typedef name follows the closing brace.
+	if err := p.skipStatement(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
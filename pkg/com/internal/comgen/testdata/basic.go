@@ -0,0 +1,393 @@
+// Code generated by comgen from basic.idl. DO NOT EDIT.
+
+package com
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// TEST_KIND implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#test_kind
+type TEST_KIND int32
+
+const (
+	TEST_KIND_FIRST  TEST_KIND = 0
+	TEST_KIND_SECOND TEST_KIND = 1
+	TEST_KIND_HEX    TEST_KIND = 526
+	TEST_KIND_NEXT   TEST_KIND = 527
+)
+
+// TEST_STATUS implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#test_status
+type TEST_STATUS struct {
+	Count uint32
+	IsSet int32
+	Kind  TEST_KIND
+}
+
+var (
+	// IID_ITest is the interface ID of ITest.
+	IID_ITest = windows.GUID{Data1: 0x76ECEACB, Data2: 0x0462, Data3: 0x4D94, Data4: [8]byte{0xAC, 0x83, 0x42, 0x3A, 0x67, 0x93, 0x77, 0x5E}}
+	// IID_ITest2 is the interface ID of ITest2.
+	IID_ITest2 = windows.GUID{Data1: 0x9E8F0CF8, Data2: 0xE670, Data3: 0x4B5E, Data4: [8]byte{0xB2, 0xBC, 0x73, 0xE0, 0x61, 0xE3, 0x18, 0x4C}}
+	// IID_ITestEventHandler is the interface ID of ITestEventHandler.
+	IID_ITestEventHandler = windows.GUID{Data1: 0x4E8A3389, Data2: 0xC9D8, Data3: 0x4BD2, Data4: [8]byte{0xB6, 0xB5, 0x12, 0x4F, 0xEE, 0x6C, 0xC1, 0x4D}}
+)
+
+type (
+	// ITest implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/itest
+	ITest struct {
+		VTBL *ITestVTBL
+	}
+
+	// ITestVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/itest
+	ITestVTBL struct {
+		BasicVTBL
+		GetName      uintptr
+		PutName      uintptr
+		GetIsEnabled uintptr
+		PutIsEnabled uintptr
+		GetKind      uintptr
+		GetStatus    uintptr
+		GetWindow    uintptr
+		PutScale     uintptr
+		GetHeaders   uintptr
+		Resolve      uintptr
+		Opaque       uintptr
+		Stream       uintptr
+		Reload       uintptr
+	}
+)
+
+// GetName calls ITest::get_Name.
+func (i *ITest) GetName() (string, error) {
+	var name *uint16
+
+	if hr := call(i.VTBL.GetName, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&name))); hr.Failed() {
+		return "", &Error{Method: "ITest::get_Name", HRESULT: hr}
+	}
+
+	return coTaskMemString(name), nil
+}
+
+// PutName calls ITest::put_Name.
+func (i *ITest) PutName(name string) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ITest::put_Name: invalid name: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutName, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr))); hr.Failed() {
+		return &Error{Method: "ITest::put_Name", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsEnabled calls ITest::get_IsEnabled.
+func (i *ITest) GetIsEnabled() (bool, error) {
+	var isEnabled int32
+
+	if hr := call(i.VTBL.GetIsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isEnabled))); hr.Failed() {
+		return false, &Error{Method: "ITest::get_IsEnabled", HRESULT: hr}
+	}
+
+	return isEnabled != 0, nil
+}
+
+// PutIsEnabled calls ITest::put_IsEnabled.
+func (i *ITest) PutIsEnabled(isEnabled bool) error {
+	if hr := call(i.VTBL.PutIsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isEnabled)); hr.Failed() {
+		return &Error{Method: "ITest::put_IsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetKind calls ITest::get_Kind.
+func (i *ITest) GetKind() (TEST_KIND, error) {
+	var kind TEST_KIND
+
+	if hr := call(i.VTBL.GetKind, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&kind))); hr.Failed() {
+		return 0, &Error{Method: "ITest::get_Kind", HRESULT: hr}
+	}
+
+	return kind, nil
+}
+
+// GetStatus calls ITest::get_Status.
+func (i *ITest) GetStatus() (TEST_STATUS, error) {
+	var status TEST_STATUS
+
+	if hr := call(i.VTBL.GetStatus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&status))); hr.Failed() {
+		return TEST_STATUS{}, &Error{Method: "ITest::get_Status", HRESULT: hr}
+	}
+
+	return status, nil
+}
+
+// GetWindow calls ITest::get_Window.
+func (i *ITest) GetWindow() (windows.Handle, error) {
+	var window windows.Handle
+
+	if hr := call(i.VTBL.GetWindow, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&window))); hr.Failed() {
+		return 0, &Error{Method: "ITest::get_Window", HRESULT: hr}
+	}
+
+	return window, nil
+}
+
+// PutScale isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// GetHeaders calls ITest::GetHeaders.
+func (i *ITest) GetHeaders(name string) (string, uint32, error) {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return "", 0, fmt.Errorf("ITest::GetHeaders: invalid name: %w", err)
+	}
+
+	var value *uint16
+	var count uint32
+
+	if hr := call(i.VTBL.GetHeaders, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(&value)), uintptr(unsafe.Pointer(&count))); hr.Failed() {
+		return "", 0, &Error{Method: "ITest::GetHeaders", HRESULT: hr}
+	}
+
+	return coTaskMemString(value), count, nil
+}
+
+// Resolve calls ITest::Resolve.
+func (i *ITest) Resolve(handler *Handler) error {
+	if hr := call(i.VTBL.Resolve, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ITest::Resolve", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// Opaque calls ITest::Opaque.
+func (i *ITest) Opaque(object unsafe.Pointer) (unsafe.Pointer, error) {
+	var missing unsafe.Pointer
+
+	if hr := call(i.VTBL.Opaque, uintptr(unsafe.Pointer(i)), uintptr(object), uintptr(unsafe.Pointer(&missing))); hr.Failed() {
+		return nil, &Error{Method: "ITest::Opaque", HRESULT: hr}
+	}
+
+	return missing, nil
+}
+
+// Stream calls ITest::Stream.
+func (i *ITest) Stream() (*IStream, error) {
+	var stream *IStream
+
+	if hr := call(i.VTBL.Stream, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&stream))); hr.Failed() {
+		return nil, &Error{Method: "ITest::Stream", HRESULT: hr}
+	}
+
+	return stream, nil
+}
+
+// Reload calls ITest::Reload.
+func (i *ITest) Reload() error {
+	if hr := call(i.VTBL.Reload, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ITest::Reload", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ITest2 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/itest2
+	ITest2 struct {
+		VTBL *ITest2VTBL
+	}
+
+	// ITest2VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/itest2
+	ITest2VTBL struct {
+		ITestVTBL
+		GetUserID uintptr
+		GetParent uintptr
+	}
+)
+
+// GetName calls ITest::get_Name.
+func (i *ITest2) GetName() (string, error) {
+	var name *uint16
+
+	if hr := call(i.VTBL.GetName, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&name))); hr.Failed() {
+		return "", &Error{Method: "ITest::get_Name", HRESULT: hr}
+	}
+
+	return coTaskMemString(name), nil
+}
+
+// PutName calls ITest::put_Name.
+func (i *ITest2) PutName(name string) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ITest::put_Name: invalid name: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutName, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr))); hr.Failed() {
+		return &Error{Method: "ITest::put_Name", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsEnabled calls ITest::get_IsEnabled.
+func (i *ITest2) GetIsEnabled() (bool, error) {
+	var isEnabled int32
+
+	if hr := call(i.VTBL.GetIsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isEnabled))); hr.Failed() {
+		return false, &Error{Method: "ITest::get_IsEnabled", HRESULT: hr}
+	}
+
+	return isEnabled != 0, nil
+}
+
+// PutIsEnabled calls ITest::put_IsEnabled.
+func (i *ITest2) PutIsEnabled(isEnabled bool) error {
+	if hr := call(i.VTBL.PutIsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isEnabled)); hr.Failed() {
+		return &Error{Method: "ITest::put_IsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetKind calls ITest::get_Kind.
+func (i *ITest2) GetKind() (TEST_KIND, error) {
+	var kind TEST_KIND
+
+	if hr := call(i.VTBL.GetKind, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&kind))); hr.Failed() {
+		return 0, &Error{Method: "ITest::get_Kind", HRESULT: hr}
+	}
+
+	return kind, nil
+}
+
+// GetStatus calls ITest::get_Status.
+func (i *ITest2) GetStatus() (TEST_STATUS, error) {
+	var status TEST_STATUS
+
+	if hr := call(i.VTBL.GetStatus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&status))); hr.Failed() {
+		return TEST_STATUS{}, &Error{Method: "ITest::get_Status", HRESULT: hr}
+	}
+
+	return status, nil
+}
+
+// GetWindow calls ITest::get_Window.
+func (i *ITest2) GetWindow() (windows.Handle, error) {
+	var window windows.Handle
+
+	if hr := call(i.VTBL.GetWindow, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&window))); hr.Failed() {
+		return 0, &Error{Method: "ITest::get_Window", HRESULT: hr}
+	}
+
+	return window, nil
+}
+
+// PutScale isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// GetHeaders calls ITest::GetHeaders.
+func (i *ITest2) GetHeaders(name string) (string, uint32, error) {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return "", 0, fmt.Errorf("ITest::GetHeaders: invalid name: %w", err)
+	}
+
+	var value *uint16
+	var count uint32
+
+	if hr := call(i.VTBL.GetHeaders, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(&value)), uintptr(unsafe.Pointer(&count))); hr.Failed() {
+		return "", 0, &Error{Method: "ITest::GetHeaders", HRESULT: hr}
+	}
+
+	return coTaskMemString(value), count, nil
+}
+
+// Resolve calls ITest::Resolve.
+func (i *ITest2) Resolve(handler *Handler) error {
+	if hr := call(i.VTBL.Resolve, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ITest::Resolve", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// Opaque calls ITest::Opaque.
+func (i *ITest2) Opaque(object unsafe.Pointer) (unsafe.Pointer, error) {
+	var missing unsafe.Pointer
+
+	if hr := call(i.VTBL.Opaque, uintptr(unsafe.Pointer(i)), uintptr(object), uintptr(unsafe.Pointer(&missing))); hr.Failed() {
+		return nil, &Error{Method: "ITest::Opaque", HRESULT: hr}
+	}
+
+	return missing, nil
+}
+
+// Stream calls ITest::Stream.
+func (i *ITest2) Stream() (*IStream, error) {
+	var stream *IStream
+
+	if hr := call(i.VTBL.Stream, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&stream))); hr.Failed() {
+		return nil, &Error{Method: "ITest::Stream", HRESULT: hr}
+	}
+
+	return stream, nil
+}
+
+// Reload calls ITest::Reload.
+func (i *ITest2) Reload() error {
+	if hr := call(i.VTBL.Reload, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ITest::Reload", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetUserID calls ITest2::get_UserId.
+func (i *ITest2) GetUserID() (int64, error) {
+	var userId int64
+
+	if hr := call(i.VTBL.GetUserID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&userId))); hr.Failed() {
+		return 0, &Error{Method: "ITest2::get_UserId", HRESULT: hr}
+	}
+
+	return userId, nil
+}
+
+// GetParent calls ITest2::get_Parent.
+func (i *ITest2) GetParent() (*ITest, error) {
+	var parent *ITest
+
+	if hr := call(i.VTBL.GetParent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&parent))); hr.Failed() {
+		return nil, &Error{Method: "ITest2::get_Parent", HRESULT: hr}
+	}
+
+	return parent, nil
+}
+
+type (
+	// ITestEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/itesteventhandler
+	ITestEventHandler struct {
+		VTBL *ITestEventHandlerVTBL
+	}
+
+	// ITestEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/itesteventhandler
+	ITestEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ITestEventHandler::Invoke.
+func (i *ITestEventHandler) Invoke(sender *ITest, args *IUnknown) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ITestEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
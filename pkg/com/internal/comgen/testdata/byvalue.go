@@ -0,0 +1,61 @@
+// Code generated by comgen from byvalue.idl. DO NOT EDIT.
+
+package com
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// COREWEBVIEW2_COLOR implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_color
+type COREWEBVIEW2_COLOR struct {
+	A uint8
+	R uint8
+	G uint8
+	B uint8
+}
+
+var (
+	// IID_ITestController is the interface ID of ITestController.
+	IID_ITestController = windows.GUID{Data1: 0x4D00C0D1, Data2: 0x9434, Data3: 0x4EB6, Data4: [8]byte{0x80, 0x78, 0x86, 0x97, 0xA5, 0x60, 0x33, 0x4F}}
+)
+
+type (
+	// ITestController implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/itestcontroller
+	ITestController struct {
+		VTBL *ITestControllerVTBL
+	}
+
+	// ITestControllerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/itestcontroller
+	ITestControllerVTBL struct {
+		BasicVTBL
+		GetBounds           uintptr
+		PutBounds           uintptr
+		SendInput           uintptr
+		RemoveChanged       uintptr
+		PutPerformanceCount uintptr
+		PutOffset           uintptr
+		PutColor            uintptr
+	}
+)
+
+// GetBounds calls ITestController::get_Bounds.
+func (i *ITestController) GetBounds() (RECT, error) {
+	var bounds RECT
+
+	if hr := call(i.VTBL.GetBounds, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&bounds))); hr.Failed() {
+		return RECT{}, &Error{Method: "ITestController::get_Bounds", HRESULT: hr}
+	}
+
+	return bounds, nil
+}
+
+// PutColor calls ITestController::put_Color.
+func (i *ITestController) PutColor(color COREWEBVIEW2_COLOR) error {
+	if hr := call(i.VTBL.PutColor, uintptr(unsafe.Pointer(i)), uintptr(color.A)|uintptr(color.R)<<8|uintptr(color.G)<<16|uintptr(color.B)<<24); hr.Failed() {
+		return &Error{Method: "ITestController::put_Color", HRESULT: hr}
+	}
+
+	return nil
+}
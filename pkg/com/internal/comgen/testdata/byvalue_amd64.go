@@ -0,0 +1,52 @@
+// Code generated by comgen from byvalue.idl. DO NOT EDIT.
+
+package com
+
+import (
+	"unsafe"
+)
+
+// PutBounds calls ITestController::put_Bounds.
+func (i *ITestController) PutBounds(bounds RECT) error {
+	if hr := call(i.VTBL.PutBounds, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&bounds))); hr.Failed() {
+		return &Error{Method: "ITestController::put_Bounds", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// SendInput calls ITestController::SendInput.
+func (i *ITestController) SendInput(data uint32, point POINT) error {
+	if hr := call(i.VTBL.SendInput, uintptr(unsafe.Pointer(i)), uintptr(data), uintptr(uint32(point.X))|uintptr(uint32(point.Y))<<32); hr.Failed() {
+		return &Error{Method: "ITestController::SendInput", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveChanged calls ITestController::remove_Changed.
+func (i *ITestController) RemoveChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ITestController::remove_Changed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutPerformanceCount calls ITestController::put_PerformanceCount.
+func (i *ITestController) PutPerformanceCount(count uint64) error {
+	if hr := call(i.VTBL.PutPerformanceCount, uintptr(unsafe.Pointer(i)), uintptr(count)); hr.Failed() {
+		return &Error{Method: "ITestController::put_PerformanceCount", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutOffset calls ITestController::put_Offset.
+func (i *ITestController) PutOffset(offset int64) error {
+	if hr := call(i.VTBL.PutOffset, uintptr(unsafe.Pointer(i)), uintptr(offset)); hr.Failed() {
+		return &Error{Method: "ITestController::put_Offset", HRESULT: hr}
+	}
+
+	return nil
+}
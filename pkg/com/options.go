@@ -0,0 +1,147 @@
+package com
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/mattpodraza/webview2/v2/pkg/hresult"
+	"golang.org/x/sys/windows"
+)
+
+// DefaultTargetCompatibleBrowserVersion is the runtime version matching the SDK WebView2.idl comes from,
+// the oldest runtime implementing its interfaces. The later versions, e.g. ICoreWebView2Settings2, are only handed out
+// by QueryInterface on the runtimes which implement them.
+const DefaultTargetCompatibleBrowserVersion = "88.0.705.50"
+
+var (
+	ole32               = windows.NewLazySystemDLL("ole32")
+	ole32CoTaskMemAlloc = ole32.NewProc("CoTaskMemAlloc")
+)
+
+// EnvironmentOptions is ICoreWebView2EnvironmentOptions implemented in Go, which configures the environment
+// created by CreateCoreWebView2EnvironmentWithOptions.
+//
+// It counts its references the way a Handler does and stays reachable until the last reference is released.
+type EnvironmentOptions struct {
+	VTBL *ICoreWebView2EnvironmentOptionsVTBL
+
+	unknown
+
+	// AdditionalBrowserArguments are passed to the browser process, e.g. --disable-gpu.
+	AdditionalBrowserArguments string
+	// Language is the default display language of the WebView, e.g. en-US.
+	Language string
+	// TargetCompatibleBrowserVersion is the oldest runtime version the application works with.
+	TargetCompatibleBrowserVersion string
+	// AllowSingleSignOnUsingOSPrimaryAccount enables single sign-on with Azure Active Directory.
+	AllowSingleSignOnUsingOSPrimaryAccount bool
+}
+
+var (
+	environmentOptionsVTBL     *ICoreWebView2EnvironmentOptionsVTBL
+	environmentOptionsVTBLOnce sync.Once
+)
+
+// NewEnvironmentOptions creates the options targeting DefaultTargetCompatibleBrowserVersion.
+// It starts with a single reference, which belongs to the caller, who must Release it once the environment is created.
+func NewEnvironmentOptions() *EnvironmentOptions {
+	environmentOptionsVTBLOnce.Do(func() {
+		environmentOptionsVTBL = &ICoreWebView2EnvironmentOptionsVTBL{
+			BasicVTBL: BasicVTBL{
+				QueryInterface: windows.NewCallback((*EnvironmentOptions).QueryInterface),
+				AddRef:         windows.NewCallback((*EnvironmentOptions).AddRef),
+				Release:        windows.NewCallback((*EnvironmentOptions).Release),
+			},
+			GetAdditionalBrowserArguments:             windows.NewCallback((*EnvironmentOptions).getAdditionalBrowserArguments),
+			PutAdditionalBrowserArguments:             windows.NewCallback((*EnvironmentOptions).putAdditionalBrowserArguments),
+			GetLanguage:                               windows.NewCallback((*EnvironmentOptions).getLanguage),
+			PutLanguage:                               windows.NewCallback((*EnvironmentOptions).putLanguage),
+			GetTargetCompatibleBrowserVersion:         windows.NewCallback((*EnvironmentOptions).getTargetCompatibleBrowserVersion),
+			PutTargetCompatibleBrowserVersion:         windows.NewCallback((*EnvironmentOptions).putTargetCompatibleBrowserVersion),
+			GetAllowSingleSignOnUsingOSPrimaryAccount: windows.NewCallback((*EnvironmentOptions).getAllowSingleSignOnUsingOSPrimaryAccount),
+			PutAllowSingleSignOnUsingOSPrimaryAccount: windows.NewCallback((*EnvironmentOptions).putAllowSingleSignOnUsingOSPrimaryAccount),
+		}
+	})
+
+	o := &EnvironmentOptions{
+		VTBL:                           environmentOptionsVTBL,
+		unknown:                        unknown{iid: IID_ICoreWebView2EnvironmentOptions},
+		TargetCompatibleBrowserVersion: DefaultTargetCompatibleBrowserVersion,
+	}
+
+	o.AddRef()
+
+	return o
+}
+
+// QueryInterface is the QueryInterface from COM, it only answers for IUnknown and ICoreWebView2EnvironmentOptions.
+func (o *EnvironmentOptions) QueryInterface(iid *windows.GUID, object *unsafe.Pointer) uintptr {
+	return o.queryInterface(unsafe.Pointer(o), iid, object)
+}
+
+func (o *EnvironmentOptions) getAdditionalBrowserArguments(value **uint16) uintptr {
+	return getString(o.AdditionalBrowserArguments, value)
+}
+
+func (o *EnvironmentOptions) putAdditionalBrowserArguments(value *uint16) uintptr {
+	o.AdditionalBrowserArguments = windows.UTF16PtrToString(value)
+	return uintptr(hresult.S_OK)
+}
+
+func (o *EnvironmentOptions) getLanguage(value **uint16) uintptr {
+	return getString(o.Language, value)
+}
+
+func (o *EnvironmentOptions) putLanguage(value *uint16) uintptr {
+	o.Language = windows.UTF16PtrToString(value)
+	return uintptr(hresult.S_OK)
+}
+
+func (o *EnvironmentOptions) getTargetCompatibleBrowserVersion(value **uint16) uintptr {
+	return getString(o.TargetCompatibleBrowserVersion, value)
+}
+
+func (o *EnvironmentOptions) putTargetCompatibleBrowserVersion(value *uint16) uintptr {
+	o.TargetCompatibleBrowserVersion = windows.UTF16PtrToString(value)
+	return uintptr(hresult.S_OK)
+}
+
+func (o *EnvironmentOptions) getAllowSingleSignOnUsingOSPrimaryAccount(allow *int32) uintptr {
+	if allow == nil {
+		return uintptr(hresult.E_POINTER)
+	}
+
+	*allow = int32(boolToUintptr(o.AllowSingleSignOnUsingOSPrimaryAccount))
+
+	return uintptr(hresult.S_OK)
+}
+
+func (o *EnvironmentOptions) putAllowSingleSignOnUsingOSPrimaryAccount(allow int32) uintptr {
+	o.AllowSingleSignOnUsingOSPrimaryAccount = allow != 0
+	return uintptr(hresult.S_OK)
+}
+
+// getString hands a copy of s allocated with CoTaskMemAlloc over to the caller, who frees it.
+func getString(s string, value **uint16) uintptr {
+	if value == nil {
+		return uintptr(hresult.E_POINTER)
+	}
+
+	u, err := windows.UTF16FromString(s)
+	if err != nil {
+		return uintptr(hresult.E_INVALIDARG)
+	}
+
+	size := uintptr(len(u)) * unsafe.Sizeof(u[0])
+
+	r, _, _ := ole32CoTaskMemAlloc.Call(size)
+	if r == 0 {
+		return uintptr(hresult.E_OUTOFMEMORY)
+	}
+
+	p := (*[1 << 29]uint16)(Pointer(r))
+	copy(p[:len(u):len(u)], u)
+	*value = &p[0]
+
+	return uintptr(hresult.S_OK)
+}
@@ -0,0 +1,8506 @@
+// Code generated by comgen from WebView2.idl. DO NOT EDIT.
+
+package com
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/mattpodraza/webview2/v2/pkg/hresult"
+	"golang.org/x/sys/windows"
+)
+
+// COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_capture_preview_image_format
+type COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT int32
+
+const (
+	COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT_PNG  COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT = 0
+	COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT_JPEG COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT = 1
+)
+
+// COREWEBVIEW2_WEB_ERROR_STATUS implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_web_error_status
+type COREWEBVIEW2_WEB_ERROR_STATUS int32
+
+const (
+	COREWEBVIEW2_WEB_ERROR_STATUS_UNKNOWN                              COREWEBVIEW2_WEB_ERROR_STATUS = 0
+	COREWEBVIEW2_WEB_ERROR_STATUS_CERTIFICATE_COMMON_NAME_IS_INCORRECT COREWEBVIEW2_WEB_ERROR_STATUS = 1
+	COREWEBVIEW2_WEB_ERROR_STATUS_CERTIFICATE_EXPIRED                  COREWEBVIEW2_WEB_ERROR_STATUS = 2
+	COREWEBVIEW2_WEB_ERROR_STATUS_CLIENT_CERTIFICATE_CONTAINS_ERRORS   COREWEBVIEW2_WEB_ERROR_STATUS = 3
+	COREWEBVIEW2_WEB_ERROR_STATUS_CERTIFICATE_REVOKED                  COREWEBVIEW2_WEB_ERROR_STATUS = 4
+	COREWEBVIEW2_WEB_ERROR_STATUS_CERTIFICATE_IS_INVALID               COREWEBVIEW2_WEB_ERROR_STATUS = 5
+	COREWEBVIEW2_WEB_ERROR_STATUS_SERVER_UNREACHABLE                   COREWEBVIEW2_WEB_ERROR_STATUS = 6
+	COREWEBVIEW2_WEB_ERROR_STATUS_TIMEOUT                              COREWEBVIEW2_WEB_ERROR_STATUS = 7
+	COREWEBVIEW2_WEB_ERROR_STATUS_ERROR_HTTP_INVALID_SERVER_RESPONSE   COREWEBVIEW2_WEB_ERROR_STATUS = 8
+	COREWEBVIEW2_WEB_ERROR_STATUS_CONNECTION_ABORTED                   COREWEBVIEW2_WEB_ERROR_STATUS = 9
+	COREWEBVIEW2_WEB_ERROR_STATUS_CONNECTION_RESET                     COREWEBVIEW2_WEB_ERROR_STATUS = 10
+	COREWEBVIEW2_WEB_ERROR_STATUS_DISCONNECTED                         COREWEBVIEW2_WEB_ERROR_STATUS = 11
+	COREWEBVIEW2_WEB_ERROR_STATUS_CANNOT_CONNECT                       COREWEBVIEW2_WEB_ERROR_STATUS = 12
+	COREWEBVIEW2_WEB_ERROR_STATUS_HOST_NAME_NOT_RESOLVED               COREWEBVIEW2_WEB_ERROR_STATUS = 13
+	COREWEBVIEW2_WEB_ERROR_STATUS_OPERATION_CANCELED                   COREWEBVIEW2_WEB_ERROR_STATUS = 14
+	COREWEBVIEW2_WEB_ERROR_STATUS_REDIRECT_FAILED                      COREWEBVIEW2_WEB_ERROR_STATUS = 15
+	COREWEBVIEW2_WEB_ERROR_STATUS_UNEXPECTED_ERROR                     COREWEBVIEW2_WEB_ERROR_STATUS = 16
+)
+
+// COREWEBVIEW2_WEB_RESOURCE_CONTEXT implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_web_resource_context
+type COREWEBVIEW2_WEB_RESOURCE_CONTEXT int32
+
+const (
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_ALL                  COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 0
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_DOCUMENT             COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 1
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_STYLESHEET           COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 2
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_IMAGE                COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 3
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_MEDIA                COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 4
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_FONT                 COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 5
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_SCRIPT               COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 6
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_XML_HTTP_REQUEST     COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 7
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_FETCH                COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 8
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_TEXT_TRACK           COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 9
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_EVENT_SOURCE         COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 10
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_WEBSOCKET            COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 11
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_MANIFEST             COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 12
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_SIGNED_EXCHANGE      COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 13
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_PING                 COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 14
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_CSP_VIOLATION_REPORT COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 15
+	COREWEBVIEW2_WEB_RESOURCE_CONTEXT_OTHER                COREWEBVIEW2_WEB_RESOURCE_CONTEXT = 16
+)
+
+// COREWEBVIEW2_MOVE_FOCUS_REASON implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_move_focus_reason
+type COREWEBVIEW2_MOVE_FOCUS_REASON int32
+
+const (
+	COREWEBVIEW2_MOVE_FOCUS_REASON_PROGRAMMATIC COREWEBVIEW2_MOVE_FOCUS_REASON = 0
+	COREWEBVIEW2_MOVE_FOCUS_REASON_NEXT         COREWEBVIEW2_MOVE_FOCUS_REASON = 1
+	COREWEBVIEW2_MOVE_FOCUS_REASON_PREVIOUS     COREWEBVIEW2_MOVE_FOCUS_REASON = 2
+)
+
+// COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_host_resource_access_kind
+type COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND int32
+
+const (
+	COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND_DENY      COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND = 0
+	COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND_ALLOW     COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND = 1
+	COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND_DENY_CORS COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND = 2
+)
+
+// COREWEBVIEW2_COOKIE_SAME_SITE_KIND implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_cookie_same_site_kind
+type COREWEBVIEW2_COOKIE_SAME_SITE_KIND int32
+
+const (
+	COREWEBVIEW2_COOKIE_SAME_SITE_KIND_NONE   COREWEBVIEW2_COOKIE_SAME_SITE_KIND = 0
+	COREWEBVIEW2_COOKIE_SAME_SITE_KIND_LAX    COREWEBVIEW2_COOKIE_SAME_SITE_KIND = 1
+	COREWEBVIEW2_COOKIE_SAME_SITE_KIND_STRICT COREWEBVIEW2_COOKIE_SAME_SITE_KIND = 2
+)
+
+// COREWEBVIEW2_SCRIPT_DIALOG_KIND implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_script_dialog_kind
+type COREWEBVIEW2_SCRIPT_DIALOG_KIND int32
+
+const (
+	COREWEBVIEW2_SCRIPT_DIALOG_KIND_ALERT        COREWEBVIEW2_SCRIPT_DIALOG_KIND = 0
+	COREWEBVIEW2_SCRIPT_DIALOG_KIND_CONFIRM      COREWEBVIEW2_SCRIPT_DIALOG_KIND = 1
+	COREWEBVIEW2_SCRIPT_DIALOG_KIND_PROMPT       COREWEBVIEW2_SCRIPT_DIALOG_KIND = 2
+	COREWEBVIEW2_SCRIPT_DIALOG_KIND_BEFOREUNLOAD COREWEBVIEW2_SCRIPT_DIALOG_KIND = 3
+)
+
+// COREWEBVIEW2_PROCESS_FAILED_KIND implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_process_failed_kind
+type COREWEBVIEW2_PROCESS_FAILED_KIND int32
+
+const (
+	COREWEBVIEW2_PROCESS_FAILED_KIND_BROWSER_PROCESS_EXITED      COREWEBVIEW2_PROCESS_FAILED_KIND = 0
+	COREWEBVIEW2_PROCESS_FAILED_KIND_RENDER_PROCESS_EXITED       COREWEBVIEW2_PROCESS_FAILED_KIND = 1
+	COREWEBVIEW2_PROCESS_FAILED_KIND_RENDER_PROCESS_UNRESPONSIVE COREWEBVIEW2_PROCESS_FAILED_KIND = 2
+)
+
+// COREWEBVIEW2_PERMISSION_KIND implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_permission_kind
+type COREWEBVIEW2_PERMISSION_KIND int32
+
+const (
+	COREWEBVIEW2_PERMISSION_KIND_UNKNOWN_PERMISSION COREWEBVIEW2_PERMISSION_KIND = 0
+	COREWEBVIEW2_PERMISSION_KIND_MICROPHONE         COREWEBVIEW2_PERMISSION_KIND = 1
+	COREWEBVIEW2_PERMISSION_KIND_CAMERA             COREWEBVIEW2_PERMISSION_KIND = 2
+	COREWEBVIEW2_PERMISSION_KIND_GEOLOCATION        COREWEBVIEW2_PERMISSION_KIND = 3
+	COREWEBVIEW2_PERMISSION_KIND_NOTIFICATIONS      COREWEBVIEW2_PERMISSION_KIND = 4
+	COREWEBVIEW2_PERMISSION_KIND_OTHER_SENSORS      COREWEBVIEW2_PERMISSION_KIND = 5
+	COREWEBVIEW2_PERMISSION_KIND_CLIPBOARD_READ     COREWEBVIEW2_PERMISSION_KIND = 6
+)
+
+// COREWEBVIEW2_PERMISSION_STATE implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_permission_state
+type COREWEBVIEW2_PERMISSION_STATE int32
+
+const (
+	COREWEBVIEW2_PERMISSION_STATE_DEFAULT COREWEBVIEW2_PERMISSION_STATE = 0
+	COREWEBVIEW2_PERMISSION_STATE_ALLOW   COREWEBVIEW2_PERMISSION_STATE = 1
+	COREWEBVIEW2_PERMISSION_STATE_DENY    COREWEBVIEW2_PERMISSION_STATE = 2
+)
+
+// COREWEBVIEW2_KEY_EVENT_KIND implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_key_event_kind
+type COREWEBVIEW2_KEY_EVENT_KIND int32
+
+const (
+	COREWEBVIEW2_KEY_EVENT_KIND_KEY_DOWN        COREWEBVIEW2_KEY_EVENT_KIND = 0
+	COREWEBVIEW2_KEY_EVENT_KIND_KEY_UP          COREWEBVIEW2_KEY_EVENT_KIND = 1
+	COREWEBVIEW2_KEY_EVENT_KIND_SYSTEM_KEY_DOWN COREWEBVIEW2_KEY_EVENT_KIND = 2
+	COREWEBVIEW2_KEY_EVENT_KIND_SYSTEM_KEY_UP   COREWEBVIEW2_KEY_EVENT_KIND = 3
+)
+
+// COREWEBVIEW2_BOUNDS_MODE implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_bounds_mode
+type COREWEBVIEW2_BOUNDS_MODE int32
+
+const (
+	COREWEBVIEW2_BOUNDS_MODE_USE_RAW_PIXELS          COREWEBVIEW2_BOUNDS_MODE = 0
+	COREWEBVIEW2_BOUNDS_MODE_USE_RASTERIZATION_SCALE COREWEBVIEW2_BOUNDS_MODE = 1
+)
+
+// COREWEBVIEW2_MOUSE_EVENT_KIND implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_mouse_event_kind
+type COREWEBVIEW2_MOUSE_EVENT_KIND int32
+
+const (
+	COREWEBVIEW2_MOUSE_EVENT_KIND_HORIZONTAL_WHEEL           COREWEBVIEW2_MOUSE_EVENT_KIND = 526
+	COREWEBVIEW2_MOUSE_EVENT_KIND_LEFT_BUTTON_DOUBLE_CLICK   COREWEBVIEW2_MOUSE_EVENT_KIND = 515
+	COREWEBVIEW2_MOUSE_EVENT_KIND_LEFT_BUTTON_DOWN           COREWEBVIEW2_MOUSE_EVENT_KIND = 513
+	COREWEBVIEW2_MOUSE_EVENT_KIND_LEFT_BUTTON_UP             COREWEBVIEW2_MOUSE_EVENT_KIND = 514
+	COREWEBVIEW2_MOUSE_EVENT_KIND_LEAVE                      COREWEBVIEW2_MOUSE_EVENT_KIND = 675
+	COREWEBVIEW2_MOUSE_EVENT_KIND_MIDDLE_BUTTON_DOUBLE_CLICK COREWEBVIEW2_MOUSE_EVENT_KIND = 521
+	COREWEBVIEW2_MOUSE_EVENT_KIND_MIDDLE_BUTTON_DOWN         COREWEBVIEW2_MOUSE_EVENT_KIND = 519
+	COREWEBVIEW2_MOUSE_EVENT_KIND_MIDDLE_BUTTON_UP           COREWEBVIEW2_MOUSE_EVENT_KIND = 520
+	COREWEBVIEW2_MOUSE_EVENT_KIND_MOVE                       COREWEBVIEW2_MOUSE_EVENT_KIND = 512
+	COREWEBVIEW2_MOUSE_EVENT_KIND_RIGHT_BUTTON_DOUBLE_CLICK  COREWEBVIEW2_MOUSE_EVENT_KIND = 518
+	COREWEBVIEW2_MOUSE_EVENT_KIND_RIGHT_BUTTON_DOWN          COREWEBVIEW2_MOUSE_EVENT_KIND = 516
+	COREWEBVIEW2_MOUSE_EVENT_KIND_RIGHT_BUTTON_UP            COREWEBVIEW2_MOUSE_EVENT_KIND = 517
+	COREWEBVIEW2_MOUSE_EVENT_KIND_WHEEL                      COREWEBVIEW2_MOUSE_EVENT_KIND = 522
+	COREWEBVIEW2_MOUSE_EVENT_KIND_X_BUTTON_DOUBLE_CLICK      COREWEBVIEW2_MOUSE_EVENT_KIND = 525
+	COREWEBVIEW2_MOUSE_EVENT_KIND_X_BUTTON_DOWN              COREWEBVIEW2_MOUSE_EVENT_KIND = 523
+	COREWEBVIEW2_MOUSE_EVENT_KIND_X_BUTTON_UP                COREWEBVIEW2_MOUSE_EVENT_KIND = 524
+)
+
+// COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_mouse_event_virtual_keys
+type COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS int32
+
+const (
+	COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS_NONE          COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS = 0
+	COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS_LEFT_BUTTON   COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS = 1
+	COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS_RIGHT_BUTTON  COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS = 2
+	COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS_SHIFT         COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS = 4
+	COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS_CONTROL       COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS = 8
+	COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS_MIDDLE_BUTTON COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS = 16
+	COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS_X_BUTTON1     COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS = 32
+	COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS_X_BUTTON2     COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS = 64
+)
+
+// COREWEBVIEW2_POINTER_EVENT_KIND implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_pointer_event_kind
+type COREWEBVIEW2_POINTER_EVENT_KIND int32
+
+const (
+	COREWEBVIEW2_POINTER_EVENT_KIND_ACTIVATE COREWEBVIEW2_POINTER_EVENT_KIND = 587
+	COREWEBVIEW2_POINTER_EVENT_KIND_DOWN     COREWEBVIEW2_POINTER_EVENT_KIND = 582
+	COREWEBVIEW2_POINTER_EVENT_KIND_ENTER    COREWEBVIEW2_POINTER_EVENT_KIND = 585
+	COREWEBVIEW2_POINTER_EVENT_KIND_LEAVE    COREWEBVIEW2_POINTER_EVENT_KIND = 586
+	COREWEBVIEW2_POINTER_EVENT_KIND_UP       COREWEBVIEW2_POINTER_EVENT_KIND = 583
+	COREWEBVIEW2_POINTER_EVENT_KIND_UPDATE   COREWEBVIEW2_POINTER_EVENT_KIND = 581
+)
+
+// COREWEBVIEW2_PHYSICAL_KEY_STATUS implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_physical_key_status
+type COREWEBVIEW2_PHYSICAL_KEY_STATUS struct {
+	RepeatCount   uint32
+	ScanCode      uint32
+	IsExtendedKey int32
+	IsMenuKeyDown int32
+	WasKeyDown    int32
+	IsKeyReleased int32
+}
+
+// COREWEBVIEW2_COLOR implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_color
+type COREWEBVIEW2_COLOR struct {
+	A uint8
+	R uint8
+	G uint8
+	B uint8
+}
+
+var (
+	// IID_ICoreWebView2 is the interface ID of ICoreWebView2.
+	IID_ICoreWebView2 = windows.GUID{Data1: 0x76ECEACB, Data2: 0x0462, Data3: 0x4D94, Data4: [8]byte{0xAC, 0x83, 0x42, 0x3A, 0x67, 0x93, 0x77, 0x5E}}
+	// IID_ICoreWebView2_2 is the interface ID of ICoreWebView2_2.
+	IID_ICoreWebView2_2 = windows.GUID{Data1: 0x9E8F0CF8, Data2: 0xE670, Data3: 0x4B5E, Data4: [8]byte{0xB2, 0xBC, 0x73, 0xE0, 0x61, 0xE3, 0x18, 0x4C}}
+	// IID_ICoreWebView2_3 is the interface ID of ICoreWebView2_3.
+	IID_ICoreWebView2_3 = windows.GUID{Data1: 0xA0D6DF20, Data2: 0x3B92, Data3: 0x416D, Data4: [8]byte{0xAA, 0x0C, 0x43, 0x7A, 0x9C, 0x72, 0x78, 0x57}}
+	// IID_ICoreWebView2Controller is the interface ID of ICoreWebView2Controller.
+	IID_ICoreWebView2Controller = windows.GUID{Data1: 0x4D00C0D1, Data2: 0x9434, Data3: 0x4EB6, Data4: [8]byte{0x80, 0x78, 0x86, 0x97, 0xA5, 0x60, 0x33, 0x4F}}
+	// IID_ICoreWebView2Settings is the interface ID of ICoreWebView2Settings.
+	IID_ICoreWebView2Settings = windows.GUID{Data1: 0xE562E4F0, Data2: 0xD7FA, Data3: 0x43AC, Data4: [8]byte{0x8D, 0x71, 0xC0, 0x51, 0x50, 0x49, 0x9F, 0x00}}
+	// IID_ICoreWebView2Environment is the interface ID of ICoreWebView2Environment.
+	IID_ICoreWebView2Environment = windows.GUID{Data1: 0xB96D755E, Data2: 0x0319, Data3: 0x4E92, Data4: [8]byte{0xA2, 0x96, 0x23, 0x43, 0x6F, 0x46, 0xA1, 0xFC}}
+	// IID_ICoreWebView2EnvironmentOptions is the interface ID of ICoreWebView2EnvironmentOptions.
+	IID_ICoreWebView2EnvironmentOptions = windows.GUID{Data1: 0x2FDE08A8, Data2: 0x1E9A, Data3: 0x4766, Data4: [8]byte{0x8C, 0x05, 0x95, 0xA9, 0xCE, 0xB9, 0xD1, 0xC5}}
+	// IID_ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler is the interface ID of ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler.
+	IID_ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler = windows.GUID{Data1: 0x4E8A3389, Data2: 0xC9D8, Data3: 0x4BD2, Data4: [8]byte{0xB6, 0xB5, 0x12, 0x4F, 0xEE, 0x6C, 0xC1, 0x4D}}
+	// IID_ICoreWebView2CreateCoreWebView2ControllerCompletedHandler is the interface ID of ICoreWebView2CreateCoreWebView2ControllerCompletedHandler.
+	IID_ICoreWebView2CreateCoreWebView2ControllerCompletedHandler = windows.GUID{Data1: 0x6C4819F3, Data2: 0xC9B7, Data3: 0x4260, Data4: [8]byte{0x81, 0x27, 0xC9, 0xF5, 0xBD, 0xE7, 0xF6, 0x8C}}
+	// IID_ICoreWebView2ExecuteScriptCompletedHandler is the interface ID of ICoreWebView2ExecuteScriptCompletedHandler.
+	IID_ICoreWebView2ExecuteScriptCompletedHandler = windows.GUID{Data1: 0x49511172, Data2: 0xCC67, Data3: 0x4BCA, Data4: [8]byte{0x99, 0x23, 0x13, 0x71, 0x12, 0xF4, 0xC4, 0xCC}}
+	// IID_ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler is the interface ID of ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler.
+	IID_ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler = windows.GUID{Data1: 0xB99369F3, Data2: 0x9B11, Data3: 0x47B5, Data4: [8]byte{0xBC, 0x6F, 0x8E, 0x78, 0x95, 0xFC, 0xEA, 0x17}}
+	// IID_ICoreWebView2WebMessageReceivedEventHandler is the interface ID of ICoreWebView2WebMessageReceivedEventHandler.
+	IID_ICoreWebView2WebMessageReceivedEventHandler = windows.GUID{Data1: 0x57213F19, Data2: 0x00E6, Data3: 0x49FA, Data4: [8]byte{0x8E, 0x07, 0x89, 0x8E, 0xA0, 0x1E, 0xCB, 0xD2}}
+	// IID_ICoreWebView2WebMessageReceivedEventArgs is the interface ID of ICoreWebView2WebMessageReceivedEventArgs.
+	IID_ICoreWebView2WebMessageReceivedEventArgs = windows.GUID{Data1: 0x0F99A40C, Data2: 0xE962, Data3: 0x4207, Data4: [8]byte{0x9E, 0x92, 0xE3, 0xD5, 0x42, 0xEF, 0xF8, 0x49}}
+	// IID_ICoreWebView2NavigationStartingEventHandler is the interface ID of ICoreWebView2NavigationStartingEventHandler.
+	IID_ICoreWebView2NavigationStartingEventHandler = windows.GUID{Data1: 0x9ADBE429, Data2: 0xF36D, Data3: 0x432B, Data4: [8]byte{0x9D, 0xDC, 0xF8, 0x88, 0x1F, 0xBD, 0x76, 0xE3}}
+	// IID_ICoreWebView2NavigationStartingEventArgs is the interface ID of ICoreWebView2NavigationStartingEventArgs.
+	IID_ICoreWebView2NavigationStartingEventArgs = windows.GUID{Data1: 0x5B495469, Data2: 0xE119, Data3: 0x438A, Data4: [8]byte{0x9B, 0x18, 0x76, 0x04, 0xF2, 0x5F, 0x2E, 0x49}}
+	// IID_ICoreWebView2ContentLoadingEventHandler is the interface ID of ICoreWebView2ContentLoadingEventHandler.
+	IID_ICoreWebView2ContentLoadingEventHandler = windows.GUID{Data1: 0x364471E7, Data2: 0xF2BE, Data3: 0x4910, Data4: [8]byte{0xBD, 0xBA, 0xD7, 0x20, 0x77, 0xD5, 0x1C, 0x4B}}
+	// IID_ICoreWebView2ContentLoadingEventArgs is the interface ID of ICoreWebView2ContentLoadingEventArgs.
+	IID_ICoreWebView2ContentLoadingEventArgs = windows.GUID{Data1: 0x0C8A1275, Data2: 0x9B6B, Data3: 0x4901, Data4: [8]byte{0x87, 0xAD, 0x70, 0xDF, 0x25, 0xBA, 0xFA, 0x6E}}
+	// IID_ICoreWebView2NavigationCompletedEventHandler is the interface ID of ICoreWebView2NavigationCompletedEventHandler.
+	IID_ICoreWebView2NavigationCompletedEventHandler = windows.GUID{Data1: 0xD33A35BF, Data2: 0x1C49, Data3: 0x4F98, Data4: [8]byte{0x93, 0xAB, 0x00, 0x6E, 0x05, 0x33, 0xFE, 0x1C}}
+	// IID_ICoreWebView2NavigationCompletedEventArgs is the interface ID of ICoreWebView2NavigationCompletedEventArgs.
+	IID_ICoreWebView2NavigationCompletedEventArgs = windows.GUID{Data1: 0x30D68B7D, Data2: 0x20D9, Data3: 0x4752, Data4: [8]byte{0xA9, 0xCA, 0xEC, 0x84, 0x48, 0xFB, 0xB5, 0xC1}}
+	// IID_ICoreWebView2WebResourceRequestedEventHandler is the interface ID of ICoreWebView2WebResourceRequestedEventHandler.
+	IID_ICoreWebView2WebResourceRequestedEventHandler = windows.GUID{Data1: 0xAB00B74C, Data2: 0x15F1, Data3: 0x4646, Data4: [8]byte{0x80, 0xE8, 0xE7, 0x63, 0x41, 0xD2, 0x5D, 0x71}}
+	// IID_ICoreWebView2WebResourceRequestedEventArgs is the interface ID of ICoreWebView2WebResourceRequestedEventArgs.
+	IID_ICoreWebView2WebResourceRequestedEventArgs = windows.GUID{Data1: 0x453E667F, Data2: 0x12C7, Data3: 0x49D4, Data4: [8]byte{0xBE, 0x6D, 0xDD, 0xBE, 0x79, 0x56, 0xF5, 0x7A}}
+	// IID_ICoreWebView2WebResourceRequest is the interface ID of ICoreWebView2WebResourceRequest.
+	IID_ICoreWebView2WebResourceRequest = windows.GUID{Data1: 0x97055CD4, Data2: 0x512C, Data3: 0x4264, Data4: [8]byte{0x8B, 0x5F, 0xE3, 0xF4, 0x46, 0xCE, 0xA6, 0xA5}}
+	// IID_ICoreWebView2WebResourceResponse is the interface ID of ICoreWebView2WebResourceResponse.
+	IID_ICoreWebView2WebResourceResponse = windows.GUID{Data1: 0xAAFCC94F, Data2: 0xFA27, Data3: 0x48FD, Data4: [8]byte{0x97, 0xDF, 0x83, 0x0E, 0xF7, 0x5A, 0xAE, 0xC9}}
+	// IID_ICoreWebView2HttpRequestHeaders is the interface ID of ICoreWebView2HttpRequestHeaders.
+	IID_ICoreWebView2HttpRequestHeaders = windows.GUID{Data1: 0xE86CAC0E, Data2: 0x5523, Data3: 0x465C, Data4: [8]byte{0xB5, 0x36, 0x8F, 0xB9, 0xFC, 0x8C, 0x8C, 0x60}}
+	// IID_ICoreWebView2HttpResponseHeaders is the interface ID of ICoreWebView2HttpResponseHeaders.
+	IID_ICoreWebView2HttpResponseHeaders = windows.GUID{Data1: 0x03C5FF5A, Data2: 0x9B45, Data3: 0x4A88, Data4: [8]byte{0x88, 0x1C, 0x89, 0xA9, 0xF3, 0x28, 0x61, 0x9C}}
+	// IID_ICoreWebView2HttpHeadersCollectionIterator is the interface ID of ICoreWebView2HttpHeadersCollectionIterator.
+	IID_ICoreWebView2HttpHeadersCollectionIterator = windows.GUID{Data1: 0x0702FC30, Data2: 0xF43B, Data3: 0x47BB, Data4: [8]byte{0xAB, 0x52, 0xA4, 0x2C, 0xB5, 0x52, 0xAD, 0x9F}}
+	// IID_ICoreWebView2Deferral is the interface ID of ICoreWebView2Deferral.
+	IID_ICoreWebView2Deferral = windows.GUID{Data1: 0xC10E7F7B, Data2: 0xB585, Data3: 0x46F0, Data4: [8]byte{0xA6, 0x23, 0x8B, 0xEF, 0xBF, 0x3E, 0x4E, 0xE0}}
+	// IID_ICoreWebView2AcceleratorKeyPressedEventArgs is the interface ID of ICoreWebView2AcceleratorKeyPressedEventArgs.
+	IID_ICoreWebView2AcceleratorKeyPressedEventArgs = windows.GUID{Data1: 0x9F760F8A, Data2: 0xFB79, Data3: 0x42BE, Data4: [8]byte{0x99, 0x90, 0x7B, 0x56, 0x90, 0x0F, 0xA9, 0xC7}}
+	// IID_ICoreWebView2AcceleratorKeyPressedEventHandler is the interface ID of ICoreWebView2AcceleratorKeyPressedEventHandler.
+	IID_ICoreWebView2AcceleratorKeyPressedEventHandler = windows.GUID{Data1: 0xB29C7E28, Data2: 0xFA79, Data3: 0x41A8, Data4: [8]byte{0x8E, 0x44, 0x65, 0x81, 0x1C, 0x76, 0xDC, 0xB2}}
+	// IID_ICoreWebView2CallDevToolsProtocolMethodCompletedHandler is the interface ID of ICoreWebView2CallDevToolsProtocolMethodCompletedHandler.
+	IID_ICoreWebView2CallDevToolsProtocolMethodCompletedHandler = windows.GUID{Data1: 0x5C4889F0, Data2: 0x5EF6, Data3: 0x4C5A, Data4: [8]byte{0x95, 0x2C, 0xD8, 0xF1, 0xB9, 0x2D, 0x05, 0x74}}
+	// IID_ICoreWebView2CapturePreviewCompletedHandler is the interface ID of ICoreWebView2CapturePreviewCompletedHandler.
+	IID_ICoreWebView2CapturePreviewCompletedHandler = windows.GUID{Data1: 0x697E05E9, Data2: 0x3D8F, Data3: 0x45FA, Data4: [8]byte{0x96, 0xF4, 0x8F, 0xFE, 0x1E, 0xDE, 0xDA, 0xF5}}
+	// IID_ICoreWebView2ContainsFullScreenElementChangedEventHandler is the interface ID of ICoreWebView2ContainsFullScreenElementChangedEventHandler.
+	IID_ICoreWebView2ContainsFullScreenElementChangedEventHandler = windows.GUID{Data1: 0xE45D98B1, Data2: 0xAFEF, Data3: 0x45BE, Data4: [8]byte{0x8B, 0xAF, 0x6C, 0x77, 0x28, 0x86, 0x7F, 0x73}}
+	// IID_ICoreWebView2Cookie is the interface ID of ICoreWebView2Cookie.
+	IID_ICoreWebView2Cookie = windows.GUID{Data1: 0xAD26D6BE, Data2: 0x1486, Data3: 0x43E6, Data4: [8]byte{0xBF, 0x87, 0xA2, 0x03, 0x40, 0x06, 0xCA, 0x21}}
+	// IID_ICoreWebView2CookieList is the interface ID of ICoreWebView2CookieList.
+	IID_ICoreWebView2CookieList = windows.GUID{Data1: 0xF7F6F714, Data2: 0x5D2A, Data3: 0x43C6, Data4: [8]byte{0x95, 0x03, 0x34, 0x6E, 0xCE, 0x02, 0xD1, 0x86}}
+	// IID_ICoreWebView2CookieManager is the interface ID of ICoreWebView2CookieManager.
+	IID_ICoreWebView2CookieManager = windows.GUID{Data1: 0x177CD9E7, Data2: 0xB6F5, Data3: 0x451A, Data4: [8]byte{0x94, 0xA0, 0x5D, 0x7A, 0x3A, 0x4C, 0x41, 0x41}}
+	// IID_ICoreWebView2GetCookiesCompletedHandler is the interface ID of ICoreWebView2GetCookiesCompletedHandler.
+	IID_ICoreWebView2GetCookiesCompletedHandler = windows.GUID{Data1: 0x5A4F5069, Data2: 0x5C15, Data3: 0x47C3, Data4: [8]byte{0x86, 0x46, 0xF4, 0xDE, 0x1C, 0x11, 0x66, 0x70}}
+	// IID_ICoreWebView2DevToolsProtocolEventReceivedEventArgs is the interface ID of ICoreWebView2DevToolsProtocolEventReceivedEventArgs.
+	IID_ICoreWebView2DevToolsProtocolEventReceivedEventArgs = windows.GUID{Data1: 0x653C2959, Data2: 0xBB3A, Data3: 0x4377, Data4: [8]byte{0x86, 0x32, 0xB5, 0x8A, 0xDA, 0x4E, 0x66, 0xC4}}
+	// IID_ICoreWebView2DevToolsProtocolEventReceivedEventHandler is the interface ID of ICoreWebView2DevToolsProtocolEventReceivedEventHandler.
+	IID_ICoreWebView2DevToolsProtocolEventReceivedEventHandler = windows.GUID{Data1: 0xE2FDA4BE, Data2: 0x5456, Data3: 0x406C, Data4: [8]byte{0xA2, 0x61, 0x3D, 0x45, 0x21, 0x38, 0x36, 0x2C}}
+	// IID_ICoreWebView2DevToolsProtocolEventReceiver is the interface ID of ICoreWebView2DevToolsProtocolEventReceiver.
+	IID_ICoreWebView2DevToolsProtocolEventReceiver = windows.GUID{Data1: 0xB32CA51A, Data2: 0x8371, Data3: 0x45E9, Data4: [8]byte{0x93, 0x17, 0xAF, 0x02, 0x1D, 0x08, 0x03, 0x67}}
+	// IID_ICoreWebView2DocumentTitleChangedEventHandler is the interface ID of ICoreWebView2DocumentTitleChangedEventHandler.
+	IID_ICoreWebView2DocumentTitleChangedEventHandler = windows.GUID{Data1: 0xF5F2B923, Data2: 0x953E, Data3: 0x4042, Data4: [8]byte{0x9F, 0x95, 0xF3, 0xA1, 0x18, 0xE1, 0xAF, 0xD4}}
+	// IID_ICoreWebView2DOMContentLoadedEventArgs is the interface ID of ICoreWebView2DOMContentLoadedEventArgs.
+	IID_ICoreWebView2DOMContentLoadedEventArgs = windows.GUID{Data1: 0x16B1E21A, Data2: 0xC503, Data3: 0x44F2, Data4: [8]byte{0x84, 0xC9, 0x70, 0xAB, 0xA5, 0x03, 0x12, 0x83}}
+	// IID_ICoreWebView2DOMContentLoadedEventHandler is the interface ID of ICoreWebView2DOMContentLoadedEventHandler.
+	IID_ICoreWebView2DOMContentLoadedEventHandler = windows.GUID{Data1: 0x4BAC7E9C, Data2: 0x199E, Data3: 0x49ED, Data4: [8]byte{0x87, 0xED, 0x24, 0x93, 0x03, 0xAC, 0xF0, 0x19}}
+	// IID_ICoreWebView2Environment2 is the interface ID of ICoreWebView2Environment2.
+	IID_ICoreWebView2Environment2 = windows.GUID{Data1: 0x41F3632B, Data2: 0x5EF4, Data3: 0x404F, Data4: [8]byte{0xAD, 0x82, 0x2D, 0x60, 0x6C, 0x5A, 0x9A, 0x21}}
+	// IID_ICoreWebView2FocusChangedEventHandler is the interface ID of ICoreWebView2FocusChangedEventHandler.
+	IID_ICoreWebView2FocusChangedEventHandler = windows.GUID{Data1: 0x05EA24BD, Data2: 0x6452, Data3: 0x4926, Data4: [8]byte{0x90, 0x14, 0x4B, 0x82, 0xB4, 0x98, 0x13, 0x5D}}
+	// IID_ICoreWebView2HistoryChangedEventHandler is the interface ID of ICoreWebView2HistoryChangedEventHandler.
+	IID_ICoreWebView2HistoryChangedEventHandler = windows.GUID{Data1: 0xC79A420C, Data2: 0xEFD9, Data3: 0x4058, Data4: [8]byte{0x92, 0x95, 0x3E, 0x8B, 0x4B, 0xCA, 0xB6, 0x45}}
+	// IID_ICoreWebView2MoveFocusRequestedEventArgs is the interface ID of ICoreWebView2MoveFocusRequestedEventArgs.
+	IID_ICoreWebView2MoveFocusRequestedEventArgs = windows.GUID{Data1: 0x2D6AA13B, Data2: 0x3839, Data3: 0x4A15, Data4: [8]byte{0x92, 0xFC, 0xD8, 0x8B, 0x3C, 0x0D, 0x9C, 0x9D}}
+	// IID_ICoreWebView2MoveFocusRequestedEventHandler is the interface ID of ICoreWebView2MoveFocusRequestedEventHandler.
+	IID_ICoreWebView2MoveFocusRequestedEventHandler = windows.GUID{Data1: 0x69035451, Data2: 0x6DC7, Data3: 0x4CB8, Data4: [8]byte{0x9B, 0xCE, 0xB2, 0xBD, 0x70, 0xAD, 0x28, 0x9F}}
+	// IID_ICoreWebView2NewBrowserVersionAvailableEventHandler is the interface ID of ICoreWebView2NewBrowserVersionAvailableEventHandler.
+	IID_ICoreWebView2NewBrowserVersionAvailableEventHandler = windows.GUID{Data1: 0xF9A2976E, Data2: 0xD34E, Data3: 0x44FC, Data4: [8]byte{0xAD, 0xEE, 0x81, 0xB6, 0xB5, 0x7C, 0xA9, 0x14}}
+	// IID_ICoreWebView2NewWindowRequestedEventArgs is the interface ID of ICoreWebView2NewWindowRequestedEventArgs.
+	IID_ICoreWebView2NewWindowRequestedEventArgs = windows.GUID{Data1: 0x34ACB11C, Data2: 0xFC37, Data3: 0x4418, Data4: [8]byte{0x91, 0x32, 0xF9, 0xC2, 0x1D, 0x1E, 0xAF, 0xB9}}
+	// IID_ICoreWebView2NewWindowRequestedEventHandler is the interface ID of ICoreWebView2NewWindowRequestedEventHandler.
+	IID_ICoreWebView2NewWindowRequestedEventHandler = windows.GUID{Data1: 0xD4C185FE, Data2: 0xC81C, Data3: 0x4989, Data4: [8]byte{0x97, 0xAF, 0x2D, 0x3F, 0xA7, 0xAB, 0x56, 0x51}}
+	// IID_ICoreWebView2PermissionRequestedEventArgs is the interface ID of ICoreWebView2PermissionRequestedEventArgs.
+	IID_ICoreWebView2PermissionRequestedEventArgs = windows.GUID{Data1: 0x973AE2EF, Data2: 0xFF18, Data3: 0x4894, Data4: [8]byte{0x8F, 0xB2, 0x3C, 0x75, 0x8F, 0x04, 0x68, 0x10}}
+	// IID_ICoreWebView2PermissionRequestedEventHandler is the interface ID of ICoreWebView2PermissionRequestedEventHandler.
+	IID_ICoreWebView2PermissionRequestedEventHandler = windows.GUID{Data1: 0x15E1C6A3, Data2: 0xC72A, Data3: 0x4DF3, Data4: [8]byte{0x91, 0xD7, 0xD0, 0x97, 0xFB, 0xEC, 0x6B, 0xFD}}
+	// IID_ICoreWebView2ProcessFailedEventArgs is the interface ID of ICoreWebView2ProcessFailedEventArgs.
+	IID_ICoreWebView2ProcessFailedEventArgs = windows.GUID{Data1: 0x8155A9A4, Data2: 0x1474, Data3: 0x4A86, Data4: [8]byte{0x8C, 0xAE, 0x15, 0x1B, 0x0F, 0xA6, 0xB8, 0xCA}}
+	// IID_ICoreWebView2ProcessFailedEventHandler is the interface ID of ICoreWebView2ProcessFailedEventHandler.
+	IID_ICoreWebView2ProcessFailedEventHandler = windows.GUID{Data1: 0x79E0AEA4, Data2: 0x990B, Data3: 0x42D9, Data4: [8]byte{0xAA, 0x1D, 0x0F, 0xCC, 0x2E, 0x5B, 0xC7, 0xF1}}
+	// IID_ICoreWebView2ScriptDialogOpeningEventArgs is the interface ID of ICoreWebView2ScriptDialogOpeningEventArgs.
+	IID_ICoreWebView2ScriptDialogOpeningEventArgs = windows.GUID{Data1: 0x7390BB70, Data2: 0xABE0, Data3: 0x4843, Data4: [8]byte{0x95, 0x29, 0xF1, 0x43, 0xB3, 0x1B, 0x03, 0xD6}}
+	// IID_ICoreWebView2ScriptDialogOpeningEventHandler is the interface ID of ICoreWebView2ScriptDialogOpeningEventHandler.
+	IID_ICoreWebView2ScriptDialogOpeningEventHandler = windows.GUID{Data1: 0xEF381BF9, Data2: 0xAFA8, Data3: 0x4E37, Data4: [8]byte{0x91, 0xC4, 0x8A, 0xC4, 0x85, 0x24, 0xBD, 0xFB}}
+	// IID_ICoreWebView2SourceChangedEventArgs is the interface ID of ICoreWebView2SourceChangedEventArgs.
+	IID_ICoreWebView2SourceChangedEventArgs = windows.GUID{Data1: 0x31E0E545, Data2: 0x1DBA, Data3: 0x4266, Data4: [8]byte{0x89, 0x14, 0xF6, 0x38, 0x48, 0xA1, 0xF7, 0xD7}}
+	// IID_ICoreWebView2SourceChangedEventHandler is the interface ID of ICoreWebView2SourceChangedEventHandler.
+	IID_ICoreWebView2SourceChangedEventHandler = windows.GUID{Data1: 0x3C067F9F, Data2: 0x5388, Data3: 0x4772, Data4: [8]byte{0x8B, 0x48, 0x79, 0xF7, 0xEF, 0x1A, 0xB3, 0x7C}}
+	// IID_ICoreWebView2WebResourceResponseReceivedEventArgs is the interface ID of ICoreWebView2WebResourceResponseReceivedEventArgs.
+	IID_ICoreWebView2WebResourceResponseReceivedEventArgs = windows.GUID{Data1: 0xD1DB483D, Data2: 0x6796, Data3: 0x4B8B, Data4: [8]byte{0x80, 0xFC, 0x13, 0x71, 0x2B, 0xB7, 0x16, 0xF4}}
+	// IID_ICoreWebView2WebResourceResponseReceivedEventHandler is the interface ID of ICoreWebView2WebResourceResponseReceivedEventHandler.
+	IID_ICoreWebView2WebResourceResponseReceivedEventHandler = windows.GUID{Data1: 0x7DE9898A, Data2: 0x24F5, Data3: 0x40C3, Data4: [8]byte{0xA2, 0xDE, 0xD4, 0xF4, 0x58, 0xE6, 0x98, 0x28}}
+	// IID_ICoreWebView2WebResourceResponseView is the interface ID of ICoreWebView2WebResourceResponseView.
+	IID_ICoreWebView2WebResourceResponseView = windows.GUID{Data1: 0x79701053, Data2: 0x7759, Data3: 0x4162, Data4: [8]byte{0x8F, 0x7D, 0xF1, 0xB3, 0xF0, 0x84, 0x92, 0x8D}}
+	// IID_ICoreWebView2WebResourceResponseViewGetContentCompletedHandler is the interface ID of ICoreWebView2WebResourceResponseViewGetContentCompletedHandler.
+	IID_ICoreWebView2WebResourceResponseViewGetContentCompletedHandler = windows.GUID{Data1: 0x875738E1, Data2: 0x9FA2, Data3: 0x40E3, Data4: [8]byte{0x8B, 0x74, 0x2E, 0x89, 0x72, 0xDD, 0x6F, 0xE7}}
+	// IID_ICoreWebView2WindowCloseRequestedEventHandler is the interface ID of ICoreWebView2WindowCloseRequestedEventHandler.
+	IID_ICoreWebView2WindowCloseRequestedEventHandler = windows.GUID{Data1: 0x5C19E9E0, Data2: 0x092F, Data3: 0x486B, Data4: [8]byte{0xAF, 0xFA, 0xCA, 0x82, 0x31, 0x91, 0x30, 0x39}}
+	// IID_ICoreWebView2WindowFeatures is the interface ID of ICoreWebView2WindowFeatures.
+	IID_ICoreWebView2WindowFeatures = windows.GUID{Data1: 0x5EAF559F, Data2: 0xB46E, Data3: 0x4397, Data4: [8]byte{0x88, 0x60, 0xE4, 0x22, 0xF2, 0x87, 0xFF, 0x1E}}
+	// IID_ICoreWebView2ZoomFactorChangedEventHandler is the interface ID of ICoreWebView2ZoomFactorChangedEventHandler.
+	IID_ICoreWebView2ZoomFactorChangedEventHandler = windows.GUID{Data1: 0xB52D71D6, Data2: 0xC4DF, Data3: 0x4543, Data4: [8]byte{0xA9, 0x0C, 0x64, 0xA3, 0xE6, 0x0F, 0x38, 0xCB}}
+	// IID_ICoreWebView2TrySuspendCompletedHandler is the interface ID of ICoreWebView2TrySuspendCompletedHandler.
+	IID_ICoreWebView2TrySuspendCompletedHandler = windows.GUID{Data1: 0x00F206A7, Data2: 0x9D17, Data3: 0x4605, Data4: [8]byte{0x91, 0xF6, 0x4E, 0x8E, 0x4D, 0xE1, 0x92, 0xE3}}
+	// IID_ICoreWebView2Controller2 is the interface ID of ICoreWebView2Controller2.
+	IID_ICoreWebView2Controller2 = windows.GUID{Data1: 0xC979903E, Data2: 0xD4CA, Data3: 0x4228, Data4: [8]byte{0x92, 0xEB, 0x47, 0xEE, 0x3F, 0xA9, 0x6E, 0xAB}}
+	// IID_ICoreWebView2Controller3 is the interface ID of ICoreWebView2Controller3.
+	IID_ICoreWebView2Controller3 = windows.GUID{Data1: 0xF9614724, Data2: 0x5D2B, Data3: 0x41DC, Data4: [8]byte{0xAE, 0xF7, 0x73, 0xD6, 0x2B, 0x51, 0x54, 0x3B}}
+	// IID_ICoreWebView2Controller4 is the interface ID of ICoreWebView2Controller4.
+	IID_ICoreWebView2Controller4 = windows.GUID{Data1: 0x97D418D5, Data2: 0xA426, Data3: 0x4E49, Data4: [8]byte{0xA1, 0x51, 0xE1, 0xA1, 0x0F, 0x32, 0x7D, 0x9E}}
+	// IID_ICoreWebView2RasterizationScaleChangedEventHandler is the interface ID of ICoreWebView2RasterizationScaleChangedEventHandler.
+	IID_ICoreWebView2RasterizationScaleChangedEventHandler = windows.GUID{Data1: 0x9C98C8B1, Data2: 0xAC53, Data3: 0x427E, Data4: [8]byte{0xA3, 0x45, 0x30, 0x49, 0xB5, 0x52, 0x4B, 0xBE}}
+	// IID_ICoreWebView2Settings2 is the interface ID of ICoreWebView2Settings2.
+	IID_ICoreWebView2Settings2 = windows.GUID{Data1: 0xEE9A0F68, Data2: 0xF46C, Data3: 0x4E32, Data4: [8]byte{0xAC, 0x23, 0xEF, 0x8C, 0xAC, 0x22, 0x4D, 0x2A}}
+	// IID_ICoreWebView2Settings3 is the interface ID of ICoreWebView2Settings3.
+	IID_ICoreWebView2Settings3 = windows.GUID{Data1: 0xFDB5AB74, Data2: 0xAF33, Data3: 0x4854, Data4: [8]byte{0x84, 0xF0, 0x0A, 0x63, 0x1D, 0xEB, 0x5E, 0xBA}}
+	// IID_ICoreWebView2Settings4 is the interface ID of ICoreWebView2Settings4.
+	IID_ICoreWebView2Settings4 = windows.GUID{Data1: 0xCB56846C, Data2: 0x4168, Data3: 0x4D53, Data4: [8]byte{0xB0, 0x4F, 0x03, 0xB6, 0xD6, 0x79, 0x6F, 0xF2}}
+	// IID_ICoreWebView2Settings5 is the interface ID of ICoreWebView2Settings5.
+	IID_ICoreWebView2Settings5 = windows.GUID{Data1: 0x183E7052, Data2: 0x1D03, Data3: 0x43A0, Data4: [8]byte{0xAB, 0x99, 0x98, 0xE0, 0x43, 0xB6, 0x6B, 0x39}}
+	// IID_ICoreWebView2Settings6 is the interface ID of ICoreWebView2Settings6.
+	IID_ICoreWebView2Settings6 = windows.GUID{Data1: 0x11CB3ACD, Data2: 0x9BC8, Data3: 0x43B8, Data4: [8]byte{0x83, 0xBF, 0xF4, 0x07, 0x53, 0x71, 0x4F, 0x87}}
+	// IID_ICoreWebView2Environment3 is the interface ID of ICoreWebView2Environment3.
+	IID_ICoreWebView2Environment3 = windows.GUID{Data1: 0x80A22AE3, Data2: 0xBE7C, Data3: 0x4CE2, Data4: [8]byte{0xAF, 0xE1, 0x5A, 0x50, 0x05, 0x6C, 0xDE, 0xEB}}
+	// IID_ICoreWebView2Environment4 is the interface ID of ICoreWebView2Environment4.
+	IID_ICoreWebView2Environment4 = windows.GUID{Data1: 0x20944379, Data2: 0x6DCF, Data3: 0x41D6, Data4: [8]byte{0xA0, 0xA0, 0xAB, 0xC0, 0xFC, 0x50, 0xDE, 0x0D}}
+	// IID_ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler is the interface ID of ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler.
+	IID_ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler = windows.GUID{Data1: 0x02FAB84B, Data2: 0x1428, Data3: 0x4FB7, Data4: [8]byte{0xAD, 0x45, 0x1B, 0x2E, 0x64, 0x73, 0x61, 0x84}}
+	// IID_ICoreWebView2CompositionController is the interface ID of ICoreWebView2CompositionController.
+	IID_ICoreWebView2CompositionController = windows.GUID{Data1: 0x3DF9B733, Data2: 0xB9AE, Data3: 0x4A15, Data4: [8]byte{0x86, 0xB4, 0xEB, 0x9E, 0xE9, 0x82, 0x64, 0x69}}
+	// IID_ICoreWebView2CompositionController2 is the interface ID of ICoreWebView2CompositionController2.
+	IID_ICoreWebView2CompositionController2 = windows.GUID{Data1: 0x0B6A3D24, Data2: 0x49CB, Data3: 0x4806, Data4: [8]byte{0xBA, 0x20, 0xB5, 0xE0, 0x73, 0x4A, 0x7B, 0x26}}
+	// IID_ICoreWebView2CursorChangedEventHandler is the interface ID of ICoreWebView2CursorChangedEventHandler.
+	IID_ICoreWebView2CursorChangedEventHandler = windows.GUID{Data1: 0x9DA43CCC, Data2: 0x26E1, Data3: 0x4DAD, Data4: [8]byte{0xB5, 0x6C, 0xD8, 0x96, 0x1C, 0x94, 0xC5, 0x71}}
+	// IID_ICoreWebView2PointerInfo is the interface ID of ICoreWebView2PointerInfo.
+	IID_ICoreWebView2PointerInfo = windows.GUID{Data1: 0xE6995887, Data2: 0xD10D, Data3: 0x4F5D, Data4: [8]byte{0x93, 0x59, 0x4C, 0xE4, 0x6E, 0x4F, 0x96, 0xB9}}
+)
+
+type (
+	// ICoreWebView2 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2
+	ICoreWebView2 struct {
+		VTBL *ICoreWebView2VTBL
+	}
+
+	// ICoreWebView2VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2
+	ICoreWebView2VTBL struct {
+		BasicVTBL
+		GetSettings                            uintptr
+		GetSource                              uintptr
+		Navigate                               uintptr
+		NavigateToString                       uintptr
+		AddNavigationStarting                  uintptr
+		RemoveNavigationStarting               uintptr
+		AddContentLoading                      uintptr
+		RemoveContentLoading                   uintptr
+		AddSourceChanged                       uintptr
+		RemoveSourceChanged                    uintptr
+		AddHistoryChanged                      uintptr
+		RemoveHistoryChanged                   uintptr
+		AddNavigationCompleted                 uintptr
+		RemoveNavigationCompleted              uintptr
+		AddFrameNavigationStarting             uintptr
+		RemoveFrameNavigationStarting          uintptr
+		AddFrameNavigationCompleted            uintptr
+		RemoveFrameNavigationCompleted         uintptr
+		AddScriptDialogOpening                 uintptr
+		RemoveScriptDialogOpening              uintptr
+		AddPermissionRequested                 uintptr
+		RemovePermissionRequested              uintptr
+		AddProcessFailed                       uintptr
+		RemoveProcessFailed                    uintptr
+		AddScriptToExecuteOnDocumentCreated    uintptr
+		RemoveScriptToExecuteOnDocumentCreated uintptr
+		ExecuteScript                          uintptr
+		CapturePreview                         uintptr
+		Reload                                 uintptr
+		PostWebMessageAsJSON                   uintptr
+		PostWebMessageAsString                 uintptr
+		AddWebMessageReceived                  uintptr
+		RemoveWebMessageReceived               uintptr
+		CallDevToolsProtocolMethod             uintptr
+		GetBrowserProcessID                    uintptr
+		GetCanGoBack                           uintptr
+		GetCanGoForward                        uintptr
+		GoBack                                 uintptr
+		GoForward                              uintptr
+		GetDevToolsProtocolEventReceiver       uintptr
+		Stop                                   uintptr
+		AddNewWindowRequested                  uintptr
+		RemoveNewWindowRequested               uintptr
+		AddDocumentTitleChanged                uintptr
+		RemoveDocumentTitleChanged             uintptr
+		GetDocumentTitle                       uintptr
+		AddHostObjectToScript                  uintptr
+		RemoveHostObjectFromScript             uintptr
+		OpenDevToolsWindow                     uintptr
+		AddContainsFullScreenElementChanged    uintptr
+		RemoveContainsFullScreenElementChanged uintptr
+		GetContainsFullScreenElement           uintptr
+		AddWebResourceRequested                uintptr
+		RemoveWebResourceRequested             uintptr
+		AddWebResourceRequestedFilter          uintptr
+		RemoveWebResourceRequestedFilter       uintptr
+		AddWindowCloseRequested                uintptr
+		RemoveWindowCloseRequested             uintptr
+	}
+)
+
+// GetSettings calls ICoreWebView2::get_Settings.
+func (i *ICoreWebView2) GetSettings() (*ICoreWebView2Settings, error) {
+	var settings *ICoreWebView2Settings
+
+	if hr := call(i.VTBL.GetSettings, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&settings))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2::get_Settings", HRESULT: hr}
+	}
+
+	return settings, nil
+}
+
+// GetSource calls ICoreWebView2::get_Source.
+func (i *ICoreWebView2) GetSource() (string, error) {
+	var uri *uint16
+
+	if hr := call(i.VTBL.GetSource, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&uri))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2::get_Source", HRESULT: hr}
+	}
+
+	return coTaskMemString(uri), nil
+}
+
+// Navigate calls ICoreWebView2::Navigate.
+func (i *ICoreWebView2) Navigate(uri string) error {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::Navigate: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.Navigate, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::Navigate", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// NavigateToString calls ICoreWebView2::NavigateToString.
+func (i *ICoreWebView2) NavigateToString(htmlContent string) error {
+	htmlContentPtr, err := windows.UTF16PtrFromString(htmlContent)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::NavigateToString: invalid htmlContent: %w", err)
+	}
+
+	if hr := call(i.VTBL.NavigateToString, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(htmlContentPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::NavigateToString", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddNavigationStarting calls ICoreWebView2::add_NavigationStarting.
+func (i *ICoreWebView2) AddNavigationStarting(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_NavigationStarting", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddContentLoading calls ICoreWebView2::add_ContentLoading.
+func (i *ICoreWebView2) AddContentLoading(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddContentLoading, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ContentLoading", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddSourceChanged calls ICoreWebView2::add_SourceChanged.
+func (i *ICoreWebView2) AddSourceChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddSourceChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_SourceChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddHistoryChanged calls ICoreWebView2::add_HistoryChanged.
+func (i *ICoreWebView2) AddHistoryChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddHistoryChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_HistoryChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddNavigationCompleted calls ICoreWebView2::add_NavigationCompleted.
+func (i *ICoreWebView2) AddNavigationCompleted(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_NavigationCompleted", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddFrameNavigationStarting calls ICoreWebView2::add_FrameNavigationStarting.
+func (i *ICoreWebView2) AddFrameNavigationStarting(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddFrameNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_FrameNavigationStarting", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddFrameNavigationCompleted calls ICoreWebView2::add_FrameNavigationCompleted.
+func (i *ICoreWebView2) AddFrameNavigationCompleted(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddFrameNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_FrameNavigationCompleted", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddScriptDialogOpening calls ICoreWebView2::add_ScriptDialogOpening.
+func (i *ICoreWebView2) AddScriptDialogOpening(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddScriptDialogOpening, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ScriptDialogOpening", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddPermissionRequested calls ICoreWebView2::add_PermissionRequested.
+func (i *ICoreWebView2) AddPermissionRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddPermissionRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_PermissionRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddProcessFailed calls ICoreWebView2::add_ProcessFailed.
+func (i *ICoreWebView2) AddProcessFailed(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddProcessFailed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ProcessFailed", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddScriptToExecuteOnDocumentCreated calls ICoreWebView2::AddScriptToExecuteOnDocumentCreated.
+func (i *ICoreWebView2) AddScriptToExecuteOnDocumentCreated(javaScript string, handler *Handler) error {
+	javaScriptPtr, err := windows.UTF16PtrFromString(javaScript)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::AddScriptToExecuteOnDocumentCreated: invalid javaScript: %w", err)
+	}
+
+	if hr := call(i.VTBL.AddScriptToExecuteOnDocumentCreated, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(javaScriptPtr)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::AddScriptToExecuteOnDocumentCreated", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveScriptToExecuteOnDocumentCreated calls ICoreWebView2::RemoveScriptToExecuteOnDocumentCreated.
+func (i *ICoreWebView2) RemoveScriptToExecuteOnDocumentCreated(id string) error {
+	idPtr, err := windows.UTF16PtrFromString(id)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::RemoveScriptToExecuteOnDocumentCreated: invalid id: %w", err)
+	}
+
+	if hr := call(i.VTBL.RemoveScriptToExecuteOnDocumentCreated, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(idPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::RemoveScriptToExecuteOnDocumentCreated", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// ExecuteScript calls ICoreWebView2::ExecuteScript.
+func (i *ICoreWebView2) ExecuteScript(javaScript string, handler *Handler) error {
+	javaScriptPtr, err := windows.UTF16PtrFromString(javaScript)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::ExecuteScript: invalid javaScript: %w", err)
+	}
+
+	if hr := call(i.VTBL.ExecuteScript, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(javaScriptPtr)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::ExecuteScript", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// CapturePreview calls ICoreWebView2::CapturePreview.
+func (i *ICoreWebView2) CapturePreview(imageFormat COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT, imageStream *IStream, handler *Handler) error {
+	if hr := call(i.VTBL.CapturePreview, uintptr(unsafe.Pointer(i)), uintptr(imageFormat), uintptr(unsafe.Pointer(imageStream)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::CapturePreview", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// Reload calls ICoreWebView2::Reload.
+func (i *ICoreWebView2) Reload() error {
+	if hr := call(i.VTBL.Reload, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::Reload", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PostWebMessageAsJSON calls ICoreWebView2::PostWebMessageAsJson.
+func (i *ICoreWebView2) PostWebMessageAsJSON(webMessageAsJson string) error {
+	webMessageAsJsonPtr, err := windows.UTF16PtrFromString(webMessageAsJson)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::PostWebMessageAsJson: invalid webMessageAsJson: %w", err)
+	}
+
+	if hr := call(i.VTBL.PostWebMessageAsJSON, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(webMessageAsJsonPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::PostWebMessageAsJson", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PostWebMessageAsString calls ICoreWebView2::PostWebMessageAsString.
+func (i *ICoreWebView2) PostWebMessageAsString(webMessageAsString string) error {
+	webMessageAsStringPtr, err := windows.UTF16PtrFromString(webMessageAsString)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::PostWebMessageAsString: invalid webMessageAsString: %w", err)
+	}
+
+	if hr := call(i.VTBL.PostWebMessageAsString, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(webMessageAsStringPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::PostWebMessageAsString", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddWebMessageReceived calls ICoreWebView2::add_WebMessageReceived.
+func (i *ICoreWebView2) AddWebMessageReceived(handler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddWebMessageReceived, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(handler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_WebMessageReceived", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// CallDevToolsProtocolMethod calls ICoreWebView2::CallDevToolsProtocolMethod.
+func (i *ICoreWebView2) CallDevToolsProtocolMethod(methodName string, parametersAsJson string, handler *Handler) error {
+	methodNamePtr, err := windows.UTF16PtrFromString(methodName)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::CallDevToolsProtocolMethod: invalid methodName: %w", err)
+	}
+
+	parametersAsJsonPtr, err := windows.UTF16PtrFromString(parametersAsJson)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::CallDevToolsProtocolMethod: invalid parametersAsJson: %w", err)
+	}
+
+	if hr := call(i.VTBL.CallDevToolsProtocolMethod, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(methodNamePtr)), uintptr(unsafe.Pointer(parametersAsJsonPtr)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::CallDevToolsProtocolMethod", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetBrowserProcessID calls ICoreWebView2::get_BrowserProcessId.
+func (i *ICoreWebView2) GetBrowserProcessID() (uint32, error) {
+	var value uint32
+
+	if hr := call(i.VTBL.GetBrowserProcessID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2::get_BrowserProcessId", HRESULT: hr}
+	}
+
+	return value, nil
+}
+
+// GetCanGoBack calls ICoreWebView2::get_CanGoBack.
+func (i *ICoreWebView2) GetCanGoBack() (bool, error) {
+	var canGoBack int32
+
+	if hr := call(i.VTBL.GetCanGoBack, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&canGoBack))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2::get_CanGoBack", HRESULT: hr}
+	}
+
+	return canGoBack != 0, nil
+}
+
+// GetCanGoForward calls ICoreWebView2::get_CanGoForward.
+func (i *ICoreWebView2) GetCanGoForward() (bool, error) {
+	var canGoForward int32
+
+	if hr := call(i.VTBL.GetCanGoForward, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&canGoForward))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2::get_CanGoForward", HRESULT: hr}
+	}
+
+	return canGoForward != 0, nil
+}
+
+// GoBack calls ICoreWebView2::GoBack.
+func (i *ICoreWebView2) GoBack() error {
+	if hr := call(i.VTBL.GoBack, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::GoBack", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GoForward calls ICoreWebView2::GoForward.
+func (i *ICoreWebView2) GoForward() error {
+	if hr := call(i.VTBL.GoForward, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::GoForward", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetDevToolsProtocolEventReceiver calls ICoreWebView2::GetDevToolsProtocolEventReceiver.
+func (i *ICoreWebView2) GetDevToolsProtocolEventReceiver(eventName string) (*ICoreWebView2DevToolsProtocolEventReceiver, error) {
+	eventNamePtr, err := windows.UTF16PtrFromString(eventName)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2::GetDevToolsProtocolEventReceiver: invalid eventName: %w", err)
+	}
+
+	var receiver *ICoreWebView2DevToolsProtocolEventReceiver
+
+	if hr := call(i.VTBL.GetDevToolsProtocolEventReceiver, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventNamePtr)), uintptr(unsafe.Pointer(&receiver))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2::GetDevToolsProtocolEventReceiver", HRESULT: hr}
+	}
+
+	return receiver, nil
+}
+
+// Stop calls ICoreWebView2::Stop.
+func (i *ICoreWebView2) Stop() error {
+	if hr := call(i.VTBL.Stop, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::Stop", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddNewWindowRequested calls ICoreWebView2::add_NewWindowRequested.
+func (i *ICoreWebView2) AddNewWindowRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNewWindowRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_NewWindowRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddDocumentTitleChanged calls ICoreWebView2::add_DocumentTitleChanged.
+func (i *ICoreWebView2) AddDocumentTitleChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddDocumentTitleChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_DocumentTitleChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetDocumentTitle calls ICoreWebView2::get_DocumentTitle.
+func (i *ICoreWebView2) GetDocumentTitle() (string, error) {
+	var title *uint16
+
+	if hr := call(i.VTBL.GetDocumentTitle, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&title))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2::get_DocumentTitle", HRESULT: hr}
+	}
+
+	return coTaskMemString(title), nil
+}
+
+// AddHostObjectToScript calls ICoreWebView2::AddHostObjectToScript.
+func (i *ICoreWebView2) AddHostObjectToScript(name string, object unsafe.Pointer) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::AddHostObjectToScript: invalid name: %w", err)
+	}
+
+	if hr := call(i.VTBL.AddHostObjectToScript, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(object)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::AddHostObjectToScript", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveHostObjectFromScript calls ICoreWebView2::RemoveHostObjectFromScript.
+func (i *ICoreWebView2) RemoveHostObjectFromScript(name string) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::RemoveHostObjectFromScript: invalid name: %w", err)
+	}
+
+	if hr := call(i.VTBL.RemoveHostObjectFromScript, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::RemoveHostObjectFromScript", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// OpenDevToolsWindow calls ICoreWebView2::OpenDevToolsWindow.
+func (i *ICoreWebView2) OpenDevToolsWindow() error {
+	if hr := call(i.VTBL.OpenDevToolsWindow, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::OpenDevToolsWindow", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddContainsFullScreenElementChanged calls ICoreWebView2::add_ContainsFullScreenElementChanged.
+func (i *ICoreWebView2) AddContainsFullScreenElementChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddContainsFullScreenElementChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ContainsFullScreenElementChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetContainsFullScreenElement calls ICoreWebView2::get_ContainsFullScreenElement.
+func (i *ICoreWebView2) GetContainsFullScreenElement() (bool, error) {
+	var containsFullScreenElement int32
+
+	if hr := call(i.VTBL.GetContainsFullScreenElement, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&containsFullScreenElement))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2::get_ContainsFullScreenElement", HRESULT: hr}
+	}
+
+	return containsFullScreenElement != 0, nil
+}
+
+// AddWebResourceRequested calls ICoreWebView2::add_WebResourceRequested.
+func (i *ICoreWebView2) AddWebResourceRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddWebResourceRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_WebResourceRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddWebResourceRequestedFilter calls ICoreWebView2::AddWebResourceRequestedFilter.
+func (i *ICoreWebView2) AddWebResourceRequestedFilter(uri string, resourceContext COREWEBVIEW2_WEB_RESOURCE_CONTEXT) error {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::AddWebResourceRequestedFilter: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.AddWebResourceRequestedFilter, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr)), uintptr(resourceContext)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::AddWebResourceRequestedFilter", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWebResourceRequestedFilter calls ICoreWebView2::RemoveWebResourceRequestedFilter.
+func (i *ICoreWebView2) RemoveWebResourceRequestedFilter(uri string, resourceContext COREWEBVIEW2_WEB_RESOURCE_CONTEXT) error {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::RemoveWebResourceRequestedFilter: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.RemoveWebResourceRequestedFilter, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr)), uintptr(resourceContext)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::RemoveWebResourceRequestedFilter", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddWindowCloseRequested calls ICoreWebView2::add_WindowCloseRequested.
+func (i *ICoreWebView2) AddWindowCloseRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddWindowCloseRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_WindowCloseRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+type (
+	// ICoreWebView2_2 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2_2
+	ICoreWebView2_2 struct {
+		VTBL *ICoreWebView2_2VTBL
+	}
+
+	// ICoreWebView2_2VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2_2
+	ICoreWebView2_2VTBL struct {
+		ICoreWebView2VTBL
+		AddWebResourceResponseReceived    uintptr
+		RemoveWebResourceResponseReceived uintptr
+		NavigateWithWebResourceRequest    uintptr
+		AddDOMContentLoaded               uintptr
+		RemoveDOMContentLoaded            uintptr
+		GetCookieManager                  uintptr
+		GetEnvironment                    uintptr
+	}
+)
+
+// GetSettings calls ICoreWebView2::get_Settings.
+func (i *ICoreWebView2_2) GetSettings() (*ICoreWebView2Settings, error) {
+	var settings *ICoreWebView2Settings
+
+	if hr := call(i.VTBL.GetSettings, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&settings))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2::get_Settings", HRESULT: hr}
+	}
+
+	return settings, nil
+}
+
+// GetSource calls ICoreWebView2::get_Source.
+func (i *ICoreWebView2_2) GetSource() (string, error) {
+	var uri *uint16
+
+	if hr := call(i.VTBL.GetSource, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&uri))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2::get_Source", HRESULT: hr}
+	}
+
+	return coTaskMemString(uri), nil
+}
+
+// Navigate calls ICoreWebView2::Navigate.
+func (i *ICoreWebView2_2) Navigate(uri string) error {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::Navigate: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.Navigate, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::Navigate", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// NavigateToString calls ICoreWebView2::NavigateToString.
+func (i *ICoreWebView2_2) NavigateToString(htmlContent string) error {
+	htmlContentPtr, err := windows.UTF16PtrFromString(htmlContent)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::NavigateToString: invalid htmlContent: %w", err)
+	}
+
+	if hr := call(i.VTBL.NavigateToString, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(htmlContentPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::NavigateToString", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddNavigationStarting calls ICoreWebView2::add_NavigationStarting.
+func (i *ICoreWebView2_2) AddNavigationStarting(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_NavigationStarting", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddContentLoading calls ICoreWebView2::add_ContentLoading.
+func (i *ICoreWebView2_2) AddContentLoading(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddContentLoading, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ContentLoading", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddSourceChanged calls ICoreWebView2::add_SourceChanged.
+func (i *ICoreWebView2_2) AddSourceChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddSourceChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_SourceChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddHistoryChanged calls ICoreWebView2::add_HistoryChanged.
+func (i *ICoreWebView2_2) AddHistoryChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddHistoryChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_HistoryChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddNavigationCompleted calls ICoreWebView2::add_NavigationCompleted.
+func (i *ICoreWebView2_2) AddNavigationCompleted(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_NavigationCompleted", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddFrameNavigationStarting calls ICoreWebView2::add_FrameNavigationStarting.
+func (i *ICoreWebView2_2) AddFrameNavigationStarting(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddFrameNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_FrameNavigationStarting", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddFrameNavigationCompleted calls ICoreWebView2::add_FrameNavigationCompleted.
+func (i *ICoreWebView2_2) AddFrameNavigationCompleted(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddFrameNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_FrameNavigationCompleted", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddScriptDialogOpening calls ICoreWebView2::add_ScriptDialogOpening.
+func (i *ICoreWebView2_2) AddScriptDialogOpening(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddScriptDialogOpening, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ScriptDialogOpening", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddPermissionRequested calls ICoreWebView2::add_PermissionRequested.
+func (i *ICoreWebView2_2) AddPermissionRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddPermissionRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_PermissionRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddProcessFailed calls ICoreWebView2::add_ProcessFailed.
+func (i *ICoreWebView2_2) AddProcessFailed(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddProcessFailed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ProcessFailed", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddScriptToExecuteOnDocumentCreated calls ICoreWebView2::AddScriptToExecuteOnDocumentCreated.
+func (i *ICoreWebView2_2) AddScriptToExecuteOnDocumentCreated(javaScript string, handler *Handler) error {
+	javaScriptPtr, err := windows.UTF16PtrFromString(javaScript)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::AddScriptToExecuteOnDocumentCreated: invalid javaScript: %w", err)
+	}
+
+	if hr := call(i.VTBL.AddScriptToExecuteOnDocumentCreated, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(javaScriptPtr)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::AddScriptToExecuteOnDocumentCreated", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveScriptToExecuteOnDocumentCreated calls ICoreWebView2::RemoveScriptToExecuteOnDocumentCreated.
+func (i *ICoreWebView2_2) RemoveScriptToExecuteOnDocumentCreated(id string) error {
+	idPtr, err := windows.UTF16PtrFromString(id)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::RemoveScriptToExecuteOnDocumentCreated: invalid id: %w", err)
+	}
+
+	if hr := call(i.VTBL.RemoveScriptToExecuteOnDocumentCreated, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(idPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::RemoveScriptToExecuteOnDocumentCreated", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// ExecuteScript calls ICoreWebView2::ExecuteScript.
+func (i *ICoreWebView2_2) ExecuteScript(javaScript string, handler *Handler) error {
+	javaScriptPtr, err := windows.UTF16PtrFromString(javaScript)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::ExecuteScript: invalid javaScript: %w", err)
+	}
+
+	if hr := call(i.VTBL.ExecuteScript, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(javaScriptPtr)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::ExecuteScript", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// CapturePreview calls ICoreWebView2::CapturePreview.
+func (i *ICoreWebView2_2) CapturePreview(imageFormat COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT, imageStream *IStream, handler *Handler) error {
+	if hr := call(i.VTBL.CapturePreview, uintptr(unsafe.Pointer(i)), uintptr(imageFormat), uintptr(unsafe.Pointer(imageStream)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::CapturePreview", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// Reload calls ICoreWebView2::Reload.
+func (i *ICoreWebView2_2) Reload() error {
+	if hr := call(i.VTBL.Reload, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::Reload", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PostWebMessageAsJSON calls ICoreWebView2::PostWebMessageAsJson.
+func (i *ICoreWebView2_2) PostWebMessageAsJSON(webMessageAsJson string) error {
+	webMessageAsJsonPtr, err := windows.UTF16PtrFromString(webMessageAsJson)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::PostWebMessageAsJson: invalid webMessageAsJson: %w", err)
+	}
+
+	if hr := call(i.VTBL.PostWebMessageAsJSON, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(webMessageAsJsonPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::PostWebMessageAsJson", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PostWebMessageAsString calls ICoreWebView2::PostWebMessageAsString.
+func (i *ICoreWebView2_2) PostWebMessageAsString(webMessageAsString string) error {
+	webMessageAsStringPtr, err := windows.UTF16PtrFromString(webMessageAsString)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::PostWebMessageAsString: invalid webMessageAsString: %w", err)
+	}
+
+	if hr := call(i.VTBL.PostWebMessageAsString, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(webMessageAsStringPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::PostWebMessageAsString", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddWebMessageReceived calls ICoreWebView2::add_WebMessageReceived.
+func (i *ICoreWebView2_2) AddWebMessageReceived(handler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddWebMessageReceived, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(handler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_WebMessageReceived", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// CallDevToolsProtocolMethod calls ICoreWebView2::CallDevToolsProtocolMethod.
+func (i *ICoreWebView2_2) CallDevToolsProtocolMethod(methodName string, parametersAsJson string, handler *Handler) error {
+	methodNamePtr, err := windows.UTF16PtrFromString(methodName)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::CallDevToolsProtocolMethod: invalid methodName: %w", err)
+	}
+
+	parametersAsJsonPtr, err := windows.UTF16PtrFromString(parametersAsJson)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::CallDevToolsProtocolMethod: invalid parametersAsJson: %w", err)
+	}
+
+	if hr := call(i.VTBL.CallDevToolsProtocolMethod, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(methodNamePtr)), uintptr(unsafe.Pointer(parametersAsJsonPtr)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::CallDevToolsProtocolMethod", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetBrowserProcessID calls ICoreWebView2::get_BrowserProcessId.
+func (i *ICoreWebView2_2) GetBrowserProcessID() (uint32, error) {
+	var value uint32
+
+	if hr := call(i.VTBL.GetBrowserProcessID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2::get_BrowserProcessId", HRESULT: hr}
+	}
+
+	return value, nil
+}
+
+// GetCanGoBack calls ICoreWebView2::get_CanGoBack.
+func (i *ICoreWebView2_2) GetCanGoBack() (bool, error) {
+	var canGoBack int32
+
+	if hr := call(i.VTBL.GetCanGoBack, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&canGoBack))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2::get_CanGoBack", HRESULT: hr}
+	}
+
+	return canGoBack != 0, nil
+}
+
+// GetCanGoForward calls ICoreWebView2::get_CanGoForward.
+func (i *ICoreWebView2_2) GetCanGoForward() (bool, error) {
+	var canGoForward int32
+
+	if hr := call(i.VTBL.GetCanGoForward, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&canGoForward))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2::get_CanGoForward", HRESULT: hr}
+	}
+
+	return canGoForward != 0, nil
+}
+
+// GoBack calls ICoreWebView2::GoBack.
+func (i *ICoreWebView2_2) GoBack() error {
+	if hr := call(i.VTBL.GoBack, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::GoBack", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GoForward calls ICoreWebView2::GoForward.
+func (i *ICoreWebView2_2) GoForward() error {
+	if hr := call(i.VTBL.GoForward, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::GoForward", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetDevToolsProtocolEventReceiver calls ICoreWebView2::GetDevToolsProtocolEventReceiver.
+func (i *ICoreWebView2_2) GetDevToolsProtocolEventReceiver(eventName string) (*ICoreWebView2DevToolsProtocolEventReceiver, error) {
+	eventNamePtr, err := windows.UTF16PtrFromString(eventName)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2::GetDevToolsProtocolEventReceiver: invalid eventName: %w", err)
+	}
+
+	var receiver *ICoreWebView2DevToolsProtocolEventReceiver
+
+	if hr := call(i.VTBL.GetDevToolsProtocolEventReceiver, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventNamePtr)), uintptr(unsafe.Pointer(&receiver))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2::GetDevToolsProtocolEventReceiver", HRESULT: hr}
+	}
+
+	return receiver, nil
+}
+
+// Stop calls ICoreWebView2::Stop.
+func (i *ICoreWebView2_2) Stop() error {
+	if hr := call(i.VTBL.Stop, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::Stop", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddNewWindowRequested calls ICoreWebView2::add_NewWindowRequested.
+func (i *ICoreWebView2_2) AddNewWindowRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNewWindowRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_NewWindowRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddDocumentTitleChanged calls ICoreWebView2::add_DocumentTitleChanged.
+func (i *ICoreWebView2_2) AddDocumentTitleChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddDocumentTitleChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_DocumentTitleChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetDocumentTitle calls ICoreWebView2::get_DocumentTitle.
+func (i *ICoreWebView2_2) GetDocumentTitle() (string, error) {
+	var title *uint16
+
+	if hr := call(i.VTBL.GetDocumentTitle, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&title))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2::get_DocumentTitle", HRESULT: hr}
+	}
+
+	return coTaskMemString(title), nil
+}
+
+// AddHostObjectToScript calls ICoreWebView2::AddHostObjectToScript.
+func (i *ICoreWebView2_2) AddHostObjectToScript(name string, object unsafe.Pointer) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::AddHostObjectToScript: invalid name: %w", err)
+	}
+
+	if hr := call(i.VTBL.AddHostObjectToScript, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(object)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::AddHostObjectToScript", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveHostObjectFromScript calls ICoreWebView2::RemoveHostObjectFromScript.
+func (i *ICoreWebView2_2) RemoveHostObjectFromScript(name string) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::RemoveHostObjectFromScript: invalid name: %w", err)
+	}
+
+	if hr := call(i.VTBL.RemoveHostObjectFromScript, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::RemoveHostObjectFromScript", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// OpenDevToolsWindow calls ICoreWebView2::OpenDevToolsWindow.
+func (i *ICoreWebView2_2) OpenDevToolsWindow() error {
+	if hr := call(i.VTBL.OpenDevToolsWindow, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::OpenDevToolsWindow", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddContainsFullScreenElementChanged calls ICoreWebView2::add_ContainsFullScreenElementChanged.
+func (i *ICoreWebView2_2) AddContainsFullScreenElementChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddContainsFullScreenElementChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ContainsFullScreenElementChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetContainsFullScreenElement calls ICoreWebView2::get_ContainsFullScreenElement.
+func (i *ICoreWebView2_2) GetContainsFullScreenElement() (bool, error) {
+	var containsFullScreenElement int32
+
+	if hr := call(i.VTBL.GetContainsFullScreenElement, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&containsFullScreenElement))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2::get_ContainsFullScreenElement", HRESULT: hr}
+	}
+
+	return containsFullScreenElement != 0, nil
+}
+
+// AddWebResourceRequested calls ICoreWebView2::add_WebResourceRequested.
+func (i *ICoreWebView2_2) AddWebResourceRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddWebResourceRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_WebResourceRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddWebResourceRequestedFilter calls ICoreWebView2::AddWebResourceRequestedFilter.
+func (i *ICoreWebView2_2) AddWebResourceRequestedFilter(uri string, resourceContext COREWEBVIEW2_WEB_RESOURCE_CONTEXT) error {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::AddWebResourceRequestedFilter: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.AddWebResourceRequestedFilter, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr)), uintptr(resourceContext)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::AddWebResourceRequestedFilter", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWebResourceRequestedFilter calls ICoreWebView2::RemoveWebResourceRequestedFilter.
+func (i *ICoreWebView2_2) RemoveWebResourceRequestedFilter(uri string, resourceContext COREWEBVIEW2_WEB_RESOURCE_CONTEXT) error {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::RemoveWebResourceRequestedFilter: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.RemoveWebResourceRequestedFilter, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr)), uintptr(resourceContext)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::RemoveWebResourceRequestedFilter", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddWindowCloseRequested calls ICoreWebView2::add_WindowCloseRequested.
+func (i *ICoreWebView2_2) AddWindowCloseRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddWindowCloseRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_WindowCloseRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddWebResourceResponseReceived calls ICoreWebView2_2::add_WebResourceResponseReceived.
+func (i *ICoreWebView2_2) AddWebResourceResponseReceived(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddWebResourceResponseReceived, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2_2::add_WebResourceResponseReceived", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// NavigateWithWebResourceRequest calls ICoreWebView2_2::NavigateWithWebResourceRequest.
+func (i *ICoreWebView2_2) NavigateWithWebResourceRequest(request *ICoreWebView2WebResourceRequest) error {
+	if hr := call(i.VTBL.NavigateWithWebResourceRequest, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(request))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2_2::NavigateWithWebResourceRequest", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddDOMContentLoaded calls ICoreWebView2_2::add_DOMContentLoaded.
+func (i *ICoreWebView2_2) AddDOMContentLoaded(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddDOMContentLoaded, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2_2::add_DOMContentLoaded", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetCookieManager calls ICoreWebView2_2::get_CookieManager.
+func (i *ICoreWebView2_2) GetCookieManager() (*ICoreWebView2CookieManager, error) {
+	var cookieManager *ICoreWebView2CookieManager
+
+	if hr := call(i.VTBL.GetCookieManager, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&cookieManager))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2_2::get_CookieManager", HRESULT: hr}
+	}
+
+	return cookieManager, nil
+}
+
+// GetEnvironment calls ICoreWebView2_2::get_Environment.
+func (i *ICoreWebView2_2) GetEnvironment() (*ICoreWebView2Environment, error) {
+	var environment *ICoreWebView2Environment
+
+	if hr := call(i.VTBL.GetEnvironment, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&environment))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2_2::get_Environment", HRESULT: hr}
+	}
+
+	return environment, nil
+}
+
+type (
+	// ICoreWebView2_3 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2_3
+	ICoreWebView2_3 struct {
+		VTBL *ICoreWebView2_3VTBL
+	}
+
+	// ICoreWebView2_3VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2_3
+	ICoreWebView2_3VTBL struct {
+		ICoreWebView2_2VTBL
+		TrySuspend                          uintptr
+		Resume                              uintptr
+		GetIsSuspended                      uintptr
+		SetVirtualHostNameToFolderMapping   uintptr
+		ClearVirtualHostNameToFolderMapping uintptr
+	}
+)
+
+// GetSettings calls ICoreWebView2::get_Settings.
+func (i *ICoreWebView2_3) GetSettings() (*ICoreWebView2Settings, error) {
+	var settings *ICoreWebView2Settings
+
+	if hr := call(i.VTBL.GetSettings, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&settings))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2::get_Settings", HRESULT: hr}
+	}
+
+	return settings, nil
+}
+
+// GetSource calls ICoreWebView2::get_Source.
+func (i *ICoreWebView2_3) GetSource() (string, error) {
+	var uri *uint16
+
+	if hr := call(i.VTBL.GetSource, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&uri))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2::get_Source", HRESULT: hr}
+	}
+
+	return coTaskMemString(uri), nil
+}
+
+// Navigate calls ICoreWebView2::Navigate.
+func (i *ICoreWebView2_3) Navigate(uri string) error {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::Navigate: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.Navigate, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::Navigate", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// NavigateToString calls ICoreWebView2::NavigateToString.
+func (i *ICoreWebView2_3) NavigateToString(htmlContent string) error {
+	htmlContentPtr, err := windows.UTF16PtrFromString(htmlContent)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::NavigateToString: invalid htmlContent: %w", err)
+	}
+
+	if hr := call(i.VTBL.NavigateToString, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(htmlContentPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::NavigateToString", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddNavigationStarting calls ICoreWebView2::add_NavigationStarting.
+func (i *ICoreWebView2_3) AddNavigationStarting(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_NavigationStarting", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddContentLoading calls ICoreWebView2::add_ContentLoading.
+func (i *ICoreWebView2_3) AddContentLoading(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddContentLoading, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ContentLoading", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddSourceChanged calls ICoreWebView2::add_SourceChanged.
+func (i *ICoreWebView2_3) AddSourceChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddSourceChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_SourceChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddHistoryChanged calls ICoreWebView2::add_HistoryChanged.
+func (i *ICoreWebView2_3) AddHistoryChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddHistoryChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_HistoryChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddNavigationCompleted calls ICoreWebView2::add_NavigationCompleted.
+func (i *ICoreWebView2_3) AddNavigationCompleted(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_NavigationCompleted", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddFrameNavigationStarting calls ICoreWebView2::add_FrameNavigationStarting.
+func (i *ICoreWebView2_3) AddFrameNavigationStarting(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddFrameNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_FrameNavigationStarting", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddFrameNavigationCompleted calls ICoreWebView2::add_FrameNavigationCompleted.
+func (i *ICoreWebView2_3) AddFrameNavigationCompleted(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddFrameNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_FrameNavigationCompleted", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddScriptDialogOpening calls ICoreWebView2::add_ScriptDialogOpening.
+func (i *ICoreWebView2_3) AddScriptDialogOpening(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddScriptDialogOpening, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ScriptDialogOpening", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddPermissionRequested calls ICoreWebView2::add_PermissionRequested.
+func (i *ICoreWebView2_3) AddPermissionRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddPermissionRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_PermissionRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddProcessFailed calls ICoreWebView2::add_ProcessFailed.
+func (i *ICoreWebView2_3) AddProcessFailed(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddProcessFailed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ProcessFailed", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddScriptToExecuteOnDocumentCreated calls ICoreWebView2::AddScriptToExecuteOnDocumentCreated.
+func (i *ICoreWebView2_3) AddScriptToExecuteOnDocumentCreated(javaScript string, handler *Handler) error {
+	javaScriptPtr, err := windows.UTF16PtrFromString(javaScript)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::AddScriptToExecuteOnDocumentCreated: invalid javaScript: %w", err)
+	}
+
+	if hr := call(i.VTBL.AddScriptToExecuteOnDocumentCreated, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(javaScriptPtr)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::AddScriptToExecuteOnDocumentCreated", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveScriptToExecuteOnDocumentCreated calls ICoreWebView2::RemoveScriptToExecuteOnDocumentCreated.
+func (i *ICoreWebView2_3) RemoveScriptToExecuteOnDocumentCreated(id string) error {
+	idPtr, err := windows.UTF16PtrFromString(id)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::RemoveScriptToExecuteOnDocumentCreated: invalid id: %w", err)
+	}
+
+	if hr := call(i.VTBL.RemoveScriptToExecuteOnDocumentCreated, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(idPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::RemoveScriptToExecuteOnDocumentCreated", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// ExecuteScript calls ICoreWebView2::ExecuteScript.
+func (i *ICoreWebView2_3) ExecuteScript(javaScript string, handler *Handler) error {
+	javaScriptPtr, err := windows.UTF16PtrFromString(javaScript)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::ExecuteScript: invalid javaScript: %w", err)
+	}
+
+	if hr := call(i.VTBL.ExecuteScript, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(javaScriptPtr)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::ExecuteScript", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// CapturePreview calls ICoreWebView2::CapturePreview.
+func (i *ICoreWebView2_3) CapturePreview(imageFormat COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT, imageStream *IStream, handler *Handler) error {
+	if hr := call(i.VTBL.CapturePreview, uintptr(unsafe.Pointer(i)), uintptr(imageFormat), uintptr(unsafe.Pointer(imageStream)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::CapturePreview", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// Reload calls ICoreWebView2::Reload.
+func (i *ICoreWebView2_3) Reload() error {
+	if hr := call(i.VTBL.Reload, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::Reload", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PostWebMessageAsJSON calls ICoreWebView2::PostWebMessageAsJson.
+func (i *ICoreWebView2_3) PostWebMessageAsJSON(webMessageAsJson string) error {
+	webMessageAsJsonPtr, err := windows.UTF16PtrFromString(webMessageAsJson)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::PostWebMessageAsJson: invalid webMessageAsJson: %w", err)
+	}
+
+	if hr := call(i.VTBL.PostWebMessageAsJSON, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(webMessageAsJsonPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::PostWebMessageAsJson", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PostWebMessageAsString calls ICoreWebView2::PostWebMessageAsString.
+func (i *ICoreWebView2_3) PostWebMessageAsString(webMessageAsString string) error {
+	webMessageAsStringPtr, err := windows.UTF16PtrFromString(webMessageAsString)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::PostWebMessageAsString: invalid webMessageAsString: %w", err)
+	}
+
+	if hr := call(i.VTBL.PostWebMessageAsString, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(webMessageAsStringPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::PostWebMessageAsString", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddWebMessageReceived calls ICoreWebView2::add_WebMessageReceived.
+func (i *ICoreWebView2_3) AddWebMessageReceived(handler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddWebMessageReceived, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(handler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_WebMessageReceived", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// CallDevToolsProtocolMethod calls ICoreWebView2::CallDevToolsProtocolMethod.
+func (i *ICoreWebView2_3) CallDevToolsProtocolMethod(methodName string, parametersAsJson string, handler *Handler) error {
+	methodNamePtr, err := windows.UTF16PtrFromString(methodName)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::CallDevToolsProtocolMethod: invalid methodName: %w", err)
+	}
+
+	parametersAsJsonPtr, err := windows.UTF16PtrFromString(parametersAsJson)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::CallDevToolsProtocolMethod: invalid parametersAsJson: %w", err)
+	}
+
+	if hr := call(i.VTBL.CallDevToolsProtocolMethod, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(methodNamePtr)), uintptr(unsafe.Pointer(parametersAsJsonPtr)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::CallDevToolsProtocolMethod", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetBrowserProcessID calls ICoreWebView2::get_BrowserProcessId.
+func (i *ICoreWebView2_3) GetBrowserProcessID() (uint32, error) {
+	var value uint32
+
+	if hr := call(i.VTBL.GetBrowserProcessID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2::get_BrowserProcessId", HRESULT: hr}
+	}
+
+	return value, nil
+}
+
+// GetCanGoBack calls ICoreWebView2::get_CanGoBack.
+func (i *ICoreWebView2_3) GetCanGoBack() (bool, error) {
+	var canGoBack int32
+
+	if hr := call(i.VTBL.GetCanGoBack, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&canGoBack))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2::get_CanGoBack", HRESULT: hr}
+	}
+
+	return canGoBack != 0, nil
+}
+
+// GetCanGoForward calls ICoreWebView2::get_CanGoForward.
+func (i *ICoreWebView2_3) GetCanGoForward() (bool, error) {
+	var canGoForward int32
+
+	if hr := call(i.VTBL.GetCanGoForward, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&canGoForward))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2::get_CanGoForward", HRESULT: hr}
+	}
+
+	return canGoForward != 0, nil
+}
+
+// GoBack calls ICoreWebView2::GoBack.
+func (i *ICoreWebView2_3) GoBack() error {
+	if hr := call(i.VTBL.GoBack, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::GoBack", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GoForward calls ICoreWebView2::GoForward.
+func (i *ICoreWebView2_3) GoForward() error {
+	if hr := call(i.VTBL.GoForward, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::GoForward", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetDevToolsProtocolEventReceiver calls ICoreWebView2::GetDevToolsProtocolEventReceiver.
+func (i *ICoreWebView2_3) GetDevToolsProtocolEventReceiver(eventName string) (*ICoreWebView2DevToolsProtocolEventReceiver, error) {
+	eventNamePtr, err := windows.UTF16PtrFromString(eventName)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2::GetDevToolsProtocolEventReceiver: invalid eventName: %w", err)
+	}
+
+	var receiver *ICoreWebView2DevToolsProtocolEventReceiver
+
+	if hr := call(i.VTBL.GetDevToolsProtocolEventReceiver, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventNamePtr)), uintptr(unsafe.Pointer(&receiver))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2::GetDevToolsProtocolEventReceiver", HRESULT: hr}
+	}
+
+	return receiver, nil
+}
+
+// Stop calls ICoreWebView2::Stop.
+func (i *ICoreWebView2_3) Stop() error {
+	if hr := call(i.VTBL.Stop, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::Stop", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddNewWindowRequested calls ICoreWebView2::add_NewWindowRequested.
+func (i *ICoreWebView2_3) AddNewWindowRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNewWindowRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_NewWindowRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddDocumentTitleChanged calls ICoreWebView2::add_DocumentTitleChanged.
+func (i *ICoreWebView2_3) AddDocumentTitleChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddDocumentTitleChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_DocumentTitleChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetDocumentTitle calls ICoreWebView2::get_DocumentTitle.
+func (i *ICoreWebView2_3) GetDocumentTitle() (string, error) {
+	var title *uint16
+
+	if hr := call(i.VTBL.GetDocumentTitle, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&title))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2::get_DocumentTitle", HRESULT: hr}
+	}
+
+	return coTaskMemString(title), nil
+}
+
+// AddHostObjectToScript calls ICoreWebView2::AddHostObjectToScript.
+func (i *ICoreWebView2_3) AddHostObjectToScript(name string, object unsafe.Pointer) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::AddHostObjectToScript: invalid name: %w", err)
+	}
+
+	if hr := call(i.VTBL.AddHostObjectToScript, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(object)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::AddHostObjectToScript", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveHostObjectFromScript calls ICoreWebView2::RemoveHostObjectFromScript.
+func (i *ICoreWebView2_3) RemoveHostObjectFromScript(name string) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::RemoveHostObjectFromScript: invalid name: %w", err)
+	}
+
+	if hr := call(i.VTBL.RemoveHostObjectFromScript, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::RemoveHostObjectFromScript", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// OpenDevToolsWindow calls ICoreWebView2::OpenDevToolsWindow.
+func (i *ICoreWebView2_3) OpenDevToolsWindow() error {
+	if hr := call(i.VTBL.OpenDevToolsWindow, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::OpenDevToolsWindow", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddContainsFullScreenElementChanged calls ICoreWebView2::add_ContainsFullScreenElementChanged.
+func (i *ICoreWebView2_3) AddContainsFullScreenElementChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddContainsFullScreenElementChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_ContainsFullScreenElementChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetContainsFullScreenElement calls ICoreWebView2::get_ContainsFullScreenElement.
+func (i *ICoreWebView2_3) GetContainsFullScreenElement() (bool, error) {
+	var containsFullScreenElement int32
+
+	if hr := call(i.VTBL.GetContainsFullScreenElement, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&containsFullScreenElement))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2::get_ContainsFullScreenElement", HRESULT: hr}
+	}
+
+	return containsFullScreenElement != 0, nil
+}
+
+// AddWebResourceRequested calls ICoreWebView2::add_WebResourceRequested.
+func (i *ICoreWebView2_3) AddWebResourceRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddWebResourceRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_WebResourceRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddWebResourceRequestedFilter calls ICoreWebView2::AddWebResourceRequestedFilter.
+func (i *ICoreWebView2_3) AddWebResourceRequestedFilter(uri string, resourceContext COREWEBVIEW2_WEB_RESOURCE_CONTEXT) error {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::AddWebResourceRequestedFilter: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.AddWebResourceRequestedFilter, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr)), uintptr(resourceContext)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::AddWebResourceRequestedFilter", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWebResourceRequestedFilter calls ICoreWebView2::RemoveWebResourceRequestedFilter.
+func (i *ICoreWebView2_3) RemoveWebResourceRequestedFilter(uri string, resourceContext COREWEBVIEW2_WEB_RESOURCE_CONTEXT) error {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2::RemoveWebResourceRequestedFilter: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.RemoveWebResourceRequestedFilter, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr)), uintptr(resourceContext)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::RemoveWebResourceRequestedFilter", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddWindowCloseRequested calls ICoreWebView2::add_WindowCloseRequested.
+func (i *ICoreWebView2_3) AddWindowCloseRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddWindowCloseRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2::add_WindowCloseRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddWebResourceResponseReceived calls ICoreWebView2_2::add_WebResourceResponseReceived.
+func (i *ICoreWebView2_3) AddWebResourceResponseReceived(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddWebResourceResponseReceived, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2_2::add_WebResourceResponseReceived", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// NavigateWithWebResourceRequest calls ICoreWebView2_2::NavigateWithWebResourceRequest.
+func (i *ICoreWebView2_3) NavigateWithWebResourceRequest(request *ICoreWebView2WebResourceRequest) error {
+	if hr := call(i.VTBL.NavigateWithWebResourceRequest, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(request))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2_2::NavigateWithWebResourceRequest", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddDOMContentLoaded calls ICoreWebView2_2::add_DOMContentLoaded.
+func (i *ICoreWebView2_3) AddDOMContentLoaded(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddDOMContentLoaded, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2_2::add_DOMContentLoaded", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetCookieManager calls ICoreWebView2_2::get_CookieManager.
+func (i *ICoreWebView2_3) GetCookieManager() (*ICoreWebView2CookieManager, error) {
+	var cookieManager *ICoreWebView2CookieManager
+
+	if hr := call(i.VTBL.GetCookieManager, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&cookieManager))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2_2::get_CookieManager", HRESULT: hr}
+	}
+
+	return cookieManager, nil
+}
+
+// GetEnvironment calls ICoreWebView2_2::get_Environment.
+func (i *ICoreWebView2_3) GetEnvironment() (*ICoreWebView2Environment, error) {
+	var environment *ICoreWebView2Environment
+
+	if hr := call(i.VTBL.GetEnvironment, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&environment))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2_2::get_Environment", HRESULT: hr}
+	}
+
+	return environment, nil
+}
+
+// TrySuspend calls ICoreWebView2_3::TrySuspend.
+func (i *ICoreWebView2_3) TrySuspend(handler *Handler) error {
+	if hr := call(i.VTBL.TrySuspend, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2_3::TrySuspend", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// Resume calls ICoreWebView2_3::Resume.
+func (i *ICoreWebView2_3) Resume() error {
+	if hr := call(i.VTBL.Resume, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2_3::Resume", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsSuspended calls ICoreWebView2_3::get_IsSuspended.
+func (i *ICoreWebView2_3) GetIsSuspended() (bool, error) {
+	var isSuspended int32
+
+	if hr := call(i.VTBL.GetIsSuspended, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isSuspended))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2_3::get_IsSuspended", HRESULT: hr}
+	}
+
+	return isSuspended != 0, nil
+}
+
+// SetVirtualHostNameToFolderMapping calls ICoreWebView2_3::SetVirtualHostNameToFolderMapping.
+func (i *ICoreWebView2_3) SetVirtualHostNameToFolderMapping(hostName string, folderPath string, accessKind COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND) error {
+	hostNamePtr, err := windows.UTF16PtrFromString(hostName)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2_3::SetVirtualHostNameToFolderMapping: invalid hostName: %w", err)
+	}
+
+	folderPathPtr, err := windows.UTF16PtrFromString(folderPath)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2_3::SetVirtualHostNameToFolderMapping: invalid folderPath: %w", err)
+	}
+
+	if hr := call(i.VTBL.SetVirtualHostNameToFolderMapping, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(hostNamePtr)), uintptr(unsafe.Pointer(folderPathPtr)), uintptr(accessKind)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2_3::SetVirtualHostNameToFolderMapping", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// ClearVirtualHostNameToFolderMapping calls ICoreWebView2_3::ClearVirtualHostNameToFolderMapping.
+func (i *ICoreWebView2_3) ClearVirtualHostNameToFolderMapping(hostName string) error {
+	hostNamePtr, err := windows.UTF16PtrFromString(hostName)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2_3::ClearVirtualHostNameToFolderMapping: invalid hostName: %w", err)
+	}
+
+	if hr := call(i.VTBL.ClearVirtualHostNameToFolderMapping, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(hostNamePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2_3::ClearVirtualHostNameToFolderMapping", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Controller implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2controller
+	ICoreWebView2Controller struct {
+		VTBL *ICoreWebView2ControllerVTBL
+	}
+
+	// ICoreWebView2ControllerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2controller
+	ICoreWebView2ControllerVTBL struct {
+		BasicVTBL
+		GetIsVisible                      uintptr
+		PutIsVisible                      uintptr
+		GetBounds                         uintptr
+		PutBounds                         uintptr
+		GetZoomFactor                     uintptr
+		PutZoomFactor                     uintptr
+		AddZoomFactorChanged              uintptr
+		RemoveZoomFactorChanged           uintptr
+		SetBoundsAndZoomFactor            uintptr
+		MoveFocus                         uintptr
+		AddMoveFocusRequested             uintptr
+		RemoveMoveFocusRequested          uintptr
+		AddGotFocus                       uintptr
+		RemoveGotFocus                    uintptr
+		AddLostFocus                      uintptr
+		RemoveLostFocus                   uintptr
+		AddAcceleratorKeyPressed          uintptr
+		RemoveAcceleratorKeyPressed       uintptr
+		GetParentWindow                   uintptr
+		PutParentWindow                   uintptr
+		NotifyParentWindowPositionChanged uintptr
+		Close                             uintptr
+		GetCoreWebView2                   uintptr
+	}
+)
+
+// GetIsVisible calls ICoreWebView2Controller::get_IsVisible.
+func (i *ICoreWebView2Controller) GetIsVisible() (bool, error) {
+	var isVisible int32
+
+	if hr := call(i.VTBL.GetIsVisible, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isVisible))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Controller::get_IsVisible", HRESULT: hr}
+	}
+
+	return isVisible != 0, nil
+}
+
+// PutIsVisible calls ICoreWebView2Controller::put_IsVisible.
+func (i *ICoreWebView2Controller) PutIsVisible(isVisible bool) error {
+	if hr := call(i.VTBL.PutIsVisible, uintptr(unsafe.Pointer(i)), boolToUintptr(isVisible)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_IsVisible", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetBounds calls ICoreWebView2Controller::get_Bounds.
+func (i *ICoreWebView2Controller) GetBounds() (RECT, error) {
+	var bounds RECT
+
+	if hr := call(i.VTBL.GetBounds, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&bounds))); hr.Failed() {
+		return RECT{}, &Error{Method: "ICoreWebView2Controller::get_Bounds", HRESULT: hr}
+	}
+
+	return bounds, nil
+}
+
+// GetZoomFactor calls ICoreWebView2Controller::get_ZoomFactor.
+func (i *ICoreWebView2Controller) GetZoomFactor() (float64, error) {
+	var zoomFactor float64
+
+	if hr := call(i.VTBL.GetZoomFactor, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&zoomFactor))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller::get_ZoomFactor", HRESULT: hr}
+	}
+
+	return zoomFactor, nil
+}
+
+// PutZoomFactor isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// AddZoomFactorChanged calls ICoreWebView2Controller::add_ZoomFactorChanged.
+func (i *ICoreWebView2Controller) AddZoomFactorChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddZoomFactorChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_ZoomFactorChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// MoveFocus calls ICoreWebView2Controller::MoveFocus.
+func (i *ICoreWebView2Controller) MoveFocus(reason COREWEBVIEW2_MOVE_FOCUS_REASON) error {
+	if hr := call(i.VTBL.MoveFocus, uintptr(unsafe.Pointer(i)), uintptr(reason)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::MoveFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddMoveFocusRequested calls ICoreWebView2Controller::add_MoveFocusRequested.
+func (i *ICoreWebView2Controller) AddMoveFocusRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddMoveFocusRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_MoveFocusRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddGotFocus calls ICoreWebView2Controller::add_GotFocus.
+func (i *ICoreWebView2Controller) AddGotFocus(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddGotFocus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_GotFocus", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddLostFocus calls ICoreWebView2Controller::add_LostFocus.
+func (i *ICoreWebView2Controller) AddLostFocus(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddLostFocus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_LostFocus", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddAcceleratorKeyPressed calls ICoreWebView2Controller::add_AcceleratorKeyPressed.
+func (i *ICoreWebView2Controller) AddAcceleratorKeyPressed(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddAcceleratorKeyPressed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_AcceleratorKeyPressed", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetParentWindow calls ICoreWebView2Controller::get_ParentWindow.
+func (i *ICoreWebView2Controller) GetParentWindow() (windows.Handle, error) {
+	var parentWindow windows.Handle
+
+	if hr := call(i.VTBL.GetParentWindow, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&parentWindow))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller::get_ParentWindow", HRESULT: hr}
+	}
+
+	return parentWindow, nil
+}
+
+// PutParentWindow calls ICoreWebView2Controller::put_ParentWindow.
+func (i *ICoreWebView2Controller) PutParentWindow(parentWindow windows.Handle) error {
+	if hr := call(i.VTBL.PutParentWindow, uintptr(unsafe.Pointer(i)), uintptr(parentWindow)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_ParentWindow", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// NotifyParentWindowPositionChanged calls ICoreWebView2Controller::NotifyParentWindowPositionChanged.
+func (i *ICoreWebView2Controller) NotifyParentWindowPositionChanged() error {
+	if hr := call(i.VTBL.NotifyParentWindowPositionChanged, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::NotifyParentWindowPositionChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// Close calls ICoreWebView2Controller::Close.
+func (i *ICoreWebView2Controller) Close() error {
+	if hr := call(i.VTBL.Close, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::Close", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetCoreWebView2 calls ICoreWebView2Controller::get_CoreWebView2.
+func (i *ICoreWebView2Controller) GetCoreWebView2() (*ICoreWebView2, error) {
+	var coreWebView2 *ICoreWebView2
+
+	if hr := call(i.VTBL.GetCoreWebView2, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&coreWebView2))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Controller::get_CoreWebView2", HRESULT: hr}
+	}
+
+	return coreWebView2, nil
+}
+
+type (
+	// ICoreWebView2Settings implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings
+	ICoreWebView2Settings struct {
+		VTBL *ICoreWebView2SettingsVTBL
+	}
+
+	// ICoreWebView2SettingsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings
+	ICoreWebView2SettingsVTBL struct {
+		BasicVTBL
+		GetIsScriptEnabled                uintptr
+		PutIsScriptEnabled                uintptr
+		GetIsWebMessageEnabled            uintptr
+		PutIsWebMessageEnabled            uintptr
+		GetAreDefaultScriptDialogsEnabled uintptr
+		PutAreDefaultScriptDialogsEnabled uintptr
+		GetIsStatusBarEnabled             uintptr
+		PutIsStatusBarEnabled             uintptr
+		GetAreDevToolsEnabled             uintptr
+		PutAreDevToolsEnabled             uintptr
+		GetAreDefaultContextMenusEnabled  uintptr
+		PutAreDefaultContextMenusEnabled  uintptr
+		GetAreHostObjectsAllowed          uintptr
+		PutAreHostObjectsAllowed          uintptr
+		GetIsZoomControlEnabled           uintptr
+		PutIsZoomControlEnabled           uintptr
+		GetIsBuiltInErrorPageEnabled      uintptr
+		PutIsBuiltInErrorPageEnabled      uintptr
+	}
+)
+
+// GetIsScriptEnabled calls ICoreWebView2Settings::get_IsScriptEnabled.
+func (i *ICoreWebView2Settings) GetIsScriptEnabled() (bool, error) {
+	var isScriptEnabled int32
+
+	if hr := call(i.VTBL.GetIsScriptEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isScriptEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return isScriptEnabled != 0, nil
+}
+
+// PutIsScriptEnabled calls ICoreWebView2Settings::put_IsScriptEnabled.
+func (i *ICoreWebView2Settings) PutIsScriptEnabled(isScriptEnabled bool) error {
+	if hr := call(i.VTBL.PutIsScriptEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isScriptEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsWebMessageEnabled calls ICoreWebView2Settings::get_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings) GetIsWebMessageEnabled() (bool, error) {
+	var isWebMessageEnabled int32
+
+	if hr := call(i.VTBL.GetIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isWebMessageEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return isWebMessageEnabled != 0, nil
+}
+
+// PutIsWebMessageEnabled calls ICoreWebView2Settings::put_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings) PutIsWebMessageEnabled(isWebMessageEnabled bool) error {
+	if hr := call(i.VTBL.PutIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isWebMessageEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings) GetAreDefaultScriptDialogsEnabled() (bool, error) {
+	var areDefaultScriptDialogsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDefaultScriptDialogsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return areDefaultScriptDialogsEnabled != 0, nil
+}
+
+// PutAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings) PutAreDefaultScriptDialogsEnabled(areDefaultScriptDialogsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDefaultScriptDialogsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsStatusBarEnabled calls ICoreWebView2Settings::get_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings) GetIsStatusBarEnabled() (bool, error) {
+	var isStatusBarEnabled int32
+
+	if hr := call(i.VTBL.GetIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isStatusBarEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return isStatusBarEnabled != 0, nil
+}
+
+// PutIsStatusBarEnabled calls ICoreWebView2Settings::put_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings) PutIsStatusBarEnabled(isStatusBarEnabled bool) error {
+	if hr := call(i.VTBL.PutIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isStatusBarEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDevToolsEnabled calls ICoreWebView2Settings::get_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings) GetAreDevToolsEnabled() (bool, error) {
+	var areDevToolsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDevToolsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return areDevToolsEnabled != 0, nil
+}
+
+// PutAreDevToolsEnabled calls ICoreWebView2Settings::put_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings) PutAreDevToolsEnabled(areDevToolsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDevToolsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultContextMenusEnabled calls ICoreWebView2Settings::get_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings) GetAreDefaultContextMenusEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutAreDefaultContextMenusEnabled calls ICoreWebView2Settings::put_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings) PutAreDefaultContextMenusEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreHostObjectsAllowed calls ICoreWebView2Settings::get_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings) GetAreHostObjectsAllowed() (bool, error) {
+	var allowed int32
+
+	if hr := call(i.VTBL.GetAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&allowed))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return allowed != 0, nil
+}
+
+// PutAreHostObjectsAllowed calls ICoreWebView2Settings::put_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings) PutAreHostObjectsAllowed(allowed bool) error {
+	if hr := call(i.VTBL.PutAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), boolToUintptr(allowed)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsZoomControlEnabled calls ICoreWebView2Settings::get_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings) GetIsZoomControlEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsZoomControlEnabled calls ICoreWebView2Settings::put_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings) PutIsZoomControlEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings) GetIsBuiltInErrorPageEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings) PutIsBuiltInErrorPageEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Environment implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2environment
+	ICoreWebView2Environment struct {
+		VTBL *ICoreWebView2EnvironmentVTBL
+	}
+
+	// ICoreWebView2EnvironmentVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2environment
+	ICoreWebView2EnvironmentVTBL struct {
+		BasicVTBL
+		CreateCoreWebView2Controller     uintptr
+		CreateWebResourceResponse        uintptr
+		GetBrowserVersionString          uintptr
+		AddNewBrowserVersionAvailable    uintptr
+		RemoveNewBrowserVersionAvailable uintptr
+	}
+)
+
+// CreateCoreWebView2Controller calls ICoreWebView2Environment::CreateCoreWebView2Controller.
+func (i *ICoreWebView2Environment) CreateCoreWebView2Controller(parentWindow windows.Handle, handler *Handler) error {
+	if hr := call(i.VTBL.CreateCoreWebView2Controller, uintptr(unsafe.Pointer(i)), uintptr(parentWindow), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Environment::CreateCoreWebView2Controller", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// CreateWebResourceResponse calls ICoreWebView2Environment::CreateWebResourceResponse.
+func (i *ICoreWebView2Environment) CreateWebResourceResponse(content *IStream, statusCode int32, reasonPhrase string, headers string) (*ICoreWebView2WebResourceResponse, error) {
+	reasonPhrasePtr, err := windows.UTF16PtrFromString(reasonPhrase)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment::CreateWebResourceResponse: invalid reasonPhrase: %w", err)
+	}
+
+	headersPtr, err := windows.UTF16PtrFromString(headers)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment::CreateWebResourceResponse: invalid headers: %w", err)
+	}
+
+	var response *ICoreWebView2WebResourceResponse
+
+	if hr := call(i.VTBL.CreateWebResourceResponse, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(content)), uintptr(statusCode), uintptr(unsafe.Pointer(reasonPhrasePtr)), uintptr(unsafe.Pointer(headersPtr)), uintptr(unsafe.Pointer(&response))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Environment::CreateWebResourceResponse", HRESULT: hr}
+	}
+
+	return response, nil
+}
+
+// GetBrowserVersionString calls ICoreWebView2Environment::get_BrowserVersionString.
+func (i *ICoreWebView2Environment) GetBrowserVersionString() (string, error) {
+	var versionInfo *uint16
+
+	if hr := call(i.VTBL.GetBrowserVersionString, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&versionInfo))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Environment::get_BrowserVersionString", HRESULT: hr}
+	}
+
+	return coTaskMemString(versionInfo), nil
+}
+
+// AddNewBrowserVersionAvailable calls ICoreWebView2Environment::add_NewBrowserVersionAvailable.
+func (i *ICoreWebView2Environment) AddNewBrowserVersionAvailable(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNewBrowserVersionAvailable, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Environment::add_NewBrowserVersionAvailable", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+type (
+	// ICoreWebView2EnvironmentOptions implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2environmentoptions
+	ICoreWebView2EnvironmentOptions struct {
+		VTBL *ICoreWebView2EnvironmentOptionsVTBL
+	}
+
+	// ICoreWebView2EnvironmentOptionsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2environmentoptions
+	ICoreWebView2EnvironmentOptionsVTBL struct {
+		BasicVTBL
+		GetAdditionalBrowserArguments             uintptr
+		PutAdditionalBrowserArguments             uintptr
+		GetLanguage                               uintptr
+		PutLanguage                               uintptr
+		GetTargetCompatibleBrowserVersion         uintptr
+		PutTargetCompatibleBrowserVersion         uintptr
+		GetAllowSingleSignOnUsingOSPrimaryAccount uintptr
+		PutAllowSingleSignOnUsingOSPrimaryAccount uintptr
+	}
+)
+
+// GetAdditionalBrowserArguments calls ICoreWebView2EnvironmentOptions::get_AdditionalBrowserArguments.
+func (i *ICoreWebView2EnvironmentOptions) GetAdditionalBrowserArguments() (string, error) {
+	var value *uint16
+
+	if hr := call(i.VTBL.GetAdditionalBrowserArguments, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2EnvironmentOptions::get_AdditionalBrowserArguments", HRESULT: hr}
+	}
+
+	return coTaskMemString(value), nil
+}
+
+// PutAdditionalBrowserArguments calls ICoreWebView2EnvironmentOptions::put_AdditionalBrowserArguments.
+func (i *ICoreWebView2EnvironmentOptions) PutAdditionalBrowserArguments(value string) error {
+	valuePtr, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2EnvironmentOptions::put_AdditionalBrowserArguments: invalid value: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutAdditionalBrowserArguments, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(valuePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2EnvironmentOptions::put_AdditionalBrowserArguments", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetLanguage calls ICoreWebView2EnvironmentOptions::get_Language.
+func (i *ICoreWebView2EnvironmentOptions) GetLanguage() (string, error) {
+	var value *uint16
+
+	if hr := call(i.VTBL.GetLanguage, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2EnvironmentOptions::get_Language", HRESULT: hr}
+	}
+
+	return coTaskMemString(value), nil
+}
+
+// PutLanguage calls ICoreWebView2EnvironmentOptions::put_Language.
+func (i *ICoreWebView2EnvironmentOptions) PutLanguage(value string) error {
+	valuePtr, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2EnvironmentOptions::put_Language: invalid value: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutLanguage, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(valuePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2EnvironmentOptions::put_Language", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetTargetCompatibleBrowserVersion calls ICoreWebView2EnvironmentOptions::get_TargetCompatibleBrowserVersion.
+func (i *ICoreWebView2EnvironmentOptions) GetTargetCompatibleBrowserVersion() (string, error) {
+	var value *uint16
+
+	if hr := call(i.VTBL.GetTargetCompatibleBrowserVersion, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2EnvironmentOptions::get_TargetCompatibleBrowserVersion", HRESULT: hr}
+	}
+
+	return coTaskMemString(value), nil
+}
+
+// PutTargetCompatibleBrowserVersion calls ICoreWebView2EnvironmentOptions::put_TargetCompatibleBrowserVersion.
+func (i *ICoreWebView2EnvironmentOptions) PutTargetCompatibleBrowserVersion(value string) error {
+	valuePtr, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2EnvironmentOptions::put_TargetCompatibleBrowserVersion: invalid value: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutTargetCompatibleBrowserVersion, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(valuePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2EnvironmentOptions::put_TargetCompatibleBrowserVersion", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAllowSingleSignOnUsingOSPrimaryAccount calls ICoreWebView2EnvironmentOptions::get_AllowSingleSignOnUsingOSPrimaryAccount.
+func (i *ICoreWebView2EnvironmentOptions) GetAllowSingleSignOnUsingOSPrimaryAccount() (bool, error) {
+	var allow int32
+
+	if hr := call(i.VTBL.GetAllowSingleSignOnUsingOSPrimaryAccount, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&allow))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2EnvironmentOptions::get_AllowSingleSignOnUsingOSPrimaryAccount", HRESULT: hr}
+	}
+
+	return allow != 0, nil
+}
+
+// PutAllowSingleSignOnUsingOSPrimaryAccount calls ICoreWebView2EnvironmentOptions::put_AllowSingleSignOnUsingOSPrimaryAccount.
+func (i *ICoreWebView2EnvironmentOptions) PutAllowSingleSignOnUsingOSPrimaryAccount(allow bool) error {
+	if hr := call(i.VTBL.PutAllowSingleSignOnUsingOSPrimaryAccount, uintptr(unsafe.Pointer(i)), boolToUintptr(allow)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2EnvironmentOptions::put_AllowSingleSignOnUsingOSPrimaryAccount", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2createcorewebview2environmentcompletedhandler
+	ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler struct {
+		VTBL *ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandlerVTBL
+	}
+
+	// ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2createcorewebview2environmentcompletedhandler
+	ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler::Invoke.
+func (i *ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler) Invoke(errorCode hresult.HRESULT, createdEnvironment *ICoreWebView2Environment) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(errorCode), uintptr(unsafe.Pointer(createdEnvironment))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2CreateCoreWebView2ControllerCompletedHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2createcorewebview2controllercompletedhandler
+	ICoreWebView2CreateCoreWebView2ControllerCompletedHandler struct {
+		VTBL *ICoreWebView2CreateCoreWebView2ControllerCompletedHandlerVTBL
+	}
+
+	// ICoreWebView2CreateCoreWebView2ControllerCompletedHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2createcorewebview2controllercompletedhandler
+	ICoreWebView2CreateCoreWebView2ControllerCompletedHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2CreateCoreWebView2ControllerCompletedHandler::Invoke.
+func (i *ICoreWebView2CreateCoreWebView2ControllerCompletedHandler) Invoke(errorCode hresult.HRESULT, createdController *ICoreWebView2Controller) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(errorCode), uintptr(unsafe.Pointer(createdController))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CreateCoreWebView2ControllerCompletedHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2ExecuteScriptCompletedHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2executescriptcompletedhandler
+	ICoreWebView2ExecuteScriptCompletedHandler struct {
+		VTBL *ICoreWebView2ExecuteScriptCompletedHandlerVTBL
+	}
+
+	// ICoreWebView2ExecuteScriptCompletedHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2executescriptcompletedhandler
+	ICoreWebView2ExecuteScriptCompletedHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2ExecuteScriptCompletedHandler::Invoke.
+func (i *ICoreWebView2ExecuteScriptCompletedHandler) Invoke(errorCode hresult.HRESULT, resultObjectAsJson string) error {
+	resultObjectAsJsonPtr, err := windows.UTF16PtrFromString(resultObjectAsJson)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2ExecuteScriptCompletedHandler::Invoke: invalid resultObjectAsJson: %w", err)
+	}
+
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(errorCode), uintptr(unsafe.Pointer(resultObjectAsJsonPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2ExecuteScriptCompletedHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2addscripttoexecuteondocumentcreatedcompletedhandler
+	ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler struct {
+		VTBL *ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandlerVTBL
+	}
+
+	// ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2addscripttoexecuteondocumentcreatedcompletedhandler
+	ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler::Invoke.
+func (i *ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler) Invoke(errorCode hresult.HRESULT, id string) error {
+	idPtr, err := windows.UTF16PtrFromString(id)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler::Invoke: invalid id: %w", err)
+	}
+
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(errorCode), uintptr(unsafe.Pointer(idPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2WebMessageReceivedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webmessagereceivedeventhandler
+	ICoreWebView2WebMessageReceivedEventHandler struct {
+		VTBL *ICoreWebView2WebMessageReceivedEventHandlerVTBL
+	}
+
+	// ICoreWebView2WebMessageReceivedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webmessagereceivedeventhandler
+	ICoreWebView2WebMessageReceivedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2WebMessageReceivedEventHandler::Invoke.
+func (i *ICoreWebView2WebMessageReceivedEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2WebMessageReceivedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebMessageReceivedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2WebMessageReceivedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webmessagereceivedeventargs
+	ICoreWebView2WebMessageReceivedEventArgs struct {
+		VTBL *ICoreWebView2WebMessageReceivedEventArgsVTBL
+	}
+
+	// ICoreWebView2WebMessageReceivedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webmessagereceivedeventargs
+	ICoreWebView2WebMessageReceivedEventArgsVTBL struct {
+		BasicVTBL
+		GetSource                uintptr
+		GetWebMessageAsJSON      uintptr
+		TryGetWebMessageAsString uintptr
+	}
+)
+
+// GetSource calls ICoreWebView2WebMessageReceivedEventArgs::get_Source.
+func (i *ICoreWebView2WebMessageReceivedEventArgs) GetSource() (string, error) {
+	var source *uint16
+
+	if hr := call(i.VTBL.GetSource, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&source))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2WebMessageReceivedEventArgs::get_Source", HRESULT: hr}
+	}
+
+	return coTaskMemString(source), nil
+}
+
+// GetWebMessageAsJSON calls ICoreWebView2WebMessageReceivedEventArgs::get_WebMessageAsJson.
+func (i *ICoreWebView2WebMessageReceivedEventArgs) GetWebMessageAsJSON() (string, error) {
+	var webMessageAsJson *uint16
+
+	if hr := call(i.VTBL.GetWebMessageAsJSON, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&webMessageAsJson))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2WebMessageReceivedEventArgs::get_WebMessageAsJson", HRESULT: hr}
+	}
+
+	return coTaskMemString(webMessageAsJson), nil
+}
+
+// TryGetWebMessageAsString calls ICoreWebView2WebMessageReceivedEventArgs::TryGetWebMessageAsString.
+func (i *ICoreWebView2WebMessageReceivedEventArgs) TryGetWebMessageAsString() (string, error) {
+	var webMessageAsString *uint16
+
+	if hr := call(i.VTBL.TryGetWebMessageAsString, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&webMessageAsString))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2WebMessageReceivedEventArgs::TryGetWebMessageAsString", HRESULT: hr}
+	}
+
+	return coTaskMemString(webMessageAsString), nil
+}
+
+type (
+	// ICoreWebView2NavigationStartingEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2navigationstartingeventhandler
+	ICoreWebView2NavigationStartingEventHandler struct {
+		VTBL *ICoreWebView2NavigationStartingEventHandlerVTBL
+	}
+
+	// ICoreWebView2NavigationStartingEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2navigationstartingeventhandler
+	ICoreWebView2NavigationStartingEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2NavigationStartingEventHandler::Invoke.
+func (i *ICoreWebView2NavigationStartingEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2NavigationStartingEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2NavigationStartingEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2NavigationStartingEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2navigationstartingeventargs
+	ICoreWebView2NavigationStartingEventArgs struct {
+		VTBL *ICoreWebView2NavigationStartingEventArgsVTBL
+	}
+
+	// ICoreWebView2NavigationStartingEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2navigationstartingeventargs
+	ICoreWebView2NavigationStartingEventArgsVTBL struct {
+		BasicVTBL
+		GetURI             uintptr
+		GetIsUserInitiated uintptr
+		GetIsRedirected    uintptr
+		GetRequestHeaders  uintptr
+		GetCancel          uintptr
+		PutCancel          uintptr
+		GetNavigationID    uintptr
+	}
+)
+
+// GetURI calls ICoreWebView2NavigationStartingEventArgs::get_Uri.
+func (i *ICoreWebView2NavigationStartingEventArgs) GetURI() (string, error) {
+	var uri *uint16
+
+	if hr := call(i.VTBL.GetURI, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&uri))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2NavigationStartingEventArgs::get_Uri", HRESULT: hr}
+	}
+
+	return coTaskMemString(uri), nil
+}
+
+// GetIsUserInitiated calls ICoreWebView2NavigationStartingEventArgs::get_IsUserInitiated.
+func (i *ICoreWebView2NavigationStartingEventArgs) GetIsUserInitiated() (bool, error) {
+	var isUserInitiated int32
+
+	if hr := call(i.VTBL.GetIsUserInitiated, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isUserInitiated))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2NavigationStartingEventArgs::get_IsUserInitiated", HRESULT: hr}
+	}
+
+	return isUserInitiated != 0, nil
+}
+
+// GetIsRedirected calls ICoreWebView2NavigationStartingEventArgs::get_IsRedirected.
+func (i *ICoreWebView2NavigationStartingEventArgs) GetIsRedirected() (bool, error) {
+	var isRedirected int32
+
+	if hr := call(i.VTBL.GetIsRedirected, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isRedirected))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2NavigationStartingEventArgs::get_IsRedirected", HRESULT: hr}
+	}
+
+	return isRedirected != 0, nil
+}
+
+// GetRequestHeaders calls ICoreWebView2NavigationStartingEventArgs::get_RequestHeaders.
+func (i *ICoreWebView2NavigationStartingEventArgs) GetRequestHeaders() (*ICoreWebView2HttpRequestHeaders, error) {
+	var requestHeaders *ICoreWebView2HttpRequestHeaders
+
+	if hr := call(i.VTBL.GetRequestHeaders, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&requestHeaders))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2NavigationStartingEventArgs::get_RequestHeaders", HRESULT: hr}
+	}
+
+	return requestHeaders, nil
+}
+
+// GetCancel calls ICoreWebView2NavigationStartingEventArgs::get_Cancel.
+func (i *ICoreWebView2NavigationStartingEventArgs) GetCancel() (bool, error) {
+	var cancel int32
+
+	if hr := call(i.VTBL.GetCancel, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&cancel))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2NavigationStartingEventArgs::get_Cancel", HRESULT: hr}
+	}
+
+	return cancel != 0, nil
+}
+
+// PutCancel calls ICoreWebView2NavigationStartingEventArgs::put_Cancel.
+func (i *ICoreWebView2NavigationStartingEventArgs) PutCancel(cancel bool) error {
+	if hr := call(i.VTBL.PutCancel, uintptr(unsafe.Pointer(i)), boolToUintptr(cancel)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2NavigationStartingEventArgs::put_Cancel", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetNavigationID calls ICoreWebView2NavigationStartingEventArgs::get_NavigationId.
+func (i *ICoreWebView2NavigationStartingEventArgs) GetNavigationID() (uint64, error) {
+	var navigationId uint64
+
+	if hr := call(i.VTBL.GetNavigationID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&navigationId))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2NavigationStartingEventArgs::get_NavigationId", HRESULT: hr}
+	}
+
+	return navigationId, nil
+}
+
+type (
+	// ICoreWebView2ContentLoadingEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2contentloadingeventhandler
+	ICoreWebView2ContentLoadingEventHandler struct {
+		VTBL *ICoreWebView2ContentLoadingEventHandlerVTBL
+	}
+
+	// ICoreWebView2ContentLoadingEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2contentloadingeventhandler
+	ICoreWebView2ContentLoadingEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2ContentLoadingEventHandler::Invoke.
+func (i *ICoreWebView2ContentLoadingEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2ContentLoadingEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2ContentLoadingEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2ContentLoadingEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2contentloadingeventargs
+	ICoreWebView2ContentLoadingEventArgs struct {
+		VTBL *ICoreWebView2ContentLoadingEventArgsVTBL
+	}
+
+	// ICoreWebView2ContentLoadingEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2contentloadingeventargs
+	ICoreWebView2ContentLoadingEventArgsVTBL struct {
+		BasicVTBL
+		GetIsErrorPage  uintptr
+		GetNavigationID uintptr
+	}
+)
+
+// GetIsErrorPage calls ICoreWebView2ContentLoadingEventArgs::get_IsErrorPage.
+func (i *ICoreWebView2ContentLoadingEventArgs) GetIsErrorPage() (bool, error) {
+	var isErrorPage int32
+
+	if hr := call(i.VTBL.GetIsErrorPage, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isErrorPage))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2ContentLoadingEventArgs::get_IsErrorPage", HRESULT: hr}
+	}
+
+	return isErrorPage != 0, nil
+}
+
+// GetNavigationID calls ICoreWebView2ContentLoadingEventArgs::get_NavigationId.
+func (i *ICoreWebView2ContentLoadingEventArgs) GetNavigationID() (uint64, error) {
+	var navigationId uint64
+
+	if hr := call(i.VTBL.GetNavigationID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&navigationId))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2ContentLoadingEventArgs::get_NavigationId", HRESULT: hr}
+	}
+
+	return navigationId, nil
+}
+
+type (
+	// ICoreWebView2NavigationCompletedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2navigationcompletedeventhandler
+	ICoreWebView2NavigationCompletedEventHandler struct {
+		VTBL *ICoreWebView2NavigationCompletedEventHandlerVTBL
+	}
+
+	// ICoreWebView2NavigationCompletedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2navigationcompletedeventhandler
+	ICoreWebView2NavigationCompletedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2NavigationCompletedEventHandler::Invoke.
+func (i *ICoreWebView2NavigationCompletedEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2NavigationCompletedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2NavigationCompletedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2NavigationCompletedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2navigationcompletedeventargs
+	ICoreWebView2NavigationCompletedEventArgs struct {
+		VTBL *ICoreWebView2NavigationCompletedEventArgsVTBL
+	}
+
+	// ICoreWebView2NavigationCompletedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2navigationcompletedeventargs
+	ICoreWebView2NavigationCompletedEventArgsVTBL struct {
+		BasicVTBL
+		GetIsSuccess      uintptr
+		GetWebErrorStatus uintptr
+		GetNavigationID   uintptr
+	}
+)
+
+// GetIsSuccess calls ICoreWebView2NavigationCompletedEventArgs::get_IsSuccess.
+func (i *ICoreWebView2NavigationCompletedEventArgs) GetIsSuccess() (bool, error) {
+	var isSuccess int32
+
+	if hr := call(i.VTBL.GetIsSuccess, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isSuccess))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2NavigationCompletedEventArgs::get_IsSuccess", HRESULT: hr}
+	}
+
+	return isSuccess != 0, nil
+}
+
+// GetWebErrorStatus calls ICoreWebView2NavigationCompletedEventArgs::get_WebErrorStatus.
+func (i *ICoreWebView2NavigationCompletedEventArgs) GetWebErrorStatus() (COREWEBVIEW2_WEB_ERROR_STATUS, error) {
+	var webErrorStatus COREWEBVIEW2_WEB_ERROR_STATUS
+
+	if hr := call(i.VTBL.GetWebErrorStatus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&webErrorStatus))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2NavigationCompletedEventArgs::get_WebErrorStatus", HRESULT: hr}
+	}
+
+	return webErrorStatus, nil
+}
+
+// GetNavigationID calls ICoreWebView2NavigationCompletedEventArgs::get_NavigationId.
+func (i *ICoreWebView2NavigationCompletedEventArgs) GetNavigationID() (uint64, error) {
+	var navigationId uint64
+
+	if hr := call(i.VTBL.GetNavigationID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&navigationId))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2NavigationCompletedEventArgs::get_NavigationId", HRESULT: hr}
+	}
+
+	return navigationId, nil
+}
+
+type (
+	// ICoreWebView2WebResourceRequestedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourcerequestedeventhandler
+	ICoreWebView2WebResourceRequestedEventHandler struct {
+		VTBL *ICoreWebView2WebResourceRequestedEventHandlerVTBL
+	}
+
+	// ICoreWebView2WebResourceRequestedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourcerequestedeventhandler
+	ICoreWebView2WebResourceRequestedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2WebResourceRequestedEventHandler::Invoke.
+func (i *ICoreWebView2WebResourceRequestedEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2WebResourceRequestedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebResourceRequestedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2WebResourceRequestedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourcerequestedeventargs
+	ICoreWebView2WebResourceRequestedEventArgs struct {
+		VTBL *ICoreWebView2WebResourceRequestedEventArgsVTBL
+	}
+
+	// ICoreWebView2WebResourceRequestedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourcerequestedeventargs
+	ICoreWebView2WebResourceRequestedEventArgsVTBL struct {
+		BasicVTBL
+		GetRequest         uintptr
+		GetResponse        uintptr
+		PutResponse        uintptr
+		GetDeferral        uintptr
+		GetResourceContext uintptr
+	}
+)
+
+// GetRequest calls ICoreWebView2WebResourceRequestedEventArgs::get_Request.
+func (i *ICoreWebView2WebResourceRequestedEventArgs) GetRequest() (*ICoreWebView2WebResourceRequest, error) {
+	var request *ICoreWebView2WebResourceRequest
+
+	if hr := call(i.VTBL.GetRequest, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&request))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2WebResourceRequestedEventArgs::get_Request", HRESULT: hr}
+	}
+
+	return request, nil
+}
+
+// GetResponse calls ICoreWebView2WebResourceRequestedEventArgs::get_Response.
+func (i *ICoreWebView2WebResourceRequestedEventArgs) GetResponse() (*ICoreWebView2WebResourceResponse, error) {
+	var response *ICoreWebView2WebResourceResponse
+
+	if hr := call(i.VTBL.GetResponse, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&response))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2WebResourceRequestedEventArgs::get_Response", HRESULT: hr}
+	}
+
+	return response, nil
+}
+
+// PutResponse calls ICoreWebView2WebResourceRequestedEventArgs::put_Response.
+func (i *ICoreWebView2WebResourceRequestedEventArgs) PutResponse(response *ICoreWebView2WebResourceResponse) error {
+	if hr := call(i.VTBL.PutResponse, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(response))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebResourceRequestedEventArgs::put_Response", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetDeferral calls ICoreWebView2WebResourceRequestedEventArgs::GetDeferral.
+func (i *ICoreWebView2WebResourceRequestedEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var deferral *ICoreWebView2Deferral
+
+	if hr := call(i.VTBL.GetDeferral, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&deferral))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2WebResourceRequestedEventArgs::GetDeferral", HRESULT: hr}
+	}
+
+	return deferral, nil
+}
+
+// GetResourceContext calls ICoreWebView2WebResourceRequestedEventArgs::get_ResourceContext.
+func (i *ICoreWebView2WebResourceRequestedEventArgs) GetResourceContext() (COREWEBVIEW2_WEB_RESOURCE_CONTEXT, error) {
+	var context COREWEBVIEW2_WEB_RESOURCE_CONTEXT
+
+	if hr := call(i.VTBL.GetResourceContext, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&context))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2WebResourceRequestedEventArgs::get_ResourceContext", HRESULT: hr}
+	}
+
+	return context, nil
+}
+
+type (
+	// ICoreWebView2WebResourceRequest implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourcerequest
+	ICoreWebView2WebResourceRequest struct {
+		VTBL *ICoreWebView2WebResourceRequestVTBL
+	}
+
+	// ICoreWebView2WebResourceRequestVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourcerequest
+	ICoreWebView2WebResourceRequestVTBL struct {
+		BasicVTBL
+		GetURI     uintptr
+		PutURI     uintptr
+		GetMethod  uintptr
+		PutMethod  uintptr
+		GetContent uintptr
+		PutContent uintptr
+		GetHeaders uintptr
+	}
+)
+
+// GetURI calls ICoreWebView2WebResourceRequest::get_Uri.
+func (i *ICoreWebView2WebResourceRequest) GetURI() (string, error) {
+	var uri *uint16
+
+	if hr := call(i.VTBL.GetURI, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&uri))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2WebResourceRequest::get_Uri", HRESULT: hr}
+	}
+
+	return coTaskMemString(uri), nil
+}
+
+// PutURI calls ICoreWebView2WebResourceRequest::put_Uri.
+func (i *ICoreWebView2WebResourceRequest) PutURI(uri string) error {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2WebResourceRequest::put_Uri: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutURI, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebResourceRequest::put_Uri", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetMethod calls ICoreWebView2WebResourceRequest::get_Method.
+func (i *ICoreWebView2WebResourceRequest) GetMethod() (string, error) {
+	var method *uint16
+
+	if hr := call(i.VTBL.GetMethod, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&method))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2WebResourceRequest::get_Method", HRESULT: hr}
+	}
+
+	return coTaskMemString(method), nil
+}
+
+// PutMethod calls ICoreWebView2WebResourceRequest::put_Method.
+func (i *ICoreWebView2WebResourceRequest) PutMethod(method string) error {
+	methodPtr, err := windows.UTF16PtrFromString(method)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2WebResourceRequest::put_Method: invalid method: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutMethod, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(methodPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebResourceRequest::put_Method", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetContent calls ICoreWebView2WebResourceRequest::get_Content.
+func (i *ICoreWebView2WebResourceRequest) GetContent() (*IStream, error) {
+	var content *IStream
+
+	if hr := call(i.VTBL.GetContent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&content))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2WebResourceRequest::get_Content", HRESULT: hr}
+	}
+
+	return content, nil
+}
+
+// PutContent calls ICoreWebView2WebResourceRequest::put_Content.
+func (i *ICoreWebView2WebResourceRequest) PutContent(content *IStream) error {
+	if hr := call(i.VTBL.PutContent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(content))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebResourceRequest::put_Content", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetHeaders calls ICoreWebView2WebResourceRequest::get_Headers.
+func (i *ICoreWebView2WebResourceRequest) GetHeaders() (*ICoreWebView2HttpRequestHeaders, error) {
+	var headers *ICoreWebView2HttpRequestHeaders
+
+	if hr := call(i.VTBL.GetHeaders, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&headers))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2WebResourceRequest::get_Headers", HRESULT: hr}
+	}
+
+	return headers, nil
+}
+
+type (
+	// ICoreWebView2WebResourceResponse implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourceresponse
+	ICoreWebView2WebResourceResponse struct {
+		VTBL *ICoreWebView2WebResourceResponseVTBL
+	}
+
+	// ICoreWebView2WebResourceResponseVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourceresponse
+	ICoreWebView2WebResourceResponseVTBL struct {
+		BasicVTBL
+		GetContent      uintptr
+		PutContent      uintptr
+		GetHeaders      uintptr
+		GetStatusCode   uintptr
+		PutStatusCode   uintptr
+		GetReasonPhrase uintptr
+		PutReasonPhrase uintptr
+	}
+)
+
+// GetContent calls ICoreWebView2WebResourceResponse::get_Content.
+func (i *ICoreWebView2WebResourceResponse) GetContent() (*IStream, error) {
+	var content *IStream
+
+	if hr := call(i.VTBL.GetContent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&content))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2WebResourceResponse::get_Content", HRESULT: hr}
+	}
+
+	return content, nil
+}
+
+// PutContent calls ICoreWebView2WebResourceResponse::put_Content.
+func (i *ICoreWebView2WebResourceResponse) PutContent(content *IStream) error {
+	if hr := call(i.VTBL.PutContent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(content))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebResourceResponse::put_Content", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetHeaders calls ICoreWebView2WebResourceResponse::get_Headers.
+func (i *ICoreWebView2WebResourceResponse) GetHeaders() (*ICoreWebView2HttpResponseHeaders, error) {
+	var headers *ICoreWebView2HttpResponseHeaders
+
+	if hr := call(i.VTBL.GetHeaders, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&headers))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2WebResourceResponse::get_Headers", HRESULT: hr}
+	}
+
+	return headers, nil
+}
+
+// GetStatusCode calls ICoreWebView2WebResourceResponse::get_StatusCode.
+func (i *ICoreWebView2WebResourceResponse) GetStatusCode() (int32, error) {
+	var statusCode int32
+
+	if hr := call(i.VTBL.GetStatusCode, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&statusCode))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2WebResourceResponse::get_StatusCode", HRESULT: hr}
+	}
+
+	return statusCode, nil
+}
+
+// PutStatusCode calls ICoreWebView2WebResourceResponse::put_StatusCode.
+func (i *ICoreWebView2WebResourceResponse) PutStatusCode(statusCode int32) error {
+	if hr := call(i.VTBL.PutStatusCode, uintptr(unsafe.Pointer(i)), uintptr(statusCode)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebResourceResponse::put_StatusCode", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetReasonPhrase calls ICoreWebView2WebResourceResponse::get_ReasonPhrase.
+func (i *ICoreWebView2WebResourceResponse) GetReasonPhrase() (string, error) {
+	var reasonPhrase *uint16
+
+	if hr := call(i.VTBL.GetReasonPhrase, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&reasonPhrase))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2WebResourceResponse::get_ReasonPhrase", HRESULT: hr}
+	}
+
+	return coTaskMemString(reasonPhrase), nil
+}
+
+// PutReasonPhrase calls ICoreWebView2WebResourceResponse::put_ReasonPhrase.
+func (i *ICoreWebView2WebResourceResponse) PutReasonPhrase(reasonPhrase string) error {
+	reasonPhrasePtr, err := windows.UTF16PtrFromString(reasonPhrase)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2WebResourceResponse::put_ReasonPhrase: invalid reasonPhrase: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutReasonPhrase, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(reasonPhrasePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebResourceResponse::put_ReasonPhrase", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2HttpRequestHeaders implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2httprequestheaders
+	ICoreWebView2HttpRequestHeaders struct {
+		VTBL *ICoreWebView2HttpRequestHeadersVTBL
+	}
+
+	// ICoreWebView2HttpRequestHeadersVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2httprequestheaders
+	ICoreWebView2HttpRequestHeadersVTBL struct {
+		BasicVTBL
+		GetHeader    uintptr
+		GetHeaders   uintptr
+		Contains     uintptr
+		SetHeader    uintptr
+		RemoveHeader uintptr
+		GetIterator  uintptr
+	}
+)
+
+// GetHeader calls ICoreWebView2HttpRequestHeaders::GetHeader.
+func (i *ICoreWebView2HttpRequestHeaders) GetHeader(name string) (string, error) {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return "", fmt.Errorf("ICoreWebView2HttpRequestHeaders::GetHeader: invalid name: %w", err)
+	}
+
+	var value *uint16
+
+	if hr := call(i.VTBL.GetHeader, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2HttpRequestHeaders::GetHeader", HRESULT: hr}
+	}
+
+	return coTaskMemString(value), nil
+}
+
+// GetHeaders calls ICoreWebView2HttpRequestHeaders::GetHeaders.
+func (i *ICoreWebView2HttpRequestHeaders) GetHeaders(name string) (*ICoreWebView2HttpHeadersCollectionIterator, error) {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2HttpRequestHeaders::GetHeaders: invalid name: %w", err)
+	}
+
+	var iterator *ICoreWebView2HttpHeadersCollectionIterator
+
+	if hr := call(i.VTBL.GetHeaders, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(&iterator))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2HttpRequestHeaders::GetHeaders", HRESULT: hr}
+	}
+
+	return iterator, nil
+}
+
+// Contains calls ICoreWebView2HttpRequestHeaders::Contains.
+func (i *ICoreWebView2HttpRequestHeaders) Contains(name string) (bool, error) {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return false, fmt.Errorf("ICoreWebView2HttpRequestHeaders::Contains: invalid name: %w", err)
+	}
+
+	var contains int32
+
+	if hr := call(i.VTBL.Contains, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(&contains))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2HttpRequestHeaders::Contains", HRESULT: hr}
+	}
+
+	return contains != 0, nil
+}
+
+// SetHeader calls ICoreWebView2HttpRequestHeaders::SetHeader.
+func (i *ICoreWebView2HttpRequestHeaders) SetHeader(name string, value string) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2HttpRequestHeaders::SetHeader: invalid name: %w", err)
+	}
+
+	valuePtr, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2HttpRequestHeaders::SetHeader: invalid value: %w", err)
+	}
+
+	if hr := call(i.VTBL.SetHeader, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(valuePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2HttpRequestHeaders::SetHeader", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveHeader calls ICoreWebView2HttpRequestHeaders::RemoveHeader.
+func (i *ICoreWebView2HttpRequestHeaders) RemoveHeader(name string) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2HttpRequestHeaders::RemoveHeader: invalid name: %w", err)
+	}
+
+	if hr := call(i.VTBL.RemoveHeader, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2HttpRequestHeaders::RemoveHeader", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIterator calls ICoreWebView2HttpRequestHeaders::GetIterator.
+func (i *ICoreWebView2HttpRequestHeaders) GetIterator() (*ICoreWebView2HttpHeadersCollectionIterator, error) {
+	var iterator *ICoreWebView2HttpHeadersCollectionIterator
+
+	if hr := call(i.VTBL.GetIterator, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&iterator))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2HttpRequestHeaders::GetIterator", HRESULT: hr}
+	}
+
+	return iterator, nil
+}
+
+type (
+	// ICoreWebView2HttpResponseHeaders implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2httpresponseheaders
+	ICoreWebView2HttpResponseHeaders struct {
+		VTBL *ICoreWebView2HttpResponseHeadersVTBL
+	}
+
+	// ICoreWebView2HttpResponseHeadersVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2httpresponseheaders
+	ICoreWebView2HttpResponseHeadersVTBL struct {
+		BasicVTBL
+		AppendHeader uintptr
+		Contains     uintptr
+		GetHeader    uintptr
+		GetHeaders   uintptr
+		GetIterator  uintptr
+	}
+)
+
+// AppendHeader calls ICoreWebView2HttpResponseHeaders::AppendHeader.
+func (i *ICoreWebView2HttpResponseHeaders) AppendHeader(name string, value string) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2HttpResponseHeaders::AppendHeader: invalid name: %w", err)
+	}
+
+	valuePtr, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2HttpResponseHeaders::AppendHeader: invalid value: %w", err)
+	}
+
+	if hr := call(i.VTBL.AppendHeader, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(valuePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2HttpResponseHeaders::AppendHeader", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// Contains calls ICoreWebView2HttpResponseHeaders::Contains.
+func (i *ICoreWebView2HttpResponseHeaders) Contains(name string) (bool, error) {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return false, fmt.Errorf("ICoreWebView2HttpResponseHeaders::Contains: invalid name: %w", err)
+	}
+
+	var contains int32
+
+	if hr := call(i.VTBL.Contains, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(&contains))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2HttpResponseHeaders::Contains", HRESULT: hr}
+	}
+
+	return contains != 0, nil
+}
+
+// GetHeader calls ICoreWebView2HttpResponseHeaders::GetHeader.
+func (i *ICoreWebView2HttpResponseHeaders) GetHeader(name string) (string, error) {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return "", fmt.Errorf("ICoreWebView2HttpResponseHeaders::GetHeader: invalid name: %w", err)
+	}
+
+	var value *uint16
+
+	if hr := call(i.VTBL.GetHeader, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2HttpResponseHeaders::GetHeader", HRESULT: hr}
+	}
+
+	return coTaskMemString(value), nil
+}
+
+// GetHeaders calls ICoreWebView2HttpResponseHeaders::GetHeaders.
+func (i *ICoreWebView2HttpResponseHeaders) GetHeaders(name string) (*ICoreWebView2HttpHeadersCollectionIterator, error) {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2HttpResponseHeaders::GetHeaders: invalid name: %w", err)
+	}
+
+	var iterator *ICoreWebView2HttpHeadersCollectionIterator
+
+	if hr := call(i.VTBL.GetHeaders, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(&iterator))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2HttpResponseHeaders::GetHeaders", HRESULT: hr}
+	}
+
+	return iterator, nil
+}
+
+// GetIterator calls ICoreWebView2HttpResponseHeaders::GetIterator.
+func (i *ICoreWebView2HttpResponseHeaders) GetIterator() (*ICoreWebView2HttpHeadersCollectionIterator, error) {
+	var iterator *ICoreWebView2HttpHeadersCollectionIterator
+
+	if hr := call(i.VTBL.GetIterator, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&iterator))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2HttpResponseHeaders::GetIterator", HRESULT: hr}
+	}
+
+	return iterator, nil
+}
+
+type (
+	// ICoreWebView2HttpHeadersCollectionIterator implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2httpheaderscollectioniterator
+	ICoreWebView2HttpHeadersCollectionIterator struct {
+		VTBL *ICoreWebView2HttpHeadersCollectionIteratorVTBL
+	}
+
+	// ICoreWebView2HttpHeadersCollectionIteratorVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2httpheaderscollectioniterator
+	ICoreWebView2HttpHeadersCollectionIteratorVTBL struct {
+		BasicVTBL
+		GetCurrentHeader    uintptr
+		GetHasCurrentHeader uintptr
+		MoveNext            uintptr
+	}
+)
+
+// GetCurrentHeader calls ICoreWebView2HttpHeadersCollectionIterator::GetCurrentHeader.
+func (i *ICoreWebView2HttpHeadersCollectionIterator) GetCurrentHeader() (string, string, error) {
+	var name *uint16
+	var value *uint16
+
+	if hr := call(i.VTBL.GetCurrentHeader, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&name)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return "", "", &Error{Method: "ICoreWebView2HttpHeadersCollectionIterator::GetCurrentHeader", HRESULT: hr}
+	}
+
+	return coTaskMemString(name), coTaskMemString(value), nil
+}
+
+// GetHasCurrentHeader calls ICoreWebView2HttpHeadersCollectionIterator::get_HasCurrentHeader.
+func (i *ICoreWebView2HttpHeadersCollectionIterator) GetHasCurrentHeader() (bool, error) {
+	var hasCurrent int32
+
+	if hr := call(i.VTBL.GetHasCurrentHeader, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&hasCurrent))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2HttpHeadersCollectionIterator::get_HasCurrentHeader", HRESULT: hr}
+	}
+
+	return hasCurrent != 0, nil
+}
+
+// MoveNext calls ICoreWebView2HttpHeadersCollectionIterator::MoveNext.
+func (i *ICoreWebView2HttpHeadersCollectionIterator) MoveNext() (bool, error) {
+	var hasNext int32
+
+	if hr := call(i.VTBL.MoveNext, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&hasNext))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2HttpHeadersCollectionIterator::MoveNext", HRESULT: hr}
+	}
+
+	return hasNext != 0, nil
+}
+
+type (
+	// ICoreWebView2Deferral implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2deferral
+	ICoreWebView2Deferral struct {
+		VTBL *ICoreWebView2DeferralVTBL
+	}
+
+	// ICoreWebView2DeferralVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2deferral
+	ICoreWebView2DeferralVTBL struct {
+		BasicVTBL
+		Complete uintptr
+	}
+)
+
+// Complete calls ICoreWebView2Deferral::Complete.
+func (i *ICoreWebView2Deferral) Complete() error {
+	if hr := call(i.VTBL.Complete, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Deferral::Complete", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2AcceleratorKeyPressedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2acceleratorkeypressedeventargs
+	ICoreWebView2AcceleratorKeyPressedEventArgs struct {
+		VTBL *ICoreWebView2AcceleratorKeyPressedEventArgsVTBL
+	}
+
+	// ICoreWebView2AcceleratorKeyPressedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2acceleratorkeypressedeventargs
+	ICoreWebView2AcceleratorKeyPressedEventArgsVTBL struct {
+		BasicVTBL
+		GetKeyEventKind      uintptr
+		GetVirtualKey        uintptr
+		GetKeyEventLParam    uintptr
+		GetPhysicalKeyStatus uintptr
+		GetHandled           uintptr
+		PutHandled           uintptr
+	}
+)
+
+// GetKeyEventKind calls ICoreWebView2AcceleratorKeyPressedEventArgs::get_KeyEventKind.
+func (i *ICoreWebView2AcceleratorKeyPressedEventArgs) GetKeyEventKind() (COREWEBVIEW2_KEY_EVENT_KIND, error) {
+	var keyEventKind COREWEBVIEW2_KEY_EVENT_KIND
+
+	if hr := call(i.VTBL.GetKeyEventKind, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&keyEventKind))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2AcceleratorKeyPressedEventArgs::get_KeyEventKind", HRESULT: hr}
+	}
+
+	return keyEventKind, nil
+}
+
+// GetVirtualKey calls ICoreWebView2AcceleratorKeyPressedEventArgs::get_VirtualKey.
+func (i *ICoreWebView2AcceleratorKeyPressedEventArgs) GetVirtualKey() (uint32, error) {
+	var virtualKey uint32
+
+	if hr := call(i.VTBL.GetVirtualKey, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&virtualKey))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2AcceleratorKeyPressedEventArgs::get_VirtualKey", HRESULT: hr}
+	}
+
+	return virtualKey, nil
+}
+
+// GetKeyEventLParam calls ICoreWebView2AcceleratorKeyPressedEventArgs::get_KeyEventLParam.
+func (i *ICoreWebView2AcceleratorKeyPressedEventArgs) GetKeyEventLParam() (int32, error) {
+	var lParam int32
+
+	if hr := call(i.VTBL.GetKeyEventLParam, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&lParam))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2AcceleratorKeyPressedEventArgs::get_KeyEventLParam", HRESULT: hr}
+	}
+
+	return lParam, nil
+}
+
+// GetPhysicalKeyStatus calls ICoreWebView2AcceleratorKeyPressedEventArgs::get_PhysicalKeyStatus.
+func (i *ICoreWebView2AcceleratorKeyPressedEventArgs) GetPhysicalKeyStatus() (COREWEBVIEW2_PHYSICAL_KEY_STATUS, error) {
+	var physicalKeyStatus COREWEBVIEW2_PHYSICAL_KEY_STATUS
+
+	if hr := call(i.VTBL.GetPhysicalKeyStatus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&physicalKeyStatus))); hr.Failed() {
+		return COREWEBVIEW2_PHYSICAL_KEY_STATUS{}, &Error{Method: "ICoreWebView2AcceleratorKeyPressedEventArgs::get_PhysicalKeyStatus", HRESULT: hr}
+	}
+
+	return physicalKeyStatus, nil
+}
+
+// GetHandled calls ICoreWebView2AcceleratorKeyPressedEventArgs::get_Handled.
+func (i *ICoreWebView2AcceleratorKeyPressedEventArgs) GetHandled() (bool, error) {
+	var handled int32
+
+	if hr := call(i.VTBL.GetHandled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&handled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2AcceleratorKeyPressedEventArgs::get_Handled", HRESULT: hr}
+	}
+
+	return handled != 0, nil
+}
+
+// PutHandled calls ICoreWebView2AcceleratorKeyPressedEventArgs::put_Handled.
+func (i *ICoreWebView2AcceleratorKeyPressedEventArgs) PutHandled(handled bool) error {
+	if hr := call(i.VTBL.PutHandled, uintptr(unsafe.Pointer(i)), boolToUintptr(handled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2AcceleratorKeyPressedEventArgs::put_Handled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2AcceleratorKeyPressedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2acceleratorkeypressedeventhandler
+	ICoreWebView2AcceleratorKeyPressedEventHandler struct {
+		VTBL *ICoreWebView2AcceleratorKeyPressedEventHandlerVTBL
+	}
+
+	// ICoreWebView2AcceleratorKeyPressedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2acceleratorkeypressedeventhandler
+	ICoreWebView2AcceleratorKeyPressedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2AcceleratorKeyPressedEventHandler::Invoke.
+func (i *ICoreWebView2AcceleratorKeyPressedEventHandler) Invoke(sender *ICoreWebView2Controller, args *ICoreWebView2AcceleratorKeyPressedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2AcceleratorKeyPressedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2CallDevToolsProtocolMethodCompletedHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2calldevtoolsprotocolmethodcompletedhandler
+	ICoreWebView2CallDevToolsProtocolMethodCompletedHandler struct {
+		VTBL *ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerVTBL
+	}
+
+	// ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2calldevtoolsprotocolmethodcompletedhandler
+	ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2CallDevToolsProtocolMethodCompletedHandler::Invoke.
+func (i *ICoreWebView2CallDevToolsProtocolMethodCompletedHandler) Invoke(errorCode hresult.HRESULT, returnObjectAsJson string) error {
+	returnObjectAsJsonPtr, err := windows.UTF16PtrFromString(returnObjectAsJson)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2CallDevToolsProtocolMethodCompletedHandler::Invoke: invalid returnObjectAsJson: %w", err)
+	}
+
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(errorCode), uintptr(unsafe.Pointer(returnObjectAsJsonPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CallDevToolsProtocolMethodCompletedHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2CapturePreviewCompletedHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2capturepreviewcompletedhandler
+	ICoreWebView2CapturePreviewCompletedHandler struct {
+		VTBL *ICoreWebView2CapturePreviewCompletedHandlerVTBL
+	}
+
+	// ICoreWebView2CapturePreviewCompletedHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2capturepreviewcompletedhandler
+	ICoreWebView2CapturePreviewCompletedHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2CapturePreviewCompletedHandler::Invoke.
+func (i *ICoreWebView2CapturePreviewCompletedHandler) Invoke(errorCode hresult.HRESULT) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(errorCode)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CapturePreviewCompletedHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2ContainsFullScreenElementChangedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2containsfullscreenelementchangedeventhandler
+	ICoreWebView2ContainsFullScreenElementChangedEventHandler struct {
+		VTBL *ICoreWebView2ContainsFullScreenElementChangedEventHandlerVTBL
+	}
+
+	// ICoreWebView2ContainsFullScreenElementChangedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2containsfullscreenelementchangedeventhandler
+	ICoreWebView2ContainsFullScreenElementChangedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2ContainsFullScreenElementChangedEventHandler::Invoke.
+func (i *ICoreWebView2ContainsFullScreenElementChangedEventHandler) Invoke(sender *ICoreWebView2, args *IUnknown) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2ContainsFullScreenElementChangedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Cookie implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2cookie
+	ICoreWebView2Cookie struct {
+		VTBL *ICoreWebView2CookieVTBL
+	}
+
+	// ICoreWebView2CookieVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2cookie
+	ICoreWebView2CookieVTBL struct {
+		BasicVTBL
+		GetName       uintptr
+		GetValue      uintptr
+		PutValue      uintptr
+		GetDomain     uintptr
+		GetPath       uintptr
+		GetExpires    uintptr
+		PutExpires    uintptr
+		GetIsHttpOnly uintptr
+		PutIsHttpOnly uintptr
+		GetSameSite   uintptr
+		PutSameSite   uintptr
+		GetIsSecure   uintptr
+		PutIsSecure   uintptr
+		GetIsSession  uintptr
+	}
+)
+
+// GetName calls ICoreWebView2Cookie::get_Name.
+func (i *ICoreWebView2Cookie) GetName() (string, error) {
+	var name *uint16
+
+	if hr := call(i.VTBL.GetName, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&name))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Cookie::get_Name", HRESULT: hr}
+	}
+
+	return coTaskMemString(name), nil
+}
+
+// GetValue calls ICoreWebView2Cookie::get_Value.
+func (i *ICoreWebView2Cookie) GetValue() (string, error) {
+	var value *uint16
+
+	if hr := call(i.VTBL.GetValue, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Cookie::get_Value", HRESULT: hr}
+	}
+
+	return coTaskMemString(value), nil
+}
+
+// PutValue calls ICoreWebView2Cookie::put_Value.
+func (i *ICoreWebView2Cookie) PutValue(value string) error {
+	valuePtr, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2Cookie::put_Value: invalid value: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutValue, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(valuePtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Cookie::put_Value", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetDomain calls ICoreWebView2Cookie::get_Domain.
+func (i *ICoreWebView2Cookie) GetDomain() (string, error) {
+	var domain *uint16
+
+	if hr := call(i.VTBL.GetDomain, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&domain))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Cookie::get_Domain", HRESULT: hr}
+	}
+
+	return coTaskMemString(domain), nil
+}
+
+// GetPath calls ICoreWebView2Cookie::get_Path.
+func (i *ICoreWebView2Cookie) GetPath() (string, error) {
+	var path *uint16
+
+	if hr := call(i.VTBL.GetPath, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&path))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Cookie::get_Path", HRESULT: hr}
+	}
+
+	return coTaskMemString(path), nil
+}
+
+// GetExpires calls ICoreWebView2Cookie::get_Expires.
+func (i *ICoreWebView2Cookie) GetExpires() (float64, error) {
+	var expires float64
+
+	if hr := call(i.VTBL.GetExpires, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&expires))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Cookie::get_Expires", HRESULT: hr}
+	}
+
+	return expires, nil
+}
+
+// PutExpires isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// GetIsHttpOnly calls ICoreWebView2Cookie::get_IsHttpOnly.
+func (i *ICoreWebView2Cookie) GetIsHttpOnly() (bool, error) {
+	var isHttpOnly int32
+
+	if hr := call(i.VTBL.GetIsHttpOnly, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isHttpOnly))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Cookie::get_IsHttpOnly", HRESULT: hr}
+	}
+
+	return isHttpOnly != 0, nil
+}
+
+// PutIsHttpOnly calls ICoreWebView2Cookie::put_IsHttpOnly.
+func (i *ICoreWebView2Cookie) PutIsHttpOnly(isHttpOnly bool) error {
+	if hr := call(i.VTBL.PutIsHttpOnly, uintptr(unsafe.Pointer(i)), boolToUintptr(isHttpOnly)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Cookie::put_IsHttpOnly", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetSameSite calls ICoreWebView2Cookie::get_SameSite.
+func (i *ICoreWebView2Cookie) GetSameSite() (COREWEBVIEW2_COOKIE_SAME_SITE_KIND, error) {
+	var sameSite COREWEBVIEW2_COOKIE_SAME_SITE_KIND
+
+	if hr := call(i.VTBL.GetSameSite, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&sameSite))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Cookie::get_SameSite", HRESULT: hr}
+	}
+
+	return sameSite, nil
+}
+
+// PutSameSite calls ICoreWebView2Cookie::put_SameSite.
+func (i *ICoreWebView2Cookie) PutSameSite(sameSite COREWEBVIEW2_COOKIE_SAME_SITE_KIND) error {
+	if hr := call(i.VTBL.PutSameSite, uintptr(unsafe.Pointer(i)), uintptr(sameSite)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Cookie::put_SameSite", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsSecure calls ICoreWebView2Cookie::get_IsSecure.
+func (i *ICoreWebView2Cookie) GetIsSecure() (bool, error) {
+	var isSecure int32
+
+	if hr := call(i.VTBL.GetIsSecure, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isSecure))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Cookie::get_IsSecure", HRESULT: hr}
+	}
+
+	return isSecure != 0, nil
+}
+
+// PutIsSecure calls ICoreWebView2Cookie::put_IsSecure.
+func (i *ICoreWebView2Cookie) PutIsSecure(isSecure bool) error {
+	if hr := call(i.VTBL.PutIsSecure, uintptr(unsafe.Pointer(i)), boolToUintptr(isSecure)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Cookie::put_IsSecure", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsSession calls ICoreWebView2Cookie::get_IsSession.
+func (i *ICoreWebView2Cookie) GetIsSession() (bool, error) {
+	var isSession int32
+
+	if hr := call(i.VTBL.GetIsSession, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isSession))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Cookie::get_IsSession", HRESULT: hr}
+	}
+
+	return isSession != 0, nil
+}
+
+type (
+	// ICoreWebView2CookieList implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2cookielist
+	ICoreWebView2CookieList struct {
+		VTBL *ICoreWebView2CookieListVTBL
+	}
+
+	// ICoreWebView2CookieListVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2cookielist
+	ICoreWebView2CookieListVTBL struct {
+		BasicVTBL
+		GetCount        uintptr
+		GetValueAtIndex uintptr
+	}
+)
+
+// GetCount calls ICoreWebView2CookieList::get_Count.
+func (i *ICoreWebView2CookieList) GetCount() (uint32, error) {
+	var count uint32
+
+	if hr := call(i.VTBL.GetCount, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&count))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2CookieList::get_Count", HRESULT: hr}
+	}
+
+	return count, nil
+}
+
+// GetValueAtIndex calls ICoreWebView2CookieList::GetValueAtIndex.
+func (i *ICoreWebView2CookieList) GetValueAtIndex(index uint32) (*ICoreWebView2Cookie, error) {
+	var cookie *ICoreWebView2Cookie
+
+	if hr := call(i.VTBL.GetValueAtIndex, uintptr(unsafe.Pointer(i)), uintptr(index), uintptr(unsafe.Pointer(&cookie))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2CookieList::GetValueAtIndex", HRESULT: hr}
+	}
+
+	return cookie, nil
+}
+
+type (
+	// ICoreWebView2CookieManager implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2cookiemanager
+	ICoreWebView2CookieManager struct {
+		VTBL *ICoreWebView2CookieManagerVTBL
+	}
+
+	// ICoreWebView2CookieManagerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2cookiemanager
+	ICoreWebView2CookieManagerVTBL struct {
+		BasicVTBL
+		CreateCookie                   uintptr
+		CopyCookie                     uintptr
+		GetCookies                     uintptr
+		AddOrUpdateCookie              uintptr
+		DeleteCookie                   uintptr
+		DeleteCookies                  uintptr
+		DeleteCookiesWithDomainAndPath uintptr
+		DeleteAllCookies               uintptr
+	}
+)
+
+// CreateCookie calls ICoreWebView2CookieManager::CreateCookie.
+func (i *ICoreWebView2CookieManager) CreateCookie(name string, value string, domain string, path string) (*ICoreWebView2Cookie, error) {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2CookieManager::CreateCookie: invalid name: %w", err)
+	}
+
+	valuePtr, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2CookieManager::CreateCookie: invalid value: %w", err)
+	}
+
+	domainPtr, err := windows.UTF16PtrFromString(domain)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2CookieManager::CreateCookie: invalid domain: %w", err)
+	}
+
+	pathPtr, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2CookieManager::CreateCookie: invalid path: %w", err)
+	}
+
+	var cookie *ICoreWebView2Cookie
+
+	if hr := call(i.VTBL.CreateCookie, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(valuePtr)), uintptr(unsafe.Pointer(domainPtr)), uintptr(unsafe.Pointer(pathPtr)), uintptr(unsafe.Pointer(&cookie))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2CookieManager::CreateCookie", HRESULT: hr}
+	}
+
+	return cookie, nil
+}
+
+// CopyCookie calls ICoreWebView2CookieManager::CopyCookie.
+func (i *ICoreWebView2CookieManager) CopyCookie(cookieParam *ICoreWebView2Cookie) (*ICoreWebView2Cookie, error) {
+	var cookie *ICoreWebView2Cookie
+
+	if hr := call(i.VTBL.CopyCookie, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(cookieParam)), uintptr(unsafe.Pointer(&cookie))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2CookieManager::CopyCookie", HRESULT: hr}
+	}
+
+	return cookie, nil
+}
+
+// GetCookies calls ICoreWebView2CookieManager::GetCookies.
+func (i *ICoreWebView2CookieManager) GetCookies(uri string, handler *Handler) error {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2CookieManager::GetCookies: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.GetCookies, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CookieManager::GetCookies", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddOrUpdateCookie calls ICoreWebView2CookieManager::AddOrUpdateCookie.
+func (i *ICoreWebView2CookieManager) AddOrUpdateCookie(cookie *ICoreWebView2Cookie) error {
+	if hr := call(i.VTBL.AddOrUpdateCookie, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(cookie))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CookieManager::AddOrUpdateCookie", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// DeleteCookie calls ICoreWebView2CookieManager::DeleteCookie.
+func (i *ICoreWebView2CookieManager) DeleteCookie(cookie *ICoreWebView2Cookie) error {
+	if hr := call(i.VTBL.DeleteCookie, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(cookie))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CookieManager::DeleteCookie", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// DeleteCookies calls ICoreWebView2CookieManager::DeleteCookies.
+func (i *ICoreWebView2CookieManager) DeleteCookies(name string, uri string) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2CookieManager::DeleteCookies: invalid name: %w", err)
+	}
+
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2CookieManager::DeleteCookies: invalid uri: %w", err)
+	}
+
+	if hr := call(i.VTBL.DeleteCookies, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(uriPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CookieManager::DeleteCookies", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// DeleteCookiesWithDomainAndPath calls ICoreWebView2CookieManager::DeleteCookiesWithDomainAndPath.
+func (i *ICoreWebView2CookieManager) DeleteCookiesWithDomainAndPath(name string, domain string, path string) error {
+	namePtr, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2CookieManager::DeleteCookiesWithDomainAndPath: invalid name: %w", err)
+	}
+
+	domainPtr, err := windows.UTF16PtrFromString(domain)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2CookieManager::DeleteCookiesWithDomainAndPath: invalid domain: %w", err)
+	}
+
+	pathPtr, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2CookieManager::DeleteCookiesWithDomainAndPath: invalid path: %w", err)
+	}
+
+	if hr := call(i.VTBL.DeleteCookiesWithDomainAndPath, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(domainPtr)), uintptr(unsafe.Pointer(pathPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CookieManager::DeleteCookiesWithDomainAndPath", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// DeleteAllCookies calls ICoreWebView2CookieManager::DeleteAllCookies.
+func (i *ICoreWebView2CookieManager) DeleteAllCookies() error {
+	if hr := call(i.VTBL.DeleteAllCookies, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CookieManager::DeleteAllCookies", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2GetCookiesCompletedHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2getcookiescompletedhandler
+	ICoreWebView2GetCookiesCompletedHandler struct {
+		VTBL *ICoreWebView2GetCookiesCompletedHandlerVTBL
+	}
+
+	// ICoreWebView2GetCookiesCompletedHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2getcookiescompletedhandler
+	ICoreWebView2GetCookiesCompletedHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2GetCookiesCompletedHandler::Invoke.
+func (i *ICoreWebView2GetCookiesCompletedHandler) Invoke(result hresult.HRESULT, cookieList *ICoreWebView2CookieList) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(result), uintptr(unsafe.Pointer(cookieList))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2GetCookiesCompletedHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2DevToolsProtocolEventReceivedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2devtoolsprotocoleventreceivedeventargs
+	ICoreWebView2DevToolsProtocolEventReceivedEventArgs struct {
+		VTBL *ICoreWebView2DevToolsProtocolEventReceivedEventArgsVTBL
+	}
+
+	// ICoreWebView2DevToolsProtocolEventReceivedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2devtoolsprotocoleventreceivedeventargs
+	ICoreWebView2DevToolsProtocolEventReceivedEventArgsVTBL struct {
+		BasicVTBL
+		GetParameterObjectAsJSON uintptr
+	}
+)
+
+// GetParameterObjectAsJSON calls ICoreWebView2DevToolsProtocolEventReceivedEventArgs::get_ParameterObjectAsJson.
+func (i *ICoreWebView2DevToolsProtocolEventReceivedEventArgs) GetParameterObjectAsJSON() (string, error) {
+	var parameterObjectAsJson *uint16
+
+	if hr := call(i.VTBL.GetParameterObjectAsJSON, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&parameterObjectAsJson))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2DevToolsProtocolEventReceivedEventArgs::get_ParameterObjectAsJson", HRESULT: hr}
+	}
+
+	return coTaskMemString(parameterObjectAsJson), nil
+}
+
+type (
+	// ICoreWebView2DevToolsProtocolEventReceivedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2devtoolsprotocoleventreceivedeventhandler
+	ICoreWebView2DevToolsProtocolEventReceivedEventHandler struct {
+		VTBL *ICoreWebView2DevToolsProtocolEventReceivedEventHandlerVTBL
+	}
+
+	// ICoreWebView2DevToolsProtocolEventReceivedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2devtoolsprotocoleventreceivedeventhandler
+	ICoreWebView2DevToolsProtocolEventReceivedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2DevToolsProtocolEventReceivedEventHandler::Invoke.
+func (i *ICoreWebView2DevToolsProtocolEventReceivedEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2DevToolsProtocolEventReceivedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2DevToolsProtocolEventReceivedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2DevToolsProtocolEventReceiver implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2devtoolsprotocoleventreceiver
+	ICoreWebView2DevToolsProtocolEventReceiver struct {
+		VTBL *ICoreWebView2DevToolsProtocolEventReceiverVTBL
+	}
+
+	// ICoreWebView2DevToolsProtocolEventReceiverVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2devtoolsprotocoleventreceiver
+	ICoreWebView2DevToolsProtocolEventReceiverVTBL struct {
+		BasicVTBL
+		AddDevToolsProtocolEventReceived    uintptr
+		RemoveDevToolsProtocolEventReceived uintptr
+	}
+)
+
+// AddDevToolsProtocolEventReceived calls ICoreWebView2DevToolsProtocolEventReceiver::add_DevToolsProtocolEventReceived.
+func (i *ICoreWebView2DevToolsProtocolEventReceiver) AddDevToolsProtocolEventReceived(handler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddDevToolsProtocolEventReceived, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(handler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2DevToolsProtocolEventReceiver::add_DevToolsProtocolEventReceived", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+type (
+	// ICoreWebView2DocumentTitleChangedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2documenttitlechangedeventhandler
+	ICoreWebView2DocumentTitleChangedEventHandler struct {
+		VTBL *ICoreWebView2DocumentTitleChangedEventHandlerVTBL
+	}
+
+	// ICoreWebView2DocumentTitleChangedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2documenttitlechangedeventhandler
+	ICoreWebView2DocumentTitleChangedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2DocumentTitleChangedEventHandler::Invoke.
+func (i *ICoreWebView2DocumentTitleChangedEventHandler) Invoke(sender *ICoreWebView2, args *IUnknown) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2DocumentTitleChangedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2DOMContentLoadedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2domcontentloadedeventargs
+	ICoreWebView2DOMContentLoadedEventArgs struct {
+		VTBL *ICoreWebView2DOMContentLoadedEventArgsVTBL
+	}
+
+	// ICoreWebView2DOMContentLoadedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2domcontentloadedeventargs
+	ICoreWebView2DOMContentLoadedEventArgsVTBL struct {
+		BasicVTBL
+		GetNavigationID uintptr
+	}
+)
+
+// GetNavigationID calls ICoreWebView2DOMContentLoadedEventArgs::get_NavigationId.
+func (i *ICoreWebView2DOMContentLoadedEventArgs) GetNavigationID() (uint64, error) {
+	var navigationId uint64
+
+	if hr := call(i.VTBL.GetNavigationID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&navigationId))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2DOMContentLoadedEventArgs::get_NavigationId", HRESULT: hr}
+	}
+
+	return navigationId, nil
+}
+
+type (
+	// ICoreWebView2DOMContentLoadedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2domcontentloadedeventhandler
+	ICoreWebView2DOMContentLoadedEventHandler struct {
+		VTBL *ICoreWebView2DOMContentLoadedEventHandlerVTBL
+	}
+
+	// ICoreWebView2DOMContentLoadedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2domcontentloadedeventhandler
+	ICoreWebView2DOMContentLoadedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2DOMContentLoadedEventHandler::Invoke.
+func (i *ICoreWebView2DOMContentLoadedEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2DOMContentLoadedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2DOMContentLoadedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Environment2 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2environment2
+	ICoreWebView2Environment2 struct {
+		VTBL *ICoreWebView2Environment2VTBL
+	}
+
+	// ICoreWebView2Environment2VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2environment2
+	ICoreWebView2Environment2VTBL struct {
+		ICoreWebView2EnvironmentVTBL
+		CreateWebResourceRequest uintptr
+	}
+)
+
+// CreateCoreWebView2Controller calls ICoreWebView2Environment::CreateCoreWebView2Controller.
+func (i *ICoreWebView2Environment2) CreateCoreWebView2Controller(parentWindow windows.Handle, handler *Handler) error {
+	if hr := call(i.VTBL.CreateCoreWebView2Controller, uintptr(unsafe.Pointer(i)), uintptr(parentWindow), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Environment::CreateCoreWebView2Controller", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// CreateWebResourceResponse calls ICoreWebView2Environment::CreateWebResourceResponse.
+func (i *ICoreWebView2Environment2) CreateWebResourceResponse(content *IStream, statusCode int32, reasonPhrase string, headers string) (*ICoreWebView2WebResourceResponse, error) {
+	reasonPhrasePtr, err := windows.UTF16PtrFromString(reasonPhrase)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment::CreateWebResourceResponse: invalid reasonPhrase: %w", err)
+	}
+
+	headersPtr, err := windows.UTF16PtrFromString(headers)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment::CreateWebResourceResponse: invalid headers: %w", err)
+	}
+
+	var response *ICoreWebView2WebResourceResponse
+
+	if hr := call(i.VTBL.CreateWebResourceResponse, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(content)), uintptr(statusCode), uintptr(unsafe.Pointer(reasonPhrasePtr)), uintptr(unsafe.Pointer(headersPtr)), uintptr(unsafe.Pointer(&response))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Environment::CreateWebResourceResponse", HRESULT: hr}
+	}
+
+	return response, nil
+}
+
+// GetBrowserVersionString calls ICoreWebView2Environment::get_BrowserVersionString.
+func (i *ICoreWebView2Environment2) GetBrowserVersionString() (string, error) {
+	var versionInfo *uint16
+
+	if hr := call(i.VTBL.GetBrowserVersionString, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&versionInfo))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Environment::get_BrowserVersionString", HRESULT: hr}
+	}
+
+	return coTaskMemString(versionInfo), nil
+}
+
+// AddNewBrowserVersionAvailable calls ICoreWebView2Environment::add_NewBrowserVersionAvailable.
+func (i *ICoreWebView2Environment2) AddNewBrowserVersionAvailable(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNewBrowserVersionAvailable, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Environment::add_NewBrowserVersionAvailable", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// CreateWebResourceRequest calls ICoreWebView2Environment2::CreateWebResourceRequest.
+func (i *ICoreWebView2Environment2) CreateWebResourceRequest(uri string, method string, postData *IStream, headers string) (*ICoreWebView2WebResourceRequest, error) {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment2::CreateWebResourceRequest: invalid uri: %w", err)
+	}
+
+	methodPtr, err := windows.UTF16PtrFromString(method)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment2::CreateWebResourceRequest: invalid method: %w", err)
+	}
+
+	headersPtr, err := windows.UTF16PtrFromString(headers)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment2::CreateWebResourceRequest: invalid headers: %w", err)
+	}
+
+	var request *ICoreWebView2WebResourceRequest
+
+	if hr := call(i.VTBL.CreateWebResourceRequest, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr)), uintptr(unsafe.Pointer(methodPtr)), uintptr(unsafe.Pointer(postData)), uintptr(unsafe.Pointer(headersPtr)), uintptr(unsafe.Pointer(&request))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Environment2::CreateWebResourceRequest", HRESULT: hr}
+	}
+
+	return request, nil
+}
+
+type (
+	// ICoreWebView2FocusChangedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2focuschangedeventhandler
+	ICoreWebView2FocusChangedEventHandler struct {
+		VTBL *ICoreWebView2FocusChangedEventHandlerVTBL
+	}
+
+	// ICoreWebView2FocusChangedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2focuschangedeventhandler
+	ICoreWebView2FocusChangedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2FocusChangedEventHandler::Invoke.
+func (i *ICoreWebView2FocusChangedEventHandler) Invoke(sender *ICoreWebView2Controller, args *IUnknown) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2FocusChangedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2HistoryChangedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2historychangedeventhandler
+	ICoreWebView2HistoryChangedEventHandler struct {
+		VTBL *ICoreWebView2HistoryChangedEventHandlerVTBL
+	}
+
+	// ICoreWebView2HistoryChangedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2historychangedeventhandler
+	ICoreWebView2HistoryChangedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2HistoryChangedEventHandler::Invoke.
+func (i *ICoreWebView2HistoryChangedEventHandler) Invoke(sender *ICoreWebView2, args *IUnknown) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2HistoryChangedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2MoveFocusRequestedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2movefocusrequestedeventargs
+	ICoreWebView2MoveFocusRequestedEventArgs struct {
+		VTBL *ICoreWebView2MoveFocusRequestedEventArgsVTBL
+	}
+
+	// ICoreWebView2MoveFocusRequestedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2movefocusrequestedeventargs
+	ICoreWebView2MoveFocusRequestedEventArgsVTBL struct {
+		BasicVTBL
+		GetReason  uintptr
+		GetHandled uintptr
+		PutHandled uintptr
+	}
+)
+
+// GetReason calls ICoreWebView2MoveFocusRequestedEventArgs::get_Reason.
+func (i *ICoreWebView2MoveFocusRequestedEventArgs) GetReason() (COREWEBVIEW2_MOVE_FOCUS_REASON, error) {
+	var reason COREWEBVIEW2_MOVE_FOCUS_REASON
+
+	if hr := call(i.VTBL.GetReason, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&reason))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2MoveFocusRequestedEventArgs::get_Reason", HRESULT: hr}
+	}
+
+	return reason, nil
+}
+
+// GetHandled calls ICoreWebView2MoveFocusRequestedEventArgs::get_Handled.
+func (i *ICoreWebView2MoveFocusRequestedEventArgs) GetHandled() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetHandled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2MoveFocusRequestedEventArgs::get_Handled", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// PutHandled calls ICoreWebView2MoveFocusRequestedEventArgs::put_Handled.
+func (i *ICoreWebView2MoveFocusRequestedEventArgs) PutHandled(value bool) error {
+	if hr := call(i.VTBL.PutHandled, uintptr(unsafe.Pointer(i)), boolToUintptr(value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2MoveFocusRequestedEventArgs::put_Handled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2MoveFocusRequestedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2movefocusrequestedeventhandler
+	ICoreWebView2MoveFocusRequestedEventHandler struct {
+		VTBL *ICoreWebView2MoveFocusRequestedEventHandlerVTBL
+	}
+
+	// ICoreWebView2MoveFocusRequestedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2movefocusrequestedeventhandler
+	ICoreWebView2MoveFocusRequestedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2MoveFocusRequestedEventHandler::Invoke.
+func (i *ICoreWebView2MoveFocusRequestedEventHandler) Invoke(sender *ICoreWebView2Controller, args *ICoreWebView2MoveFocusRequestedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2MoveFocusRequestedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2NewBrowserVersionAvailableEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2newbrowserversionavailableeventhandler
+	ICoreWebView2NewBrowserVersionAvailableEventHandler struct {
+		VTBL *ICoreWebView2NewBrowserVersionAvailableEventHandlerVTBL
+	}
+
+	// ICoreWebView2NewBrowserVersionAvailableEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2newbrowserversionavailableeventhandler
+	ICoreWebView2NewBrowserVersionAvailableEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2NewBrowserVersionAvailableEventHandler::Invoke.
+func (i *ICoreWebView2NewBrowserVersionAvailableEventHandler) Invoke(sender *ICoreWebView2Environment, args *IUnknown) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2NewBrowserVersionAvailableEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2NewWindowRequestedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2newwindowrequestedeventargs
+	ICoreWebView2NewWindowRequestedEventArgs struct {
+		VTBL *ICoreWebView2NewWindowRequestedEventArgsVTBL
+	}
+
+	// ICoreWebView2NewWindowRequestedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2newwindowrequestedeventargs
+	ICoreWebView2NewWindowRequestedEventArgsVTBL struct {
+		BasicVTBL
+		GetURI             uintptr
+		PutNewWindow       uintptr
+		GetNewWindow       uintptr
+		PutHandled         uintptr
+		GetHandled         uintptr
+		GetIsUserInitiated uintptr
+		GetDeferral        uintptr
+		GetWindowFeatures  uintptr
+	}
+)
+
+// GetURI calls ICoreWebView2NewWindowRequestedEventArgs::get_Uri.
+func (i *ICoreWebView2NewWindowRequestedEventArgs) GetURI() (string, error) {
+	var uri *uint16
+
+	if hr := call(i.VTBL.GetURI, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&uri))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2NewWindowRequestedEventArgs::get_Uri", HRESULT: hr}
+	}
+
+	return coTaskMemString(uri), nil
+}
+
+// PutNewWindow calls ICoreWebView2NewWindowRequestedEventArgs::put_NewWindow.
+func (i *ICoreWebView2NewWindowRequestedEventArgs) PutNewWindow(newWindow *ICoreWebView2) error {
+	if hr := call(i.VTBL.PutNewWindow, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(newWindow))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2NewWindowRequestedEventArgs::put_NewWindow", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetNewWindow calls ICoreWebView2NewWindowRequestedEventArgs::get_NewWindow.
+func (i *ICoreWebView2NewWindowRequestedEventArgs) GetNewWindow() (*ICoreWebView2, error) {
+	var newWindow *ICoreWebView2
+
+	if hr := call(i.VTBL.GetNewWindow, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&newWindow))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2NewWindowRequestedEventArgs::get_NewWindow", HRESULT: hr}
+	}
+
+	return newWindow, nil
+}
+
+// PutHandled calls ICoreWebView2NewWindowRequestedEventArgs::put_Handled.
+func (i *ICoreWebView2NewWindowRequestedEventArgs) PutHandled(handled bool) error {
+	if hr := call(i.VTBL.PutHandled, uintptr(unsafe.Pointer(i)), boolToUintptr(handled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2NewWindowRequestedEventArgs::put_Handled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetHandled calls ICoreWebView2NewWindowRequestedEventArgs::get_Handled.
+func (i *ICoreWebView2NewWindowRequestedEventArgs) GetHandled() (bool, error) {
+	var handled int32
+
+	if hr := call(i.VTBL.GetHandled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&handled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2NewWindowRequestedEventArgs::get_Handled", HRESULT: hr}
+	}
+
+	return handled != 0, nil
+}
+
+// GetIsUserInitiated calls ICoreWebView2NewWindowRequestedEventArgs::get_IsUserInitiated.
+func (i *ICoreWebView2NewWindowRequestedEventArgs) GetIsUserInitiated() (bool, error) {
+	var isUserInitiated int32
+
+	if hr := call(i.VTBL.GetIsUserInitiated, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isUserInitiated))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2NewWindowRequestedEventArgs::get_IsUserInitiated", HRESULT: hr}
+	}
+
+	return isUserInitiated != 0, nil
+}
+
+// GetDeferral calls ICoreWebView2NewWindowRequestedEventArgs::GetDeferral.
+func (i *ICoreWebView2NewWindowRequestedEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var deferral *ICoreWebView2Deferral
+
+	if hr := call(i.VTBL.GetDeferral, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&deferral))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2NewWindowRequestedEventArgs::GetDeferral", HRESULT: hr}
+	}
+
+	return deferral, nil
+}
+
+// GetWindowFeatures calls ICoreWebView2NewWindowRequestedEventArgs::get_WindowFeatures.
+func (i *ICoreWebView2NewWindowRequestedEventArgs) GetWindowFeatures() (*ICoreWebView2WindowFeatures, error) {
+	var value *ICoreWebView2WindowFeatures
+
+	if hr := call(i.VTBL.GetWindowFeatures, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2NewWindowRequestedEventArgs::get_WindowFeatures", HRESULT: hr}
+	}
+
+	return value, nil
+}
+
+type (
+	// ICoreWebView2NewWindowRequestedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2newwindowrequestedeventhandler
+	ICoreWebView2NewWindowRequestedEventHandler struct {
+		VTBL *ICoreWebView2NewWindowRequestedEventHandlerVTBL
+	}
+
+	// ICoreWebView2NewWindowRequestedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2newwindowrequestedeventhandler
+	ICoreWebView2NewWindowRequestedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2NewWindowRequestedEventHandler::Invoke.
+func (i *ICoreWebView2NewWindowRequestedEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2NewWindowRequestedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2NewWindowRequestedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2PermissionRequestedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2permissionrequestedeventargs
+	ICoreWebView2PermissionRequestedEventArgs struct {
+		VTBL *ICoreWebView2PermissionRequestedEventArgsVTBL
+	}
+
+	// ICoreWebView2PermissionRequestedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2permissionrequestedeventargs
+	ICoreWebView2PermissionRequestedEventArgsVTBL struct {
+		BasicVTBL
+		GetURI             uintptr
+		GetPermissionKind  uintptr
+		GetIsUserInitiated uintptr
+		GetState           uintptr
+		PutState           uintptr
+		GetDeferral        uintptr
+	}
+)
+
+// GetURI calls ICoreWebView2PermissionRequestedEventArgs::get_Uri.
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetURI() (string, error) {
+	var uri *uint16
+
+	if hr := call(i.VTBL.GetURI, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&uri))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2PermissionRequestedEventArgs::get_Uri", HRESULT: hr}
+	}
+
+	return coTaskMemString(uri), nil
+}
+
+// GetPermissionKind calls ICoreWebView2PermissionRequestedEventArgs::get_PermissionKind.
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetPermissionKind() (COREWEBVIEW2_PERMISSION_KIND, error) {
+	var permissionKind COREWEBVIEW2_PERMISSION_KIND
+
+	if hr := call(i.VTBL.GetPermissionKind, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&permissionKind))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PermissionRequestedEventArgs::get_PermissionKind", HRESULT: hr}
+	}
+
+	return permissionKind, nil
+}
+
+// GetIsUserInitiated calls ICoreWebView2PermissionRequestedEventArgs::get_IsUserInitiated.
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetIsUserInitiated() (bool, error) {
+	var isUserInitiated int32
+
+	if hr := call(i.VTBL.GetIsUserInitiated, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isUserInitiated))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2PermissionRequestedEventArgs::get_IsUserInitiated", HRESULT: hr}
+	}
+
+	return isUserInitiated != 0, nil
+}
+
+// GetState calls ICoreWebView2PermissionRequestedEventArgs::get_State.
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetState() (COREWEBVIEW2_PERMISSION_STATE, error) {
+	var state COREWEBVIEW2_PERMISSION_STATE
+
+	if hr := call(i.VTBL.GetState, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&state))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PermissionRequestedEventArgs::get_State", HRESULT: hr}
+	}
+
+	return state, nil
+}
+
+// PutState calls ICoreWebView2PermissionRequestedEventArgs::put_State.
+func (i *ICoreWebView2PermissionRequestedEventArgs) PutState(state COREWEBVIEW2_PERMISSION_STATE) error {
+	if hr := call(i.VTBL.PutState, uintptr(unsafe.Pointer(i)), uintptr(state)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PermissionRequestedEventArgs::put_State", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetDeferral calls ICoreWebView2PermissionRequestedEventArgs::GetDeferral.
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var deferral *ICoreWebView2Deferral
+
+	if hr := call(i.VTBL.GetDeferral, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&deferral))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2PermissionRequestedEventArgs::GetDeferral", HRESULT: hr}
+	}
+
+	return deferral, nil
+}
+
+type (
+	// ICoreWebView2PermissionRequestedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2permissionrequestedeventhandler
+	ICoreWebView2PermissionRequestedEventHandler struct {
+		VTBL *ICoreWebView2PermissionRequestedEventHandlerVTBL
+	}
+
+	// ICoreWebView2PermissionRequestedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2permissionrequestedeventhandler
+	ICoreWebView2PermissionRequestedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2PermissionRequestedEventHandler::Invoke.
+func (i *ICoreWebView2PermissionRequestedEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2PermissionRequestedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PermissionRequestedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2ProcessFailedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2processfailedeventargs
+	ICoreWebView2ProcessFailedEventArgs struct {
+		VTBL *ICoreWebView2ProcessFailedEventArgsVTBL
+	}
+
+	// ICoreWebView2ProcessFailedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2processfailedeventargs
+	ICoreWebView2ProcessFailedEventArgsVTBL struct {
+		BasicVTBL
+		GetProcessFailedKind uintptr
+	}
+)
+
+// GetProcessFailedKind calls ICoreWebView2ProcessFailedEventArgs::get_ProcessFailedKind.
+func (i *ICoreWebView2ProcessFailedEventArgs) GetProcessFailedKind() (COREWEBVIEW2_PROCESS_FAILED_KIND, error) {
+	var processFailedKind COREWEBVIEW2_PROCESS_FAILED_KIND
+
+	if hr := call(i.VTBL.GetProcessFailedKind, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&processFailedKind))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2ProcessFailedEventArgs::get_ProcessFailedKind", HRESULT: hr}
+	}
+
+	return processFailedKind, nil
+}
+
+type (
+	// ICoreWebView2ProcessFailedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2processfailedeventhandler
+	ICoreWebView2ProcessFailedEventHandler struct {
+		VTBL *ICoreWebView2ProcessFailedEventHandlerVTBL
+	}
+
+	// ICoreWebView2ProcessFailedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2processfailedeventhandler
+	ICoreWebView2ProcessFailedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2ProcessFailedEventHandler::Invoke.
+func (i *ICoreWebView2ProcessFailedEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2ProcessFailedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2ProcessFailedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2ScriptDialogOpeningEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2scriptdialogopeningeventargs
+	ICoreWebView2ScriptDialogOpeningEventArgs struct {
+		VTBL *ICoreWebView2ScriptDialogOpeningEventArgsVTBL
+	}
+
+	// ICoreWebView2ScriptDialogOpeningEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2scriptdialogopeningeventargs
+	ICoreWebView2ScriptDialogOpeningEventArgsVTBL struct {
+		BasicVTBL
+		GetURI         uintptr
+		GetKind        uintptr
+		GetMessage     uintptr
+		Accept         uintptr
+		GetDefaultText uintptr
+		GetResultText  uintptr
+		PutResultText  uintptr
+		GetDeferral    uintptr
+	}
+)
+
+// GetURI calls ICoreWebView2ScriptDialogOpeningEventArgs::get_Uri.
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) GetURI() (string, error) {
+	var uri *uint16
+
+	if hr := call(i.VTBL.GetURI, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&uri))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2ScriptDialogOpeningEventArgs::get_Uri", HRESULT: hr}
+	}
+
+	return coTaskMemString(uri), nil
+}
+
+// GetKind calls ICoreWebView2ScriptDialogOpeningEventArgs::get_Kind.
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) GetKind() (COREWEBVIEW2_SCRIPT_DIALOG_KIND, error) {
+	var kind COREWEBVIEW2_SCRIPT_DIALOG_KIND
+
+	if hr := call(i.VTBL.GetKind, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&kind))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2ScriptDialogOpeningEventArgs::get_Kind", HRESULT: hr}
+	}
+
+	return kind, nil
+}
+
+// GetMessage calls ICoreWebView2ScriptDialogOpeningEventArgs::get_Message.
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) GetMessage() (string, error) {
+	var message *uint16
+
+	if hr := call(i.VTBL.GetMessage, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&message))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2ScriptDialogOpeningEventArgs::get_Message", HRESULT: hr}
+	}
+
+	return coTaskMemString(message), nil
+}
+
+// Accept calls ICoreWebView2ScriptDialogOpeningEventArgs::Accept.
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) Accept() error {
+	if hr := call(i.VTBL.Accept, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2ScriptDialogOpeningEventArgs::Accept", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetDefaultText calls ICoreWebView2ScriptDialogOpeningEventArgs::get_DefaultText.
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) GetDefaultText() (string, error) {
+	var defaultText *uint16
+
+	if hr := call(i.VTBL.GetDefaultText, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&defaultText))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2ScriptDialogOpeningEventArgs::get_DefaultText", HRESULT: hr}
+	}
+
+	return coTaskMemString(defaultText), nil
+}
+
+// GetResultText calls ICoreWebView2ScriptDialogOpeningEventArgs::get_ResultText.
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) GetResultText() (string, error) {
+	var resultText *uint16
+
+	if hr := call(i.VTBL.GetResultText, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&resultText))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2ScriptDialogOpeningEventArgs::get_ResultText", HRESULT: hr}
+	}
+
+	return coTaskMemString(resultText), nil
+}
+
+// PutResultText calls ICoreWebView2ScriptDialogOpeningEventArgs::put_ResultText.
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) PutResultText(resultText string) error {
+	resultTextPtr, err := windows.UTF16PtrFromString(resultText)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2ScriptDialogOpeningEventArgs::put_ResultText: invalid resultText: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutResultText, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(resultTextPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2ScriptDialogOpeningEventArgs::put_ResultText", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetDeferral calls ICoreWebView2ScriptDialogOpeningEventArgs::GetDeferral.
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var deferral *ICoreWebView2Deferral
+
+	if hr := call(i.VTBL.GetDeferral, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&deferral))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2ScriptDialogOpeningEventArgs::GetDeferral", HRESULT: hr}
+	}
+
+	return deferral, nil
+}
+
+type (
+	// ICoreWebView2ScriptDialogOpeningEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2scriptdialogopeningeventhandler
+	ICoreWebView2ScriptDialogOpeningEventHandler struct {
+		VTBL *ICoreWebView2ScriptDialogOpeningEventHandlerVTBL
+	}
+
+	// ICoreWebView2ScriptDialogOpeningEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2scriptdialogopeningeventhandler
+	ICoreWebView2ScriptDialogOpeningEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2ScriptDialogOpeningEventHandler::Invoke.
+func (i *ICoreWebView2ScriptDialogOpeningEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2ScriptDialogOpeningEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2ScriptDialogOpeningEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2SourceChangedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2sourcechangedeventargs
+	ICoreWebView2SourceChangedEventArgs struct {
+		VTBL *ICoreWebView2SourceChangedEventArgsVTBL
+	}
+
+	// ICoreWebView2SourceChangedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2sourcechangedeventargs
+	ICoreWebView2SourceChangedEventArgsVTBL struct {
+		BasicVTBL
+		GetIsNewDocument uintptr
+	}
+)
+
+// GetIsNewDocument calls ICoreWebView2SourceChangedEventArgs::get_IsNewDocument.
+func (i *ICoreWebView2SourceChangedEventArgs) GetIsNewDocument() (bool, error) {
+	var isNewDocument int32
+
+	if hr := call(i.VTBL.GetIsNewDocument, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isNewDocument))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2SourceChangedEventArgs::get_IsNewDocument", HRESULT: hr}
+	}
+
+	return isNewDocument != 0, nil
+}
+
+type (
+	// ICoreWebView2SourceChangedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2sourcechangedeventhandler
+	ICoreWebView2SourceChangedEventHandler struct {
+		VTBL *ICoreWebView2SourceChangedEventHandlerVTBL
+	}
+
+	// ICoreWebView2SourceChangedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2sourcechangedeventhandler
+	ICoreWebView2SourceChangedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2SourceChangedEventHandler::Invoke.
+func (i *ICoreWebView2SourceChangedEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2SourceChangedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2SourceChangedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2WebResourceResponseReceivedEventArgs implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourceresponsereceivedeventargs
+	ICoreWebView2WebResourceResponseReceivedEventArgs struct {
+		VTBL *ICoreWebView2WebResourceResponseReceivedEventArgsVTBL
+	}
+
+	// ICoreWebView2WebResourceResponseReceivedEventArgsVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourceresponsereceivedeventargs
+	ICoreWebView2WebResourceResponseReceivedEventArgsVTBL struct {
+		BasicVTBL
+		GetRequest  uintptr
+		GetResponse uintptr
+	}
+)
+
+// GetRequest calls ICoreWebView2WebResourceResponseReceivedEventArgs::get_Request.
+func (i *ICoreWebView2WebResourceResponseReceivedEventArgs) GetRequest() (*ICoreWebView2WebResourceRequest, error) {
+	var request *ICoreWebView2WebResourceRequest
+
+	if hr := call(i.VTBL.GetRequest, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&request))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2WebResourceResponseReceivedEventArgs::get_Request", HRESULT: hr}
+	}
+
+	return request, nil
+}
+
+// GetResponse calls ICoreWebView2WebResourceResponseReceivedEventArgs::get_Response.
+func (i *ICoreWebView2WebResourceResponseReceivedEventArgs) GetResponse() (*ICoreWebView2WebResourceResponseView, error) {
+	var response *ICoreWebView2WebResourceResponseView
+
+	if hr := call(i.VTBL.GetResponse, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&response))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2WebResourceResponseReceivedEventArgs::get_Response", HRESULT: hr}
+	}
+
+	return response, nil
+}
+
+type (
+	// ICoreWebView2WebResourceResponseReceivedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourceresponsereceivedeventhandler
+	ICoreWebView2WebResourceResponseReceivedEventHandler struct {
+		VTBL *ICoreWebView2WebResourceResponseReceivedEventHandlerVTBL
+	}
+
+	// ICoreWebView2WebResourceResponseReceivedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourceresponsereceivedeventhandler
+	ICoreWebView2WebResourceResponseReceivedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2WebResourceResponseReceivedEventHandler::Invoke.
+func (i *ICoreWebView2WebResourceResponseReceivedEventHandler) Invoke(sender *ICoreWebView2, args *ICoreWebView2WebResourceResponseReceivedEventArgs) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebResourceResponseReceivedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2WebResourceResponseView implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourceresponseview
+	ICoreWebView2WebResourceResponseView struct {
+		VTBL *ICoreWebView2WebResourceResponseViewVTBL
+	}
+
+	// ICoreWebView2WebResourceResponseViewVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourceresponseview
+	ICoreWebView2WebResourceResponseViewVTBL struct {
+		BasicVTBL
+		GetHeaders      uintptr
+		GetStatusCode   uintptr
+		GetReasonPhrase uintptr
+		GetContent      uintptr
+	}
+)
+
+// GetHeaders calls ICoreWebView2WebResourceResponseView::get_Headers.
+func (i *ICoreWebView2WebResourceResponseView) GetHeaders() (*ICoreWebView2HttpResponseHeaders, error) {
+	var headers *ICoreWebView2HttpResponseHeaders
+
+	if hr := call(i.VTBL.GetHeaders, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&headers))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2WebResourceResponseView::get_Headers", HRESULT: hr}
+	}
+
+	return headers, nil
+}
+
+// GetStatusCode calls ICoreWebView2WebResourceResponseView::get_StatusCode.
+func (i *ICoreWebView2WebResourceResponseView) GetStatusCode() (int32, error) {
+	var statusCode int32
+
+	if hr := call(i.VTBL.GetStatusCode, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&statusCode))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2WebResourceResponseView::get_StatusCode", HRESULT: hr}
+	}
+
+	return statusCode, nil
+}
+
+// GetReasonPhrase calls ICoreWebView2WebResourceResponseView::get_ReasonPhrase.
+func (i *ICoreWebView2WebResourceResponseView) GetReasonPhrase() (string, error) {
+	var reasonPhrase *uint16
+
+	if hr := call(i.VTBL.GetReasonPhrase, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&reasonPhrase))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2WebResourceResponseView::get_ReasonPhrase", HRESULT: hr}
+	}
+
+	return coTaskMemString(reasonPhrase), nil
+}
+
+// GetContent calls ICoreWebView2WebResourceResponseView::GetContent.
+func (i *ICoreWebView2WebResourceResponseView) GetContent(handler *Handler) error {
+	if hr := call(i.VTBL.GetContent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebResourceResponseView::GetContent", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2WebResourceResponseViewGetContentCompletedHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourceresponseviewgetcontentcompletedhandler
+	ICoreWebView2WebResourceResponseViewGetContentCompletedHandler struct {
+		VTBL *ICoreWebView2WebResourceResponseViewGetContentCompletedHandlerVTBL
+	}
+
+	// ICoreWebView2WebResourceResponseViewGetContentCompletedHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2webresourceresponseviewgetcontentcompletedhandler
+	ICoreWebView2WebResourceResponseViewGetContentCompletedHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2WebResourceResponseViewGetContentCompletedHandler::Invoke.
+func (i *ICoreWebView2WebResourceResponseViewGetContentCompletedHandler) Invoke(errorCode hresult.HRESULT, content *IStream) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(errorCode), uintptr(unsafe.Pointer(content))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WebResourceResponseViewGetContentCompletedHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2WindowCloseRequestedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2windowcloserequestedeventhandler
+	ICoreWebView2WindowCloseRequestedEventHandler struct {
+		VTBL *ICoreWebView2WindowCloseRequestedEventHandlerVTBL
+	}
+
+	// ICoreWebView2WindowCloseRequestedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2windowcloserequestedeventhandler
+	ICoreWebView2WindowCloseRequestedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2WindowCloseRequestedEventHandler::Invoke.
+func (i *ICoreWebView2WindowCloseRequestedEventHandler) Invoke(sender *ICoreWebView2, args *IUnknown) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2WindowCloseRequestedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2WindowFeatures implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2windowfeatures
+	ICoreWebView2WindowFeatures struct {
+		VTBL *ICoreWebView2WindowFeaturesVTBL
+	}
+
+	// ICoreWebView2WindowFeaturesVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2windowfeatures
+	ICoreWebView2WindowFeaturesVTBL struct {
+		BasicVTBL
+		GetHasPosition             uintptr
+		GetHasSize                 uintptr
+		GetLeft                    uintptr
+		GetTop                     uintptr
+		GetHeight                  uintptr
+		GetWidth                   uintptr
+		GetShouldDisplayMenuBar    uintptr
+		GetShouldDisplayStatus     uintptr
+		GetShouldDisplayToolbar    uintptr
+		GetShouldDisplayScrollBars uintptr
+	}
+)
+
+// GetHasPosition calls ICoreWebView2WindowFeatures::get_HasPosition.
+func (i *ICoreWebView2WindowFeatures) GetHasPosition() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetHasPosition, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2WindowFeatures::get_HasPosition", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// GetHasSize calls ICoreWebView2WindowFeatures::get_HasSize.
+func (i *ICoreWebView2WindowFeatures) GetHasSize() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetHasSize, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2WindowFeatures::get_HasSize", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// GetLeft calls ICoreWebView2WindowFeatures::get_Left.
+func (i *ICoreWebView2WindowFeatures) GetLeft() (uint32, error) {
+	var value uint32
+
+	if hr := call(i.VTBL.GetLeft, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2WindowFeatures::get_Left", HRESULT: hr}
+	}
+
+	return value, nil
+}
+
+// GetTop calls ICoreWebView2WindowFeatures::get_Top.
+func (i *ICoreWebView2WindowFeatures) GetTop() (uint32, error) {
+	var value uint32
+
+	if hr := call(i.VTBL.GetTop, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2WindowFeatures::get_Top", HRESULT: hr}
+	}
+
+	return value, nil
+}
+
+// GetHeight calls ICoreWebView2WindowFeatures::get_Height.
+func (i *ICoreWebView2WindowFeatures) GetHeight() (uint32, error) {
+	var value uint32
+
+	if hr := call(i.VTBL.GetHeight, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2WindowFeatures::get_Height", HRESULT: hr}
+	}
+
+	return value, nil
+}
+
+// GetWidth calls ICoreWebView2WindowFeatures::get_Width.
+func (i *ICoreWebView2WindowFeatures) GetWidth() (uint32, error) {
+	var value uint32
+
+	if hr := call(i.VTBL.GetWidth, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2WindowFeatures::get_Width", HRESULT: hr}
+	}
+
+	return value, nil
+}
+
+// GetShouldDisplayMenuBar calls ICoreWebView2WindowFeatures::get_ShouldDisplayMenuBar.
+func (i *ICoreWebView2WindowFeatures) GetShouldDisplayMenuBar() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetShouldDisplayMenuBar, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2WindowFeatures::get_ShouldDisplayMenuBar", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// GetShouldDisplayStatus calls ICoreWebView2WindowFeatures::get_ShouldDisplayStatus.
+func (i *ICoreWebView2WindowFeatures) GetShouldDisplayStatus() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetShouldDisplayStatus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2WindowFeatures::get_ShouldDisplayStatus", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// GetShouldDisplayToolbar calls ICoreWebView2WindowFeatures::get_ShouldDisplayToolbar.
+func (i *ICoreWebView2WindowFeatures) GetShouldDisplayToolbar() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetShouldDisplayToolbar, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2WindowFeatures::get_ShouldDisplayToolbar", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// GetShouldDisplayScrollBars calls ICoreWebView2WindowFeatures::get_ShouldDisplayScrollBars.
+func (i *ICoreWebView2WindowFeatures) GetShouldDisplayScrollBars() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetShouldDisplayScrollBars, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2WindowFeatures::get_ShouldDisplayScrollBars", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+type (
+	// ICoreWebView2ZoomFactorChangedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2zoomfactorchangedeventhandler
+	ICoreWebView2ZoomFactorChangedEventHandler struct {
+		VTBL *ICoreWebView2ZoomFactorChangedEventHandlerVTBL
+	}
+
+	// ICoreWebView2ZoomFactorChangedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2zoomfactorchangedeventhandler
+	ICoreWebView2ZoomFactorChangedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2ZoomFactorChangedEventHandler::Invoke.
+func (i *ICoreWebView2ZoomFactorChangedEventHandler) Invoke(sender *ICoreWebView2Controller, args *IUnknown) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2ZoomFactorChangedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2TrySuspendCompletedHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2trysuspendcompletedhandler
+	ICoreWebView2TrySuspendCompletedHandler struct {
+		VTBL *ICoreWebView2TrySuspendCompletedHandlerVTBL
+	}
+
+	// ICoreWebView2TrySuspendCompletedHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2trysuspendcompletedhandler
+	ICoreWebView2TrySuspendCompletedHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2TrySuspendCompletedHandler::Invoke.
+func (i *ICoreWebView2TrySuspendCompletedHandler) Invoke(errorCode hresult.HRESULT, isSuccessful bool) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(errorCode), boolToUintptr(isSuccessful)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2TrySuspendCompletedHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Controller2 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2controller2
+	ICoreWebView2Controller2 struct {
+		VTBL *ICoreWebView2Controller2VTBL
+	}
+
+	// ICoreWebView2Controller2VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2controller2
+	ICoreWebView2Controller2VTBL struct {
+		ICoreWebView2ControllerVTBL
+		GetDefaultBackgroundColor uintptr
+		PutDefaultBackgroundColor uintptr
+	}
+)
+
+// GetIsVisible calls ICoreWebView2Controller::get_IsVisible.
+func (i *ICoreWebView2Controller2) GetIsVisible() (bool, error) {
+	var isVisible int32
+
+	if hr := call(i.VTBL.GetIsVisible, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isVisible))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Controller::get_IsVisible", HRESULT: hr}
+	}
+
+	return isVisible != 0, nil
+}
+
+// PutIsVisible calls ICoreWebView2Controller::put_IsVisible.
+func (i *ICoreWebView2Controller2) PutIsVisible(isVisible bool) error {
+	if hr := call(i.VTBL.PutIsVisible, uintptr(unsafe.Pointer(i)), boolToUintptr(isVisible)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_IsVisible", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetBounds calls ICoreWebView2Controller::get_Bounds.
+func (i *ICoreWebView2Controller2) GetBounds() (RECT, error) {
+	var bounds RECT
+
+	if hr := call(i.VTBL.GetBounds, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&bounds))); hr.Failed() {
+		return RECT{}, &Error{Method: "ICoreWebView2Controller::get_Bounds", HRESULT: hr}
+	}
+
+	return bounds, nil
+}
+
+// GetZoomFactor calls ICoreWebView2Controller::get_ZoomFactor.
+func (i *ICoreWebView2Controller2) GetZoomFactor() (float64, error) {
+	var zoomFactor float64
+
+	if hr := call(i.VTBL.GetZoomFactor, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&zoomFactor))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller::get_ZoomFactor", HRESULT: hr}
+	}
+
+	return zoomFactor, nil
+}
+
+// PutZoomFactor isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// AddZoomFactorChanged calls ICoreWebView2Controller::add_ZoomFactorChanged.
+func (i *ICoreWebView2Controller2) AddZoomFactorChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddZoomFactorChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_ZoomFactorChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// MoveFocus calls ICoreWebView2Controller::MoveFocus.
+func (i *ICoreWebView2Controller2) MoveFocus(reason COREWEBVIEW2_MOVE_FOCUS_REASON) error {
+	if hr := call(i.VTBL.MoveFocus, uintptr(unsafe.Pointer(i)), uintptr(reason)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::MoveFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddMoveFocusRequested calls ICoreWebView2Controller::add_MoveFocusRequested.
+func (i *ICoreWebView2Controller2) AddMoveFocusRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddMoveFocusRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_MoveFocusRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddGotFocus calls ICoreWebView2Controller::add_GotFocus.
+func (i *ICoreWebView2Controller2) AddGotFocus(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddGotFocus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_GotFocus", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddLostFocus calls ICoreWebView2Controller::add_LostFocus.
+func (i *ICoreWebView2Controller2) AddLostFocus(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddLostFocus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_LostFocus", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddAcceleratorKeyPressed calls ICoreWebView2Controller::add_AcceleratorKeyPressed.
+func (i *ICoreWebView2Controller2) AddAcceleratorKeyPressed(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddAcceleratorKeyPressed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_AcceleratorKeyPressed", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetParentWindow calls ICoreWebView2Controller::get_ParentWindow.
+func (i *ICoreWebView2Controller2) GetParentWindow() (windows.Handle, error) {
+	var parentWindow windows.Handle
+
+	if hr := call(i.VTBL.GetParentWindow, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&parentWindow))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller::get_ParentWindow", HRESULT: hr}
+	}
+
+	return parentWindow, nil
+}
+
+// PutParentWindow calls ICoreWebView2Controller::put_ParentWindow.
+func (i *ICoreWebView2Controller2) PutParentWindow(parentWindow windows.Handle) error {
+	if hr := call(i.VTBL.PutParentWindow, uintptr(unsafe.Pointer(i)), uintptr(parentWindow)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_ParentWindow", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// NotifyParentWindowPositionChanged calls ICoreWebView2Controller::NotifyParentWindowPositionChanged.
+func (i *ICoreWebView2Controller2) NotifyParentWindowPositionChanged() error {
+	if hr := call(i.VTBL.NotifyParentWindowPositionChanged, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::NotifyParentWindowPositionChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// Close calls ICoreWebView2Controller::Close.
+func (i *ICoreWebView2Controller2) Close() error {
+	if hr := call(i.VTBL.Close, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::Close", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetCoreWebView2 calls ICoreWebView2Controller::get_CoreWebView2.
+func (i *ICoreWebView2Controller2) GetCoreWebView2() (*ICoreWebView2, error) {
+	var coreWebView2 *ICoreWebView2
+
+	if hr := call(i.VTBL.GetCoreWebView2, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&coreWebView2))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Controller::get_CoreWebView2", HRESULT: hr}
+	}
+
+	return coreWebView2, nil
+}
+
+// GetDefaultBackgroundColor calls ICoreWebView2Controller2::get_DefaultBackgroundColor.
+func (i *ICoreWebView2Controller2) GetDefaultBackgroundColor() (COREWEBVIEW2_COLOR, error) {
+	var backgroundColor COREWEBVIEW2_COLOR
+
+	if hr := call(i.VTBL.GetDefaultBackgroundColor, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&backgroundColor))); hr.Failed() {
+		return COREWEBVIEW2_COLOR{}, &Error{Method: "ICoreWebView2Controller2::get_DefaultBackgroundColor", HRESULT: hr}
+	}
+
+	return backgroundColor, nil
+}
+
+// PutDefaultBackgroundColor calls ICoreWebView2Controller2::put_DefaultBackgroundColor.
+func (i *ICoreWebView2Controller2) PutDefaultBackgroundColor(backgroundColor COREWEBVIEW2_COLOR) error {
+	if hr := call(i.VTBL.PutDefaultBackgroundColor, uintptr(unsafe.Pointer(i)), uintptr(backgroundColor.A)|uintptr(backgroundColor.R)<<8|uintptr(backgroundColor.G)<<16|uintptr(backgroundColor.B)<<24); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller2::put_DefaultBackgroundColor", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Controller3 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2controller3
+	ICoreWebView2Controller3 struct {
+		VTBL *ICoreWebView2Controller3VTBL
+	}
+
+	// ICoreWebView2Controller3VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2controller3
+	ICoreWebView2Controller3VTBL struct {
+		ICoreWebView2Controller2VTBL
+		GetRasterizationScale              uintptr
+		PutRasterizationScale              uintptr
+		GetShouldDetectMonitorScaleChanges uintptr
+		PutShouldDetectMonitorScaleChanges uintptr
+		AddRasterizationScaleChanged       uintptr
+		RemoveRasterizationScaleChanged    uintptr
+		GetBoundsMode                      uintptr
+		PutBoundsMode                      uintptr
+	}
+)
+
+// GetIsVisible calls ICoreWebView2Controller::get_IsVisible.
+func (i *ICoreWebView2Controller3) GetIsVisible() (bool, error) {
+	var isVisible int32
+
+	if hr := call(i.VTBL.GetIsVisible, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isVisible))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Controller::get_IsVisible", HRESULT: hr}
+	}
+
+	return isVisible != 0, nil
+}
+
+// PutIsVisible calls ICoreWebView2Controller::put_IsVisible.
+func (i *ICoreWebView2Controller3) PutIsVisible(isVisible bool) error {
+	if hr := call(i.VTBL.PutIsVisible, uintptr(unsafe.Pointer(i)), boolToUintptr(isVisible)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_IsVisible", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetBounds calls ICoreWebView2Controller::get_Bounds.
+func (i *ICoreWebView2Controller3) GetBounds() (RECT, error) {
+	var bounds RECT
+
+	if hr := call(i.VTBL.GetBounds, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&bounds))); hr.Failed() {
+		return RECT{}, &Error{Method: "ICoreWebView2Controller::get_Bounds", HRESULT: hr}
+	}
+
+	return bounds, nil
+}
+
+// GetZoomFactor calls ICoreWebView2Controller::get_ZoomFactor.
+func (i *ICoreWebView2Controller3) GetZoomFactor() (float64, error) {
+	var zoomFactor float64
+
+	if hr := call(i.VTBL.GetZoomFactor, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&zoomFactor))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller::get_ZoomFactor", HRESULT: hr}
+	}
+
+	return zoomFactor, nil
+}
+
+// PutZoomFactor isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// AddZoomFactorChanged calls ICoreWebView2Controller::add_ZoomFactorChanged.
+func (i *ICoreWebView2Controller3) AddZoomFactorChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddZoomFactorChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_ZoomFactorChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// MoveFocus calls ICoreWebView2Controller::MoveFocus.
+func (i *ICoreWebView2Controller3) MoveFocus(reason COREWEBVIEW2_MOVE_FOCUS_REASON) error {
+	if hr := call(i.VTBL.MoveFocus, uintptr(unsafe.Pointer(i)), uintptr(reason)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::MoveFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddMoveFocusRequested calls ICoreWebView2Controller::add_MoveFocusRequested.
+func (i *ICoreWebView2Controller3) AddMoveFocusRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddMoveFocusRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_MoveFocusRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddGotFocus calls ICoreWebView2Controller::add_GotFocus.
+func (i *ICoreWebView2Controller3) AddGotFocus(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddGotFocus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_GotFocus", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddLostFocus calls ICoreWebView2Controller::add_LostFocus.
+func (i *ICoreWebView2Controller3) AddLostFocus(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddLostFocus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_LostFocus", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddAcceleratorKeyPressed calls ICoreWebView2Controller::add_AcceleratorKeyPressed.
+func (i *ICoreWebView2Controller3) AddAcceleratorKeyPressed(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddAcceleratorKeyPressed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_AcceleratorKeyPressed", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetParentWindow calls ICoreWebView2Controller::get_ParentWindow.
+func (i *ICoreWebView2Controller3) GetParentWindow() (windows.Handle, error) {
+	var parentWindow windows.Handle
+
+	if hr := call(i.VTBL.GetParentWindow, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&parentWindow))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller::get_ParentWindow", HRESULT: hr}
+	}
+
+	return parentWindow, nil
+}
+
+// PutParentWindow calls ICoreWebView2Controller::put_ParentWindow.
+func (i *ICoreWebView2Controller3) PutParentWindow(parentWindow windows.Handle) error {
+	if hr := call(i.VTBL.PutParentWindow, uintptr(unsafe.Pointer(i)), uintptr(parentWindow)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_ParentWindow", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// NotifyParentWindowPositionChanged calls ICoreWebView2Controller::NotifyParentWindowPositionChanged.
+func (i *ICoreWebView2Controller3) NotifyParentWindowPositionChanged() error {
+	if hr := call(i.VTBL.NotifyParentWindowPositionChanged, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::NotifyParentWindowPositionChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// Close calls ICoreWebView2Controller::Close.
+func (i *ICoreWebView2Controller3) Close() error {
+	if hr := call(i.VTBL.Close, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::Close", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetCoreWebView2 calls ICoreWebView2Controller::get_CoreWebView2.
+func (i *ICoreWebView2Controller3) GetCoreWebView2() (*ICoreWebView2, error) {
+	var coreWebView2 *ICoreWebView2
+
+	if hr := call(i.VTBL.GetCoreWebView2, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&coreWebView2))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Controller::get_CoreWebView2", HRESULT: hr}
+	}
+
+	return coreWebView2, nil
+}
+
+// GetDefaultBackgroundColor calls ICoreWebView2Controller2::get_DefaultBackgroundColor.
+func (i *ICoreWebView2Controller3) GetDefaultBackgroundColor() (COREWEBVIEW2_COLOR, error) {
+	var backgroundColor COREWEBVIEW2_COLOR
+
+	if hr := call(i.VTBL.GetDefaultBackgroundColor, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&backgroundColor))); hr.Failed() {
+		return COREWEBVIEW2_COLOR{}, &Error{Method: "ICoreWebView2Controller2::get_DefaultBackgroundColor", HRESULT: hr}
+	}
+
+	return backgroundColor, nil
+}
+
+// PutDefaultBackgroundColor calls ICoreWebView2Controller2::put_DefaultBackgroundColor.
+func (i *ICoreWebView2Controller3) PutDefaultBackgroundColor(backgroundColor COREWEBVIEW2_COLOR) error {
+	if hr := call(i.VTBL.PutDefaultBackgroundColor, uintptr(unsafe.Pointer(i)), uintptr(backgroundColor.A)|uintptr(backgroundColor.R)<<8|uintptr(backgroundColor.G)<<16|uintptr(backgroundColor.B)<<24); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller2::put_DefaultBackgroundColor", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetRasterizationScale calls ICoreWebView2Controller3::get_RasterizationScale.
+func (i *ICoreWebView2Controller3) GetRasterizationScale() (float64, error) {
+	var scale float64
+
+	if hr := call(i.VTBL.GetRasterizationScale, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&scale))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller3::get_RasterizationScale", HRESULT: hr}
+	}
+
+	return scale, nil
+}
+
+// PutRasterizationScale isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// GetShouldDetectMonitorScaleChanges calls ICoreWebView2Controller3::get_ShouldDetectMonitorScaleChanges.
+func (i *ICoreWebView2Controller3) GetShouldDetectMonitorScaleChanges() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetShouldDetectMonitorScaleChanges, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Controller3::get_ShouldDetectMonitorScaleChanges", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// PutShouldDetectMonitorScaleChanges calls ICoreWebView2Controller3::put_ShouldDetectMonitorScaleChanges.
+func (i *ICoreWebView2Controller3) PutShouldDetectMonitorScaleChanges(value bool) error {
+	if hr := call(i.VTBL.PutShouldDetectMonitorScaleChanges, uintptr(unsafe.Pointer(i)), boolToUintptr(value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller3::put_ShouldDetectMonitorScaleChanges", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddRasterizationScaleChanged calls ICoreWebView2Controller3::add_RasterizationScaleChanged.
+func (i *ICoreWebView2Controller3) AddRasterizationScaleChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddRasterizationScaleChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller3::add_RasterizationScaleChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetBoundsMode calls ICoreWebView2Controller3::get_BoundsMode.
+func (i *ICoreWebView2Controller3) GetBoundsMode() (COREWEBVIEW2_BOUNDS_MODE, error) {
+	var boundsMode COREWEBVIEW2_BOUNDS_MODE
+
+	if hr := call(i.VTBL.GetBoundsMode, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&boundsMode))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller3::get_BoundsMode", HRESULT: hr}
+	}
+
+	return boundsMode, nil
+}
+
+// PutBoundsMode calls ICoreWebView2Controller3::put_BoundsMode.
+func (i *ICoreWebView2Controller3) PutBoundsMode(boundsMode COREWEBVIEW2_BOUNDS_MODE) error {
+	if hr := call(i.VTBL.PutBoundsMode, uintptr(unsafe.Pointer(i)), uintptr(boundsMode)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller3::put_BoundsMode", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Controller4 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2controller4
+	ICoreWebView2Controller4 struct {
+		VTBL *ICoreWebView2Controller4VTBL
+	}
+
+	// ICoreWebView2Controller4VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2controller4
+	ICoreWebView2Controller4VTBL struct {
+		ICoreWebView2Controller3VTBL
+		GetAllowExternalDrop uintptr
+		PutAllowExternalDrop uintptr
+	}
+)
+
+// GetIsVisible calls ICoreWebView2Controller::get_IsVisible.
+func (i *ICoreWebView2Controller4) GetIsVisible() (bool, error) {
+	var isVisible int32
+
+	if hr := call(i.VTBL.GetIsVisible, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isVisible))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Controller::get_IsVisible", HRESULT: hr}
+	}
+
+	return isVisible != 0, nil
+}
+
+// PutIsVisible calls ICoreWebView2Controller::put_IsVisible.
+func (i *ICoreWebView2Controller4) PutIsVisible(isVisible bool) error {
+	if hr := call(i.VTBL.PutIsVisible, uintptr(unsafe.Pointer(i)), boolToUintptr(isVisible)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_IsVisible", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetBounds calls ICoreWebView2Controller::get_Bounds.
+func (i *ICoreWebView2Controller4) GetBounds() (RECT, error) {
+	var bounds RECT
+
+	if hr := call(i.VTBL.GetBounds, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&bounds))); hr.Failed() {
+		return RECT{}, &Error{Method: "ICoreWebView2Controller::get_Bounds", HRESULT: hr}
+	}
+
+	return bounds, nil
+}
+
+// GetZoomFactor calls ICoreWebView2Controller::get_ZoomFactor.
+func (i *ICoreWebView2Controller4) GetZoomFactor() (float64, error) {
+	var zoomFactor float64
+
+	if hr := call(i.VTBL.GetZoomFactor, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&zoomFactor))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller::get_ZoomFactor", HRESULT: hr}
+	}
+
+	return zoomFactor, nil
+}
+
+// PutZoomFactor isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// AddZoomFactorChanged calls ICoreWebView2Controller::add_ZoomFactorChanged.
+func (i *ICoreWebView2Controller4) AddZoomFactorChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddZoomFactorChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_ZoomFactorChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// MoveFocus calls ICoreWebView2Controller::MoveFocus.
+func (i *ICoreWebView2Controller4) MoveFocus(reason COREWEBVIEW2_MOVE_FOCUS_REASON) error {
+	if hr := call(i.VTBL.MoveFocus, uintptr(unsafe.Pointer(i)), uintptr(reason)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::MoveFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddMoveFocusRequested calls ICoreWebView2Controller::add_MoveFocusRequested.
+func (i *ICoreWebView2Controller4) AddMoveFocusRequested(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddMoveFocusRequested, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_MoveFocusRequested", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddGotFocus calls ICoreWebView2Controller::add_GotFocus.
+func (i *ICoreWebView2Controller4) AddGotFocus(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddGotFocus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_GotFocus", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddLostFocus calls ICoreWebView2Controller::add_LostFocus.
+func (i *ICoreWebView2Controller4) AddLostFocus(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddLostFocus, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_LostFocus", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// AddAcceleratorKeyPressed calls ICoreWebView2Controller::add_AcceleratorKeyPressed.
+func (i *ICoreWebView2Controller4) AddAcceleratorKeyPressed(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddAcceleratorKeyPressed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller::add_AcceleratorKeyPressed", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetParentWindow calls ICoreWebView2Controller::get_ParentWindow.
+func (i *ICoreWebView2Controller4) GetParentWindow() (windows.Handle, error) {
+	var parentWindow windows.Handle
+
+	if hr := call(i.VTBL.GetParentWindow, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&parentWindow))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller::get_ParentWindow", HRESULT: hr}
+	}
+
+	return parentWindow, nil
+}
+
+// PutParentWindow calls ICoreWebView2Controller::put_ParentWindow.
+func (i *ICoreWebView2Controller4) PutParentWindow(parentWindow windows.Handle) error {
+	if hr := call(i.VTBL.PutParentWindow, uintptr(unsafe.Pointer(i)), uintptr(parentWindow)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_ParentWindow", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// NotifyParentWindowPositionChanged calls ICoreWebView2Controller::NotifyParentWindowPositionChanged.
+func (i *ICoreWebView2Controller4) NotifyParentWindowPositionChanged() error {
+	if hr := call(i.VTBL.NotifyParentWindowPositionChanged, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::NotifyParentWindowPositionChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// Close calls ICoreWebView2Controller::Close.
+func (i *ICoreWebView2Controller4) Close() error {
+	if hr := call(i.VTBL.Close, uintptr(unsafe.Pointer(i))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::Close", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetCoreWebView2 calls ICoreWebView2Controller::get_CoreWebView2.
+func (i *ICoreWebView2Controller4) GetCoreWebView2() (*ICoreWebView2, error) {
+	var coreWebView2 *ICoreWebView2
+
+	if hr := call(i.VTBL.GetCoreWebView2, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&coreWebView2))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Controller::get_CoreWebView2", HRESULT: hr}
+	}
+
+	return coreWebView2, nil
+}
+
+// GetDefaultBackgroundColor calls ICoreWebView2Controller2::get_DefaultBackgroundColor.
+func (i *ICoreWebView2Controller4) GetDefaultBackgroundColor() (COREWEBVIEW2_COLOR, error) {
+	var backgroundColor COREWEBVIEW2_COLOR
+
+	if hr := call(i.VTBL.GetDefaultBackgroundColor, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&backgroundColor))); hr.Failed() {
+		return COREWEBVIEW2_COLOR{}, &Error{Method: "ICoreWebView2Controller2::get_DefaultBackgroundColor", HRESULT: hr}
+	}
+
+	return backgroundColor, nil
+}
+
+// PutDefaultBackgroundColor calls ICoreWebView2Controller2::put_DefaultBackgroundColor.
+func (i *ICoreWebView2Controller4) PutDefaultBackgroundColor(backgroundColor COREWEBVIEW2_COLOR) error {
+	if hr := call(i.VTBL.PutDefaultBackgroundColor, uintptr(unsafe.Pointer(i)), uintptr(backgroundColor.A)|uintptr(backgroundColor.R)<<8|uintptr(backgroundColor.G)<<16|uintptr(backgroundColor.B)<<24); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller2::put_DefaultBackgroundColor", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetRasterizationScale calls ICoreWebView2Controller3::get_RasterizationScale.
+func (i *ICoreWebView2Controller4) GetRasterizationScale() (float64, error) {
+	var scale float64
+
+	if hr := call(i.VTBL.GetRasterizationScale, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&scale))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller3::get_RasterizationScale", HRESULT: hr}
+	}
+
+	return scale, nil
+}
+
+// PutRasterizationScale isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// GetShouldDetectMonitorScaleChanges calls ICoreWebView2Controller3::get_ShouldDetectMonitorScaleChanges.
+func (i *ICoreWebView2Controller4) GetShouldDetectMonitorScaleChanges() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetShouldDetectMonitorScaleChanges, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Controller3::get_ShouldDetectMonitorScaleChanges", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// PutShouldDetectMonitorScaleChanges calls ICoreWebView2Controller3::put_ShouldDetectMonitorScaleChanges.
+func (i *ICoreWebView2Controller4) PutShouldDetectMonitorScaleChanges(value bool) error {
+	if hr := call(i.VTBL.PutShouldDetectMonitorScaleChanges, uintptr(unsafe.Pointer(i)), boolToUintptr(value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller3::put_ShouldDetectMonitorScaleChanges", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// AddRasterizationScaleChanged calls ICoreWebView2Controller3::add_RasterizationScaleChanged.
+func (i *ICoreWebView2Controller4) AddRasterizationScaleChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddRasterizationScaleChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Controller3::add_RasterizationScaleChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetBoundsMode calls ICoreWebView2Controller3::get_BoundsMode.
+func (i *ICoreWebView2Controller4) GetBoundsMode() (COREWEBVIEW2_BOUNDS_MODE, error) {
+	var boundsMode COREWEBVIEW2_BOUNDS_MODE
+
+	if hr := call(i.VTBL.GetBoundsMode, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&boundsMode))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2Controller3::get_BoundsMode", HRESULT: hr}
+	}
+
+	return boundsMode, nil
+}
+
+// PutBoundsMode calls ICoreWebView2Controller3::put_BoundsMode.
+func (i *ICoreWebView2Controller4) PutBoundsMode(boundsMode COREWEBVIEW2_BOUNDS_MODE) error {
+	if hr := call(i.VTBL.PutBoundsMode, uintptr(unsafe.Pointer(i)), uintptr(boundsMode)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller3::put_BoundsMode", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAllowExternalDrop calls ICoreWebView2Controller4::get_AllowExternalDrop.
+func (i *ICoreWebView2Controller4) GetAllowExternalDrop() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetAllowExternalDrop, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Controller4::get_AllowExternalDrop", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// PutAllowExternalDrop calls ICoreWebView2Controller4::put_AllowExternalDrop.
+func (i *ICoreWebView2Controller4) PutAllowExternalDrop(value bool) error {
+	if hr := call(i.VTBL.PutAllowExternalDrop, uintptr(unsafe.Pointer(i)), boolToUintptr(value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller4::put_AllowExternalDrop", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2RasterizationScaleChangedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2rasterizationscalechangedeventhandler
+	ICoreWebView2RasterizationScaleChangedEventHandler struct {
+		VTBL *ICoreWebView2RasterizationScaleChangedEventHandlerVTBL
+	}
+
+	// ICoreWebView2RasterizationScaleChangedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2rasterizationscalechangedeventhandler
+	ICoreWebView2RasterizationScaleChangedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2RasterizationScaleChangedEventHandler::Invoke.
+func (i *ICoreWebView2RasterizationScaleChangedEventHandler) Invoke(sender *ICoreWebView2Controller, args *IUnknown) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2RasterizationScaleChangedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Settings2 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings2
+	ICoreWebView2Settings2 struct {
+		VTBL *ICoreWebView2Settings2VTBL
+	}
+
+	// ICoreWebView2Settings2VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings2
+	ICoreWebView2Settings2VTBL struct {
+		ICoreWebView2SettingsVTBL
+		GetUserAgent uintptr
+		PutUserAgent uintptr
+	}
+)
+
+// GetIsScriptEnabled calls ICoreWebView2Settings::get_IsScriptEnabled.
+func (i *ICoreWebView2Settings2) GetIsScriptEnabled() (bool, error) {
+	var isScriptEnabled int32
+
+	if hr := call(i.VTBL.GetIsScriptEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isScriptEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return isScriptEnabled != 0, nil
+}
+
+// PutIsScriptEnabled calls ICoreWebView2Settings::put_IsScriptEnabled.
+func (i *ICoreWebView2Settings2) PutIsScriptEnabled(isScriptEnabled bool) error {
+	if hr := call(i.VTBL.PutIsScriptEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isScriptEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsWebMessageEnabled calls ICoreWebView2Settings::get_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings2) GetIsWebMessageEnabled() (bool, error) {
+	var isWebMessageEnabled int32
+
+	if hr := call(i.VTBL.GetIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isWebMessageEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return isWebMessageEnabled != 0, nil
+}
+
+// PutIsWebMessageEnabled calls ICoreWebView2Settings::put_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings2) PutIsWebMessageEnabled(isWebMessageEnabled bool) error {
+	if hr := call(i.VTBL.PutIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isWebMessageEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings2) GetAreDefaultScriptDialogsEnabled() (bool, error) {
+	var areDefaultScriptDialogsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDefaultScriptDialogsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return areDefaultScriptDialogsEnabled != 0, nil
+}
+
+// PutAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings2) PutAreDefaultScriptDialogsEnabled(areDefaultScriptDialogsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDefaultScriptDialogsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsStatusBarEnabled calls ICoreWebView2Settings::get_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings2) GetIsStatusBarEnabled() (bool, error) {
+	var isStatusBarEnabled int32
+
+	if hr := call(i.VTBL.GetIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isStatusBarEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return isStatusBarEnabled != 0, nil
+}
+
+// PutIsStatusBarEnabled calls ICoreWebView2Settings::put_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings2) PutIsStatusBarEnabled(isStatusBarEnabled bool) error {
+	if hr := call(i.VTBL.PutIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isStatusBarEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDevToolsEnabled calls ICoreWebView2Settings::get_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings2) GetAreDevToolsEnabled() (bool, error) {
+	var areDevToolsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDevToolsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return areDevToolsEnabled != 0, nil
+}
+
+// PutAreDevToolsEnabled calls ICoreWebView2Settings::put_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings2) PutAreDevToolsEnabled(areDevToolsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDevToolsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultContextMenusEnabled calls ICoreWebView2Settings::get_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings2) GetAreDefaultContextMenusEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutAreDefaultContextMenusEnabled calls ICoreWebView2Settings::put_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings2) PutAreDefaultContextMenusEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreHostObjectsAllowed calls ICoreWebView2Settings::get_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings2) GetAreHostObjectsAllowed() (bool, error) {
+	var allowed int32
+
+	if hr := call(i.VTBL.GetAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&allowed))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return allowed != 0, nil
+}
+
+// PutAreHostObjectsAllowed calls ICoreWebView2Settings::put_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings2) PutAreHostObjectsAllowed(allowed bool) error {
+	if hr := call(i.VTBL.PutAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), boolToUintptr(allowed)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsZoomControlEnabled calls ICoreWebView2Settings::get_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings2) GetIsZoomControlEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsZoomControlEnabled calls ICoreWebView2Settings::put_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings2) PutIsZoomControlEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings2) GetIsBuiltInErrorPageEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings2) PutIsBuiltInErrorPageEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetUserAgent calls ICoreWebView2Settings2::get_UserAgent.
+func (i *ICoreWebView2Settings2) GetUserAgent() (string, error) {
+	var userAgent *uint16
+
+	if hr := call(i.VTBL.GetUserAgent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&userAgent))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Settings2::get_UserAgent", HRESULT: hr}
+	}
+
+	return coTaskMemString(userAgent), nil
+}
+
+// PutUserAgent calls ICoreWebView2Settings2::put_UserAgent.
+func (i *ICoreWebView2Settings2) PutUserAgent(userAgent string) error {
+	userAgentPtr, err := windows.UTF16PtrFromString(userAgent)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2Settings2::put_UserAgent: invalid userAgent: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutUserAgent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(userAgentPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings2::put_UserAgent", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Settings3 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings3
+	ICoreWebView2Settings3 struct {
+		VTBL *ICoreWebView2Settings3VTBL
+	}
+
+	// ICoreWebView2Settings3VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings3
+	ICoreWebView2Settings3VTBL struct {
+		ICoreWebView2Settings2VTBL
+		GetAreBrowserAcceleratorKeysEnabled uintptr
+		PutAreBrowserAcceleratorKeysEnabled uintptr
+	}
+)
+
+// GetIsScriptEnabled calls ICoreWebView2Settings::get_IsScriptEnabled.
+func (i *ICoreWebView2Settings3) GetIsScriptEnabled() (bool, error) {
+	var isScriptEnabled int32
+
+	if hr := call(i.VTBL.GetIsScriptEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isScriptEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return isScriptEnabled != 0, nil
+}
+
+// PutIsScriptEnabled calls ICoreWebView2Settings::put_IsScriptEnabled.
+func (i *ICoreWebView2Settings3) PutIsScriptEnabled(isScriptEnabled bool) error {
+	if hr := call(i.VTBL.PutIsScriptEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isScriptEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsWebMessageEnabled calls ICoreWebView2Settings::get_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings3) GetIsWebMessageEnabled() (bool, error) {
+	var isWebMessageEnabled int32
+
+	if hr := call(i.VTBL.GetIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isWebMessageEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return isWebMessageEnabled != 0, nil
+}
+
+// PutIsWebMessageEnabled calls ICoreWebView2Settings::put_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings3) PutIsWebMessageEnabled(isWebMessageEnabled bool) error {
+	if hr := call(i.VTBL.PutIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isWebMessageEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings3) GetAreDefaultScriptDialogsEnabled() (bool, error) {
+	var areDefaultScriptDialogsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDefaultScriptDialogsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return areDefaultScriptDialogsEnabled != 0, nil
+}
+
+// PutAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings3) PutAreDefaultScriptDialogsEnabled(areDefaultScriptDialogsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDefaultScriptDialogsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsStatusBarEnabled calls ICoreWebView2Settings::get_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings3) GetIsStatusBarEnabled() (bool, error) {
+	var isStatusBarEnabled int32
+
+	if hr := call(i.VTBL.GetIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isStatusBarEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return isStatusBarEnabled != 0, nil
+}
+
+// PutIsStatusBarEnabled calls ICoreWebView2Settings::put_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings3) PutIsStatusBarEnabled(isStatusBarEnabled bool) error {
+	if hr := call(i.VTBL.PutIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isStatusBarEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDevToolsEnabled calls ICoreWebView2Settings::get_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings3) GetAreDevToolsEnabled() (bool, error) {
+	var areDevToolsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDevToolsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return areDevToolsEnabled != 0, nil
+}
+
+// PutAreDevToolsEnabled calls ICoreWebView2Settings::put_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings3) PutAreDevToolsEnabled(areDevToolsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDevToolsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultContextMenusEnabled calls ICoreWebView2Settings::get_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings3) GetAreDefaultContextMenusEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutAreDefaultContextMenusEnabled calls ICoreWebView2Settings::put_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings3) PutAreDefaultContextMenusEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreHostObjectsAllowed calls ICoreWebView2Settings::get_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings3) GetAreHostObjectsAllowed() (bool, error) {
+	var allowed int32
+
+	if hr := call(i.VTBL.GetAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&allowed))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return allowed != 0, nil
+}
+
+// PutAreHostObjectsAllowed calls ICoreWebView2Settings::put_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings3) PutAreHostObjectsAllowed(allowed bool) error {
+	if hr := call(i.VTBL.PutAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), boolToUintptr(allowed)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsZoomControlEnabled calls ICoreWebView2Settings::get_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings3) GetIsZoomControlEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsZoomControlEnabled calls ICoreWebView2Settings::put_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings3) PutIsZoomControlEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings3) GetIsBuiltInErrorPageEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings3) PutIsBuiltInErrorPageEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetUserAgent calls ICoreWebView2Settings2::get_UserAgent.
+func (i *ICoreWebView2Settings3) GetUserAgent() (string, error) {
+	var userAgent *uint16
+
+	if hr := call(i.VTBL.GetUserAgent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&userAgent))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Settings2::get_UserAgent", HRESULT: hr}
+	}
+
+	return coTaskMemString(userAgent), nil
+}
+
+// PutUserAgent calls ICoreWebView2Settings2::put_UserAgent.
+func (i *ICoreWebView2Settings3) PutUserAgent(userAgent string) error {
+	userAgentPtr, err := windows.UTF16PtrFromString(userAgent)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2Settings2::put_UserAgent: invalid userAgent: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutUserAgent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(userAgentPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings2::put_UserAgent", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreBrowserAcceleratorKeysEnabled calls ICoreWebView2Settings3::get_AreBrowserAcceleratorKeysEnabled.
+func (i *ICoreWebView2Settings3) GetAreBrowserAcceleratorKeysEnabled() (bool, error) {
+	var areBrowserAcceleratorKeysEnabled int32
+
+	if hr := call(i.VTBL.GetAreBrowserAcceleratorKeysEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areBrowserAcceleratorKeysEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings3::get_AreBrowserAcceleratorKeysEnabled", HRESULT: hr}
+	}
+
+	return areBrowserAcceleratorKeysEnabled != 0, nil
+}
+
+// PutAreBrowserAcceleratorKeysEnabled calls ICoreWebView2Settings3::put_AreBrowserAcceleratorKeysEnabled.
+func (i *ICoreWebView2Settings3) PutAreBrowserAcceleratorKeysEnabled(areBrowserAcceleratorKeysEnabled bool) error {
+	if hr := call(i.VTBL.PutAreBrowserAcceleratorKeysEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areBrowserAcceleratorKeysEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings3::put_AreBrowserAcceleratorKeysEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Settings4 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings4
+	ICoreWebView2Settings4 struct {
+		VTBL *ICoreWebView2Settings4VTBL
+	}
+
+	// ICoreWebView2Settings4VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings4
+	ICoreWebView2Settings4VTBL struct {
+		ICoreWebView2Settings3VTBL
+		GetIsPasswordAutosaveEnabled uintptr
+		PutIsPasswordAutosaveEnabled uintptr
+		GetIsGeneralAutofillEnabled  uintptr
+		PutIsGeneralAutofillEnabled  uintptr
+	}
+)
+
+// GetIsScriptEnabled calls ICoreWebView2Settings::get_IsScriptEnabled.
+func (i *ICoreWebView2Settings4) GetIsScriptEnabled() (bool, error) {
+	var isScriptEnabled int32
+
+	if hr := call(i.VTBL.GetIsScriptEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isScriptEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return isScriptEnabled != 0, nil
+}
+
+// PutIsScriptEnabled calls ICoreWebView2Settings::put_IsScriptEnabled.
+func (i *ICoreWebView2Settings4) PutIsScriptEnabled(isScriptEnabled bool) error {
+	if hr := call(i.VTBL.PutIsScriptEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isScriptEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsWebMessageEnabled calls ICoreWebView2Settings::get_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings4) GetIsWebMessageEnabled() (bool, error) {
+	var isWebMessageEnabled int32
+
+	if hr := call(i.VTBL.GetIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isWebMessageEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return isWebMessageEnabled != 0, nil
+}
+
+// PutIsWebMessageEnabled calls ICoreWebView2Settings::put_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings4) PutIsWebMessageEnabled(isWebMessageEnabled bool) error {
+	if hr := call(i.VTBL.PutIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isWebMessageEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings4) GetAreDefaultScriptDialogsEnabled() (bool, error) {
+	var areDefaultScriptDialogsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDefaultScriptDialogsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return areDefaultScriptDialogsEnabled != 0, nil
+}
+
+// PutAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings4) PutAreDefaultScriptDialogsEnabled(areDefaultScriptDialogsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDefaultScriptDialogsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsStatusBarEnabled calls ICoreWebView2Settings::get_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings4) GetIsStatusBarEnabled() (bool, error) {
+	var isStatusBarEnabled int32
+
+	if hr := call(i.VTBL.GetIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isStatusBarEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return isStatusBarEnabled != 0, nil
+}
+
+// PutIsStatusBarEnabled calls ICoreWebView2Settings::put_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings4) PutIsStatusBarEnabled(isStatusBarEnabled bool) error {
+	if hr := call(i.VTBL.PutIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isStatusBarEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDevToolsEnabled calls ICoreWebView2Settings::get_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings4) GetAreDevToolsEnabled() (bool, error) {
+	var areDevToolsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDevToolsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return areDevToolsEnabled != 0, nil
+}
+
+// PutAreDevToolsEnabled calls ICoreWebView2Settings::put_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings4) PutAreDevToolsEnabled(areDevToolsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDevToolsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultContextMenusEnabled calls ICoreWebView2Settings::get_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings4) GetAreDefaultContextMenusEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutAreDefaultContextMenusEnabled calls ICoreWebView2Settings::put_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings4) PutAreDefaultContextMenusEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreHostObjectsAllowed calls ICoreWebView2Settings::get_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings4) GetAreHostObjectsAllowed() (bool, error) {
+	var allowed int32
+
+	if hr := call(i.VTBL.GetAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&allowed))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return allowed != 0, nil
+}
+
+// PutAreHostObjectsAllowed calls ICoreWebView2Settings::put_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings4) PutAreHostObjectsAllowed(allowed bool) error {
+	if hr := call(i.VTBL.PutAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), boolToUintptr(allowed)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsZoomControlEnabled calls ICoreWebView2Settings::get_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings4) GetIsZoomControlEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsZoomControlEnabled calls ICoreWebView2Settings::put_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings4) PutIsZoomControlEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings4) GetIsBuiltInErrorPageEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings4) PutIsBuiltInErrorPageEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetUserAgent calls ICoreWebView2Settings2::get_UserAgent.
+func (i *ICoreWebView2Settings4) GetUserAgent() (string, error) {
+	var userAgent *uint16
+
+	if hr := call(i.VTBL.GetUserAgent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&userAgent))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Settings2::get_UserAgent", HRESULT: hr}
+	}
+
+	return coTaskMemString(userAgent), nil
+}
+
+// PutUserAgent calls ICoreWebView2Settings2::put_UserAgent.
+func (i *ICoreWebView2Settings4) PutUserAgent(userAgent string) error {
+	userAgentPtr, err := windows.UTF16PtrFromString(userAgent)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2Settings2::put_UserAgent: invalid userAgent: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutUserAgent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(userAgentPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings2::put_UserAgent", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreBrowserAcceleratorKeysEnabled calls ICoreWebView2Settings3::get_AreBrowserAcceleratorKeysEnabled.
+func (i *ICoreWebView2Settings4) GetAreBrowserAcceleratorKeysEnabled() (bool, error) {
+	var areBrowserAcceleratorKeysEnabled int32
+
+	if hr := call(i.VTBL.GetAreBrowserAcceleratorKeysEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areBrowserAcceleratorKeysEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings3::get_AreBrowserAcceleratorKeysEnabled", HRESULT: hr}
+	}
+
+	return areBrowserAcceleratorKeysEnabled != 0, nil
+}
+
+// PutAreBrowserAcceleratorKeysEnabled calls ICoreWebView2Settings3::put_AreBrowserAcceleratorKeysEnabled.
+func (i *ICoreWebView2Settings4) PutAreBrowserAcceleratorKeysEnabled(areBrowserAcceleratorKeysEnabled bool) error {
+	if hr := call(i.VTBL.PutAreBrowserAcceleratorKeysEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areBrowserAcceleratorKeysEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings3::put_AreBrowserAcceleratorKeysEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsPasswordAutosaveEnabled calls ICoreWebView2Settings4::get_IsPasswordAutosaveEnabled.
+func (i *ICoreWebView2Settings4) GetIsPasswordAutosaveEnabled() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetIsPasswordAutosaveEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings4::get_IsPasswordAutosaveEnabled", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// PutIsPasswordAutosaveEnabled calls ICoreWebView2Settings4::put_IsPasswordAutosaveEnabled.
+func (i *ICoreWebView2Settings4) PutIsPasswordAutosaveEnabled(value bool) error {
+	if hr := call(i.VTBL.PutIsPasswordAutosaveEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings4::put_IsPasswordAutosaveEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsGeneralAutofillEnabled calls ICoreWebView2Settings4::get_IsGeneralAutofillEnabled.
+func (i *ICoreWebView2Settings4) GetIsGeneralAutofillEnabled() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetIsGeneralAutofillEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings4::get_IsGeneralAutofillEnabled", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// PutIsGeneralAutofillEnabled calls ICoreWebView2Settings4::put_IsGeneralAutofillEnabled.
+func (i *ICoreWebView2Settings4) PutIsGeneralAutofillEnabled(value bool) error {
+	if hr := call(i.VTBL.PutIsGeneralAutofillEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings4::put_IsGeneralAutofillEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Settings5 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings5
+	ICoreWebView2Settings5 struct {
+		VTBL *ICoreWebView2Settings5VTBL
+	}
+
+	// ICoreWebView2Settings5VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings5
+	ICoreWebView2Settings5VTBL struct {
+		ICoreWebView2Settings4VTBL
+		GetIsPinchZoomEnabled uintptr
+		PutIsPinchZoomEnabled uintptr
+	}
+)
+
+// GetIsScriptEnabled calls ICoreWebView2Settings::get_IsScriptEnabled.
+func (i *ICoreWebView2Settings5) GetIsScriptEnabled() (bool, error) {
+	var isScriptEnabled int32
+
+	if hr := call(i.VTBL.GetIsScriptEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isScriptEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return isScriptEnabled != 0, nil
+}
+
+// PutIsScriptEnabled calls ICoreWebView2Settings::put_IsScriptEnabled.
+func (i *ICoreWebView2Settings5) PutIsScriptEnabled(isScriptEnabled bool) error {
+	if hr := call(i.VTBL.PutIsScriptEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isScriptEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsWebMessageEnabled calls ICoreWebView2Settings::get_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings5) GetIsWebMessageEnabled() (bool, error) {
+	var isWebMessageEnabled int32
+
+	if hr := call(i.VTBL.GetIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isWebMessageEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return isWebMessageEnabled != 0, nil
+}
+
+// PutIsWebMessageEnabled calls ICoreWebView2Settings::put_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings5) PutIsWebMessageEnabled(isWebMessageEnabled bool) error {
+	if hr := call(i.VTBL.PutIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isWebMessageEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings5) GetAreDefaultScriptDialogsEnabled() (bool, error) {
+	var areDefaultScriptDialogsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDefaultScriptDialogsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return areDefaultScriptDialogsEnabled != 0, nil
+}
+
+// PutAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings5) PutAreDefaultScriptDialogsEnabled(areDefaultScriptDialogsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDefaultScriptDialogsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsStatusBarEnabled calls ICoreWebView2Settings::get_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings5) GetIsStatusBarEnabled() (bool, error) {
+	var isStatusBarEnabled int32
+
+	if hr := call(i.VTBL.GetIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isStatusBarEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return isStatusBarEnabled != 0, nil
+}
+
+// PutIsStatusBarEnabled calls ICoreWebView2Settings::put_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings5) PutIsStatusBarEnabled(isStatusBarEnabled bool) error {
+	if hr := call(i.VTBL.PutIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isStatusBarEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDevToolsEnabled calls ICoreWebView2Settings::get_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings5) GetAreDevToolsEnabled() (bool, error) {
+	var areDevToolsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDevToolsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return areDevToolsEnabled != 0, nil
+}
+
+// PutAreDevToolsEnabled calls ICoreWebView2Settings::put_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings5) PutAreDevToolsEnabled(areDevToolsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDevToolsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultContextMenusEnabled calls ICoreWebView2Settings::get_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings5) GetAreDefaultContextMenusEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutAreDefaultContextMenusEnabled calls ICoreWebView2Settings::put_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings5) PutAreDefaultContextMenusEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreHostObjectsAllowed calls ICoreWebView2Settings::get_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings5) GetAreHostObjectsAllowed() (bool, error) {
+	var allowed int32
+
+	if hr := call(i.VTBL.GetAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&allowed))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return allowed != 0, nil
+}
+
+// PutAreHostObjectsAllowed calls ICoreWebView2Settings::put_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings5) PutAreHostObjectsAllowed(allowed bool) error {
+	if hr := call(i.VTBL.PutAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), boolToUintptr(allowed)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsZoomControlEnabled calls ICoreWebView2Settings::get_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings5) GetIsZoomControlEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsZoomControlEnabled calls ICoreWebView2Settings::put_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings5) PutIsZoomControlEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings5) GetIsBuiltInErrorPageEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings5) PutIsBuiltInErrorPageEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetUserAgent calls ICoreWebView2Settings2::get_UserAgent.
+func (i *ICoreWebView2Settings5) GetUserAgent() (string, error) {
+	var userAgent *uint16
+
+	if hr := call(i.VTBL.GetUserAgent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&userAgent))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Settings2::get_UserAgent", HRESULT: hr}
+	}
+
+	return coTaskMemString(userAgent), nil
+}
+
+// PutUserAgent calls ICoreWebView2Settings2::put_UserAgent.
+func (i *ICoreWebView2Settings5) PutUserAgent(userAgent string) error {
+	userAgentPtr, err := windows.UTF16PtrFromString(userAgent)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2Settings2::put_UserAgent: invalid userAgent: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutUserAgent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(userAgentPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings2::put_UserAgent", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreBrowserAcceleratorKeysEnabled calls ICoreWebView2Settings3::get_AreBrowserAcceleratorKeysEnabled.
+func (i *ICoreWebView2Settings5) GetAreBrowserAcceleratorKeysEnabled() (bool, error) {
+	var areBrowserAcceleratorKeysEnabled int32
+
+	if hr := call(i.VTBL.GetAreBrowserAcceleratorKeysEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areBrowserAcceleratorKeysEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings3::get_AreBrowserAcceleratorKeysEnabled", HRESULT: hr}
+	}
+
+	return areBrowserAcceleratorKeysEnabled != 0, nil
+}
+
+// PutAreBrowserAcceleratorKeysEnabled calls ICoreWebView2Settings3::put_AreBrowserAcceleratorKeysEnabled.
+func (i *ICoreWebView2Settings5) PutAreBrowserAcceleratorKeysEnabled(areBrowserAcceleratorKeysEnabled bool) error {
+	if hr := call(i.VTBL.PutAreBrowserAcceleratorKeysEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areBrowserAcceleratorKeysEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings3::put_AreBrowserAcceleratorKeysEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsPasswordAutosaveEnabled calls ICoreWebView2Settings4::get_IsPasswordAutosaveEnabled.
+func (i *ICoreWebView2Settings5) GetIsPasswordAutosaveEnabled() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetIsPasswordAutosaveEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings4::get_IsPasswordAutosaveEnabled", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// PutIsPasswordAutosaveEnabled calls ICoreWebView2Settings4::put_IsPasswordAutosaveEnabled.
+func (i *ICoreWebView2Settings5) PutIsPasswordAutosaveEnabled(value bool) error {
+	if hr := call(i.VTBL.PutIsPasswordAutosaveEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings4::put_IsPasswordAutosaveEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsGeneralAutofillEnabled calls ICoreWebView2Settings4::get_IsGeneralAutofillEnabled.
+func (i *ICoreWebView2Settings5) GetIsGeneralAutofillEnabled() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetIsGeneralAutofillEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings4::get_IsGeneralAutofillEnabled", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// PutIsGeneralAutofillEnabled calls ICoreWebView2Settings4::put_IsGeneralAutofillEnabled.
+func (i *ICoreWebView2Settings5) PutIsGeneralAutofillEnabled(value bool) error {
+	if hr := call(i.VTBL.PutIsGeneralAutofillEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings4::put_IsGeneralAutofillEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsPinchZoomEnabled calls ICoreWebView2Settings5::get_IsPinchZoomEnabled.
+func (i *ICoreWebView2Settings5) GetIsPinchZoomEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsPinchZoomEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings5::get_IsPinchZoomEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsPinchZoomEnabled calls ICoreWebView2Settings5::put_IsPinchZoomEnabled.
+func (i *ICoreWebView2Settings5) PutIsPinchZoomEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsPinchZoomEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings5::put_IsPinchZoomEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Settings6 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings6
+	ICoreWebView2Settings6 struct {
+		VTBL *ICoreWebView2Settings6VTBL
+	}
+
+	// ICoreWebView2Settings6VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2settings6
+	ICoreWebView2Settings6VTBL struct {
+		ICoreWebView2Settings5VTBL
+		GetIsSwipeNavigationEnabled uintptr
+		PutIsSwipeNavigationEnabled uintptr
+	}
+)
+
+// GetIsScriptEnabled calls ICoreWebView2Settings::get_IsScriptEnabled.
+func (i *ICoreWebView2Settings6) GetIsScriptEnabled() (bool, error) {
+	var isScriptEnabled int32
+
+	if hr := call(i.VTBL.GetIsScriptEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isScriptEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return isScriptEnabled != 0, nil
+}
+
+// PutIsScriptEnabled calls ICoreWebView2Settings::put_IsScriptEnabled.
+func (i *ICoreWebView2Settings6) PutIsScriptEnabled(isScriptEnabled bool) error {
+	if hr := call(i.VTBL.PutIsScriptEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isScriptEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsScriptEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsWebMessageEnabled calls ICoreWebView2Settings::get_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings6) GetIsWebMessageEnabled() (bool, error) {
+	var isWebMessageEnabled int32
+
+	if hr := call(i.VTBL.GetIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isWebMessageEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return isWebMessageEnabled != 0, nil
+}
+
+// PutIsWebMessageEnabled calls ICoreWebView2Settings::put_IsWebMessageEnabled.
+func (i *ICoreWebView2Settings6) PutIsWebMessageEnabled(isWebMessageEnabled bool) error {
+	if hr := call(i.VTBL.PutIsWebMessageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isWebMessageEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsWebMessageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings6) GetAreDefaultScriptDialogsEnabled() (bool, error) {
+	var areDefaultScriptDialogsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDefaultScriptDialogsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return areDefaultScriptDialogsEnabled != 0, nil
+}
+
+// PutAreDefaultScriptDialogsEnabled calls ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled.
+func (i *ICoreWebView2Settings6) PutAreDefaultScriptDialogsEnabled(areDefaultScriptDialogsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultScriptDialogsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDefaultScriptDialogsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultScriptDialogsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsStatusBarEnabled calls ICoreWebView2Settings::get_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings6) GetIsStatusBarEnabled() (bool, error) {
+	var isStatusBarEnabled int32
+
+	if hr := call(i.VTBL.GetIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&isStatusBarEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return isStatusBarEnabled != 0, nil
+}
+
+// PutIsStatusBarEnabled calls ICoreWebView2Settings::put_IsStatusBarEnabled.
+func (i *ICoreWebView2Settings6) PutIsStatusBarEnabled(isStatusBarEnabled bool) error {
+	if hr := call(i.VTBL.PutIsStatusBarEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(isStatusBarEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsStatusBarEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDevToolsEnabled calls ICoreWebView2Settings::get_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings6) GetAreDevToolsEnabled() (bool, error) {
+	var areDevToolsEnabled int32
+
+	if hr := call(i.VTBL.GetAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areDevToolsEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return areDevToolsEnabled != 0, nil
+}
+
+// PutAreDevToolsEnabled calls ICoreWebView2Settings::put_AreDevToolsEnabled.
+func (i *ICoreWebView2Settings6) PutAreDevToolsEnabled(areDevToolsEnabled bool) error {
+	if hr := call(i.VTBL.PutAreDevToolsEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areDevToolsEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDevToolsEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreDefaultContextMenusEnabled calls ICoreWebView2Settings::get_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings6) GetAreDefaultContextMenusEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutAreDefaultContextMenusEnabled calls ICoreWebView2Settings::put_AreDefaultContextMenusEnabled.
+func (i *ICoreWebView2Settings6) PutAreDefaultContextMenusEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutAreDefaultContextMenusEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreDefaultContextMenusEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreHostObjectsAllowed calls ICoreWebView2Settings::get_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings6) GetAreHostObjectsAllowed() (bool, error) {
+	var allowed int32
+
+	if hr := call(i.VTBL.GetAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&allowed))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return allowed != 0, nil
+}
+
+// PutAreHostObjectsAllowed calls ICoreWebView2Settings::put_AreHostObjectsAllowed.
+func (i *ICoreWebView2Settings6) PutAreHostObjectsAllowed(allowed bool) error {
+	if hr := call(i.VTBL.PutAreHostObjectsAllowed, uintptr(unsafe.Pointer(i)), boolToUintptr(allowed)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_AreHostObjectsAllowed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsZoomControlEnabled calls ICoreWebView2Settings::get_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings6) GetIsZoomControlEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsZoomControlEnabled calls ICoreWebView2Settings::put_IsZoomControlEnabled.
+func (i *ICoreWebView2Settings6) PutIsZoomControlEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsZoomControlEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsZoomControlEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings6) GetIsBuiltInErrorPageEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings::get_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsBuiltInErrorPageEnabled calls ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled.
+func (i *ICoreWebView2Settings6) PutIsBuiltInErrorPageEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsBuiltInErrorPageEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings::put_IsBuiltInErrorPageEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetUserAgent calls ICoreWebView2Settings2::get_UserAgent.
+func (i *ICoreWebView2Settings6) GetUserAgent() (string, error) {
+	var userAgent *uint16
+
+	if hr := call(i.VTBL.GetUserAgent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&userAgent))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Settings2::get_UserAgent", HRESULT: hr}
+	}
+
+	return coTaskMemString(userAgent), nil
+}
+
+// PutUserAgent calls ICoreWebView2Settings2::put_UserAgent.
+func (i *ICoreWebView2Settings6) PutUserAgent(userAgent string) error {
+	userAgentPtr, err := windows.UTF16PtrFromString(userAgent)
+	if err != nil {
+		return fmt.Errorf("ICoreWebView2Settings2::put_UserAgent: invalid userAgent: %w", err)
+	}
+
+	if hr := call(i.VTBL.PutUserAgent, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(userAgentPtr))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings2::put_UserAgent", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetAreBrowserAcceleratorKeysEnabled calls ICoreWebView2Settings3::get_AreBrowserAcceleratorKeysEnabled.
+func (i *ICoreWebView2Settings6) GetAreBrowserAcceleratorKeysEnabled() (bool, error) {
+	var areBrowserAcceleratorKeysEnabled int32
+
+	if hr := call(i.VTBL.GetAreBrowserAcceleratorKeysEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&areBrowserAcceleratorKeysEnabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings3::get_AreBrowserAcceleratorKeysEnabled", HRESULT: hr}
+	}
+
+	return areBrowserAcceleratorKeysEnabled != 0, nil
+}
+
+// PutAreBrowserAcceleratorKeysEnabled calls ICoreWebView2Settings3::put_AreBrowserAcceleratorKeysEnabled.
+func (i *ICoreWebView2Settings6) PutAreBrowserAcceleratorKeysEnabled(areBrowserAcceleratorKeysEnabled bool) error {
+	if hr := call(i.VTBL.PutAreBrowserAcceleratorKeysEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(areBrowserAcceleratorKeysEnabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings3::put_AreBrowserAcceleratorKeysEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsPasswordAutosaveEnabled calls ICoreWebView2Settings4::get_IsPasswordAutosaveEnabled.
+func (i *ICoreWebView2Settings6) GetIsPasswordAutosaveEnabled() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetIsPasswordAutosaveEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings4::get_IsPasswordAutosaveEnabled", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// PutIsPasswordAutosaveEnabled calls ICoreWebView2Settings4::put_IsPasswordAutosaveEnabled.
+func (i *ICoreWebView2Settings6) PutIsPasswordAutosaveEnabled(value bool) error {
+	if hr := call(i.VTBL.PutIsPasswordAutosaveEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings4::put_IsPasswordAutosaveEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsGeneralAutofillEnabled calls ICoreWebView2Settings4::get_IsGeneralAutofillEnabled.
+func (i *ICoreWebView2Settings6) GetIsGeneralAutofillEnabled() (bool, error) {
+	var value int32
+
+	if hr := call(i.VTBL.GetIsGeneralAutofillEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&value))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings4::get_IsGeneralAutofillEnabled", HRESULT: hr}
+	}
+
+	return value != 0, nil
+}
+
+// PutIsGeneralAutofillEnabled calls ICoreWebView2Settings4::put_IsGeneralAutofillEnabled.
+func (i *ICoreWebView2Settings6) PutIsGeneralAutofillEnabled(value bool) error {
+	if hr := call(i.VTBL.PutIsGeneralAutofillEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings4::put_IsGeneralAutofillEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsPinchZoomEnabled calls ICoreWebView2Settings5::get_IsPinchZoomEnabled.
+func (i *ICoreWebView2Settings6) GetIsPinchZoomEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsPinchZoomEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings5::get_IsPinchZoomEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsPinchZoomEnabled calls ICoreWebView2Settings5::put_IsPinchZoomEnabled.
+func (i *ICoreWebView2Settings6) PutIsPinchZoomEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsPinchZoomEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings5::put_IsPinchZoomEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetIsSwipeNavigationEnabled calls ICoreWebView2Settings6::get_IsSwipeNavigationEnabled.
+func (i *ICoreWebView2Settings6) GetIsSwipeNavigationEnabled() (bool, error) {
+	var enabled int32
+
+	if hr := call(i.VTBL.GetIsSwipeNavigationEnabled, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&enabled))); hr.Failed() {
+		return false, &Error{Method: "ICoreWebView2Settings6::get_IsSwipeNavigationEnabled", HRESULT: hr}
+	}
+
+	return enabled != 0, nil
+}
+
+// PutIsSwipeNavigationEnabled calls ICoreWebView2Settings6::put_IsSwipeNavigationEnabled.
+func (i *ICoreWebView2Settings6) PutIsSwipeNavigationEnabled(enabled bool) error {
+	if hr := call(i.VTBL.PutIsSwipeNavigationEnabled, uintptr(unsafe.Pointer(i)), boolToUintptr(enabled)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Settings6::put_IsSwipeNavigationEnabled", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2Environment3 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2environment3
+	ICoreWebView2Environment3 struct {
+		VTBL *ICoreWebView2Environment3VTBL
+	}
+
+	// ICoreWebView2Environment3VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2environment3
+	ICoreWebView2Environment3VTBL struct {
+		ICoreWebView2Environment2VTBL
+		CreateCoreWebView2CompositionController uintptr
+		CreateCoreWebView2PointerInfo           uintptr
+	}
+)
+
+// CreateCoreWebView2Controller calls ICoreWebView2Environment::CreateCoreWebView2Controller.
+func (i *ICoreWebView2Environment3) CreateCoreWebView2Controller(parentWindow windows.Handle, handler *Handler) error {
+	if hr := call(i.VTBL.CreateCoreWebView2Controller, uintptr(unsafe.Pointer(i)), uintptr(parentWindow), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Environment::CreateCoreWebView2Controller", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// CreateWebResourceResponse calls ICoreWebView2Environment::CreateWebResourceResponse.
+func (i *ICoreWebView2Environment3) CreateWebResourceResponse(content *IStream, statusCode int32, reasonPhrase string, headers string) (*ICoreWebView2WebResourceResponse, error) {
+	reasonPhrasePtr, err := windows.UTF16PtrFromString(reasonPhrase)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment::CreateWebResourceResponse: invalid reasonPhrase: %w", err)
+	}
+
+	headersPtr, err := windows.UTF16PtrFromString(headers)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment::CreateWebResourceResponse: invalid headers: %w", err)
+	}
+
+	var response *ICoreWebView2WebResourceResponse
+
+	if hr := call(i.VTBL.CreateWebResourceResponse, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(content)), uintptr(statusCode), uintptr(unsafe.Pointer(reasonPhrasePtr)), uintptr(unsafe.Pointer(headersPtr)), uintptr(unsafe.Pointer(&response))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Environment::CreateWebResourceResponse", HRESULT: hr}
+	}
+
+	return response, nil
+}
+
+// GetBrowserVersionString calls ICoreWebView2Environment::get_BrowserVersionString.
+func (i *ICoreWebView2Environment3) GetBrowserVersionString() (string, error) {
+	var versionInfo *uint16
+
+	if hr := call(i.VTBL.GetBrowserVersionString, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&versionInfo))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Environment::get_BrowserVersionString", HRESULT: hr}
+	}
+
+	return coTaskMemString(versionInfo), nil
+}
+
+// AddNewBrowserVersionAvailable calls ICoreWebView2Environment::add_NewBrowserVersionAvailable.
+func (i *ICoreWebView2Environment3) AddNewBrowserVersionAvailable(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNewBrowserVersionAvailable, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Environment::add_NewBrowserVersionAvailable", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// CreateWebResourceRequest calls ICoreWebView2Environment2::CreateWebResourceRequest.
+func (i *ICoreWebView2Environment3) CreateWebResourceRequest(uri string, method string, postData *IStream, headers string) (*ICoreWebView2WebResourceRequest, error) {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment2::CreateWebResourceRequest: invalid uri: %w", err)
+	}
+
+	methodPtr, err := windows.UTF16PtrFromString(method)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment2::CreateWebResourceRequest: invalid method: %w", err)
+	}
+
+	headersPtr, err := windows.UTF16PtrFromString(headers)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment2::CreateWebResourceRequest: invalid headers: %w", err)
+	}
+
+	var request *ICoreWebView2WebResourceRequest
+
+	if hr := call(i.VTBL.CreateWebResourceRequest, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr)), uintptr(unsafe.Pointer(methodPtr)), uintptr(unsafe.Pointer(postData)), uintptr(unsafe.Pointer(headersPtr)), uintptr(unsafe.Pointer(&request))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Environment2::CreateWebResourceRequest", HRESULT: hr}
+	}
+
+	return request, nil
+}
+
+// CreateCoreWebView2CompositionController calls ICoreWebView2Environment3::CreateCoreWebView2CompositionController.
+func (i *ICoreWebView2Environment3) CreateCoreWebView2CompositionController(parentWindow windows.Handle, handler *Handler) error {
+	if hr := call(i.VTBL.CreateCoreWebView2CompositionController, uintptr(unsafe.Pointer(i)), uintptr(parentWindow), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Environment3::CreateCoreWebView2CompositionController", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// CreateCoreWebView2PointerInfo calls ICoreWebView2Environment3::CreateCoreWebView2PointerInfo.
+func (i *ICoreWebView2Environment3) CreateCoreWebView2PointerInfo() (*ICoreWebView2PointerInfo, error) {
+	var pointerInfo *ICoreWebView2PointerInfo
+
+	if hr := call(i.VTBL.CreateCoreWebView2PointerInfo, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&pointerInfo))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Environment3::CreateCoreWebView2PointerInfo", HRESULT: hr}
+	}
+
+	return pointerInfo, nil
+}
+
+type (
+	// ICoreWebView2Environment4 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2environment4
+	ICoreWebView2Environment4 struct {
+		VTBL *ICoreWebView2Environment4VTBL
+	}
+
+	// ICoreWebView2Environment4VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2environment4
+	ICoreWebView2Environment4VTBL struct {
+		ICoreWebView2Environment3VTBL
+		GetProviderForHwnd uintptr
+	}
+)
+
+// CreateCoreWebView2Controller calls ICoreWebView2Environment::CreateCoreWebView2Controller.
+func (i *ICoreWebView2Environment4) CreateCoreWebView2Controller(parentWindow windows.Handle, handler *Handler) error {
+	if hr := call(i.VTBL.CreateCoreWebView2Controller, uintptr(unsafe.Pointer(i)), uintptr(parentWindow), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Environment::CreateCoreWebView2Controller", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// CreateWebResourceResponse calls ICoreWebView2Environment::CreateWebResourceResponse.
+func (i *ICoreWebView2Environment4) CreateWebResourceResponse(content *IStream, statusCode int32, reasonPhrase string, headers string) (*ICoreWebView2WebResourceResponse, error) {
+	reasonPhrasePtr, err := windows.UTF16PtrFromString(reasonPhrase)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment::CreateWebResourceResponse: invalid reasonPhrase: %w", err)
+	}
+
+	headersPtr, err := windows.UTF16PtrFromString(headers)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment::CreateWebResourceResponse: invalid headers: %w", err)
+	}
+
+	var response *ICoreWebView2WebResourceResponse
+
+	if hr := call(i.VTBL.CreateWebResourceResponse, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(content)), uintptr(statusCode), uintptr(unsafe.Pointer(reasonPhrasePtr)), uintptr(unsafe.Pointer(headersPtr)), uintptr(unsafe.Pointer(&response))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Environment::CreateWebResourceResponse", HRESULT: hr}
+	}
+
+	return response, nil
+}
+
+// GetBrowserVersionString calls ICoreWebView2Environment::get_BrowserVersionString.
+func (i *ICoreWebView2Environment4) GetBrowserVersionString() (string, error) {
+	var versionInfo *uint16
+
+	if hr := call(i.VTBL.GetBrowserVersionString, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&versionInfo))); hr.Failed() {
+		return "", &Error{Method: "ICoreWebView2Environment::get_BrowserVersionString", HRESULT: hr}
+	}
+
+	return coTaskMemString(versionInfo), nil
+}
+
+// AddNewBrowserVersionAvailable calls ICoreWebView2Environment::add_NewBrowserVersionAvailable.
+func (i *ICoreWebView2Environment4) AddNewBrowserVersionAvailable(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddNewBrowserVersionAvailable, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2Environment::add_NewBrowserVersionAvailable", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// CreateWebResourceRequest calls ICoreWebView2Environment2::CreateWebResourceRequest.
+func (i *ICoreWebView2Environment4) CreateWebResourceRequest(uri string, method string, postData *IStream, headers string) (*ICoreWebView2WebResourceRequest, error) {
+	uriPtr, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment2::CreateWebResourceRequest: invalid uri: %w", err)
+	}
+
+	methodPtr, err := windows.UTF16PtrFromString(method)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment2::CreateWebResourceRequest: invalid method: %w", err)
+	}
+
+	headersPtr, err := windows.UTF16PtrFromString(headers)
+	if err != nil {
+		return nil, fmt.Errorf("ICoreWebView2Environment2::CreateWebResourceRequest: invalid headers: %w", err)
+	}
+
+	var request *ICoreWebView2WebResourceRequest
+
+	if hr := call(i.VTBL.CreateWebResourceRequest, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(uriPtr)), uintptr(unsafe.Pointer(methodPtr)), uintptr(unsafe.Pointer(postData)), uintptr(unsafe.Pointer(headersPtr)), uintptr(unsafe.Pointer(&request))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Environment2::CreateWebResourceRequest", HRESULT: hr}
+	}
+
+	return request, nil
+}
+
+// CreateCoreWebView2CompositionController calls ICoreWebView2Environment3::CreateCoreWebView2CompositionController.
+func (i *ICoreWebView2Environment4) CreateCoreWebView2CompositionController(parentWindow windows.Handle, handler *Handler) error {
+	if hr := call(i.VTBL.CreateCoreWebView2CompositionController, uintptr(unsafe.Pointer(i)), uintptr(parentWindow), uintptr(unsafe.Pointer(handler))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Environment3::CreateCoreWebView2CompositionController", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// CreateCoreWebView2PointerInfo calls ICoreWebView2Environment3::CreateCoreWebView2PointerInfo.
+func (i *ICoreWebView2Environment4) CreateCoreWebView2PointerInfo() (*ICoreWebView2PointerInfo, error) {
+	var pointerInfo *ICoreWebView2PointerInfo
+
+	if hr := call(i.VTBL.CreateCoreWebView2PointerInfo, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&pointerInfo))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Environment3::CreateCoreWebView2PointerInfo", HRESULT: hr}
+	}
+
+	return pointerInfo, nil
+}
+
+// GetProviderForHwnd calls ICoreWebView2Environment4::GetProviderForHwnd.
+func (i *ICoreWebView2Environment4) GetProviderForHwnd(hwnd windows.Handle) (*IUnknown, error) {
+	var provider *IUnknown
+
+	if hr := call(i.VTBL.GetProviderForHwnd, uintptr(unsafe.Pointer(i)), uintptr(hwnd), uintptr(unsafe.Pointer(&provider))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2Environment4::GetProviderForHwnd", HRESULT: hr}
+	}
+
+	return provider, nil
+}
+
+type (
+	// ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2createcorewebview2compositioncontrollercompletedhandler
+	ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler struct {
+		VTBL *ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerVTBL
+	}
+
+	// ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2createcorewebview2compositioncontrollercompletedhandler
+	ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler::Invoke.
+func (i *ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler) Invoke(errorCode hresult.HRESULT, webView *ICoreWebView2CompositionController) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(errorCode), uintptr(unsafe.Pointer(webView))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CreateCoreWebView2CompositionControllerCompletedHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2CompositionController implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2compositioncontroller
+	ICoreWebView2CompositionController struct {
+		VTBL *ICoreWebView2CompositionControllerVTBL
+	}
+
+	// ICoreWebView2CompositionControllerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2compositioncontroller
+	ICoreWebView2CompositionControllerVTBL struct {
+		BasicVTBL
+		GetRootVisualTarget uintptr
+		PutRootVisualTarget uintptr
+		SendMouseInput      uintptr
+		SendPointerInput    uintptr
+		GetCursor           uintptr
+		GetSystemCursorID   uintptr
+		AddCursorChanged    uintptr
+		RemoveCursorChanged uintptr
+	}
+)
+
+// GetRootVisualTarget calls ICoreWebView2CompositionController::get_RootVisualTarget.
+func (i *ICoreWebView2CompositionController) GetRootVisualTarget() (*IUnknown, error) {
+	var target *IUnknown
+
+	if hr := call(i.VTBL.GetRootVisualTarget, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&target))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2CompositionController::get_RootVisualTarget", HRESULT: hr}
+	}
+
+	return target, nil
+}
+
+// PutRootVisualTarget calls ICoreWebView2CompositionController::put_RootVisualTarget.
+func (i *ICoreWebView2CompositionController) PutRootVisualTarget(target *IUnknown) error {
+	if hr := call(i.VTBL.PutRootVisualTarget, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(target))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CompositionController::put_RootVisualTarget", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// SendPointerInput calls ICoreWebView2CompositionController::SendPointerInput.
+func (i *ICoreWebView2CompositionController) SendPointerInput(eventKind COREWEBVIEW2_POINTER_EVENT_KIND, pointerInfo *ICoreWebView2PointerInfo) error {
+	if hr := call(i.VTBL.SendPointerInput, uintptr(unsafe.Pointer(i)), uintptr(eventKind), uintptr(unsafe.Pointer(pointerInfo))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CompositionController::SendPointerInput", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetCursor calls ICoreWebView2CompositionController::get_Cursor.
+func (i *ICoreWebView2CompositionController) GetCursor() (windows.Handle, error) {
+	var cursor windows.Handle
+
+	if hr := call(i.VTBL.GetCursor, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&cursor))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2CompositionController::get_Cursor", HRESULT: hr}
+	}
+
+	return cursor, nil
+}
+
+// GetSystemCursorID calls ICoreWebView2CompositionController::get_SystemCursorId.
+func (i *ICoreWebView2CompositionController) GetSystemCursorID() (uint32, error) {
+	var systemCursorId uint32
+
+	if hr := call(i.VTBL.GetSystemCursorID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&systemCursorId))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2CompositionController::get_SystemCursorId", HRESULT: hr}
+	}
+
+	return systemCursorId, nil
+}
+
+// AddCursorChanged calls ICoreWebView2CompositionController::add_CursorChanged.
+func (i *ICoreWebView2CompositionController) AddCursorChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddCursorChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2CompositionController::add_CursorChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+type (
+	// ICoreWebView2CompositionController2 implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2compositioncontroller2
+	ICoreWebView2CompositionController2 struct {
+		VTBL *ICoreWebView2CompositionController2VTBL
+	}
+
+	// ICoreWebView2CompositionController2VTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2compositioncontroller2
+	ICoreWebView2CompositionController2VTBL struct {
+		ICoreWebView2CompositionControllerVTBL
+		GetUIAProvider uintptr
+	}
+)
+
+// GetRootVisualTarget calls ICoreWebView2CompositionController::get_RootVisualTarget.
+func (i *ICoreWebView2CompositionController2) GetRootVisualTarget() (*IUnknown, error) {
+	var target *IUnknown
+
+	if hr := call(i.VTBL.GetRootVisualTarget, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&target))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2CompositionController::get_RootVisualTarget", HRESULT: hr}
+	}
+
+	return target, nil
+}
+
+// PutRootVisualTarget calls ICoreWebView2CompositionController::put_RootVisualTarget.
+func (i *ICoreWebView2CompositionController2) PutRootVisualTarget(target *IUnknown) error {
+	if hr := call(i.VTBL.PutRootVisualTarget, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(target))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CompositionController::put_RootVisualTarget", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// SendPointerInput calls ICoreWebView2CompositionController::SendPointerInput.
+func (i *ICoreWebView2CompositionController2) SendPointerInput(eventKind COREWEBVIEW2_POINTER_EVENT_KIND, pointerInfo *ICoreWebView2PointerInfo) error {
+	if hr := call(i.VTBL.SendPointerInput, uintptr(unsafe.Pointer(i)), uintptr(eventKind), uintptr(unsafe.Pointer(pointerInfo))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CompositionController::SendPointerInput", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetCursor calls ICoreWebView2CompositionController::get_Cursor.
+func (i *ICoreWebView2CompositionController2) GetCursor() (windows.Handle, error) {
+	var cursor windows.Handle
+
+	if hr := call(i.VTBL.GetCursor, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&cursor))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2CompositionController::get_Cursor", HRESULT: hr}
+	}
+
+	return cursor, nil
+}
+
+// GetSystemCursorID calls ICoreWebView2CompositionController::get_SystemCursorId.
+func (i *ICoreWebView2CompositionController2) GetSystemCursorID() (uint32, error) {
+	var systemCursorId uint32
+
+	if hr := call(i.VTBL.GetSystemCursorID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&systemCursorId))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2CompositionController::get_SystemCursorId", HRESULT: hr}
+	}
+
+	return systemCursorId, nil
+}
+
+// AddCursorChanged calls ICoreWebView2CompositionController::add_CursorChanged.
+func (i *ICoreWebView2CompositionController2) AddCursorChanged(eventHandler *Handler) (EventRegistrationToken, error) {
+	var token EventRegistrationToken
+
+	if hr := call(i.VTBL.AddCursorChanged, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); hr.Failed() {
+		return EventRegistrationToken{}, &Error{Method: "ICoreWebView2CompositionController::add_CursorChanged", HRESULT: hr}
+	}
+
+	return token, nil
+}
+
+// GetUIAProvider calls ICoreWebView2CompositionController2::get_UIAProvider.
+func (i *ICoreWebView2CompositionController2) GetUIAProvider() (*IUnknown, error) {
+	var provider *IUnknown
+
+	if hr := call(i.VTBL.GetUIAProvider, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&provider))); hr.Failed() {
+		return nil, &Error{Method: "ICoreWebView2CompositionController2::get_UIAProvider", HRESULT: hr}
+	}
+
+	return provider, nil
+}
+
+type (
+	// ICoreWebView2CursorChangedEventHandler implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2cursorchangedeventhandler
+	ICoreWebView2CursorChangedEventHandler struct {
+		VTBL *ICoreWebView2CursorChangedEventHandlerVTBL
+	}
+
+	// ICoreWebView2CursorChangedEventHandlerVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2cursorchangedeventhandler
+	ICoreWebView2CursorChangedEventHandlerVTBL struct {
+		BasicVTBL
+		Invoke uintptr
+	}
+)
+
+// Invoke calls ICoreWebView2CursorChangedEventHandler::Invoke.
+func (i *ICoreWebView2CursorChangedEventHandler) Invoke(sender *ICoreWebView2CompositionController, args *IUnknown) error {
+	if hr := call(i.VTBL.Invoke, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(sender)), uintptr(unsafe.Pointer(args))); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CursorChangedEventHandler::Invoke", HRESULT: hr}
+	}
+
+	return nil
+}
+
+type (
+	// ICoreWebView2PointerInfo implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2pointerinfo
+	ICoreWebView2PointerInfo struct {
+		VTBL *ICoreWebView2PointerInfoVTBL
+	}
+
+	// ICoreWebView2PointerInfoVTBL implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/icorewebview2pointerinfo
+	ICoreWebView2PointerInfoVTBL struct {
+		BasicVTBL
+		GetPointerKind         uintptr
+		PutPointerKind         uintptr
+		GetPointerID           uintptr
+		PutPointerID           uintptr
+		GetFrameID             uintptr
+		PutFrameID             uintptr
+		GetPointerFlags        uintptr
+		PutPointerFlags        uintptr
+		GetPointerDeviceRect   uintptr
+		PutPointerDeviceRect   uintptr
+		GetDisplayRect         uintptr
+		PutDisplayRect         uintptr
+		GetPixelLocation       uintptr
+		PutPixelLocation       uintptr
+		GetHimetricLocation    uintptr
+		PutHimetricLocation    uintptr
+		GetPixelLocationRaw    uintptr
+		PutPixelLocationRaw    uintptr
+		GetHimetricLocationRaw uintptr
+		PutHimetricLocationRaw uintptr
+		GetTime                uintptr
+		PutTime                uintptr
+		GetHistoryCount        uintptr
+		PutHistoryCount        uintptr
+		GetInputData           uintptr
+		PutInputData           uintptr
+		GetKeyStates           uintptr
+		PutKeyStates           uintptr
+		GetPerformanceCount    uintptr
+		PutPerformanceCount    uintptr
+		GetButtonChangeKind    uintptr
+		PutButtonChangeKind    uintptr
+		GetPenFlags            uintptr
+		PutPenFlags            uintptr
+		GetPenMask             uintptr
+		PutPenMask             uintptr
+		GetPenPressure         uintptr
+		PutPenPressure         uintptr
+		GetPenRotation         uintptr
+		PutPenRotation         uintptr
+		GetPenTiltX            uintptr
+		PutPenTiltX            uintptr
+		GetPenTiltY            uintptr
+		PutPenTiltY            uintptr
+		GetTouchFlags          uintptr
+		PutTouchFlags          uintptr
+		GetTouchMask           uintptr
+		PutTouchMask           uintptr
+		GetTouchContact        uintptr
+		PutTouchContact        uintptr
+		GetTouchContactRaw     uintptr
+		PutTouchContactRaw     uintptr
+		GetTouchOrientation    uintptr
+		PutTouchOrientation    uintptr
+		GetTouchPressure       uintptr
+		PutTouchPressure       uintptr
+	}
+)
+
+// GetPointerKind calls ICoreWebView2PointerInfo::get_PointerKind.
+func (i *ICoreWebView2PointerInfo) GetPointerKind() (uint32, error) {
+	var pointerKind uint32
+
+	if hr := call(i.VTBL.GetPointerKind, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&pointerKind))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_PointerKind", HRESULT: hr}
+	}
+
+	return pointerKind, nil
+}
+
+// PutPointerKind calls ICoreWebView2PointerInfo::put_PointerKind.
+func (i *ICoreWebView2PointerInfo) PutPointerKind(pointerKind uint32) error {
+	if hr := call(i.VTBL.PutPointerKind, uintptr(unsafe.Pointer(i)), uintptr(pointerKind)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PointerKind", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetPointerID calls ICoreWebView2PointerInfo::get_PointerId.
+func (i *ICoreWebView2PointerInfo) GetPointerID() (uint32, error) {
+	var pointerId uint32
+
+	if hr := call(i.VTBL.GetPointerID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&pointerId))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_PointerId", HRESULT: hr}
+	}
+
+	return pointerId, nil
+}
+
+// PutPointerID calls ICoreWebView2PointerInfo::put_PointerId.
+func (i *ICoreWebView2PointerInfo) PutPointerID(pointerId uint32) error {
+	if hr := call(i.VTBL.PutPointerID, uintptr(unsafe.Pointer(i)), uintptr(pointerId)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PointerId", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetFrameID calls ICoreWebView2PointerInfo::get_FrameId.
+func (i *ICoreWebView2PointerInfo) GetFrameID() (uint32, error) {
+	var frameId uint32
+
+	if hr := call(i.VTBL.GetFrameID, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&frameId))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_FrameId", HRESULT: hr}
+	}
+
+	return frameId, nil
+}
+
+// PutFrameID calls ICoreWebView2PointerInfo::put_FrameId.
+func (i *ICoreWebView2PointerInfo) PutFrameID(frameId uint32) error {
+	if hr := call(i.VTBL.PutFrameID, uintptr(unsafe.Pointer(i)), uintptr(frameId)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_FrameId", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetPointerFlags calls ICoreWebView2PointerInfo::get_PointerFlags.
+func (i *ICoreWebView2PointerInfo) GetPointerFlags() (uint32, error) {
+	var pointerFlags uint32
+
+	if hr := call(i.VTBL.GetPointerFlags, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&pointerFlags))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_PointerFlags", HRESULT: hr}
+	}
+
+	return pointerFlags, nil
+}
+
+// PutPointerFlags calls ICoreWebView2PointerInfo::put_PointerFlags.
+func (i *ICoreWebView2PointerInfo) PutPointerFlags(pointerFlags uint32) error {
+	if hr := call(i.VTBL.PutPointerFlags, uintptr(unsafe.Pointer(i)), uintptr(pointerFlags)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PointerFlags", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetPointerDeviceRect calls ICoreWebView2PointerInfo::get_PointerDeviceRect.
+func (i *ICoreWebView2PointerInfo) GetPointerDeviceRect() (RECT, error) {
+	var pointerDeviceRect RECT
+
+	if hr := call(i.VTBL.GetPointerDeviceRect, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&pointerDeviceRect))); hr.Failed() {
+		return RECT{}, &Error{Method: "ICoreWebView2PointerInfo::get_PointerDeviceRect", HRESULT: hr}
+	}
+
+	return pointerDeviceRect, nil
+}
+
+// GetDisplayRect calls ICoreWebView2PointerInfo::get_DisplayRect.
+func (i *ICoreWebView2PointerInfo) GetDisplayRect() (RECT, error) {
+	var displayRect RECT
+
+	if hr := call(i.VTBL.GetDisplayRect, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&displayRect))); hr.Failed() {
+		return RECT{}, &Error{Method: "ICoreWebView2PointerInfo::get_DisplayRect", HRESULT: hr}
+	}
+
+	return displayRect, nil
+}
+
+// GetPixelLocation calls ICoreWebView2PointerInfo::get_PixelLocation.
+func (i *ICoreWebView2PointerInfo) GetPixelLocation() (POINT, error) {
+	var pixelLocation POINT
+
+	if hr := call(i.VTBL.GetPixelLocation, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&pixelLocation))); hr.Failed() {
+		return POINT{}, &Error{Method: "ICoreWebView2PointerInfo::get_PixelLocation", HRESULT: hr}
+	}
+
+	return pixelLocation, nil
+}
+
+// GetHimetricLocation calls ICoreWebView2PointerInfo::get_HimetricLocation.
+func (i *ICoreWebView2PointerInfo) GetHimetricLocation() (POINT, error) {
+	var himetricLocation POINT
+
+	if hr := call(i.VTBL.GetHimetricLocation, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&himetricLocation))); hr.Failed() {
+		return POINT{}, &Error{Method: "ICoreWebView2PointerInfo::get_HimetricLocation", HRESULT: hr}
+	}
+
+	return himetricLocation, nil
+}
+
+// GetPixelLocationRaw calls ICoreWebView2PointerInfo::get_PixelLocationRaw.
+func (i *ICoreWebView2PointerInfo) GetPixelLocationRaw() (POINT, error) {
+	var pixelLocationRaw POINT
+
+	if hr := call(i.VTBL.GetPixelLocationRaw, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&pixelLocationRaw))); hr.Failed() {
+		return POINT{}, &Error{Method: "ICoreWebView2PointerInfo::get_PixelLocationRaw", HRESULT: hr}
+	}
+
+	return pixelLocationRaw, nil
+}
+
+// GetHimetricLocationRaw calls ICoreWebView2PointerInfo::get_HimetricLocationRaw.
+func (i *ICoreWebView2PointerInfo) GetHimetricLocationRaw() (POINT, error) {
+	var himetricLocationRaw POINT
+
+	if hr := call(i.VTBL.GetHimetricLocationRaw, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&himetricLocationRaw))); hr.Failed() {
+		return POINT{}, &Error{Method: "ICoreWebView2PointerInfo::get_HimetricLocationRaw", HRESULT: hr}
+	}
+
+	return himetricLocationRaw, nil
+}
+
+// GetTime calls ICoreWebView2PointerInfo::get_Time.
+func (i *ICoreWebView2PointerInfo) GetTime() (uint32, error) {
+	var time uint32
+
+	if hr := call(i.VTBL.GetTime, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&time))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_Time", HRESULT: hr}
+	}
+
+	return time, nil
+}
+
+// PutTime calls ICoreWebView2PointerInfo::put_Time.
+func (i *ICoreWebView2PointerInfo) PutTime(time uint32) error {
+	if hr := call(i.VTBL.PutTime, uintptr(unsafe.Pointer(i)), uintptr(time)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_Time", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetHistoryCount calls ICoreWebView2PointerInfo::get_HistoryCount.
+func (i *ICoreWebView2PointerInfo) GetHistoryCount() (uint32, error) {
+	var historyCount uint32
+
+	if hr := call(i.VTBL.GetHistoryCount, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&historyCount))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_HistoryCount", HRESULT: hr}
+	}
+
+	return historyCount, nil
+}
+
+// PutHistoryCount calls ICoreWebView2PointerInfo::put_HistoryCount.
+func (i *ICoreWebView2PointerInfo) PutHistoryCount(historyCount uint32) error {
+	if hr := call(i.VTBL.PutHistoryCount, uintptr(unsafe.Pointer(i)), uintptr(historyCount)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_HistoryCount", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetInputData calls ICoreWebView2PointerInfo::get_InputData.
+func (i *ICoreWebView2PointerInfo) GetInputData() (int32, error) {
+	var inputData int32
+
+	if hr := call(i.VTBL.GetInputData, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&inputData))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_InputData", HRESULT: hr}
+	}
+
+	return inputData, nil
+}
+
+// PutInputData calls ICoreWebView2PointerInfo::put_InputData.
+func (i *ICoreWebView2PointerInfo) PutInputData(inputData int32) error {
+	if hr := call(i.VTBL.PutInputData, uintptr(unsafe.Pointer(i)), uintptr(inputData)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_InputData", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetKeyStates calls ICoreWebView2PointerInfo::get_KeyStates.
+func (i *ICoreWebView2PointerInfo) GetKeyStates() (uint32, error) {
+	var keyStates uint32
+
+	if hr := call(i.VTBL.GetKeyStates, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&keyStates))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_KeyStates", HRESULT: hr}
+	}
+
+	return keyStates, nil
+}
+
+// PutKeyStates calls ICoreWebView2PointerInfo::put_KeyStates.
+func (i *ICoreWebView2PointerInfo) PutKeyStates(keyStates uint32) error {
+	if hr := call(i.VTBL.PutKeyStates, uintptr(unsafe.Pointer(i)), uintptr(keyStates)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_KeyStates", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetPerformanceCount calls ICoreWebView2PointerInfo::get_PerformanceCount.
+func (i *ICoreWebView2PointerInfo) GetPerformanceCount() (uint64, error) {
+	var performanceCount uint64
+
+	if hr := call(i.VTBL.GetPerformanceCount, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&performanceCount))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_PerformanceCount", HRESULT: hr}
+	}
+
+	return performanceCount, nil
+}
+
+// GetButtonChangeKind calls ICoreWebView2PointerInfo::get_ButtonChangeKind.
+func (i *ICoreWebView2PointerInfo) GetButtonChangeKind() (int32, error) {
+	var buttonChangeKind int32
+
+	if hr := call(i.VTBL.GetButtonChangeKind, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&buttonChangeKind))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_ButtonChangeKind", HRESULT: hr}
+	}
+
+	return buttonChangeKind, nil
+}
+
+// PutButtonChangeKind calls ICoreWebView2PointerInfo::put_ButtonChangeKind.
+func (i *ICoreWebView2PointerInfo) PutButtonChangeKind(buttonChangeKind int32) error {
+	if hr := call(i.VTBL.PutButtonChangeKind, uintptr(unsafe.Pointer(i)), uintptr(buttonChangeKind)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_ButtonChangeKind", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetPenFlags calls ICoreWebView2PointerInfo::get_PenFlags.
+func (i *ICoreWebView2PointerInfo) GetPenFlags() (uint32, error) {
+	var penFlags uint32
+
+	if hr := call(i.VTBL.GetPenFlags, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&penFlags))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_PenFlags", HRESULT: hr}
+	}
+
+	return penFlags, nil
+}
+
+// PutPenFlags calls ICoreWebView2PointerInfo::put_PenFlags.
+func (i *ICoreWebView2PointerInfo) PutPenFlags(penFlags uint32) error {
+	if hr := call(i.VTBL.PutPenFlags, uintptr(unsafe.Pointer(i)), uintptr(penFlags)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PenFlags", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetPenMask calls ICoreWebView2PointerInfo::get_PenMask.
+func (i *ICoreWebView2PointerInfo) GetPenMask() (uint32, error) {
+	var penMask uint32
+
+	if hr := call(i.VTBL.GetPenMask, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&penMask))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_PenMask", HRESULT: hr}
+	}
+
+	return penMask, nil
+}
+
+// PutPenMask calls ICoreWebView2PointerInfo::put_PenMask.
+func (i *ICoreWebView2PointerInfo) PutPenMask(penMask uint32) error {
+	if hr := call(i.VTBL.PutPenMask, uintptr(unsafe.Pointer(i)), uintptr(penMask)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PenMask", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetPenPressure calls ICoreWebView2PointerInfo::get_PenPressure.
+func (i *ICoreWebView2PointerInfo) GetPenPressure() (uint32, error) {
+	var penPressure uint32
+
+	if hr := call(i.VTBL.GetPenPressure, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&penPressure))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_PenPressure", HRESULT: hr}
+	}
+
+	return penPressure, nil
+}
+
+// PutPenPressure calls ICoreWebView2PointerInfo::put_PenPressure.
+func (i *ICoreWebView2PointerInfo) PutPenPressure(penPressure uint32) error {
+	if hr := call(i.VTBL.PutPenPressure, uintptr(unsafe.Pointer(i)), uintptr(penPressure)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PenPressure", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetPenRotation calls ICoreWebView2PointerInfo::get_PenRotation.
+func (i *ICoreWebView2PointerInfo) GetPenRotation() (uint32, error) {
+	var penRotation uint32
+
+	if hr := call(i.VTBL.GetPenRotation, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&penRotation))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_PenRotation", HRESULT: hr}
+	}
+
+	return penRotation, nil
+}
+
+// PutPenRotation calls ICoreWebView2PointerInfo::put_PenRotation.
+func (i *ICoreWebView2PointerInfo) PutPenRotation(penRotation uint32) error {
+	if hr := call(i.VTBL.PutPenRotation, uintptr(unsafe.Pointer(i)), uintptr(penRotation)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PenRotation", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetPenTiltX calls ICoreWebView2PointerInfo::get_PenTiltX.
+func (i *ICoreWebView2PointerInfo) GetPenTiltX() (int32, error) {
+	var penTiltX int32
+
+	if hr := call(i.VTBL.GetPenTiltX, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&penTiltX))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_PenTiltX", HRESULT: hr}
+	}
+
+	return penTiltX, nil
+}
+
+// PutPenTiltX calls ICoreWebView2PointerInfo::put_PenTiltX.
+func (i *ICoreWebView2PointerInfo) PutPenTiltX(penTiltX int32) error {
+	if hr := call(i.VTBL.PutPenTiltX, uintptr(unsafe.Pointer(i)), uintptr(penTiltX)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PenTiltX", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetPenTiltY calls ICoreWebView2PointerInfo::get_PenTiltY.
+func (i *ICoreWebView2PointerInfo) GetPenTiltY() (int32, error) {
+	var penTiltY int32
+
+	if hr := call(i.VTBL.GetPenTiltY, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&penTiltY))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_PenTiltY", HRESULT: hr}
+	}
+
+	return penTiltY, nil
+}
+
+// PutPenTiltY calls ICoreWebView2PointerInfo::put_PenTiltY.
+func (i *ICoreWebView2PointerInfo) PutPenTiltY(penTiltY int32) error {
+	if hr := call(i.VTBL.PutPenTiltY, uintptr(unsafe.Pointer(i)), uintptr(penTiltY)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PenTiltY", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetTouchFlags calls ICoreWebView2PointerInfo::get_TouchFlags.
+func (i *ICoreWebView2PointerInfo) GetTouchFlags() (uint32, error) {
+	var touchFlags uint32
+
+	if hr := call(i.VTBL.GetTouchFlags, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&touchFlags))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_TouchFlags", HRESULT: hr}
+	}
+
+	return touchFlags, nil
+}
+
+// PutTouchFlags calls ICoreWebView2PointerInfo::put_TouchFlags.
+func (i *ICoreWebView2PointerInfo) PutTouchFlags(touchFlags uint32) error {
+	if hr := call(i.VTBL.PutTouchFlags, uintptr(unsafe.Pointer(i)), uintptr(touchFlags)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_TouchFlags", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetTouchMask calls ICoreWebView2PointerInfo::get_TouchMask.
+func (i *ICoreWebView2PointerInfo) GetTouchMask() (uint32, error) {
+	var touchMask uint32
+
+	if hr := call(i.VTBL.GetTouchMask, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&touchMask))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_TouchMask", HRESULT: hr}
+	}
+
+	return touchMask, nil
+}
+
+// PutTouchMask calls ICoreWebView2PointerInfo::put_TouchMask.
+func (i *ICoreWebView2PointerInfo) PutTouchMask(touchMask uint32) error {
+	if hr := call(i.VTBL.PutTouchMask, uintptr(unsafe.Pointer(i)), uintptr(touchMask)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_TouchMask", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetTouchContact calls ICoreWebView2PointerInfo::get_TouchContact.
+func (i *ICoreWebView2PointerInfo) GetTouchContact() (RECT, error) {
+	var touchContact RECT
+
+	if hr := call(i.VTBL.GetTouchContact, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&touchContact))); hr.Failed() {
+		return RECT{}, &Error{Method: "ICoreWebView2PointerInfo::get_TouchContact", HRESULT: hr}
+	}
+
+	return touchContact, nil
+}
+
+// GetTouchContactRaw calls ICoreWebView2PointerInfo::get_TouchContactRaw.
+func (i *ICoreWebView2PointerInfo) GetTouchContactRaw() (RECT, error) {
+	var touchContactRaw RECT
+
+	if hr := call(i.VTBL.GetTouchContactRaw, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&touchContactRaw))); hr.Failed() {
+		return RECT{}, &Error{Method: "ICoreWebView2PointerInfo::get_TouchContactRaw", HRESULT: hr}
+	}
+
+	return touchContactRaw, nil
+}
+
+// GetTouchOrientation calls ICoreWebView2PointerInfo::get_TouchOrientation.
+func (i *ICoreWebView2PointerInfo) GetTouchOrientation() (uint32, error) {
+	var touchOrientation uint32
+
+	if hr := call(i.VTBL.GetTouchOrientation, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&touchOrientation))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_TouchOrientation", HRESULT: hr}
+	}
+
+	return touchOrientation, nil
+}
+
+// PutTouchOrientation calls ICoreWebView2PointerInfo::put_TouchOrientation.
+func (i *ICoreWebView2PointerInfo) PutTouchOrientation(touchOrientation uint32) error {
+	if hr := call(i.VTBL.PutTouchOrientation, uintptr(unsafe.Pointer(i)), uintptr(touchOrientation)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_TouchOrientation", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// GetTouchPressure calls ICoreWebView2PointerInfo::get_TouchPressure.
+func (i *ICoreWebView2PointerInfo) GetTouchPressure() (uint32, error) {
+	var touchPressure uint32
+
+	if hr := call(i.VTBL.GetTouchPressure, uintptr(unsafe.Pointer(i)), uintptr(unsafe.Pointer(&touchPressure))); hr.Failed() {
+		return 0, &Error{Method: "ICoreWebView2PointerInfo::get_TouchPressure", HRESULT: hr}
+	}
+
+	return touchPressure, nil
+}
+
+// PutTouchPressure calls ICoreWebView2PointerInfo::put_TouchPressure.
+func (i *ICoreWebView2PointerInfo) PutTouchPressure(touchPressure uint32) error {
+	if hr := call(i.VTBL.PutTouchPressure, uintptr(unsafe.Pointer(i)), uintptr(touchPressure)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_TouchPressure", HRESULT: hr}
+	}
+
+	return nil
+}
@@ -0,0 +1,879 @@
+// Code generated by comgen from WebView2.idl. DO NOT EDIT.
+
+package com
+
+import (
+	"unsafe"
+)
+
+// RemoveNavigationStarting calls ICoreWebView2::remove_NavigationStarting.
+func (i *ICoreWebView2) RemoveNavigationStarting(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_NavigationStarting", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveContentLoading calls ICoreWebView2::remove_ContentLoading.
+func (i *ICoreWebView2) RemoveContentLoading(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveContentLoading, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ContentLoading", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveSourceChanged calls ICoreWebView2::remove_SourceChanged.
+func (i *ICoreWebView2) RemoveSourceChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveSourceChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_SourceChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveHistoryChanged calls ICoreWebView2::remove_HistoryChanged.
+func (i *ICoreWebView2) RemoveHistoryChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveHistoryChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_HistoryChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNavigationCompleted calls ICoreWebView2::remove_NavigationCompleted.
+func (i *ICoreWebView2) RemoveNavigationCompleted(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_NavigationCompleted", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveFrameNavigationStarting calls ICoreWebView2::remove_FrameNavigationStarting.
+func (i *ICoreWebView2) RemoveFrameNavigationStarting(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveFrameNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_FrameNavigationStarting", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveFrameNavigationCompleted calls ICoreWebView2::remove_FrameNavigationCompleted.
+func (i *ICoreWebView2) RemoveFrameNavigationCompleted(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveFrameNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_FrameNavigationCompleted", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveScriptDialogOpening calls ICoreWebView2::remove_ScriptDialogOpening.
+func (i *ICoreWebView2) RemoveScriptDialogOpening(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveScriptDialogOpening, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ScriptDialogOpening", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemovePermissionRequested calls ICoreWebView2::remove_PermissionRequested.
+func (i *ICoreWebView2) RemovePermissionRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemovePermissionRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_PermissionRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveProcessFailed calls ICoreWebView2::remove_ProcessFailed.
+func (i *ICoreWebView2) RemoveProcessFailed(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveProcessFailed, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ProcessFailed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWebMessageReceived calls ICoreWebView2::remove_WebMessageReceived.
+func (i *ICoreWebView2) RemoveWebMessageReceived(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveWebMessageReceived, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_WebMessageReceived", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNewWindowRequested calls ICoreWebView2::remove_NewWindowRequested.
+func (i *ICoreWebView2) RemoveNewWindowRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNewWindowRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_NewWindowRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveDocumentTitleChanged calls ICoreWebView2::remove_DocumentTitleChanged.
+func (i *ICoreWebView2) RemoveDocumentTitleChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveDocumentTitleChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_DocumentTitleChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveContainsFullScreenElementChanged calls ICoreWebView2::remove_ContainsFullScreenElementChanged.
+func (i *ICoreWebView2) RemoveContainsFullScreenElementChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveContainsFullScreenElementChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ContainsFullScreenElementChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWebResourceRequested calls ICoreWebView2::remove_WebResourceRequested.
+func (i *ICoreWebView2) RemoveWebResourceRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveWebResourceRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_WebResourceRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWindowCloseRequested calls ICoreWebView2::remove_WindowCloseRequested.
+func (i *ICoreWebView2) RemoveWindowCloseRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveWindowCloseRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_WindowCloseRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNavigationStarting calls ICoreWebView2::remove_NavigationStarting.
+func (i *ICoreWebView2_2) RemoveNavigationStarting(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_NavigationStarting", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveContentLoading calls ICoreWebView2::remove_ContentLoading.
+func (i *ICoreWebView2_2) RemoveContentLoading(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveContentLoading, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ContentLoading", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveSourceChanged calls ICoreWebView2::remove_SourceChanged.
+func (i *ICoreWebView2_2) RemoveSourceChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveSourceChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_SourceChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveHistoryChanged calls ICoreWebView2::remove_HistoryChanged.
+func (i *ICoreWebView2_2) RemoveHistoryChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveHistoryChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_HistoryChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNavigationCompleted calls ICoreWebView2::remove_NavigationCompleted.
+func (i *ICoreWebView2_2) RemoveNavigationCompleted(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_NavigationCompleted", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveFrameNavigationStarting calls ICoreWebView2::remove_FrameNavigationStarting.
+func (i *ICoreWebView2_2) RemoveFrameNavigationStarting(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveFrameNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_FrameNavigationStarting", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveFrameNavigationCompleted calls ICoreWebView2::remove_FrameNavigationCompleted.
+func (i *ICoreWebView2_2) RemoveFrameNavigationCompleted(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveFrameNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_FrameNavigationCompleted", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveScriptDialogOpening calls ICoreWebView2::remove_ScriptDialogOpening.
+func (i *ICoreWebView2_2) RemoveScriptDialogOpening(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveScriptDialogOpening, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ScriptDialogOpening", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemovePermissionRequested calls ICoreWebView2::remove_PermissionRequested.
+func (i *ICoreWebView2_2) RemovePermissionRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemovePermissionRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_PermissionRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveProcessFailed calls ICoreWebView2::remove_ProcessFailed.
+func (i *ICoreWebView2_2) RemoveProcessFailed(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveProcessFailed, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ProcessFailed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWebMessageReceived calls ICoreWebView2::remove_WebMessageReceived.
+func (i *ICoreWebView2_2) RemoveWebMessageReceived(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveWebMessageReceived, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_WebMessageReceived", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNewWindowRequested calls ICoreWebView2::remove_NewWindowRequested.
+func (i *ICoreWebView2_2) RemoveNewWindowRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNewWindowRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_NewWindowRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveDocumentTitleChanged calls ICoreWebView2::remove_DocumentTitleChanged.
+func (i *ICoreWebView2_2) RemoveDocumentTitleChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveDocumentTitleChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_DocumentTitleChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveContainsFullScreenElementChanged calls ICoreWebView2::remove_ContainsFullScreenElementChanged.
+func (i *ICoreWebView2_2) RemoveContainsFullScreenElementChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveContainsFullScreenElementChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ContainsFullScreenElementChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWebResourceRequested calls ICoreWebView2::remove_WebResourceRequested.
+func (i *ICoreWebView2_2) RemoveWebResourceRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveWebResourceRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_WebResourceRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWindowCloseRequested calls ICoreWebView2::remove_WindowCloseRequested.
+func (i *ICoreWebView2_2) RemoveWindowCloseRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveWindowCloseRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_WindowCloseRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWebResourceResponseReceived calls ICoreWebView2_2::remove_WebResourceResponseReceived.
+func (i *ICoreWebView2_2) RemoveWebResourceResponseReceived(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveWebResourceResponseReceived, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2_2::remove_WebResourceResponseReceived", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveDOMContentLoaded calls ICoreWebView2_2::remove_DOMContentLoaded.
+func (i *ICoreWebView2_2) RemoveDOMContentLoaded(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveDOMContentLoaded, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2_2::remove_DOMContentLoaded", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNavigationStarting calls ICoreWebView2::remove_NavigationStarting.
+func (i *ICoreWebView2_3) RemoveNavigationStarting(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_NavigationStarting", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveContentLoading calls ICoreWebView2::remove_ContentLoading.
+func (i *ICoreWebView2_3) RemoveContentLoading(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveContentLoading, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ContentLoading", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveSourceChanged calls ICoreWebView2::remove_SourceChanged.
+func (i *ICoreWebView2_3) RemoveSourceChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveSourceChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_SourceChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveHistoryChanged calls ICoreWebView2::remove_HistoryChanged.
+func (i *ICoreWebView2_3) RemoveHistoryChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveHistoryChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_HistoryChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNavigationCompleted calls ICoreWebView2::remove_NavigationCompleted.
+func (i *ICoreWebView2_3) RemoveNavigationCompleted(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_NavigationCompleted", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveFrameNavigationStarting calls ICoreWebView2::remove_FrameNavigationStarting.
+func (i *ICoreWebView2_3) RemoveFrameNavigationStarting(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveFrameNavigationStarting, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_FrameNavigationStarting", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveFrameNavigationCompleted calls ICoreWebView2::remove_FrameNavigationCompleted.
+func (i *ICoreWebView2_3) RemoveFrameNavigationCompleted(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveFrameNavigationCompleted, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_FrameNavigationCompleted", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveScriptDialogOpening calls ICoreWebView2::remove_ScriptDialogOpening.
+func (i *ICoreWebView2_3) RemoveScriptDialogOpening(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveScriptDialogOpening, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ScriptDialogOpening", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemovePermissionRequested calls ICoreWebView2::remove_PermissionRequested.
+func (i *ICoreWebView2_3) RemovePermissionRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemovePermissionRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_PermissionRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveProcessFailed calls ICoreWebView2::remove_ProcessFailed.
+func (i *ICoreWebView2_3) RemoveProcessFailed(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveProcessFailed, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ProcessFailed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWebMessageReceived calls ICoreWebView2::remove_WebMessageReceived.
+func (i *ICoreWebView2_3) RemoveWebMessageReceived(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveWebMessageReceived, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_WebMessageReceived", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNewWindowRequested calls ICoreWebView2::remove_NewWindowRequested.
+func (i *ICoreWebView2_3) RemoveNewWindowRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNewWindowRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_NewWindowRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveDocumentTitleChanged calls ICoreWebView2::remove_DocumentTitleChanged.
+func (i *ICoreWebView2_3) RemoveDocumentTitleChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveDocumentTitleChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_DocumentTitleChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveContainsFullScreenElementChanged calls ICoreWebView2::remove_ContainsFullScreenElementChanged.
+func (i *ICoreWebView2_3) RemoveContainsFullScreenElementChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveContainsFullScreenElementChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_ContainsFullScreenElementChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWebResourceRequested calls ICoreWebView2::remove_WebResourceRequested.
+func (i *ICoreWebView2_3) RemoveWebResourceRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveWebResourceRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_WebResourceRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWindowCloseRequested calls ICoreWebView2::remove_WindowCloseRequested.
+func (i *ICoreWebView2_3) RemoveWindowCloseRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveWindowCloseRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2::remove_WindowCloseRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveWebResourceResponseReceived calls ICoreWebView2_2::remove_WebResourceResponseReceived.
+func (i *ICoreWebView2_3) RemoveWebResourceResponseReceived(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveWebResourceResponseReceived, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2_2::remove_WebResourceResponseReceived", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveDOMContentLoaded calls ICoreWebView2_2::remove_DOMContentLoaded.
+func (i *ICoreWebView2_3) RemoveDOMContentLoaded(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveDOMContentLoaded, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2_2::remove_DOMContentLoaded", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutBounds calls ICoreWebView2Controller::put_Bounds.
+func (i *ICoreWebView2Controller) PutBounds(bounds RECT) error {
+	if hr := call(i.VTBL.PutBounds, uintptr(unsafe.Pointer(i)), uintptr(uint32(bounds.Left))|uintptr(uint32(bounds.Top))<<32, uintptr(uint32(bounds.Right))|uintptr(uint32(bounds.Bottom))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_Bounds", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveZoomFactorChanged calls ICoreWebView2Controller::remove_ZoomFactorChanged.
+func (i *ICoreWebView2Controller) RemoveZoomFactorChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveZoomFactorChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_ZoomFactorChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// SetBoundsAndZoomFactor isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// RemoveMoveFocusRequested calls ICoreWebView2Controller::remove_MoveFocusRequested.
+func (i *ICoreWebView2Controller) RemoveMoveFocusRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveMoveFocusRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_MoveFocusRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveGotFocus calls ICoreWebView2Controller::remove_GotFocus.
+func (i *ICoreWebView2Controller) RemoveGotFocus(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveGotFocus, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_GotFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveLostFocus calls ICoreWebView2Controller::remove_LostFocus.
+func (i *ICoreWebView2Controller) RemoveLostFocus(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveLostFocus, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_LostFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveAcceleratorKeyPressed calls ICoreWebView2Controller::remove_AcceleratorKeyPressed.
+func (i *ICoreWebView2Controller) RemoveAcceleratorKeyPressed(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveAcceleratorKeyPressed, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_AcceleratorKeyPressed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNewBrowserVersionAvailable calls ICoreWebView2Environment::remove_NewBrowserVersionAvailable.
+func (i *ICoreWebView2Environment) RemoveNewBrowserVersionAvailable(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNewBrowserVersionAvailable, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Environment::remove_NewBrowserVersionAvailable", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveDevToolsProtocolEventReceived calls ICoreWebView2DevToolsProtocolEventReceiver::remove_DevToolsProtocolEventReceived.
+func (i *ICoreWebView2DevToolsProtocolEventReceiver) RemoveDevToolsProtocolEventReceived(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveDevToolsProtocolEventReceived, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2DevToolsProtocolEventReceiver::remove_DevToolsProtocolEventReceived", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNewBrowserVersionAvailable calls ICoreWebView2Environment::remove_NewBrowserVersionAvailable.
+func (i *ICoreWebView2Environment2) RemoveNewBrowserVersionAvailable(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNewBrowserVersionAvailable, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Environment::remove_NewBrowserVersionAvailable", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutBounds calls ICoreWebView2Controller::put_Bounds.
+func (i *ICoreWebView2Controller2) PutBounds(bounds RECT) error {
+	if hr := call(i.VTBL.PutBounds, uintptr(unsafe.Pointer(i)), uintptr(uint32(bounds.Left))|uintptr(uint32(bounds.Top))<<32, uintptr(uint32(bounds.Right))|uintptr(uint32(bounds.Bottom))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_Bounds", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveZoomFactorChanged calls ICoreWebView2Controller::remove_ZoomFactorChanged.
+func (i *ICoreWebView2Controller2) RemoveZoomFactorChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveZoomFactorChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_ZoomFactorChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// SetBoundsAndZoomFactor isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// RemoveMoveFocusRequested calls ICoreWebView2Controller::remove_MoveFocusRequested.
+func (i *ICoreWebView2Controller2) RemoveMoveFocusRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveMoveFocusRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_MoveFocusRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveGotFocus calls ICoreWebView2Controller::remove_GotFocus.
+func (i *ICoreWebView2Controller2) RemoveGotFocus(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveGotFocus, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_GotFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveLostFocus calls ICoreWebView2Controller::remove_LostFocus.
+func (i *ICoreWebView2Controller2) RemoveLostFocus(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveLostFocus, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_LostFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveAcceleratorKeyPressed calls ICoreWebView2Controller::remove_AcceleratorKeyPressed.
+func (i *ICoreWebView2Controller2) RemoveAcceleratorKeyPressed(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveAcceleratorKeyPressed, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_AcceleratorKeyPressed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutBounds calls ICoreWebView2Controller::put_Bounds.
+func (i *ICoreWebView2Controller3) PutBounds(bounds RECT) error {
+	if hr := call(i.VTBL.PutBounds, uintptr(unsafe.Pointer(i)), uintptr(uint32(bounds.Left))|uintptr(uint32(bounds.Top))<<32, uintptr(uint32(bounds.Right))|uintptr(uint32(bounds.Bottom))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_Bounds", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveZoomFactorChanged calls ICoreWebView2Controller::remove_ZoomFactorChanged.
+func (i *ICoreWebView2Controller3) RemoveZoomFactorChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveZoomFactorChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_ZoomFactorChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// SetBoundsAndZoomFactor isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// RemoveMoveFocusRequested calls ICoreWebView2Controller::remove_MoveFocusRequested.
+func (i *ICoreWebView2Controller3) RemoveMoveFocusRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveMoveFocusRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_MoveFocusRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveGotFocus calls ICoreWebView2Controller::remove_GotFocus.
+func (i *ICoreWebView2Controller3) RemoveGotFocus(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveGotFocus, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_GotFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveLostFocus calls ICoreWebView2Controller::remove_LostFocus.
+func (i *ICoreWebView2Controller3) RemoveLostFocus(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveLostFocus, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_LostFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveAcceleratorKeyPressed calls ICoreWebView2Controller::remove_AcceleratorKeyPressed.
+func (i *ICoreWebView2Controller3) RemoveAcceleratorKeyPressed(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveAcceleratorKeyPressed, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_AcceleratorKeyPressed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveRasterizationScaleChanged calls ICoreWebView2Controller3::remove_RasterizationScaleChanged.
+func (i *ICoreWebView2Controller3) RemoveRasterizationScaleChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveRasterizationScaleChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller3::remove_RasterizationScaleChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutBounds calls ICoreWebView2Controller::put_Bounds.
+func (i *ICoreWebView2Controller4) PutBounds(bounds RECT) error {
+	if hr := call(i.VTBL.PutBounds, uintptr(unsafe.Pointer(i)), uintptr(uint32(bounds.Left))|uintptr(uint32(bounds.Top))<<32, uintptr(uint32(bounds.Right))|uintptr(uint32(bounds.Bottom))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::put_Bounds", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveZoomFactorChanged calls ICoreWebView2Controller::remove_ZoomFactorChanged.
+func (i *ICoreWebView2Controller4) RemoveZoomFactorChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveZoomFactorChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_ZoomFactorChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// SetBoundsAndZoomFactor isn't wrapped, floating point arguments can't be passed through a syscall.
+
+// RemoveMoveFocusRequested calls ICoreWebView2Controller::remove_MoveFocusRequested.
+func (i *ICoreWebView2Controller4) RemoveMoveFocusRequested(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveMoveFocusRequested, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_MoveFocusRequested", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveGotFocus calls ICoreWebView2Controller::remove_GotFocus.
+func (i *ICoreWebView2Controller4) RemoveGotFocus(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveGotFocus, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_GotFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveLostFocus calls ICoreWebView2Controller::remove_LostFocus.
+func (i *ICoreWebView2Controller4) RemoveLostFocus(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveLostFocus, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_LostFocus", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveAcceleratorKeyPressed calls ICoreWebView2Controller::remove_AcceleratorKeyPressed.
+func (i *ICoreWebView2Controller4) RemoveAcceleratorKeyPressed(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveAcceleratorKeyPressed, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller::remove_AcceleratorKeyPressed", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveRasterizationScaleChanged calls ICoreWebView2Controller3::remove_RasterizationScaleChanged.
+func (i *ICoreWebView2Controller4) RemoveRasterizationScaleChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveRasterizationScaleChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Controller3::remove_RasterizationScaleChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNewBrowserVersionAvailable calls ICoreWebView2Environment::remove_NewBrowserVersionAvailable.
+func (i *ICoreWebView2Environment3) RemoveNewBrowserVersionAvailable(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNewBrowserVersionAvailable, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Environment::remove_NewBrowserVersionAvailable", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveNewBrowserVersionAvailable calls ICoreWebView2Environment::remove_NewBrowserVersionAvailable.
+func (i *ICoreWebView2Environment4) RemoveNewBrowserVersionAvailable(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveNewBrowserVersionAvailable, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2Environment::remove_NewBrowserVersionAvailable", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// SendMouseInput calls ICoreWebView2CompositionController::SendMouseInput.
+func (i *ICoreWebView2CompositionController) SendMouseInput(eventKind COREWEBVIEW2_MOUSE_EVENT_KIND, virtualKeys COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS, mouseData uint32, point POINT) error {
+	if hr := call(i.VTBL.SendMouseInput, uintptr(unsafe.Pointer(i)), uintptr(eventKind), uintptr(virtualKeys), uintptr(mouseData), uintptr(uint32(point.X))|uintptr(uint32(point.Y))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CompositionController::SendMouseInput", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveCursorChanged calls ICoreWebView2CompositionController::remove_CursorChanged.
+func (i *ICoreWebView2CompositionController) RemoveCursorChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveCursorChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CompositionController::remove_CursorChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// SendMouseInput calls ICoreWebView2CompositionController::SendMouseInput.
+func (i *ICoreWebView2CompositionController2) SendMouseInput(eventKind COREWEBVIEW2_MOUSE_EVENT_KIND, virtualKeys COREWEBVIEW2_MOUSE_EVENT_VIRTUAL_KEYS, mouseData uint32, point POINT) error {
+	if hr := call(i.VTBL.SendMouseInput, uintptr(unsafe.Pointer(i)), uintptr(eventKind), uintptr(virtualKeys), uintptr(mouseData), uintptr(uint32(point.X))|uintptr(uint32(point.Y))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CompositionController::SendMouseInput", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// RemoveCursorChanged calls ICoreWebView2CompositionController::remove_CursorChanged.
+func (i *ICoreWebView2CompositionController2) RemoveCursorChanged(token EventRegistrationToken) error {
+	if hr := call(i.VTBL.RemoveCursorChanged, uintptr(unsafe.Pointer(i)), uintptr(token.Value)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2CompositionController::remove_CursorChanged", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutPointerDeviceRect calls ICoreWebView2PointerInfo::put_PointerDeviceRect.
+func (i *ICoreWebView2PointerInfo) PutPointerDeviceRect(pointerDeviceRect RECT) error {
+	if hr := call(i.VTBL.PutPointerDeviceRect, uintptr(unsafe.Pointer(i)), uintptr(uint32(pointerDeviceRect.Left))|uintptr(uint32(pointerDeviceRect.Top))<<32, uintptr(uint32(pointerDeviceRect.Right))|uintptr(uint32(pointerDeviceRect.Bottom))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PointerDeviceRect", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutDisplayRect calls ICoreWebView2PointerInfo::put_DisplayRect.
+func (i *ICoreWebView2PointerInfo) PutDisplayRect(displayRect RECT) error {
+	if hr := call(i.VTBL.PutDisplayRect, uintptr(unsafe.Pointer(i)), uintptr(uint32(displayRect.Left))|uintptr(uint32(displayRect.Top))<<32, uintptr(uint32(displayRect.Right))|uintptr(uint32(displayRect.Bottom))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_DisplayRect", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutPixelLocation calls ICoreWebView2PointerInfo::put_PixelLocation.
+func (i *ICoreWebView2PointerInfo) PutPixelLocation(pixelLocation POINT) error {
+	if hr := call(i.VTBL.PutPixelLocation, uintptr(unsafe.Pointer(i)), uintptr(uint32(pixelLocation.X))|uintptr(uint32(pixelLocation.Y))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PixelLocation", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutHimetricLocation calls ICoreWebView2PointerInfo::put_HimetricLocation.
+func (i *ICoreWebView2PointerInfo) PutHimetricLocation(himetricLocation POINT) error {
+	if hr := call(i.VTBL.PutHimetricLocation, uintptr(unsafe.Pointer(i)), uintptr(uint32(himetricLocation.X))|uintptr(uint32(himetricLocation.Y))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_HimetricLocation", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutPixelLocationRaw calls ICoreWebView2PointerInfo::put_PixelLocationRaw.
+func (i *ICoreWebView2PointerInfo) PutPixelLocationRaw(pixelLocationRaw POINT) error {
+	if hr := call(i.VTBL.PutPixelLocationRaw, uintptr(unsafe.Pointer(i)), uintptr(uint32(pixelLocationRaw.X))|uintptr(uint32(pixelLocationRaw.Y))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PixelLocationRaw", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutHimetricLocationRaw calls ICoreWebView2PointerInfo::put_HimetricLocationRaw.
+func (i *ICoreWebView2PointerInfo) PutHimetricLocationRaw(himetricLocationRaw POINT) error {
+	if hr := call(i.VTBL.PutHimetricLocationRaw, uintptr(unsafe.Pointer(i)), uintptr(uint32(himetricLocationRaw.X))|uintptr(uint32(himetricLocationRaw.Y))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_HimetricLocationRaw", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutPerformanceCount calls ICoreWebView2PointerInfo::put_PerformanceCount.
+func (i *ICoreWebView2PointerInfo) PutPerformanceCount(performanceCount uint64) error {
+	if hr := call(i.VTBL.PutPerformanceCount, uintptr(unsafe.Pointer(i)), uintptr(performanceCount)); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_PerformanceCount", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutTouchContact calls ICoreWebView2PointerInfo::put_TouchContact.
+func (i *ICoreWebView2PointerInfo) PutTouchContact(touchContact RECT) error {
+	if hr := call(i.VTBL.PutTouchContact, uintptr(unsafe.Pointer(i)), uintptr(uint32(touchContact.Left))|uintptr(uint32(touchContact.Top))<<32, uintptr(uint32(touchContact.Right))|uintptr(uint32(touchContact.Bottom))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_TouchContact", HRESULT: hr}
+	}
+
+	return nil
+}
+
+// PutTouchContactRaw calls ICoreWebView2PointerInfo::put_TouchContactRaw.
+func (i *ICoreWebView2PointerInfo) PutTouchContactRaw(touchContactRaw RECT) error {
+	if hr := call(i.VTBL.PutTouchContactRaw, uintptr(unsafe.Pointer(i)), uintptr(uint32(touchContactRaw.Left))|uintptr(uint32(touchContactRaw.Top))<<32, uintptr(uint32(touchContactRaw.Right))|uintptr(uint32(touchContactRaw.Bottom))<<32); hr.Failed() {
+		return &Error{Method: "ICoreWebView2PointerInfo::put_TouchContactRaw", HRESULT: hr}
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+// Package discovery finds the WebView2 runtime to create an environment with, like WebView2Loader.dll does:
+// a fixed version runtime in the browser executable folder, or an installed Evergreen runtime found through
+// the EdgeUpdate registry keys, picking the channel by preference.
+//
+// The registry and the file system are read through interfaces, SystemRegistry and OSFileSystem on Windows,
+// so the selection is tested against fake ones.
+package discovery
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mattpodraza/webview2/v2/pkg/browserversion"
+)
+
+// ErrNotFound is returned when no runtime matches the options.
+var ErrNotFound = errors.New("no compatible WebView2 runtime found")
+
+// Root is a root key of the registry.
+type Root int
+
+const (
+	LocalMachine Root = iota
+	CurrentUser
+)
+
+func (r Root) String() string {
+	if r == CurrentUser {
+		return "HKEY_CURRENT_USER"
+	}
+
+	return "HKEY_LOCAL_MACHINE"
+}
+
+// Registry reads string values from the 32-bit view of the registry, where EdgeUpdate keeps its keys.
+type Registry interface {
+	// String returns the value, or false if the key or the value doesn't exist.
+	String(root Root, key, name string) (string, bool)
+}
+
+// FileSystem tells whether files exist.
+type FileSystem interface {
+	Exists(path string) bool
+}
+
+// OSFileSystem is the FileSystem of the os package.
+type OSFileSystem struct{}
+
+// Exists reports whether the file can be stat'ed.
+func (OSFileSystem) Exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// Kind is the kind of runtime, passed on to CreateWebViewEnvironmentWithOptionsInternal.
+type Kind int
+
+const (
+	// Installed is an Evergreen runtime, or a preview channel of Edge.
+	Installed Kind = 0
+	// Redistributable is a fixed version runtime.
+	Redistributable Kind = 1
+)
+
+// Channel is a release channel of the runtime.
+type Channel struct {
+	Name string
+	// GUID is the EdgeUpdate client ID of the channel.
+	GUID string
+}
+
+// Channels are the release channels, from the most to the least stable.
+var Channels = []Channel{
+	{"stable", "{F3017226-FE2A-4295-8BDF-00C3A9A7E4C5}"},
+	{"beta", "{2CD8A007-E189-409D-A2C8-9AF4EF3C72AA}"},
+	{"dev", "{0D50BFEC-CD6A-4F9A-964C-C7416E3ACB10}"},
+	{"canary", "{65C35B14-6C1D-4122-AC46-7148CC9D6497}"},
+}
+
+// Arch returns the folder of the client DLL for the GOARCH, e.g. runtime.GOARCH, or an empty string if there's none.
+func Arch(goarch string) string {
+	switch goarch {
+	case "amd64":
+		return "x64"
+	case "386":
+		return "x86"
+	case "arm64":
+		return "arm64"
+	}
+
+	return ""
+}
+
+// ClientDLL is the DLL of the runtime exporting CreateWebViewEnvironmentWithOptionsInternal.
+const ClientDLL = "EmbeddedBrowserWebView.dll"
+
+// Options are the inputs of Find.
+type Options struct {
+	// BrowserExecutableFolder holds a fixed version runtime, which is used instead of the installed ones.
+	BrowserExecutableFolder string
+	// PreferCanary searches the channels from the least to the most stable,
+	// like WEBVIEW2_RELEASE_CHANNEL_PREFERENCE=1.
+	PreferCanary bool
+	// Arch is the folder of the client DLL matching the process architecture: x64, x86 or arm64.
+	Arch string
+	// MinVersion skips the installed runtimes older than the target compatible browser version.
+	MinVersion browserversion.Version
+}
+
+// Runtime is a runtime found by Find.
+type Runtime struct {
+	Kind Kind
+	// Version is zero for the fixed version runtimes, the registry doesn't know about them.
+	// Its Channel is set for the preview channels.
+	Version browserversion.Version
+	// Dir is the folder of the runtime, holding msedgewebview2.exe.
+	Dir string
+	// ClientDLL is the path of EmbeddedBrowserWebView.dll.
+	ClientDLL string
+	PerUser   bool
+}
+
+// Find picks the runtime the environment is created with.
+func Find(reg Registry, fs FileSystem, opts Options) (Runtime, error) {
+	runtimes, err := All(reg, fs, opts)
+	if err != nil {
+		return Runtime{}, err
+	}
+
+	return runtimes[0], nil
+}
+
+// All returns the runtimes matching the options, in the order Find prefers them.
+// The registry entries without a valid version or a client DLL are skipped.
+func All(reg Registry, fs FileSystem, opts Options) ([]Runtime, error) {
+	if opts.Arch == "" {
+		return nil, errors.New("unknown architecture")
+	}
+
+	if opts.BrowserExecutableFolder != "" {
+		dll := clientDLL(opts.BrowserExecutableFolder, opts.Arch)
+		if !fs.Exists(dll) {
+			return nil, fmt.Errorf("%w: %s doesn't exist", ErrNotFound, dll)
+		}
+
+		return []Runtime{{Kind: Redistributable, Dir: opts.BrowserExecutableFolder, ClientDLL: dll}}, nil
+	}
+
+	channels := make([]Channel, len(Channels))
+	copy(channels, Channels)
+
+	if opts.PreferCanary {
+		for i, j := 0, len(channels)-1; i < j; i, j = i+1, j-1 {
+			channels[i], channels[j] = channels[j], channels[i]
+		}
+	}
+
+	var runtimes []Runtime
+
+	for _, channel := range channels {
+		for _, root := range []Root{LocalMachine, CurrentUser} {
+			if r, ok := installed(reg, fs, root, channel, opts); ok {
+				runtimes = append(runtimes, r)
+			}
+		}
+	}
+
+	if len(runtimes) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return runtimes, nil
+}
+
+// installed looks for the runtime of a channel installed under the root key.
+func installed(reg Registry, fs FileSystem, root Root, channel Channel, opts Options) (Runtime, bool) {
+	dir, version, ok := installDir(reg, root, channel.GUID)
+	if !ok || version.IsZero() || version.Compare(opts.MinVersion) < 0 {
+		return Runtime{}, false
+	}
+
+	dll := clientDLL(dir, opts.Arch)
+	if !fs.Exists(dll) {
+		return Runtime{}, false
+	}
+
+	if channel.Name != Channels[0].Name {
+		version.Channel = channel.Name
+	}
+
+	return Runtime{
+		Kind:      Installed,
+		Version:   version,
+		Dir:       dir,
+		ClientDLL: dll,
+		PerUser:   root == CurrentUser,
+	}, true
+}
+
+// installDir returns the folder of the installed runtime, named after its version.
+// ClientState holds the folder in the EBWebView value, older installs only have the location and the version in Clients.
+func installDir(reg Registry, root Root, guid string) (string, browserversion.Version, bool) {
+	if dir, ok := reg.String(root, `SOFTWARE\Microsoft\EdgeUpdate\ClientState\`+guid, "EBWebView"); ok && dir != "" {
+		version, err := browserversion.Parse(base(dir))
+		if err == nil {
+			return dir, version, true
+		}
+	}
+
+	clients := `SOFTWARE\Microsoft\EdgeUpdate\Clients\` + guid
+
+	pv, ok := reg.String(root, clients, "pv")
+	if !ok {
+		return "", browserversion.Version{}, false
+	}
+
+	version, err := browserversion.Parse(pv)
+	if err != nil {
+		return "", browserversion.Version{}, false
+	}
+
+	location, ok := reg.String(root, clients, "location")
+	if !ok || location == "" {
+		return "", browserversion.Version{}, false
+	}
+
+	return join(location, pv), version, true
+}
+
+// clientDLL returns the path of the client DLL in the folder of a runtime.
+func clientDLL(dir, arch string) string {
+	return join(dir, "EBWebView", arch, ClientDLL)
+}
+
+// join joins Windows paths, which filepath only does on Windows.
+func join(elem ...string) string {
+	parts := make([]string, 0, len(elem))
+
+	for i, e := range elem {
+		if i > 0 {
+			e = strings.TrimLeft(e, `\/`)
+		}
+
+		if i < len(elem)-1 {
+			e = strings.TrimRight(e, `\/`)
+		}
+
+		parts = append(parts, e)
+	}
+
+	return strings.Join(parts, `\`)
+}
+
+// base returns the last element of a Windows path.
+func base(path string) string {
+	path = strings.TrimRight(path, `\/`)
+
+	if i := strings.LastIndexAny(path, `\/`); i >= 0 {
+		return path[i+1:]
+	}
+
+	return path
+}
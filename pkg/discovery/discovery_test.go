@@ -0,0 +1,217 @@
+package discovery
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mattpodraza/webview2/v2/pkg/browserversion"
+)
+
+// fakeRegistry maps root, key and value names to their values.
+type fakeRegistry map[Root]map[string]map[string]string
+
+func (r fakeRegistry) String(root Root, key, name string) (string, bool) {
+	value, ok := r[root][key][name]
+	return value, ok
+}
+
+// fakeFileSystem holds the paths of the files which exist.
+type fakeFileSystem map[string]bool
+
+func (fs fakeFileSystem) Exists(path string) bool {
+	return fs[path]
+}
+
+const (
+	stableGUID = "{F3017226-FE2A-4295-8BDF-00C3A9A7E4C5}"
+	betaGUID   = "{2CD8A007-E189-409D-A2C8-9AF4EF3C72AA}"
+	canaryGUID = "{65C35B14-6C1D-4122-AC46-7148CC9D6497}"
+
+	stableDir = `C:\Program Files (x86)\Microsoft\EdgeWebView\Application\90.0.818.66`
+	betaDir   = `C:\Program Files (x86)\Microsoft\Edge Beta\Application\91.0.864.27`
+	canaryDir = `C:\Users\me\AppData\Local\Microsoft\Edge SxS\Application\92.0.891.0`
+)
+
+func clients(guid, pv, location string) map[string]map[string]string {
+	return map[string]map[string]string{
+		`SOFTWARE\Microsoft\EdgeUpdate\Clients\` + guid: {"pv": pv, "location": location},
+	}
+}
+
+func clientState(guid, dir string) map[string]map[string]string {
+	return map[string]map[string]string{
+		`SOFTWARE\Microsoft\EdgeUpdate\ClientState\` + guid: {"EBWebView": dir},
+	}
+}
+
+// merge merges the keys of a root.
+func merge(keys ...map[string]map[string]string) map[string]map[string]string {
+	merged := map[string]map[string]string{}
+
+	for _, k := range keys {
+		for name, values := range k {
+			merged[name] = values
+		}
+	}
+
+	return merged
+}
+
+func dll(dir string) string {
+	return dir + `\EBWebView\x64\EmbeddedBrowserWebView.dll`
+}
+
+// installedRegistry has the stable runtime and Beta machine wide, and Canary for the user.
+var installedRegistry = fakeRegistry{
+	LocalMachine: merge(
+		clientState(stableGUID, stableDir),
+		clients(betaGUID, "91.0.864.27", `C:\Program Files (x86)\Microsoft\Edge Beta\Application`),
+	),
+	CurrentUser: clientState(canaryGUID, canaryDir),
+}
+
+var installedFiles = fakeFileSystem{dll(stableDir): true, dll(betaDir): true, dll(canaryDir): true}
+
+var (
+	stable = Runtime{Kind: Installed, Version: browserversion.MustParse("90.0.818.66"), Dir: stableDir, ClientDLL: dll(stableDir)}
+	beta   = Runtime{Kind: Installed, Version: browserversion.MustParse("91.0.864.27 beta"), Dir: betaDir, ClientDLL: dll(betaDir)}
+	canary = Runtime{Kind: Installed, Version: browserversion.MustParse("92.0.891.0 canary"), Dir: canaryDir, ClientDLL: dll(canaryDir), PerUser: true}
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name string
+		reg  fakeRegistry
+		fs   fakeFileSystem
+		opts Options
+		want Runtime
+		err  error
+	}{
+		{"stableOverPreview", installedRegistry, installedFiles, Options{Arch: "x64"}, stable, nil},
+		{"preferCanary", installedRegistry, installedFiles, Options{Arch: "x64", PreferCanary: true}, canary, nil},
+		{
+			"minVersionSkipsStable", installedRegistry, installedFiles,
+			Options{Arch: "x64", MinVersion: browserversion.MustParse("91.0.0.0")}, beta, nil,
+		},
+		{
+			"minVersionSkipsAll", installedRegistry, installedFiles,
+			Options{Arch: "x64", MinVersion: browserversion.MustParse("93.0.0.0")}, Runtime{}, ErrNotFound,
+		},
+		{
+			"minVersionMatchesExactly", installedRegistry, installedFiles,
+			Options{Arch: "x64", MinVersion: browserversion.MustParse("90.0.818.66")}, stable, nil,
+		},
+		{
+			"clientStateOverClients",
+			fakeRegistry{LocalMachine: merge(
+				clientState(stableGUID, stableDir),
+				clients(stableGUID, "89.0.774.76", `C:\elsewhere`),
+			)},
+			installedFiles, Options{Arch: "x64"}, stable, nil,
+		},
+		{
+			"clientsFallback",
+			fakeRegistry{LocalMachine: clients(stableGUID, "90.0.818.66", `C:\Program Files (x86)\Microsoft\EdgeWebView\Application\`)},
+			installedFiles, Options{Arch: "x64"}, stable, nil,
+		},
+		{
+			"malformedClientStateFallsBack",
+			fakeRegistry{LocalMachine: merge(
+				clientState(stableGUID, `C:\Program Files (x86)\Microsoft\EdgeWebView\Application\current`),
+				clients(stableGUID, "90.0.818.66", `C:\Program Files (x86)\Microsoft\EdgeWebView\Application`),
+			)},
+			installedFiles, Options{Arch: "x64"}, stable, nil,
+		},
+		{
+			"malformedVersionSkipped",
+			fakeRegistry{LocalMachine: merge(
+				clients(stableGUID, "90.0.818", `C:\Program Files (x86)\Microsoft\EdgeWebView\Application`),
+				clients(betaGUID, "91.0.864.27", `C:\Program Files (x86)\Microsoft\Edge Beta\Application`),
+			)},
+			installedFiles, Options{Arch: "x64"}, beta, nil,
+		},
+		{
+			"uninstalledSkipped",
+			fakeRegistry{LocalMachine: merge(
+				clients(stableGUID, "0.0.0.0", `C:\Program Files (x86)\Microsoft\EdgeWebView\Application`),
+				clients(betaGUID, "91.0.864.27", `C:\Program Files (x86)\Microsoft\Edge Beta\Application`),
+			)},
+			installedFiles, Options{Arch: "x64"}, beta, nil,
+		},
+		{
+			"missingLocationSkipped",
+			fakeRegistry{LocalMachine: clients(stableGUID, "90.0.818.66", "")},
+			installedFiles, Options{Arch: "x64"}, Runtime{}, ErrNotFound,
+		},
+		{
+			"missingClientDLLSkipped", installedRegistry,
+			fakeFileSystem{dll(betaDir): true, dll(canaryDir): true}, Options{Arch: "x64"}, beta, nil,
+		},
+		{
+			"otherArch", installedRegistry, installedFiles, Options{Arch: "arm64"}, Runtime{}, ErrNotFound,
+		},
+		{
+			"perUser",
+			fakeRegistry{CurrentUser: clientState(stableGUID, stableDir)},
+			installedFiles, Options{Arch: "x64"}, Runtime{Kind: Installed, Version: stable.Version, Dir: stableDir, ClientDLL: dll(stableDir), PerUser: true}, nil,
+		},
+		{
+			"fixedVersion", installedRegistry,
+			fakeFileSystem{dll(`D:\app\runtime`): true}, Options{Arch: "x64", BrowserExecutableFolder: `D:\app\runtime\`},
+			Runtime{Kind: Redistributable, Dir: `D:\app\runtime\`, ClientDLL: dll(`D:\app\runtime`)}, nil,
+		},
+		{
+			"fixedVersionWithoutClientDLL", installedRegistry, installedFiles,
+			Options{Arch: "x64", BrowserExecutableFolder: `D:\app\runtime`}, Runtime{}, ErrNotFound,
+		},
+		{"nothingInstalled", fakeRegistry{}, fakeFileSystem{}, Options{Arch: "x64"}, Runtime{}, ErrNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Find(test.reg, test.fs, test.opts)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Find() error = %v, want %v", err, test.err)
+			}
+
+			if got != test.want {
+				t.Errorf("Find() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFindUnknownArch(t *testing.T) {
+	if _, err := Find(installedRegistry, installedFiles, Options{}); err == nil || errors.Is(err, ErrNotFound) {
+		t.Fatalf("Find() without an architecture = %v, want an error other than %v", err, ErrNotFound)
+	}
+}
+
+func TestAll(t *testing.T) {
+	got, err := All(installedRegistry, installedFiles, Options{Arch: "x64"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []Runtime{stable, beta, canary}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %+v, want %+v", got, want)
+	}
+
+	got, err = All(installedRegistry, installedFiles, Options{Arch: "x64", PreferCanary: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []Runtime{canary, beta, stable}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() preferring Canary = %+v, want %+v", got, want)
+	}
+}
+
+func TestArch(t *testing.T) {
+	for goarch, want := range map[string]string{"amd64": "x64", "386": "x86", "arm64": "arm64", "arm": ""} {
+		if got := Arch(goarch); got != want {
+			t.Errorf("Arch(%q) = %q, want %q", goarch, got, want)
+		}
+	}
+}
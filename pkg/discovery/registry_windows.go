@@ -0,0 +1,28 @@
+package discovery
+
+import "golang.org/x/sys/windows/registry"
+
+// SystemRegistry is the Registry of Windows.
+type SystemRegistry struct{}
+
+// String reads the value from the 32-bit view of the registry.
+func (SystemRegistry) String(root Root, path, name string) (string, bool) {
+	k := registry.LOCAL_MACHINE
+	if root == CurrentUser {
+		k = registry.CURRENT_USER
+	}
+
+	key, err := registry.OpenKey(k, path, registry.QUERY_VALUE|registry.WOW64_32KEY)
+	if err != nil {
+		return "", false
+	}
+
+	defer key.Close()
+
+	value, _, err := key.GetStringValue(name)
+	if err != nil {
+		return "", false
+	}
+
+	return value, true
+}
@@ -0,0 +1,183 @@
+// Package envpolicy decides how the WEBVIEW2_* environment variables combine with the options
+// given to the WebView explicitly.
+//
+// The WebView2 loader reads the variables itself while it creates the environment, and lets them win
+// over the arguments it's called with. Resolve computes the settings the WebView should end up with,
+// from the explicit settings and a Lookup standing in for the environment, so it's tested on its own.
+// Apply makes the loader see exactly those for the duration of the call.
+package envpolicy
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The environment variables read by the WebView2 loader.
+const (
+	BrowserExecutableFolderVar    = "WEBVIEW2_BROWSER_EXECUTABLE_FOLDER"
+	UserDataFolderVar             = "WEBVIEW2_USER_DATA_FOLDER"
+	AdditionalBrowserArgumentsVar = "WEBVIEW2_ADDITIONAL_BROWSER_ARGUMENTS"
+	ReleaseChannelPreferenceVar   = "WEBVIEW2_RELEASE_CHANNEL_PREFERENCE"
+)
+
+// Vars lists the environment variables read by the WebView2 loader.
+var Vars = []string{
+	BrowserExecutableFolderVar,
+	UserDataFolderVar,
+	AdditionalBrowserArgumentsVar,
+	ReleaseChannelPreferenceVar,
+}
+
+// Policy controls whether the WEBVIEW2_* environment variables apply.
+type Policy int
+
+const (
+	// Ignore only uses the explicit options, the environment variables are hidden from the loader.
+	Ignore Policy = iota
+	// Honour lets the environment variables win over the explicit options, like the loader does on its own.
+	// The additional browser arguments of both are kept, the ones from the environment come last.
+	Honour
+	// Override lets the explicit options win, the environment variables only fill in the options left empty.
+	// The additional browser arguments of both are kept, the explicit ones come last.
+	Override
+)
+
+func (p Policy) String() string {
+	switch p {
+	case Ignore:
+		return "Ignore"
+	case Honour:
+		return "Honour"
+	case Override:
+		return "Override"
+	}
+
+	return "Policy(" + strconv.Itoa(int(p)) + ")"
+}
+
+// Settings are the values the loader takes either from the environment or from its arguments.
+// Empty values are unset.
+type Settings struct {
+	BrowserExecutableFolder    string
+	UserDataFolder             string
+	AdditionalBrowserArguments string
+	// ReleaseChannelPreference is 0 to prefer the stable runtime, 1 to prefer the Canary one.
+	// There's no explicit option for it, it only comes from the environment.
+	ReleaseChannelPreference string
+}
+
+// Lookup reads an environment variable, like os.LookupEnv.
+type Lookup func(key string) (string, bool)
+
+// FromEnv reads the settings from the environment, the empty variables count as unset.
+func FromEnv(lookup Lookup) Settings {
+	get := func(key string) string {
+		value, _ := lookup(key)
+		return strings.TrimSpace(value)
+	}
+
+	return Settings{
+		BrowserExecutableFolder:    get(BrowserExecutableFolderVar),
+		UserDataFolder:             get(UserDataFolderVar),
+		AdditionalBrowserArguments: get(AdditionalBrowserArgumentsVar),
+		ReleaseChannelPreference:   get(ReleaseChannelPreferenceVar),
+	}
+}
+
+// Resolve merges the explicit settings with the ones from the environment according to the policy.
+func Resolve(policy Policy, explicit Settings, lookup Lookup) Settings {
+	if policy == Ignore {
+		return explicit
+	}
+
+	env := FromEnv(lookup)
+
+	first, second := env, explicit
+	if policy == Honour {
+		first, second = explicit, env
+	}
+
+	return Settings{
+		BrowserExecutableFolder:    pick(second.BrowserExecutableFolder, first.BrowserExecutableFolder),
+		UserDataFolder:             pick(second.UserDataFolder, first.UserDataFolder),
+		AdditionalBrowserArguments: join(first.AdditionalBrowserArguments, second.AdditionalBrowserArguments),
+		ReleaseChannelPreference:   pick(second.ReleaseChannelPreference, first.ReleaseChannelPreference),
+	}
+}
+
+// pick returns the preferred value, unless it's empty.
+func pick(preferred, fallback string) string {
+	if preferred != "" {
+		return preferred
+	}
+
+	return fallback
+}
+
+// join joins the command line switches, the later ones win when the browser parses them.
+func join(args ...string) string {
+	var nonEmpty []string
+
+	for _, a := range args {
+		if a = strings.TrimSpace(a); a != "" {
+			nonEmpty = append(nonEmpty, a)
+		}
+	}
+
+	return strings.Join(nonEmpty, " ")
+}
+
+// applyMu serializes Apply, the environment is shared by the whole process.
+var applyMu sync.Mutex
+
+// Apply sets the environment variables read by the loader for the duration of the call creating the environment,
+// and returns a function restoring the previous values.
+//
+// The folders and the additional browser arguments are passed to the loader as arguments, so their variables are
+// unset to keep them from winning. The release channel preference has no argument, its variable is set if resolved.
+//
+// The loader has no other way to be told to ignore the variables, so Apply changes the environment of the process.
+// It holds a package mutex until restore is called, so the environments created concurrently take turns,
+// but the rest of the process must not change or rely on the WEBVIEW2_* variables meanwhile.
+func Apply(s Settings) (restore func()) {
+	type saved struct {
+		key     string
+		value   string
+		present bool
+	}
+
+	applyMu.Lock()
+
+	var previous []saved
+
+	for _, key := range Vars {
+		value, present := os.LookupEnv(key)
+		previous = append(previous, saved{key, value, present})
+	}
+
+	for _, key := range Vars {
+		os.Unsetenv(key)
+	}
+
+	if s.ReleaseChannelPreference != "" {
+		os.Setenv(ReleaseChannelPreferenceVar, s.ReleaseChannelPreference)
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			for _, p := range previous {
+				if p.present {
+					os.Setenv(p.key, p.value)
+				} else {
+					os.Unsetenv(p.key)
+				}
+			}
+
+			applyMu.Unlock()
+		})
+	}
+}
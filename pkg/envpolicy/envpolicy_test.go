@@ -0,0 +1,166 @@
+package envpolicy
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func lookup(env map[string]string) Lookup {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+}
+
+func TestResolve(t *testing.T) {
+	explicit := Settings{
+		BrowserExecutableFolder:    `C:\explicit\runtime`,
+		UserDataFolder:             `C:\explicit\data`,
+		AdditionalBrowserArguments: "--disable-gpu",
+	}
+
+	env := map[string]string{
+		BrowserExecutableFolderVar:    `C:\env\runtime`,
+		UserDataFolderVar:             `C:\env\data`,
+		AdditionalBrowserArgumentsVar: "--enable-logging",
+		ReleaseChannelPreferenceVar:   "1",
+	}
+
+	blank := map[string]string{
+		BrowserExecutableFolderVar:    "",
+		UserDataFolderVar:             "  ",
+		AdditionalBrowserArgumentsVar: " ",
+		ReleaseChannelPreferenceVar:   "",
+	}
+
+	tests := []struct {
+		name     string
+		policy   Policy
+		explicit Settings
+		env      map[string]string
+		want     Settings
+	}{
+		{"ignore", Ignore, explicit, env, explicit},
+		{"ignoreEmpty", Ignore, Settings{}, env, Settings{}},
+		{
+			"honour", Honour, explicit, env,
+			Settings{`C:\env\runtime`, `C:\env\data`, "--disable-gpu --enable-logging", "1"},
+		},
+		{
+			"honourEmptyExplicit", Honour, Settings{}, env,
+			Settings{`C:\env\runtime`, `C:\env\data`, "--enable-logging", "1"},
+		},
+		{"honourBlankEnv", Honour, explicit, blank, explicit},
+		{"honourNoEnv", Honour, explicit, nil, explicit},
+		{
+			"override", Override, explicit, env,
+			Settings{`C:\explicit\runtime`, `C:\explicit\data`, "--enable-logging --disable-gpu", "1"},
+		},
+		{
+			"overrideEmptyExplicit", Override, Settings{}, env,
+			Settings{`C:\env\runtime`, `C:\env\data`, "--enable-logging", "1"},
+		},
+		{
+			"overrideFillsIn", Override, Settings{UserDataFolder: `C:\explicit\data`}, env,
+			Settings{`C:\env\runtime`, `C:\explicit\data`, "--enable-logging", "1"},
+		},
+		{"overrideBlankEnv", Override, explicit, blank, explicit},
+		{
+			"blankExplicitArguments", Override, Settings{AdditionalBrowserArguments: "  "}, env,
+			Settings{`C:\env\runtime`, `C:\env\data`, "--enable-logging", "1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Resolve(test.policy, test.explicit, lookup(test.env)); got != test.want {
+				t.Errorf("Resolve(%s) = %+v, want %+v", test.policy, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPolicyString(t *testing.T) {
+	for policy, want := range map[Policy]string{Ignore: "Ignore", Honour: "Honour", Override: "Override", 7: "Policy(7)"} {
+		if got := policy.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
+
+// setenv sets the variable for the test, and restores it afterwards.
+func setenv(t *testing.T, key, value string) {
+	t.Helper()
+
+	previous, present := os.LookupEnv(key)
+
+	t.Cleanup(func() {
+		if present {
+			os.Setenv(key, previous)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+
+	os.Setenv(key, value)
+}
+
+func TestApply(t *testing.T) {
+	setenv(t, BrowserExecutableFolderVar, `C:\env\runtime`)
+	setenv(t, ReleaseChannelPreferenceVar, "0")
+	setenv(t, UserDataFolderVar, "")
+	os.Unsetenv(UserDataFolderVar)
+
+	restore := Apply(Settings{BrowserExecutableFolder: `C:\explicit\runtime`, ReleaseChannelPreference: "1"})
+
+	for _, key := range []string{BrowserExecutableFolderVar, UserDataFolderVar, AdditionalBrowserArgumentsVar} {
+		if value, ok := os.LookupEnv(key); ok {
+			t.Errorf("%s = %q while applied, want it unset", key, value)
+		}
+	}
+
+	if value := os.Getenv(ReleaseChannelPreferenceVar); value != "1" {
+		t.Errorf("%s = %q while applied, want 1", ReleaseChannelPreferenceVar, value)
+	}
+
+	restore()
+	restore()
+
+	if value := os.Getenv(BrowserExecutableFolderVar); value != `C:\env\runtime` {
+		t.Errorf("%s = %q after restore, want it back", BrowserExecutableFolderVar, value)
+	}
+
+	if value := os.Getenv(ReleaseChannelPreferenceVar); value != "0" {
+		t.Errorf("%s = %q after restore, want 0", ReleaseChannelPreferenceVar, value)
+	}
+
+	if _, ok := os.LookupEnv(UserDataFolderVar); ok {
+		t.Errorf("%s is set after restore, want it unset", UserDataFolderVar)
+	}
+}
+
+func TestApplySerializes(t *testing.T) {
+	restore := Apply(Settings{})
+
+	applied := make(chan struct{})
+
+	go func() {
+		Apply(Settings{})()
+		close(applied)
+	}()
+
+	select {
+	case <-applied:
+		t.Fatal("Apply returned while another one wasn't restored")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	restore()
+
+	select {
+	case <-applied:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Apply didn't return after the other one was restored")
+	}
+}
@@ -0,0 +1,106 @@
+package hresult
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The severities of an HRESULT.
+const (
+	SEVERITY_SUCCESS = 0
+	SEVERITY_ERROR   = 1
+)
+
+// The facilities of an HRESULT, i.e. the subsystems reporting the errors.
+const (
+	FACILITY_NULL     = 0
+	FACILITY_RPC      = 1
+	FACILITY_DISPATCH = 2
+	FACILITY_STORAGE  = 3
+	FACILITY_ITF      = 4
+	FACILITY_WIN32    = 7
+	FACILITY_WINDOWS  = 8
+	FACILITY_SECURITY = 9
+	FACILITY_CONTROL  = 10
+	FACILITY_CERT     = 11
+	FACILITY_INTERNET = 12
+	FACILITY_SETUPAPI = 15
+)
+
+// Error makes HRESULT an error, so it can be returned and wrapped like any other.
+// Note that a successful HRESULT is an error as well, check Failed before returning it.
+func (hr HRESULT) Error() string {
+	return hr.String()
+}
+
+// String renders the HRESULT in hex followed by its name, e.g. 0x80004005 (E_FAIL).
+// The HRESULTs wrapping a Win32 error code without a constant of their own render like
+// 0x80070002 (HRESULT_FROM_WIN32(ERROR_FILE_NOT_FOUND)), unknown values render as the bare hex.
+func (hr HRESULT) String() string {
+	if name, ok := hresultNames[hr]; ok {
+		return fmt.Sprintf("0x%08X (%s)", uint32(hr), name)
+	}
+
+	if e, ok := hr.Win32(); ok {
+		if name, ok := win32ErrorNames[e]; ok {
+			return fmt.Sprintf("0x%08X (HRESULT_FROM_WIN32(%s))", uint32(hr), name)
+		}
+	}
+
+	return fmt.Sprintf("0x%08X", uint32(hr))
+}
+
+// Message returns the description of the HRESULT from winerror.h, e.g. "Unspecified error" for E_FAIL.
+// The HRESULTs wrapping a Win32 error code fall back to the description of the code.
+// The codes without a description in the tables get the one of the message table of Windows,
+// which holds the MessageText of winerror.h, elsewhere they return an empty string.
+func Message(hr HRESULT) string {
+	if message, ok := hresultMessages[hr]; ok {
+		return message
+	}
+
+	if e, ok := hr.Win32(); ok {
+		if message, ok := win32ErrorMessages[e]; ok {
+			return message
+		}
+	}
+
+	return systemMessage(uint32(hr))
+}
+
+// Failed reports whether the HRESULT is a failure, i.e. its severity bit is set, like the FAILED macro.
+// Success codes other than S_OK, e.g. S_FALSE, aren't failures.
+func (hr HRESULT) Failed() bool {
+	return int32(uint32(hr)) < 0
+}
+
+// Succeeded is the opposite of Failed, like the SUCCEEDED macro.
+func (hr HRESULT) Succeeded() bool {
+	return !hr.Failed()
+}
+
+// Severity returns the severity bit of the HRESULT, SEVERITY_SUCCESS or SEVERITY_ERROR.
+func (hr HRESULT) Severity() uint32 {
+	return uint32(hr) >> 31 & 0x1
+}
+
+// Facility returns the facility of the HRESULT, e.g. FACILITY_WIN32.
+func (hr HRESULT) Facility() uint32 {
+	return uint32(hr) >> 16 & 0x1FFF
+}
+
+// Code returns the code of the HRESULT within its facility, e.g. the Win32 error code for FACILITY_WIN32.
+func (hr HRESULT) Code() uint32 {
+	return uint32(hr) & 0xFFFF
+}
+
+// From returns the HRESULT found in the chain of err with errors.As, and false if there's none.
+func From(err error) (HRESULT, bool) {
+	var hr HRESULT
+
+	if errors.As(err, &hr) {
+		return hr, true
+	}
+
+	return S_OK, false
+}
@@ -0,0 +1,52 @@
+package hresult
+
+import "testing"
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		name string
+		hr   HRESULT
+		want string
+	}{
+		{"S_OK", S_OK, "0x00000000 (S_OK)"},
+		{"S_FALSE", S_FALSE, "0x00000001 (S_FALSE)"},
+		{"E_FAIL", E_FAIL, "0x80004005 (E_FAIL)"},
+		{"E_ACCESSDENIED", E_ACCESSDENIED, "0x80070005 (E_ACCESSDENIED)"},
+		{"fromWin32", 0x80070002, "0x80070002 (HRESULT_FROM_WIN32(ERROR_FILE_NOT_FOUND))"},
+		{"fromWin32Unknown", 0x8007FFFE, "0x8007FFFE"},
+		{"unknown", 0x8FFF1234, "0x8FFF1234"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if s := test.hr.String(); s != test.want {
+				t.Errorf("String() = %q, want %q", s, test.want)
+			}
+
+			if s := test.hr.Error(); s != test.want {
+				t.Errorf("Error() = %q, want %q", s, test.want)
+			}
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		hr   HRESULT
+		want string
+	}{
+		{"E_FAIL", E_FAIL, "Unspecified error"},
+		{"E_NOINTERFACE", E_NOINTERFACE, "No such interface supported"},
+		{"fromWin32", FromWin32(ERROR_FILE_NOT_FOUND), "The system cannot find the file specified."},
+		{"unknown", 0x8FFF1234, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if message := Message(test.hr); message != test.want {
+				t.Errorf("Message() = %q, want %q", message, test.want)
+			}
+		})
+	}
+}
@@ -2,1433 +2,614 @@ package hresult
 
 // Based on https://github.com/github/VisualStudio/blob/master/tools/Debugging%20Tools%20for%20Windows/winext/manifest/winerror.h#L1
 // Copyright (c) GitHub Inc.
+// The rest of the codes come from winerror.h of mingw-w64, which is in the public domain.
 
-//go:generate stringer -type=HRESULT
+// The codes of winerror.h the package doesn't declare here are generated into znames.go, along with the lookup tables
+// of the names and the descriptions of all the codes used by String and Message.
+//go:generate go run ./internal/namegen -h winerror.h -o znames.go
 
+// HRESULT is a COM result code, with the severity in the top bit, followed by the facility and the code.
+// See FromWin32 for the HRESULTs wrapping Win32 error codes.
 type HRESULT uintptr
 
 const (
-	ERROR_SUCCESS                            HRESULT = 0x0
-	ERROR_INVALID_FUNCTION                   HRESULT = 0x1
-	ERROR_FILE_NOT_FOUND                     HRESULT = 0x2
-	ERROR_PATH_NOT_FOUND                     HRESULT = 0x3
-	ERROR_TOO_MANY_OPEN_FILES                HRESULT = 0x4
-	ERROR_ACCESS_DENIED                      HRESULT = 0x5
-	ERROR_INVALID_HANDLE                     HRESULT = 0x6
-	ERROR_ARENA_TRASHED                      HRESULT = 0x7
-	ERROR_NOT_ENOUGH_MEMORY                  HRESULT = 0x8
-	ERROR_INVALID_BLOCK                      HRESULT = 0x9
-	ERROR_BAD_ENVIRONMENT                    HRESULT = 0xa
-	ERROR_BAD_FORMAT                         HRESULT = 0xb
-	ERROR_INVALID_ACCESS                     HRESULT = 0xc
-	ERROR_INVALID_DATA                       HRESULT = 0xd
-	ERROR_OUTOFMEMORY                        HRESULT = 0xe
-	ERROR_INVALID_DRIVE                      HRESULT = 0xf
-	ERROR_CURRENT_DIRECTORY                  HRESULT = 0x10
-	ERROR_NOT_SAME_DEVICE                    HRESULT = 0x11
-	ERROR_NO_MORE_FILES                      HRESULT = 0x12
-	ERROR_WRITE_PROTECT                      HRESULT = 0x13
-	ERROR_BAD_UNIT                           HRESULT = 0x14
-	ERROR_NOT_READY                          HRESULT = 0x15
-	ERROR_BAD_COMMAND                        HRESULT = 0x16
-	ERROR_CRC                                HRESULT = 0x17
-	ERROR_BAD_LENGTH                         HRESULT = 0x18
-	ERROR_SEEK                               HRESULT = 0x19
-	ERROR_NOT_DOS_DISK                       HRESULT = 0x1a
-	ERROR_SECTOR_NOT_FOUND                   HRESULT = 0x1b
-	ERROR_OUT_OF_PAPER                       HRESULT = 0x1c
-	ERROR_WRITE_FAULT                        HRESULT = 0x1d
-	ERROR_READ_FAULT                         HRESULT = 0x1e
-	ERROR_GEN_FAILURE                        HRESULT = 0x1f
-	ERROR_SHARING_VIOLATION                  HRESULT = 0x20
-	ERROR_LOCK_VIOLATION                     HRESULT = 0x21
-	ERROR_WRONG_DISK                         HRESULT = 0x22
-	ERROR_SHARING_BUFFER_EXCEEDED            HRESULT = 0x24
-	ERROR_HANDLE_EOF                         HRESULT = 0x26
-	ERROR_HANDLE_DISK_FULL                   HRESULT = 0x27
-	ERROR_NOT_SUPPORTED                      HRESULT = 0x32
-	ERROR_REM_NOT_LIST                       HRESULT = 0x33
-	ERROR_DUP_NAME                           HRESULT = 0x34
-	ERROR_BAD_NETPATH                        HRESULT = 0x35
-	ERROR_NETWORK_BUSY                       HRESULT = 0x36
-	ERROR_DEV_NOT_EXIST                      HRESULT = 0x37
-	ERROR_TOO_MANY_CMDS                      HRESULT = 0x38
-	ERROR_ADAP_HDW_ERR                       HRESULT = 0x39
-	ERROR_BAD_NET_RESP                       HRESULT = 0x3a
-	ERROR_UNEXP_NET_ERR                      HRESULT = 0x3b
-	ERROR_BAD_REM_ADAP                       HRESULT = 0x3c
-	ERROR_PRINTQ_FULL                        HRESULT = 0x3d
-	ERROR_NO_SPOOL_SPACE                     HRESULT = 0x3e
-	ERROR_PRINT_CANCELLED                    HRESULT = 0x3f
-	ERROR_NETNAME_DELETED                    HRESULT = 0x40
-	ERROR_NETWORK_ACCESS_DENIED              HRESULT = 0x41
-	ERROR_BAD_DEV_TYPE                       HRESULT = 0x42
-	ERROR_BAD_NET_NAME                       HRESULT = 0x43
-	ERROR_TOO_MANY_NAMES                     HRESULT = 0x44
-	ERROR_TOO_MANY_SESS                      HRESULT = 0x45
-	ERROR_SHARING_PAUSED                     HRESULT = 0x46
-	ERROR_REQ_NOT_ACCEP                      HRESULT = 0x47
-	ERROR_REDIR_PAUSED                       HRESULT = 0x48
-	ERROR_FILE_EXISTS                        HRESULT = 0x50
-	ERROR_CANNOT_MAKE                        HRESULT = 0x52
-	ERROR_FAIL_I24                           HRESULT = 0x53
-	ERROR_OUT_OF_STRUCTURES                  HRESULT = 0x54
-	ERROR_ALREADY_ASSIGNED                   HRESULT = 0x55
-	ERROR_INVALID_PASSWORD                   HRESULT = 0x56
-	ERROR_INVALID_PARAMETER                  HRESULT = 0x57
-	ERROR_NET_WRITE_FAULT                    HRESULT = 0x58
-	ERROR_NO_PROC_SLOTS                      HRESULT = 0x59
-	ERROR_TOO_MANY_SEMAPHORES                HRESULT = 0x64
-	ERROR_EXCL_SEM_ALREADY_OWNED             HRESULT = 0x65
-	ERROR_SEM_IS_SET                         HRESULT = 0x66
-	ERROR_TOO_MANY_SEM_REQUESTS              HRESULT = 0x67
-	ERROR_INVALID_AT_INTERRUPT_TIME          HRESULT = 0x68
-	ERROR_SEM_OWNER_DIED                     HRESULT = 0x69
-	ERROR_SEM_USER_LIMIT                     HRESULT = 0x6a
-	ERROR_DISK_CHANGE                        HRESULT = 0x6b
-	ERROR_DRIVE_LOCKED                       HRESULT = 0x6c
-	ERROR_BROKEN_PIPE                        HRESULT = 0x6d
-	ERROR_OPEN_FAILED                        HRESULT = 0x6e
-	ERROR_BUFFER_OVERFLOW                    HRESULT = 0x6f
-	ERROR_DISK_FULL                          HRESULT = 0x70
-	ERROR_NO_MORE_SEARCH_HANDLES             HRESULT = 0x71
-	ERROR_INVALID_TARGET_HANDLE              HRESULT = 0x72
-	ERROR_INVALID_CATEGORY                   HRESULT = 0x75
-	ERROR_INVALID_VERIFY_SWITCH              HRESULT = 0x76
-	ERROR_BAD_DRIVER_LEVEL                   HRESULT = 0x77
-	ERROR_CALL_NOT_IMPLEMENTED               HRESULT = 0x78
-	ERROR_SEM_TIMEOUT                        HRESULT = 0x79
-	ERROR_INSUFFICIENT_BUFFER                HRESULT = 0x7a
-	ERROR_INVALID_NAME                       HRESULT = 0x7b
-	ERROR_INVALID_LEVEL                      HRESULT = 0x7c
-	ERROR_NO_VOLUME_LABEL                    HRESULT = 0x7d
-	ERROR_MOD_NOT_FOUND                      HRESULT = 0x7e
-	ERROR_PROC_NOT_FOUND                     HRESULT = 0x7f
-	ERROR_WAIT_NO_CHILDREN                   HRESULT = 0x80
-	ERROR_CHILD_NOT_COMPLETE                 HRESULT = 0x81
-	ERROR_DIRECT_ACCESS_HANDLE               HRESULT = 0x82
-	ERROR_NEGATIVE_SEEK                      HRESULT = 0x83
-	ERROR_SEEK_ON_DEVICE                     HRESULT = 0x84
-	ERROR_IS_JOIN_TARGET                     HRESULT = 0x85
-	ERROR_IS_JOINED                          HRESULT = 0x86
-	ERROR_IS_SUBSTED                         HRESULT = 0x87
-	ERROR_NOT_JOINED                         HRESULT = 0x88
-	ERROR_NOT_SUBSTED                        HRESULT = 0x89
-	ERROR_JOIN_TO_JOIN                       HRESULT = 0x8a
-	ERROR_SUBST_TO_SUBST                     HRESULT = 0x8b
-	ERROR_JOIN_TO_SUBST                      HRESULT = 0x8c
-	ERROR_SUBST_TO_JOIN                      HRESULT = 0x8d
-	ERROR_BUSY_DRIVE                         HRESULT = 0x8e
-	ERROR_SAME_DRIVE                         HRESULT = 0x8f
-	ERROR_DIR_NOT_ROOT                       HRESULT = 0x90
-	ERROR_DIR_NOT_EMPTY                      HRESULT = 0x91
-	ERROR_IS_SUBST_PATH                      HRESULT = 0x92
-	ERROR_IS_JOIN_PATH                       HRESULT = 0x93
-	ERROR_PATH_BUSY                          HRESULT = 0x94
-	ERROR_IS_SUBST_TARGET                    HRESULT = 0x95
-	ERROR_SYSTEM_TRACE                       HRESULT = 0x96
-	ERROR_INVALID_EVENT_COUNT                HRESULT = 0x97
-	ERROR_TOO_MANY_MUXWAITERS                HRESULT = 0x98
-	ERROR_INVALID_LIST_FORMAT                HRESULT = 0x99
-	ERROR_LABEL_TOO_LONG                     HRESULT = 0x9a
-	ERROR_TOO_MANY_TCBS                      HRESULT = 0x9b
-	ERROR_SIGNAL_REFUSED                     HRESULT = 0x9c
-	ERROR_DISCARDED                          HRESULT = 0x9d
-	ERROR_NOT_LOCKED                         HRESULT = 0x9e
-	ERROR_BAD_THREADID_ADDR                  HRESULT = 0x9f
-	ERROR_BAD_ARGUMENTS                      HRESULT = 0xa0
-	ERROR_BAD_PATHNAME                       HRESULT = 0xa1
-	ERROR_SIGNAL_PENDING                     HRESULT = 0xa2
-	ERROR_MAX_THRDS_REACHED                  HRESULT = 0xa4
-	ERROR_LOCK_FAILED                        HRESULT = 0xa7
-	ERROR_BUSY                               HRESULT = 0xaa
-	ERROR_CANCEL_VIOLATION                   HRESULT = 0xad
-	ERROR_ATOMIC_LOCKS_NOT_SUPPORTED         HRESULT = 0xae
-	ERROR_INVALID_SEGMENT_NUMBER             HRESULT = 0xb4
-	ERROR_INVALID_ORDINAL                    HRESULT = 0xb6
-	ERROR_ALREADY_EXISTS                     HRESULT = 0xb7
-	ERROR_INVALID_FLAG_NUMBER                HRESULT = 0xba
-	ERROR_SEM_NOT_FOUND                      HRESULT = 0xbb
-	ERROR_INVALID_STARTING_CODESEG           HRESULT = 0xbc
-	ERROR_INVALID_STACKSEG                   HRESULT = 0xbd
-	ERROR_INVALID_MODULETYPE                 HRESULT = 0xbe
-	ERROR_INVALID_EXE_SIGNATURE              HRESULT = 0xbf
-	ERROR_EXE_MARKED_INVALID                 HRESULT = 0xc0
-	ERROR_BAD_EXE_FORMAT                     HRESULT = 0xc1
-	ERROR_ITERATED_DATA_EXCEEDS_64k          HRESULT = 0xc2
-	ERROR_INVALID_MINALLOCSIZE               HRESULT = 0xc3
-	ERROR_DYNLINK_FROM_INVALID_RING          HRESULT = 0xc4
-	ERROR_IOPL_NOT_ENABLED                   HRESULT = 0xc5
-	ERROR_INVALID_SEGDPL                     HRESULT = 0xc6
-	ERROR_AUTODATASEG_EXCEEDS_64k            HRESULT = 0xc7
-	ERROR_RING2SEG_MUST_BE_MOVABLE           HRESULT = 0xc8
-	ERROR_RELOC_CHAIN_XEEDS_SEGLIM           HRESULT = 0xc9
-	ERROR_INFLOOP_IN_RELOC_CHAIN             HRESULT = 0xca
-	ERROR_ENVVAR_NOT_FOUND                   HRESULT = 0xcb
-	ERROR_NO_SIGNAL_SENT                     HRESULT = 0xcd
-	ERROR_FILENAME_EXCED_RANGE               HRESULT = 0xce
-	ERROR_RING2_STACK_IN_USE                 HRESULT = 0xcf
-	ERROR_META_EXPANSION_TOO_LONG            HRESULT = 0xd0
-	ERROR_INVALID_SIGNAL_NUMBER              HRESULT = 0xd1
-	ERROR_THREAD_1_INACTIVE                  HRESULT = 0xd2
-	ERROR_LOCKED                             HRESULT = 0xd4
-	ERROR_TOO_MANY_MODULES                   HRESULT = 0xd6
-	ERROR_NESTING_NOT_ALLOWED                HRESULT = 0xd7
-	ERROR_EXE_MACHINE_TYPE_MISMATCH          HRESULT = 0xd8
-	ERROR_BAD_PIPE                           HRESULT = 0xe6
-	ERROR_PIPE_BUSY                          HRESULT = 0xe7
-	ERROR_NO_DATA                            HRESULT = 0xe8
-	ERROR_PIPE_NOT_CONNECTED                 HRESULT = 0xe9
-	ERROR_MORE_DATA                          HRESULT = 0xea
-	ERROR_VC_DISCONNECTED                    HRESULT = 0xf0
-	ERROR_INVALID_EA_NAME                    HRESULT = 0xfe
-	ERROR_EA_LIST_INCONSISTENT               HRESULT = 0xff
-	ERROR_NO_MORE_ITEMS                      HRESULT = 0x103
-	ERROR_CANNOT_COPY                        HRESULT = 0x10a
-	ERROR_DIRECTORY                          HRESULT = 0x10b
-	ERROR_EAS_DIDNT_FIT                      HRESULT = 0x113
-	ERROR_EA_FILE_CORRUPT                    HRESULT = 0x114
-	ERROR_EA_TABLE_FULL                      HRESULT = 0x115
-	ERROR_INVALID_EA_HANDLE                  HRESULT = 0x116
-	ERROR_EAS_NOT_SUPPORTED                  HRESULT = 0x11a
-	ERROR_NOT_OWNER                          HRESULT = 0x120
-	ERROR_TOO_MANY_POSTS                     HRESULT = 0x12a
-	ERROR_PARTIAL_COPY                       HRESULT = 0x12b
-	ERROR_OPLOCK_NOT_GRANTED                 HRESULT = 0x12c
-	ERROR_INVALID_OPLOCK_PROTOCOL            HRESULT = 0x12d
-	ERROR_MR_MID_NOT_FOUND                   HRESULT = 0x13d
-	ERROR_INVALID_ADDRESS                    HRESULT = 0x1e7
-	ERROR_ARITHMETIC_OVERFLOW                HRESULT = 0x216
-	ERROR_PIPE_CONNECTED                     HRESULT = 0x217
-	ERROR_PIPE_LISTENING                     HRESULT = 0x218
-	ERROR_EA_ACCESS_DENIED                   HRESULT = 0x3e2
-	ERROR_OPERATION_ABORTED                  HRESULT = 0x3e3
-	ERROR_IO_INCOMPLETE                      HRESULT = 0x3e4
-	ERROR_IO_PENDING                         HRESULT = 0x3e5
-	ERROR_NOACCESS                           HRESULT = 0x3e6
-	ERROR_SWAPERROR                          HRESULT = 0x3e7
-	ERROR_STACK_OVERFLOW                     HRESULT = 0x3e9
-	ERROR_INVALID_MESSAGE                    HRESULT = 0x3ea
-	ERROR_CAN_NOT_COMPLETE                   HRESULT = 0x3eb
-	ERROR_INVALID_FLAGS                      HRESULT = 0x3ec
-	ERROR_UNRECOGNIZED_VOLUME                HRESULT = 0x3ed
-	ERROR_FILE_INVALID                       HRESULT = 0x3ee
-	ERROR_FULLSCREEN_MODE                    HRESULT = 0x3ef
-	ERROR_NO_TOKEN                           HRESULT = 0x3f0
-	ERROR_BADDB                              HRESULT = 0x3f1
-	ERROR_BADKEY                             HRESULT = 0x3f2
-	ERROR_CANTOPEN                           HRESULT = 0x3f3
-	ERROR_CANTREAD                           HRESULT = 0x3f4
-	ERROR_CANTWRITE                          HRESULT = 0x3f5
-	ERROR_REGISTRY_RECOVERED                 HRESULT = 0x3f6
-	ERROR_REGISTRY_CORRUPT                   HRESULT = 0x3f7
-	ERROR_REGISTRY_IO_FAILED                 HRESULT = 0x3f8
-	ERROR_NOT_REGISTRY_FILE                  HRESULT = 0x3f9
-	ERROR_KEY_DELETED                        HRESULT = 0x3fa
-	ERROR_NO_LOG_SPACE                       HRESULT = 0x3fb
-	ERROR_KEY_HAS_CHILDREN                   HRESULT = 0x3fc
-	ERROR_CHILD_MUST_BE_VOLATILE             HRESULT = 0x3fd
-	ERROR_NOTIFY_ENUM_DIR                    HRESULT = 0x3fe
-	ERROR_DEPENDENT_SERVICES_RUNNING         HRESULT = 0x41b
-	ERROR_INVALID_SERVICE_CONTROL            HRESULT = 0x41c
-	ERROR_SERVICE_REQUEST_TIMEOUT            HRESULT = 0x41d
-	ERROR_SERVICE_NO_THREAD                  HRESULT = 0x41e
-	ERROR_SERVICE_DATABASE_LOCKED            HRESULT = 0x41f
-	ERROR_SERVICE_ALREADY_RUNNING            HRESULT = 0x420
-	ERROR_INVALID_SERVICE_ACCOUNT            HRESULT = 0x421
-	ERROR_SERVICE_DISABLED                   HRESULT = 0x422
-	ERROR_CIRCULAR_DEPENDENCY                HRESULT = 0x423
-	ERROR_SERVICE_DOES_NOT_EXIST             HRESULT = 0x424
-	ERROR_SERVICE_CANNOT_ACCEPT_CTRL         HRESULT = 0x425
-	ERROR_SERVICE_NOT_ACTIVE                 HRESULT = 0x426
-	ERROR_FAILED_SERVICE_CONTROLLER_CONNECT  HRESULT = 0x427
-	ERROR_EXCEPTION_IN_SERVICE               HRESULT = 0x428
-	ERROR_DATABASE_DOES_NOT_EXIST            HRESULT = 0x429
-	ERROR_SERVICE_SPECIFIC_ERROR             HRESULT = 0x42a
-	ERROR_PROCESS_ABORTED                    HRESULT = 0x42b
-	ERROR_SERVICE_DEPENDENCY_FAIL            HRESULT = 0x42c
-	ERROR_SERVICE_LOGON_FAILED               HRESULT = 0x42d
-	ERROR_SERVICE_START_HANG                 HRESULT = 0x42e
-	ERROR_INVALID_SERVICE_LOCK               HRESULT = 0x42f
-	ERROR_SERVICE_MARKED_FOR_DELETE          HRESULT = 0x430
-	ERROR_SERVICE_EXISTS                     HRESULT = 0x431
-	ERROR_ALREADY_RUNNING_LKG                HRESULT = 0x432
-	ERROR_SERVICE_DEPENDENCY_DELETED         HRESULT = 0x433
-	ERROR_BOOT_ALREADY_ACCEPTED              HRESULT = 0x434
-	ERROR_SERVICE_NEVER_STARTED              HRESULT = 0x435
-	ERROR_DUPLICATE_SERVICE_NAME             HRESULT = 0x436
-	ERROR_DIFFERENT_SERVICE_ACCOUNT          HRESULT = 0x437
-	ERROR_CANNOT_DETECT_DRIVER_FAILURE       HRESULT = 0x438
-	ERROR_CANNOT_DETECT_PROCESS_ABORT        HRESULT = 0x439
-	ERROR_NO_RECOVERY_PROGRAM                HRESULT = 0x43a
-	ERROR_END_OF_MEDIA                       HRESULT = 0x44c
-	ERROR_FILEMARK_DETECTED                  HRESULT = 0x44d
-	ERROR_BEGINNING_OF_MEDIA                 HRESULT = 0x44e
-	ERROR_SETMARK_DETECTED                   HRESULT = 0x44f
-	ERROR_NO_DATA_DETECTED                   HRESULT = 0x450
-	ERROR_PARTITION_FAILURE                  HRESULT = 0x451
-	ERROR_INVALID_BLOCK_LENGTH               HRESULT = 0x452
-	ERROR_DEVICE_NOT_PARTITIONED             HRESULT = 0x453
-	ERROR_UNABLE_TO_LOCK_MEDIA               HRESULT = 0x454
-	ERROR_UNABLE_TO_UNLOAD_MEDIA             HRESULT = 0x455
-	ERROR_MEDIA_CHANGED                      HRESULT = 0x456
-	ERROR_BUS_RESET                          HRESULT = 0x457
-	ERROR_NO_MEDIA_IN_DRIVE                  HRESULT = 0x458
-	ERROR_NO_UNICODE_TRANSLATION             HRESULT = 0x459
-	ERROR_DLL_INIT_FAILED                    HRESULT = 0x45a
-	ERROR_SHUTDOWN_IN_PROGRESS               HRESULT = 0x45b
-	ERROR_NO_SHUTDOWN_IN_PROGRESS            HRESULT = 0x45c
-	ERROR_IO_DEVICE                          HRESULT = 0x45d
-	ERROR_SERIAL_NO_DEVICE                   HRESULT = 0x45e
-	ERROR_IRQ_BUSY                           HRESULT = 0x45f
-	ERROR_MORE_WRITES                        HRESULT = 0x460
-	ERROR_COUNTER_TIMEOUT                    HRESULT = 0x461
-	ERROR_FLOPPY_ID_MARK_NOT_FOUND           HRESULT = 0x462
-	ERROR_FLOPPY_WRONG_CYLINDER              HRESULT = 0x463
-	ERROR_FLOPPY_UNKNOWN_ERROR               HRESULT = 0x464
-	ERROR_FLOPPY_BAD_REGISTERS               HRESULT = 0x465
-	ERROR_DISK_RECALIBRATE_FAILED            HRESULT = 0x466
-	ERROR_DISK_OPERATION_FAILED              HRESULT = 0x467
-	ERROR_DISK_RESET_FAILED                  HRESULT = 0x468
-	ERROR_EOM_OVERFLOW                       HRESULT = 0x469
-	ERROR_NOT_ENOUGH_SERVER_MEMORY           HRESULT = 0x46a
-	ERROR_POSSIBLE_DEADLOCK                  HRESULT = 0x46b
-	ERROR_MAPPED_ALIGNMENT                   HRESULT = 0x46c
-	ERROR_SET_POWER_STATE_VETOED             HRESULT = 0x474
-	ERROR_SET_POWER_STATE_FAILED             HRESULT = 0x475
-	ERROR_TOO_MANY_LINKS                     HRESULT = 0x476
-	ERROR_OLD_WIN_VERSION                    HRESULT = 0x47e
-	ERROR_APP_WRONG_OS                       HRESULT = 0x47f
-	ERROR_SINGLE_INSTANCE_APP                HRESULT = 0x480
-	ERROR_RMODE_APP                          HRESULT = 0x481
-	ERROR_INVALID_DLL                        HRESULT = 0x482
-	ERROR_NO_ASSOCIATION                     HRESULT = 0x483
-	ERROR_DDE_FAIL                           HRESULT = 0x484
-	ERROR_DLL_NOT_FOUND                      HRESULT = 0x485
-	ERROR_NO_MORE_USER_HANDLES               HRESULT = 0x486
-	ERROR_MESSAGE_SYNC_ONLY                  HRESULT = 0x487
-	ERROR_SOURCE_ELEMENT_EMPTY               HRESULT = 0x488
-	ERROR_DESTINATION_ELEMENT_FULL           HRESULT = 0x489
-	ERROR_ILLEGAL_ELEMENT_ADDRESS            HRESULT = 0x48a
-	ERROR_MAGAZINE_NOT_PRESENT               HRESULT = 0x48b
-	ERROR_DEVICE_REINITIALIZATION_NEEDED     HRESULT = 0x48c
-	ERROR_DEVICE_REQUIRES_CLEANING           HRESULT = 0x48d
-	ERROR_DEVICE_DOOR_OPEN                   HRESULT = 0x48e
-	ERROR_DEVICE_NOT_CONNECTED               HRESULT = 0x48f
-	ERROR_NOT_FOUND                          HRESULT = 0x490
-	ERROR_NO_MATCH                           HRESULT = 0x491
-	ERROR_SET_NOT_FOUND                      HRESULT = 0x492
-	ERROR_POINT_NOT_FOUND                    HRESULT = 0x493
-	ERROR_NO_TRACKING_SERVICE                HRESULT = 0x494
-	ERROR_NO_VOLUME_ID                       HRESULT = 0x495
-	ERROR_CONNECTED_OTHER_PASSWORD           HRESULT = 0x83c
-	ERROR_BAD_USERNAME                       HRESULT = 0x89a
-	ERROR_NOT_CONNECTED                      HRESULT = 0x8ca
-	ERROR_OPEN_FILES                         HRESULT = 0x961
-	ERROR_ACTIVE_CONNECTIONS                 HRESULT = 0x962
-	ERROR_DEVICE_IN_USE                      HRESULT = 0x964
-	ERROR_BAD_DEVICE                         HRESULT = 0x4b0
-	ERROR_CONNECTION_UNAVAIL                 HRESULT = 0x4b1
-	ERROR_DEVICE_ALREADY_REMEMBERED          HRESULT = 0x4b2
-	ERROR_NO_NET_OR_BAD_PATH                 HRESULT = 0x4b3
-	ERROR_BAD_PROVIDER                       HRESULT = 0x4b4
-	ERROR_CANNOT_OPEN_PROFILE                HRESULT = 0x4b5
-	ERROR_BAD_PROFILE                        HRESULT = 0x4b6
-	ERROR_NOT_CONTAINER                      HRESULT = 0x4b7
-	ERROR_EXTENDED_ERROR                     HRESULT = 0x4b8
-	ERROR_INVALID_GROUPNAME                  HRESULT = 0x4b9
-	ERROR_INVALID_COMPUTERNAME               HRESULT = 0x4ba
-	ERROR_INVALID_EVENTNAME                  HRESULT = 0x4bb
-	ERROR_INVALID_DOMAINNAME                 HRESULT = 0x4bc
-	ERROR_INVALID_SERVICENAME                HRESULT = 0x4bd
-	ERROR_INVALID_NETNAME                    HRESULT = 0x4be
-	ERROR_INVALID_SHARENAME                  HRESULT = 0x4bf
-	ERROR_INVALID_PASSWORDNAME               HRESULT = 0x4c0
-	ERROR_INVALID_MESSAGENAME                HRESULT = 0x4c1
-	ERROR_INVALID_MESSAGEDEST                HRESULT = 0x4c2
-	ERROR_SESSION_CREDENTIAL_CONFLICT        HRESULT = 0x4c3
-	ERROR_REMOTE_SESSION_LIMIT_EXCEEDED      HRESULT = 0x4c4
-	ERROR_DUP_DOMAINNAME                     HRESULT = 0x4c5
-	ERROR_NO_NETWORK                         HRESULT = 0x4c6
-	ERROR_CANCELLED                          HRESULT = 0x4c7
-	ERROR_USER_MAPPED_FILE                   HRESULT = 0x4c8
-	ERROR_CONNECTION_REFUSED                 HRESULT = 0x4c9
-	ERROR_GRACEFUL_DISCONNECT                HRESULT = 0x4ca
-	ERROR_ADDRESS_ALREADY_ASSOCIATED         HRESULT = 0x4cb
-	ERROR_ADDRESS_NOT_ASSOCIATED             HRESULT = 0x4cc
-	ERROR_CONNECTION_INVALID                 HRESULT = 0x4cd
-	ERROR_CONNECTION_ACTIVE                  HRESULT = 0x4ce
-	ERROR_NETWORK_UNREACHABLE                HRESULT = 0x4cf
-	ERROR_HOST_UNREACHABLE                   HRESULT = 0x4d0
-	ERROR_PROTOCOL_UNREACHABLE               HRESULT = 0x4d1
-	ERROR_PORT_UNREACHABLE                   HRESULT = 0x4d2
-	ERROR_REQUEST_ABORTED                    HRESULT = 0x4d3
-	ERROR_CONNECTION_ABORTED                 HRESULT = 0x4d4
-	ERROR_RETRY                              HRESULT = 0x4d5
-	ERROR_CONNECTION_COUNT_LIMIT             HRESULT = 0x4d6
-	ERROR_LOGIN_TIME_RESTRICTION             HRESULT = 0x4d7
-	ERROR_LOGIN_WKSTA_RESTRICTION            HRESULT = 0x4d8
-	ERROR_INCORRECT_ADDRESS                  HRESULT = 0x4d9
-	ERROR_ALREADY_REGISTERED                 HRESULT = 0x4da
-	ERROR_SERVICE_NOT_FOUND                  HRESULT = 0x4db
-	ERROR_NOT_AUTHENTICATED                  HRESULT = 0x4dc
-	ERROR_NOT_LOGGED_ON                      HRESULT = 0x4dd
-	ERROR_CONTINUE                           HRESULT = 0x4de
-	ERROR_ALREADY_INITIALIZED                HRESULT = 0x4df
-	ERROR_NO_MORE_DEVICES                    HRESULT = 0x4e0
-	ERROR_NO_SUCH_SITE                       HRESULT = 0x4e1
-	ERROR_DOMAIN_CONTROLLER_EXISTS           HRESULT = 0x4e2
-	ERROR_DS_NOT_INSTALLED                   HRESULT = 0x4e3
-	ERROR_NOT_ALL_ASSIGNED                   HRESULT = 0x514
-	ERROR_SOME_NOT_MAPPED                    HRESULT = 0x515
-	ERROR_NO_QUOTAS_FOR_ACCOUNT              HRESULT = 0x516
-	ERROR_LOCAL_USER_SESSION_KEY             HRESULT = 0x517
-	ERROR_NULL_LM_PASSWORD                   HRESULT = 0x518
-	ERROR_UNKNOWN_REVISION                   HRESULT = 0x519
-	ERROR_REVISION_MISMATCH                  HRESULT = 0x51a
-	ERROR_INVALID_OWNER                      HRESULT = 0x51b
-	ERROR_INVALID_PRIMARY_GROUP              HRESULT = 0x51c
-	ERROR_NO_IMPERSONATION_TOKEN             HRESULT = 0x51d
-	ERROR_CANT_DISABLE_MANDATORY             HRESULT = 0x51e
-	ERROR_NO_LOGON_SERVERS                   HRESULT = 0x51f
-	ERROR_NO_SUCH_LOGON_SESSION              HRESULT = 0x520
-	ERROR_NO_SUCH_PRIVILEGE                  HRESULT = 0x521
-	ERROR_PRIVILEGE_NOT_HELD                 HRESULT = 0x522
-	ERROR_INVALID_ACCOUNT_NAME               HRESULT = 0x523
-	ERROR_USER_EXISTS                        HRESULT = 0x524
-	ERROR_NO_SUCH_USER                       HRESULT = 0x525
-	ERROR_GROUP_EXISTS                       HRESULT = 0x526
-	ERROR_NO_SUCH_GROUP                      HRESULT = 0x527
-	ERROR_MEMBER_IN_GROUP                    HRESULT = 0x528
-	ERROR_MEMBER_NOT_IN_GROUP                HRESULT = 0x529
-	ERROR_LAST_ADMIN                         HRESULT = 0x52a
-	ERROR_WRONG_PASSWORD                     HRESULT = 0x52b
-	ERROR_ILL_FORMED_PASSWORD                HRESULT = 0x52c
-	ERROR_PASSWORD_RESTRICTION               HRESULT = 0x52d
-	ERROR_LOGON_FAILURE                      HRESULT = 0x52e
-	ERROR_ACCOUNT_RESTRICTION                HRESULT = 0x52f
-	ERROR_INVALID_LOGON_HOURS                HRESULT = 0x530
-	ERROR_INVALID_WORKSTATION                HRESULT = 0x531
-	ERROR_PASSWORD_EXPIRED                   HRESULT = 0x532
-	ERROR_ACCOUNT_DISABLED                   HRESULT = 0x533
-	ERROR_NONE_MAPPED                        HRESULT = 0x534
-	ERROR_TOO_MANY_LUIDS_REQUESTED           HRESULT = 0x535
-	ERROR_LUIDS_EXHAUSTED                    HRESULT = 0x536
-	ERROR_INVALID_SUB_AUTHORITY              HRESULT = 0x537
-	ERROR_INVALID_ACL                        HRESULT = 0x538
-	ERROR_INVALID_SID                        HRESULT = 0x539
-	ERROR_INVALID_SECURITY_DESCR             HRESULT = 0x53a
-	ERROR_BAD_INHERITANCE_ACL                HRESULT = 0x53c
-	ERROR_SERVER_DISABLED                    HRESULT = 0x53d
-	ERROR_SERVER_NOT_DISABLED                HRESULT = 0x53e
-	ERROR_INVALID_ID_AUTHORITY               HRESULT = 0x53f
-	ERROR_ALLOTTED_SPACE_EXCEEDED            HRESULT = 0x540
-	ERROR_INVALID_GROUP_ATTRIBUTES           HRESULT = 0x541
-	ERROR_BAD_IMPERSONATION_LEVEL            HRESULT = 0x542
-	ERROR_CANT_OPEN_ANONYMOUS                HRESULT = 0x543
-	ERROR_BAD_VALIDATION_CLASS               HRESULT = 0x544
-	ERROR_BAD_TOKEN_TYPE                     HRESULT = 0x545
-	ERROR_NO_SECURITY_ON_OBJECT              HRESULT = 0x546
-	ERROR_CANT_ACCESS_DOMAIN_INFO            HRESULT = 0x547
-	ERROR_INVALID_SERVER_STATE               HRESULT = 0x548
-	ERROR_INVALID_DOMAIN_STATE               HRESULT = 0x549
-	ERROR_INVALID_DOMAIN_ROLE                HRESULT = 0x54a
-	ERROR_NO_SUCH_DOMAIN                     HRESULT = 0x54b
-	ERROR_DOMAIN_EXISTS                      HRESULT = 0x54c
-	ERROR_DOMAIN_LIMIT_EXCEEDED              HRESULT = 0x54d
-	ERROR_INTERNAL_DB_CORRUPTION             HRESULT = 0x54e
-	ERROR_INTERNAL_ERROR                     HRESULT = 0x54f
-	ERROR_GENERIC_NOT_MAPPED                 HRESULT = 0x550
-	ERROR_BAD_DESCRIPTOR_FORMAT              HRESULT = 0x551
-	ERROR_NOT_LOGON_PROCESS                  HRESULT = 0x552
-	ERROR_LOGON_SESSION_EXISTS               HRESULT = 0x553
-	ERROR_NO_SUCH_PACKAGE                    HRESULT = 0x554
-	ERROR_BAD_LOGON_SESSION_STATE            HRESULT = 0x555
-	ERROR_LOGON_SESSION_COLLISION            HRESULT = 0x556
-	ERROR_INVALID_LOGON_TYPE                 HRESULT = 0x557
-	ERROR_CANNOT_IMPERSONATE                 HRESULT = 0x558
-	ERROR_RXACT_INVALID_STATE                HRESULT = 0x559
-	ERROR_RXACT_COMMIT_FAILURE               HRESULT = 0x55a
-	ERROR_SPECIAL_ACCOUNT                    HRESULT = 0x55b
-	ERROR_SPECIAL_GROUP                      HRESULT = 0x55c
-	ERROR_SPECIAL_USER                       HRESULT = 0x55d
-	ERROR_MEMBERS_PRIMARY_GROUP              HRESULT = 0x55e
-	ERROR_TOKEN_ALREADY_IN_USE               HRESULT = 0x55f
-	ERROR_NO_SUCH_ALIAS                      HRESULT = 0x560
-	ERROR_MEMBER_NOT_IN_ALIAS                HRESULT = 0x561
-	ERROR_MEMBER_IN_ALIAS                    HRESULT = 0x562
-	ERROR_ALIAS_EXISTS                       HRESULT = 0x563
-	ERROR_LOGON_NOT_GRANTED                  HRESULT = 0x564
-	ERROR_TOO_MANY_SECRETS                   HRESULT = 0x565
-	ERROR_SECRET_TOO_LONG                    HRESULT = 0x566
-	ERROR_INTERNAL_DB_ERROR                  HRESULT = 0x567
-	ERROR_TOO_MANY_CONTEXT_IDS               HRESULT = 0x568
-	ERROR_LOGON_TYPE_NOT_GRANTED             HRESULT = 0x569
-	ERROR_NT_CROSS_ENCRYPTION_REQUIRED       HRESULT = 0x56a
-	ERROR_NO_SUCH_MEMBER                     HRESULT = 0x56b
-	ERROR_INVALID_MEMBER                     HRESULT = 0x56c
-	ERROR_TOO_MANY_SIDS                      HRESULT = 0x56d
-	ERROR_LM_CROSS_ENCRYPTION_REQUIRED       HRESULT = 0x56e
-	ERROR_NO_INHERITANCE                     HRESULT = 0x56f
-	ERROR_FILE_CORRUPT                       HRESULT = 0x570
-	ERROR_DISK_CORRUPT                       HRESULT = 0x571
-	ERROR_NO_USER_SESSION_KEY                HRESULT = 0x572
-	ERROR_LICENSE_QUOTA_EXCEEDED             HRESULT = 0x573
-	ERROR_INVALID_WINDOW_HANDLE              HRESULT = 0x578
-	ERROR_INVALID_MENU_HANDLE                HRESULT = 0x579
-	ERROR_INVALID_CURSOR_HANDLE              HRESULT = 0x57a
-	ERROR_INVALID_ACCEL_HANDLE               HRESULT = 0x57b
-	ERROR_INVALID_HOOK_HANDLE                HRESULT = 0x57c
-	ERROR_INVALID_DWP_HANDLE                 HRESULT = 0x57d
-	ERROR_TLW_WITH_WSCHILD                   HRESULT = 0x57e
-	ERROR_CANNOT_FIND_WND_CLASS              HRESULT = 0x57f
-	ERROR_WINDOW_OF_OTHER_THREAD             HRESULT = 0x580
-	ERROR_HOTKEY_ALREADY_REGISTERED          HRESULT = 0x581
-	ERROR_CLASS_ALREADY_EXISTS               HRESULT = 0x582
-	ERROR_CLASS_DOES_NOT_EXIST               HRESULT = 0x583
-	ERROR_CLASS_HAS_WINDOWS                  HRESULT = 0x584
-	ERROR_INVALID_INDEX                      HRESULT = 0x585
-	ERROR_INVALID_ICON_HANDLE                HRESULT = 0x586
-	ERROR_PRIVATE_DIALOG_INDEX               HRESULT = 0x587
-	ERROR_LISTBOX_ID_NOT_FOUND               HRESULT = 0x588
-	ERROR_NO_WILDCARD_CHARACTERS             HRESULT = 0x589
-	ERROR_CLIPBOARD_NOT_OPEN                 HRESULT = 0x58a
-	ERROR_HOTKEY_NOT_REGISTERED              HRESULT = 0x58b
-	ERROR_WINDOW_NOT_DIALOG                  HRESULT = 0x58c
-	ERROR_CONTROL_ID_NOT_FOUND               HRESULT = 0x58d
-	ERROR_INVALID_COMBOBOX_MESSAGE           HRESULT = 0x58e
-	ERROR_WINDOW_NOT_COMBOBOX                HRESULT = 0x58f
-	ERROR_INVALID_EDIT_HEIGHT                HRESULT = 0x590
-	ERROR_DC_NOT_FOUND                       HRESULT = 0x591
-	ERROR_INVALID_HOOK_FILTER                HRESULT = 0x592
-	ERROR_INVALID_FILTER_PROC                HRESULT = 0x593
-	ERROR_HOOK_NEEDS_HMOD                    HRESULT = 0x594
-	ERROR_GLOBAL_ONLY_HOOK                   HRESULT = 0x595
-	ERROR_JOURNAL_HOOK_SET                   HRESULT = 0x596
-	ERROR_HOOK_NOT_INSTALLED                 HRESULT = 0x597
-	ERROR_INVALID_LB_MESSAGE                 HRESULT = 0x598
-	ERROR_SETCOUNT_ON_BAD_LB                 HRESULT = 0x599
-	ERROR_LB_WITHOUT_TABSTOPS                HRESULT = 0x59a
-	ERROR_DESTROY_OBJECT_OF_OTHER_THREAD     HRESULT = 0x59b
-	ERROR_CHILD_WINDOW_MENU                  HRESULT = 0x59c
-	ERROR_NO_SYSTEM_MENU                     HRESULT = 0x59d
-	ERROR_INVALID_MSGBOX_STYLE               HRESULT = 0x59e
-	ERROR_INVALID_SPI_VALUE                  HRESULT = 0x59f
-	ERROR_SCREEN_ALREADY_LOCKED              HRESULT = 0x5a0
-	ERROR_HWNDS_HAVE_DIFF_PARENT             HRESULT = 0x5a1
-	ERROR_NOT_CHILD_WINDOW                   HRESULT = 0x5a2
-	ERROR_INVALID_GW_COMMAND                 HRESULT = 0x5a3
-	ERROR_INVALID_THREAD_ID                  HRESULT = 0x5a4
-	ERROR_NON_MDICHILD_WINDOW                HRESULT = 0x5a5
-	ERROR_POPUP_ALREADY_ACTIVE               HRESULT = 0x5a6
-	ERROR_NO_SCROLLBARS                      HRESULT = 0x5a7
-	ERROR_INVALID_SCROLLBAR_RANGE            HRESULT = 0x5a8
-	ERROR_INVALID_SHOWWIN_COMMAND            HRESULT = 0x5a9
-	ERROR_NO_SYSTEM_RESOURCES                HRESULT = 0x5aa
-	ERROR_NONPAGED_SYSTEM_RESOURCES          HRESULT = 0x5ab
-	ERROR_PAGED_SYSTEM_RESOURCES             HRESULT = 0x5ac
-	ERROR_WORKING_SET_QUOTA                  HRESULT = 0x5ad
-	ERROR_PAGEFILE_QUOTA                     HRESULT = 0x5ae
-	ERROR_COMMITMENT_LIMIT                   HRESULT = 0x5af
-	ERROR_MENU_ITEM_NOT_FOUND                HRESULT = 0x5b0
-	ERROR_INVALID_KEYBOARD_HANDLE            HRESULT = 0x5b1
-	ERROR_HOOK_TYPE_NOT_ALLOWED              HRESULT = 0x5b2
-	ERROR_REQUIRES_INTERACTIVE_WINDOWSTATION HRESULT = 0x5b3
-	ERROR_TIMEOUT                            HRESULT = 0x5b4
-	ERROR_INVALID_MONITOR_HANDLE             HRESULT = 0x5b5
-	ERROR_EVENTLOG_FILE_CORRUPT              HRESULT = 0x5dc
-	ERROR_EVENTLOG_CANT_START                HRESULT = 0x5dd
-	ERROR_LOG_FILE_FULL                      HRESULT = 0x5de
-	ERROR_EVENTLOG_FILE_CHANGED              HRESULT = 0x5df
-	ERROR_INSTALL_SERVICE                    HRESULT = 0x641
-	ERROR_INSTALL_USEREXIT                   HRESULT = 0x642
-	ERROR_INSTALL_FAILURE                    HRESULT = 0x643
-	ERROR_INSTALL_SUSPEND                    HRESULT = 0x644
-	ERROR_UNKNOWN_PRODUCT                    HRESULT = 0x645
-	ERROR_UNKNOWN_FEATURE                    HRESULT = 0x646
-	ERROR_UNKNOWN_COMPONENT                  HRESULT = 0x647
-	ERROR_UNKNOWN_PROPERTY                   HRESULT = 0x648
-	ERROR_INVALID_HANDLE_STATE               HRESULT = 0x649
-	ERROR_BAD_CONFIGURATION                  HRESULT = 0x64a
-	ERROR_INDEX_ABSENT                       HRESULT = 0x64b
-	ERROR_INSTALL_SOURCE_ABSENT              HRESULT = 0x64c
-	ERROR_BAD_DATABASE_VERSION               HRESULT = 0x64d
-	ERROR_PRODUCT_UNINSTALLED                HRESULT = 0x64e
-	ERROR_BAD_QUERY_SYNTAX                   HRESULT = 0x64f
-	ERROR_INVALID_FIELD                      HRESULT = 0x650
-	RPC_S_INVALID_STRING_BINDING             HRESULT = 0x6a4
-	RPC_S_WRONG_KIND_OF_BINDING              HRESULT = 0x6a5
-	RPC_S_INVALID_BINDING                    HRESULT = 0x6a6
-	RPC_S_PROTSEQ_NOT_SUPPORTED              HRESULT = 0x6a7
-	RPC_S_INVALID_RPC_PROTSEQ                HRESULT = 0x6a8
-	RPC_S_INVALID_STRING_UUID                HRESULT = 0x6a9
-	RPC_S_INVALID_ENDPOINT_FORMAT            HRESULT = 0x6aa
-	RPC_S_INVALID_NET_ADDR                   HRESULT = 0x6ab
-	RPC_S_NO_ENDPOINT_FOUND                  HRESULT = 0x6ac
-	RPC_S_INVALID_TIMEOUT                    HRESULT = 0x6ad
-	RPC_S_OBJECT_NOT_FOUND                   HRESULT = 0x6ae
-	RPC_S_ALREADY_REGISTERED                 HRESULT = 0x6af
-	RPC_S_TYPE_ALREADY_REGISTERED            HRESULT = 0x6b0
-	RPC_S_ALREADY_LISTENING                  HRESULT = 0x6b1
-	RPC_S_NO_PROTSEQS_REGISTERED             HRESULT = 0x6b2
-	RPC_S_NOT_LISTENING                      HRESULT = 0x6b3
-	RPC_S_UNKNOWN_MGR_TYPE                   HRESULT = 0x6b4
-	RPC_S_UNKNOWN_IF                         HRESULT = 0x6b5
-	RPC_S_NO_BINDINGS                        HRESULT = 0x6b6
-	RPC_S_NO_PROTSEQS                        HRESULT = 0x6b7
-	RPC_S_CANT_CREATE_ENDPOINT               HRESULT = 0x6b8
-	RPC_S_OUT_OF_RESOURCES                   HRESULT = 0x6b9
-	RPC_S_SERVER_UNAVAILABLE                 HRESULT = 0x6ba
-	RPC_S_SERVER_TOO_BUSY                    HRESULT = 0x6bb
-	RPC_S_INVALID_NETWORK_OPTIONS            HRESULT = 0x6bc
-	RPC_S_NO_CALL_ACTIVE                     HRESULT = 0x6bd
-	RPC_S_CALL_FAILED                        HRESULT = 0x6be
-	RPC_S_CALL_FAILED_DNE                    HRESULT = 0x6bf
-	RPC_S_PROTOCOL_ERROR                     HRESULT = 0x6c0
-	RPC_S_UNSUPPORTED_TRANS_SYN              HRESULT = 0x6c2
-	RPC_S_UNSUPPORTED_TYPE                   HRESULT = 0x6c4
-	RPC_S_INVALID_TAG                        HRESULT = 0x6c5
-	RPC_S_INVALID_BOUND                      HRESULT = 0x6c6
-	RPC_S_NO_ENTRY_NAME                      HRESULT = 0x6c7
-	RPC_S_INVALID_NAME_SYNTAX                HRESULT = 0x6c8
-	RPC_S_UNSUPPORTED_NAME_SYNTAX            HRESULT = 0x6c9
-	RPC_S_UUID_NO_ADDRESS                    HRESULT = 0x6cb
-	RPC_S_DUPLICATE_ENDPOINT                 HRESULT = 0x6cc
-	RPC_S_UNKNOWN_AUTHN_TYPE                 HRESULT = 0x6cd
-	RPC_S_MAX_CALLS_TOO_SMALL                HRESULT = 0x6ce
-	RPC_S_STRING_TOO_LONG                    HRESULT = 0x6cf
-	RPC_S_PROTSEQ_NOT_FOUND                  HRESULT = 0x6d0
-	RPC_S_PROCNUM_OUT_OF_RANGE               HRESULT = 0x6d1
-	RPC_S_BINDING_HAS_NO_AUTH                HRESULT = 0x6d2
-	RPC_S_UNKNOWN_AUTHN_SERVICE              HRESULT = 0x6d3
-	RPC_S_UNKNOWN_AUTHN_LEVEL                HRESULT = 0x6d4
-	RPC_S_INVALID_AUTH_IDENTITY              HRESULT = 0x6d5
-	RPC_S_UNKNOWN_AUTHZ_SERVICE              HRESULT = 0x6d6
-	EPT_S_INVALID_ENTRY                      HRESULT = 0x6d7
-	EPT_S_CANT_PERFORM_OP                    HRESULT = 0x6d8
-	EPT_S_NOT_REGISTERED                     HRESULT = 0x6d9
-	RPC_S_NOTHING_TO_EXPORT                  HRESULT = 0x6da
-	RPC_S_INCOMPLETE_NAME                    HRESULT = 0x6db
-	RPC_S_INVALID_VERS_OPTION                HRESULT = 0x6dc
-	RPC_S_NO_MORE_MEMBERS                    HRESULT = 0x6dd
-	RPC_S_NOT_ALL_OBJS_UNEXPORTED            HRESULT = 0x6de
-	RPC_S_INTERFACE_NOT_FOUND                HRESULT = 0x6df
-	RPC_S_ENTRY_ALREADY_EXISTS               HRESULT = 0x6e0
-	RPC_S_ENTRY_NOT_FOUND                    HRESULT = 0x6e1
-	RPC_S_NAME_SERVICE_UNAVAILABLE           HRESULT = 0x6e2
-	RPC_S_INVALID_NAF_ID                     HRESULT = 0x6e3
-	RPC_S_CANNOT_SUPPORT                     HRESULT = 0x6e4
-	RPC_S_NO_CONTEXT_AVAILABLE               HRESULT = 0x6e5
-	RPC_S_INTERNAL_ERROR                     HRESULT = 0x6e6
-	RPC_S_ZERO_DIVIDE                        HRESULT = 0x6e7
-	RPC_S_ADDRESS_ERROR                      HRESULT = 0x6e8
-	RPC_S_FP_DIV_ZERO                        HRESULT = 0x6e9
-	RPC_S_FP_UNDERFLOW                       HRESULT = 0x6ea
-	RPC_S_FP_OVERFLOW                        HRESULT = 0x6eb
-	RPC_X_NO_MORE_ENTRIES                    HRESULT = 0x6ec
-	RPC_X_SS_CHAR_TRANS_OPEN_FAIL            HRESULT = 0x6ed
-	RPC_X_SS_CHAR_TRANS_SHORT_FILE           HRESULT = 0x6ee
-	RPC_X_SS_IN_NULL_CONTEXT                 HRESULT = 0x6ef
-	RPC_X_SS_CONTEXT_DAMAGED                 HRESULT = 0x6f1
-	RPC_X_SS_HANDLES_MISMATCH                HRESULT = 0x6f2
-	RPC_X_SS_CANNOT_GET_CALL_HANDLE          HRESULT = 0x6f3
-	RPC_X_NULL_REF_POINTER                   HRESULT = 0x6f4
-	RPC_X_ENUM_VALUE_OUT_OF_RANGE            HRESULT = 0x6f5
-	RPC_X_BYTE_COUNT_TOO_SMALL               HRESULT = 0x6f6
-	RPC_X_BAD_STUB_DATA                      HRESULT = 0x6f7
-	ERROR_INVALID_USER_BUFFER                HRESULT = 0x6f8
-	ERROR_UNRECOGNIZED_MEDIA                 HRESULT = 0x6f9
-	ERROR_NO_TRUST_LSA_SECRET                HRESULT = 0x6fa
-	ERROR_NO_TRUST_SAM_ACCOUNT               HRESULT = 0x6fb
-	ERROR_TRUSTED_DOMAIN_FAILURE             HRESULT = 0x6fc
-	ERROR_TRUSTED_RELATIONSHIP_FAILURE       HRESULT = 0x6fd
-	ERROR_TRUST_FAILURE                      HRESULT = 0x6fe
-	RPC_S_CALL_IN_PROGRESS                   HRESULT = 0x6ff
-	ERROR_NETLOGON_NOT_STARTED               HRESULT = 0x700
-	ERROR_ACCOUNT_EXPIRED                    HRESULT = 0x701
-	ERROR_REDIRECTOR_HAS_OPEN_HANDLES        HRESULT = 0x702
-	ERROR_PRINTER_DRIVER_ALREADY_INSTALLED   HRESULT = 0x703
-	ERROR_UNKNOWN_PORT                       HRESULT = 0x704
-	ERROR_UNKNOWN_PRINTER_DRIVER             HRESULT = 0x705
-	ERROR_UNKNOWN_PRINTPROCESSOR             HRESULT = 0x706
-	ERROR_INVALID_SEPARATOR_FILE             HRESULT = 0x707
-	ERROR_INVALID_PRIORITY                   HRESULT = 0x708
-	ERROR_INVALID_PRINTER_NAME               HRESULT = 0x709
-	ERROR_PRINTER_ALREADY_EXISTS             HRESULT = 0x70a
-	ERROR_INVALID_PRINTER_COMMAND            HRESULT = 0x70b
-	ERROR_INVALID_DATATYPE                   HRESULT = 0x70c
-	ERROR_INVALID_ENVIRONMENT                HRESULT = 0x70d
-	RPC_S_NO_MORE_BINDINGS                   HRESULT = 0x70e
-	ERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT  HRESULT = 0x70f
-	ERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNT  HRESULT = 0x710
-	ERROR_NOLOGON_SERVER_TRUST_ACCOUNT       HRESULT = 0x711
-	ERROR_DOMAIN_TRUST_INCONSISTENT          HRESULT = 0x712
-	ERROR_SERVER_HAS_OPEN_HANDLES            HRESULT = 0x713
-	ERROR_RESOURCE_DATA_NOT_FOUND            HRESULT = 0x714
-	ERROR_RESOURCE_TYPE_NOT_FOUND            HRESULT = 0x715
-	ERROR_RESOURCE_NAME_NOT_FOUND            HRESULT = 0x716
-	ERROR_RESOURCE_LANG_NOT_FOUND            HRESULT = 0x717
-	ERROR_NOT_ENOUGH_QUOTA                   HRESULT = 0x718
-	RPC_S_NO_INTERFACES                      HRESULT = 0x719
-	RPC_S_CALL_CANCELLED                     HRESULT = 0x71a
-	RPC_S_BINDING_INCOMPLETE                 HRESULT = 0x71b
-	RPC_S_COMM_FAILURE                       HRESULT = 0x71c
-	RPC_S_UNSUPPORTED_AUTHN_LEVEL            HRESULT = 0x71d
-	RPC_S_NO_PRINC_NAME                      HRESULT = 0x71e
-	RPC_S_NOT_RPC_ERROR                      HRESULT = 0x71f
-	RPC_S_UUID_LOCAL_ONLY                    HRESULT = 0x720
-	RPC_S_SEC_PKG_ERROR                      HRESULT = 0x721
-	RPC_S_NOT_CANCELLED                      HRESULT = 0x722
-	RPC_X_INVALID_ES_ACTION                  HRESULT = 0x723
-	RPC_X_WRONG_ES_VERSION                   HRESULT = 0x724
-	RPC_X_WRONG_STUB_VERSION                 HRESULT = 0x725
-	RPC_X_INVALID_PIPE_OBJECT                HRESULT = 0x726
-	RPC_X_WRONG_PIPE_ORDER                   HRESULT = 0x727
-	RPC_X_WRONG_PIPE_VERSION                 HRESULT = 0x728
-	RPC_S_GROUP_MEMBER_NOT_FOUND             HRESULT = 0x76a
-	EPT_S_CANT_CREATE                        HRESULT = 0x76b
-	RPC_S_INVALID_OBJECT                     HRESULT = 0x76c
-	ERROR_INVALID_TIME                       HRESULT = 0x76d
-	ERROR_INVALID_FORM_NAME                  HRESULT = 0x76e
-	ERROR_INVALID_FORM_SIZE                  HRESULT = 0x76f
-	ERROR_ALREADY_WAITING                    HRESULT = 0x770
-	ERROR_PRINTER_DELETED                    HRESULT = 0x771
-	ERROR_INVALID_PRINTER_STATE              HRESULT = 0x772
-	ERROR_PASSWORD_MUST_CHANGE               HRESULT = 0x773
-	ERROR_DOMAIN_CONTROLLER_NOT_FOUND        HRESULT = 0x774
-	ERROR_ACCOUNT_LOCKED_OUT                 HRESULT = 0x775
-	OR_INVALID_OXID                          HRESULT = 0x776
-	OR_INVALID_OID                           HRESULT = 0x777
-	OR_INVALID_SET                           HRESULT = 0x778
-	RPC_S_SEND_INCOMPLETE                    HRESULT = 0x779
-	RPC_S_INVALID_ASYNC_HANDLE               HRESULT = 0x77a
-	RPC_S_INVALID_ASYNC_CALL                 HRESULT = 0x77b
-	RPC_X_PIPE_CLOSED                        HRESULT = 0x77c
-	RPC_X_PIPE_DISCIPLINE_ERROR              HRESULT = 0x77d
-	RPC_X_PIPE_EMPTY                         HRESULT = 0x77e
-	ERROR_NO_SITENAME                        HRESULT = 0x77f
-	ERROR_CANT_ACCESS_FILE                   HRESULT = 0x780
-	ERROR_CANT_RESOLVE_FILENAME              HRESULT = 0x781
-	ERROR_DS_MEMBERSHIP_EVALUATED_LOCALLY    HRESULT = 0x782
-	ERROR_DS_NO_ATTRIBUTE_OR_VALUE           HRESULT = 0x783
-	ERROR_DS_INVALID_ATTRIBUTE_SYNTAX        HRESULT = 0x784
-	ERROR_DS_ATTRIBUTE_TYPE_UNDEFINED        HRESULT = 0x785
-	ERROR_DS_ATTRIBUTE_OR_VALUE_EXISTS       HRESULT = 0x786
-	ERROR_DS_BUSY                            HRESULT = 0x787
-	ERROR_DS_UNAVAILABLE                     HRESULT = 0x788
-	ERROR_DS_NO_RIDS_ALLOCATED               HRESULT = 0x789
-	ERROR_DS_NO_MORE_RIDS                    HRESULT = 0x78a
-	ERROR_DS_INCORRECT_ROLE_OWNER            HRESULT = 0x78b
-	ERROR_DS_RIDMGR_INIT_ERROR               HRESULT = 0x78c
-	ERROR_DS_OBJ_CLASS_VIOLATION             HRESULT = 0x78d
-	ERROR_DS_CANT_ON_NON_LEAF                HRESULT = 0x78e
-	ERROR_DS_CANT_ON_RDN                     HRESULT = 0x78f
-	ERROR_DS_CANT_MOD_OBJ_CLASS              HRESULT = 0x790
-	ERROR_DS_CROSS_DOM_MOVE_ERROR            HRESULT = 0x791
-	ERROR_DS_GC_NOT_AVAILABLE                HRESULT = 0x792
-	ERROR_NO_BROWSER_SERVERS_FOUND           HRESULT = 0x17e6
-	ERROR_INVALID_PIXEL_FORMAT               HRESULT = 0x7d0
-	ERROR_BAD_DRIVER                         HRESULT = 0x7d1
-	ERROR_INVALID_WINDOW_STYLE               HRESULT = 0x7d2
-	ERROR_METAFILE_NOT_SUPPORTED             HRESULT = 0x7d3
-	ERROR_TRANSFORM_NOT_SUPPORTED            HRESULT = 0x7d4
-	ERROR_CLIPPING_NOT_SUPPORTED             HRESULT = 0x7d5
-	ERROR_INVALID_CMM                        HRESULT = 0x8fc
-	ERROR_INVALID_PROFILE                    HRESULT = 0x8fd
-	ERROR_TAG_NOT_FOUND                      HRESULT = 0x8fe
-	ERROR_TAG_NOT_PRESENT                    HRESULT = 0x8ff
-	ERROR_DUPLICATE_TAG                      HRESULT = 0x900
-	ERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICE HRESULT = 0x901
-	ERROR_PROFILE_NOT_FOUND                  HRESULT = 0x902
-	ERROR_INVALID_COLORSPACE                 HRESULT = 0x903
-	ERROR_ICM_NOT_ENABLED                    HRESULT = 0x904
-	ERROR_DELETING_ICM_XFORM                 HRESULT = 0x905
-	ERROR_INVALID_TRANSFORM                  HRESULT = 0x906
-	ERROR_UNKNOWN_PRINT_MONITOR              HRESULT = 0xbb8
-	ERROR_PRINTER_DRIVER_IN_USE              HRESULT = 0xbb9
-	ERROR_SPOOL_FILE_NOT_FOUND               HRESULT = 0xbba
-	ERROR_SPL_NO_STARTDOC                    HRESULT = 0xbbb
-	ERROR_SPL_NO_ADDJOB                      HRESULT = 0xbbc
-	ERROR_PRINT_PROCESSOR_ALREADY_INSTALLED  HRESULT = 0xbbd
-	ERROR_PRINT_MONITOR_ALREADY_INSTALLED    HRESULT = 0xbbe
-	ERROR_INVALID_PRINT_MONITOR              HRESULT = 0xbbf
-	ERROR_PRINT_MONITOR_IN_USE               HRESULT = 0xbc0
-	ERROR_PRINTER_HAS_JOBS_QUEUED            HRESULT = 0xbc1
-	ERROR_SUCCESS_REBOOT_REQUIRED            HRESULT = 0xbc2
-	ERROR_SUCCESS_RESTART_REQUIRED           HRESULT = 0xbc3
-	ERROR_WINS_INTERNAL                      HRESULT = 0xfa0
-	ERROR_CAN_NOT_DEL_LOCAL_WINS             HRESULT = 0xfa1
-	ERROR_STATIC_INIT                        HRESULT = 0xfa2
-	ERROR_INC_BACKUP                         HRESULT = 0xfa3
-	ERROR_FULL_BACKUP                        HRESULT = 0xfa4
-	ERROR_REC_NON_EXISTENT                   HRESULT = 0xfa5
-	ERROR_RPL_NOT_ALLOWED                    HRESULT = 0xfa6
-	ERROR_DHCP_ADDRESS_CONFLICT              HRESULT = 0x1004
-	ERROR_WMI_GUID_NOT_FOUND                 HRESULT = 0x1068
-	ERROR_WMI_INSTANCE_NOT_FOUND             HRESULT = 0x1069
-	ERROR_WMI_ITEMID_NOT_FOUND               HRESULT = 0x106a
-	ERROR_WMI_TRY_AGAIN                      HRESULT = 0x106b
-	ERROR_WMI_DP_NOT_FOUND                   HRESULT = 0x106c
-	ERROR_WMI_UNRESOLVED_INSTANCE_REF        HRESULT = 0x106d
-	ERROR_WMI_ALREADY_ENABLED                HRESULT = 0x106e
-	ERROR_WMI_GUID_DISCONNECTED              HRESULT = 0x106f
-	ERROR_WMI_SERVER_UNAVAILABLE             HRESULT = 0x1070
-	ERROR_WMI_DP_FAILED                      HRESULT = 0x1071
-	ERROR_WMI_INVALID_MOF                    HRESULT = 0x1072
-	ERROR_WMI_INVALID_REGINFO                HRESULT = 0x1073
-	ERROR_INVALID_MEDIA                      HRESULT = 0x10cc
-	ERROR_INVALID_LIBRARY                    HRESULT = 0x10cd
-	ERROR_INVALID_MEDIA_POOL                 HRESULT = 0x10ce
-	ERROR_DRIVE_MEDIA_MISMATCH               HRESULT = 0x10cf
-	ERROR_MEDIA_OFFLINE                      HRESULT = 0x10d0
-	ERROR_LIBRARY_OFFLINE                    HRESULT = 0x10d1
-	ERROR_EMPTY                              HRESULT = 0x10d2
-	ERROR_NOT_EMPTY                          HRESULT = 0x10d3
-	ERROR_MEDIA_UNAVAILABLE                  HRESULT = 0x10d4
-	ERROR_RESOURCE_DISABLED                  HRESULT = 0x10d5
-	ERROR_INVALID_CLEANER                    HRESULT = 0x10d6
-	ERROR_UNABLE_TO_CLEAN                    HRESULT = 0x10d7
-	ERROR_OBJECT_NOT_FOUND                   HRESULT = 0x10d8
-	ERROR_DATABASE_FAILURE                   HRESULT = 0x10d9
-	ERROR_DATABASE_FULL                      HRESULT = 0x10da
-	ERROR_MEDIA_INCOMPATIBLE                 HRESULT = 0x10db
-	ERROR_RESOURCE_NOT_PRESENT               HRESULT = 0x10dc
-	ERROR_INVALID_OPERATION                  HRESULT = 0x10dd
-	ERROR_MEDIA_NOT_AVAILABLE                HRESULT = 0x10de
-	ERROR_DEVICE_NOT_AVAILABLE               HRESULT = 0x10df
-	ERROR_REQUEST_REFUSED                    HRESULT = 0x10e0
-	ERROR_FILE_OFFLINE                       HRESULT = 0x10fe
-	ERROR_REMOTE_STORAGE_NOT_ACTIVE          HRESULT = 0x10ff
-	ERROR_REMOTE_STORAGE_MEDIA_ERROR         HRESULT = 0x1100
-	ERROR_NOT_A_REPARSE_POINT                HRESULT = 0x1126
-	ERROR_REPARSE_ATTRIBUTE_CONFLICT         HRESULT = 0x1127
-	ERROR_DEPENDENT_RESOURCE_EXISTS          HRESULT = 0x1389
-	ERROR_DEPENDENCY_NOT_FOUND               HRESULT = 0x138a
-	ERROR_DEPENDENCY_ALREADY_EXISTS          HRESULT = 0x138b
-	ERROR_RESOURCE_NOT_ONLINE                HRESULT = 0x138c
-	ERROR_HOST_NODE_NOT_AVAILABLE            HRESULT = 0x138d
-	ERROR_RESOURCE_NOT_AVAILABLE             HRESULT = 0x138e
-	ERROR_RESOURCE_NOT_FOUND                 HRESULT = 0x138f
-	ERROR_SHUTDOWN_CLUSTER                   HRESULT = 0x1390
-	ERROR_CANT_EVICT_ACTIVE_NODE             HRESULT = 0x1391
-	ERROR_OBJECT_ALREADY_EXISTS              HRESULT = 0x1392
-	ERROR_OBJECT_IN_LIST                     HRESULT = 0x1393
-	ERROR_GROUP_NOT_AVAILABLE                HRESULT = 0x1394
-	ERROR_GROUP_NOT_FOUND                    HRESULT = 0x1395
-	ERROR_GROUP_NOT_ONLINE                   HRESULT = 0x1396
-	ERROR_HOST_NODE_NOT_RESOURCE_OWNER       HRESULT = 0x1397
-	ERROR_HOST_NODE_NOT_GROUP_OWNER          HRESULT = 0x1398
-	ERROR_RESMON_CREATE_FAILED               HRESULT = 0x1399
-	ERROR_RESMON_ONLINE_FAILED               HRESULT = 0x139a
-	ERROR_RESOURCE_ONLINE                    HRESULT = 0x139b
-	ERROR_QUORUM_RESOURCE                    HRESULT = 0x139c
-	ERROR_NOT_QUORUM_CAPABLE                 HRESULT = 0x139d
-	ERROR_CLUSTER_SHUTTING_DOWN              HRESULT = 0x139e
-	ERROR_INVALID_STATE                      HRESULT = 0x139f
-	ERROR_RESOURCE_PROPERTIES_STORED         HRESULT = 0x13a0
-	ERROR_NOT_QUORUM_CLASS                   HRESULT = 0x13a1
-	ERROR_CORE_RESOURCE                      HRESULT = 0x13a2
-	ERROR_QUORUM_RESOURCE_ONLINE_FAILED      HRESULT = 0x13a3
-	ERROR_QUORUMLOG_OPEN_FAILED              HRESULT = 0x13a4
-	ERROR_CLUSTERLOG_CORRUPT                 HRESULT = 0x13a5
-	ERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZE  HRESULT = 0x13a6
-	ERROR_CLUSTERLOG_EXCEEDS_MAXSIZE         HRESULT = 0x13a7
-	ERROR_CLUSTERLOG_CHKPOINT_NOT_FOUND      HRESULT = 0x13a8
-	ERROR_CLUSTERLOG_NOT_ENOUGH_SPACE        HRESULT = 0x13a9
-	ERROR_ENCRYPTION_FAILED                  HRESULT = 0x1770
-	ERROR_DECRYPTION_FAILED                  HRESULT = 0x1771
-	ERROR_FILE_ENCRYPTED                     HRESULT = 0x1772
-	ERROR_NO_RECOVERY_POLICY                 HRESULT = 0x1773
-	ERROR_NO_EFS                             HRESULT = 0x1774
-	ERROR_WRONG_EFS                          HRESULT = 0x1775
-	ERROR_NO_USER_KEYS                       HRESULT = 0x1776
-	ERROR_FILE_NOT_ENCRYPTED                 HRESULT = 0x1777
-	ERROR_NOT_EXPORT_FORMAT                  HRESULT = 0x1778
-	E_UNEXPECTED                             HRESULT = 0x8000FFFF
-	E_NOTIMPL                                HRESULT = 0x80004001
-	E_OUTOFMEMORY                            HRESULT = 0x8007000E
-	E_INVALIDARG                             HRESULT = 0x80070057
-	E_NOINTERFACE                            HRESULT = 0x80004002
-	E_POINTER                                HRESULT = 0x80004003
-	E_HANDLE                                 HRESULT = 0x80070006
-	E_ABORT                                  HRESULT = 0x80004004
-	E_FAIL                                   HRESULT = 0x80004005
-	E_ACCESSDENIED                           HRESULT = 0x80070005
-	E_PENDING                                HRESULT = 0x8000000A
-	CO_E_INIT_TLS                            HRESULT = 0x80004006
-	CO_E_INIT_SHARED_ALLOCATOR               HRESULT = 0x80004007
-	CO_E_INIT_MEMORY_ALLOCATOR               HRESULT = 0x80004008
-	CO_E_INIT_CLASS_CACHE                    HRESULT = 0x80004009
-	CO_E_INIT_RPC_CHANNEL                    HRESULT = 0x8000400A
-	CO_E_INIT_TLS_SET_CHANNEL_CONTROL        HRESULT = 0x8000400B
-	CO_E_INIT_TLS_CHANNEL_CONTROL            HRESULT = 0x8000400C
-	CO_E_INIT_UNACCEPTED_USER_ALLOCATOR      HRESULT = 0x8000400D
-	CO_E_INIT_SCM_MUTEX_EXISTS               HRESULT = 0x8000400E
-	CO_E_INIT_SCM_FILE_MAPPING_EXISTS        HRESULT = 0x8000400F
-	CO_E_INIT_SCM_MAP_VIEW_OF_FILE           HRESULT = 0x80004010
-	CO_E_INIT_SCM_EXEC_FAILURE               HRESULT = 0x80004011
-	CO_E_INIT_ONLY_SINGLE_THREADED           HRESULT = 0x80004012
-	CO_E_CANT_REMOTE                         HRESULT = 0x80004013
-	CO_E_BAD_SERVER_NAME                     HRESULT = 0x80004014
-	CO_E_WRONG_SERVER_IDENTITY               HRESULT = 0x80004015
-	CO_E_OLE1DDE_DISABLED                    HRESULT = 0x80004016
-	CO_E_RUNAS_SYNTAX                        HRESULT = 0x80004017
-	CO_E_CREATEPROCESS_FAILURE               HRESULT = 0x80004018
-	CO_E_RUNAS_CREATEPROCESS_FAILURE         HRESULT = 0x80004019
-	CO_E_RUNAS_LOGON_FAILURE                 HRESULT = 0x8000401A
-	CO_E_LAUNCH_PERMSSION_DENIED             HRESULT = 0x8000401B
-	CO_E_START_SERVICE_FAILURE               HRESULT = 0x8000401C
-	CO_E_REMOTE_COMMUNICATION_FAILURE        HRESULT = 0x8000401D
-	CO_E_SERVER_START_TIMEOUT                HRESULT = 0x8000401E
-	CO_E_CLSREG_INCONSISTENT                 HRESULT = 0x8000401F
-	CO_E_IIDREG_INCONSISTENT                 HRESULT = 0x80004020
-	CO_E_NOT_SUPPORTED                       HRESULT = 0x80004021
-	CO_E_RELOAD_DLL                          HRESULT = 0x80004022
-	CO_E_MSI_ERROR                           HRESULT = 0x80004023
-	S_OK                                     HRESULT = 0x00000000
-	S_FALSE                                  HRESULT = 0x00000001
-	OLE_E_FIRST                              HRESULT = 0x80040000
-	OLE_E_LAST                               HRESULT = 0x800400FF
-	OLE_S_FIRST                              HRESULT = 0x00040000
-	OLE_S_LAST                               HRESULT = 0x000400FF
-	OLE_E_OLEVERB                            HRESULT = 0x80040000
-	OLE_E_ADVF                               HRESULT = 0x80040001
-	OLE_E_ENUM_NOMORE                        HRESULT = 0x80040002
-	OLE_E_ADVISENOTSUPPORTED                 HRESULT = 0x80040003
-	OLE_E_NOCONNECTION                       HRESULT = 0x80040004
-	OLE_E_NOTRUNNING                         HRESULT = 0x80040005
-	OLE_E_NOCACHE                            HRESULT = 0x80040006
-	OLE_E_BLANK                              HRESULT = 0x80040007
-	OLE_E_CLASSDIFF                          HRESULT = 0x80040008
-	OLE_E_CANT_GETMONIKER                    HRESULT = 0x80040009
-	OLE_E_CANT_BINDTOSOURCE                  HRESULT = 0x8004000A
-	OLE_E_STATIC                             HRESULT = 0x8004000B
-	OLE_E_PROMPTSAVECANCELLED                HRESULT = 0x8004000C
-	OLE_E_INVALIDRECT                        HRESULT = 0x8004000D
-	OLE_E_WRONGCOMPOBJ                       HRESULT = 0x8004000E
-	OLE_E_INVALIDHWND                        HRESULT = 0x8004000F
-	OLE_E_NOT_INPLACEACTIVE                  HRESULT = 0x80040010
-	OLE_E_CANTCONVERT                        HRESULT = 0x80040011
-	OLE_E_NOSTORAGE                          HRESULT = 0x80040012
-	DV_E_FORMATETC                           HRESULT = 0x80040064
-	DV_E_DVTARGETDEVICE                      HRESULT = 0x80040065
-	DV_E_STGMEDIUM                           HRESULT = 0x80040066
-	DV_E_STATDATA                            HRESULT = 0x80040067
-	DV_E_LINDEX                              HRESULT = 0x80040068
-	DV_E_TYMED                               HRESULT = 0x80040069
-	DV_E_CLIPFORMAT                          HRESULT = 0x8004006A
-	DV_E_DVASPECT                            HRESULT = 0x8004006B
-	DV_E_DVTARGETDEVICE_SIZE                 HRESULT = 0x8004006C
-	DV_E_NOIVIEWOBJECT                       HRESULT = 0x8004006D
-	DRAGDROP_E_FIRST                         HRESULT = 0x80040100
-	DRAGDROP_E_LAST                          HRESULT = 0x8004010F
-	DRAGDROP_S_FIRST                         HRESULT = 0x00040100
-	DRAGDROP_S_LAST                          HRESULT = 0x0004010F
-	DRAGDROP_E_NOTREGISTERED                 HRESULT = 0x80040100
-	DRAGDROP_E_ALREADYREGISTERED             HRESULT = 0x80040101
-	DRAGDROP_E_INVALIDHWND                   HRESULT = 0x80040102
-	CLASSFACTORY_E_FIRST                     HRESULT = 0x80040110
-	CLASSFACTORY_E_LAST                      HRESULT = 0x8004011F
-	CLASSFACTORY_S_FIRST                     HRESULT = 0x00040110
-	CLASSFACTORY_S_LAST                      HRESULT = 0x0004011F
-	CLASS_E_NOAGGREGATION                    HRESULT = 0x80040110
-	CLASS_E_CLASSNOTAVAILABLE                HRESULT = 0x80040111
-	CLASS_E_NOTLICENSED                      HRESULT = 0x80040112
-	MARSHAL_E_FIRST                          HRESULT = 0x80040120
-	MARSHAL_E_LAST                           HRESULT = 0x8004012F
-	MARSHAL_S_FIRST                          HRESULT = 0x00040120
-	MARSHAL_S_LAST                           HRESULT = 0x0004012F
-	DATA_E_FIRST                             HRESULT = 0x80040130
-	DATA_E_LAST                              HRESULT = 0x8004013F
-	DATA_S_FIRST                             HRESULT = 0x00040130
-	DATA_S_LAST                              HRESULT = 0x0004013F
-	VIEW_E_FIRST                             HRESULT = 0x80040140
-	VIEW_E_LAST                              HRESULT = 0x8004014F
-	VIEW_S_FIRST                             HRESULT = 0x00040140
-	VIEW_S_LAST                              HRESULT = 0x0004014F
-	VIEW_E_DRAW                              HRESULT = 0x80040140
-	REGDB_E_FIRST                            HRESULT = 0x80040150
-	REGDB_E_LAST                             HRESULT = 0x8004015F
-	REGDB_S_FIRST                            HRESULT = 0x00040150
-	REGDB_S_LAST                             HRESULT = 0x0004015F
-	REGDB_E_READREGDB                        HRESULT = 0x80040150
-	REGDB_E_WRITEREGDB                       HRESULT = 0x80040151
-	REGDB_E_KEYMISSING                       HRESULT = 0x80040152
-	REGDB_E_INVALIDVALUE                     HRESULT = 0x80040153
-	REGDB_E_CLASSNOTREG                      HRESULT = 0x80040154
-	REGDB_E_IIDNOTREG                        HRESULT = 0x80040155
-	CAT_E_FIRST                              HRESULT = 0x80040160
-	CAT_E_LAST                               HRESULT = 0x80040161
-	CAT_E_CATIDNOEXIST                       HRESULT = 0x80040160
-	CAT_E_NODESCRIPTION                      HRESULT = 0x80040161
-	CS_E_FIRST                               HRESULT = 0x80040164
-	CS_E_LAST                                HRESULT = 0x80040168
-	CS_E_PACKAGE_NOTFOUND                    HRESULT = 0x80040164
-	CS_E_NOT_DELETABLE                       HRESULT = 0x80040165
-	CS_E_CLASS_NOTFOUND                      HRESULT = 0x80040166
-	CS_E_INVALID_VERSION                     HRESULT = 0x80040167
-	CS_E_NO_CLASSSTORE                       HRESULT = 0x80040168
-	CACHE_E_FIRST                            HRESULT = 0x80040170
-	CACHE_E_LAST                             HRESULT = 0x8004017F
-	CACHE_S_FIRST                            HRESULT = 0x00040170
-	CACHE_S_LAST                             HRESULT = 0x0004017F
-	CACHE_E_NOCACHE_UPDATED                  HRESULT = 0x80040170
-	OLEOBJ_E_FIRST                           HRESULT = 0x80040180
-	OLEOBJ_E_LAST                            HRESULT = 0x8004018F
-	OLEOBJ_S_FIRST                           HRESULT = 0x00040180
-	OLEOBJ_S_LAST                            HRESULT = 0x0004018F
-	OLEOBJ_E_NOVERBS                         HRESULT = 0x80040180
-	OLEOBJ_E_INVALIDVERB                     HRESULT = 0x80040181
-	CLIENTSITE_E_FIRST                       HRESULT = 0x80040190
-	CLIENTSITE_E_LAST                        HRESULT = 0x8004019F
-	CLIENTSITE_S_FIRST                       HRESULT = 0x00040190
-	CLIENTSITE_S_LAST                        HRESULT = 0x0004019F
-	INPLACE_E_NOTUNDOABLE                    HRESULT = 0x800401A0
-	INPLACE_E_NOTOOLSPACE                    HRESULT = 0x800401A1
-	INPLACE_E_FIRST                          HRESULT = 0x800401A0
-	INPLACE_E_LAST                           HRESULT = 0x800401AF
-	INPLACE_S_FIRST                          HRESULT = 0x000401A0
-	INPLACE_S_LAST                           HRESULT = 0x000401AF
-	ENUM_E_FIRST                             HRESULT = 0x800401B0
-	ENUM_E_LAST                              HRESULT = 0x800401BF
-	ENUM_S_FIRST                             HRESULT = 0x000401B0
-	ENUM_S_LAST                              HRESULT = 0x000401BF
-	CONVERT10_E_FIRST                        HRESULT = 0x800401C0
-	CONVERT10_E_LAST                         HRESULT = 0x800401CF
-	CONVERT10_S_FIRST                        HRESULT = 0x000401C0
-	CONVERT10_S_LAST                         HRESULT = 0x000401CF
-	CONVERT10_E_OLESTREAM_GET                HRESULT = 0x800401C0
-	CONVERT10_E_OLESTREAM_PUT                HRESULT = 0x800401C1
-	CONVERT10_E_OLESTREAM_FMT                HRESULT = 0x800401C2
-	CONVERT10_E_OLESTREAM_BITMAP_TO_DIB      HRESULT = 0x800401C3
-	CONVERT10_E_STG_FMT                      HRESULT = 0x800401C4
-	CONVERT10_E_STG_NO_STD_STREAM            HRESULT = 0x800401C5
-	CONVERT10_E_STG_DIB_TO_BITMAP            HRESULT = 0x800401C6
-	CLIPBRD_E_FIRST                          HRESULT = 0x800401D0
-	CLIPBRD_E_LAST                           HRESULT = 0x800401DF
-	CLIPBRD_S_FIRST                          HRESULT = 0x000401D0
-	CLIPBRD_S_LAST                           HRESULT = 0x000401DF
-	CLIPBRD_E_CANT_OPEN                      HRESULT = 0x800401D0
-	CLIPBRD_E_CANT_EMPTY                     HRESULT = 0x800401D1
-	CLIPBRD_E_CANT_SET                       HRESULT = 0x800401D2
-	CLIPBRD_E_BAD_DATA                       HRESULT = 0x800401D3
-	CLIPBRD_E_CANT_CLOSE                     HRESULT = 0x800401D4
-	MK_E_FIRST                               HRESULT = 0x800401E0
-	MK_E_LAST                                HRESULT = 0x800401EF
-	MK_S_FIRST                               HRESULT = 0x000401E0
-	MK_S_LAST                                HRESULT = 0x000401EF
-	MK_E_CONNECTMANUALLY                     HRESULT = 0x800401E0
-	MK_E_EXCEEDEDDEADLINE                    HRESULT = 0x800401E1
-	MK_E_NEEDGENERIC                         HRESULT = 0x800401E2
-	MK_E_UNAVAILABLE                         HRESULT = 0x800401E3
-	MK_E_SYNTAX                              HRESULT = 0x800401E4
-	MK_E_NOOBJECT                            HRESULT = 0x800401E5
-	MK_E_INVALIDEXTENSION                    HRESULT = 0x800401E6
-	MK_E_INTERMEDIATEINTERFACENOTSUPPORTED   HRESULT = 0x800401E7
-	MK_E_NOTBINDABLE                         HRESULT = 0x800401E8
-	MK_E_NOTBOUND                            HRESULT = 0x800401E9
-	MK_E_CANTOPENFILE                        HRESULT = 0x800401EA
-	MK_E_MUSTBOTHERUSER                      HRESULT = 0x800401EB
-	MK_E_NOINVERSE                           HRESULT = 0x800401EC
-	MK_E_NOSTORAGE                           HRESULT = 0x800401ED
-	MK_E_NOPREFIX                            HRESULT = 0x800401EE
-	MK_E_ENUMERATION_FAILED                  HRESULT = 0x800401EF
-	CO_E_FIRST                               HRESULT = 0x800401F0
-	CO_E_LAST                                HRESULT = 0x800401FF
-	CO_S_FIRST                               HRESULT = 0x000401F0
-	CO_S_LAST                                HRESULT = 0x000401FF
-	CO_E_NOTINITIALIZED                      HRESULT = 0x800401F0
-	CO_E_ALREADYINITIALIZED                  HRESULT = 0x800401F1
-	CO_E_CANTDETERMINECLASS                  HRESULT = 0x800401F2
-	CO_E_CLASSSTRING                         HRESULT = 0x800401F3
-	CO_E_IIDSTRING                           HRESULT = 0x800401F4
-	CO_E_APPNOTFOUND                         HRESULT = 0x800401F5
-	CO_E_APPSINGLEUSE                        HRESULT = 0x800401F6
-	CO_E_ERRORINAPP                          HRESULT = 0x800401F7
-	CO_E_DLLNOTFOUND                         HRESULT = 0x800401F8
-	CO_E_ERRORINDLL                          HRESULT = 0x800401F9
-	CO_E_WRONGOSFORAPP                       HRESULT = 0x800401FA
-	CO_E_OBJNOTREG                           HRESULT = 0x800401FB
-	CO_E_OBJISREG                            HRESULT = 0x800401FC
-	CO_E_OBJNOTCONNECTED                     HRESULT = 0x800401FD
-	CO_E_APPDIDNTREG                         HRESULT = 0x800401FE
-	CO_E_RELEASED                            HRESULT = 0x800401FF
-	CO_E_FAILEDTOIMPERSONATE                 HRESULT = 0x80040200
-	CO_E_FAILEDTOGETSECCTX                   HRESULT = 0x80040201
-	CO_E_FAILEDTOOPENTHREADTOKEN             HRESULT = 0x80040202
-	CO_E_FAILEDTOGETTOKENINFO                HRESULT = 0x80040203
-	CO_E_TRUSTEEDOESNTMATCHCLIENT            HRESULT = 0x80040204
-	CO_E_FAILEDTOQUERYCLIENTBLANKET          HRESULT = 0x80040205
-	CO_E_FAILEDTOSETDACL                     HRESULT = 0x80040206
-	CO_E_ACCESSCHECKFAILED                   HRESULT = 0x80040207
-	CO_E_NETACCESSAPIFAILED                  HRESULT = 0x80040208
-	CO_E_WRONGTRUSTEENAMESYNTAX              HRESULT = 0x80040209
-	CO_E_INVALIDSID                          HRESULT = 0x8004020A
-	CO_E_CONVERSIONFAILED                    HRESULT = 0x8004020B
-	CO_E_NOMATCHINGSIDFOUND                  HRESULT = 0x8004020C
-	CO_E_LOOKUPACCSIDFAILED                  HRESULT = 0x8004020D
-	CO_E_NOMATCHINGNAMEFOUND                 HRESULT = 0x8004020E
-	CO_E_LOOKUPACCNAMEFAILED                 HRESULT = 0x8004020F
-	CO_E_SETSERLHNDLFAILED                   HRESULT = 0x80040210
-	CO_E_FAILEDTOGETWINDIR                   HRESULT = 0x80040211
-	CO_E_PATHTOOLONG                         HRESULT = 0x80040212
-	CO_E_FAILEDTOGENUUID                     HRESULT = 0x80040213
-	CO_E_FAILEDTOCREATEFILE                  HRESULT = 0x80040214
-	CO_E_FAILEDTOCLOSEHANDLE                 HRESULT = 0x80040215
-	CO_E_EXCEEDSYSACLLIMIT                   HRESULT = 0x80040216
-	CO_E_ACESINWRONGORDER                    HRESULT = 0x80040217
-	CO_E_INCOMPATIBLESTREAMVERSION           HRESULT = 0x80040218
-	CO_E_FAILEDTOOPENPROCESSTOKEN            HRESULT = 0x80040219
-	CO_E_DECODEFAILED                        HRESULT = 0x8004021A
-	CO_E_ACNOTINITIALIZED                    HRESULT = 0x8004021B
-	OLE_S_USEREG                             HRESULT = 0x00040000
-	OLE_S_STATIC                             HRESULT = 0x00040001
-	OLE_S_MAC_CLIPFORMAT                     HRESULT = 0x00040002
-	DRAGDROP_S_DROP                          HRESULT = 0x00040100
-	DRAGDROP_S_CANCEL                        HRESULT = 0x00040101
-	DRAGDROP_S_USEDEFAULTCURSORS             HRESULT = 0x00040102
-	DATA_S_SAMEFORMATETC                     HRESULT = 0x00040130
-	VIEW_S_ALREADY_FROZEN                    HRESULT = 0x00040140
-	CACHE_S_FORMATETC_NOTSUPPORTED           HRESULT = 0x00040170
-	CACHE_S_SAMECACHE                        HRESULT = 0x00040171
-	CACHE_S_SOMECACHES_NOTUPDATED            HRESULT = 0x00040172
-	OLEOBJ_S_INVALIDVERB                     HRESULT = 0x00040180
-	OLEOBJ_S_CANNOT_DOVERB_NOW               HRESULT = 0x00040181
-	OLEOBJ_S_INVALIDHWND                     HRESULT = 0x00040182
-	INPLACE_S_TRUNCATED                      HRESULT = 0x000401A0
-	CONVERT10_S_NO_PRESENTATION              HRESULT = 0x000401C0
-	MK_S_REDUCED_TO_SELF                     HRESULT = 0x000401E2
-	MK_S_ME                                  HRESULT = 0x000401E4
-	MK_S_HIM                                 HRESULT = 0x000401E5
-	MK_S_US                                  HRESULT = 0x000401E6
-	MK_S_MONIKERALREADYREGISTERED            HRESULT = 0x000401E7
-	CO_E_CLASS_CREATE_FAILED                 HRESULT = 0x80080001
-	CO_E_SCM_ERROR                           HRESULT = 0x80080002
-	CO_E_SCM_RPC_FAILURE                     HRESULT = 0x80080003
-	CO_E_BAD_PATH                            HRESULT = 0x80080004
-	CO_E_SERVER_EXEC_FAILURE                 HRESULT = 0x80080005
-	CO_E_OBJSRV_RPC_FAILURE                  HRESULT = 0x80080006
-	MK_E_NO_NORMALIZED                       HRESULT = 0x80080007
-	CO_E_SERVER_STOPPING                     HRESULT = 0x80080008
-	MEM_E_INVALID_ROOT                       HRESULT = 0x80080009
-	MEM_E_INVALID_LINK                       HRESULT = 0x80080010
-	MEM_E_INVALID_SIZE                       HRESULT = 0x80080011
-	CO_S_NOTALLINTERFACES                    HRESULT = 0x00080012
-	DISP_E_UNKNOWNINTERFACE                  HRESULT = 0x80020001
-	DISP_E_MEMBERNOTFOUND                    HRESULT = 0x80020003
-	DISP_E_PARAMNOTFOUND                     HRESULT = 0x80020004
-	DISP_E_TYPEMISMATCH                      HRESULT = 0x80020005
-	DISP_E_UNKNOWNNAME                       HRESULT = 0x80020006
-	DISP_E_NONAMEDARGS                       HRESULT = 0x80020007
-	DISP_E_BADVARTYPE                        HRESULT = 0x80020008
-	DISP_E_EXCEPTION                         HRESULT = 0x80020009
-	DISP_E_OVERFLOW                          HRESULT = 0x8002000A
-	DISP_E_BADINDEX                          HRESULT = 0x8002000B
-	DISP_E_UNKNOWNLCID                       HRESULT = 0x8002000C
-	DISP_E_ARRAYISLOCKED                     HRESULT = 0x8002000D
-	DISP_E_BADPARAMCOUNT                     HRESULT = 0x8002000E
-	DISP_E_PARAMNOTOPTIONAL                  HRESULT = 0x8002000F
-	DISP_E_BADCALLEE                         HRESULT = 0x80020010
-	DISP_E_NOTACOLLECTION                    HRESULT = 0x80020011
-	DISP_E_DIVBYZERO                         HRESULT = 0x80020012
-	TYPE_E_BUFFERTOOSMALL                    HRESULT = 0x80028016
-	TYPE_E_FIELDNOTFOUND                     HRESULT = 0x80028017
-	TYPE_E_INVDATAREAD                       HRESULT = 0x80028018
-	TYPE_E_UNSUPFORMAT                       HRESULT = 0x80028019
-	TYPE_E_REGISTRYACCESS                    HRESULT = 0x8002801C
-	TYPE_E_LIBNOTREGISTERED                  HRESULT = 0x8002801D
-	TYPE_E_UNDEFINEDTYPE                     HRESULT = 0x80028027
-	TYPE_E_QUALIFIEDNAMEDISALLOWED           HRESULT = 0x80028028
-	TYPE_E_INVALIDSTATE                      HRESULT = 0x80028029
-	TYPE_E_WRONGTYPEKIND                     HRESULT = 0x8002802A
-	TYPE_E_ELEMENTNOTFOUND                   HRESULT = 0x8002802B
-	TYPE_E_AMBIGUOUSNAME                     HRESULT = 0x8002802C
-	TYPE_E_NAMECONFLICT                      HRESULT = 0x8002802D
-	TYPE_E_UNKNOWNLCID                       HRESULT = 0x8002802E
-	TYPE_E_DLLFUNCTIONNOTFOUND               HRESULT = 0x8002802F
-	TYPE_E_BADMODULEKIND                     HRESULT = 0x800288BD
-	TYPE_E_SIZETOOBIG                        HRESULT = 0x800288C5
-	TYPE_E_DUPLICATEID                       HRESULT = 0x800288C6
-	TYPE_E_INVALIDID                         HRESULT = 0x800288CF
-	TYPE_E_TYPEMISMATCH                      HRESULT = 0x80028CA0
-	TYPE_E_OUTOFBOUNDS                       HRESULT = 0x80028CA1
-	TYPE_E_IOERROR                           HRESULT = 0x80028CA2
-	TYPE_E_CANTCREATETMPFILE                 HRESULT = 0x80028CA3
-	TYPE_E_CANTLOADLIBRARY                   HRESULT = 0x80029C4A
-	TYPE_E_INCONSISTENTPROPFUNCS             HRESULT = 0x80029C83
-	TYPE_E_CIRCULARTYPE                      HRESULT = 0x80029C84
-	STG_E_INVALIDFUNCTION                    HRESULT = 0x80030001
-	STG_E_FILENOTFOUND                       HRESULT = 0x80030002
-	STG_E_PATHNOTFOUND                       HRESULT = 0x80030003
-	STG_E_TOOMANYOPENFILES                   HRESULT = 0x80030004
-	STG_E_ACCESSDENIED                       HRESULT = 0x80030005
-	STG_E_INVALIDHANDLE                      HRESULT = 0x80030006
-	STG_E_INSUFFICIENTMEMORY                 HRESULT = 0x80030008
-	STG_E_INVALIDPOINTER                     HRESULT = 0x80030009
-	STG_E_NOMOREFILES                        HRESULT = 0x80030012
-	STG_E_DISKISWRITEPROTECTED               HRESULT = 0x80030013
-	STG_E_SEEKERROR                          HRESULT = 0x80030019
-	STG_E_WRITEFAULT                         HRESULT = 0x8003001D
-	STG_E_READFAULT                          HRESULT = 0x8003001E
-	STG_E_SHAREVIOLATION                     HRESULT = 0x80030020
-	STG_E_LOCKVIOLATION                      HRESULT = 0x80030021
-	STG_E_FILEALREADYEXISTS                  HRESULT = 0x80030050
-	STG_E_INVALIDPARAMETER                   HRESULT = 0x80030057
-	STG_E_MEDIUMFULL                         HRESULT = 0x80030070
-	STG_E_PROPSETMISMATCHED                  HRESULT = 0x800300F0
-	STG_E_ABNORMALAPIEXIT                    HRESULT = 0x800300FA
-	STG_E_INVALIDHEADER                      HRESULT = 0x800300FB
-	STG_E_INVALIDNAME                        HRESULT = 0x800300FC
-	STG_E_UNKNOWN                            HRESULT = 0x800300FD
-	STG_E_UNIMPLEMENTEDFUNCTION              HRESULT = 0x800300FE
-	STG_E_INVALIDFLAG                        HRESULT = 0x800300FF
-	STG_E_INUSE                              HRESULT = 0x80030100
-	STG_E_NOTCURRENT                         HRESULT = 0x80030101
-	STG_E_REVERTED                           HRESULT = 0x80030102
-	STG_E_CANTSAVE                           HRESULT = 0x80030103
-	STG_E_OLDFORMAT                          HRESULT = 0x80030104
-	STG_E_OLDDLL                             HRESULT = 0x80030105
-	STG_E_SHAREREQUIRED                      HRESULT = 0x80030106
-	STG_E_NOTFILEBASEDSTORAGE                HRESULT = 0x80030107
-	STG_E_EXTANTMARSHALLINGS                 HRESULT = 0x80030108
-	STG_E_DOCFILECORRUPT                     HRESULT = 0x80030109
-	STG_E_BADBASEADDRESS                     HRESULT = 0x80030110
-	STG_E_INCOMPLETE                         HRESULT = 0x80030201
-	STG_E_TERMINATED                         HRESULT = 0x80030202
-	STG_S_CONVERTED                          HRESULT = 0x00030200
-	STG_S_BLOCK                              HRESULT = 0x00030201
-	STG_S_RETRYNOW                           HRESULT = 0x00030202
-	STG_S_MONITORING                         HRESULT = 0x00030203
-	STG_S_MULTIPLEOPENS                      HRESULT = 0x00030204
-	STG_S_CONSOLIDATIONFAILED                HRESULT = 0x00030205
-	STG_S_CANNOTCONSOLIDATE                  HRESULT = 0x00030206
-	RPC_E_CALL_REJECTED                      HRESULT = 0x80010001
-	RPC_E_CALL_CANCELED                      HRESULT = 0x80010002
-	RPC_E_CANTPOST_INSENDCALL                HRESULT = 0x80010003
-	RPC_E_CANTCALLOUT_INASYNCCALL            HRESULT = 0x80010004
-	RPC_E_CANTCALLOUT_INEXTERNALCALL         HRESULT = 0x80010005
-	RPC_E_CONNECTION_TERMINATED              HRESULT = 0x80010006
-	RPC_E_SERVER_DIED                        HRESULT = 0x80010007
-	RPC_E_CLIENT_DIED                        HRESULT = 0x80010008
-	RPC_E_INVALID_DATAPACKET                 HRESULT = 0x80010009
-	RPC_E_CANTTRANSMIT_CALL                  HRESULT = 0x8001000A
-	RPC_E_CLIENT_CANTMARSHAL_DATA            HRESULT = 0x8001000B
-	RPC_E_CLIENT_CANTUNMARSHAL_DATA          HRESULT = 0x8001000C
-	RPC_E_SERVER_CANTMARSHAL_DATA            HRESULT = 0x8001000D
-	RPC_E_SERVER_CANTUNMARSHAL_DATA          HRESULT = 0x8001000E
-	RPC_E_INVALID_DATA                       HRESULT = 0x8001000F
-	RPC_E_INVALID_PARAMETER                  HRESULT = 0x80010010
-	RPC_E_CANTCALLOUT_AGAIN                  HRESULT = 0x80010011
-	RPC_E_SERVER_DIED_DNE                    HRESULT = 0x80010012
-	RPC_E_SYS_CALL_FAILED                    HRESULT = 0x80010100
-	RPC_E_OUT_OF_RESOURCES                   HRESULT = 0x80010101
-	RPC_E_ATTEMPTED_MULTITHREAD              HRESULT = 0x80010102
-	RPC_E_NOT_REGISTERED                     HRESULT = 0x80010103
-	RPC_E_FAULT                              HRESULT = 0x80010104
-	RPC_E_SERVERFAULT                        HRESULT = 0x80010105
-	RPC_E_CHANGED_MODE                       HRESULT = 0x80010106
-	RPC_E_INVALIDMETHOD                      HRESULT = 0x80010107
-	RPC_E_DISCONNECTED                       HRESULT = 0x80010108
-	RPC_E_RETRY                              HRESULT = 0x80010109
-	RPC_E_SERVERCALL_RETRYLATER              HRESULT = 0x8001010A
-	RPC_E_SERVERCALL_REJECTED                HRESULT = 0x8001010B
-	RPC_E_INVALID_CALLDATA                   HRESULT = 0x8001010C
-	RPC_E_CANTCALLOUT_ININPUTSYNCCALL        HRESULT = 0x8001010D
-	RPC_E_WRONG_THREAD                       HRESULT = 0x8001010E
-	RPC_E_THREAD_NOT_INIT                    HRESULT = 0x8001010F
-	RPC_E_VERSION_MISMATCH                   HRESULT = 0x80010110
-	RPC_E_INVALID_HEADER                     HRESULT = 0x80010111
-	RPC_E_INVALID_EXTENSION                  HRESULT = 0x80010112
-	RPC_E_INVALID_IPID                       HRESULT = 0x80010113
-	RPC_E_INVALID_OBJECT                     HRESULT = 0x80010114
-	RPC_S_CALLPENDING                        HRESULT = 0x80010115
-	RPC_S_WAITONTIMER                        HRESULT = 0x80010116
-	RPC_E_CALL_COMPLETE                      HRESULT = 0x80010117
-	RPC_E_UNSECURE_CALL                      HRESULT = 0x80010118
-	RPC_E_TOO_LATE                           HRESULT = 0x80010119
-	RPC_E_NO_GOOD_SECURITY_PACKAGES          HRESULT = 0x8001011A
-	RPC_E_ACCESS_DENIED                      HRESULT = 0x8001011B
-	RPC_E_REMOTE_DISABLED                    HRESULT = 0x8001011C
-	RPC_E_INVALID_OBJREF                     HRESULT = 0x8001011D
-	RPC_E_NO_CONTEXT                         HRESULT = 0x8001011E
-	RPC_E_TIMEOUT                            HRESULT = 0x8001011F
-	RPC_E_NO_SYNC                            HRESULT = 0x80010120
-	RPC_E_UNEXPECTED                         HRESULT = 0x8001FFFF
-	NTE_BAD_UID                              HRESULT = 0x80090001
-	NTE_BAD_HASH                             HRESULT = 0x80090002
-	NTE_BAD_KEY                              HRESULT = 0x80090003
-	NTE_BAD_LEN                              HRESULT = 0x80090004
-	NTE_BAD_DATA                             HRESULT = 0x80090005
-	NTE_BAD_SIGNATURE                        HRESULT = 0x80090006
-	NTE_BAD_VER                              HRESULT = 0x80090007
-	NTE_BAD_ALGID                            HRESULT = 0x80090008
-	NTE_BAD_FLAGS                            HRESULT = 0x80090009
-	NTE_BAD_TYPE                             HRESULT = 0x8009000A
-	NTE_BAD_KEY_STATE                        HRESULT = 0x8009000B
-	NTE_BAD_HASH_STATE                       HRESULT = 0x8009000C
-	NTE_NO_KEY                               HRESULT = 0x8009000D
-	NTE_NO_MEMORY                            HRESULT = 0x8009000E
-	NTE_EXISTS                               HRESULT = 0x8009000F
-	NTE_PERM                                 HRESULT = 0x80090010
-	NTE_NOT_FOUND                            HRESULT = 0x80090011
-	NTE_DOUBLE_ENCRYPT                       HRESULT = 0x80090012
-	NTE_BAD_PROVIDER                         HRESULT = 0x80090013
-	NTE_BAD_PROV_TYPE                        HRESULT = 0x80090014
-	NTE_BAD_PUBLIC_KEY                       HRESULT = 0x80090015
-	NTE_BAD_KEYSET                           HRESULT = 0x80090016
-	NTE_PROV_TYPE_NOT_DEF                    HRESULT = 0x80090017
-	NTE_PROV_TYPE_ENTRY_BAD                  HRESULT = 0x80090018
-	NTE_KEYSET_NOT_DEF                       HRESULT = 0x80090019
-	NTE_KEYSET_ENTRY_BAD                     HRESULT = 0x8009001A
-	NTE_PROV_TYPE_NO_MATCH                   HRESULT = 0x8009001B
-	NTE_SIGNATURE_FILE_BAD                   HRESULT = 0x8009001C
-	NTE_PROVIDER_DLL_FAIL                    HRESULT = 0x8009001D
-	NTE_PROV_DLL_NOT_FOUND                   HRESULT = 0x8009001E
-	NTE_BAD_KEYSET_PARAM                     HRESULT = 0x8009001F
-	NTE_FAIL                                 HRESULT = 0x80090020
-	NTE_SYS_ERR                              HRESULT = 0x80090021
-	CRYPT_E_MSG_ERROR                        HRESULT = 0x80091001
-	CRYPT_E_UNKNOWN_ALGO                     HRESULT = 0x80091002
-	CRYPT_E_OID_FORMAT                       HRESULT = 0x80091003
-	CRYPT_E_INVALID_MSG_TYPE                 HRESULT = 0x80091004
-	CRYPT_E_UNEXPECTED_ENCODING              HRESULT = 0x80091005
-	CRYPT_E_AUTH_ATTR_MISSING                HRESULT = 0x80091006
-	CRYPT_E_HASH_VALUE                       HRESULT = 0x80091007
-	CRYPT_E_INVALID_INDEX                    HRESULT = 0x80091008
-	CRYPT_E_ALREADY_DECRYPTED                HRESULT = 0x80091009
-	CRYPT_E_NOT_DECRYPTED                    HRESULT = 0x8009100A
-	CRYPT_E_RECIPIENT_NOT_FOUND              HRESULT = 0x8009100B
-	CRYPT_E_CONTROL_TYPE                     HRESULT = 0x8009100C
-	CRYPT_E_ISSUER_SERIALNUMBER              HRESULT = 0x8009100D
-	CRYPT_E_SIGNER_NOT_FOUND                 HRESULT = 0x8009100E
-	CRYPT_E_ATTRIBUTES_MISSING               HRESULT = 0x8009100F
-	CRYPT_E_STREAM_MSG_NOT_READY             HRESULT = 0x80091010
-	CRYPT_E_STREAM_INSUFFICIENT_DATA         HRESULT = 0x80091011
-	CRYPT_E_BAD_LEN                          HRESULT = 0x80092001
-	CRYPT_E_BAD_ENCODE                       HRESULT = 0x80092002
-	CRYPT_E_FILE_ERROR                       HRESULT = 0x80092003
-	CRYPT_E_NOT_FOUND                        HRESULT = 0x80092004
-	CRYPT_E_EXISTS                           HRESULT = 0x80092005
-	CRYPT_E_NO_PROVIDER                      HRESULT = 0x80092006
-	CRYPT_E_SELF_SIGNED                      HRESULT = 0x80092007
-	CRYPT_E_DELETED_PREV                     HRESULT = 0x80092008
-	CRYPT_E_NO_MATCH                         HRESULT = 0x80092009
-	CRYPT_E_UNEXPECTED_MSG_TYPE              HRESULT = 0x8009200A
-	CRYPT_E_NO_KEY_PROPERTY                  HRESULT = 0x8009200B
-	CRYPT_E_NO_DECRYPT_CERT                  HRESULT = 0x8009200C
-	CRYPT_E_BAD_MSG                          HRESULT = 0x8009200D
-	CRYPT_E_NO_SIGNER                        HRESULT = 0x8009200E
-	CRYPT_E_PENDING_CLOSE                    HRESULT = 0x8009200F
-	CRYPT_E_REVOKED                          HRESULT = 0x80092010
-	CRYPT_E_NO_REVOCATION_DLL                HRESULT = 0x80092011
-	CRYPT_E_NO_REVOCATION_CHECK              HRESULT = 0x80092012
-	CRYPT_E_REVOCATION_OFFLINE               HRESULT = 0x80092013
-	CRYPT_E_NOT_IN_REVOCATION_DATABASE       HRESULT = 0x80092014
-	CRYPT_E_INVALID_NUMERIC_STRING           HRESULT = 0x80092020
-	CRYPT_E_INVALID_PRINTABLE_STRING         HRESULT = 0x80092021
-	CRYPT_E_INVALID_IA5_STRING               HRESULT = 0x80092022
-	CRYPT_E_INVALID_X500_STRING              HRESULT = 0x80092023
-	CRYPT_E_NOT_CHAR_STRING                  HRESULT = 0x80092024
-	CRYPT_E_FILERESIZED                      HRESULT = 0x80092025
-	CRYPT_E_SECURITY_SETTINGS                HRESULT = 0x80092026
-	CRYPT_E_NO_VERIFY_USAGE_DLL              HRESULT = 0x80092027
-	CRYPT_E_NO_VERIFY_USAGE_CHECK            HRESULT = 0x80092028
-	CRYPT_E_VERIFY_USAGE_OFFLINE             HRESULT = 0x80092029
-	CRYPT_E_NOT_IN_CTL                       HRESULT = 0x8009202A
-	CRYPT_E_NO_TRUSTED_SIGNER                HRESULT = 0x8009202B
-	CRYPT_E_OSS_ERROR                        HRESULT = 0x80093000
-	CERTSRV_E_BAD_REQUESTSUBJECT             HRESULT = 0x80094001
-	CERTSRV_E_NO_REQUEST                     HRESULT = 0x80094002
-	CERTSRV_E_BAD_REQUESTSTATUS              HRESULT = 0x80094003
-	CERTSRV_E_PROPERTY_EMPTY                 HRESULT = 0x80094004
-	CERTDB_E_JET_ERROR                       HRESULT = 0x80095000
-	TRUST_E_SYSTEM_ERROR                     HRESULT = 0x80096001
-	TRUST_E_NO_SIGNER_CERT                   HRESULT = 0x80096002
-	TRUST_E_COUNTER_SIGNER                   HRESULT = 0x80096003
-	TRUST_E_CERT_SIGNATURE                   HRESULT = 0x80096004
-	TRUST_E_TIME_STAMP                       HRESULT = 0x80096005
-	TRUST_E_BAD_DIGEST                       HRESULT = 0x80096010
-	TRUST_E_BASIC_CONSTRAINTS                HRESULT = 0x80096019
-	TRUST_E_FINANCIAL_CRITERIA               HRESULT = 0x8009601E
-	TRUST_E_PROVIDER_UNKNOWN                 HRESULT = 0x800B0001
-	TRUST_E_ACTION_UNKNOWN                   HRESULT = 0x800B0002
-	TRUST_E_SUBJECT_FORM_UNKNOWN             HRESULT = 0x800B0003
-	TRUST_E_SUBJECT_NOT_TRUSTED              HRESULT = 0x800B0004
-	DIGSIG_E_ENCODE                          HRESULT = 0x800B0005
-	DIGSIG_E_DECODE                          HRESULT = 0x800B0006
-	DIGSIG_E_EXTENSIBILITY                   HRESULT = 0x800B0007
-	DIGSIG_E_CRYPTO                          HRESULT = 0x800B0008
-	PERSIST_E_SIZEDEFINITE                   HRESULT = 0x800B0009
-	PERSIST_E_SIZEINDEFINITE                 HRESULT = 0x800B000A
-	PERSIST_E_NOTSELFSIZING                  HRESULT = 0x800B000B
-	TRUST_E_NOSIGNATURE                      HRESULT = 0x800B0100
-	CERT_E_EXPIRED                           HRESULT = 0x800B0101
-	CERT_E_VALIDITYPERIODNESTING             HRESULT = 0x800B0102
-	CERT_E_ROLE                              HRESULT = 0x800B0103
-	CERT_E_PATHLENCONST                      HRESULT = 0x800B0104
-	CERT_E_CRITICAL                          HRESULT = 0x800B0105
-	CERT_E_PURPOSE                           HRESULT = 0x800B0106
-	CERT_E_ISSUERCHAINING                    HRESULT = 0x800B0107
-	CERT_E_MALFORMED                         HRESULT = 0x800B0108
-	CERT_E_UNTRUSTEDROOT                     HRESULT = 0x800B0109
-	CERT_E_CHAINING                          HRESULT = 0x800B010A
-	TRUST_E_FAIL                             HRESULT = 0x800B010B
-	CERT_E_REVOKED                           HRESULT = 0x800B010C
-	CERT_E_UNTRUSTEDTESTROOT                 HRESULT = 0x800B010D
-	CERT_E_REVOCATION_FAILURE                HRESULT = 0x800B010E
-	CERT_E_CN_NO_MATCH                       HRESULT = 0x800B010F
-	CERT_E_WRONG_USAGE                       HRESULT = 0x800B0110
-	SPAPI_E_EXPECTED_SECTION_NAME            HRESULT = 0x800F0000
-	SPAPI_E_BAD_SECTION_NAME_LINE            HRESULT = 0x800F0001
-	SPAPI_E_SECTION_NAME_TOO_LONG            HRESULT = 0x800F0002
-	SPAPI_E_GENERAL_SYNTAX                   HRESULT = 0x800F0003
-	SPAPI_E_WRONG_INF_STYLE                  HRESULT = 0x800F0100
-	SPAPI_E_SECTION_NOT_FOUND                HRESULT = 0x800F0101
-	SPAPI_E_LINE_NOT_FOUND                   HRESULT = 0x800F0102
-	SPAPI_E_NO_ASSOCIATED_CLASS              HRESULT = 0x800F0200
-	SPAPI_E_CLASS_MISMATCH                   HRESULT = 0x800F0201
-	SPAPI_E_DUPLICATE_FOUND                  HRESULT = 0x800F0202
-	SPAPI_E_NO_DRIVER_SELECTED               HRESULT = 0x800F0203
-	SPAPI_E_KEY_DOES_NOT_EXIST               HRESULT = 0x800F0204
-	SPAPI_E_INVALID_DEVINST_NAME             HRESULT = 0x800F0205
-	SPAPI_E_INVALID_CLASS                    HRESULT = 0x800F0206
-	SPAPI_E_DEVINST_ALREADY_EXISTS           HRESULT = 0x800F0207
-	SPAPI_E_DEVINFO_NOT_REGISTERED           HRESULT = 0x800F0208
-	SPAPI_E_INVALID_REG_PROPERTY             HRESULT = 0x800F0209
-	SPAPI_E_NO_INF                           HRESULT = 0x800F020A
-	SPAPI_E_NO_SUCH_DEVINST                  HRESULT = 0x800F020B
-	SPAPI_E_CANT_LOAD_CLASS_ICON             HRESULT = 0x800F020C
-	SPAPI_E_INVALID_CLASS_INSTALLER          HRESULT = 0x800F020D
-	SPAPI_E_DI_DO_DEFAULT                    HRESULT = 0x800F020E
-	SPAPI_E_DI_NOFILECOPY                    HRESULT = 0x800F020F
-	SPAPI_E_INVALID_HWPROFILE                HRESULT = 0x800F0210
-	SPAPI_E_NO_DEVICE_SELECTED               HRESULT = 0x800F0211
-	SPAPI_E_DEVINFO_LIST_LOCKED              HRESULT = 0x800F0212
-	SPAPI_E_DEVINFO_DATA_LOCKED              HRESULT = 0x800F0213
-	SPAPI_E_DI_BAD_PATH                      HRESULT = 0x800F0214
-	SPAPI_E_NO_CLASSINSTALL_PARAMS           HRESULT = 0x800F0215
-	SPAPI_E_FILEQUEUE_LOCKED                 HRESULT = 0x800F0216
-	SPAPI_E_BAD_SERVICE_INSTALLSECT          HRESULT = 0x800F0217
-	SPAPI_E_NO_CLASS_DRIVER_LIST             HRESULT = 0x800F0218
-	SPAPI_E_NO_ASSOCIATED_SERVICE            HRESULT = 0x800F0219
-	SPAPI_E_NO_DEFAULT_DEVICE_INTERFACE      HRESULT = 0x800F021A
-	SPAPI_E_DEVICE_INTERFACE_ACTIVE          HRESULT = 0x800F021B
-	SPAPI_E_DEVICE_INTERFACE_REMOVED         HRESULT = 0x800F021C
-	SPAPI_E_BAD_INTERFACE_INSTALLSECT        HRESULT = 0x800F021D
-	SPAPI_E_NO_SUCH_INTERFACE_CLASS          HRESULT = 0x800F021E
-	SPAPI_E_INVALID_REFERENCE_STRING         HRESULT = 0x800F021F
-	SPAPI_E_INVALID_MACHINENAME              HRESULT = 0x800F0220
-	SPAPI_E_REMOTE_COMM_FAILURE              HRESULT = 0x800F0221
-	SPAPI_E_MACHINE_UNAVAILABLE              HRESULT = 0x800F0222
-	SPAPI_E_NO_CONFIGMGR_SERVICES            HRESULT = 0x800F0223
-	SPAPI_E_INVALID_PROPPAGE_PROVIDER        HRESULT = 0x800F0224
-	SPAPI_E_NO_SUCH_DEVICE_INTERFACE         HRESULT = 0x800F0225
-	SPAPI_E_DI_POSTPROCESSING_REQUIRED       HRESULT = 0x800F0226
-	SPAPI_E_INVALID_COINSTALLER              HRESULT = 0x800F0227
-	SPAPI_E_NO_COMPAT_DRIVERS                HRESULT = 0x800F0228
-	SPAPI_E_NO_DEVICE_ICON                   HRESULT = 0x800F0229
-	SPAPI_E_INVALID_INF_LOGCONFIG            HRESULT = 0x800F022A
-	SPAPI_E_DI_DONT_INSTALL                  HRESULT = 0x800F022B
-	SPAPI_E_INVALID_FILTER_DRIVER            HRESULT = 0x800F022C
-	SPAPI_E_ERROR_NOT_INSTALLED              HRESULT = 0x800F1000
+	E_UNEXPECTED                           HRESULT = 0x8000FFFF // Catastrophic failure
+	E_NOTIMPL                              HRESULT = 0x80004001 // Not implemented
+	E_OUTOFMEMORY                          HRESULT = 0x8007000E // Ran out of memory
+	E_INVALIDARG                           HRESULT = 0x80070057 // One or more arguments are invalid
+	E_NOINTERFACE                          HRESULT = 0x80004002 // No such interface supported
+	E_POINTER                              HRESULT = 0x80004003 // Invalid pointer
+	E_HANDLE                               HRESULT = 0x80070006 // Invalid handle
+	E_ABORT                                HRESULT = 0x80004004 // Operation aborted
+	E_FAIL                                 HRESULT = 0x80004005 // Unspecified error
+	E_ACCESSDENIED                         HRESULT = 0x80070005 // General access denied error
+	E_PENDING                              HRESULT = 0x8000000A // The data necessary to complete this operation is not yet available.
+	E_BOUNDS                               HRESULT = 0x8000000B // The operation attempted to access data outside the valid range
+	E_CHANGED_STATE                        HRESULT = 0x8000000C // A concurrent or interleaved operation changed the state of the object, invalidating this operation.
+	E_ILLEGAL_STATE_CHANGE                 HRESULT = 0x8000000D // An illegal state change was requested.
+	E_ILLEGAL_METHOD_CALL                  HRESULT = 0x8000000E // A method was called at an unexpected time.
+	E_STRING_NOT_NULL_TERMINATED           HRESULT = 0x80000017 // String not null terminated.
+	E_ILLEGAL_DELEGATE_ASSIGNMENT          HRESULT = 0x80000018 // A delegate was assigned when not allowed.
+	E_NOT_SUFFICIENT_BUFFER                HRESULT = 0x8007007A // The data area passed to a system call is too small.
+	E_NOT_SET                              HRESULT = 0x80070490 // Element not found.
+	E_NOT_VALID_STATE                      HRESULT = 0x8007139F // The group or resource is not in the correct state to perform the requested operation.
+	E_UAC_DISABLED                         HRESULT = 0x80270252
+	E_APPLICATION_ACTIVATION_TIMED_OUT     HRESULT = 0x8027025A
+	E_APPLICATION_ACTIVATION_EXEC_FAILURE  HRESULT = 0x8027025B
+	CO_E_INIT_TLS                          HRESULT = 0x80004006
+	CO_E_INIT_SHARED_ALLOCATOR             HRESULT = 0x80004007
+	CO_E_INIT_MEMORY_ALLOCATOR             HRESULT = 0x80004008
+	CO_E_INIT_CLASS_CACHE                  HRESULT = 0x80004009
+	CO_E_INIT_RPC_CHANNEL                  HRESULT = 0x8000400A
+	CO_E_INIT_TLS_SET_CHANNEL_CONTROL      HRESULT = 0x8000400B
+	CO_E_INIT_TLS_CHANNEL_CONTROL          HRESULT = 0x8000400C
+	CO_E_INIT_UNACCEPTED_USER_ALLOCATOR    HRESULT = 0x8000400D
+	CO_E_INIT_SCM_MUTEX_EXISTS             HRESULT = 0x8000400E
+	CO_E_INIT_SCM_FILE_MAPPING_EXISTS      HRESULT = 0x8000400F
+	CO_E_INIT_SCM_MAP_VIEW_OF_FILE         HRESULT = 0x80004010
+	CO_E_INIT_SCM_EXEC_FAILURE             HRESULT = 0x80004011
+	CO_E_INIT_ONLY_SINGLE_THREADED         HRESULT = 0x80004012
+	CO_E_CANT_REMOTE                       HRESULT = 0x80004013
+	CO_E_BAD_SERVER_NAME                   HRESULT = 0x80004014
+	CO_E_WRONG_SERVER_IDENTITY             HRESULT = 0x80004015
+	CO_E_OLE1DDE_DISABLED                  HRESULT = 0x80004016
+	CO_E_RUNAS_SYNTAX                      HRESULT = 0x80004017
+	CO_E_CREATEPROCESS_FAILURE             HRESULT = 0x80004018
+	CO_E_RUNAS_CREATEPROCESS_FAILURE       HRESULT = 0x80004019
+	CO_E_RUNAS_LOGON_FAILURE               HRESULT = 0x8000401A
+	CO_E_LAUNCH_PERMSSION_DENIED           HRESULT = 0x8000401B
+	CO_E_START_SERVICE_FAILURE             HRESULT = 0x8000401C
+	CO_E_REMOTE_COMMUNICATION_FAILURE      HRESULT = 0x8000401D
+	CO_E_SERVER_START_TIMEOUT              HRESULT = 0x8000401E
+	CO_E_CLSREG_INCONSISTENT               HRESULT = 0x8000401F
+	CO_E_IIDREG_INCONSISTENT               HRESULT = 0x80004020
+	CO_E_NOT_SUPPORTED                     HRESULT = 0x80004021
+	CO_E_RELOAD_DLL                        HRESULT = 0x80004022
+	CO_E_MSI_ERROR                         HRESULT = 0x80004023
+	S_OK                                   HRESULT = 0x00000000
+	S_FALSE                                HRESULT = 0x00000001
+	OLE_E_FIRST                            HRESULT = 0x80040000
+	OLE_E_LAST                             HRESULT = 0x800400FF
+	OLE_S_FIRST                            HRESULT = 0x00040000
+	OLE_S_LAST                             HRESULT = 0x000400FF
+	OLE_E_OLEVERB                          HRESULT = 0x80040000
+	OLE_E_ADVF                             HRESULT = 0x80040001
+	OLE_E_ENUM_NOMORE                      HRESULT = 0x80040002
+	OLE_E_ADVISENOTSUPPORTED               HRESULT = 0x80040003
+	OLE_E_NOCONNECTION                     HRESULT = 0x80040004
+	OLE_E_NOTRUNNING                       HRESULT = 0x80040005
+	OLE_E_NOCACHE                          HRESULT = 0x80040006
+	OLE_E_BLANK                            HRESULT = 0x80040007
+	OLE_E_CLASSDIFF                        HRESULT = 0x80040008
+	OLE_E_CANT_GETMONIKER                  HRESULT = 0x80040009
+	OLE_E_CANT_BINDTOSOURCE                HRESULT = 0x8004000A
+	OLE_E_STATIC                           HRESULT = 0x8004000B
+	OLE_E_PROMPTSAVECANCELLED              HRESULT = 0x8004000C
+	OLE_E_INVALIDRECT                      HRESULT = 0x8004000D
+	OLE_E_WRONGCOMPOBJ                     HRESULT = 0x8004000E
+	OLE_E_INVALIDHWND                      HRESULT = 0x8004000F
+	OLE_E_NOT_INPLACEACTIVE                HRESULT = 0x80040010
+	OLE_E_CANTCONVERT                      HRESULT = 0x80040011
+	OLE_E_NOSTORAGE                        HRESULT = 0x80040012
+	DV_E_FORMATETC                         HRESULT = 0x80040064
+	DV_E_DVTARGETDEVICE                    HRESULT = 0x80040065
+	DV_E_STGMEDIUM                         HRESULT = 0x80040066
+	DV_E_STATDATA                          HRESULT = 0x80040067
+	DV_E_LINDEX                            HRESULT = 0x80040068
+	DV_E_TYMED                             HRESULT = 0x80040069
+	DV_E_CLIPFORMAT                        HRESULT = 0x8004006A
+	DV_E_DVASPECT                          HRESULT = 0x8004006B
+	DV_E_DVTARGETDEVICE_SIZE               HRESULT = 0x8004006C
+	DV_E_NOIVIEWOBJECT                     HRESULT = 0x8004006D
+	DRAGDROP_E_FIRST                       HRESULT = 0x80040100
+	DRAGDROP_E_LAST                        HRESULT = 0x8004010F
+	DRAGDROP_S_FIRST                       HRESULT = 0x00040100
+	DRAGDROP_S_LAST                        HRESULT = 0x0004010F
+	DRAGDROP_E_NOTREGISTERED               HRESULT = 0x80040100
+	DRAGDROP_E_ALREADYREGISTERED           HRESULT = 0x80040101
+	DRAGDROP_E_INVALIDHWND                 HRESULT = 0x80040102
+	CLASSFACTORY_E_FIRST                   HRESULT = 0x80040110
+	CLASSFACTORY_E_LAST                    HRESULT = 0x8004011F
+	CLASSFACTORY_S_FIRST                   HRESULT = 0x00040110
+	CLASSFACTORY_S_LAST                    HRESULT = 0x0004011F
+	CLASS_E_NOAGGREGATION                  HRESULT = 0x80040110 // Class does not support aggregation (or class object is remote)
+	CLASS_E_CLASSNOTAVAILABLE              HRESULT = 0x80040111
+	CLASS_E_NOTLICENSED                    HRESULT = 0x80040112
+	MARSHAL_E_FIRST                        HRESULT = 0x80040120
+	MARSHAL_E_LAST                         HRESULT = 0x8004012F
+	MARSHAL_S_FIRST                        HRESULT = 0x00040120
+	MARSHAL_S_LAST                         HRESULT = 0x0004012F
+	DATA_E_FIRST                           HRESULT = 0x80040130
+	DATA_E_LAST                            HRESULT = 0x8004013F
+	DATA_S_FIRST                           HRESULT = 0x00040130
+	DATA_S_LAST                            HRESULT = 0x0004013F
+	VIEW_E_FIRST                           HRESULT = 0x80040140
+	VIEW_E_LAST                            HRESULT = 0x8004014F
+	VIEW_S_FIRST                           HRESULT = 0x00040140
+	VIEW_S_LAST                            HRESULT = 0x0004014F
+	VIEW_E_DRAW                            HRESULT = 0x80040140
+	REGDB_E_FIRST                          HRESULT = 0x80040150
+	REGDB_E_LAST                           HRESULT = 0x8004015F
+	REGDB_S_FIRST                          HRESULT = 0x00040150
+	REGDB_S_LAST                           HRESULT = 0x0004015F
+	REGDB_E_READREGDB                      HRESULT = 0x80040150
+	REGDB_E_WRITEREGDB                     HRESULT = 0x80040151
+	REGDB_E_KEYMISSING                     HRESULT = 0x80040152
+	REGDB_E_INVALIDVALUE                   HRESULT = 0x80040153
+	REGDB_E_CLASSNOTREG                    HRESULT = 0x80040154 // Class not registered
+	REGDB_E_IIDNOTREG                      HRESULT = 0x80040155
+	CAT_E_FIRST                            HRESULT = 0x80040160
+	CAT_E_LAST                             HRESULT = 0x80040161
+	CAT_E_CATIDNOEXIST                     HRESULT = 0x80040160
+	CAT_E_NODESCRIPTION                    HRESULT = 0x80040161
+	CS_E_FIRST                             HRESULT = 0x80040164
+	CS_E_LAST                              HRESULT = 0x8004016F
+	CS_E_PACKAGE_NOTFOUND                  HRESULT = 0x80040164
+	CS_E_NOT_DELETABLE                     HRESULT = 0x80040165
+	CS_E_CLASS_NOTFOUND                    HRESULT = 0x80040166
+	CS_E_INVALID_VERSION                   HRESULT = 0x80040167
+	CS_E_NO_CLASSSTORE                     HRESULT = 0x80040168
+	CACHE_E_FIRST                          HRESULT = 0x80040170
+	CACHE_E_LAST                           HRESULT = 0x8004017F
+	CACHE_S_FIRST                          HRESULT = 0x00040170
+	CACHE_S_LAST                           HRESULT = 0x0004017F
+	CACHE_E_NOCACHE_UPDATED                HRESULT = 0x80040170
+	OLEOBJ_E_FIRST                         HRESULT = 0x80040180
+	OLEOBJ_E_LAST                          HRESULT = 0x8004018F
+	OLEOBJ_S_FIRST                         HRESULT = 0x00040180
+	OLEOBJ_S_LAST                          HRESULT = 0x0004018F
+	OLEOBJ_E_NOVERBS                       HRESULT = 0x80040180
+	OLEOBJ_E_INVALIDVERB                   HRESULT = 0x80040181
+	CLIENTSITE_E_FIRST                     HRESULT = 0x80040190
+	CLIENTSITE_E_LAST                      HRESULT = 0x8004019F
+	CLIENTSITE_S_FIRST                     HRESULT = 0x00040190
+	CLIENTSITE_S_LAST                      HRESULT = 0x0004019F
+	INPLACE_E_NOTUNDOABLE                  HRESULT = 0x800401A0
+	INPLACE_E_NOTOOLSPACE                  HRESULT = 0x800401A1
+	INPLACE_E_FIRST                        HRESULT = 0x800401A0
+	INPLACE_E_LAST                         HRESULT = 0x800401AF
+	INPLACE_S_FIRST                        HRESULT = 0x000401A0
+	INPLACE_S_LAST                         HRESULT = 0x000401AF
+	ENUM_E_FIRST                           HRESULT = 0x800401B0
+	ENUM_E_LAST                            HRESULT = 0x800401BF
+	ENUM_S_FIRST                           HRESULT = 0x000401B0
+	ENUM_S_LAST                            HRESULT = 0x000401BF
+	CONVERT10_E_FIRST                      HRESULT = 0x800401C0
+	CONVERT10_E_LAST                       HRESULT = 0x800401CF
+	CONVERT10_S_FIRST                      HRESULT = 0x000401C0
+	CONVERT10_S_LAST                       HRESULT = 0x000401CF
+	CONVERT10_E_OLESTREAM_GET              HRESULT = 0x800401C0
+	CONVERT10_E_OLESTREAM_PUT              HRESULT = 0x800401C1
+	CONVERT10_E_OLESTREAM_FMT              HRESULT = 0x800401C2
+	CONVERT10_E_OLESTREAM_BITMAP_TO_DIB    HRESULT = 0x800401C3
+	CONVERT10_E_STG_FMT                    HRESULT = 0x800401C4
+	CONVERT10_E_STG_NO_STD_STREAM          HRESULT = 0x800401C5
+	CONVERT10_E_STG_DIB_TO_BITMAP          HRESULT = 0x800401C6
+	CLIPBRD_E_FIRST                        HRESULT = 0x800401D0
+	CLIPBRD_E_LAST                         HRESULT = 0x800401DF
+	CLIPBRD_S_FIRST                        HRESULT = 0x000401D0
+	CLIPBRD_S_LAST                         HRESULT = 0x000401DF
+	CLIPBRD_E_CANT_OPEN                    HRESULT = 0x800401D0
+	CLIPBRD_E_CANT_EMPTY                   HRESULT = 0x800401D1
+	CLIPBRD_E_CANT_SET                     HRESULT = 0x800401D2
+	CLIPBRD_E_BAD_DATA                     HRESULT = 0x800401D3
+	CLIPBRD_E_CANT_CLOSE                   HRESULT = 0x800401D4
+	MK_E_FIRST                             HRESULT = 0x800401E0
+	MK_E_LAST                              HRESULT = 0x800401EF
+	MK_S_FIRST                             HRESULT = 0x000401E0
+	MK_S_LAST                              HRESULT = 0x000401EF
+	MK_E_CONNECTMANUALLY                   HRESULT = 0x800401E0
+	MK_E_EXCEEDEDDEADLINE                  HRESULT = 0x800401E1
+	MK_E_NEEDGENERIC                       HRESULT = 0x800401E2
+	MK_E_UNAVAILABLE                       HRESULT = 0x800401E3
+	MK_E_SYNTAX                            HRESULT = 0x800401E4
+	MK_E_NOOBJECT                          HRESULT = 0x800401E5
+	MK_E_INVALIDEXTENSION                  HRESULT = 0x800401E6
+	MK_E_INTERMEDIATEINTERFACENOTSUPPORTED HRESULT = 0x800401E7
+	MK_E_NOTBINDABLE                       HRESULT = 0x800401E8
+	MK_E_NOTBOUND                          HRESULT = 0x800401E9
+	MK_E_CANTOPENFILE                      HRESULT = 0x800401EA
+	MK_E_MUSTBOTHERUSER                    HRESULT = 0x800401EB
+	MK_E_NOINVERSE                         HRESULT = 0x800401EC
+	MK_E_NOSTORAGE                         HRESULT = 0x800401ED
+	MK_E_NOPREFIX                          HRESULT = 0x800401EE
+	MK_E_ENUMERATION_FAILED                HRESULT = 0x800401EF
+	CO_E_FIRST                             HRESULT = 0x800401F0
+	CO_E_LAST                              HRESULT = 0x800401FF
+	CO_S_FIRST                             HRESULT = 0x000401F0
+	CO_S_LAST                              HRESULT = 0x000401FF
+	CO_E_NOTINITIALIZED                    HRESULT = 0x800401F0 // CoInitialize has not been called.
+	CO_E_ALREADYINITIALIZED                HRESULT = 0x800401F1 // CoInitialize has already been called.
+	CO_E_CANTDETERMINECLASS                HRESULT = 0x800401F2
+	CO_E_CLASSSTRING                       HRESULT = 0x800401F3
+	CO_E_IIDSTRING                         HRESULT = 0x800401F4
+	CO_E_APPNOTFOUND                       HRESULT = 0x800401F5
+	CO_E_APPSINGLEUSE                      HRESULT = 0x800401F6
+	CO_E_ERRORINAPP                        HRESULT = 0x800401F7
+	CO_E_DLLNOTFOUND                       HRESULT = 0x800401F8
+	CO_E_ERRORINDLL                        HRESULT = 0x800401F9
+	CO_E_WRONGOSFORAPP                     HRESULT = 0x800401FA
+	CO_E_OBJNOTREG                         HRESULT = 0x800401FB
+	CO_E_OBJISREG                          HRESULT = 0x800401FC
+	CO_E_OBJNOTCONNECTED                   HRESULT = 0x800401FD
+	CO_E_APPDIDNTREG                       HRESULT = 0x800401FE
+	CO_E_RELEASED                          HRESULT = 0x800401FF
+	CO_E_FAILEDTOIMPERSONATE               HRESULT = 0x80010123
+	CO_E_FAILEDTOGETSECCTX                 HRESULT = 0x80010124
+	CO_E_FAILEDTOOPENTHREADTOKEN           HRESULT = 0x80010125
+	CO_E_FAILEDTOGETTOKENINFO              HRESULT = 0x80010126
+	CO_E_TRUSTEEDOESNTMATCHCLIENT          HRESULT = 0x80010127
+	CO_E_FAILEDTOQUERYCLIENTBLANKET        HRESULT = 0x80010128
+	CO_E_FAILEDTOSETDACL                   HRESULT = 0x80010129
+	CO_E_ACCESSCHECKFAILED                 HRESULT = 0x8001012A
+	CO_E_NETACCESSAPIFAILED                HRESULT = 0x8001012B
+	CO_E_WRONGTRUSTEENAMESYNTAX            HRESULT = 0x8001012C
+	CO_E_INVALIDSID                        HRESULT = 0x8001012D
+	CO_E_CONVERSIONFAILED                  HRESULT = 0x8001012E
+	CO_E_NOMATCHINGSIDFOUND                HRESULT = 0x8001012F
+	CO_E_LOOKUPACCSIDFAILED                HRESULT = 0x80010130
+	CO_E_NOMATCHINGNAMEFOUND               HRESULT = 0x80010131
+	CO_E_LOOKUPACCNAMEFAILED               HRESULT = 0x80010132
+	CO_E_SETSERLHNDLFAILED                 HRESULT = 0x80010133
+	CO_E_FAILEDTOGETWINDIR                 HRESULT = 0x80010134
+	CO_E_PATHTOOLONG                       HRESULT = 0x80010135
+	CO_E_FAILEDTOGENUUID                   HRESULT = 0x80010136
+	CO_E_FAILEDTOCREATEFILE                HRESULT = 0x80010137
+	CO_E_FAILEDTOCLOSEHANDLE               HRESULT = 0x80010138
+	CO_E_EXCEEDSYSACLLIMIT                 HRESULT = 0x80010139
+	CO_E_ACESINWRONGORDER                  HRESULT = 0x8001013A
+	CO_E_INCOMPATIBLESTREAMVERSION         HRESULT = 0x8001013B
+	CO_E_FAILEDTOOPENPROCESSTOKEN          HRESULT = 0x8001013C
+	CO_E_DECODEFAILED                      HRESULT = 0x8001013D
+	CO_E_ACNOTINITIALIZED                  HRESULT = 0x8001013F
+	OLE_S_USEREG                           HRESULT = 0x00040000
+	OLE_S_STATIC                           HRESULT = 0x00040001
+	OLE_S_MAC_CLIPFORMAT                   HRESULT = 0x00040002
+	DRAGDROP_S_DROP                        HRESULT = 0x00040100
+	DRAGDROP_S_CANCEL                      HRESULT = 0x00040101
+	DRAGDROP_S_USEDEFAULTCURSORS           HRESULT = 0x00040102
+	DATA_S_SAMEFORMATETC                   HRESULT = 0x00040130
+	VIEW_S_ALREADY_FROZEN                  HRESULT = 0x00040140
+	CACHE_S_FORMATETC_NOTSUPPORTED         HRESULT = 0x00040170
+	CACHE_S_SAMECACHE                      HRESULT = 0x00040171
+	CACHE_S_SOMECACHES_NOTUPDATED          HRESULT = 0x00040172
+	OLEOBJ_S_INVALIDVERB                   HRESULT = 0x00040180
+	OLEOBJ_S_CANNOT_DOVERB_NOW             HRESULT = 0x00040181
+	OLEOBJ_S_INVALIDHWND                   HRESULT = 0x00040182
+	INPLACE_S_TRUNCATED                    HRESULT = 0x000401A0
+	CONVERT10_S_NO_PRESENTATION            HRESULT = 0x000401C0
+	MK_S_REDUCED_TO_SELF                   HRESULT = 0x000401E2
+	MK_S_ME                                HRESULT = 0x000401E4
+	MK_S_HIM                               HRESULT = 0x000401E5
+	MK_S_US                                HRESULT = 0x000401E6
+	MK_S_MONIKERALREADYREGISTERED          HRESULT = 0x000401E7
+	CO_E_CLASS_CREATE_FAILED               HRESULT = 0x80080001
+	CO_E_SCM_ERROR                         HRESULT = 0x80080002
+	CO_E_SCM_RPC_FAILURE                   HRESULT = 0x80080003
+	CO_E_BAD_PATH                          HRESULT = 0x80080004
+	CO_E_SERVER_EXEC_FAILURE               HRESULT = 0x80080005
+	CO_E_OBJSRV_RPC_FAILURE                HRESULT = 0x80080006
+	MK_E_NO_NORMALIZED                     HRESULT = 0x80080007
+	CO_E_SERVER_STOPPING                   HRESULT = 0x80080008
+	MEM_E_INVALID_ROOT                     HRESULT = 0x80080009
+	MEM_E_INVALID_LINK                     HRESULT = 0x80080010
+	MEM_E_INVALID_SIZE                     HRESULT = 0x80080011
+	CO_S_NOTALLINTERFACES                  HRESULT = 0x00080012
+	DISP_E_UNKNOWNINTERFACE                HRESULT = 0x80020001
+	DISP_E_MEMBERNOTFOUND                  HRESULT = 0x80020003
+	DISP_E_PARAMNOTFOUND                   HRESULT = 0x80020004
+	DISP_E_TYPEMISMATCH                    HRESULT = 0x80020005
+	DISP_E_UNKNOWNNAME                     HRESULT = 0x80020006
+	DISP_E_NONAMEDARGS                     HRESULT = 0x80020007
+	DISP_E_BADVARTYPE                      HRESULT = 0x80020008
+	DISP_E_EXCEPTION                       HRESULT = 0x80020009
+	DISP_E_OVERFLOW                        HRESULT = 0x8002000A
+	DISP_E_BADINDEX                        HRESULT = 0x8002000B
+	DISP_E_UNKNOWNLCID                     HRESULT = 0x8002000C
+	DISP_E_ARRAYISLOCKED                   HRESULT = 0x8002000D
+	DISP_E_BADPARAMCOUNT                   HRESULT = 0x8002000E
+	DISP_E_PARAMNOTOPTIONAL                HRESULT = 0x8002000F
+	DISP_E_BADCALLEE                       HRESULT = 0x80020010
+	DISP_E_NOTACOLLECTION                  HRESULT = 0x80020011
+	DISP_E_DIVBYZERO                       HRESULT = 0x80020012
+	TYPE_E_BUFFERTOOSMALL                  HRESULT = 0x80028016
+	TYPE_E_FIELDNOTFOUND                   HRESULT = 0x80028017
+	TYPE_E_INVDATAREAD                     HRESULT = 0x80028018
+	TYPE_E_UNSUPFORMAT                     HRESULT = 0x80028019
+	TYPE_E_REGISTRYACCESS                  HRESULT = 0x8002801C
+	TYPE_E_LIBNOTREGISTERED                HRESULT = 0x8002801D
+	TYPE_E_UNDEFINEDTYPE                   HRESULT = 0x80028027
+	TYPE_E_QUALIFIEDNAMEDISALLOWED         HRESULT = 0x80028028
+	TYPE_E_INVALIDSTATE                    HRESULT = 0x80028029
+	TYPE_E_WRONGTYPEKIND                   HRESULT = 0x8002802A
+	TYPE_E_ELEMENTNOTFOUND                 HRESULT = 0x8002802B
+	TYPE_E_AMBIGUOUSNAME                   HRESULT = 0x8002802C
+	TYPE_E_NAMECONFLICT                    HRESULT = 0x8002802D
+	TYPE_E_UNKNOWNLCID                     HRESULT = 0x8002802E
+	TYPE_E_DLLFUNCTIONNOTFOUND             HRESULT = 0x8002802F
+	TYPE_E_BADMODULEKIND                   HRESULT = 0x800288BD
+	TYPE_E_SIZETOOBIG                      HRESULT = 0x800288C5
+	TYPE_E_DUPLICATEID                     HRESULT = 0x800288C6
+	TYPE_E_INVALIDID                       HRESULT = 0x800288CF
+	TYPE_E_TYPEMISMATCH                    HRESULT = 0x80028CA0
+	TYPE_E_OUTOFBOUNDS                     HRESULT = 0x80028CA1
+	TYPE_E_IOERROR                         HRESULT = 0x80028CA2
+	TYPE_E_CANTCREATETMPFILE               HRESULT = 0x80028CA3
+	TYPE_E_CANTLOADLIBRARY                 HRESULT = 0x80029C4A
+	TYPE_E_INCONSISTENTPROPFUNCS           HRESULT = 0x80029C83
+	TYPE_E_CIRCULARTYPE                    HRESULT = 0x80029C84
+	STG_E_INVALIDFUNCTION                  HRESULT = 0x80030001
+	STG_E_FILENOTFOUND                     HRESULT = 0x80030002
+	STG_E_PATHNOTFOUND                     HRESULT = 0x80030003
+	STG_E_TOOMANYOPENFILES                 HRESULT = 0x80030004
+	STG_E_ACCESSDENIED                     HRESULT = 0x80030005
+	STG_E_INVALIDHANDLE                    HRESULT = 0x80030006
+	STG_E_INSUFFICIENTMEMORY               HRESULT = 0x80030008
+	STG_E_INVALIDPOINTER                   HRESULT = 0x80030009
+	STG_E_NOMOREFILES                      HRESULT = 0x80030012
+	STG_E_DISKISWRITEPROTECTED             HRESULT = 0x80030013
+	STG_E_SEEKERROR                        HRESULT = 0x80030019
+	STG_E_WRITEFAULT                       HRESULT = 0x8003001D
+	STG_E_READFAULT                        HRESULT = 0x8003001E
+	STG_E_SHAREVIOLATION                   HRESULT = 0x80030020
+	STG_E_LOCKVIOLATION                    HRESULT = 0x80030021
+	STG_E_FILEALREADYEXISTS                HRESULT = 0x80030050
+	STG_E_INVALIDPARAMETER                 HRESULT = 0x80030057
+	STG_E_MEDIUMFULL                       HRESULT = 0x80030070
+	STG_E_PROPSETMISMATCHED                HRESULT = 0x800300F0
+	STG_E_ABNORMALAPIEXIT                  HRESULT = 0x800300FA
+	STG_E_INVALIDHEADER                    HRESULT = 0x800300FB
+	STG_E_INVALIDNAME                      HRESULT = 0x800300FC
+	STG_E_UNKNOWN                          HRESULT = 0x800300FD
+	STG_E_UNIMPLEMENTEDFUNCTION            HRESULT = 0x800300FE
+	STG_E_INVALIDFLAG                      HRESULT = 0x800300FF
+	STG_E_INUSE                            HRESULT = 0x80030100
+	STG_E_NOTCURRENT                       HRESULT = 0x80030101
+	STG_E_REVERTED                         HRESULT = 0x80030102
+	STG_E_CANTSAVE                         HRESULT = 0x80030103
+	STG_E_OLDFORMAT                        HRESULT = 0x80030104
+	STG_E_OLDDLL                           HRESULT = 0x80030105
+	STG_E_SHAREREQUIRED                    HRESULT = 0x80030106
+	STG_E_NOTFILEBASEDSTORAGE              HRESULT = 0x80030107
+	STG_E_EXTANTMARSHALLINGS               HRESULT = 0x80030108
+	STG_E_DOCFILECORRUPT                   HRESULT = 0x80030109
+	STG_E_BADBASEADDRESS                   HRESULT = 0x80030110
+	STG_E_INCOMPLETE                       HRESULT = 0x80030201
+	STG_E_TERMINATED                       HRESULT = 0x80030202
+	STG_S_CONVERTED                        HRESULT = 0x00030200
+	STG_S_BLOCK                            HRESULT = 0x00030201
+	STG_S_RETRYNOW                         HRESULT = 0x00030202
+	STG_S_MONITORING                       HRESULT = 0x00030203
+	STG_S_MULTIPLEOPENS                    HRESULT = 0x00030204
+	STG_S_CONSOLIDATIONFAILED              HRESULT = 0x00030205
+	STG_S_CANNOTCONSOLIDATE                HRESULT = 0x00030206
+	RPC_E_CALL_REJECTED                    HRESULT = 0x80010001 // Call was rejected by callee.
+	RPC_E_CALL_CANCELED                    HRESULT = 0x80010002
+	RPC_E_CANTPOST_INSENDCALL              HRESULT = 0x80010003
+	RPC_E_CANTCALLOUT_INASYNCCALL          HRESULT = 0x80010004
+	RPC_E_CANTCALLOUT_INEXTERNALCALL       HRESULT = 0x80010005
+	RPC_E_CONNECTION_TERMINATED            HRESULT = 0x80010006
+	RPC_E_SERVER_DIED                      HRESULT = 0x80010007 // The remote procedure call failed and did not execute.
+	RPC_E_CLIENT_DIED                      HRESULT = 0x80010008
+	RPC_E_INVALID_DATAPACKET               HRESULT = 0x80010009
+	RPC_E_CANTTRANSMIT_CALL                HRESULT = 0x8001000A
+	RPC_E_CLIENT_CANTMARSHAL_DATA          HRESULT = 0x8001000B
+	RPC_E_CLIENT_CANTUNMARSHAL_DATA        HRESULT = 0x8001000C
+	RPC_E_SERVER_CANTMARSHAL_DATA          HRESULT = 0x8001000D
+	RPC_E_SERVER_CANTUNMARSHAL_DATA        HRESULT = 0x8001000E
+	RPC_E_INVALID_DATA                     HRESULT = 0x8001000F
+	RPC_E_INVALID_PARAMETER                HRESULT = 0x80010010
+	RPC_E_CANTCALLOUT_AGAIN                HRESULT = 0x80010011
+	RPC_E_SERVER_DIED_DNE                  HRESULT = 0x80010012
+	RPC_E_SYS_CALL_FAILED                  HRESULT = 0x80010100
+	RPC_E_OUT_OF_RESOURCES                 HRESULT = 0x80010101
+	RPC_E_ATTEMPTED_MULTITHREAD            HRESULT = 0x80010102
+	RPC_E_NOT_REGISTERED                   HRESULT = 0x80010103
+	RPC_E_FAULT                            HRESULT = 0x80010104
+	RPC_E_SERVERFAULT                      HRESULT = 0x80010105 // The server threw an exception.
+	RPC_E_CHANGED_MODE                     HRESULT = 0x80010106 // Cannot change thread mode after it is set.
+	RPC_E_INVALIDMETHOD                    HRESULT = 0x80010107
+	RPC_E_DISCONNECTED                     HRESULT = 0x80010108 // The object invoked has disconnected from its clients.
+	RPC_E_RETRY                            HRESULT = 0x80010109
+	RPC_E_SERVERCALL_RETRYLATER            HRESULT = 0x8001010A
+	RPC_E_SERVERCALL_REJECTED              HRESULT = 0x8001010B
+	RPC_E_INVALID_CALLDATA                 HRESULT = 0x8001010C
+	RPC_E_CANTCALLOUT_ININPUTSYNCCALL      HRESULT = 0x8001010D
+	RPC_E_WRONG_THREAD                     HRESULT = 0x8001010E // The application called an interface that was marshalled for a different thread.
+	RPC_E_THREAD_NOT_INIT                  HRESULT = 0x8001010F
+	RPC_E_VERSION_MISMATCH                 HRESULT = 0x80010110
+	RPC_E_INVALID_HEADER                   HRESULT = 0x80010111
+	RPC_E_INVALID_EXTENSION                HRESULT = 0x80010112
+	RPC_E_INVALID_IPID                     HRESULT = 0x80010113
+	RPC_E_INVALID_OBJECT                   HRESULT = 0x80010114
+	RPC_S_CALLPENDING                      HRESULT = 0x80010115
+	RPC_S_WAITONTIMER                      HRESULT = 0x80010116
+	RPC_E_CALL_COMPLETE                    HRESULT = 0x80010117
+	RPC_E_UNSECURE_CALL                    HRESULT = 0x80010118
+	RPC_E_TOO_LATE                         HRESULT = 0x80010119
+	RPC_E_NO_GOOD_SECURITY_PACKAGES        HRESULT = 0x8001011A
+	RPC_E_ACCESS_DENIED                    HRESULT = 0x8001011B
+	RPC_E_REMOTE_DISABLED                  HRESULT = 0x8001011C
+	RPC_E_INVALID_OBJREF                   HRESULT = 0x8001011D
+	RPC_E_NO_CONTEXT                       HRESULT = 0x8001011E
+	RPC_E_TIMEOUT                          HRESULT = 0x8001011F
+	RPC_E_NO_SYNC                          HRESULT = 0x80010120
+	RPC_E_UNEXPECTED                       HRESULT = 0x8001FFFF
+	NTE_BAD_UID                            HRESULT = 0x80090001
+	NTE_BAD_HASH                           HRESULT = 0x80090002
+	NTE_BAD_KEY                            HRESULT = 0x80090003
+	NTE_BAD_LEN                            HRESULT = 0x80090004
+	NTE_BAD_DATA                           HRESULT = 0x80090005
+	NTE_BAD_SIGNATURE                      HRESULT = 0x80090006
+	NTE_BAD_VER                            HRESULT = 0x80090007
+	NTE_BAD_ALGID                          HRESULT = 0x80090008
+	NTE_BAD_FLAGS                          HRESULT = 0x80090009
+	NTE_BAD_TYPE                           HRESULT = 0x8009000A
+	NTE_BAD_KEY_STATE                      HRESULT = 0x8009000B
+	NTE_BAD_HASH_STATE                     HRESULT = 0x8009000C
+	NTE_NO_KEY                             HRESULT = 0x8009000D
+	NTE_NO_MEMORY                          HRESULT = 0x8009000E
+	NTE_EXISTS                             HRESULT = 0x8009000F
+	NTE_PERM                               HRESULT = 0x80090010
+	NTE_NOT_FOUND                          HRESULT = 0x80090011
+	NTE_DOUBLE_ENCRYPT                     HRESULT = 0x80090012
+	NTE_BAD_PROVIDER                       HRESULT = 0x80090013
+	NTE_BAD_PROV_TYPE                      HRESULT = 0x80090014
+	NTE_BAD_PUBLIC_KEY                     HRESULT = 0x80090015
+	NTE_BAD_KEYSET                         HRESULT = 0x80090016
+	NTE_PROV_TYPE_NOT_DEF                  HRESULT = 0x80090017
+	NTE_PROV_TYPE_ENTRY_BAD                HRESULT = 0x80090018
+	NTE_KEYSET_NOT_DEF                     HRESULT = 0x80090019
+	NTE_KEYSET_ENTRY_BAD                   HRESULT = 0x8009001A
+	NTE_PROV_TYPE_NO_MATCH                 HRESULT = 0x8009001B
+	NTE_SIGNATURE_FILE_BAD                 HRESULT = 0x8009001C
+	NTE_PROVIDER_DLL_FAIL                  HRESULT = 0x8009001D
+	NTE_PROV_DLL_NOT_FOUND                 HRESULT = 0x8009001E
+	NTE_BAD_KEYSET_PARAM                   HRESULT = 0x8009001F
+	NTE_FAIL                               HRESULT = 0x80090020
+	NTE_SYS_ERR                            HRESULT = 0x80090021
+	CRYPT_E_MSG_ERROR                      HRESULT = 0x80091001
+	CRYPT_E_UNKNOWN_ALGO                   HRESULT = 0x80091002
+	CRYPT_E_OID_FORMAT                     HRESULT = 0x80091003
+	CRYPT_E_INVALID_MSG_TYPE               HRESULT = 0x80091004
+	CRYPT_E_UNEXPECTED_ENCODING            HRESULT = 0x80091005
+	CRYPT_E_AUTH_ATTR_MISSING              HRESULT = 0x80091006
+	CRYPT_E_HASH_VALUE                     HRESULT = 0x80091007
+	CRYPT_E_INVALID_INDEX                  HRESULT = 0x80091008
+	CRYPT_E_ALREADY_DECRYPTED              HRESULT = 0x80091009
+	CRYPT_E_NOT_DECRYPTED                  HRESULT = 0x8009100A
+	CRYPT_E_RECIPIENT_NOT_FOUND            HRESULT = 0x8009100B
+	CRYPT_E_CONTROL_TYPE                   HRESULT = 0x8009100C
+	CRYPT_E_ISSUER_SERIALNUMBER            HRESULT = 0x8009100D
+	CRYPT_E_SIGNER_NOT_FOUND               HRESULT = 0x8009100E
+	CRYPT_E_ATTRIBUTES_MISSING             HRESULT = 0x8009100F
+	CRYPT_E_STREAM_MSG_NOT_READY           HRESULT = 0x80091010
+	CRYPT_E_STREAM_INSUFFICIENT_DATA       HRESULT = 0x80091011
+	CRYPT_E_BAD_LEN                        HRESULT = 0x80092001
+	CRYPT_E_BAD_ENCODE                     HRESULT = 0x80092002
+	CRYPT_E_FILE_ERROR                     HRESULT = 0x80092003
+	CRYPT_E_NOT_FOUND                      HRESULT = 0x80092004
+	CRYPT_E_EXISTS                         HRESULT = 0x80092005
+	CRYPT_E_NO_PROVIDER                    HRESULT = 0x80092006
+	CRYPT_E_SELF_SIGNED                    HRESULT = 0x80092007
+	CRYPT_E_DELETED_PREV                   HRESULT = 0x80092008
+	CRYPT_E_NO_MATCH                       HRESULT = 0x80092009
+	CRYPT_E_UNEXPECTED_MSG_TYPE            HRESULT = 0x8009200A
+	CRYPT_E_NO_KEY_PROPERTY                HRESULT = 0x8009200B
+	CRYPT_E_NO_DECRYPT_CERT                HRESULT = 0x8009200C
+	CRYPT_E_BAD_MSG                        HRESULT = 0x8009200D
+	CRYPT_E_NO_SIGNER                      HRESULT = 0x8009200E
+	CRYPT_E_PENDING_CLOSE                  HRESULT = 0x8009200F
+	CRYPT_E_REVOKED                        HRESULT = 0x80092010
+	CRYPT_E_NO_REVOCATION_DLL              HRESULT = 0x80092011
+	CRYPT_E_NO_REVOCATION_CHECK            HRESULT = 0x80092012
+	CRYPT_E_REVOCATION_OFFLINE             HRESULT = 0x80092013
+	CRYPT_E_NOT_IN_REVOCATION_DATABASE     HRESULT = 0x80092014
+	CRYPT_E_INVALID_NUMERIC_STRING         HRESULT = 0x80092020
+	CRYPT_E_INVALID_PRINTABLE_STRING       HRESULT = 0x80092021
+	CRYPT_E_INVALID_IA5_STRING             HRESULT = 0x80092022
+	CRYPT_E_INVALID_X500_STRING            HRESULT = 0x80092023
+	CRYPT_E_NOT_CHAR_STRING                HRESULT = 0x80092024
+	CRYPT_E_FILERESIZED                    HRESULT = 0x80092025
+	CRYPT_E_SECURITY_SETTINGS              HRESULT = 0x80092026
+	CRYPT_E_NO_VERIFY_USAGE_DLL            HRESULT = 0x80092027
+	CRYPT_E_NO_VERIFY_USAGE_CHECK          HRESULT = 0x80092028
+	CRYPT_E_VERIFY_USAGE_OFFLINE           HRESULT = 0x80092029
+	CRYPT_E_NOT_IN_CTL                     HRESULT = 0x8009202A
+	CRYPT_E_NO_TRUSTED_SIGNER              HRESULT = 0x8009202B
+	CRYPT_E_OSS_ERROR                      HRESULT = 0x80093000
+	CERTSRV_E_BAD_REQUESTSUBJECT           HRESULT = 0x80094001
+	CERTSRV_E_NO_REQUEST                   HRESULT = 0x80094002
+	CERTSRV_E_BAD_REQUESTSTATUS            HRESULT = 0x80094003
+	CERTSRV_E_PROPERTY_EMPTY               HRESULT = 0x80094004
+	CERTDB_E_JET_ERROR                     HRESULT = 0x80095000
+	TRUST_E_SYSTEM_ERROR                   HRESULT = 0x80096001
+	TRUST_E_NO_SIGNER_CERT                 HRESULT = 0x80096002
+	TRUST_E_COUNTER_SIGNER                 HRESULT = 0x80096003
+	TRUST_E_CERT_SIGNATURE                 HRESULT = 0x80096004
+	TRUST_E_TIME_STAMP                     HRESULT = 0x80096005
+	TRUST_E_BAD_DIGEST                     HRESULT = 0x80096010
+	TRUST_E_BASIC_CONSTRAINTS              HRESULT = 0x80096019
+	TRUST_E_FINANCIAL_CRITERIA             HRESULT = 0x8009601E
+	TRUST_E_PROVIDER_UNKNOWN               HRESULT = 0x800B0001
+	TRUST_E_ACTION_UNKNOWN                 HRESULT = 0x800B0002
+	TRUST_E_SUBJECT_FORM_UNKNOWN           HRESULT = 0x800B0003
+	TRUST_E_SUBJECT_NOT_TRUSTED            HRESULT = 0x800B0004
+	DIGSIG_E_ENCODE                        HRESULT = 0x800B0005
+	DIGSIG_E_DECODE                        HRESULT = 0x800B0006
+	DIGSIG_E_EXTENSIBILITY                 HRESULT = 0x800B0007
+	DIGSIG_E_CRYPTO                        HRESULT = 0x800B0008
+	PERSIST_E_SIZEDEFINITE                 HRESULT = 0x800B0009
+	PERSIST_E_SIZEINDEFINITE               HRESULT = 0x800B000A
+	PERSIST_E_NOTSELFSIZING                HRESULT = 0x800B000B
+	TRUST_E_NOSIGNATURE                    HRESULT = 0x800B0100
+	CERT_E_EXPIRED                         HRESULT = 0x800B0101
+	CERT_E_VALIDITYPERIODNESTING           HRESULT = 0x800B0102
+	CERT_E_ROLE                            HRESULT = 0x800B0103
+	CERT_E_PATHLENCONST                    HRESULT = 0x800B0104
+	CERT_E_CRITICAL                        HRESULT = 0x800B0105
+	CERT_E_PURPOSE                         HRESULT = 0x800B0106
+	CERT_E_ISSUERCHAINING                  HRESULT = 0x800B0107
+	CERT_E_MALFORMED                       HRESULT = 0x800B0108
+	CERT_E_UNTRUSTEDROOT                   HRESULT = 0x800B0109
+	CERT_E_CHAINING                        HRESULT = 0x800B010A
+	TRUST_E_FAIL                           HRESULT = 0x800B010B
+	CERT_E_REVOKED                         HRESULT = 0x800B010C
+	CERT_E_UNTRUSTEDTESTROOT               HRESULT = 0x800B010D
+	CERT_E_REVOCATION_FAILURE              HRESULT = 0x800B010E
+	CERT_E_CN_NO_MATCH                     HRESULT = 0x800B010F
+	CERT_E_WRONG_USAGE                     HRESULT = 0x800B0110
+	SPAPI_E_EXPECTED_SECTION_NAME          HRESULT = 0x800F0000
+	SPAPI_E_BAD_SECTION_NAME_LINE          HRESULT = 0x800F0001
+	SPAPI_E_SECTION_NAME_TOO_LONG          HRESULT = 0x800F0002
+	SPAPI_E_GENERAL_SYNTAX                 HRESULT = 0x800F0003
+	SPAPI_E_WRONG_INF_STYLE                HRESULT = 0x800F0100
+	SPAPI_E_SECTION_NOT_FOUND              HRESULT = 0x800F0101
+	SPAPI_E_LINE_NOT_FOUND                 HRESULT = 0x800F0102
+	SPAPI_E_NO_ASSOCIATED_CLASS            HRESULT = 0x800F0200
+	SPAPI_E_CLASS_MISMATCH                 HRESULT = 0x800F0201
+	SPAPI_E_DUPLICATE_FOUND                HRESULT = 0x800F0202
+	SPAPI_E_NO_DRIVER_SELECTED             HRESULT = 0x800F0203
+	SPAPI_E_KEY_DOES_NOT_EXIST             HRESULT = 0x800F0204
+	SPAPI_E_INVALID_DEVINST_NAME           HRESULT = 0x800F0205
+	SPAPI_E_INVALID_CLASS                  HRESULT = 0x800F0206
+	SPAPI_E_DEVINST_ALREADY_EXISTS         HRESULT = 0x800F0207
+	SPAPI_E_DEVINFO_NOT_REGISTERED         HRESULT = 0x800F0208
+	SPAPI_E_INVALID_REG_PROPERTY           HRESULT = 0x800F0209
+	SPAPI_E_NO_INF                         HRESULT = 0x800F020A
+	SPAPI_E_NO_SUCH_DEVINST                HRESULT = 0x800F020B
+	SPAPI_E_CANT_LOAD_CLASS_ICON           HRESULT = 0x800F020C
+	SPAPI_E_INVALID_CLASS_INSTALLER        HRESULT = 0x800F020D
+	SPAPI_E_DI_DO_DEFAULT                  HRESULT = 0x800F020E
+	SPAPI_E_DI_NOFILECOPY                  HRESULT = 0x800F020F
+	SPAPI_E_INVALID_HWPROFILE              HRESULT = 0x800F0210
+	SPAPI_E_NO_DEVICE_SELECTED             HRESULT = 0x800F0211
+	SPAPI_E_DEVINFO_LIST_LOCKED            HRESULT = 0x800F0212
+	SPAPI_E_DEVINFO_DATA_LOCKED            HRESULT = 0x800F0213
+	SPAPI_E_DI_BAD_PATH                    HRESULT = 0x800F0214
+	SPAPI_E_NO_CLASSINSTALL_PARAMS         HRESULT = 0x800F0215
+	SPAPI_E_FILEQUEUE_LOCKED               HRESULT = 0x800F0216
+	SPAPI_E_BAD_SERVICE_INSTALLSECT        HRESULT = 0x800F0217
+	SPAPI_E_NO_CLASS_DRIVER_LIST           HRESULT = 0x800F0218
+	SPAPI_E_NO_ASSOCIATED_SERVICE          HRESULT = 0x800F0219
+	SPAPI_E_NO_DEFAULT_DEVICE_INTERFACE    HRESULT = 0x800F021A
+	SPAPI_E_DEVICE_INTERFACE_ACTIVE        HRESULT = 0x800F021B
+	SPAPI_E_DEVICE_INTERFACE_REMOVED       HRESULT = 0x800F021C
+	SPAPI_E_BAD_INTERFACE_INSTALLSECT      HRESULT = 0x800F021D
+	SPAPI_E_NO_SUCH_INTERFACE_CLASS        HRESULT = 0x800F021E
+	SPAPI_E_INVALID_REFERENCE_STRING       HRESULT = 0x800F021F
+	SPAPI_E_INVALID_MACHINENAME            HRESULT = 0x800F0220
+	SPAPI_E_REMOTE_COMM_FAILURE            HRESULT = 0x800F0221
+	SPAPI_E_MACHINE_UNAVAILABLE            HRESULT = 0x800F0222
+	SPAPI_E_NO_CONFIGMGR_SERVICES          HRESULT = 0x800F0223
+	SPAPI_E_INVALID_PROPPAGE_PROVIDER      HRESULT = 0x800F0224
+	SPAPI_E_NO_SUCH_DEVICE_INTERFACE       HRESULT = 0x800F0225
+	SPAPI_E_DI_POSTPROCESSING_REQUIRED     HRESULT = 0x800F0226
+	SPAPI_E_INVALID_COINSTALLER            HRESULT = 0x800F0227
+	SPAPI_E_NO_COMPAT_DRIVERS              HRESULT = 0x800F0228
+	SPAPI_E_NO_DEVICE_ICON                 HRESULT = 0x800F0229
+	SPAPI_E_INVALID_INF_LOGCONFIG          HRESULT = 0x800F022A
+	SPAPI_E_DI_DONT_INSTALL                HRESULT = 0x800F022B
+	SPAPI_E_INVALID_FILTER_DRIVER          HRESULT = 0x800F022C
+	SPAPI_E_ERROR_NOT_INSTALLED            HRESULT = 0x800F1000
 )
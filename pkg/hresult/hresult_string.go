@@ -1,2841 +0,0 @@
-// Code generated by "stringer -type=HRESULT"; DO NOT EDIT.
-
-package hresult
-
-import "strconv"
-
-func _() {
-	// An "invalid array index" compiler error signifies that the constant values have changed.
-	// Re-run the stringer command to generate them again.
-	var x [1]struct{}
-	_ = x[ERROR_SUCCESS-0]
-	_ = x[ERROR_INVALID_FUNCTION-1]
-	_ = x[ERROR_FILE_NOT_FOUND-2]
-	_ = x[ERROR_PATH_NOT_FOUND-3]
-	_ = x[ERROR_TOO_MANY_OPEN_FILES-4]
-	_ = x[ERROR_ACCESS_DENIED-5]
-	_ = x[ERROR_INVALID_HANDLE-6]
-	_ = x[ERROR_ARENA_TRASHED-7]
-	_ = x[ERROR_NOT_ENOUGH_MEMORY-8]
-	_ = x[ERROR_INVALID_BLOCK-9]
-	_ = x[ERROR_BAD_ENVIRONMENT-10]
-	_ = x[ERROR_BAD_FORMAT-11]
-	_ = x[ERROR_INVALID_ACCESS-12]
-	_ = x[ERROR_INVALID_DATA-13]
-	_ = x[ERROR_OUTOFMEMORY-14]
-	_ = x[ERROR_INVALID_DRIVE-15]
-	_ = x[ERROR_CURRENT_DIRECTORY-16]
-	_ = x[ERROR_NOT_SAME_DEVICE-17]
-	_ = x[ERROR_NO_MORE_FILES-18]
-	_ = x[ERROR_WRITE_PROTECT-19]
-	_ = x[ERROR_BAD_UNIT-20]
-	_ = x[ERROR_NOT_READY-21]
-	_ = x[ERROR_BAD_COMMAND-22]
-	_ = x[ERROR_CRC-23]
-	_ = x[ERROR_BAD_LENGTH-24]
-	_ = x[ERROR_SEEK-25]
-	_ = x[ERROR_NOT_DOS_DISK-26]
-	_ = x[ERROR_SECTOR_NOT_FOUND-27]
-	_ = x[ERROR_OUT_OF_PAPER-28]
-	_ = x[ERROR_WRITE_FAULT-29]
-	_ = x[ERROR_READ_FAULT-30]
-	_ = x[ERROR_GEN_FAILURE-31]
-	_ = x[ERROR_SHARING_VIOLATION-32]
-	_ = x[ERROR_LOCK_VIOLATION-33]
-	_ = x[ERROR_WRONG_DISK-34]
-	_ = x[ERROR_SHARING_BUFFER_EXCEEDED-36]
-	_ = x[ERROR_HANDLE_EOF-38]
-	_ = x[ERROR_HANDLE_DISK_FULL-39]
-	_ = x[ERROR_NOT_SUPPORTED-50]
-	_ = x[ERROR_REM_NOT_LIST-51]
-	_ = x[ERROR_DUP_NAME-52]
-	_ = x[ERROR_BAD_NETPATH-53]
-	_ = x[ERROR_NETWORK_BUSY-54]
-	_ = x[ERROR_DEV_NOT_EXIST-55]
-	_ = x[ERROR_TOO_MANY_CMDS-56]
-	_ = x[ERROR_ADAP_HDW_ERR-57]
-	_ = x[ERROR_BAD_NET_RESP-58]
-	_ = x[ERROR_UNEXP_NET_ERR-59]
-	_ = x[ERROR_BAD_REM_ADAP-60]
-	_ = x[ERROR_PRINTQ_FULL-61]
-	_ = x[ERROR_NO_SPOOL_SPACE-62]
-	_ = x[ERROR_PRINT_CANCELLED-63]
-	_ = x[ERROR_NETNAME_DELETED-64]
-	_ = x[ERROR_NETWORK_ACCESS_DENIED-65]
-	_ = x[ERROR_BAD_DEV_TYPE-66]
-	_ = x[ERROR_BAD_NET_NAME-67]
-	_ = x[ERROR_TOO_MANY_NAMES-68]
-	_ = x[ERROR_TOO_MANY_SESS-69]
-	_ = x[ERROR_SHARING_PAUSED-70]
-	_ = x[ERROR_REQ_NOT_ACCEP-71]
-	_ = x[ERROR_REDIR_PAUSED-72]
-	_ = x[ERROR_FILE_EXISTS-80]
-	_ = x[ERROR_CANNOT_MAKE-82]
-	_ = x[ERROR_FAIL_I24-83]
-	_ = x[ERROR_OUT_OF_STRUCTURES-84]
-	_ = x[ERROR_ALREADY_ASSIGNED-85]
-	_ = x[ERROR_INVALID_PASSWORD-86]
-	_ = x[ERROR_INVALID_PARAMETER-87]
-	_ = x[ERROR_NET_WRITE_FAULT-88]
-	_ = x[ERROR_NO_PROC_SLOTS-89]
-	_ = x[ERROR_TOO_MANY_SEMAPHORES-100]
-	_ = x[ERROR_EXCL_SEM_ALREADY_OWNED-101]
-	_ = x[ERROR_SEM_IS_SET-102]
-	_ = x[ERROR_TOO_MANY_SEM_REQUESTS-103]
-	_ = x[ERROR_INVALID_AT_INTERRUPT_TIME-104]
-	_ = x[ERROR_SEM_OWNER_DIED-105]
-	_ = x[ERROR_SEM_USER_LIMIT-106]
-	_ = x[ERROR_DISK_CHANGE-107]
-	_ = x[ERROR_DRIVE_LOCKED-108]
-	_ = x[ERROR_BROKEN_PIPE-109]
-	_ = x[ERROR_OPEN_FAILED-110]
-	_ = x[ERROR_BUFFER_OVERFLOW-111]
-	_ = x[ERROR_DISK_FULL-112]
-	_ = x[ERROR_NO_MORE_SEARCH_HANDLES-113]
-	_ = x[ERROR_INVALID_TARGET_HANDLE-114]
-	_ = x[ERROR_INVALID_CATEGORY-117]
-	_ = x[ERROR_INVALID_VERIFY_SWITCH-118]
-	_ = x[ERROR_BAD_DRIVER_LEVEL-119]
-	_ = x[ERROR_CALL_NOT_IMPLEMENTED-120]
-	_ = x[ERROR_SEM_TIMEOUT-121]
-	_ = x[ERROR_INSUFFICIENT_BUFFER-122]
-	_ = x[ERROR_INVALID_NAME-123]
-	_ = x[ERROR_INVALID_LEVEL-124]
-	_ = x[ERROR_NO_VOLUME_LABEL-125]
-	_ = x[ERROR_MOD_NOT_FOUND-126]
-	_ = x[ERROR_PROC_NOT_FOUND-127]
-	_ = x[ERROR_WAIT_NO_CHILDREN-128]
-	_ = x[ERROR_CHILD_NOT_COMPLETE-129]
-	_ = x[ERROR_DIRECT_ACCESS_HANDLE-130]
-	_ = x[ERROR_NEGATIVE_SEEK-131]
-	_ = x[ERROR_SEEK_ON_DEVICE-132]
-	_ = x[ERROR_IS_JOIN_TARGET-133]
-	_ = x[ERROR_IS_JOINED-134]
-	_ = x[ERROR_IS_SUBSTED-135]
-	_ = x[ERROR_NOT_JOINED-136]
-	_ = x[ERROR_NOT_SUBSTED-137]
-	_ = x[ERROR_JOIN_TO_JOIN-138]
-	_ = x[ERROR_SUBST_TO_SUBST-139]
-	_ = x[ERROR_JOIN_TO_SUBST-140]
-	_ = x[ERROR_SUBST_TO_JOIN-141]
-	_ = x[ERROR_BUSY_DRIVE-142]
-	_ = x[ERROR_SAME_DRIVE-143]
-	_ = x[ERROR_DIR_NOT_ROOT-144]
-	_ = x[ERROR_DIR_NOT_EMPTY-145]
-	_ = x[ERROR_IS_SUBST_PATH-146]
-	_ = x[ERROR_IS_JOIN_PATH-147]
-	_ = x[ERROR_PATH_BUSY-148]
-	_ = x[ERROR_IS_SUBST_TARGET-149]
-	_ = x[ERROR_SYSTEM_TRACE-150]
-	_ = x[ERROR_INVALID_EVENT_COUNT-151]
-	_ = x[ERROR_TOO_MANY_MUXWAITERS-152]
-	_ = x[ERROR_INVALID_LIST_FORMAT-153]
-	_ = x[ERROR_LABEL_TOO_LONG-154]
-	_ = x[ERROR_TOO_MANY_TCBS-155]
-	_ = x[ERROR_SIGNAL_REFUSED-156]
-	_ = x[ERROR_DISCARDED-157]
-	_ = x[ERROR_NOT_LOCKED-158]
-	_ = x[ERROR_BAD_THREADID_ADDR-159]
-	_ = x[ERROR_BAD_ARGUMENTS-160]
-	_ = x[ERROR_BAD_PATHNAME-161]
-	_ = x[ERROR_SIGNAL_PENDING-162]
-	_ = x[ERROR_MAX_THRDS_REACHED-164]
-	_ = x[ERROR_LOCK_FAILED-167]
-	_ = x[ERROR_BUSY-170]
-	_ = x[ERROR_CANCEL_VIOLATION-173]
-	_ = x[ERROR_ATOMIC_LOCKS_NOT_SUPPORTED-174]
-	_ = x[ERROR_INVALID_SEGMENT_NUMBER-180]
-	_ = x[ERROR_INVALID_ORDINAL-182]
-	_ = x[ERROR_ALREADY_EXISTS-183]
-	_ = x[ERROR_INVALID_FLAG_NUMBER-186]
-	_ = x[ERROR_SEM_NOT_FOUND-187]
-	_ = x[ERROR_INVALID_STARTING_CODESEG-188]
-	_ = x[ERROR_INVALID_STACKSEG-189]
-	_ = x[ERROR_INVALID_MODULETYPE-190]
-	_ = x[ERROR_INVALID_EXE_SIGNATURE-191]
-	_ = x[ERROR_EXE_MARKED_INVALID-192]
-	_ = x[ERROR_BAD_EXE_FORMAT-193]
-	_ = x[ERROR_ITERATED_DATA_EXCEEDS_64k-194]
-	_ = x[ERROR_INVALID_MINALLOCSIZE-195]
-	_ = x[ERROR_DYNLINK_FROM_INVALID_RING-196]
-	_ = x[ERROR_IOPL_NOT_ENABLED-197]
-	_ = x[ERROR_INVALID_SEGDPL-198]
-	_ = x[ERROR_AUTODATASEG_EXCEEDS_64k-199]
-	_ = x[ERROR_RING2SEG_MUST_BE_MOVABLE-200]
-	_ = x[ERROR_RELOC_CHAIN_XEEDS_SEGLIM-201]
-	_ = x[ERROR_INFLOOP_IN_RELOC_CHAIN-202]
-	_ = x[ERROR_ENVVAR_NOT_FOUND-203]
-	_ = x[ERROR_NO_SIGNAL_SENT-205]
-	_ = x[ERROR_FILENAME_EXCED_RANGE-206]
-	_ = x[ERROR_RING2_STACK_IN_USE-207]
-	_ = x[ERROR_META_EXPANSION_TOO_LONG-208]
-	_ = x[ERROR_INVALID_SIGNAL_NUMBER-209]
-	_ = x[ERROR_THREAD_1_INACTIVE-210]
-	_ = x[ERROR_LOCKED-212]
-	_ = x[ERROR_TOO_MANY_MODULES-214]
-	_ = x[ERROR_NESTING_NOT_ALLOWED-215]
-	_ = x[ERROR_EXE_MACHINE_TYPE_MISMATCH-216]
-	_ = x[ERROR_BAD_PIPE-230]
-	_ = x[ERROR_PIPE_BUSY-231]
-	_ = x[ERROR_NO_DATA-232]
-	_ = x[ERROR_PIPE_NOT_CONNECTED-233]
-	_ = x[ERROR_MORE_DATA-234]
-	_ = x[ERROR_VC_DISCONNECTED-240]
-	_ = x[ERROR_INVALID_EA_NAME-254]
-	_ = x[ERROR_EA_LIST_INCONSISTENT-255]
-	_ = x[ERROR_NO_MORE_ITEMS-259]
-	_ = x[ERROR_CANNOT_COPY-266]
-	_ = x[ERROR_DIRECTORY-267]
-	_ = x[ERROR_EAS_DIDNT_FIT-275]
-	_ = x[ERROR_EA_FILE_CORRUPT-276]
-	_ = x[ERROR_EA_TABLE_FULL-277]
-	_ = x[ERROR_INVALID_EA_HANDLE-278]
-	_ = x[ERROR_EAS_NOT_SUPPORTED-282]
-	_ = x[ERROR_NOT_OWNER-288]
-	_ = x[ERROR_TOO_MANY_POSTS-298]
-	_ = x[ERROR_PARTIAL_COPY-299]
-	_ = x[ERROR_OPLOCK_NOT_GRANTED-300]
-	_ = x[ERROR_INVALID_OPLOCK_PROTOCOL-301]
-	_ = x[ERROR_MR_MID_NOT_FOUND-317]
-	_ = x[ERROR_INVALID_ADDRESS-487]
-	_ = x[ERROR_ARITHMETIC_OVERFLOW-534]
-	_ = x[ERROR_PIPE_CONNECTED-535]
-	_ = x[ERROR_PIPE_LISTENING-536]
-	_ = x[ERROR_EA_ACCESS_DENIED-994]
-	_ = x[ERROR_OPERATION_ABORTED-995]
-	_ = x[ERROR_IO_INCOMPLETE-996]
-	_ = x[ERROR_IO_PENDING-997]
-	_ = x[ERROR_NOACCESS-998]
-	_ = x[ERROR_SWAPERROR-999]
-	_ = x[ERROR_STACK_OVERFLOW-1001]
-	_ = x[ERROR_INVALID_MESSAGE-1002]
-	_ = x[ERROR_CAN_NOT_COMPLETE-1003]
-	_ = x[ERROR_INVALID_FLAGS-1004]
-	_ = x[ERROR_UNRECOGNIZED_VOLUME-1005]
-	_ = x[ERROR_FILE_INVALID-1006]
-	_ = x[ERROR_FULLSCREEN_MODE-1007]
-	_ = x[ERROR_NO_TOKEN-1008]
-	_ = x[ERROR_BADDB-1009]
-	_ = x[ERROR_BADKEY-1010]
-	_ = x[ERROR_CANTOPEN-1011]
-	_ = x[ERROR_CANTREAD-1012]
-	_ = x[ERROR_CANTWRITE-1013]
-	_ = x[ERROR_REGISTRY_RECOVERED-1014]
-	_ = x[ERROR_REGISTRY_CORRUPT-1015]
-	_ = x[ERROR_REGISTRY_IO_FAILED-1016]
-	_ = x[ERROR_NOT_REGISTRY_FILE-1017]
-	_ = x[ERROR_KEY_DELETED-1018]
-	_ = x[ERROR_NO_LOG_SPACE-1019]
-	_ = x[ERROR_KEY_HAS_CHILDREN-1020]
-	_ = x[ERROR_CHILD_MUST_BE_VOLATILE-1021]
-	_ = x[ERROR_NOTIFY_ENUM_DIR-1022]
-	_ = x[ERROR_DEPENDENT_SERVICES_RUNNING-1051]
-	_ = x[ERROR_INVALID_SERVICE_CONTROL-1052]
-	_ = x[ERROR_SERVICE_REQUEST_TIMEOUT-1053]
-	_ = x[ERROR_SERVICE_NO_THREAD-1054]
-	_ = x[ERROR_SERVICE_DATABASE_LOCKED-1055]
-	_ = x[ERROR_SERVICE_ALREADY_RUNNING-1056]
-	_ = x[ERROR_INVALID_SERVICE_ACCOUNT-1057]
-	_ = x[ERROR_SERVICE_DISABLED-1058]
-	_ = x[ERROR_CIRCULAR_DEPENDENCY-1059]
-	_ = x[ERROR_SERVICE_DOES_NOT_EXIST-1060]
-	_ = x[ERROR_SERVICE_CANNOT_ACCEPT_CTRL-1061]
-	_ = x[ERROR_SERVICE_NOT_ACTIVE-1062]
-	_ = x[ERROR_FAILED_SERVICE_CONTROLLER_CONNECT-1063]
-	_ = x[ERROR_EXCEPTION_IN_SERVICE-1064]
-	_ = x[ERROR_DATABASE_DOES_NOT_EXIST-1065]
-	_ = x[ERROR_SERVICE_SPECIFIC_ERROR-1066]
-	_ = x[ERROR_PROCESS_ABORTED-1067]
-	_ = x[ERROR_SERVICE_DEPENDENCY_FAIL-1068]
-	_ = x[ERROR_SERVICE_LOGON_FAILED-1069]
-	_ = x[ERROR_SERVICE_START_HANG-1070]
-	_ = x[ERROR_INVALID_SERVICE_LOCK-1071]
-	_ = x[ERROR_SERVICE_MARKED_FOR_DELETE-1072]
-	_ = x[ERROR_SERVICE_EXISTS-1073]
-	_ = x[ERROR_ALREADY_RUNNING_LKG-1074]
-	_ = x[ERROR_SERVICE_DEPENDENCY_DELETED-1075]
-	_ = x[ERROR_BOOT_ALREADY_ACCEPTED-1076]
-	_ = x[ERROR_SERVICE_NEVER_STARTED-1077]
-	_ = x[ERROR_DUPLICATE_SERVICE_NAME-1078]
-	_ = x[ERROR_DIFFERENT_SERVICE_ACCOUNT-1079]
-	_ = x[ERROR_CANNOT_DETECT_DRIVER_FAILURE-1080]
-	_ = x[ERROR_CANNOT_DETECT_PROCESS_ABORT-1081]
-	_ = x[ERROR_NO_RECOVERY_PROGRAM-1082]
-	_ = x[ERROR_END_OF_MEDIA-1100]
-	_ = x[ERROR_FILEMARK_DETECTED-1101]
-	_ = x[ERROR_BEGINNING_OF_MEDIA-1102]
-	_ = x[ERROR_SETMARK_DETECTED-1103]
-	_ = x[ERROR_NO_DATA_DETECTED-1104]
-	_ = x[ERROR_PARTITION_FAILURE-1105]
-	_ = x[ERROR_INVALID_BLOCK_LENGTH-1106]
-	_ = x[ERROR_DEVICE_NOT_PARTITIONED-1107]
-	_ = x[ERROR_UNABLE_TO_LOCK_MEDIA-1108]
-	_ = x[ERROR_UNABLE_TO_UNLOAD_MEDIA-1109]
-	_ = x[ERROR_MEDIA_CHANGED-1110]
-	_ = x[ERROR_BUS_RESET-1111]
-	_ = x[ERROR_NO_MEDIA_IN_DRIVE-1112]
-	_ = x[ERROR_NO_UNICODE_TRANSLATION-1113]
-	_ = x[ERROR_DLL_INIT_FAILED-1114]
-	_ = x[ERROR_SHUTDOWN_IN_PROGRESS-1115]
-	_ = x[ERROR_NO_SHUTDOWN_IN_PROGRESS-1116]
-	_ = x[ERROR_IO_DEVICE-1117]
-	_ = x[ERROR_SERIAL_NO_DEVICE-1118]
-	_ = x[ERROR_IRQ_BUSY-1119]
-	_ = x[ERROR_MORE_WRITES-1120]
-	_ = x[ERROR_COUNTER_TIMEOUT-1121]
-	_ = x[ERROR_FLOPPY_ID_MARK_NOT_FOUND-1122]
-	_ = x[ERROR_FLOPPY_WRONG_CYLINDER-1123]
-	_ = x[ERROR_FLOPPY_UNKNOWN_ERROR-1124]
-	_ = x[ERROR_FLOPPY_BAD_REGISTERS-1125]
-	_ = x[ERROR_DISK_RECALIBRATE_FAILED-1126]
-	_ = x[ERROR_DISK_OPERATION_FAILED-1127]
-	_ = x[ERROR_DISK_RESET_FAILED-1128]
-	_ = x[ERROR_EOM_OVERFLOW-1129]
-	_ = x[ERROR_NOT_ENOUGH_SERVER_MEMORY-1130]
-	_ = x[ERROR_POSSIBLE_DEADLOCK-1131]
-	_ = x[ERROR_MAPPED_ALIGNMENT-1132]
-	_ = x[ERROR_SET_POWER_STATE_VETOED-1140]
-	_ = x[ERROR_SET_POWER_STATE_FAILED-1141]
-	_ = x[ERROR_TOO_MANY_LINKS-1142]
-	_ = x[ERROR_OLD_WIN_VERSION-1150]
-	_ = x[ERROR_APP_WRONG_OS-1151]
-	_ = x[ERROR_SINGLE_INSTANCE_APP-1152]
-	_ = x[ERROR_RMODE_APP-1153]
-	_ = x[ERROR_INVALID_DLL-1154]
-	_ = x[ERROR_NO_ASSOCIATION-1155]
-	_ = x[ERROR_DDE_FAIL-1156]
-	_ = x[ERROR_DLL_NOT_FOUND-1157]
-	_ = x[ERROR_NO_MORE_USER_HANDLES-1158]
-	_ = x[ERROR_MESSAGE_SYNC_ONLY-1159]
-	_ = x[ERROR_SOURCE_ELEMENT_EMPTY-1160]
-	_ = x[ERROR_DESTINATION_ELEMENT_FULL-1161]
-	_ = x[ERROR_ILLEGAL_ELEMENT_ADDRESS-1162]
-	_ = x[ERROR_MAGAZINE_NOT_PRESENT-1163]
-	_ = x[ERROR_DEVICE_REINITIALIZATION_NEEDED-1164]
-	_ = x[ERROR_DEVICE_REQUIRES_CLEANING-1165]
-	_ = x[ERROR_DEVICE_DOOR_OPEN-1166]
-	_ = x[ERROR_DEVICE_NOT_CONNECTED-1167]
-	_ = x[ERROR_NOT_FOUND-1168]
-	_ = x[ERROR_NO_MATCH-1169]
-	_ = x[ERROR_SET_NOT_FOUND-1170]
-	_ = x[ERROR_POINT_NOT_FOUND-1171]
-	_ = x[ERROR_NO_TRACKING_SERVICE-1172]
-	_ = x[ERROR_NO_VOLUME_ID-1173]
-	_ = x[ERROR_CONNECTED_OTHER_PASSWORD-2108]
-	_ = x[ERROR_BAD_USERNAME-2202]
-	_ = x[ERROR_NOT_CONNECTED-2250]
-	_ = x[ERROR_OPEN_FILES-2401]
-	_ = x[ERROR_ACTIVE_CONNECTIONS-2402]
-	_ = x[ERROR_DEVICE_IN_USE-2404]
-	_ = x[ERROR_BAD_DEVICE-1200]
-	_ = x[ERROR_CONNECTION_UNAVAIL-1201]
-	_ = x[ERROR_DEVICE_ALREADY_REMEMBERED-1202]
-	_ = x[ERROR_NO_NET_OR_BAD_PATH-1203]
-	_ = x[ERROR_BAD_PROVIDER-1204]
-	_ = x[ERROR_CANNOT_OPEN_PROFILE-1205]
-	_ = x[ERROR_BAD_PROFILE-1206]
-	_ = x[ERROR_NOT_CONTAINER-1207]
-	_ = x[ERROR_EXTENDED_ERROR-1208]
-	_ = x[ERROR_INVALID_GROUPNAME-1209]
-	_ = x[ERROR_INVALID_COMPUTERNAME-1210]
-	_ = x[ERROR_INVALID_EVENTNAME-1211]
-	_ = x[ERROR_INVALID_DOMAINNAME-1212]
-	_ = x[ERROR_INVALID_SERVICENAME-1213]
-	_ = x[ERROR_INVALID_NETNAME-1214]
-	_ = x[ERROR_INVALID_SHARENAME-1215]
-	_ = x[ERROR_INVALID_PASSWORDNAME-1216]
-	_ = x[ERROR_INVALID_MESSAGENAME-1217]
-	_ = x[ERROR_INVALID_MESSAGEDEST-1218]
-	_ = x[ERROR_SESSION_CREDENTIAL_CONFLICT-1219]
-	_ = x[ERROR_REMOTE_SESSION_LIMIT_EXCEEDED-1220]
-	_ = x[ERROR_DUP_DOMAINNAME-1221]
-	_ = x[ERROR_NO_NETWORK-1222]
-	_ = x[ERROR_CANCELLED-1223]
-	_ = x[ERROR_USER_MAPPED_FILE-1224]
-	_ = x[ERROR_CONNECTION_REFUSED-1225]
-	_ = x[ERROR_GRACEFUL_DISCONNECT-1226]
-	_ = x[ERROR_ADDRESS_ALREADY_ASSOCIATED-1227]
-	_ = x[ERROR_ADDRESS_NOT_ASSOCIATED-1228]
-	_ = x[ERROR_CONNECTION_INVALID-1229]
-	_ = x[ERROR_CONNECTION_ACTIVE-1230]
-	_ = x[ERROR_NETWORK_UNREACHABLE-1231]
-	_ = x[ERROR_HOST_UNREACHABLE-1232]
-	_ = x[ERROR_PROTOCOL_UNREACHABLE-1233]
-	_ = x[ERROR_PORT_UNREACHABLE-1234]
-	_ = x[ERROR_REQUEST_ABORTED-1235]
-	_ = x[ERROR_CONNECTION_ABORTED-1236]
-	_ = x[ERROR_RETRY-1237]
-	_ = x[ERROR_CONNECTION_COUNT_LIMIT-1238]
-	_ = x[ERROR_LOGIN_TIME_RESTRICTION-1239]
-	_ = x[ERROR_LOGIN_WKSTA_RESTRICTION-1240]
-	_ = x[ERROR_INCORRECT_ADDRESS-1241]
-	_ = x[ERROR_ALREADY_REGISTERED-1242]
-	_ = x[ERROR_SERVICE_NOT_FOUND-1243]
-	_ = x[ERROR_NOT_AUTHENTICATED-1244]
-	_ = x[ERROR_NOT_LOGGED_ON-1245]
-	_ = x[ERROR_CONTINUE-1246]
-	_ = x[ERROR_ALREADY_INITIALIZED-1247]
-	_ = x[ERROR_NO_MORE_DEVICES-1248]
-	_ = x[ERROR_NO_SUCH_SITE-1249]
-	_ = x[ERROR_DOMAIN_CONTROLLER_EXISTS-1250]
-	_ = x[ERROR_DS_NOT_INSTALLED-1251]
-	_ = x[ERROR_NOT_ALL_ASSIGNED-1300]
-	_ = x[ERROR_SOME_NOT_MAPPED-1301]
-	_ = x[ERROR_NO_QUOTAS_FOR_ACCOUNT-1302]
-	_ = x[ERROR_LOCAL_USER_SESSION_KEY-1303]
-	_ = x[ERROR_NULL_LM_PASSWORD-1304]
-	_ = x[ERROR_UNKNOWN_REVISION-1305]
-	_ = x[ERROR_REVISION_MISMATCH-1306]
-	_ = x[ERROR_INVALID_OWNER-1307]
-	_ = x[ERROR_INVALID_PRIMARY_GROUP-1308]
-	_ = x[ERROR_NO_IMPERSONATION_TOKEN-1309]
-	_ = x[ERROR_CANT_DISABLE_MANDATORY-1310]
-	_ = x[ERROR_NO_LOGON_SERVERS-1311]
-	_ = x[ERROR_NO_SUCH_LOGON_SESSION-1312]
-	_ = x[ERROR_NO_SUCH_PRIVILEGE-1313]
-	_ = x[ERROR_PRIVILEGE_NOT_HELD-1314]
-	_ = x[ERROR_INVALID_ACCOUNT_NAME-1315]
-	_ = x[ERROR_USER_EXISTS-1316]
-	_ = x[ERROR_NO_SUCH_USER-1317]
-	_ = x[ERROR_GROUP_EXISTS-1318]
-	_ = x[ERROR_NO_SUCH_GROUP-1319]
-	_ = x[ERROR_MEMBER_IN_GROUP-1320]
-	_ = x[ERROR_MEMBER_NOT_IN_GROUP-1321]
-	_ = x[ERROR_LAST_ADMIN-1322]
-	_ = x[ERROR_WRONG_PASSWORD-1323]
-	_ = x[ERROR_ILL_FORMED_PASSWORD-1324]
-	_ = x[ERROR_PASSWORD_RESTRICTION-1325]
-	_ = x[ERROR_LOGON_FAILURE-1326]
-	_ = x[ERROR_ACCOUNT_RESTRICTION-1327]
-	_ = x[ERROR_INVALID_LOGON_HOURS-1328]
-	_ = x[ERROR_INVALID_WORKSTATION-1329]
-	_ = x[ERROR_PASSWORD_EXPIRED-1330]
-	_ = x[ERROR_ACCOUNT_DISABLED-1331]
-	_ = x[ERROR_NONE_MAPPED-1332]
-	_ = x[ERROR_TOO_MANY_LUIDS_REQUESTED-1333]
-	_ = x[ERROR_LUIDS_EXHAUSTED-1334]
-	_ = x[ERROR_INVALID_SUB_AUTHORITY-1335]
-	_ = x[ERROR_INVALID_ACL-1336]
-	_ = x[ERROR_INVALID_SID-1337]
-	_ = x[ERROR_INVALID_SECURITY_DESCR-1338]
-	_ = x[ERROR_BAD_INHERITANCE_ACL-1340]
-	_ = x[ERROR_SERVER_DISABLED-1341]
-	_ = x[ERROR_SERVER_NOT_DISABLED-1342]
-	_ = x[ERROR_INVALID_ID_AUTHORITY-1343]
-	_ = x[ERROR_ALLOTTED_SPACE_EXCEEDED-1344]
-	_ = x[ERROR_INVALID_GROUP_ATTRIBUTES-1345]
-	_ = x[ERROR_BAD_IMPERSONATION_LEVEL-1346]
-	_ = x[ERROR_CANT_OPEN_ANONYMOUS-1347]
-	_ = x[ERROR_BAD_VALIDATION_CLASS-1348]
-	_ = x[ERROR_BAD_TOKEN_TYPE-1349]
-	_ = x[ERROR_NO_SECURITY_ON_OBJECT-1350]
-	_ = x[ERROR_CANT_ACCESS_DOMAIN_INFO-1351]
-	_ = x[ERROR_INVALID_SERVER_STATE-1352]
-	_ = x[ERROR_INVALID_DOMAIN_STATE-1353]
-	_ = x[ERROR_INVALID_DOMAIN_ROLE-1354]
-	_ = x[ERROR_NO_SUCH_DOMAIN-1355]
-	_ = x[ERROR_DOMAIN_EXISTS-1356]
-	_ = x[ERROR_DOMAIN_LIMIT_EXCEEDED-1357]
-	_ = x[ERROR_INTERNAL_DB_CORRUPTION-1358]
-	_ = x[ERROR_INTERNAL_ERROR-1359]
-	_ = x[ERROR_GENERIC_NOT_MAPPED-1360]
-	_ = x[ERROR_BAD_DESCRIPTOR_FORMAT-1361]
-	_ = x[ERROR_NOT_LOGON_PROCESS-1362]
-	_ = x[ERROR_LOGON_SESSION_EXISTS-1363]
-	_ = x[ERROR_NO_SUCH_PACKAGE-1364]
-	_ = x[ERROR_BAD_LOGON_SESSION_STATE-1365]
-	_ = x[ERROR_LOGON_SESSION_COLLISION-1366]
-	_ = x[ERROR_INVALID_LOGON_TYPE-1367]
-	_ = x[ERROR_CANNOT_IMPERSONATE-1368]
-	_ = x[ERROR_RXACT_INVALID_STATE-1369]
-	_ = x[ERROR_RXACT_COMMIT_FAILURE-1370]
-	_ = x[ERROR_SPECIAL_ACCOUNT-1371]
-	_ = x[ERROR_SPECIAL_GROUP-1372]
-	_ = x[ERROR_SPECIAL_USER-1373]
-	_ = x[ERROR_MEMBERS_PRIMARY_GROUP-1374]
-	_ = x[ERROR_TOKEN_ALREADY_IN_USE-1375]
-	_ = x[ERROR_NO_SUCH_ALIAS-1376]
-	_ = x[ERROR_MEMBER_NOT_IN_ALIAS-1377]
-	_ = x[ERROR_MEMBER_IN_ALIAS-1378]
-	_ = x[ERROR_ALIAS_EXISTS-1379]
-	_ = x[ERROR_LOGON_NOT_GRANTED-1380]
-	_ = x[ERROR_TOO_MANY_SECRETS-1381]
-	_ = x[ERROR_SECRET_TOO_LONG-1382]
-	_ = x[ERROR_INTERNAL_DB_ERROR-1383]
-	_ = x[ERROR_TOO_MANY_CONTEXT_IDS-1384]
-	_ = x[ERROR_LOGON_TYPE_NOT_GRANTED-1385]
-	_ = x[ERROR_NT_CROSS_ENCRYPTION_REQUIRED-1386]
-	_ = x[ERROR_NO_SUCH_MEMBER-1387]
-	_ = x[ERROR_INVALID_MEMBER-1388]
-	_ = x[ERROR_TOO_MANY_SIDS-1389]
-	_ = x[ERROR_LM_CROSS_ENCRYPTION_REQUIRED-1390]
-	_ = x[ERROR_NO_INHERITANCE-1391]
-	_ = x[ERROR_FILE_CORRUPT-1392]
-	_ = x[ERROR_DISK_CORRUPT-1393]
-	_ = x[ERROR_NO_USER_SESSION_KEY-1394]
-	_ = x[ERROR_LICENSE_QUOTA_EXCEEDED-1395]
-	_ = x[ERROR_INVALID_WINDOW_HANDLE-1400]
-	_ = x[ERROR_INVALID_MENU_HANDLE-1401]
-	_ = x[ERROR_INVALID_CURSOR_HANDLE-1402]
-	_ = x[ERROR_INVALID_ACCEL_HANDLE-1403]
-	_ = x[ERROR_INVALID_HOOK_HANDLE-1404]
-	_ = x[ERROR_INVALID_DWP_HANDLE-1405]
-	_ = x[ERROR_TLW_WITH_WSCHILD-1406]
-	_ = x[ERROR_CANNOT_FIND_WND_CLASS-1407]
-	_ = x[ERROR_WINDOW_OF_OTHER_THREAD-1408]
-	_ = x[ERROR_HOTKEY_ALREADY_REGISTERED-1409]
-	_ = x[ERROR_CLASS_ALREADY_EXISTS-1410]
-	_ = x[ERROR_CLASS_DOES_NOT_EXIST-1411]
-	_ = x[ERROR_CLASS_HAS_WINDOWS-1412]
-	_ = x[ERROR_INVALID_INDEX-1413]
-	_ = x[ERROR_INVALID_ICON_HANDLE-1414]
-	_ = x[ERROR_PRIVATE_DIALOG_INDEX-1415]
-	_ = x[ERROR_LISTBOX_ID_NOT_FOUND-1416]
-	_ = x[ERROR_NO_WILDCARD_CHARACTERS-1417]
-	_ = x[ERROR_CLIPBOARD_NOT_OPEN-1418]
-	_ = x[ERROR_HOTKEY_NOT_REGISTERED-1419]
-	_ = x[ERROR_WINDOW_NOT_DIALOG-1420]
-	_ = x[ERROR_CONTROL_ID_NOT_FOUND-1421]
-	_ = x[ERROR_INVALID_COMBOBOX_MESSAGE-1422]
-	_ = x[ERROR_WINDOW_NOT_COMBOBOX-1423]
-	_ = x[ERROR_INVALID_EDIT_HEIGHT-1424]
-	_ = x[ERROR_DC_NOT_FOUND-1425]
-	_ = x[ERROR_INVALID_HOOK_FILTER-1426]
-	_ = x[ERROR_INVALID_FILTER_PROC-1427]
-	_ = x[ERROR_HOOK_NEEDS_HMOD-1428]
-	_ = x[ERROR_GLOBAL_ONLY_HOOK-1429]
-	_ = x[ERROR_JOURNAL_HOOK_SET-1430]
-	_ = x[ERROR_HOOK_NOT_INSTALLED-1431]
-	_ = x[ERROR_INVALID_LB_MESSAGE-1432]
-	_ = x[ERROR_SETCOUNT_ON_BAD_LB-1433]
-	_ = x[ERROR_LB_WITHOUT_TABSTOPS-1434]
-	_ = x[ERROR_DESTROY_OBJECT_OF_OTHER_THREAD-1435]
-	_ = x[ERROR_CHILD_WINDOW_MENU-1436]
-	_ = x[ERROR_NO_SYSTEM_MENU-1437]
-	_ = x[ERROR_INVALID_MSGBOX_STYLE-1438]
-	_ = x[ERROR_INVALID_SPI_VALUE-1439]
-	_ = x[ERROR_SCREEN_ALREADY_LOCKED-1440]
-	_ = x[ERROR_HWNDS_HAVE_DIFF_PARENT-1441]
-	_ = x[ERROR_NOT_CHILD_WINDOW-1442]
-	_ = x[ERROR_INVALID_GW_COMMAND-1443]
-	_ = x[ERROR_INVALID_THREAD_ID-1444]
-	_ = x[ERROR_NON_MDICHILD_WINDOW-1445]
-	_ = x[ERROR_POPUP_ALREADY_ACTIVE-1446]
-	_ = x[ERROR_NO_SCROLLBARS-1447]
-	_ = x[ERROR_INVALID_SCROLLBAR_RANGE-1448]
-	_ = x[ERROR_INVALID_SHOWWIN_COMMAND-1449]
-	_ = x[ERROR_NO_SYSTEM_RESOURCES-1450]
-	_ = x[ERROR_NONPAGED_SYSTEM_RESOURCES-1451]
-	_ = x[ERROR_PAGED_SYSTEM_RESOURCES-1452]
-	_ = x[ERROR_WORKING_SET_QUOTA-1453]
-	_ = x[ERROR_PAGEFILE_QUOTA-1454]
-	_ = x[ERROR_COMMITMENT_LIMIT-1455]
-	_ = x[ERROR_MENU_ITEM_NOT_FOUND-1456]
-	_ = x[ERROR_INVALID_KEYBOARD_HANDLE-1457]
-	_ = x[ERROR_HOOK_TYPE_NOT_ALLOWED-1458]
-	_ = x[ERROR_REQUIRES_INTERACTIVE_WINDOWSTATION-1459]
-	_ = x[ERROR_TIMEOUT-1460]
-	_ = x[ERROR_INVALID_MONITOR_HANDLE-1461]
-	_ = x[ERROR_EVENTLOG_FILE_CORRUPT-1500]
-	_ = x[ERROR_EVENTLOG_CANT_START-1501]
-	_ = x[ERROR_LOG_FILE_FULL-1502]
-	_ = x[ERROR_EVENTLOG_FILE_CHANGED-1503]
-	_ = x[ERROR_INSTALL_SERVICE-1601]
-	_ = x[ERROR_INSTALL_USEREXIT-1602]
-	_ = x[ERROR_INSTALL_FAILURE-1603]
-	_ = x[ERROR_INSTALL_SUSPEND-1604]
-	_ = x[ERROR_UNKNOWN_PRODUCT-1605]
-	_ = x[ERROR_UNKNOWN_FEATURE-1606]
-	_ = x[ERROR_UNKNOWN_COMPONENT-1607]
-	_ = x[ERROR_UNKNOWN_PROPERTY-1608]
-	_ = x[ERROR_INVALID_HANDLE_STATE-1609]
-	_ = x[ERROR_BAD_CONFIGURATION-1610]
-	_ = x[ERROR_INDEX_ABSENT-1611]
-	_ = x[ERROR_INSTALL_SOURCE_ABSENT-1612]
-	_ = x[ERROR_BAD_DATABASE_VERSION-1613]
-	_ = x[ERROR_PRODUCT_UNINSTALLED-1614]
-	_ = x[ERROR_BAD_QUERY_SYNTAX-1615]
-	_ = x[ERROR_INVALID_FIELD-1616]
-	_ = x[RPC_S_INVALID_STRING_BINDING-1700]
-	_ = x[RPC_S_WRONG_KIND_OF_BINDING-1701]
-	_ = x[RPC_S_INVALID_BINDING-1702]
-	_ = x[RPC_S_PROTSEQ_NOT_SUPPORTED-1703]
-	_ = x[RPC_S_INVALID_RPC_PROTSEQ-1704]
-	_ = x[RPC_S_INVALID_STRING_UUID-1705]
-	_ = x[RPC_S_INVALID_ENDPOINT_FORMAT-1706]
-	_ = x[RPC_S_INVALID_NET_ADDR-1707]
-	_ = x[RPC_S_NO_ENDPOINT_FOUND-1708]
-	_ = x[RPC_S_INVALID_TIMEOUT-1709]
-	_ = x[RPC_S_OBJECT_NOT_FOUND-1710]
-	_ = x[RPC_S_ALREADY_REGISTERED-1711]
-	_ = x[RPC_S_TYPE_ALREADY_REGISTERED-1712]
-	_ = x[RPC_S_ALREADY_LISTENING-1713]
-	_ = x[RPC_S_NO_PROTSEQS_REGISTERED-1714]
-	_ = x[RPC_S_NOT_LISTENING-1715]
-	_ = x[RPC_S_UNKNOWN_MGR_TYPE-1716]
-	_ = x[RPC_S_UNKNOWN_IF-1717]
-	_ = x[RPC_S_NO_BINDINGS-1718]
-	_ = x[RPC_S_NO_PROTSEQS-1719]
-	_ = x[RPC_S_CANT_CREATE_ENDPOINT-1720]
-	_ = x[RPC_S_OUT_OF_RESOURCES-1721]
-	_ = x[RPC_S_SERVER_UNAVAILABLE-1722]
-	_ = x[RPC_S_SERVER_TOO_BUSY-1723]
-	_ = x[RPC_S_INVALID_NETWORK_OPTIONS-1724]
-	_ = x[RPC_S_NO_CALL_ACTIVE-1725]
-	_ = x[RPC_S_CALL_FAILED-1726]
-	_ = x[RPC_S_CALL_FAILED_DNE-1727]
-	_ = x[RPC_S_PROTOCOL_ERROR-1728]
-	_ = x[RPC_S_UNSUPPORTED_TRANS_SYN-1730]
-	_ = x[RPC_S_UNSUPPORTED_TYPE-1732]
-	_ = x[RPC_S_INVALID_TAG-1733]
-	_ = x[RPC_S_INVALID_BOUND-1734]
-	_ = x[RPC_S_NO_ENTRY_NAME-1735]
-	_ = x[RPC_S_INVALID_NAME_SYNTAX-1736]
-	_ = x[RPC_S_UNSUPPORTED_NAME_SYNTAX-1737]
-	_ = x[RPC_S_UUID_NO_ADDRESS-1739]
-	_ = x[RPC_S_DUPLICATE_ENDPOINT-1740]
-	_ = x[RPC_S_UNKNOWN_AUTHN_TYPE-1741]
-	_ = x[RPC_S_MAX_CALLS_TOO_SMALL-1742]
-	_ = x[RPC_S_STRING_TOO_LONG-1743]
-	_ = x[RPC_S_PROTSEQ_NOT_FOUND-1744]
-	_ = x[RPC_S_PROCNUM_OUT_OF_RANGE-1745]
-	_ = x[RPC_S_BINDING_HAS_NO_AUTH-1746]
-	_ = x[RPC_S_UNKNOWN_AUTHN_SERVICE-1747]
-	_ = x[RPC_S_UNKNOWN_AUTHN_LEVEL-1748]
-	_ = x[RPC_S_INVALID_AUTH_IDENTITY-1749]
-	_ = x[RPC_S_UNKNOWN_AUTHZ_SERVICE-1750]
-	_ = x[EPT_S_INVALID_ENTRY-1751]
-	_ = x[EPT_S_CANT_PERFORM_OP-1752]
-	_ = x[EPT_S_NOT_REGISTERED-1753]
-	_ = x[RPC_S_NOTHING_TO_EXPORT-1754]
-	_ = x[RPC_S_INCOMPLETE_NAME-1755]
-	_ = x[RPC_S_INVALID_VERS_OPTION-1756]
-	_ = x[RPC_S_NO_MORE_MEMBERS-1757]
-	_ = x[RPC_S_NOT_ALL_OBJS_UNEXPORTED-1758]
-	_ = x[RPC_S_INTERFACE_NOT_FOUND-1759]
-	_ = x[RPC_S_ENTRY_ALREADY_EXISTS-1760]
-	_ = x[RPC_S_ENTRY_NOT_FOUND-1761]
-	_ = x[RPC_S_NAME_SERVICE_UNAVAILABLE-1762]
-	_ = x[RPC_S_INVALID_NAF_ID-1763]
-	_ = x[RPC_S_CANNOT_SUPPORT-1764]
-	_ = x[RPC_S_NO_CONTEXT_AVAILABLE-1765]
-	_ = x[RPC_S_INTERNAL_ERROR-1766]
-	_ = x[RPC_S_ZERO_DIVIDE-1767]
-	_ = x[RPC_S_ADDRESS_ERROR-1768]
-	_ = x[RPC_S_FP_DIV_ZERO-1769]
-	_ = x[RPC_S_FP_UNDERFLOW-1770]
-	_ = x[RPC_S_FP_OVERFLOW-1771]
-	_ = x[RPC_X_NO_MORE_ENTRIES-1772]
-	_ = x[RPC_X_SS_CHAR_TRANS_OPEN_FAIL-1773]
-	_ = x[RPC_X_SS_CHAR_TRANS_SHORT_FILE-1774]
-	_ = x[RPC_X_SS_IN_NULL_CONTEXT-1775]
-	_ = x[RPC_X_SS_CONTEXT_DAMAGED-1777]
-	_ = x[RPC_X_SS_HANDLES_MISMATCH-1778]
-	_ = x[RPC_X_SS_CANNOT_GET_CALL_HANDLE-1779]
-	_ = x[RPC_X_NULL_REF_POINTER-1780]
-	_ = x[RPC_X_ENUM_VALUE_OUT_OF_RANGE-1781]
-	_ = x[RPC_X_BYTE_COUNT_TOO_SMALL-1782]
-	_ = x[RPC_X_BAD_STUB_DATA-1783]
-	_ = x[ERROR_INVALID_USER_BUFFER-1784]
-	_ = x[ERROR_UNRECOGNIZED_MEDIA-1785]
-	_ = x[ERROR_NO_TRUST_LSA_SECRET-1786]
-	_ = x[ERROR_NO_TRUST_SAM_ACCOUNT-1787]
-	_ = x[ERROR_TRUSTED_DOMAIN_FAILURE-1788]
-	_ = x[ERROR_TRUSTED_RELATIONSHIP_FAILURE-1789]
-	_ = x[ERROR_TRUST_FAILURE-1790]
-	_ = x[RPC_S_CALL_IN_PROGRESS-1791]
-	_ = x[ERROR_NETLOGON_NOT_STARTED-1792]
-	_ = x[ERROR_ACCOUNT_EXPIRED-1793]
-	_ = x[ERROR_REDIRECTOR_HAS_OPEN_HANDLES-1794]
-	_ = x[ERROR_PRINTER_DRIVER_ALREADY_INSTALLED-1795]
-	_ = x[ERROR_UNKNOWN_PORT-1796]
-	_ = x[ERROR_UNKNOWN_PRINTER_DRIVER-1797]
-	_ = x[ERROR_UNKNOWN_PRINTPROCESSOR-1798]
-	_ = x[ERROR_INVALID_SEPARATOR_FILE-1799]
-	_ = x[ERROR_INVALID_PRIORITY-1800]
-	_ = x[ERROR_INVALID_PRINTER_NAME-1801]
-	_ = x[ERROR_PRINTER_ALREADY_EXISTS-1802]
-	_ = x[ERROR_INVALID_PRINTER_COMMAND-1803]
-	_ = x[ERROR_INVALID_DATATYPE-1804]
-	_ = x[ERROR_INVALID_ENVIRONMENT-1805]
-	_ = x[RPC_S_NO_MORE_BINDINGS-1806]
-	_ = x[ERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT-1807]
-	_ = x[ERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNT-1808]
-	_ = x[ERROR_NOLOGON_SERVER_TRUST_ACCOUNT-1809]
-	_ = x[ERROR_DOMAIN_TRUST_INCONSISTENT-1810]
-	_ = x[ERROR_SERVER_HAS_OPEN_HANDLES-1811]
-	_ = x[ERROR_RESOURCE_DATA_NOT_FOUND-1812]
-	_ = x[ERROR_RESOURCE_TYPE_NOT_FOUND-1813]
-	_ = x[ERROR_RESOURCE_NAME_NOT_FOUND-1814]
-	_ = x[ERROR_RESOURCE_LANG_NOT_FOUND-1815]
-	_ = x[ERROR_NOT_ENOUGH_QUOTA-1816]
-	_ = x[RPC_S_NO_INTERFACES-1817]
-	_ = x[RPC_S_CALL_CANCELLED-1818]
-	_ = x[RPC_S_BINDING_INCOMPLETE-1819]
-	_ = x[RPC_S_COMM_FAILURE-1820]
-	_ = x[RPC_S_UNSUPPORTED_AUTHN_LEVEL-1821]
-	_ = x[RPC_S_NO_PRINC_NAME-1822]
-	_ = x[RPC_S_NOT_RPC_ERROR-1823]
-	_ = x[RPC_S_UUID_LOCAL_ONLY-1824]
-	_ = x[RPC_S_SEC_PKG_ERROR-1825]
-	_ = x[RPC_S_NOT_CANCELLED-1826]
-	_ = x[RPC_X_INVALID_ES_ACTION-1827]
-	_ = x[RPC_X_WRONG_ES_VERSION-1828]
-	_ = x[RPC_X_WRONG_STUB_VERSION-1829]
-	_ = x[RPC_X_INVALID_PIPE_OBJECT-1830]
-	_ = x[RPC_X_WRONG_PIPE_ORDER-1831]
-	_ = x[RPC_X_WRONG_PIPE_VERSION-1832]
-	_ = x[RPC_S_GROUP_MEMBER_NOT_FOUND-1898]
-	_ = x[EPT_S_CANT_CREATE-1899]
-	_ = x[RPC_S_INVALID_OBJECT-1900]
-	_ = x[ERROR_INVALID_TIME-1901]
-	_ = x[ERROR_INVALID_FORM_NAME-1902]
-	_ = x[ERROR_INVALID_FORM_SIZE-1903]
-	_ = x[ERROR_ALREADY_WAITING-1904]
-	_ = x[ERROR_PRINTER_DELETED-1905]
-	_ = x[ERROR_INVALID_PRINTER_STATE-1906]
-	_ = x[ERROR_PASSWORD_MUST_CHANGE-1907]
-	_ = x[ERROR_DOMAIN_CONTROLLER_NOT_FOUND-1908]
-	_ = x[ERROR_ACCOUNT_LOCKED_OUT-1909]
-	_ = x[OR_INVALID_OXID-1910]
-	_ = x[OR_INVALID_OID-1911]
-	_ = x[OR_INVALID_SET-1912]
-	_ = x[RPC_S_SEND_INCOMPLETE-1913]
-	_ = x[RPC_S_INVALID_ASYNC_HANDLE-1914]
-	_ = x[RPC_S_INVALID_ASYNC_CALL-1915]
-	_ = x[RPC_X_PIPE_CLOSED-1916]
-	_ = x[RPC_X_PIPE_DISCIPLINE_ERROR-1917]
-	_ = x[RPC_X_PIPE_EMPTY-1918]
-	_ = x[ERROR_NO_SITENAME-1919]
-	_ = x[ERROR_CANT_ACCESS_FILE-1920]
-	_ = x[ERROR_CANT_RESOLVE_FILENAME-1921]
-	_ = x[ERROR_DS_MEMBERSHIP_EVALUATED_LOCALLY-1922]
-	_ = x[ERROR_DS_NO_ATTRIBUTE_OR_VALUE-1923]
-	_ = x[ERROR_DS_INVALID_ATTRIBUTE_SYNTAX-1924]
-	_ = x[ERROR_DS_ATTRIBUTE_TYPE_UNDEFINED-1925]
-	_ = x[ERROR_DS_ATTRIBUTE_OR_VALUE_EXISTS-1926]
-	_ = x[ERROR_DS_BUSY-1927]
-	_ = x[ERROR_DS_UNAVAILABLE-1928]
-	_ = x[ERROR_DS_NO_RIDS_ALLOCATED-1929]
-	_ = x[ERROR_DS_NO_MORE_RIDS-1930]
-	_ = x[ERROR_DS_INCORRECT_ROLE_OWNER-1931]
-	_ = x[ERROR_DS_RIDMGR_INIT_ERROR-1932]
-	_ = x[ERROR_DS_OBJ_CLASS_VIOLATION-1933]
-	_ = x[ERROR_DS_CANT_ON_NON_LEAF-1934]
-	_ = x[ERROR_DS_CANT_ON_RDN-1935]
-	_ = x[ERROR_DS_CANT_MOD_OBJ_CLASS-1936]
-	_ = x[ERROR_DS_CROSS_DOM_MOVE_ERROR-1937]
-	_ = x[ERROR_DS_GC_NOT_AVAILABLE-1938]
-	_ = x[ERROR_NO_BROWSER_SERVERS_FOUND-6118]
-	_ = x[ERROR_INVALID_PIXEL_FORMAT-2000]
-	_ = x[ERROR_BAD_DRIVER-2001]
-	_ = x[ERROR_INVALID_WINDOW_STYLE-2002]
-	_ = x[ERROR_METAFILE_NOT_SUPPORTED-2003]
-	_ = x[ERROR_TRANSFORM_NOT_SUPPORTED-2004]
-	_ = x[ERROR_CLIPPING_NOT_SUPPORTED-2005]
-	_ = x[ERROR_INVALID_CMM-2300]
-	_ = x[ERROR_INVALID_PROFILE-2301]
-	_ = x[ERROR_TAG_NOT_FOUND-2302]
-	_ = x[ERROR_TAG_NOT_PRESENT-2303]
-	_ = x[ERROR_DUPLICATE_TAG-2304]
-	_ = x[ERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICE-2305]
-	_ = x[ERROR_PROFILE_NOT_FOUND-2306]
-	_ = x[ERROR_INVALID_COLORSPACE-2307]
-	_ = x[ERROR_ICM_NOT_ENABLED-2308]
-	_ = x[ERROR_DELETING_ICM_XFORM-2309]
-	_ = x[ERROR_INVALID_TRANSFORM-2310]
-	_ = x[ERROR_UNKNOWN_PRINT_MONITOR-3000]
-	_ = x[ERROR_PRINTER_DRIVER_IN_USE-3001]
-	_ = x[ERROR_SPOOL_FILE_NOT_FOUND-3002]
-	_ = x[ERROR_SPL_NO_STARTDOC-3003]
-	_ = x[ERROR_SPL_NO_ADDJOB-3004]
-	_ = x[ERROR_PRINT_PROCESSOR_ALREADY_INSTALLED-3005]
-	_ = x[ERROR_PRINT_MONITOR_ALREADY_INSTALLED-3006]
-	_ = x[ERROR_INVALID_PRINT_MONITOR-3007]
-	_ = x[ERROR_PRINT_MONITOR_IN_USE-3008]
-	_ = x[ERROR_PRINTER_HAS_JOBS_QUEUED-3009]
-	_ = x[ERROR_SUCCESS_REBOOT_REQUIRED-3010]
-	_ = x[ERROR_SUCCESS_RESTART_REQUIRED-3011]
-	_ = x[ERROR_WINS_INTERNAL-4000]
-	_ = x[ERROR_CAN_NOT_DEL_LOCAL_WINS-4001]
-	_ = x[ERROR_STATIC_INIT-4002]
-	_ = x[ERROR_INC_BACKUP-4003]
-	_ = x[ERROR_FULL_BACKUP-4004]
-	_ = x[ERROR_REC_NON_EXISTENT-4005]
-	_ = x[ERROR_RPL_NOT_ALLOWED-4006]
-	_ = x[ERROR_DHCP_ADDRESS_CONFLICT-4100]
-	_ = x[ERROR_WMI_GUID_NOT_FOUND-4200]
-	_ = x[ERROR_WMI_INSTANCE_NOT_FOUND-4201]
-	_ = x[ERROR_WMI_ITEMID_NOT_FOUND-4202]
-	_ = x[ERROR_WMI_TRY_AGAIN-4203]
-	_ = x[ERROR_WMI_DP_NOT_FOUND-4204]
-	_ = x[ERROR_WMI_UNRESOLVED_INSTANCE_REF-4205]
-	_ = x[ERROR_WMI_ALREADY_ENABLED-4206]
-	_ = x[ERROR_WMI_GUID_DISCONNECTED-4207]
-	_ = x[ERROR_WMI_SERVER_UNAVAILABLE-4208]
-	_ = x[ERROR_WMI_DP_FAILED-4209]
-	_ = x[ERROR_WMI_INVALID_MOF-4210]
-	_ = x[ERROR_WMI_INVALID_REGINFO-4211]
-	_ = x[ERROR_INVALID_MEDIA-4300]
-	_ = x[ERROR_INVALID_LIBRARY-4301]
-	_ = x[ERROR_INVALID_MEDIA_POOL-4302]
-	_ = x[ERROR_DRIVE_MEDIA_MISMATCH-4303]
-	_ = x[ERROR_MEDIA_OFFLINE-4304]
-	_ = x[ERROR_LIBRARY_OFFLINE-4305]
-	_ = x[ERROR_EMPTY-4306]
-	_ = x[ERROR_NOT_EMPTY-4307]
-	_ = x[ERROR_MEDIA_UNAVAILABLE-4308]
-	_ = x[ERROR_RESOURCE_DISABLED-4309]
-	_ = x[ERROR_INVALID_CLEANER-4310]
-	_ = x[ERROR_UNABLE_TO_CLEAN-4311]
-	_ = x[ERROR_OBJECT_NOT_FOUND-4312]
-	_ = x[ERROR_DATABASE_FAILURE-4313]
-	_ = x[ERROR_DATABASE_FULL-4314]
-	_ = x[ERROR_MEDIA_INCOMPATIBLE-4315]
-	_ = x[ERROR_RESOURCE_NOT_PRESENT-4316]
-	_ = x[ERROR_INVALID_OPERATION-4317]
-	_ = x[ERROR_MEDIA_NOT_AVAILABLE-4318]
-	_ = x[ERROR_DEVICE_NOT_AVAILABLE-4319]
-	_ = x[ERROR_REQUEST_REFUSED-4320]
-	_ = x[ERROR_FILE_OFFLINE-4350]
-	_ = x[ERROR_REMOTE_STORAGE_NOT_ACTIVE-4351]
-	_ = x[ERROR_REMOTE_STORAGE_MEDIA_ERROR-4352]
-	_ = x[ERROR_NOT_A_REPARSE_POINT-4390]
-	_ = x[ERROR_REPARSE_ATTRIBUTE_CONFLICT-4391]
-	_ = x[ERROR_DEPENDENT_RESOURCE_EXISTS-5001]
-	_ = x[ERROR_DEPENDENCY_NOT_FOUND-5002]
-	_ = x[ERROR_DEPENDENCY_ALREADY_EXISTS-5003]
-	_ = x[ERROR_RESOURCE_NOT_ONLINE-5004]
-	_ = x[ERROR_HOST_NODE_NOT_AVAILABLE-5005]
-	_ = x[ERROR_RESOURCE_NOT_AVAILABLE-5006]
-	_ = x[ERROR_RESOURCE_NOT_FOUND-5007]
-	_ = x[ERROR_SHUTDOWN_CLUSTER-5008]
-	_ = x[ERROR_CANT_EVICT_ACTIVE_NODE-5009]
-	_ = x[ERROR_OBJECT_ALREADY_EXISTS-5010]
-	_ = x[ERROR_OBJECT_IN_LIST-5011]
-	_ = x[ERROR_GROUP_NOT_AVAILABLE-5012]
-	_ = x[ERROR_GROUP_NOT_FOUND-5013]
-	_ = x[ERROR_GROUP_NOT_ONLINE-5014]
-	_ = x[ERROR_HOST_NODE_NOT_RESOURCE_OWNER-5015]
-	_ = x[ERROR_HOST_NODE_NOT_GROUP_OWNER-5016]
-	_ = x[ERROR_RESMON_CREATE_FAILED-5017]
-	_ = x[ERROR_RESMON_ONLINE_FAILED-5018]
-	_ = x[ERROR_RESOURCE_ONLINE-5019]
-	_ = x[ERROR_QUORUM_RESOURCE-5020]
-	_ = x[ERROR_NOT_QUORUM_CAPABLE-5021]
-	_ = x[ERROR_CLUSTER_SHUTTING_DOWN-5022]
-	_ = x[ERROR_INVALID_STATE-5023]
-	_ = x[ERROR_RESOURCE_PROPERTIES_STORED-5024]
-	_ = x[ERROR_NOT_QUORUM_CLASS-5025]
-	_ = x[ERROR_CORE_RESOURCE-5026]
-	_ = x[ERROR_QUORUM_RESOURCE_ONLINE_FAILED-5027]
-	_ = x[ERROR_QUORUMLOG_OPEN_FAILED-5028]
-	_ = x[ERROR_CLUSTERLOG_CORRUPT-5029]
-	_ = x[ERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZE-5030]
-	_ = x[ERROR_CLUSTERLOG_EXCEEDS_MAXSIZE-5031]
-	_ = x[ERROR_CLUSTERLOG_CHKPOINT_NOT_FOUND-5032]
-	_ = x[ERROR_CLUSTERLOG_NOT_ENOUGH_SPACE-5033]
-	_ = x[ERROR_ENCRYPTION_FAILED-6000]
-	_ = x[ERROR_DECRYPTION_FAILED-6001]
-	_ = x[ERROR_FILE_ENCRYPTED-6002]
-	_ = x[ERROR_NO_RECOVERY_POLICY-6003]
-	_ = x[ERROR_NO_EFS-6004]
-	_ = x[ERROR_WRONG_EFS-6005]
-	_ = x[ERROR_NO_USER_KEYS-6006]
-	_ = x[ERROR_FILE_NOT_ENCRYPTED-6007]
-	_ = x[ERROR_NOT_EXPORT_FORMAT-6008]
-	_ = x[E_UNEXPECTED-2147549183]
-	_ = x[E_NOTIMPL-2147500033]
-	_ = x[E_OUTOFMEMORY-2147942414]
-	_ = x[E_INVALIDARG-2147942487]
-	_ = x[E_NOINTERFACE-2147500034]
-	_ = x[E_POINTER-2147500035]
-	_ = x[E_HANDLE-2147942406]
-	_ = x[E_ABORT-2147500036]
-	_ = x[E_FAIL-2147500037]
-	_ = x[E_ACCESSDENIED-2147942405]
-	_ = x[E_PENDING-2147483658]
-	_ = x[CO_E_INIT_TLS-2147500038]
-	_ = x[CO_E_INIT_SHARED_ALLOCATOR-2147500039]
-	_ = x[CO_E_INIT_MEMORY_ALLOCATOR-2147500040]
-	_ = x[CO_E_INIT_CLASS_CACHE-2147500041]
-	_ = x[CO_E_INIT_RPC_CHANNEL-2147500042]
-	_ = x[CO_E_INIT_TLS_SET_CHANNEL_CONTROL-2147500043]
-	_ = x[CO_E_INIT_TLS_CHANNEL_CONTROL-2147500044]
-	_ = x[CO_E_INIT_UNACCEPTED_USER_ALLOCATOR-2147500045]
-	_ = x[CO_E_INIT_SCM_MUTEX_EXISTS-2147500046]
-	_ = x[CO_E_INIT_SCM_FILE_MAPPING_EXISTS-2147500047]
-	_ = x[CO_E_INIT_SCM_MAP_VIEW_OF_FILE-2147500048]
-	_ = x[CO_E_INIT_SCM_EXEC_FAILURE-2147500049]
-	_ = x[CO_E_INIT_ONLY_SINGLE_THREADED-2147500050]
-	_ = x[CO_E_CANT_REMOTE-2147500051]
-	_ = x[CO_E_BAD_SERVER_NAME-2147500052]
-	_ = x[CO_E_WRONG_SERVER_IDENTITY-2147500053]
-	_ = x[CO_E_OLE1DDE_DISABLED-2147500054]
-	_ = x[CO_E_RUNAS_SYNTAX-2147500055]
-	_ = x[CO_E_CREATEPROCESS_FAILURE-2147500056]
-	_ = x[CO_E_RUNAS_CREATEPROCESS_FAILURE-2147500057]
-	_ = x[CO_E_RUNAS_LOGON_FAILURE-2147500058]
-	_ = x[CO_E_LAUNCH_PERMSSION_DENIED-2147500059]
-	_ = x[CO_E_START_SERVICE_FAILURE-2147500060]
-	_ = x[CO_E_REMOTE_COMMUNICATION_FAILURE-2147500061]
-	_ = x[CO_E_SERVER_START_TIMEOUT-2147500062]
-	_ = x[CO_E_CLSREG_INCONSISTENT-2147500063]
-	_ = x[CO_E_IIDREG_INCONSISTENT-2147500064]
-	_ = x[CO_E_NOT_SUPPORTED-2147500065]
-	_ = x[CO_E_RELOAD_DLL-2147500066]
-	_ = x[CO_E_MSI_ERROR-2147500067]
-	_ = x[S_OK-0]
-	_ = x[S_FALSE-1]
-	_ = x[OLE_E_FIRST-2147745792]
-	_ = x[OLE_E_LAST-2147746047]
-	_ = x[OLE_S_FIRST-262144]
-	_ = x[OLE_S_LAST-262399]
-	_ = x[OLE_E_OLEVERB-2147745792]
-	_ = x[OLE_E_ADVF-2147745793]
-	_ = x[OLE_E_ENUM_NOMORE-2147745794]
-	_ = x[OLE_E_ADVISENOTSUPPORTED-2147745795]
-	_ = x[OLE_E_NOCONNECTION-2147745796]
-	_ = x[OLE_E_NOTRUNNING-2147745797]
-	_ = x[OLE_E_NOCACHE-2147745798]
-	_ = x[OLE_E_BLANK-2147745799]
-	_ = x[OLE_E_CLASSDIFF-2147745800]
-	_ = x[OLE_E_CANT_GETMONIKER-2147745801]
-	_ = x[OLE_E_CANT_BINDTOSOURCE-2147745802]
-	_ = x[OLE_E_STATIC-2147745803]
-	_ = x[OLE_E_PROMPTSAVECANCELLED-2147745804]
-	_ = x[OLE_E_INVALIDRECT-2147745805]
-	_ = x[OLE_E_WRONGCOMPOBJ-2147745806]
-	_ = x[OLE_E_INVALIDHWND-2147745807]
-	_ = x[OLE_E_NOT_INPLACEACTIVE-2147745808]
-	_ = x[OLE_E_CANTCONVERT-2147745809]
-	_ = x[OLE_E_NOSTORAGE-2147745810]
-	_ = x[DV_E_FORMATETC-2147745892]
-	_ = x[DV_E_DVTARGETDEVICE-2147745893]
-	_ = x[DV_E_STGMEDIUM-2147745894]
-	_ = x[DV_E_STATDATA-2147745895]
-	_ = x[DV_E_LINDEX-2147745896]
-	_ = x[DV_E_TYMED-2147745897]
-	_ = x[DV_E_CLIPFORMAT-2147745898]
-	_ = x[DV_E_DVASPECT-2147745899]
-	_ = x[DV_E_DVTARGETDEVICE_SIZE-2147745900]
-	_ = x[DV_E_NOIVIEWOBJECT-2147745901]
-	_ = x[DRAGDROP_E_FIRST-2147746048]
-	_ = x[DRAGDROP_E_LAST-2147746063]
-	_ = x[DRAGDROP_S_FIRST-262400]
-	_ = x[DRAGDROP_S_LAST-262415]
-	_ = x[DRAGDROP_E_NOTREGISTERED-2147746048]
-	_ = x[DRAGDROP_E_ALREADYREGISTERED-2147746049]
-	_ = x[DRAGDROP_E_INVALIDHWND-2147746050]
-	_ = x[CLASSFACTORY_E_FIRST-2147746064]
-	_ = x[CLASSFACTORY_E_LAST-2147746079]
-	_ = x[CLASSFACTORY_S_FIRST-262416]
-	_ = x[CLASSFACTORY_S_LAST-262431]
-	_ = x[CLASS_E_NOAGGREGATION-2147746064]
-	_ = x[CLASS_E_CLASSNOTAVAILABLE-2147746065]
-	_ = x[CLASS_E_NOTLICENSED-2147746066]
-	_ = x[MARSHAL_E_FIRST-2147746080]
-	_ = x[MARSHAL_E_LAST-2147746095]
-	_ = x[MARSHAL_S_FIRST-262432]
-	_ = x[MARSHAL_S_LAST-262447]
-	_ = x[DATA_E_FIRST-2147746096]
-	_ = x[DATA_E_LAST-2147746111]
-	_ = x[DATA_S_FIRST-262448]
-	_ = x[DATA_S_LAST-262463]
-	_ = x[VIEW_E_FIRST-2147746112]
-	_ = x[VIEW_E_LAST-2147746127]
-	_ = x[VIEW_S_FIRST-262464]
-	_ = x[VIEW_S_LAST-262479]
-	_ = x[VIEW_E_DRAW-2147746112]
-	_ = x[REGDB_E_FIRST-2147746128]
-	_ = x[REGDB_E_LAST-2147746143]
-	_ = x[REGDB_S_FIRST-262480]
-	_ = x[REGDB_S_LAST-262495]
-	_ = x[REGDB_E_READREGDB-2147746128]
-	_ = x[REGDB_E_WRITEREGDB-2147746129]
-	_ = x[REGDB_E_KEYMISSING-2147746130]
-	_ = x[REGDB_E_INVALIDVALUE-2147746131]
-	_ = x[REGDB_E_CLASSNOTREG-2147746132]
-	_ = x[REGDB_E_IIDNOTREG-2147746133]
-	_ = x[CAT_E_FIRST-2147746144]
-	_ = x[CAT_E_LAST-2147746145]
-	_ = x[CAT_E_CATIDNOEXIST-2147746144]
-	_ = x[CAT_E_NODESCRIPTION-2147746145]
-	_ = x[CS_E_FIRST-2147746148]
-	_ = x[CS_E_LAST-2147746152]
-	_ = x[CS_E_PACKAGE_NOTFOUND-2147746148]
-	_ = x[CS_E_NOT_DELETABLE-2147746149]
-	_ = x[CS_E_CLASS_NOTFOUND-2147746150]
-	_ = x[CS_E_INVALID_VERSION-2147746151]
-	_ = x[CS_E_NO_CLASSSTORE-2147746152]
-	_ = x[CACHE_E_FIRST-2147746160]
-	_ = x[CACHE_E_LAST-2147746175]
-	_ = x[CACHE_S_FIRST-262512]
-	_ = x[CACHE_S_LAST-262527]
-	_ = x[CACHE_E_NOCACHE_UPDATED-2147746160]
-	_ = x[OLEOBJ_E_FIRST-2147746176]
-	_ = x[OLEOBJ_E_LAST-2147746191]
-	_ = x[OLEOBJ_S_FIRST-262528]
-	_ = x[OLEOBJ_S_LAST-262543]
-	_ = x[OLEOBJ_E_NOVERBS-2147746176]
-	_ = x[OLEOBJ_E_INVALIDVERB-2147746177]
-	_ = x[CLIENTSITE_E_FIRST-2147746192]
-	_ = x[CLIENTSITE_E_LAST-2147746207]
-	_ = x[CLIENTSITE_S_FIRST-262544]
-	_ = x[CLIENTSITE_S_LAST-262559]
-	_ = x[INPLACE_E_NOTUNDOABLE-2147746208]
-	_ = x[INPLACE_E_NOTOOLSPACE-2147746209]
-	_ = x[INPLACE_E_FIRST-2147746208]
-	_ = x[INPLACE_E_LAST-2147746223]
-	_ = x[INPLACE_S_FIRST-262560]
-	_ = x[INPLACE_S_LAST-262575]
-	_ = x[ENUM_E_FIRST-2147746224]
-	_ = x[ENUM_E_LAST-2147746239]
-	_ = x[ENUM_S_FIRST-262576]
-	_ = x[ENUM_S_LAST-262591]
-	_ = x[CONVERT10_E_FIRST-2147746240]
-	_ = x[CONVERT10_E_LAST-2147746255]
-	_ = x[CONVERT10_S_FIRST-262592]
-	_ = x[CONVERT10_S_LAST-262607]
-	_ = x[CONVERT10_E_OLESTREAM_GET-2147746240]
-	_ = x[CONVERT10_E_OLESTREAM_PUT-2147746241]
-	_ = x[CONVERT10_E_OLESTREAM_FMT-2147746242]
-	_ = x[CONVERT10_E_OLESTREAM_BITMAP_TO_DIB-2147746243]
-	_ = x[CONVERT10_E_STG_FMT-2147746244]
-	_ = x[CONVERT10_E_STG_NO_STD_STREAM-2147746245]
-	_ = x[CONVERT10_E_STG_DIB_TO_BITMAP-2147746246]
-	_ = x[CLIPBRD_E_FIRST-2147746256]
-	_ = x[CLIPBRD_E_LAST-2147746271]
-	_ = x[CLIPBRD_S_FIRST-262608]
-	_ = x[CLIPBRD_S_LAST-262623]
-	_ = x[CLIPBRD_E_CANT_OPEN-2147746256]
-	_ = x[CLIPBRD_E_CANT_EMPTY-2147746257]
-	_ = x[CLIPBRD_E_CANT_SET-2147746258]
-	_ = x[CLIPBRD_E_BAD_DATA-2147746259]
-	_ = x[CLIPBRD_E_CANT_CLOSE-2147746260]
-	_ = x[MK_E_FIRST-2147746272]
-	_ = x[MK_E_LAST-2147746287]
-	_ = x[MK_S_FIRST-262624]
-	_ = x[MK_S_LAST-262639]
-	_ = x[MK_E_CONNECTMANUALLY-2147746272]
-	_ = x[MK_E_EXCEEDEDDEADLINE-2147746273]
-	_ = x[MK_E_NEEDGENERIC-2147746274]
-	_ = x[MK_E_UNAVAILABLE-2147746275]
-	_ = x[MK_E_SYNTAX-2147746276]
-	_ = x[MK_E_NOOBJECT-2147746277]
-	_ = x[MK_E_INVALIDEXTENSION-2147746278]
-	_ = x[MK_E_INTERMEDIATEINTERFACENOTSUPPORTED-2147746279]
-	_ = x[MK_E_NOTBINDABLE-2147746280]
-	_ = x[MK_E_NOTBOUND-2147746281]
-	_ = x[MK_E_CANTOPENFILE-2147746282]
-	_ = x[MK_E_MUSTBOTHERUSER-2147746283]
-	_ = x[MK_E_NOINVERSE-2147746284]
-	_ = x[MK_E_NOSTORAGE-2147746285]
-	_ = x[MK_E_NOPREFIX-2147746286]
-	_ = x[MK_E_ENUMERATION_FAILED-2147746287]
-	_ = x[CO_E_FIRST-2147746288]
-	_ = x[CO_E_LAST-2147746303]
-	_ = x[CO_S_FIRST-262640]
-	_ = x[CO_S_LAST-262655]
-	_ = x[CO_E_NOTINITIALIZED-2147746288]
-	_ = x[CO_E_ALREADYINITIALIZED-2147746289]
-	_ = x[CO_E_CANTDETERMINECLASS-2147746290]
-	_ = x[CO_E_CLASSSTRING-2147746291]
-	_ = x[CO_E_IIDSTRING-2147746292]
-	_ = x[CO_E_APPNOTFOUND-2147746293]
-	_ = x[CO_E_APPSINGLEUSE-2147746294]
-	_ = x[CO_E_ERRORINAPP-2147746295]
-	_ = x[CO_E_DLLNOTFOUND-2147746296]
-	_ = x[CO_E_ERRORINDLL-2147746297]
-	_ = x[CO_E_WRONGOSFORAPP-2147746298]
-	_ = x[CO_E_OBJNOTREG-2147746299]
-	_ = x[CO_E_OBJISREG-2147746300]
-	_ = x[CO_E_OBJNOTCONNECTED-2147746301]
-	_ = x[CO_E_APPDIDNTREG-2147746302]
-	_ = x[CO_E_RELEASED-2147746303]
-	_ = x[CO_E_FAILEDTOIMPERSONATE-2147746304]
-	_ = x[CO_E_FAILEDTOGETSECCTX-2147746305]
-	_ = x[CO_E_FAILEDTOOPENTHREADTOKEN-2147746306]
-	_ = x[CO_E_FAILEDTOGETTOKENINFO-2147746307]
-	_ = x[CO_E_TRUSTEEDOESNTMATCHCLIENT-2147746308]
-	_ = x[CO_E_FAILEDTOQUERYCLIENTBLANKET-2147746309]
-	_ = x[CO_E_FAILEDTOSETDACL-2147746310]
-	_ = x[CO_E_ACCESSCHECKFAILED-2147746311]
-	_ = x[CO_E_NETACCESSAPIFAILED-2147746312]
-	_ = x[CO_E_WRONGTRUSTEENAMESYNTAX-2147746313]
-	_ = x[CO_E_INVALIDSID-2147746314]
-	_ = x[CO_E_CONVERSIONFAILED-2147746315]
-	_ = x[CO_E_NOMATCHINGSIDFOUND-2147746316]
-	_ = x[CO_E_LOOKUPACCSIDFAILED-2147746317]
-	_ = x[CO_E_NOMATCHINGNAMEFOUND-2147746318]
-	_ = x[CO_E_LOOKUPACCNAMEFAILED-2147746319]
-	_ = x[CO_E_SETSERLHNDLFAILED-2147746320]
-	_ = x[CO_E_FAILEDTOGETWINDIR-2147746321]
-	_ = x[CO_E_PATHTOOLONG-2147746322]
-	_ = x[CO_E_FAILEDTOGENUUID-2147746323]
-	_ = x[CO_E_FAILEDTOCREATEFILE-2147746324]
-	_ = x[CO_E_FAILEDTOCLOSEHANDLE-2147746325]
-	_ = x[CO_E_EXCEEDSYSACLLIMIT-2147746326]
-	_ = x[CO_E_ACESINWRONGORDER-2147746327]
-	_ = x[CO_E_INCOMPATIBLESTREAMVERSION-2147746328]
-	_ = x[CO_E_FAILEDTOOPENPROCESSTOKEN-2147746329]
-	_ = x[CO_E_DECODEFAILED-2147746330]
-	_ = x[CO_E_ACNOTINITIALIZED-2147746331]
-	_ = x[OLE_S_USEREG-262144]
-	_ = x[OLE_S_STATIC-262145]
-	_ = x[OLE_S_MAC_CLIPFORMAT-262146]
-	_ = x[DRAGDROP_S_DROP-262400]
-	_ = x[DRAGDROP_S_CANCEL-262401]
-	_ = x[DRAGDROP_S_USEDEFAULTCURSORS-262402]
-	_ = x[DATA_S_SAMEFORMATETC-262448]
-	_ = x[VIEW_S_ALREADY_FROZEN-262464]
-	_ = x[CACHE_S_FORMATETC_NOTSUPPORTED-262512]
-	_ = x[CACHE_S_SAMECACHE-262513]
-	_ = x[CACHE_S_SOMECACHES_NOTUPDATED-262514]
-	_ = x[OLEOBJ_S_INVALIDVERB-262528]
-	_ = x[OLEOBJ_S_CANNOT_DOVERB_NOW-262529]
-	_ = x[OLEOBJ_S_INVALIDHWND-262530]
-	_ = x[INPLACE_S_TRUNCATED-262560]
-	_ = x[CONVERT10_S_NO_PRESENTATION-262592]
-	_ = x[MK_S_REDUCED_TO_SELF-262626]
-	_ = x[MK_S_ME-262628]
-	_ = x[MK_S_HIM-262629]
-	_ = x[MK_S_US-262630]
-	_ = x[MK_S_MONIKERALREADYREGISTERED-262631]
-	_ = x[CO_E_CLASS_CREATE_FAILED-2148007937]
-	_ = x[CO_E_SCM_ERROR-2148007938]
-	_ = x[CO_E_SCM_RPC_FAILURE-2148007939]
-	_ = x[CO_E_BAD_PATH-2148007940]
-	_ = x[CO_E_SERVER_EXEC_FAILURE-2148007941]
-	_ = x[CO_E_OBJSRV_RPC_FAILURE-2148007942]
-	_ = x[MK_E_NO_NORMALIZED-2148007943]
-	_ = x[CO_E_SERVER_STOPPING-2148007944]
-	_ = x[MEM_E_INVALID_ROOT-2148007945]
-	_ = x[MEM_E_INVALID_LINK-2148007952]
-	_ = x[MEM_E_INVALID_SIZE-2148007953]
-	_ = x[CO_S_NOTALLINTERFACES-524306]
-	_ = x[DISP_E_UNKNOWNINTERFACE-2147614721]
-	_ = x[DISP_E_MEMBERNOTFOUND-2147614723]
-	_ = x[DISP_E_PARAMNOTFOUND-2147614724]
-	_ = x[DISP_E_TYPEMISMATCH-2147614725]
-	_ = x[DISP_E_UNKNOWNNAME-2147614726]
-	_ = x[DISP_E_NONAMEDARGS-2147614727]
-	_ = x[DISP_E_BADVARTYPE-2147614728]
-	_ = x[DISP_E_EXCEPTION-2147614729]
-	_ = x[DISP_E_OVERFLOW-2147614730]
-	_ = x[DISP_E_BADINDEX-2147614731]
-	_ = x[DISP_E_UNKNOWNLCID-2147614732]
-	_ = x[DISP_E_ARRAYISLOCKED-2147614733]
-	_ = x[DISP_E_BADPARAMCOUNT-2147614734]
-	_ = x[DISP_E_PARAMNOTOPTIONAL-2147614735]
-	_ = x[DISP_E_BADCALLEE-2147614736]
-	_ = x[DISP_E_NOTACOLLECTION-2147614737]
-	_ = x[DISP_E_DIVBYZERO-2147614738]
-	_ = x[TYPE_E_BUFFERTOOSMALL-2147647510]
-	_ = x[TYPE_E_FIELDNOTFOUND-2147647511]
-	_ = x[TYPE_E_INVDATAREAD-2147647512]
-	_ = x[TYPE_E_UNSUPFORMAT-2147647513]
-	_ = x[TYPE_E_REGISTRYACCESS-2147647516]
-	_ = x[TYPE_E_LIBNOTREGISTERED-2147647517]
-	_ = x[TYPE_E_UNDEFINEDTYPE-2147647527]
-	_ = x[TYPE_E_QUALIFIEDNAMEDISALLOWED-2147647528]
-	_ = x[TYPE_E_INVALIDSTATE-2147647529]
-	_ = x[TYPE_E_WRONGTYPEKIND-2147647530]
-	_ = x[TYPE_E_ELEMENTNOTFOUND-2147647531]
-	_ = x[TYPE_E_AMBIGUOUSNAME-2147647532]
-	_ = x[TYPE_E_NAMECONFLICT-2147647533]
-	_ = x[TYPE_E_UNKNOWNLCID-2147647534]
-	_ = x[TYPE_E_DLLFUNCTIONNOTFOUND-2147647535]
-	_ = x[TYPE_E_BADMODULEKIND-2147649725]
-	_ = x[TYPE_E_SIZETOOBIG-2147649733]
-	_ = x[TYPE_E_DUPLICATEID-2147649734]
-	_ = x[TYPE_E_INVALIDID-2147649743]
-	_ = x[TYPE_E_TYPEMISMATCH-2147650720]
-	_ = x[TYPE_E_OUTOFBOUNDS-2147650721]
-	_ = x[TYPE_E_IOERROR-2147650722]
-	_ = x[TYPE_E_CANTCREATETMPFILE-2147650723]
-	_ = x[TYPE_E_CANTLOADLIBRARY-2147654730]
-	_ = x[TYPE_E_INCONSISTENTPROPFUNCS-2147654787]
-	_ = x[TYPE_E_CIRCULARTYPE-2147654788]
-	_ = x[STG_E_INVALIDFUNCTION-2147680257]
-	_ = x[STG_E_FILENOTFOUND-2147680258]
-	_ = x[STG_E_PATHNOTFOUND-2147680259]
-	_ = x[STG_E_TOOMANYOPENFILES-2147680260]
-	_ = x[STG_E_ACCESSDENIED-2147680261]
-	_ = x[STG_E_INVALIDHANDLE-2147680262]
-	_ = x[STG_E_INSUFFICIENTMEMORY-2147680264]
-	_ = x[STG_E_INVALIDPOINTER-2147680265]
-	_ = x[STG_E_NOMOREFILES-2147680274]
-	_ = x[STG_E_DISKISWRITEPROTECTED-2147680275]
-	_ = x[STG_E_SEEKERROR-2147680281]
-	_ = x[STG_E_WRITEFAULT-2147680285]
-	_ = x[STG_E_READFAULT-2147680286]
-	_ = x[STG_E_SHAREVIOLATION-2147680288]
-	_ = x[STG_E_LOCKVIOLATION-2147680289]
-	_ = x[STG_E_FILEALREADYEXISTS-2147680336]
-	_ = x[STG_E_INVALIDPARAMETER-2147680343]
-	_ = x[STG_E_MEDIUMFULL-2147680368]
-	_ = x[STG_E_PROPSETMISMATCHED-2147680496]
-	_ = x[STG_E_ABNORMALAPIEXIT-2147680506]
-	_ = x[STG_E_INVALIDHEADER-2147680507]
-	_ = x[STG_E_INVALIDNAME-2147680508]
-	_ = x[STG_E_UNKNOWN-2147680509]
-	_ = x[STG_E_UNIMPLEMENTEDFUNCTION-2147680510]
-	_ = x[STG_E_INVALIDFLAG-2147680511]
-	_ = x[STG_E_INUSE-2147680512]
-	_ = x[STG_E_NOTCURRENT-2147680513]
-	_ = x[STG_E_REVERTED-2147680514]
-	_ = x[STG_E_CANTSAVE-2147680515]
-	_ = x[STG_E_OLDFORMAT-2147680516]
-	_ = x[STG_E_OLDDLL-2147680517]
-	_ = x[STG_E_SHAREREQUIRED-2147680518]
-	_ = x[STG_E_NOTFILEBASEDSTORAGE-2147680519]
-	_ = x[STG_E_EXTANTMARSHALLINGS-2147680520]
-	_ = x[STG_E_DOCFILECORRUPT-2147680521]
-	_ = x[STG_E_BADBASEADDRESS-2147680528]
-	_ = x[STG_E_INCOMPLETE-2147680769]
-	_ = x[STG_E_TERMINATED-2147680770]
-	_ = x[STG_S_CONVERTED-197120]
-	_ = x[STG_S_BLOCK-197121]
-	_ = x[STG_S_RETRYNOW-197122]
-	_ = x[STG_S_MONITORING-197123]
-	_ = x[STG_S_MULTIPLEOPENS-197124]
-	_ = x[STG_S_CONSOLIDATIONFAILED-197125]
-	_ = x[STG_S_CANNOTCONSOLIDATE-197126]
-	_ = x[RPC_E_CALL_REJECTED-2147549185]
-	_ = x[RPC_E_CALL_CANCELED-2147549186]
-	_ = x[RPC_E_CANTPOST_INSENDCALL-2147549187]
-	_ = x[RPC_E_CANTCALLOUT_INASYNCCALL-2147549188]
-	_ = x[RPC_E_CANTCALLOUT_INEXTERNALCALL-2147549189]
-	_ = x[RPC_E_CONNECTION_TERMINATED-2147549190]
-	_ = x[RPC_E_SERVER_DIED-2147549191]
-	_ = x[RPC_E_CLIENT_DIED-2147549192]
-	_ = x[RPC_E_INVALID_DATAPACKET-2147549193]
-	_ = x[RPC_E_CANTTRANSMIT_CALL-2147549194]
-	_ = x[RPC_E_CLIENT_CANTMARSHAL_DATA-2147549195]
-	_ = x[RPC_E_CLIENT_CANTUNMARSHAL_DATA-2147549196]
-	_ = x[RPC_E_SERVER_CANTMARSHAL_DATA-2147549197]
-	_ = x[RPC_E_SERVER_CANTUNMARSHAL_DATA-2147549198]
-	_ = x[RPC_E_INVALID_DATA-2147549199]
-	_ = x[RPC_E_INVALID_PARAMETER-2147549200]
-	_ = x[RPC_E_CANTCALLOUT_AGAIN-2147549201]
-	_ = x[RPC_E_SERVER_DIED_DNE-2147549202]
-	_ = x[RPC_E_SYS_CALL_FAILED-2147549440]
-	_ = x[RPC_E_OUT_OF_RESOURCES-2147549441]
-	_ = x[RPC_E_ATTEMPTED_MULTITHREAD-2147549442]
-	_ = x[RPC_E_NOT_REGISTERED-2147549443]
-	_ = x[RPC_E_FAULT-2147549444]
-	_ = x[RPC_E_SERVERFAULT-2147549445]
-	_ = x[RPC_E_CHANGED_MODE-2147549446]
-	_ = x[RPC_E_INVALIDMETHOD-2147549447]
-	_ = x[RPC_E_DISCONNECTED-2147549448]
-	_ = x[RPC_E_RETRY-2147549449]
-	_ = x[RPC_E_SERVERCALL_RETRYLATER-2147549450]
-	_ = x[RPC_E_SERVERCALL_REJECTED-2147549451]
-	_ = x[RPC_E_INVALID_CALLDATA-2147549452]
-	_ = x[RPC_E_CANTCALLOUT_ININPUTSYNCCALL-2147549453]
-	_ = x[RPC_E_WRONG_THREAD-2147549454]
-	_ = x[RPC_E_THREAD_NOT_INIT-2147549455]
-	_ = x[RPC_E_VERSION_MISMATCH-2147549456]
-	_ = x[RPC_E_INVALID_HEADER-2147549457]
-	_ = x[RPC_E_INVALID_EXTENSION-2147549458]
-	_ = x[RPC_E_INVALID_IPID-2147549459]
-	_ = x[RPC_E_INVALID_OBJECT-2147549460]
-	_ = x[RPC_S_CALLPENDING-2147549461]
-	_ = x[RPC_S_WAITONTIMER-2147549462]
-	_ = x[RPC_E_CALL_COMPLETE-2147549463]
-	_ = x[RPC_E_UNSECURE_CALL-2147549464]
-	_ = x[RPC_E_TOO_LATE-2147549465]
-	_ = x[RPC_E_NO_GOOD_SECURITY_PACKAGES-2147549466]
-	_ = x[RPC_E_ACCESS_DENIED-2147549467]
-	_ = x[RPC_E_REMOTE_DISABLED-2147549468]
-	_ = x[RPC_E_INVALID_OBJREF-2147549469]
-	_ = x[RPC_E_NO_CONTEXT-2147549470]
-	_ = x[RPC_E_TIMEOUT-2147549471]
-	_ = x[RPC_E_NO_SYNC-2147549472]
-	_ = x[RPC_E_UNEXPECTED-2147614719]
-	_ = x[NTE_BAD_UID-2148073473]
-	_ = x[NTE_BAD_HASH-2148073474]
-	_ = x[NTE_BAD_KEY-2148073475]
-	_ = x[NTE_BAD_LEN-2148073476]
-	_ = x[NTE_BAD_DATA-2148073477]
-	_ = x[NTE_BAD_SIGNATURE-2148073478]
-	_ = x[NTE_BAD_VER-2148073479]
-	_ = x[NTE_BAD_ALGID-2148073480]
-	_ = x[NTE_BAD_FLAGS-2148073481]
-	_ = x[NTE_BAD_TYPE-2148073482]
-	_ = x[NTE_BAD_KEY_STATE-2148073483]
-	_ = x[NTE_BAD_HASH_STATE-2148073484]
-	_ = x[NTE_NO_KEY-2148073485]
-	_ = x[NTE_NO_MEMORY-2148073486]
-	_ = x[NTE_EXISTS-2148073487]
-	_ = x[NTE_PERM-2148073488]
-	_ = x[NTE_NOT_FOUND-2148073489]
-	_ = x[NTE_DOUBLE_ENCRYPT-2148073490]
-	_ = x[NTE_BAD_PROVIDER-2148073491]
-	_ = x[NTE_BAD_PROV_TYPE-2148073492]
-	_ = x[NTE_BAD_PUBLIC_KEY-2148073493]
-	_ = x[NTE_BAD_KEYSET-2148073494]
-	_ = x[NTE_PROV_TYPE_NOT_DEF-2148073495]
-	_ = x[NTE_PROV_TYPE_ENTRY_BAD-2148073496]
-	_ = x[NTE_KEYSET_NOT_DEF-2148073497]
-	_ = x[NTE_KEYSET_ENTRY_BAD-2148073498]
-	_ = x[NTE_PROV_TYPE_NO_MATCH-2148073499]
-	_ = x[NTE_SIGNATURE_FILE_BAD-2148073500]
-	_ = x[NTE_PROVIDER_DLL_FAIL-2148073501]
-	_ = x[NTE_PROV_DLL_NOT_FOUND-2148073502]
-	_ = x[NTE_BAD_KEYSET_PARAM-2148073503]
-	_ = x[NTE_FAIL-2148073504]
-	_ = x[NTE_SYS_ERR-2148073505]
-	_ = x[CRYPT_E_MSG_ERROR-2148077569]
-	_ = x[CRYPT_E_UNKNOWN_ALGO-2148077570]
-	_ = x[CRYPT_E_OID_FORMAT-2148077571]
-	_ = x[CRYPT_E_INVALID_MSG_TYPE-2148077572]
-	_ = x[CRYPT_E_UNEXPECTED_ENCODING-2148077573]
-	_ = x[CRYPT_E_AUTH_ATTR_MISSING-2148077574]
-	_ = x[CRYPT_E_HASH_VALUE-2148077575]
-	_ = x[CRYPT_E_INVALID_INDEX-2148077576]
-	_ = x[CRYPT_E_ALREADY_DECRYPTED-2148077577]
-	_ = x[CRYPT_E_NOT_DECRYPTED-2148077578]
-	_ = x[CRYPT_E_RECIPIENT_NOT_FOUND-2148077579]
-	_ = x[CRYPT_E_CONTROL_TYPE-2148077580]
-	_ = x[CRYPT_E_ISSUER_SERIALNUMBER-2148077581]
-	_ = x[CRYPT_E_SIGNER_NOT_FOUND-2148077582]
-	_ = x[CRYPT_E_ATTRIBUTES_MISSING-2148077583]
-	_ = x[CRYPT_E_STREAM_MSG_NOT_READY-2148077584]
-	_ = x[CRYPT_E_STREAM_INSUFFICIENT_DATA-2148077585]
-	_ = x[CRYPT_E_BAD_LEN-2148081665]
-	_ = x[CRYPT_E_BAD_ENCODE-2148081666]
-	_ = x[CRYPT_E_FILE_ERROR-2148081667]
-	_ = x[CRYPT_E_NOT_FOUND-2148081668]
-	_ = x[CRYPT_E_EXISTS-2148081669]
-	_ = x[CRYPT_E_NO_PROVIDER-2148081670]
-	_ = x[CRYPT_E_SELF_SIGNED-2148081671]
-	_ = x[CRYPT_E_DELETED_PREV-2148081672]
-	_ = x[CRYPT_E_NO_MATCH-2148081673]
-	_ = x[CRYPT_E_UNEXPECTED_MSG_TYPE-2148081674]
-	_ = x[CRYPT_E_NO_KEY_PROPERTY-2148081675]
-	_ = x[CRYPT_E_NO_DECRYPT_CERT-2148081676]
-	_ = x[CRYPT_E_BAD_MSG-2148081677]
-	_ = x[CRYPT_E_NO_SIGNER-2148081678]
-	_ = x[CRYPT_E_PENDING_CLOSE-2148081679]
-	_ = x[CRYPT_E_REVOKED-2148081680]
-	_ = x[CRYPT_E_NO_REVOCATION_DLL-2148081681]
-	_ = x[CRYPT_E_NO_REVOCATION_CHECK-2148081682]
-	_ = x[CRYPT_E_REVOCATION_OFFLINE-2148081683]
-	_ = x[CRYPT_E_NOT_IN_REVOCATION_DATABASE-2148081684]
-	_ = x[CRYPT_E_INVALID_NUMERIC_STRING-2148081696]
-	_ = x[CRYPT_E_INVALID_PRINTABLE_STRING-2148081697]
-	_ = x[CRYPT_E_INVALID_IA5_STRING-2148081698]
-	_ = x[CRYPT_E_INVALID_X500_STRING-2148081699]
-	_ = x[CRYPT_E_NOT_CHAR_STRING-2148081700]
-	_ = x[CRYPT_E_FILERESIZED-2148081701]
-	_ = x[CRYPT_E_SECURITY_SETTINGS-2148081702]
-	_ = x[CRYPT_E_NO_VERIFY_USAGE_DLL-2148081703]
-	_ = x[CRYPT_E_NO_VERIFY_USAGE_CHECK-2148081704]
-	_ = x[CRYPT_E_VERIFY_USAGE_OFFLINE-2148081705]
-	_ = x[CRYPT_E_NOT_IN_CTL-2148081706]
-	_ = x[CRYPT_E_NO_TRUSTED_SIGNER-2148081707]
-	_ = x[CRYPT_E_OSS_ERROR-2148085760]
-	_ = x[CERTSRV_E_BAD_REQUESTSUBJECT-2148089857]
-	_ = x[CERTSRV_E_NO_REQUEST-2148089858]
-	_ = x[CERTSRV_E_BAD_REQUESTSTATUS-2148089859]
-	_ = x[CERTSRV_E_PROPERTY_EMPTY-2148089860]
-	_ = x[CERTDB_E_JET_ERROR-2148093952]
-	_ = x[TRUST_E_SYSTEM_ERROR-2148098049]
-	_ = x[TRUST_E_NO_SIGNER_CERT-2148098050]
-	_ = x[TRUST_E_COUNTER_SIGNER-2148098051]
-	_ = x[TRUST_E_CERT_SIGNATURE-2148098052]
-	_ = x[TRUST_E_TIME_STAMP-2148098053]
-	_ = x[TRUST_E_BAD_DIGEST-2148098064]
-	_ = x[TRUST_E_BASIC_CONSTRAINTS-2148098073]
-	_ = x[TRUST_E_FINANCIAL_CRITERIA-2148098078]
-	_ = x[TRUST_E_PROVIDER_UNKNOWN-2148204545]
-	_ = x[TRUST_E_ACTION_UNKNOWN-2148204546]
-	_ = x[TRUST_E_SUBJECT_FORM_UNKNOWN-2148204547]
-	_ = x[TRUST_E_SUBJECT_NOT_TRUSTED-2148204548]
-	_ = x[DIGSIG_E_ENCODE-2148204549]
-	_ = x[DIGSIG_E_DECODE-2148204550]
-	_ = x[DIGSIG_E_EXTENSIBILITY-2148204551]
-	_ = x[DIGSIG_E_CRYPTO-2148204552]
-	_ = x[PERSIST_E_SIZEDEFINITE-2148204553]
-	_ = x[PERSIST_E_SIZEINDEFINITE-2148204554]
-	_ = x[PERSIST_E_NOTSELFSIZING-2148204555]
-	_ = x[TRUST_E_NOSIGNATURE-2148204800]
-	_ = x[CERT_E_EXPIRED-2148204801]
-	_ = x[CERT_E_VALIDITYPERIODNESTING-2148204802]
-	_ = x[CERT_E_ROLE-2148204803]
-	_ = x[CERT_E_PATHLENCONST-2148204804]
-	_ = x[CERT_E_CRITICAL-2148204805]
-	_ = x[CERT_E_PURPOSE-2148204806]
-	_ = x[CERT_E_ISSUERCHAINING-2148204807]
-	_ = x[CERT_E_MALFORMED-2148204808]
-	_ = x[CERT_E_UNTRUSTEDROOT-2148204809]
-	_ = x[CERT_E_CHAINING-2148204810]
-	_ = x[TRUST_E_FAIL-2148204811]
-	_ = x[CERT_E_REVOKED-2148204812]
-	_ = x[CERT_E_UNTRUSTEDTESTROOT-2148204813]
-	_ = x[CERT_E_REVOCATION_FAILURE-2148204814]
-	_ = x[CERT_E_CN_NO_MATCH-2148204815]
-	_ = x[CERT_E_WRONG_USAGE-2148204816]
-	_ = x[SPAPI_E_EXPECTED_SECTION_NAME-2148466688]
-	_ = x[SPAPI_E_BAD_SECTION_NAME_LINE-2148466689]
-	_ = x[SPAPI_E_SECTION_NAME_TOO_LONG-2148466690]
-	_ = x[SPAPI_E_GENERAL_SYNTAX-2148466691]
-	_ = x[SPAPI_E_WRONG_INF_STYLE-2148466944]
-	_ = x[SPAPI_E_SECTION_NOT_FOUND-2148466945]
-	_ = x[SPAPI_E_LINE_NOT_FOUND-2148466946]
-	_ = x[SPAPI_E_NO_ASSOCIATED_CLASS-2148467200]
-	_ = x[SPAPI_E_CLASS_MISMATCH-2148467201]
-	_ = x[SPAPI_E_DUPLICATE_FOUND-2148467202]
-	_ = x[SPAPI_E_NO_DRIVER_SELECTED-2148467203]
-	_ = x[SPAPI_E_KEY_DOES_NOT_EXIST-2148467204]
-	_ = x[SPAPI_E_INVALID_DEVINST_NAME-2148467205]
-	_ = x[SPAPI_E_INVALID_CLASS-2148467206]
-	_ = x[SPAPI_E_DEVINST_ALREADY_EXISTS-2148467207]
-	_ = x[SPAPI_E_DEVINFO_NOT_REGISTERED-2148467208]
-	_ = x[SPAPI_E_INVALID_REG_PROPERTY-2148467209]
-	_ = x[SPAPI_E_NO_INF-2148467210]
-	_ = x[SPAPI_E_NO_SUCH_DEVINST-2148467211]
-	_ = x[SPAPI_E_CANT_LOAD_CLASS_ICON-2148467212]
-	_ = x[SPAPI_E_INVALID_CLASS_INSTALLER-2148467213]
-	_ = x[SPAPI_E_DI_DO_DEFAULT-2148467214]
-	_ = x[SPAPI_E_DI_NOFILECOPY-2148467215]
-	_ = x[SPAPI_E_INVALID_HWPROFILE-2148467216]
-	_ = x[SPAPI_E_NO_DEVICE_SELECTED-2148467217]
-	_ = x[SPAPI_E_DEVINFO_LIST_LOCKED-2148467218]
-	_ = x[SPAPI_E_DEVINFO_DATA_LOCKED-2148467219]
-	_ = x[SPAPI_E_DI_BAD_PATH-2148467220]
-	_ = x[SPAPI_E_NO_CLASSINSTALL_PARAMS-2148467221]
-	_ = x[SPAPI_E_FILEQUEUE_LOCKED-2148467222]
-	_ = x[SPAPI_E_BAD_SERVICE_INSTALLSECT-2148467223]
-	_ = x[SPAPI_E_NO_CLASS_DRIVER_LIST-2148467224]
-	_ = x[SPAPI_E_NO_ASSOCIATED_SERVICE-2148467225]
-	_ = x[SPAPI_E_NO_DEFAULT_DEVICE_INTERFACE-2148467226]
-	_ = x[SPAPI_E_DEVICE_INTERFACE_ACTIVE-2148467227]
-	_ = x[SPAPI_E_DEVICE_INTERFACE_REMOVED-2148467228]
-	_ = x[SPAPI_E_BAD_INTERFACE_INSTALLSECT-2148467229]
-	_ = x[SPAPI_E_NO_SUCH_INTERFACE_CLASS-2148467230]
-	_ = x[SPAPI_E_INVALID_REFERENCE_STRING-2148467231]
-	_ = x[SPAPI_E_INVALID_MACHINENAME-2148467232]
-	_ = x[SPAPI_E_REMOTE_COMM_FAILURE-2148467233]
-	_ = x[SPAPI_E_MACHINE_UNAVAILABLE-2148467234]
-	_ = x[SPAPI_E_NO_CONFIGMGR_SERVICES-2148467235]
-	_ = x[SPAPI_E_INVALID_PROPPAGE_PROVIDER-2148467236]
-	_ = x[SPAPI_E_NO_SUCH_DEVICE_INTERFACE-2148467237]
-	_ = x[SPAPI_E_DI_POSTPROCESSING_REQUIRED-2148467238]
-	_ = x[SPAPI_E_INVALID_COINSTALLER-2148467239]
-	_ = x[SPAPI_E_NO_COMPAT_DRIVERS-2148467240]
-	_ = x[SPAPI_E_NO_DEVICE_ICON-2148467241]
-	_ = x[SPAPI_E_INVALID_INF_LOGCONFIG-2148467242]
-	_ = x[SPAPI_E_DI_DONT_INSTALL-2148467243]
-	_ = x[SPAPI_E_INVALID_FILTER_DRIVER-2148467244]
-	_ = x[SPAPI_E_ERROR_NOT_INSTALLED-2148470784]
-}
-
-const _HRESULT_name = "ERROR_SUCCESSERROR_INVALID_FUNCTIONERROR_FILE_NOT_FOUNDERROR_PATH_NOT_FOUNDERROR_TOO_MANY_OPEN_FILESERROR_ACCESS_DENIEDERROR_INVALID_HANDLEERROR_ARENA_TRASHEDERROR_NOT_ENOUGH_MEMORYERROR_INVALID_BLOCKERROR_BAD_ENVIRONMENTERROR_BAD_FORMATERROR_INVALID_ACCESSERROR_INVALID_DATAERROR_OUTOFMEMORYERROR_INVALID_DRIVEERROR_CURRENT_DIRECTORYERROR_NOT_SAME_DEVICEERROR_NO_MORE_FILESERROR_WRITE_PROTECTERROR_BAD_UNITERROR_NOT_READYERROR_BAD_COMMANDERROR_CRCERROR_BAD_LENGTHERROR_SEEKERROR_NOT_DOS_DISKERROR_SECTOR_NOT_FOUNDERROR_OUT_OF_PAPERERROR_WRITE_FAULTERROR_READ_FAULTERROR_GEN_FAILUREERROR_SHARING_VIOLATIONERROR_LOCK_VIOLATIONERROR_WRONG_DISKERROR_SHARING_BUFFER_EXCEEDEDERROR_HANDLE_EOFERROR_HANDLE_DISK_FULLERROR_NOT_SUPPORTEDERROR_REM_NOT_LISTERROR_DUP_NAMEERROR_BAD_NETPATHERROR_NETWORK_BUSYERROR_DEV_NOT_EXISTERROR_TOO_MANY_CMDSERROR_ADAP_HDW_ERRERROR_BAD_NET_RESPERROR_UNEXP_NET_ERRERROR_BAD_REM_ADAPERROR_PRINTQ_FULLERROR_NO_SPOOL_SPACEERROR_PRINT_CANCELLEDERROR_NETNAME_DELETEDERROR_NETWORK_ACCESS_DENIEDERROR_BAD_DEV_TYPEERROR_BAD_NET_NAMEERROR_TOO_MANY_NAMESERROR_TOO_MANY_SESSERROR_SHARING_PAUSEDERROR_REQ_NOT_ACCEPERROR_REDIR_PAUSEDERROR_FILE_EXISTSERROR_CANNOT_MAKEERROR_FAIL_I24ERROR_OUT_OF_STRUCTURESERROR_ALREADY_ASSIGNEDERROR_INVALID_PASSWORDERROR_INVALID_PARAMETERERROR_NET_WRITE_FAULTERROR_NO_PROC_SLOTSERROR_TOO_MANY_SEMAPHORESERROR_EXCL_SEM_ALREADY_OWNEDERROR_SEM_IS_SETERROR_TOO_MANY_SEM_REQUESTSERROR_INVALID_AT_INTERRUPT_TIMEERROR_SEM_OWNER_DIEDERROR_SEM_USER_LIMITERROR_DISK_CHANGEERROR_DRIVE_LOCKEDERROR_BROKEN_PIPEERROR_OPEN_FAILEDERROR_BUFFER_OVERFLOWERROR_DISK_FULLERROR_NO_MORE_SEARCH_HANDLESERROR_INVALID_TARGET_HANDLEERROR_INVALID_CATEGORYERROR_INVALID_VERIFY_SWITCHERROR_BAD_DRIVER_LEVELERROR_CALL_NOT_IMPLEMENTEDERROR_SEM_TIMEOUTERROR_INSUFFICIENT_BUFFERERROR_INVALID_NAMEERROR_INVALID_LEVELERROR_NO_VOLUME_LABELERROR_MOD_NOT_FOUNDERROR_PROC_NOT_FOUNDERROR_WAIT_NO_CHILDRENERROR_CHILD_NOT_COMPLETEERROR_DIRECT_ACCESS_HANDLEERROR_NEGATIVE_SEEKERROR_SEEK_ON_DEVICEERROR_IS_JOIN_TARGETERROR_IS_JOINEDERROR_IS_SUBSTEDERROR_NOT_JOINEDERROR_NOT_SUBSTEDERROR_JOIN_TO_JOINERROR_SUBST_TO_SUBSTERROR_JOIN_TO_SUBSTERROR_SUBST_TO_JOINERROR_BUSY_DRIVEERROR_SAME_DRIVEERROR_DIR_NOT_ROOTERROR_DIR_NOT_EMPTYERROR_IS_SUBST_PATHERROR_IS_JOIN_PATHERROR_PATH_BUSYERROR_IS_SUBST_TARGETERROR_SYSTEM_TRACEERROR_INVALID_EVENT_COUNTERROR_TOO_MANY_MUXWAITERSERROR_INVALID_LIST_FORMATERROR_LABEL_TOO_LONGERROR_TOO_MANY_TCBSERROR_SIGNAL_REFUSEDERROR_DISCARDEDERROR_NOT_LOCKEDERROR_BAD_THREADID_ADDRERROR_BAD_ARGUMENTSERROR_BAD_PATHNAMEERROR_SIGNAL_PENDINGERROR_MAX_THRDS_REACHEDERROR_LOCK_FAILEDERROR_BUSYERROR_CANCEL_VIOLATIONERROR_ATOMIC_LOCKS_NOT_SUPPORTEDERROR_INVALID_SEGMENT_NUMBERERROR_INVALID_ORDINALERROR_ALREADY_EXISTSERROR_INVALID_FLAG_NUMBERERROR_SEM_NOT_FOUNDERROR_INVALID_STARTING_CODESEGERROR_INVALID_STACKSEGERROR_INVALID_MODULETYPEERROR_INVALID_EXE_SIGNATUREERROR_EXE_MARKED_INVALIDERROR_BAD_EXE_FORMATERROR_ITERATED_DATA_EXCEEDS_64kERROR_INVALID_MINALLOCSIZEERROR_DYNLINK_FROM_INVALID_RINGERROR_IOPL_NOT_ENABLEDERROR_INVALID_SEGDPLERROR_AUTODATASEG_EXCEEDS_64kERROR_RING2SEG_MUST_BE_MOVABLEERROR_RELOC_CHAIN_XEEDS_SEGLIMERROR_INFLOOP_IN_RELOC_CHAINERROR_ENVVAR_NOT_FOUNDERROR_NO_SIGNAL_SENTERROR_FILENAME_EXCED_RANGEERROR_RING2_STACK_IN_USEERROR_META_EXPANSION_TOO_LONGERROR_INVALID_SIGNAL_NUMBERERROR_THREAD_1_INACTIVEERROR_LOCKEDERROR_TOO_MANY_MODULESERROR_NESTING_NOT_ALLOWEDERROR_EXE_MACHINE_TYPE_MISMATCHERROR_BAD_PIPEERROR_PIPE_BUSYERROR_NO_DATAERROR_PIPE_NOT_CONNECTEDERROR_MORE_DATAERROR_VC_DISCONNECTEDERROR_INVALID_EA_NAMEERROR_EA_LIST_INCONSISTENTERROR_NO_MORE_ITEMSERROR_CANNOT_COPYERROR_DIRECTORYERROR_EAS_DIDNT_FITERROR_EA_FILE_CORRUPTERROR_EA_TABLE_FULLERROR_INVALID_EA_HANDLEERROR_EAS_NOT_SUPPORTEDERROR_NOT_OWNERERROR_TOO_MANY_POSTSERROR_PARTIAL_COPYERROR_OPLOCK_NOT_GRANTEDERROR_INVALID_OPLOCK_PROTOCOLERROR_MR_MID_NOT_FOUNDERROR_INVALID_ADDRESSERROR_ARITHMETIC_OVERFLOWERROR_PIPE_CONNECTEDERROR_PIPE_LISTENINGERROR_EA_ACCESS_DENIEDERROR_OPERATION_ABORTEDERROR_IO_INCOMPLETEERROR_IO_PENDINGERROR_NOACCESSERROR_SWAPERRORERROR_STACK_OVERFLOWERROR_INVALID_MESSAGEERROR_CAN_NOT_COMPLETEERROR_INVALID_FLAGSERROR_UNRECOGNIZED_VOLUMEERROR_FILE_INVALIDERROR_FULLSCREEN_MODEERROR_NO_TOKENERROR_BADDBERROR_BADKEYERROR_CANTOPENERROR_CANTREADERROR_CANTWRITEERROR_REGISTRY_RECOVEREDERROR_REGISTRY_CORRUPTERROR_REGISTRY_IO_FAILEDERROR_NOT_REGISTRY_FILEERROR_KEY_DELETEDERROR_NO_LOG_SPACEERROR_KEY_HAS_CHILDRENERROR_CHILD_MUST_BE_VOLATILEERROR_NOTIFY_ENUM_DIRERROR_DEPENDENT_SERVICES_RUNNINGERROR_INVALID_SERVICE_CONTROLERROR_SERVICE_REQUEST_TIMEOUTERROR_SERVICE_NO_THREADERROR_SERVICE_DATABASE_LOCKEDERROR_SERVICE_ALREADY_RUNNINGERROR_INVALID_SERVICE_ACCOUNTERROR_SERVICE_DISABLEDERROR_CIRCULAR_DEPENDENCYERROR_SERVICE_DOES_NOT_EXISTERROR_SERVICE_CANNOT_ACCEPT_CTRLERROR_SERVICE_NOT_ACTIVEERROR_FAILED_SERVICE_CONTROLLER_CONNECTERROR_EXCEPTION_IN_SERVICEERROR_DATABASE_DOES_NOT_EXISTERROR_SERVICE_SPECIFIC_ERRORERROR_PROCESS_ABORTEDERROR_SERVICE_DEPENDENCY_FAILERROR_SERVICE_LOGON_FAILEDERROR_SERVICE_START_HANGERROR_INVALID_SERVICE_LOCKERROR_SERVICE_MARKED_FOR_DELETEERROR_SERVICE_EXISTSERROR_ALREADY_RUNNING_LKGERROR_SERVICE_DEPENDENCY_DELETEDERROR_BOOT_ALREADY_ACCEPTEDERROR_SERVICE_NEVER_STARTEDERROR_DUPLICATE_SERVICE_NAMEERROR_DIFFERENT_SERVICE_ACCOUNTERROR_CANNOT_DETECT_DRIVER_FAILUREERROR_CANNOT_DETECT_PROCESS_ABORTERROR_NO_RECOVERY_PROGRAMERROR_END_OF_MEDIAERROR_FILEMARK_DETECTEDERROR_BEGINNING_OF_MEDIAERROR_SETMARK_DETECTEDERROR_NO_DATA_DETECTEDERROR_PARTITION_FAILUREERROR_INVALID_BLOCK_LENGTHERROR_DEVICE_NOT_PARTITIONEDERROR_UNABLE_TO_LOCK_MEDIAERROR_UNABLE_TO_UNLOAD_MEDIAERROR_MEDIA_CHANGEDERROR_BUS_RESETERROR_NO_MEDIA_IN_DRIVEERROR_NO_UNICODE_TRANSLATIONERROR_DLL_INIT_FAILEDERROR_SHUTDOWN_IN_PROGRESSERROR_NO_SHUTDOWN_IN_PROGRESSERROR_IO_DEVICEERROR_SERIAL_NO_DEVICEERROR_IRQ_BUSYERROR_MORE_WRITESERROR_COUNTER_TIMEOUTERROR_FLOPPY_ID_MARK_NOT_FOUNDERROR_FLOPPY_WRONG_CYLINDERERROR_FLOPPY_UNKNOWN_ERRORERROR_FLOPPY_BAD_REGISTERSERROR_DISK_RECALIBRATE_FAILEDERROR_DISK_OPERATION_FAILEDERROR_DISK_RESET_FAILEDERROR_EOM_OVERFLOWERROR_NOT_ENOUGH_SERVER_MEMORYERROR_POSSIBLE_DEADLOCKERROR_MAPPED_ALIGNMENTERROR_SET_POWER_STATE_VETOEDERROR_SET_POWER_STATE_FAILEDERROR_TOO_MANY_LINKSERROR_OLD_WIN_VERSIONERROR_APP_WRONG_OSERROR_SINGLE_INSTANCE_APPERROR_RMODE_APPERROR_INVALID_DLLERROR_NO_ASSOCIATIONERROR_DDE_FAILERROR_DLL_NOT_FOUNDERROR_NO_MORE_USER_HANDLESERROR_MESSAGE_SYNC_ONLYERROR_SOURCE_ELEMENT_EMPTYERROR_DESTINATION_ELEMENT_FULLERROR_ILLEGAL_ELEMENT_ADDRESSERROR_MAGAZINE_NOT_PRESENTERROR_DEVICE_REINITIALIZATION_NEEDEDERROR_DEVICE_REQUIRES_CLEANINGERROR_DEVICE_DOOR_OPENERROR_DEVICE_NOT_CONNECTEDERROR_NOT_FOUNDERROR_NO_MATCHERROR_SET_NOT_FOUNDERROR_POINT_NOT_FOUNDERROR_NO_TRACKING_SERVICEERROR_NO_VOLUME_IDERROR_BAD_DEVICEERROR_CONNECTION_UNAVAILERROR_DEVICE_ALREADY_REMEMBEREDERROR_NO_NET_OR_BAD_PATHERROR_BAD_PROVIDERERROR_CANNOT_OPEN_PROFILEERROR_BAD_PROFILEERROR_NOT_CONTAINERERROR_EXTENDED_ERRORERROR_INVALID_GROUPNAMEERROR_INVALID_COMPUTERNAMEERROR_INVALID_EVENTNAMEERROR_INVALID_DOMAINNAMEERROR_INVALID_SERVICENAMEERROR_INVALID_NETNAMEERROR_INVALID_SHARENAMEERROR_INVALID_PASSWORDNAMEERROR_INVALID_MESSAGENAMEERROR_INVALID_MESSAGEDESTERROR_SESSION_CREDENTIAL_CONFLICTERROR_REMOTE_SESSION_LIMIT_EXCEEDEDERROR_DUP_DOMAINNAMEERROR_NO_NETWORKERROR_CANCELLEDERROR_USER_MAPPED_FILEERROR_CONNECTION_REFUSEDERROR_GRACEFUL_DISCONNECTERROR_ADDRESS_ALREADY_ASSOCIATEDERROR_ADDRESS_NOT_ASSOCIATEDERROR_CONNECTION_INVALIDERROR_CONNECTION_ACTIVEERROR_NETWORK_UNREACHABLEERROR_HOST_UNREACHABLEERROR_PROTOCOL_UNREACHABLEERROR_PORT_UNREACHABLEERROR_REQUEST_ABORTEDERROR_CONNECTION_ABORTEDERROR_RETRYERROR_CONNECTION_COUNT_LIMITERROR_LOGIN_TIME_RESTRICTIONERROR_LOGIN_WKSTA_RESTRICTIONERROR_INCORRECT_ADDRESSERROR_ALREADY_REGISTEREDERROR_SERVICE_NOT_FOUNDERROR_NOT_AUTHENTICATEDERROR_NOT_LOGGED_ONERROR_CONTINUEERROR_ALREADY_INITIALIZEDERROR_NO_MORE_DEVICESERROR_NO_SUCH_SITEERROR_DOMAIN_CONTROLLER_EXISTSERROR_DS_NOT_INSTALLEDERROR_NOT_ALL_ASSIGNEDERROR_SOME_NOT_MAPPEDERROR_NO_QUOTAS_FOR_ACCOUNTERROR_LOCAL_USER_SESSION_KEYERROR_NULL_LM_PASSWORDERROR_UNKNOWN_REVISIONERROR_REVISION_MISMATCHERROR_INVALID_OWNERERROR_INVALID_PRIMARY_GROUPERROR_NO_IMPERSONATION_TOKENERROR_CANT_DISABLE_MANDATORYERROR_NO_LOGON_SERVERSERROR_NO_SUCH_LOGON_SESSIONERROR_NO_SUCH_PRIVILEGEERROR_PRIVILEGE_NOT_HELDERROR_INVALID_ACCOUNT_NAMEERROR_USER_EXISTSERROR_NO_SUCH_USERERROR_GROUP_EXISTSERROR_NO_SUCH_GROUPERROR_MEMBER_IN_GROUPERROR_MEMBER_NOT_IN_GROUPERROR_LAST_ADMINERROR_WRONG_PASSWORDERROR_ILL_FORMED_PASSWORDERROR_PASSWORD_RESTRICTIONERROR_LOGON_FAILUREERROR_ACCOUNT_RESTRICTIONERROR_INVALID_LOGON_HOURSERROR_INVALID_WORKSTATIONERROR_PASSWORD_EXPIREDERROR_ACCOUNT_DISABLEDERROR_NONE_MAPPEDERROR_TOO_MANY_LUIDS_REQUESTEDERROR_LUIDS_EXHAUSTEDERROR_INVALID_SUB_AUTHORITYERROR_INVALID_ACLERROR_INVALID_SIDERROR_INVALID_SECURITY_DESCRERROR_BAD_INHERITANCE_ACLERROR_SERVER_DISABLEDERROR_SERVER_NOT_DISABLEDERROR_INVALID_ID_AUTHORITYERROR_ALLOTTED_SPACE_EXCEEDEDERROR_INVALID_GROUP_ATTRIBUTESERROR_BAD_IMPERSONATION_LEVELERROR_CANT_OPEN_ANONYMOUSERROR_BAD_VALIDATION_CLASSERROR_BAD_TOKEN_TYPEERROR_NO_SECURITY_ON_OBJECTERROR_CANT_ACCESS_DOMAIN_INFOERROR_INVALID_SERVER_STATEERROR_INVALID_DOMAIN_STATEERROR_INVALID_DOMAIN_ROLEERROR_NO_SUCH_DOMAINERROR_DOMAIN_EXISTSERROR_DOMAIN_LIMIT_EXCEEDEDERROR_INTERNAL_DB_CORRUPTIONERROR_INTERNAL_ERRORERROR_GENERIC_NOT_MAPPEDERROR_BAD_DESCRIPTOR_FORMATERROR_NOT_LOGON_PROCESSERROR_LOGON_SESSION_EXISTSERROR_NO_SUCH_PACKAGEERROR_BAD_LOGON_SESSION_STATEERROR_LOGON_SESSION_COLLISIONERROR_INVALID_LOGON_TYPEERROR_CANNOT_IMPERSONATEERROR_RXACT_INVALID_STATEERROR_RXACT_COMMIT_FAILUREERROR_SPECIAL_ACCOUNTERROR_SPECIAL_GROUPERROR_SPECIAL_USERERROR_MEMBERS_PRIMARY_GROUPERROR_TOKEN_ALREADY_IN_USEERROR_NO_SUCH_ALIASERROR_MEMBER_NOT_IN_ALIASERROR_MEMBER_IN_ALIASERROR_ALIAS_EXISTSERROR_LOGON_NOT_GRANTEDERROR_TOO_MANY_SECRETSERROR_SECRET_TOO_LONGERROR_INTERNAL_DB_ERRORERROR_TOO_MANY_CONTEXT_IDSERROR_LOGON_TYPE_NOT_GRANTEDERROR_NT_CROSS_ENCRYPTION_REQUIREDERROR_NO_SUCH_MEMBERERROR_INVALID_MEMBERERROR_TOO_MANY_SIDSERROR_LM_CROSS_ENCRYPTION_REQUIREDERROR_NO_INHERITANCEERROR_FILE_CORRUPTERROR_DISK_CORRUPTERROR_NO_USER_SESSION_KEYERROR_LICENSE_QUOTA_EXCEEDEDERROR_INVALID_WINDOW_HANDLEERROR_INVALID_MENU_HANDLEERROR_INVALID_CURSOR_HANDLEERROR_INVALID_ACCEL_HANDLEERROR_INVALID_HOOK_HANDLEERROR_INVALID_DWP_HANDLEERROR_TLW_WITH_WSCHILDERROR_CANNOT_FIND_WND_CLASSERROR_WINDOW_OF_OTHER_THREADERROR_HOTKEY_ALREADY_REGISTEREDERROR_CLASS_ALREADY_EXISTSERROR_CLASS_DOES_NOT_EXISTERROR_CLASS_HAS_WINDOWSERROR_INVALID_INDEXERROR_INVALID_ICON_HANDLEERROR_PRIVATE_DIALOG_INDEXERROR_LISTBOX_ID_NOT_FOUNDERROR_NO_WILDCARD_CHARACTERSERROR_CLIPBOARD_NOT_OPENERROR_HOTKEY_NOT_REGISTEREDERROR_WINDOW_NOT_DIALOGERROR_CONTROL_ID_NOT_FOUNDERROR_INVALID_COMBOBOX_MESSAGEERROR_WINDOW_NOT_COMBOBOXERROR_INVALID_EDIT_HEIGHTERROR_DC_NOT_FOUNDERROR_INVALID_HOOK_FILTERERROR_INVALID_FILTER_PROCERROR_HOOK_NEEDS_HMODERROR_GLOBAL_ONLY_HOOKERROR_JOURNAL_HOOK_SETERROR_HOOK_NOT_INSTALLEDERROR_INVALID_LB_MESSAGEERROR_SETCOUNT_ON_BAD_LBERROR_LB_WITHOUT_TABSTOPSERROR_DESTROY_OBJECT_OF_OTHER_THREADERROR_CHILD_WINDOW_MENUERROR_NO_SYSTEM_MENUERROR_INVALID_MSGBOX_STYLEERROR_INVALID_SPI_VALUEERROR_SCREEN_ALREADY_LOCKEDERROR_HWNDS_HAVE_DIFF_PARENTERROR_NOT_CHILD_WINDOWERROR_INVALID_GW_COMMANDERROR_INVALID_THREAD_IDERROR_NON_MDICHILD_WINDOWERROR_POPUP_ALREADY_ACTIVEERROR_NO_SCROLLBARSERROR_INVALID_SCROLLBAR_RANGEERROR_INVALID_SHOWWIN_COMMANDERROR_NO_SYSTEM_RESOURCESERROR_NONPAGED_SYSTEM_RESOURCESERROR_PAGED_SYSTEM_RESOURCESERROR_WORKING_SET_QUOTAERROR_PAGEFILE_QUOTAERROR_COMMITMENT_LIMITERROR_MENU_ITEM_NOT_FOUNDERROR_INVALID_KEYBOARD_HANDLEERROR_HOOK_TYPE_NOT_ALLOWEDERROR_REQUIRES_INTERACTIVE_WINDOWSTATIONERROR_TIMEOUTERROR_INVALID_MONITOR_HANDLEERROR_EVENTLOG_FILE_CORRUPTERROR_EVENTLOG_CANT_STARTERROR_LOG_FILE_FULLERROR_EVENTLOG_FILE_CHANGEDERROR_INSTALL_SERVICEERROR_INSTALL_USEREXITERROR_INSTALL_FAILUREERROR_INSTALL_SUSPENDERROR_UNKNOWN_PRODUCTERROR_UNKNOWN_FEATUREERROR_UNKNOWN_COMPONENTERROR_UNKNOWN_PROPERTYERROR_INVALID_HANDLE_STATEERROR_BAD_CONFIGURATIONERROR_INDEX_ABSENTERROR_INSTALL_SOURCE_ABSENTERROR_BAD_DATABASE_VERSIONERROR_PRODUCT_UNINSTALLEDERROR_BAD_QUERY_SYNTAXERROR_INVALID_FIELDRPC_S_INVALID_STRING_BINDINGRPC_S_WRONG_KIND_OF_BINDINGRPC_S_INVALID_BINDINGRPC_S_PROTSEQ_NOT_SUPPORTEDRPC_S_INVALID_RPC_PROTSEQRPC_S_INVALID_STRING_UUIDRPC_S_INVALID_ENDPOINT_FORMATRPC_S_INVALID_NET_ADDRRPC_S_NO_ENDPOINT_FOUNDRPC_S_INVALID_TIMEOUTRPC_S_OBJECT_NOT_FOUNDRPC_S_ALREADY_REGISTEREDRPC_S_TYPE_ALREADY_REGISTEREDRPC_S_ALREADY_LISTENINGRPC_S_NO_PROTSEQS_REGISTEREDRPC_S_NOT_LISTENINGRPC_S_UNKNOWN_MGR_TYPERPC_S_UNKNOWN_IFRPC_S_NO_BINDINGSRPC_S_NO_PROTSEQSRPC_S_CANT_CREATE_ENDPOINTRPC_S_OUT_OF_RESOURCESRPC_S_SERVER_UNAVAILABLERPC_S_SERVER_TOO_BUSYRPC_S_INVALID_NETWORK_OPTIONSRPC_S_NO_CALL_ACTIVERPC_S_CALL_FAILEDRPC_S_CALL_FAILED_DNERPC_S_PROTOCOL_ERRORRPC_S_UNSUPPORTED_TRANS_SYNRPC_S_UNSUPPORTED_TYPERPC_S_INVALID_TAGRPC_S_INVALID_BOUNDRPC_S_NO_ENTRY_NAMERPC_S_INVALID_NAME_SYNTAXRPC_S_UNSUPPORTED_NAME_SYNTAXRPC_S_UUID_NO_ADDRESSRPC_S_DUPLICATE_ENDPOINTRPC_S_UNKNOWN_AUTHN_TYPERPC_S_MAX_CALLS_TOO_SMALLRPC_S_STRING_TOO_LONGRPC_S_PROTSEQ_NOT_FOUNDRPC_S_PROCNUM_OUT_OF_RANGERPC_S_BINDING_HAS_NO_AUTHRPC_S_UNKNOWN_AUTHN_SERVICERPC_S_UNKNOWN_AUTHN_LEVELRPC_S_INVALID_AUTH_IDENTITYRPC_S_UNKNOWN_AUTHZ_SERVICEEPT_S_INVALID_ENTRYEPT_S_CANT_PERFORM_OPEPT_S_NOT_REGISTEREDRPC_S_NOTHING_TO_EXPORTRPC_S_INCOMPLETE_NAMERPC_S_INVALID_VERS_OPTIONRPC_S_NO_MORE_MEMBERSRPC_S_NOT_ALL_OBJS_UNEXPORTEDRPC_S_INTERFACE_NOT_FOUNDRPC_S_ENTRY_ALREADY_EXISTSRPC_S_ENTRY_NOT_FOUNDRPC_S_NAME_SERVICE_UNAVAILABLERPC_S_INVALID_NAF_IDRPC_S_CANNOT_SUPPORTRPC_S_NO_CONTEXT_AVAILABLERPC_S_INTERNAL_ERRORRPC_S_ZERO_DIVIDERPC_S_ADDRESS_ERRORRPC_S_FP_DIV_ZERORPC_S_FP_UNDERFLOWRPC_S_FP_OVERFLOWRPC_X_NO_MORE_ENTRIESRPC_X_SS_CHAR_TRANS_OPEN_FAILRPC_X_SS_CHAR_TRANS_SHORT_FILERPC_X_SS_IN_NULL_CONTEXTRPC_X_SS_CONTEXT_DAMAGEDRPC_X_SS_HANDLES_MISMATCHRPC_X_SS_CANNOT_GET_CALL_HANDLERPC_X_NULL_REF_POINTERRPC_X_ENUM_VALUE_OUT_OF_RANGERPC_X_BYTE_COUNT_TOO_SMALLRPC_X_BAD_STUB_DATAERROR_INVALID_USER_BUFFERERROR_UNRECOGNIZED_MEDIAERROR_NO_TRUST_LSA_SECRETERROR_NO_TRUST_SAM_ACCOUNTERROR_TRUSTED_DOMAIN_FAILUREERROR_TRUSTED_RELATIONSHIP_FAILUREERROR_TRUST_FAILURERPC_S_CALL_IN_PROGRESSERROR_NETLOGON_NOT_STARTEDERROR_ACCOUNT_EXPIREDERROR_REDIRECTOR_HAS_OPEN_HANDLESERROR_PRINTER_DRIVER_ALREADY_INSTALLEDERROR_UNKNOWN_PORTERROR_UNKNOWN_PRINTER_DRIVERERROR_UNKNOWN_PRINTPROCESSORERROR_INVALID_SEPARATOR_FILEERROR_INVALID_PRIORITYERROR_INVALID_PRINTER_NAMEERROR_PRINTER_ALREADY_EXISTSERROR_INVALID_PRINTER_COMMANDERROR_INVALID_DATATYPEERROR_INVALID_ENVIRONMENTRPC_S_NO_MORE_BINDINGSERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNTERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNTERROR_NOLOGON_SERVER_TRUST_ACCOUNTERROR_DOMAIN_TRUST_INCONSISTENTERROR_SERVER_HAS_OPEN_HANDLESERROR_RESOURCE_DATA_NOT_FOUNDERROR_RESOURCE_TYPE_NOT_FOUNDERROR_RESOURCE_NAME_NOT_FOUNDERROR_RESOURCE_LANG_NOT_FOUNDERROR_NOT_ENOUGH_QUOTARPC_S_NO_INTERFACESRPC_S_CALL_CANCELLEDRPC_S_BINDING_INCOMPLETERPC_S_COMM_FAILURERPC_S_UNSUPPORTED_AUTHN_LEVELRPC_S_NO_PRINC_NAMERPC_S_NOT_RPC_ERRORRPC_S_UUID_LOCAL_ONLYRPC_S_SEC_PKG_ERRORRPC_S_NOT_CANCELLEDRPC_X_INVALID_ES_ACTIONRPC_X_WRONG_ES_VERSIONRPC_X_WRONG_STUB_VERSIONRPC_X_INVALID_PIPE_OBJECTRPC_X_WRONG_PIPE_ORDERRPC_X_WRONG_PIPE_VERSIONRPC_S_GROUP_MEMBER_NOT_FOUNDEPT_S_CANT_CREATERPC_S_INVALID_OBJECTERROR_INVALID_TIMEERROR_INVALID_FORM_NAMEERROR_INVALID_FORM_SIZEERROR_ALREADY_WAITINGERROR_PRINTER_DELETEDERROR_INVALID_PRINTER_STATEERROR_PASSWORD_MUST_CHANGEERROR_DOMAIN_CONTROLLER_NOT_FOUNDERROR_ACCOUNT_LOCKED_OUTOR_INVALID_OXIDOR_INVALID_OIDOR_INVALID_SETRPC_S_SEND_INCOMPLETERPC_S_INVALID_ASYNC_HANDLERPC_S_INVALID_ASYNC_CALLRPC_X_PIPE_CLOSEDRPC_X_PIPE_DISCIPLINE_ERRORRPC_X_PIPE_EMPTYERROR_NO_SITENAMEERROR_CANT_ACCESS_FILEERROR_CANT_RESOLVE_FILENAMEERROR_DS_MEMBERSHIP_EVALUATED_LOCALLYERROR_DS_NO_ATTRIBUTE_OR_VALUEERROR_DS_INVALID_ATTRIBUTE_SYNTAXERROR_DS_ATTRIBUTE_TYPE_UNDEFINEDERROR_DS_ATTRIBUTE_OR_VALUE_EXISTSERROR_DS_BUSYERROR_DS_UNAVAILABLEERROR_DS_NO_RIDS_ALLOCATEDERROR_DS_NO_MORE_RIDSERROR_DS_INCORRECT_ROLE_OWNERERROR_DS_RIDMGR_INIT_ERRORERROR_DS_OBJ_CLASS_VIOLATIONERROR_DS_CANT_ON_NON_LEAFERROR_DS_CANT_ON_RDNERROR_DS_CANT_MOD_OBJ_CLASSERROR_DS_CROSS_DOM_MOVE_ERRORERROR_DS_GC_NOT_AVAILABLEERROR_INVALID_PIXEL_FORMATERROR_BAD_DRIVERERROR_INVALID_WINDOW_STYLEERROR_METAFILE_NOT_SUPPORTEDERROR_TRANSFORM_NOT_SUPPORTEDERROR_CLIPPING_NOT_SUPPORTEDERROR_CONNECTED_OTHER_PASSWORDERROR_BAD_USERNAMEERROR_NOT_CONNECTEDERROR_INVALID_CMMERROR_INVALID_PROFILEERROR_TAG_NOT_FOUNDERROR_TAG_NOT_PRESENTERROR_DUPLICATE_TAGERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICEERROR_PROFILE_NOT_FOUNDERROR_INVALID_COLORSPACEERROR_ICM_NOT_ENABLEDERROR_DELETING_ICM_XFORMERROR_INVALID_TRANSFORMERROR_OPEN_FILESERROR_ACTIVE_CONNECTIONSERROR_DEVICE_IN_USEERROR_UNKNOWN_PRINT_MONITORERROR_PRINTER_DRIVER_IN_USEERROR_SPOOL_FILE_NOT_FOUNDERROR_SPL_NO_STARTDOCERROR_SPL_NO_ADDJOBERROR_PRINT_PROCESSOR_ALREADY_INSTALLEDERROR_PRINT_MONITOR_ALREADY_INSTALLEDERROR_INVALID_PRINT_MONITORERROR_PRINT_MONITOR_IN_USEERROR_PRINTER_HAS_JOBS_QUEUEDERROR_SUCCESS_REBOOT_REQUIREDERROR_SUCCESS_RESTART_REQUIREDERROR_WINS_INTERNALERROR_CAN_NOT_DEL_LOCAL_WINSERROR_STATIC_INITERROR_INC_BACKUPERROR_FULL_BACKUPERROR_REC_NON_EXISTENTERROR_RPL_NOT_ALLOWEDERROR_DHCP_ADDRESS_CONFLICTERROR_WMI_GUID_NOT_FOUNDERROR_WMI_INSTANCE_NOT_FOUNDERROR_WMI_ITEMID_NOT_FOUNDERROR_WMI_TRY_AGAINERROR_WMI_DP_NOT_FOUNDERROR_WMI_UNRESOLVED_INSTANCE_REFERROR_WMI_ALREADY_ENABLEDERROR_WMI_GUID_DISCONNECTEDERROR_WMI_SERVER_UNAVAILABLEERROR_WMI_DP_FAILEDERROR_WMI_INVALID_MOFERROR_WMI_INVALID_REGINFOERROR_INVALID_MEDIAERROR_INVALID_LIBRARYERROR_INVALID_MEDIA_POOLERROR_DRIVE_MEDIA_MISMATCHERROR_MEDIA_OFFLINEERROR_LIBRARY_OFFLINEERROR_EMPTYERROR_NOT_EMPTYERROR_MEDIA_UNAVAILABLEERROR_RESOURCE_DISABLEDERROR_INVALID_CLEANERERROR_UNABLE_TO_CLEANERROR_OBJECT_NOT_FOUNDERROR_DATABASE_FAILUREERROR_DATABASE_FULLERROR_MEDIA_INCOMPATIBLEERROR_RESOURCE_NOT_PRESENTERROR_INVALID_OPERATIONERROR_MEDIA_NOT_AVAILABLEERROR_DEVICE_NOT_AVAILABLEERROR_REQUEST_REFUSEDERROR_FILE_OFFLINEERROR_REMOTE_STORAGE_NOT_ACTIVEERROR_REMOTE_STORAGE_MEDIA_ERRORERROR_NOT_A_REPARSE_POINTERROR_REPARSE_ATTRIBUTE_CONFLICTERROR_DEPENDENT_RESOURCE_EXISTSERROR_DEPENDENCY_NOT_FOUNDERROR_DEPENDENCY_ALREADY_EXISTSERROR_RESOURCE_NOT_ONLINEERROR_HOST_NODE_NOT_AVAILABLEERROR_RESOURCE_NOT_AVAILABLEERROR_RESOURCE_NOT_FOUNDERROR_SHUTDOWN_CLUSTERERROR_CANT_EVICT_ACTIVE_NODEERROR_OBJECT_ALREADY_EXISTSERROR_OBJECT_IN_LISTERROR_GROUP_NOT_AVAILABLEERROR_GROUP_NOT_FOUNDERROR_GROUP_NOT_ONLINEERROR_HOST_NODE_NOT_RESOURCE_OWNERERROR_HOST_NODE_NOT_GROUP_OWNERERROR_RESMON_CREATE_FAILEDERROR_RESMON_ONLINE_FAILEDERROR_RESOURCE_ONLINEERROR_QUORUM_RESOURCEERROR_NOT_QUORUM_CAPABLEERROR_CLUSTER_SHUTTING_DOWNERROR_INVALID_STATEERROR_RESOURCE_PROPERTIES_STOREDERROR_NOT_QUORUM_CLASSERROR_CORE_RESOURCEERROR_QUORUM_RESOURCE_ONLINE_FAILEDERROR_QUORUMLOG_OPEN_FAILEDERROR_CLUSTERLOG_CORRUPTERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZEERROR_CLUSTERLOG_EXCEEDS_MAXSIZEERROR_CLUSTERLOG_CHKPOINT_NOT_FOUNDERROR_CLUSTERLOG_NOT_ENOUGH_SPACEERROR_ENCRYPTION_FAILEDERROR_DECRYPTION_FAILEDERROR_FILE_ENCRYPTEDERROR_NO_RECOVERY_POLICYERROR_NO_EFSERROR_WRONG_EFSERROR_NO_USER_KEYSERROR_FILE_NOT_ENCRYPTEDERROR_NOT_EXPORT_FORMATERROR_NO_BROWSER_SERVERS_FOUNDSTG_S_CONVERTEDSTG_S_BLOCKSTG_S_RETRYNOWSTG_S_MONITORINGSTG_S_MULTIPLEOPENSSTG_S_CONSOLIDATIONFAILEDSTG_S_CANNOTCONSOLIDATEOLE_S_FIRSTOLE_S_STATICOLE_S_MAC_CLIPFORMATOLE_S_LASTDRAGDROP_S_FIRSTDRAGDROP_S_CANCELDRAGDROP_S_USEDEFAULTCURSORSDRAGDROP_S_LASTCLASSFACTORY_S_FIRSTCLASSFACTORY_S_LASTMARSHAL_S_FIRSTMARSHAL_S_LASTDATA_S_FIRSTDATA_S_LASTVIEW_S_FIRSTVIEW_S_LASTREGDB_S_FIRSTREGDB_S_LASTCACHE_S_FIRSTCACHE_S_SAMECACHECACHE_S_SOMECACHES_NOTUPDATEDCACHE_S_LASTOLEOBJ_S_FIRSTOLEOBJ_S_CANNOT_DOVERB_NOWOLEOBJ_S_INVALIDHWNDOLEOBJ_S_LASTCLIENTSITE_S_FIRSTCLIENTSITE_S_LASTINPLACE_S_FIRSTINPLACE_S_LASTENUM_S_FIRSTENUM_S_LASTCONVERT10_S_FIRSTCONVERT10_S_LASTCLIPBRD_S_FIRSTCLIPBRD_S_LASTMK_S_FIRSTMK_S_REDUCED_TO_SELFMK_S_MEMK_S_HIMMK_S_USMK_S_MONIKERALREADYREGISTEREDMK_S_LASTCO_S_FIRSTCO_S_LASTCO_S_NOTALLINTERFACESE_PENDINGE_NOTIMPLE_NOINTERFACEE_POINTERE_ABORTE_FAILCO_E_INIT_TLSCO_E_INIT_SHARED_ALLOCATORCO_E_INIT_MEMORY_ALLOCATORCO_E_INIT_CLASS_CACHECO_E_INIT_RPC_CHANNELCO_E_INIT_TLS_SET_CHANNEL_CONTROLCO_E_INIT_TLS_CHANNEL_CONTROLCO_E_INIT_UNACCEPTED_USER_ALLOCATORCO_E_INIT_SCM_MUTEX_EXISTSCO_E_INIT_SCM_FILE_MAPPING_EXISTSCO_E_INIT_SCM_MAP_VIEW_OF_FILECO_E_INIT_SCM_EXEC_FAILURECO_E_INIT_ONLY_SINGLE_THREADEDCO_E_CANT_REMOTECO_E_BAD_SERVER_NAMECO_E_WRONG_SERVER_IDENTITYCO_E_OLE1DDE_DISABLEDCO_E_RUNAS_SYNTAXCO_E_CREATEPROCESS_FAILURECO_E_RUNAS_CREATEPROCESS_FAILURECO_E_RUNAS_LOGON_FAILURECO_E_LAUNCH_PERMSSION_DENIEDCO_E_START_SERVICE_FAILURECO_E_REMOTE_COMMUNICATION_FAILURECO_E_SERVER_START_TIMEOUTCO_E_CLSREG_INCONSISTENTCO_E_IIDREG_INCONSISTENTCO_E_NOT_SUPPORTEDCO_E_RELOAD_DLLCO_E_MSI_ERRORE_UNEXPECTEDRPC_E_CALL_REJECTEDRPC_E_CALL_CANCELEDRPC_E_CANTPOST_INSENDCALLRPC_E_CANTCALLOUT_INASYNCCALLRPC_E_CANTCALLOUT_INEXTERNALCALLRPC_E_CONNECTION_TERMINATEDRPC_E_SERVER_DIEDRPC_E_CLIENT_DIEDRPC_E_INVALID_DATAPACKETRPC_E_CANTTRANSMIT_CALLRPC_E_CLIENT_CANTMARSHAL_DATARPC_E_CLIENT_CANTUNMARSHAL_DATARPC_E_SERVER_CANTMARSHAL_DATARPC_E_SERVER_CANTUNMARSHAL_DATARPC_E_INVALID_DATARPC_E_INVALID_PARAMETERRPC_E_CANTCALLOUT_AGAINRPC_E_SERVER_DIED_DNERPC_E_SYS_CALL_FAILEDRPC_E_OUT_OF_RESOURCESRPC_E_ATTEMPTED_MULTITHREADRPC_E_NOT_REGISTEREDRPC_E_FAULTRPC_E_SERVERFAULTRPC_E_CHANGED_MODERPC_E_INVALIDMETHODRPC_E_DISCONNECTEDRPC_E_RETRYRPC_E_SERVERCALL_RETRYLATERRPC_E_SERVERCALL_REJECTEDRPC_E_INVALID_CALLDATARPC_E_CANTCALLOUT_ININPUTSYNCCALLRPC_E_WRONG_THREADRPC_E_THREAD_NOT_INITRPC_E_VERSION_MISMATCHRPC_E_INVALID_HEADERRPC_E_INVALID_EXTENSIONRPC_E_INVALID_IPIDRPC_E_INVALID_OBJECTRPC_S_CALLPENDINGRPC_S_WAITONTIMERRPC_E_CALL_COMPLETERPC_E_UNSECURE_CALLRPC_E_TOO_LATERPC_E_NO_GOOD_SECURITY_PACKAGESRPC_E_ACCESS_DENIEDRPC_E_REMOTE_DISABLEDRPC_E_INVALID_OBJREFRPC_E_NO_CONTEXTRPC_E_TIMEOUTRPC_E_NO_SYNCRPC_E_UNEXPECTEDDISP_E_UNKNOWNINTERFACEDISP_E_MEMBERNOTFOUNDDISP_E_PARAMNOTFOUNDDISP_E_TYPEMISMATCHDISP_E_UNKNOWNNAMEDISP_E_NONAMEDARGSDISP_E_BADVARTYPEDISP_E_EXCEPTIONDISP_E_OVERFLOWDISP_E_BADINDEXDISP_E_UNKNOWNLCIDDISP_E_ARRAYISLOCKEDDISP_E_BADPARAMCOUNTDISP_E_PARAMNOTOPTIONALDISP_E_BADCALLEEDISP_E_NOTACOLLECTIONDISP_E_DIVBYZEROTYPE_E_BUFFERTOOSMALLTYPE_E_FIELDNOTFOUNDTYPE_E_INVDATAREADTYPE_E_UNSUPFORMATTYPE_E_REGISTRYACCESSTYPE_E_LIBNOTREGISTEREDTYPE_E_UNDEFINEDTYPETYPE_E_QUALIFIEDNAMEDISALLOWEDTYPE_E_INVALIDSTATETYPE_E_WRONGTYPEKINDTYPE_E_ELEMENTNOTFOUNDTYPE_E_AMBIGUOUSNAMETYPE_E_NAMECONFLICTTYPE_E_UNKNOWNLCIDTYPE_E_DLLFUNCTIONNOTFOUNDTYPE_E_BADMODULEKINDTYPE_E_SIZETOOBIGTYPE_E_DUPLICATEIDTYPE_E_INVALIDIDTYPE_E_TYPEMISMATCHTYPE_E_OUTOFBOUNDSTYPE_E_IOERRORTYPE_E_CANTCREATETMPFILETYPE_E_CANTLOADLIBRARYTYPE_E_INCONSISTENTPROPFUNCSTYPE_E_CIRCULARTYPESTG_E_INVALIDFUNCTIONSTG_E_FILENOTFOUNDSTG_E_PATHNOTFOUNDSTG_E_TOOMANYOPENFILESSTG_E_ACCESSDENIEDSTG_E_INVALIDHANDLESTG_E_INSUFFICIENTMEMORYSTG_E_INVALIDPOINTERSTG_E_NOMOREFILESSTG_E_DISKISWRITEPROTECTEDSTG_E_SEEKERRORSTG_E_WRITEFAULTSTG_E_READFAULTSTG_E_SHAREVIOLATIONSTG_E_LOCKVIOLATIONSTG_E_FILEALREADYEXISTSSTG_E_INVALIDPARAMETERSTG_E_MEDIUMFULLSTG_E_PROPSETMISMATCHEDSTG_E_ABNORMALAPIEXITSTG_E_INVALIDHEADERSTG_E_INVALIDNAMESTG_E_UNKNOWNSTG_E_UNIMPLEMENTEDFUNCTIONSTG_E_INVALIDFLAGSTG_E_INUSESTG_E_NOTCURRENTSTG_E_REVERTEDSTG_E_CANTSAVESTG_E_OLDFORMATSTG_E_OLDDLLSTG_E_SHAREREQUIREDSTG_E_NOTFILEBASEDSTORAGESTG_E_EXTANTMARSHALLINGSSTG_E_DOCFILECORRUPTSTG_E_BADBASEADDRESSSTG_E_INCOMPLETESTG_E_TERMINATEDOLE_E_FIRSTOLE_E_ADVFOLE_E_ENUM_NOMOREOLE_E_ADVISENOTSUPPORTEDOLE_E_NOCONNECTIONOLE_E_NOTRUNNINGOLE_E_NOCACHEOLE_E_BLANKOLE_E_CLASSDIFFOLE_E_CANT_GETMONIKEROLE_E_CANT_BINDTOSOURCEOLE_E_STATICOLE_E_PROMPTSAVECANCELLEDOLE_E_INVALIDRECTOLE_E_WRONGCOMPOBJOLE_E_INVALIDHWNDOLE_E_NOT_INPLACEACTIVEOLE_E_CANTCONVERTOLE_E_NOSTORAGEDV_E_FORMATETCDV_E_DVTARGETDEVICEDV_E_STGMEDIUMDV_E_STATDATADV_E_LINDEXDV_E_TYMEDDV_E_CLIPFORMATDV_E_DVASPECTDV_E_DVTARGETDEVICE_SIZEDV_E_NOIVIEWOBJECTOLE_E_LASTDRAGDROP_E_FIRSTDRAGDROP_E_ALREADYREGISTEREDDRAGDROP_E_INVALIDHWNDDRAGDROP_E_LASTCLASSFACTORY_E_FIRSTCLASS_E_CLASSNOTAVAILABLECLASS_E_NOTLICENSEDCLASSFACTORY_E_LASTMARSHAL_E_FIRSTMARSHAL_E_LASTDATA_E_FIRSTDATA_E_LASTVIEW_E_FIRSTVIEW_E_LASTREGDB_E_FIRSTREGDB_E_WRITEREGDBREGDB_E_KEYMISSINGREGDB_E_INVALIDVALUEREGDB_E_CLASSNOTREGREGDB_E_IIDNOTREGREGDB_E_LASTCAT_E_FIRSTCAT_E_LASTCS_E_FIRSTCS_E_NOT_DELETABLECS_E_CLASS_NOTFOUNDCS_E_INVALID_VERSIONCS_E_LASTCACHE_E_FIRSTCACHE_E_LASTOLEOBJ_E_FIRSTOLEOBJ_E_INVALIDVERBOLEOBJ_E_LASTCLIENTSITE_E_FIRSTCLIENTSITE_E_LASTINPLACE_E_NOTUNDOABLEINPLACE_E_NOTOOLSPACEINPLACE_E_LASTENUM_E_FIRSTENUM_E_LASTCONVERT10_E_FIRSTCONVERT10_E_OLESTREAM_PUTCONVERT10_E_OLESTREAM_FMTCONVERT10_E_OLESTREAM_BITMAP_TO_DIBCONVERT10_E_STG_FMTCONVERT10_E_STG_NO_STD_STREAMCONVERT10_E_STG_DIB_TO_BITMAPCONVERT10_E_LASTCLIPBRD_E_FIRSTCLIPBRD_E_CANT_EMPTYCLIPBRD_E_CANT_SETCLIPBRD_E_BAD_DATACLIPBRD_E_CANT_CLOSECLIPBRD_E_LASTMK_E_FIRSTMK_E_EXCEEDEDDEADLINEMK_E_NEEDGENERICMK_E_UNAVAILABLEMK_E_SYNTAXMK_E_NOOBJECTMK_E_INVALIDEXTENSIONMK_E_INTERMEDIATEINTERFACENOTSUPPORTEDMK_E_NOTBINDABLEMK_E_NOTBOUNDMK_E_CANTOPENFILEMK_E_MUSTBOTHERUSERMK_E_NOINVERSEMK_E_NOSTORAGEMK_E_NOPREFIXMK_E_LASTCO_E_FIRSTCO_E_ALREADYINITIALIZEDCO_E_CANTDETERMINECLASSCO_E_CLASSSTRINGCO_E_IIDSTRINGCO_E_APPNOTFOUNDCO_E_APPSINGLEUSECO_E_ERRORINAPPCO_E_DLLNOTFOUNDCO_E_ERRORINDLLCO_E_WRONGOSFORAPPCO_E_OBJNOTREGCO_E_OBJISREGCO_E_OBJNOTCONNECTEDCO_E_APPDIDNTREGCO_E_LASTCO_E_FAILEDTOIMPERSONATECO_E_FAILEDTOGETSECCTXCO_E_FAILEDTOOPENTHREADTOKENCO_E_FAILEDTOGETTOKENINFOCO_E_TRUSTEEDOESNTMATCHCLIENTCO_E_FAILEDTOQUERYCLIENTBLANKETCO_E_FAILEDTOSETDACLCO_E_ACCESSCHECKFAILEDCO_E_NETACCESSAPIFAILEDCO_E_WRONGTRUSTEENAMESYNTAXCO_E_INVALIDSIDCO_E_CONVERSIONFAILEDCO_E_NOMATCHINGSIDFOUNDCO_E_LOOKUPACCSIDFAILEDCO_E_NOMATCHINGNAMEFOUNDCO_E_LOOKUPACCNAMEFAILEDCO_E_SETSERLHNDLFAILEDCO_E_FAILEDTOGETWINDIRCO_E_PATHTOOLONGCO_E_FAILEDTOGENUUIDCO_E_FAILEDTOCREATEFILECO_E_FAILEDTOCLOSEHANDLECO_E_EXCEEDSYSACLLIMITCO_E_ACESINWRONGORDERCO_E_INCOMPATIBLESTREAMVERSIONCO_E_FAILEDTOOPENPROCESSTOKENCO_E_DECODEFAILEDCO_E_ACNOTINITIALIZEDE_ACCESSDENIEDE_HANDLEE_OUTOFMEMORYE_INVALIDARGCO_E_CLASS_CREATE_FAILEDCO_E_SCM_ERRORCO_E_SCM_RPC_FAILURECO_E_BAD_PATHCO_E_SERVER_EXEC_FAILURECO_E_OBJSRV_RPC_FAILUREMK_E_NO_NORMALIZEDCO_E_SERVER_STOPPINGMEM_E_INVALID_ROOTMEM_E_INVALID_LINKMEM_E_INVALID_SIZENTE_BAD_UIDNTE_BAD_HASHNTE_BAD_KEYNTE_BAD_LENNTE_BAD_DATANTE_BAD_SIGNATURENTE_BAD_VERNTE_BAD_ALGIDNTE_BAD_FLAGSNTE_BAD_TYPENTE_BAD_KEY_STATENTE_BAD_HASH_STATENTE_NO_KEYNTE_NO_MEMORYNTE_EXISTSNTE_PERMNTE_NOT_FOUNDNTE_DOUBLE_ENCRYPTNTE_BAD_PROVIDERNTE_BAD_PROV_TYPENTE_BAD_PUBLIC_KEYNTE_BAD_KEYSETNTE_PROV_TYPE_NOT_DEFNTE_PROV_TYPE_ENTRY_BADNTE_KEYSET_NOT_DEFNTE_KEYSET_ENTRY_BADNTE_PROV_TYPE_NO_MATCHNTE_SIGNATURE_FILE_BADNTE_PROVIDER_DLL_FAILNTE_PROV_DLL_NOT_FOUNDNTE_BAD_KEYSET_PARAMNTE_FAILNTE_SYS_ERRCRYPT_E_MSG_ERRORCRYPT_E_UNKNOWN_ALGOCRYPT_E_OID_FORMATCRYPT_E_INVALID_MSG_TYPECRYPT_E_UNEXPECTED_ENCODINGCRYPT_E_AUTH_ATTR_MISSINGCRYPT_E_HASH_VALUECRYPT_E_INVALID_INDEXCRYPT_E_ALREADY_DECRYPTEDCRYPT_E_NOT_DECRYPTEDCRYPT_E_RECIPIENT_NOT_FOUNDCRYPT_E_CONTROL_TYPECRYPT_E_ISSUER_SERIALNUMBERCRYPT_E_SIGNER_NOT_FOUNDCRYPT_E_ATTRIBUTES_MISSINGCRYPT_E_STREAM_MSG_NOT_READYCRYPT_E_STREAM_INSUFFICIENT_DATACRYPT_E_BAD_LENCRYPT_E_BAD_ENCODECRYPT_E_FILE_ERRORCRYPT_E_NOT_FOUNDCRYPT_E_EXISTSCRYPT_E_NO_PROVIDERCRYPT_E_SELF_SIGNEDCRYPT_E_DELETED_PREVCRYPT_E_NO_MATCHCRYPT_E_UNEXPECTED_MSG_TYPECRYPT_E_NO_KEY_PROPERTYCRYPT_E_NO_DECRYPT_CERTCRYPT_E_BAD_MSGCRYPT_E_NO_SIGNERCRYPT_E_PENDING_CLOSECRYPT_E_REVOKEDCRYPT_E_NO_REVOCATION_DLLCRYPT_E_NO_REVOCATION_CHECKCRYPT_E_REVOCATION_OFFLINECRYPT_E_NOT_IN_REVOCATION_DATABASECRYPT_E_INVALID_NUMERIC_STRINGCRYPT_E_INVALID_PRINTABLE_STRINGCRYPT_E_INVALID_IA5_STRINGCRYPT_E_INVALID_X500_STRINGCRYPT_E_NOT_CHAR_STRINGCRYPT_E_FILERESIZEDCRYPT_E_SECURITY_SETTINGSCRYPT_E_NO_VERIFY_USAGE_DLLCRYPT_E_NO_VERIFY_USAGE_CHECKCRYPT_E_VERIFY_USAGE_OFFLINECRYPT_E_NOT_IN_CTLCRYPT_E_NO_TRUSTED_SIGNERCRYPT_E_OSS_ERRORCERTSRV_E_BAD_REQUESTSUBJECTCERTSRV_E_NO_REQUESTCERTSRV_E_BAD_REQUESTSTATUSCERTSRV_E_PROPERTY_EMPTYCERTDB_E_JET_ERRORTRUST_E_SYSTEM_ERRORTRUST_E_NO_SIGNER_CERTTRUST_E_COUNTER_SIGNERTRUST_E_CERT_SIGNATURETRUST_E_TIME_STAMPTRUST_E_BAD_DIGESTTRUST_E_BASIC_CONSTRAINTSTRUST_E_FINANCIAL_CRITERIATRUST_E_PROVIDER_UNKNOWNTRUST_E_ACTION_UNKNOWNTRUST_E_SUBJECT_FORM_UNKNOWNTRUST_E_SUBJECT_NOT_TRUSTEDDIGSIG_E_ENCODEDIGSIG_E_DECODEDIGSIG_E_EXTENSIBILITYDIGSIG_E_CRYPTOPERSIST_E_SIZEDEFINITEPERSIST_E_SIZEINDEFINITEPERSIST_E_NOTSELFSIZINGTRUST_E_NOSIGNATURECERT_E_EXPIREDCERT_E_VALIDITYPERIODNESTINGCERT_E_ROLECERT_E_PATHLENCONSTCERT_E_CRITICALCERT_E_PURPOSECERT_E_ISSUERCHAININGCERT_E_MALFORMEDCERT_E_UNTRUSTEDROOTCERT_E_CHAININGTRUST_E_FAILCERT_E_REVOKEDCERT_E_UNTRUSTEDTESTROOTCERT_E_REVOCATION_FAILURECERT_E_CN_NO_MATCHCERT_E_WRONG_USAGESPAPI_E_EXPECTED_SECTION_NAMESPAPI_E_BAD_SECTION_NAME_LINESPAPI_E_SECTION_NAME_TOO_LONGSPAPI_E_GENERAL_SYNTAXSPAPI_E_WRONG_INF_STYLESPAPI_E_SECTION_NOT_FOUNDSPAPI_E_LINE_NOT_FOUNDSPAPI_E_NO_ASSOCIATED_CLASSSPAPI_E_CLASS_MISMATCHSPAPI_E_DUPLICATE_FOUNDSPAPI_E_NO_DRIVER_SELECTEDSPAPI_E_KEY_DOES_NOT_EXISTSPAPI_E_INVALID_DEVINST_NAMESPAPI_E_INVALID_CLASSSPAPI_E_DEVINST_ALREADY_EXISTSSPAPI_E_DEVINFO_NOT_REGISTEREDSPAPI_E_INVALID_REG_PROPERTYSPAPI_E_NO_INFSPAPI_E_NO_SUCH_DEVINSTSPAPI_E_CANT_LOAD_CLASS_ICONSPAPI_E_INVALID_CLASS_INSTALLERSPAPI_E_DI_DO_DEFAULTSPAPI_E_DI_NOFILECOPYSPAPI_E_INVALID_HWPROFILESPAPI_E_NO_DEVICE_SELECTEDSPAPI_E_DEVINFO_LIST_LOCKEDSPAPI_E_DEVINFO_DATA_LOCKEDSPAPI_E_DI_BAD_PATHSPAPI_E_NO_CLASSINSTALL_PARAMSSPAPI_E_FILEQUEUE_LOCKEDSPAPI_E_BAD_SERVICE_INSTALLSECTSPAPI_E_NO_CLASS_DRIVER_LISTSPAPI_E_NO_ASSOCIATED_SERVICESPAPI_E_NO_DEFAULT_DEVICE_INTERFACESPAPI_E_DEVICE_INTERFACE_ACTIVESPAPI_E_DEVICE_INTERFACE_REMOVEDSPAPI_E_BAD_INTERFACE_INSTALLSECTSPAPI_E_NO_SUCH_INTERFACE_CLASSSPAPI_E_INVALID_REFERENCE_STRINGSPAPI_E_INVALID_MACHINENAMESPAPI_E_REMOTE_COMM_FAILURESPAPI_E_MACHINE_UNAVAILABLESPAPI_E_NO_CONFIGMGR_SERVICESSPAPI_E_INVALID_PROPPAGE_PROVIDERSPAPI_E_NO_SUCH_DEVICE_INTERFACESPAPI_E_DI_POSTPROCESSING_REQUIREDSPAPI_E_INVALID_COINSTALLERSPAPI_E_NO_COMPAT_DRIVERSSPAPI_E_NO_DEVICE_ICONSPAPI_E_INVALID_INF_LOGCONFIGSPAPI_E_DI_DONT_INSTALLSPAPI_E_INVALID_FILTER_DRIVERSPAPI_E_ERROR_NOT_INSTALLED"
-
-var _HRESULT_map = map[HRESULT]string{
-	0:          _HRESULT_name[0:13],
-	1:          _HRESULT_name[13:35],
-	2:          _HRESULT_name[35:55],
-	3:          _HRESULT_name[55:75],
-	4:          _HRESULT_name[75:100],
-	5:          _HRESULT_name[100:119],
-	6:          _HRESULT_name[119:139],
-	7:          _HRESULT_name[139:158],
-	8:          _HRESULT_name[158:181],
-	9:          _HRESULT_name[181:200],
-	10:         _HRESULT_name[200:221],
-	11:         _HRESULT_name[221:237],
-	12:         _HRESULT_name[237:257],
-	13:         _HRESULT_name[257:275],
-	14:         _HRESULT_name[275:292],
-	15:         _HRESULT_name[292:311],
-	16:         _HRESULT_name[311:334],
-	17:         _HRESULT_name[334:355],
-	18:         _HRESULT_name[355:374],
-	19:         _HRESULT_name[374:393],
-	20:         _HRESULT_name[393:407],
-	21:         _HRESULT_name[407:422],
-	22:         _HRESULT_name[422:439],
-	23:         _HRESULT_name[439:448],
-	24:         _HRESULT_name[448:464],
-	25:         _HRESULT_name[464:474],
-	26:         _HRESULT_name[474:492],
-	27:         _HRESULT_name[492:514],
-	28:         _HRESULT_name[514:532],
-	29:         _HRESULT_name[532:549],
-	30:         _HRESULT_name[549:565],
-	31:         _HRESULT_name[565:582],
-	32:         _HRESULT_name[582:605],
-	33:         _HRESULT_name[605:625],
-	34:         _HRESULT_name[625:641],
-	36:         _HRESULT_name[641:670],
-	38:         _HRESULT_name[670:686],
-	39:         _HRESULT_name[686:708],
-	50:         _HRESULT_name[708:727],
-	51:         _HRESULT_name[727:745],
-	52:         _HRESULT_name[745:759],
-	53:         _HRESULT_name[759:776],
-	54:         _HRESULT_name[776:794],
-	55:         _HRESULT_name[794:813],
-	56:         _HRESULT_name[813:832],
-	57:         _HRESULT_name[832:850],
-	58:         _HRESULT_name[850:868],
-	59:         _HRESULT_name[868:887],
-	60:         _HRESULT_name[887:905],
-	61:         _HRESULT_name[905:922],
-	62:         _HRESULT_name[922:942],
-	63:         _HRESULT_name[942:963],
-	64:         _HRESULT_name[963:984],
-	65:         _HRESULT_name[984:1011],
-	66:         _HRESULT_name[1011:1029],
-	67:         _HRESULT_name[1029:1047],
-	68:         _HRESULT_name[1047:1067],
-	69:         _HRESULT_name[1067:1086],
-	70:         _HRESULT_name[1086:1106],
-	71:         _HRESULT_name[1106:1125],
-	72:         _HRESULT_name[1125:1143],
-	80:         _HRESULT_name[1143:1160],
-	82:         _HRESULT_name[1160:1177],
-	83:         _HRESULT_name[1177:1191],
-	84:         _HRESULT_name[1191:1214],
-	85:         _HRESULT_name[1214:1236],
-	86:         _HRESULT_name[1236:1258],
-	87:         _HRESULT_name[1258:1281],
-	88:         _HRESULT_name[1281:1302],
-	89:         _HRESULT_name[1302:1321],
-	100:        _HRESULT_name[1321:1346],
-	101:        _HRESULT_name[1346:1374],
-	102:        _HRESULT_name[1374:1390],
-	103:        _HRESULT_name[1390:1417],
-	104:        _HRESULT_name[1417:1448],
-	105:        _HRESULT_name[1448:1468],
-	106:        _HRESULT_name[1468:1488],
-	107:        _HRESULT_name[1488:1505],
-	108:        _HRESULT_name[1505:1523],
-	109:        _HRESULT_name[1523:1540],
-	110:        _HRESULT_name[1540:1557],
-	111:        _HRESULT_name[1557:1578],
-	112:        _HRESULT_name[1578:1593],
-	113:        _HRESULT_name[1593:1621],
-	114:        _HRESULT_name[1621:1648],
-	117:        _HRESULT_name[1648:1670],
-	118:        _HRESULT_name[1670:1697],
-	119:        _HRESULT_name[1697:1719],
-	120:        _HRESULT_name[1719:1745],
-	121:        _HRESULT_name[1745:1762],
-	122:        _HRESULT_name[1762:1787],
-	123:        _HRESULT_name[1787:1805],
-	124:        _HRESULT_name[1805:1824],
-	125:        _HRESULT_name[1824:1845],
-	126:        _HRESULT_name[1845:1864],
-	127:        _HRESULT_name[1864:1884],
-	128:        _HRESULT_name[1884:1906],
-	129:        _HRESULT_name[1906:1930],
-	130:        _HRESULT_name[1930:1956],
-	131:        _HRESULT_name[1956:1975],
-	132:        _HRESULT_name[1975:1995],
-	133:        _HRESULT_name[1995:2015],
-	134:        _HRESULT_name[2015:2030],
-	135:        _HRESULT_name[2030:2046],
-	136:        _HRESULT_name[2046:2062],
-	137:        _HRESULT_name[2062:2079],
-	138:        _HRESULT_name[2079:2097],
-	139:        _HRESULT_name[2097:2117],
-	140:        _HRESULT_name[2117:2136],
-	141:        _HRESULT_name[2136:2155],
-	142:        _HRESULT_name[2155:2171],
-	143:        _HRESULT_name[2171:2187],
-	144:        _HRESULT_name[2187:2205],
-	145:        _HRESULT_name[2205:2224],
-	146:        _HRESULT_name[2224:2243],
-	147:        _HRESULT_name[2243:2261],
-	148:        _HRESULT_name[2261:2276],
-	149:        _HRESULT_name[2276:2297],
-	150:        _HRESULT_name[2297:2315],
-	151:        _HRESULT_name[2315:2340],
-	152:        _HRESULT_name[2340:2365],
-	153:        _HRESULT_name[2365:2390],
-	154:        _HRESULT_name[2390:2410],
-	155:        _HRESULT_name[2410:2429],
-	156:        _HRESULT_name[2429:2449],
-	157:        _HRESULT_name[2449:2464],
-	158:        _HRESULT_name[2464:2480],
-	159:        _HRESULT_name[2480:2503],
-	160:        _HRESULT_name[2503:2522],
-	161:        _HRESULT_name[2522:2540],
-	162:        _HRESULT_name[2540:2560],
-	164:        _HRESULT_name[2560:2583],
-	167:        _HRESULT_name[2583:2600],
-	170:        _HRESULT_name[2600:2610],
-	173:        _HRESULT_name[2610:2632],
-	174:        _HRESULT_name[2632:2664],
-	180:        _HRESULT_name[2664:2692],
-	182:        _HRESULT_name[2692:2713],
-	183:        _HRESULT_name[2713:2733],
-	186:        _HRESULT_name[2733:2758],
-	187:        _HRESULT_name[2758:2777],
-	188:        _HRESULT_name[2777:2807],
-	189:        _HRESULT_name[2807:2829],
-	190:        _HRESULT_name[2829:2853],
-	191:        _HRESULT_name[2853:2880],
-	192:        _HRESULT_name[2880:2904],
-	193:        _HRESULT_name[2904:2924],
-	194:        _HRESULT_name[2924:2955],
-	195:        _HRESULT_name[2955:2981],
-	196:        _HRESULT_name[2981:3012],
-	197:        _HRESULT_name[3012:3034],
-	198:        _HRESULT_name[3034:3054],
-	199:        _HRESULT_name[3054:3083],
-	200:        _HRESULT_name[3083:3113],
-	201:        _HRESULT_name[3113:3143],
-	202:        _HRESULT_name[3143:3171],
-	203:        _HRESULT_name[3171:3193],
-	205:        _HRESULT_name[3193:3213],
-	206:        _HRESULT_name[3213:3239],
-	207:        _HRESULT_name[3239:3263],
-	208:        _HRESULT_name[3263:3292],
-	209:        _HRESULT_name[3292:3319],
-	210:        _HRESULT_name[3319:3342],
-	212:        _HRESULT_name[3342:3354],
-	214:        _HRESULT_name[3354:3376],
-	215:        _HRESULT_name[3376:3401],
-	216:        _HRESULT_name[3401:3432],
-	230:        _HRESULT_name[3432:3446],
-	231:        _HRESULT_name[3446:3461],
-	232:        _HRESULT_name[3461:3474],
-	233:        _HRESULT_name[3474:3498],
-	234:        _HRESULT_name[3498:3513],
-	240:        _HRESULT_name[3513:3534],
-	254:        _HRESULT_name[3534:3555],
-	255:        _HRESULT_name[3555:3581],
-	259:        _HRESULT_name[3581:3600],
-	266:        _HRESULT_name[3600:3617],
-	267:        _HRESULT_name[3617:3632],
-	275:        _HRESULT_name[3632:3651],
-	276:        _HRESULT_name[3651:3672],
-	277:        _HRESULT_name[3672:3691],
-	278:        _HRESULT_name[3691:3714],
-	282:        _HRESULT_name[3714:3737],
-	288:        _HRESULT_name[3737:3752],
-	298:        _HRESULT_name[3752:3772],
-	299:        _HRESULT_name[3772:3790],
-	300:        _HRESULT_name[3790:3814],
-	301:        _HRESULT_name[3814:3843],
-	317:        _HRESULT_name[3843:3865],
-	487:        _HRESULT_name[3865:3886],
-	534:        _HRESULT_name[3886:3911],
-	535:        _HRESULT_name[3911:3931],
-	536:        _HRESULT_name[3931:3951],
-	994:        _HRESULT_name[3951:3973],
-	995:        _HRESULT_name[3973:3996],
-	996:        _HRESULT_name[3996:4015],
-	997:        _HRESULT_name[4015:4031],
-	998:        _HRESULT_name[4031:4045],
-	999:        _HRESULT_name[4045:4060],
-	1001:       _HRESULT_name[4060:4080],
-	1002:       _HRESULT_name[4080:4101],
-	1003:       _HRESULT_name[4101:4123],
-	1004:       _HRESULT_name[4123:4142],
-	1005:       _HRESULT_name[4142:4167],
-	1006:       _HRESULT_name[4167:4185],
-	1007:       _HRESULT_name[4185:4206],
-	1008:       _HRESULT_name[4206:4220],
-	1009:       _HRESULT_name[4220:4231],
-	1010:       _HRESULT_name[4231:4243],
-	1011:       _HRESULT_name[4243:4257],
-	1012:       _HRESULT_name[4257:4271],
-	1013:       _HRESULT_name[4271:4286],
-	1014:       _HRESULT_name[4286:4310],
-	1015:       _HRESULT_name[4310:4332],
-	1016:       _HRESULT_name[4332:4356],
-	1017:       _HRESULT_name[4356:4379],
-	1018:       _HRESULT_name[4379:4396],
-	1019:       _HRESULT_name[4396:4414],
-	1020:       _HRESULT_name[4414:4436],
-	1021:       _HRESULT_name[4436:4464],
-	1022:       _HRESULT_name[4464:4485],
-	1051:       _HRESULT_name[4485:4517],
-	1052:       _HRESULT_name[4517:4546],
-	1053:       _HRESULT_name[4546:4575],
-	1054:       _HRESULT_name[4575:4598],
-	1055:       _HRESULT_name[4598:4627],
-	1056:       _HRESULT_name[4627:4656],
-	1057:       _HRESULT_name[4656:4685],
-	1058:       _HRESULT_name[4685:4707],
-	1059:       _HRESULT_name[4707:4732],
-	1060:       _HRESULT_name[4732:4760],
-	1061:       _HRESULT_name[4760:4792],
-	1062:       _HRESULT_name[4792:4816],
-	1063:       _HRESULT_name[4816:4855],
-	1064:       _HRESULT_name[4855:4881],
-	1065:       _HRESULT_name[4881:4910],
-	1066:       _HRESULT_name[4910:4938],
-	1067:       _HRESULT_name[4938:4959],
-	1068:       _HRESULT_name[4959:4988],
-	1069:       _HRESULT_name[4988:5014],
-	1070:       _HRESULT_name[5014:5038],
-	1071:       _HRESULT_name[5038:5064],
-	1072:       _HRESULT_name[5064:5095],
-	1073:       _HRESULT_name[5095:5115],
-	1074:       _HRESULT_name[5115:5140],
-	1075:       _HRESULT_name[5140:5172],
-	1076:       _HRESULT_name[5172:5199],
-	1077:       _HRESULT_name[5199:5226],
-	1078:       _HRESULT_name[5226:5254],
-	1079:       _HRESULT_name[5254:5285],
-	1080:       _HRESULT_name[5285:5319],
-	1081:       _HRESULT_name[5319:5352],
-	1082:       _HRESULT_name[5352:5377],
-	1100:       _HRESULT_name[5377:5395],
-	1101:       _HRESULT_name[5395:5418],
-	1102:       _HRESULT_name[5418:5442],
-	1103:       _HRESULT_name[5442:5464],
-	1104:       _HRESULT_name[5464:5486],
-	1105:       _HRESULT_name[5486:5509],
-	1106:       _HRESULT_name[5509:5535],
-	1107:       _HRESULT_name[5535:5563],
-	1108:       _HRESULT_name[5563:5589],
-	1109:       _HRESULT_name[5589:5617],
-	1110:       _HRESULT_name[5617:5636],
-	1111:       _HRESULT_name[5636:5651],
-	1112:       _HRESULT_name[5651:5674],
-	1113:       _HRESULT_name[5674:5702],
-	1114:       _HRESULT_name[5702:5723],
-	1115:       _HRESULT_name[5723:5749],
-	1116:       _HRESULT_name[5749:5778],
-	1117:       _HRESULT_name[5778:5793],
-	1118:       _HRESULT_name[5793:5815],
-	1119:       _HRESULT_name[5815:5829],
-	1120:       _HRESULT_name[5829:5846],
-	1121:       _HRESULT_name[5846:5867],
-	1122:       _HRESULT_name[5867:5897],
-	1123:       _HRESULT_name[5897:5924],
-	1124:       _HRESULT_name[5924:5950],
-	1125:       _HRESULT_name[5950:5976],
-	1126:       _HRESULT_name[5976:6005],
-	1127:       _HRESULT_name[6005:6032],
-	1128:       _HRESULT_name[6032:6055],
-	1129:       _HRESULT_name[6055:6073],
-	1130:       _HRESULT_name[6073:6103],
-	1131:       _HRESULT_name[6103:6126],
-	1132:       _HRESULT_name[6126:6148],
-	1140:       _HRESULT_name[6148:6176],
-	1141:       _HRESULT_name[6176:6204],
-	1142:       _HRESULT_name[6204:6224],
-	1150:       _HRESULT_name[6224:6245],
-	1151:       _HRESULT_name[6245:6263],
-	1152:       _HRESULT_name[6263:6288],
-	1153:       _HRESULT_name[6288:6303],
-	1154:       _HRESULT_name[6303:6320],
-	1155:       _HRESULT_name[6320:6340],
-	1156:       _HRESULT_name[6340:6354],
-	1157:       _HRESULT_name[6354:6373],
-	1158:       _HRESULT_name[6373:6399],
-	1159:       _HRESULT_name[6399:6422],
-	1160:       _HRESULT_name[6422:6448],
-	1161:       _HRESULT_name[6448:6478],
-	1162:       _HRESULT_name[6478:6507],
-	1163:       _HRESULT_name[6507:6533],
-	1164:       _HRESULT_name[6533:6569],
-	1165:       _HRESULT_name[6569:6599],
-	1166:       _HRESULT_name[6599:6621],
-	1167:       _HRESULT_name[6621:6647],
-	1168:       _HRESULT_name[6647:6662],
-	1169:       _HRESULT_name[6662:6676],
-	1170:       _HRESULT_name[6676:6695],
-	1171:       _HRESULT_name[6695:6716],
-	1172:       _HRESULT_name[6716:6741],
-	1173:       _HRESULT_name[6741:6759],
-	1200:       _HRESULT_name[6759:6775],
-	1201:       _HRESULT_name[6775:6799],
-	1202:       _HRESULT_name[6799:6830],
-	1203:       _HRESULT_name[6830:6854],
-	1204:       _HRESULT_name[6854:6872],
-	1205:       _HRESULT_name[6872:6897],
-	1206:       _HRESULT_name[6897:6914],
-	1207:       _HRESULT_name[6914:6933],
-	1208:       _HRESULT_name[6933:6953],
-	1209:       _HRESULT_name[6953:6976],
-	1210:       _HRESULT_name[6976:7002],
-	1211:       _HRESULT_name[7002:7025],
-	1212:       _HRESULT_name[7025:7049],
-	1213:       _HRESULT_name[7049:7074],
-	1214:       _HRESULT_name[7074:7095],
-	1215:       _HRESULT_name[7095:7118],
-	1216:       _HRESULT_name[7118:7144],
-	1217:       _HRESULT_name[7144:7169],
-	1218:       _HRESULT_name[7169:7194],
-	1219:       _HRESULT_name[7194:7227],
-	1220:       _HRESULT_name[7227:7262],
-	1221:       _HRESULT_name[7262:7282],
-	1222:       _HRESULT_name[7282:7298],
-	1223:       _HRESULT_name[7298:7313],
-	1224:       _HRESULT_name[7313:7335],
-	1225:       _HRESULT_name[7335:7359],
-	1226:       _HRESULT_name[7359:7384],
-	1227:       _HRESULT_name[7384:7416],
-	1228:       _HRESULT_name[7416:7444],
-	1229:       _HRESULT_name[7444:7468],
-	1230:       _HRESULT_name[7468:7491],
-	1231:       _HRESULT_name[7491:7516],
-	1232:       _HRESULT_name[7516:7538],
-	1233:       _HRESULT_name[7538:7564],
-	1234:       _HRESULT_name[7564:7586],
-	1235:       _HRESULT_name[7586:7607],
-	1236:       _HRESULT_name[7607:7631],
-	1237:       _HRESULT_name[7631:7642],
-	1238:       _HRESULT_name[7642:7670],
-	1239:       _HRESULT_name[7670:7698],
-	1240:       _HRESULT_name[7698:7727],
-	1241:       _HRESULT_name[7727:7750],
-	1242:       _HRESULT_name[7750:7774],
-	1243:       _HRESULT_name[7774:7797],
-	1244:       _HRESULT_name[7797:7820],
-	1245:       _HRESULT_name[7820:7839],
-	1246:       _HRESULT_name[7839:7853],
-	1247:       _HRESULT_name[7853:7878],
-	1248:       _HRESULT_name[7878:7899],
-	1249:       _HRESULT_name[7899:7917],
-	1250:       _HRESULT_name[7917:7947],
-	1251:       _HRESULT_name[7947:7969],
-	1300:       _HRESULT_name[7969:7991],
-	1301:       _HRESULT_name[7991:8012],
-	1302:       _HRESULT_name[8012:8039],
-	1303:       _HRESULT_name[8039:8067],
-	1304:       _HRESULT_name[8067:8089],
-	1305:       _HRESULT_name[8089:8111],
-	1306:       _HRESULT_name[8111:8134],
-	1307:       _HRESULT_name[8134:8153],
-	1308:       _HRESULT_name[8153:8180],
-	1309:       _HRESULT_name[8180:8208],
-	1310:       _HRESULT_name[8208:8236],
-	1311:       _HRESULT_name[8236:8258],
-	1312:       _HRESULT_name[8258:8285],
-	1313:       _HRESULT_name[8285:8308],
-	1314:       _HRESULT_name[8308:8332],
-	1315:       _HRESULT_name[8332:8358],
-	1316:       _HRESULT_name[8358:8375],
-	1317:       _HRESULT_name[8375:8393],
-	1318:       _HRESULT_name[8393:8411],
-	1319:       _HRESULT_name[8411:8430],
-	1320:       _HRESULT_name[8430:8451],
-	1321:       _HRESULT_name[8451:8476],
-	1322:       _HRESULT_name[8476:8492],
-	1323:       _HRESULT_name[8492:8512],
-	1324:       _HRESULT_name[8512:8537],
-	1325:       _HRESULT_name[8537:8563],
-	1326:       _HRESULT_name[8563:8582],
-	1327:       _HRESULT_name[8582:8607],
-	1328:       _HRESULT_name[8607:8632],
-	1329:       _HRESULT_name[8632:8657],
-	1330:       _HRESULT_name[8657:8679],
-	1331:       _HRESULT_name[8679:8701],
-	1332:       _HRESULT_name[8701:8718],
-	1333:       _HRESULT_name[8718:8748],
-	1334:       _HRESULT_name[8748:8769],
-	1335:       _HRESULT_name[8769:8796],
-	1336:       _HRESULT_name[8796:8813],
-	1337:       _HRESULT_name[8813:8830],
-	1338:       _HRESULT_name[8830:8858],
-	1340:       _HRESULT_name[8858:8883],
-	1341:       _HRESULT_name[8883:8904],
-	1342:       _HRESULT_name[8904:8929],
-	1343:       _HRESULT_name[8929:8955],
-	1344:       _HRESULT_name[8955:8984],
-	1345:       _HRESULT_name[8984:9014],
-	1346:       _HRESULT_name[9014:9043],
-	1347:       _HRESULT_name[9043:9068],
-	1348:       _HRESULT_name[9068:9094],
-	1349:       _HRESULT_name[9094:9114],
-	1350:       _HRESULT_name[9114:9141],
-	1351:       _HRESULT_name[9141:9170],
-	1352:       _HRESULT_name[9170:9196],
-	1353:       _HRESULT_name[9196:9222],
-	1354:       _HRESULT_name[9222:9247],
-	1355:       _HRESULT_name[9247:9267],
-	1356:       _HRESULT_name[9267:9286],
-	1357:       _HRESULT_name[9286:9313],
-	1358:       _HRESULT_name[9313:9341],
-	1359:       _HRESULT_name[9341:9361],
-	1360:       _HRESULT_name[9361:9385],
-	1361:       _HRESULT_name[9385:9412],
-	1362:       _HRESULT_name[9412:9435],
-	1363:       _HRESULT_name[9435:9461],
-	1364:       _HRESULT_name[9461:9482],
-	1365:       _HRESULT_name[9482:9511],
-	1366:       _HRESULT_name[9511:9540],
-	1367:       _HRESULT_name[9540:9564],
-	1368:       _HRESULT_name[9564:9588],
-	1369:       _HRESULT_name[9588:9613],
-	1370:       _HRESULT_name[9613:9639],
-	1371:       _HRESULT_name[9639:9660],
-	1372:       _HRESULT_name[9660:9679],
-	1373:       _HRESULT_name[9679:9697],
-	1374:       _HRESULT_name[9697:9724],
-	1375:       _HRESULT_name[9724:9750],
-	1376:       _HRESULT_name[9750:9769],
-	1377:       _HRESULT_name[9769:9794],
-	1378:       _HRESULT_name[9794:9815],
-	1379:       _HRESULT_name[9815:9833],
-	1380:       _HRESULT_name[9833:9856],
-	1381:       _HRESULT_name[9856:9878],
-	1382:       _HRESULT_name[9878:9899],
-	1383:       _HRESULT_name[9899:9922],
-	1384:       _HRESULT_name[9922:9948],
-	1385:       _HRESULT_name[9948:9976],
-	1386:       _HRESULT_name[9976:10010],
-	1387:       _HRESULT_name[10010:10030],
-	1388:       _HRESULT_name[10030:10050],
-	1389:       _HRESULT_name[10050:10069],
-	1390:       _HRESULT_name[10069:10103],
-	1391:       _HRESULT_name[10103:10123],
-	1392:       _HRESULT_name[10123:10141],
-	1393:       _HRESULT_name[10141:10159],
-	1394:       _HRESULT_name[10159:10184],
-	1395:       _HRESULT_name[10184:10212],
-	1400:       _HRESULT_name[10212:10239],
-	1401:       _HRESULT_name[10239:10264],
-	1402:       _HRESULT_name[10264:10291],
-	1403:       _HRESULT_name[10291:10317],
-	1404:       _HRESULT_name[10317:10342],
-	1405:       _HRESULT_name[10342:10366],
-	1406:       _HRESULT_name[10366:10388],
-	1407:       _HRESULT_name[10388:10415],
-	1408:       _HRESULT_name[10415:10443],
-	1409:       _HRESULT_name[10443:10474],
-	1410:       _HRESULT_name[10474:10500],
-	1411:       _HRESULT_name[10500:10526],
-	1412:       _HRESULT_name[10526:10549],
-	1413:       _HRESULT_name[10549:10568],
-	1414:       _HRESULT_name[10568:10593],
-	1415:       _HRESULT_name[10593:10619],
-	1416:       _HRESULT_name[10619:10645],
-	1417:       _HRESULT_name[10645:10673],
-	1418:       _HRESULT_name[10673:10697],
-	1419:       _HRESULT_name[10697:10724],
-	1420:       _HRESULT_name[10724:10747],
-	1421:       _HRESULT_name[10747:10773],
-	1422:       _HRESULT_name[10773:10803],
-	1423:       _HRESULT_name[10803:10828],
-	1424:       _HRESULT_name[10828:10853],
-	1425:       _HRESULT_name[10853:10871],
-	1426:       _HRESULT_name[10871:10896],
-	1427:       _HRESULT_name[10896:10921],
-	1428:       _HRESULT_name[10921:10942],
-	1429:       _HRESULT_name[10942:10964],
-	1430:       _HRESULT_name[10964:10986],
-	1431:       _HRESULT_name[10986:11010],
-	1432:       _HRESULT_name[11010:11034],
-	1433:       _HRESULT_name[11034:11058],
-	1434:       _HRESULT_name[11058:11083],
-	1435:       _HRESULT_name[11083:11119],
-	1436:       _HRESULT_name[11119:11142],
-	1437:       _HRESULT_name[11142:11162],
-	1438:       _HRESULT_name[11162:11188],
-	1439:       _HRESULT_name[11188:11211],
-	1440:       _HRESULT_name[11211:11238],
-	1441:       _HRESULT_name[11238:11266],
-	1442:       _HRESULT_name[11266:11288],
-	1443:       _HRESULT_name[11288:11312],
-	1444:       _HRESULT_name[11312:11335],
-	1445:       _HRESULT_name[11335:11360],
-	1446:       _HRESULT_name[11360:11386],
-	1447:       _HRESULT_name[11386:11405],
-	1448:       _HRESULT_name[11405:11434],
-	1449:       _HRESULT_name[11434:11463],
-	1450:       _HRESULT_name[11463:11488],
-	1451:       _HRESULT_name[11488:11519],
-	1452:       _HRESULT_name[11519:11547],
-	1453:       _HRESULT_name[11547:11570],
-	1454:       _HRESULT_name[11570:11590],
-	1455:       _HRESULT_name[11590:11612],
-	1456:       _HRESULT_name[11612:11637],
-	1457:       _HRESULT_name[11637:11666],
-	1458:       _HRESULT_name[11666:11693],
-	1459:       _HRESULT_name[11693:11733],
-	1460:       _HRESULT_name[11733:11746],
-	1461:       _HRESULT_name[11746:11774],
-	1500:       _HRESULT_name[11774:11801],
-	1501:       _HRESULT_name[11801:11826],
-	1502:       _HRESULT_name[11826:11845],
-	1503:       _HRESULT_name[11845:11872],
-	1601:       _HRESULT_name[11872:11893],
-	1602:       _HRESULT_name[11893:11915],
-	1603:       _HRESULT_name[11915:11936],
-	1604:       _HRESULT_name[11936:11957],
-	1605:       _HRESULT_name[11957:11978],
-	1606:       _HRESULT_name[11978:11999],
-	1607:       _HRESULT_name[11999:12022],
-	1608:       _HRESULT_name[12022:12044],
-	1609:       _HRESULT_name[12044:12070],
-	1610:       _HRESULT_name[12070:12093],
-	1611:       _HRESULT_name[12093:12111],
-	1612:       _HRESULT_name[12111:12138],
-	1613:       _HRESULT_name[12138:12164],
-	1614:       _HRESULT_name[12164:12189],
-	1615:       _HRESULT_name[12189:12211],
-	1616:       _HRESULT_name[12211:12230],
-	1700:       _HRESULT_name[12230:12258],
-	1701:       _HRESULT_name[12258:12285],
-	1702:       _HRESULT_name[12285:12306],
-	1703:       _HRESULT_name[12306:12333],
-	1704:       _HRESULT_name[12333:12358],
-	1705:       _HRESULT_name[12358:12383],
-	1706:       _HRESULT_name[12383:12412],
-	1707:       _HRESULT_name[12412:12434],
-	1708:       _HRESULT_name[12434:12457],
-	1709:       _HRESULT_name[12457:12478],
-	1710:       _HRESULT_name[12478:12500],
-	1711:       _HRESULT_name[12500:12524],
-	1712:       _HRESULT_name[12524:12553],
-	1713:       _HRESULT_name[12553:12576],
-	1714:       _HRESULT_name[12576:12604],
-	1715:       _HRESULT_name[12604:12623],
-	1716:       _HRESULT_name[12623:12645],
-	1717:       _HRESULT_name[12645:12661],
-	1718:       _HRESULT_name[12661:12678],
-	1719:       _HRESULT_name[12678:12695],
-	1720:       _HRESULT_name[12695:12721],
-	1721:       _HRESULT_name[12721:12743],
-	1722:       _HRESULT_name[12743:12767],
-	1723:       _HRESULT_name[12767:12788],
-	1724:       _HRESULT_name[12788:12817],
-	1725:       _HRESULT_name[12817:12837],
-	1726:       _HRESULT_name[12837:12854],
-	1727:       _HRESULT_name[12854:12875],
-	1728:       _HRESULT_name[12875:12895],
-	1730:       _HRESULT_name[12895:12922],
-	1732:       _HRESULT_name[12922:12944],
-	1733:       _HRESULT_name[12944:12961],
-	1734:       _HRESULT_name[12961:12980],
-	1735:       _HRESULT_name[12980:12999],
-	1736:       _HRESULT_name[12999:13024],
-	1737:       _HRESULT_name[13024:13053],
-	1739:       _HRESULT_name[13053:13074],
-	1740:       _HRESULT_name[13074:13098],
-	1741:       _HRESULT_name[13098:13122],
-	1742:       _HRESULT_name[13122:13147],
-	1743:       _HRESULT_name[13147:13168],
-	1744:       _HRESULT_name[13168:13191],
-	1745:       _HRESULT_name[13191:13217],
-	1746:       _HRESULT_name[13217:13242],
-	1747:       _HRESULT_name[13242:13269],
-	1748:       _HRESULT_name[13269:13294],
-	1749:       _HRESULT_name[13294:13321],
-	1750:       _HRESULT_name[13321:13348],
-	1751:       _HRESULT_name[13348:13367],
-	1752:       _HRESULT_name[13367:13388],
-	1753:       _HRESULT_name[13388:13408],
-	1754:       _HRESULT_name[13408:13431],
-	1755:       _HRESULT_name[13431:13452],
-	1756:       _HRESULT_name[13452:13477],
-	1757:       _HRESULT_name[13477:13498],
-	1758:       _HRESULT_name[13498:13527],
-	1759:       _HRESULT_name[13527:13552],
-	1760:       _HRESULT_name[13552:13578],
-	1761:       _HRESULT_name[13578:13599],
-	1762:       _HRESULT_name[13599:13629],
-	1763:       _HRESULT_name[13629:13649],
-	1764:       _HRESULT_name[13649:13669],
-	1765:       _HRESULT_name[13669:13695],
-	1766:       _HRESULT_name[13695:13715],
-	1767:       _HRESULT_name[13715:13732],
-	1768:       _HRESULT_name[13732:13751],
-	1769:       _HRESULT_name[13751:13768],
-	1770:       _HRESULT_name[13768:13786],
-	1771:       _HRESULT_name[13786:13803],
-	1772:       _HRESULT_name[13803:13824],
-	1773:       _HRESULT_name[13824:13853],
-	1774:       _HRESULT_name[13853:13883],
-	1775:       _HRESULT_name[13883:13907],
-	1777:       _HRESULT_name[13907:13931],
-	1778:       _HRESULT_name[13931:13956],
-	1779:       _HRESULT_name[13956:13987],
-	1780:       _HRESULT_name[13987:14009],
-	1781:       _HRESULT_name[14009:14038],
-	1782:       _HRESULT_name[14038:14064],
-	1783:       _HRESULT_name[14064:14083],
-	1784:       _HRESULT_name[14083:14108],
-	1785:       _HRESULT_name[14108:14132],
-	1786:       _HRESULT_name[14132:14157],
-	1787:       _HRESULT_name[14157:14183],
-	1788:       _HRESULT_name[14183:14211],
-	1789:       _HRESULT_name[14211:14245],
-	1790:       _HRESULT_name[14245:14264],
-	1791:       _HRESULT_name[14264:14286],
-	1792:       _HRESULT_name[14286:14312],
-	1793:       _HRESULT_name[14312:14333],
-	1794:       _HRESULT_name[14333:14366],
-	1795:       _HRESULT_name[14366:14404],
-	1796:       _HRESULT_name[14404:14422],
-	1797:       _HRESULT_name[14422:14450],
-	1798:       _HRESULT_name[14450:14478],
-	1799:       _HRESULT_name[14478:14506],
-	1800:       _HRESULT_name[14506:14528],
-	1801:       _HRESULT_name[14528:14554],
-	1802:       _HRESULT_name[14554:14582],
-	1803:       _HRESULT_name[14582:14611],
-	1804:       _HRESULT_name[14611:14633],
-	1805:       _HRESULT_name[14633:14658],
-	1806:       _HRESULT_name[14658:14680],
-	1807:       _HRESULT_name[14680:14719],
-	1808:       _HRESULT_name[14719:14758],
-	1809:       _HRESULT_name[14758:14792],
-	1810:       _HRESULT_name[14792:14823],
-	1811:       _HRESULT_name[14823:14852],
-	1812:       _HRESULT_name[14852:14881],
-	1813:       _HRESULT_name[14881:14910],
-	1814:       _HRESULT_name[14910:14939],
-	1815:       _HRESULT_name[14939:14968],
-	1816:       _HRESULT_name[14968:14990],
-	1817:       _HRESULT_name[14990:15009],
-	1818:       _HRESULT_name[15009:15029],
-	1819:       _HRESULT_name[15029:15053],
-	1820:       _HRESULT_name[15053:15071],
-	1821:       _HRESULT_name[15071:15100],
-	1822:       _HRESULT_name[15100:15119],
-	1823:       _HRESULT_name[15119:15138],
-	1824:       _HRESULT_name[15138:15159],
-	1825:       _HRESULT_name[15159:15178],
-	1826:       _HRESULT_name[15178:15197],
-	1827:       _HRESULT_name[15197:15220],
-	1828:       _HRESULT_name[15220:15242],
-	1829:       _HRESULT_name[15242:15266],
-	1830:       _HRESULT_name[15266:15291],
-	1831:       _HRESULT_name[15291:15313],
-	1832:       _HRESULT_name[15313:15337],
-	1898:       _HRESULT_name[15337:15365],
-	1899:       _HRESULT_name[15365:15382],
-	1900:       _HRESULT_name[15382:15402],
-	1901:       _HRESULT_name[15402:15420],
-	1902:       _HRESULT_name[15420:15443],
-	1903:       _HRESULT_name[15443:15466],
-	1904:       _HRESULT_name[15466:15487],
-	1905:       _HRESULT_name[15487:15508],
-	1906:       _HRESULT_name[15508:15535],
-	1907:       _HRESULT_name[15535:15561],
-	1908:       _HRESULT_name[15561:15594],
-	1909:       _HRESULT_name[15594:15618],
-	1910:       _HRESULT_name[15618:15633],
-	1911:       _HRESULT_name[15633:15647],
-	1912:       _HRESULT_name[15647:15661],
-	1913:       _HRESULT_name[15661:15682],
-	1914:       _HRESULT_name[15682:15708],
-	1915:       _HRESULT_name[15708:15732],
-	1916:       _HRESULT_name[15732:15749],
-	1917:       _HRESULT_name[15749:15776],
-	1918:       _HRESULT_name[15776:15792],
-	1919:       _HRESULT_name[15792:15809],
-	1920:       _HRESULT_name[15809:15831],
-	1921:       _HRESULT_name[15831:15858],
-	1922:       _HRESULT_name[15858:15895],
-	1923:       _HRESULT_name[15895:15925],
-	1924:       _HRESULT_name[15925:15958],
-	1925:       _HRESULT_name[15958:15991],
-	1926:       _HRESULT_name[15991:16025],
-	1927:       _HRESULT_name[16025:16038],
-	1928:       _HRESULT_name[16038:16058],
-	1929:       _HRESULT_name[16058:16084],
-	1930:       _HRESULT_name[16084:16105],
-	1931:       _HRESULT_name[16105:16134],
-	1932:       _HRESULT_name[16134:16160],
-	1933:       _HRESULT_name[16160:16188],
-	1934:       _HRESULT_name[16188:16213],
-	1935:       _HRESULT_name[16213:16233],
-	1936:       _HRESULT_name[16233:16260],
-	1937:       _HRESULT_name[16260:16289],
-	1938:       _HRESULT_name[16289:16314],
-	2000:       _HRESULT_name[16314:16340],
-	2001:       _HRESULT_name[16340:16356],
-	2002:       _HRESULT_name[16356:16382],
-	2003:       _HRESULT_name[16382:16410],
-	2004:       _HRESULT_name[16410:16439],
-	2005:       _HRESULT_name[16439:16467],
-	2108:       _HRESULT_name[16467:16497],
-	2202:       _HRESULT_name[16497:16515],
-	2250:       _HRESULT_name[16515:16534],
-	2300:       _HRESULT_name[16534:16551],
-	2301:       _HRESULT_name[16551:16572],
-	2302:       _HRESULT_name[16572:16591],
-	2303:       _HRESULT_name[16591:16612],
-	2304:       _HRESULT_name[16612:16631],
-	2305:       _HRESULT_name[16631:16671],
-	2306:       _HRESULT_name[16671:16694],
-	2307:       _HRESULT_name[16694:16718],
-	2308:       _HRESULT_name[16718:16739],
-	2309:       _HRESULT_name[16739:16763],
-	2310:       _HRESULT_name[16763:16786],
-	2401:       _HRESULT_name[16786:16802],
-	2402:       _HRESULT_name[16802:16826],
-	2404:       _HRESULT_name[16826:16845],
-	3000:       _HRESULT_name[16845:16872],
-	3001:       _HRESULT_name[16872:16899],
-	3002:       _HRESULT_name[16899:16925],
-	3003:       _HRESULT_name[16925:16946],
-	3004:       _HRESULT_name[16946:16965],
-	3005:       _HRESULT_name[16965:17004],
-	3006:       _HRESULT_name[17004:17041],
-	3007:       _HRESULT_name[17041:17068],
-	3008:       _HRESULT_name[17068:17094],
-	3009:       _HRESULT_name[17094:17123],
-	3010:       _HRESULT_name[17123:17152],
-	3011:       _HRESULT_name[17152:17182],
-	4000:       _HRESULT_name[17182:17201],
-	4001:       _HRESULT_name[17201:17229],
-	4002:       _HRESULT_name[17229:17246],
-	4003:       _HRESULT_name[17246:17262],
-	4004:       _HRESULT_name[17262:17279],
-	4005:       _HRESULT_name[17279:17301],
-	4006:       _HRESULT_name[17301:17322],
-	4100:       _HRESULT_name[17322:17349],
-	4200:       _HRESULT_name[17349:17373],
-	4201:       _HRESULT_name[17373:17401],
-	4202:       _HRESULT_name[17401:17427],
-	4203:       _HRESULT_name[17427:17446],
-	4204:       _HRESULT_name[17446:17468],
-	4205:       _HRESULT_name[17468:17501],
-	4206:       _HRESULT_name[17501:17526],
-	4207:       _HRESULT_name[17526:17553],
-	4208:       _HRESULT_name[17553:17581],
-	4209:       _HRESULT_name[17581:17600],
-	4210:       _HRESULT_name[17600:17621],
-	4211:       _HRESULT_name[17621:17646],
-	4300:       _HRESULT_name[17646:17665],
-	4301:       _HRESULT_name[17665:17686],
-	4302:       _HRESULT_name[17686:17710],
-	4303:       _HRESULT_name[17710:17736],
-	4304:       _HRESULT_name[17736:17755],
-	4305:       _HRESULT_name[17755:17776],
-	4306:       _HRESULT_name[17776:17787],
-	4307:       _HRESULT_name[17787:17802],
-	4308:       _HRESULT_name[17802:17825],
-	4309:       _HRESULT_name[17825:17848],
-	4310:       _HRESULT_name[17848:17869],
-	4311:       _HRESULT_name[17869:17890],
-	4312:       _HRESULT_name[17890:17912],
-	4313:       _HRESULT_name[17912:17934],
-	4314:       _HRESULT_name[17934:17953],
-	4315:       _HRESULT_name[17953:17977],
-	4316:       _HRESULT_name[17977:18003],
-	4317:       _HRESULT_name[18003:18026],
-	4318:       _HRESULT_name[18026:18051],
-	4319:       _HRESULT_name[18051:18077],
-	4320:       _HRESULT_name[18077:18098],
-	4350:       _HRESULT_name[18098:18116],
-	4351:       _HRESULT_name[18116:18147],
-	4352:       _HRESULT_name[18147:18179],
-	4390:       _HRESULT_name[18179:18204],
-	4391:       _HRESULT_name[18204:18236],
-	5001:       _HRESULT_name[18236:18267],
-	5002:       _HRESULT_name[18267:18293],
-	5003:       _HRESULT_name[18293:18324],
-	5004:       _HRESULT_name[18324:18349],
-	5005:       _HRESULT_name[18349:18378],
-	5006:       _HRESULT_name[18378:18406],
-	5007:       _HRESULT_name[18406:18430],
-	5008:       _HRESULT_name[18430:18452],
-	5009:       _HRESULT_name[18452:18480],
-	5010:       _HRESULT_name[18480:18507],
-	5011:       _HRESULT_name[18507:18527],
-	5012:       _HRESULT_name[18527:18552],
-	5013:       _HRESULT_name[18552:18573],
-	5014:       _HRESULT_name[18573:18595],
-	5015:       _HRESULT_name[18595:18629],
-	5016:       _HRESULT_name[18629:18660],
-	5017:       _HRESULT_name[18660:18686],
-	5018:       _HRESULT_name[18686:18712],
-	5019:       _HRESULT_name[18712:18733],
-	5020:       _HRESULT_name[18733:18754],
-	5021:       _HRESULT_name[18754:18778],
-	5022:       _HRESULT_name[18778:18805],
-	5023:       _HRESULT_name[18805:18824],
-	5024:       _HRESULT_name[18824:18856],
-	5025:       _HRESULT_name[18856:18878],
-	5026:       _HRESULT_name[18878:18897],
-	5027:       _HRESULT_name[18897:18932],
-	5028:       _HRESULT_name[18932:18959],
-	5029:       _HRESULT_name[18959:18983],
-	5030:       _HRESULT_name[18983:19022],
-	5031:       _HRESULT_name[19022:19054],
-	5032:       _HRESULT_name[19054:19089],
-	5033:       _HRESULT_name[19089:19122],
-	6000:       _HRESULT_name[19122:19145],
-	6001:       _HRESULT_name[19145:19168],
-	6002:       _HRESULT_name[19168:19188],
-	6003:       _HRESULT_name[19188:19212],
-	6004:       _HRESULT_name[19212:19224],
-	6005:       _HRESULT_name[19224:19239],
-	6006:       _HRESULT_name[19239:19257],
-	6007:       _HRESULT_name[19257:19281],
-	6008:       _HRESULT_name[19281:19304],
-	6118:       _HRESULT_name[19304:19334],
-	197120:     _HRESULT_name[19334:19349],
-	197121:     _HRESULT_name[19349:19360],
-	197122:     _HRESULT_name[19360:19374],
-	197123:     _HRESULT_name[19374:19390],
-	197124:     _HRESULT_name[19390:19409],
-	197125:     _HRESULT_name[19409:19434],
-	197126:     _HRESULT_name[19434:19457],
-	262144:     _HRESULT_name[19457:19468],
-	262145:     _HRESULT_name[19468:19480],
-	262146:     _HRESULT_name[19480:19500],
-	262399:     _HRESULT_name[19500:19510],
-	262400:     _HRESULT_name[19510:19526],
-	262401:     _HRESULT_name[19526:19543],
-	262402:     _HRESULT_name[19543:19571],
-	262415:     _HRESULT_name[19571:19586],
-	262416:     _HRESULT_name[19586:19606],
-	262431:     _HRESULT_name[19606:19625],
-	262432:     _HRESULT_name[19625:19640],
-	262447:     _HRESULT_name[19640:19654],
-	262448:     _HRESULT_name[19654:19666],
-	262463:     _HRESULT_name[19666:19677],
-	262464:     _HRESULT_name[19677:19689],
-	262479:     _HRESULT_name[19689:19700],
-	262480:     _HRESULT_name[19700:19713],
-	262495:     _HRESULT_name[19713:19725],
-	262512:     _HRESULT_name[19725:19738],
-	262513:     _HRESULT_name[19738:19755],
-	262514:     _HRESULT_name[19755:19784],
-	262527:     _HRESULT_name[19784:19796],
-	262528:     _HRESULT_name[19796:19810],
-	262529:     _HRESULT_name[19810:19836],
-	262530:     _HRESULT_name[19836:19856],
-	262543:     _HRESULT_name[19856:19869],
-	262544:     _HRESULT_name[19869:19887],
-	262559:     _HRESULT_name[19887:19904],
-	262560:     _HRESULT_name[19904:19919],
-	262575:     _HRESULT_name[19919:19933],
-	262576:     _HRESULT_name[19933:19945],
-	262591:     _HRESULT_name[19945:19956],
-	262592:     _HRESULT_name[19956:19973],
-	262607:     _HRESULT_name[19973:19989],
-	262608:     _HRESULT_name[19989:20004],
-	262623:     _HRESULT_name[20004:20018],
-	262624:     _HRESULT_name[20018:20028],
-	262626:     _HRESULT_name[20028:20048],
-	262628:     _HRESULT_name[20048:20055],
-	262629:     _HRESULT_name[20055:20063],
-	262630:     _HRESULT_name[20063:20070],
-	262631:     _HRESULT_name[20070:20099],
-	262639:     _HRESULT_name[20099:20108],
-	262640:     _HRESULT_name[20108:20118],
-	262655:     _HRESULT_name[20118:20127],
-	524306:     _HRESULT_name[20127:20148],
-	2147483658: _HRESULT_name[20148:20157],
-	2147500033: _HRESULT_name[20157:20166],
-	2147500034: _HRESULT_name[20166:20179],
-	2147500035: _HRESULT_name[20179:20188],
-	2147500036: _HRESULT_name[20188:20195],
-	2147500037: _HRESULT_name[20195:20201],
-	2147500038: _HRESULT_name[20201:20214],
-	2147500039: _HRESULT_name[20214:20240],
-	2147500040: _HRESULT_name[20240:20266],
-	2147500041: _HRESULT_name[20266:20287],
-	2147500042: _HRESULT_name[20287:20308],
-	2147500043: _HRESULT_name[20308:20341],
-	2147500044: _HRESULT_name[20341:20370],
-	2147500045: _HRESULT_name[20370:20405],
-	2147500046: _HRESULT_name[20405:20431],
-	2147500047: _HRESULT_name[20431:20464],
-	2147500048: _HRESULT_name[20464:20494],
-	2147500049: _HRESULT_name[20494:20520],
-	2147500050: _HRESULT_name[20520:20550],
-	2147500051: _HRESULT_name[20550:20566],
-	2147500052: _HRESULT_name[20566:20586],
-	2147500053: _HRESULT_name[20586:20612],
-	2147500054: _HRESULT_name[20612:20633],
-	2147500055: _HRESULT_name[20633:20650],
-	2147500056: _HRESULT_name[20650:20676],
-	2147500057: _HRESULT_name[20676:20708],
-	2147500058: _HRESULT_name[20708:20732],
-	2147500059: _HRESULT_name[20732:20760],
-	2147500060: _HRESULT_name[20760:20786],
-	2147500061: _HRESULT_name[20786:20819],
-	2147500062: _HRESULT_name[20819:20844],
-	2147500063: _HRESULT_name[20844:20868],
-	2147500064: _HRESULT_name[20868:20892],
-	2147500065: _HRESULT_name[20892:20910],
-	2147500066: _HRESULT_name[20910:20925],
-	2147500067: _HRESULT_name[20925:20939],
-	2147549183: _HRESULT_name[20939:20951],
-	2147549185: _HRESULT_name[20951:20970],
-	2147549186: _HRESULT_name[20970:20989],
-	2147549187: _HRESULT_name[20989:21014],
-	2147549188: _HRESULT_name[21014:21043],
-	2147549189: _HRESULT_name[21043:21075],
-	2147549190: _HRESULT_name[21075:21102],
-	2147549191: _HRESULT_name[21102:21119],
-	2147549192: _HRESULT_name[21119:21136],
-	2147549193: _HRESULT_name[21136:21160],
-	2147549194: _HRESULT_name[21160:21183],
-	2147549195: _HRESULT_name[21183:21212],
-	2147549196: _HRESULT_name[21212:21243],
-	2147549197: _HRESULT_name[21243:21272],
-	2147549198: _HRESULT_name[21272:21303],
-	2147549199: _HRESULT_name[21303:21321],
-	2147549200: _HRESULT_name[21321:21344],
-	2147549201: _HRESULT_name[21344:21367],
-	2147549202: _HRESULT_name[21367:21388],
-	2147549440: _HRESULT_name[21388:21409],
-	2147549441: _HRESULT_name[21409:21431],
-	2147549442: _HRESULT_name[21431:21458],
-	2147549443: _HRESULT_name[21458:21478],
-	2147549444: _HRESULT_name[21478:21489],
-	2147549445: _HRESULT_name[21489:21506],
-	2147549446: _HRESULT_name[21506:21524],
-	2147549447: _HRESULT_name[21524:21543],
-	2147549448: _HRESULT_name[21543:21561],
-	2147549449: _HRESULT_name[21561:21572],
-	2147549450: _HRESULT_name[21572:21599],
-	2147549451: _HRESULT_name[21599:21624],
-	2147549452: _HRESULT_name[21624:21646],
-	2147549453: _HRESULT_name[21646:21679],
-	2147549454: _HRESULT_name[21679:21697],
-	2147549455: _HRESULT_name[21697:21718],
-	2147549456: _HRESULT_name[21718:21740],
-	2147549457: _HRESULT_name[21740:21760],
-	2147549458: _HRESULT_name[21760:21783],
-	2147549459: _HRESULT_name[21783:21801],
-	2147549460: _HRESULT_name[21801:21821],
-	2147549461: _HRESULT_name[21821:21838],
-	2147549462: _HRESULT_name[21838:21855],
-	2147549463: _HRESULT_name[21855:21874],
-	2147549464: _HRESULT_name[21874:21893],
-	2147549465: _HRESULT_name[21893:21907],
-	2147549466: _HRESULT_name[21907:21938],
-	2147549467: _HRESULT_name[21938:21957],
-	2147549468: _HRESULT_name[21957:21978],
-	2147549469: _HRESULT_name[21978:21998],
-	2147549470: _HRESULT_name[21998:22014],
-	2147549471: _HRESULT_name[22014:22027],
-	2147549472: _HRESULT_name[22027:22040],
-	2147614719: _HRESULT_name[22040:22056],
-	2147614721: _HRESULT_name[22056:22079],
-	2147614723: _HRESULT_name[22079:22100],
-	2147614724: _HRESULT_name[22100:22120],
-	2147614725: _HRESULT_name[22120:22139],
-	2147614726: _HRESULT_name[22139:22157],
-	2147614727: _HRESULT_name[22157:22175],
-	2147614728: _HRESULT_name[22175:22192],
-	2147614729: _HRESULT_name[22192:22208],
-	2147614730: _HRESULT_name[22208:22223],
-	2147614731: _HRESULT_name[22223:22238],
-	2147614732: _HRESULT_name[22238:22256],
-	2147614733: _HRESULT_name[22256:22276],
-	2147614734: _HRESULT_name[22276:22296],
-	2147614735: _HRESULT_name[22296:22319],
-	2147614736: _HRESULT_name[22319:22335],
-	2147614737: _HRESULT_name[22335:22356],
-	2147614738: _HRESULT_name[22356:22372],
-	2147647510: _HRESULT_name[22372:22393],
-	2147647511: _HRESULT_name[22393:22413],
-	2147647512: _HRESULT_name[22413:22431],
-	2147647513: _HRESULT_name[22431:22449],
-	2147647516: _HRESULT_name[22449:22470],
-	2147647517: _HRESULT_name[22470:22493],
-	2147647527: _HRESULT_name[22493:22513],
-	2147647528: _HRESULT_name[22513:22543],
-	2147647529: _HRESULT_name[22543:22562],
-	2147647530: _HRESULT_name[22562:22582],
-	2147647531: _HRESULT_name[22582:22604],
-	2147647532: _HRESULT_name[22604:22624],
-	2147647533: _HRESULT_name[22624:22643],
-	2147647534: _HRESULT_name[22643:22661],
-	2147647535: _HRESULT_name[22661:22687],
-	2147649725: _HRESULT_name[22687:22707],
-	2147649733: _HRESULT_name[22707:22724],
-	2147649734: _HRESULT_name[22724:22742],
-	2147649743: _HRESULT_name[22742:22758],
-	2147650720: _HRESULT_name[22758:22777],
-	2147650721: _HRESULT_name[22777:22795],
-	2147650722: _HRESULT_name[22795:22809],
-	2147650723: _HRESULT_name[22809:22833],
-	2147654730: _HRESULT_name[22833:22855],
-	2147654787: _HRESULT_name[22855:22883],
-	2147654788: _HRESULT_name[22883:22902],
-	2147680257: _HRESULT_name[22902:22923],
-	2147680258: _HRESULT_name[22923:22941],
-	2147680259: _HRESULT_name[22941:22959],
-	2147680260: _HRESULT_name[22959:22981],
-	2147680261: _HRESULT_name[22981:22999],
-	2147680262: _HRESULT_name[22999:23018],
-	2147680264: _HRESULT_name[23018:23042],
-	2147680265: _HRESULT_name[23042:23062],
-	2147680274: _HRESULT_name[23062:23079],
-	2147680275: _HRESULT_name[23079:23105],
-	2147680281: _HRESULT_name[23105:23120],
-	2147680285: _HRESULT_name[23120:23136],
-	2147680286: _HRESULT_name[23136:23151],
-	2147680288: _HRESULT_name[23151:23171],
-	2147680289: _HRESULT_name[23171:23190],
-	2147680336: _HRESULT_name[23190:23213],
-	2147680343: _HRESULT_name[23213:23235],
-	2147680368: _HRESULT_name[23235:23251],
-	2147680496: _HRESULT_name[23251:23274],
-	2147680506: _HRESULT_name[23274:23295],
-	2147680507: _HRESULT_name[23295:23314],
-	2147680508: _HRESULT_name[23314:23331],
-	2147680509: _HRESULT_name[23331:23344],
-	2147680510: _HRESULT_name[23344:23371],
-	2147680511: _HRESULT_name[23371:23388],
-	2147680512: _HRESULT_name[23388:23399],
-	2147680513: _HRESULT_name[23399:23415],
-	2147680514: _HRESULT_name[23415:23429],
-	2147680515: _HRESULT_name[23429:23443],
-	2147680516: _HRESULT_name[23443:23458],
-	2147680517: _HRESULT_name[23458:23470],
-	2147680518: _HRESULT_name[23470:23489],
-	2147680519: _HRESULT_name[23489:23514],
-	2147680520: _HRESULT_name[23514:23538],
-	2147680521: _HRESULT_name[23538:23558],
-	2147680528: _HRESULT_name[23558:23578],
-	2147680769: _HRESULT_name[23578:23594],
-	2147680770: _HRESULT_name[23594:23610],
-	2147745792: _HRESULT_name[23610:23621],
-	2147745793: _HRESULT_name[23621:23631],
-	2147745794: _HRESULT_name[23631:23648],
-	2147745795: _HRESULT_name[23648:23672],
-	2147745796: _HRESULT_name[23672:23690],
-	2147745797: _HRESULT_name[23690:23706],
-	2147745798: _HRESULT_name[23706:23719],
-	2147745799: _HRESULT_name[23719:23730],
-	2147745800: _HRESULT_name[23730:23745],
-	2147745801: _HRESULT_name[23745:23766],
-	2147745802: _HRESULT_name[23766:23789],
-	2147745803: _HRESULT_name[23789:23801],
-	2147745804: _HRESULT_name[23801:23826],
-	2147745805: _HRESULT_name[23826:23843],
-	2147745806: _HRESULT_name[23843:23861],
-	2147745807: _HRESULT_name[23861:23878],
-	2147745808: _HRESULT_name[23878:23901],
-	2147745809: _HRESULT_name[23901:23918],
-	2147745810: _HRESULT_name[23918:23933],
-	2147745892: _HRESULT_name[23933:23947],
-	2147745893: _HRESULT_name[23947:23966],
-	2147745894: _HRESULT_name[23966:23980],
-	2147745895: _HRESULT_name[23980:23993],
-	2147745896: _HRESULT_name[23993:24004],
-	2147745897: _HRESULT_name[24004:24014],
-	2147745898: _HRESULT_name[24014:24029],
-	2147745899: _HRESULT_name[24029:24042],
-	2147745900: _HRESULT_name[24042:24066],
-	2147745901: _HRESULT_name[24066:24084],
-	2147746047: _HRESULT_name[24084:24094],
-	2147746048: _HRESULT_name[24094:24110],
-	2147746049: _HRESULT_name[24110:24138],
-	2147746050: _HRESULT_name[24138:24160],
-	2147746063: _HRESULT_name[24160:24175],
-	2147746064: _HRESULT_name[24175:24195],
-	2147746065: _HRESULT_name[24195:24220],
-	2147746066: _HRESULT_name[24220:24239],
-	2147746079: _HRESULT_name[24239:24258],
-	2147746080: _HRESULT_name[24258:24273],
-	2147746095: _HRESULT_name[24273:24287],
-	2147746096: _HRESULT_name[24287:24299],
-	2147746111: _HRESULT_name[24299:24310],
-	2147746112: _HRESULT_name[24310:24322],
-	2147746127: _HRESULT_name[24322:24333],
-	2147746128: _HRESULT_name[24333:24346],
-	2147746129: _HRESULT_name[24346:24364],
-	2147746130: _HRESULT_name[24364:24382],
-	2147746131: _HRESULT_name[24382:24402],
-	2147746132: _HRESULT_name[24402:24421],
-	2147746133: _HRESULT_name[24421:24438],
-	2147746143: _HRESULT_name[24438:24450],
-	2147746144: _HRESULT_name[24450:24461],
-	2147746145: _HRESULT_name[24461:24471],
-	2147746148: _HRESULT_name[24471:24481],
-	2147746149: _HRESULT_name[24481:24499],
-	2147746150: _HRESULT_name[24499:24518],
-	2147746151: _HRESULT_name[24518:24538],
-	2147746152: _HRESULT_name[24538:24547],
-	2147746160: _HRESULT_name[24547:24560],
-	2147746175: _HRESULT_name[24560:24572],
-	2147746176: _HRESULT_name[24572:24586],
-	2147746177: _HRESULT_name[24586:24606],
-	2147746191: _HRESULT_name[24606:24619],
-	2147746192: _HRESULT_name[24619:24637],
-	2147746207: _HRESULT_name[24637:24654],
-	2147746208: _HRESULT_name[24654:24675],
-	2147746209: _HRESULT_name[24675:24696],
-	2147746223: _HRESULT_name[24696:24710],
-	2147746224: _HRESULT_name[24710:24722],
-	2147746239: _HRESULT_name[24722:24733],
-	2147746240: _HRESULT_name[24733:24750],
-	2147746241: _HRESULT_name[24750:24775],
-	2147746242: _HRESULT_name[24775:24800],
-	2147746243: _HRESULT_name[24800:24835],
-	2147746244: _HRESULT_name[24835:24854],
-	2147746245: _HRESULT_name[24854:24883],
-	2147746246: _HRESULT_name[24883:24912],
-	2147746255: _HRESULT_name[24912:24928],
-	2147746256: _HRESULT_name[24928:24943],
-	2147746257: _HRESULT_name[24943:24963],
-	2147746258: _HRESULT_name[24963:24981],
-	2147746259: _HRESULT_name[24981:24999],
-	2147746260: _HRESULT_name[24999:25019],
-	2147746271: _HRESULT_name[25019:25033],
-	2147746272: _HRESULT_name[25033:25043],
-	2147746273: _HRESULT_name[25043:25064],
-	2147746274: _HRESULT_name[25064:25080],
-	2147746275: _HRESULT_name[25080:25096],
-	2147746276: _HRESULT_name[25096:25107],
-	2147746277: _HRESULT_name[25107:25120],
-	2147746278: _HRESULT_name[25120:25141],
-	2147746279: _HRESULT_name[25141:25179],
-	2147746280: _HRESULT_name[25179:25195],
-	2147746281: _HRESULT_name[25195:25208],
-	2147746282: _HRESULT_name[25208:25225],
-	2147746283: _HRESULT_name[25225:25244],
-	2147746284: _HRESULT_name[25244:25258],
-	2147746285: _HRESULT_name[25258:25272],
-	2147746286: _HRESULT_name[25272:25285],
-	2147746287: _HRESULT_name[25285:25294],
-	2147746288: _HRESULT_name[25294:25304],
-	2147746289: _HRESULT_name[25304:25327],
-	2147746290: _HRESULT_name[25327:25350],
-	2147746291: _HRESULT_name[25350:25366],
-	2147746292: _HRESULT_name[25366:25380],
-	2147746293: _HRESULT_name[25380:25396],
-	2147746294: _HRESULT_name[25396:25413],
-	2147746295: _HRESULT_name[25413:25428],
-	2147746296: _HRESULT_name[25428:25444],
-	2147746297: _HRESULT_name[25444:25459],
-	2147746298: _HRESULT_name[25459:25477],
-	2147746299: _HRESULT_name[25477:25491],
-	2147746300: _HRESULT_name[25491:25504],
-	2147746301: _HRESULT_name[25504:25524],
-	2147746302: _HRESULT_name[25524:25540],
-	2147746303: _HRESULT_name[25540:25549],
-	2147746304: _HRESULT_name[25549:25573],
-	2147746305: _HRESULT_name[25573:25595],
-	2147746306: _HRESULT_name[25595:25623],
-	2147746307: _HRESULT_name[25623:25648],
-	2147746308: _HRESULT_name[25648:25677],
-	2147746309: _HRESULT_name[25677:25708],
-	2147746310: _HRESULT_name[25708:25728],
-	2147746311: _HRESULT_name[25728:25750],
-	2147746312: _HRESULT_name[25750:25773],
-	2147746313: _HRESULT_name[25773:25800],
-	2147746314: _HRESULT_name[25800:25815],
-	2147746315: _HRESULT_name[25815:25836],
-	2147746316: _HRESULT_name[25836:25859],
-	2147746317: _HRESULT_name[25859:25882],
-	2147746318: _HRESULT_name[25882:25906],
-	2147746319: _HRESULT_name[25906:25930],
-	2147746320: _HRESULT_name[25930:25952],
-	2147746321: _HRESULT_name[25952:25974],
-	2147746322: _HRESULT_name[25974:25990],
-	2147746323: _HRESULT_name[25990:26010],
-	2147746324: _HRESULT_name[26010:26033],
-	2147746325: _HRESULT_name[26033:26057],
-	2147746326: _HRESULT_name[26057:26079],
-	2147746327: _HRESULT_name[26079:26100],
-	2147746328: _HRESULT_name[26100:26130],
-	2147746329: _HRESULT_name[26130:26159],
-	2147746330: _HRESULT_name[26159:26176],
-	2147746331: _HRESULT_name[26176:26197],
-	2147942405: _HRESULT_name[26197:26211],
-	2147942406: _HRESULT_name[26211:26219],
-	2147942414: _HRESULT_name[26219:26232],
-	2147942487: _HRESULT_name[26232:26244],
-	2148007937: _HRESULT_name[26244:26268],
-	2148007938: _HRESULT_name[26268:26282],
-	2148007939: _HRESULT_name[26282:26302],
-	2148007940: _HRESULT_name[26302:26315],
-	2148007941: _HRESULT_name[26315:26339],
-	2148007942: _HRESULT_name[26339:26362],
-	2148007943: _HRESULT_name[26362:26380],
-	2148007944: _HRESULT_name[26380:26400],
-	2148007945: _HRESULT_name[26400:26418],
-	2148007952: _HRESULT_name[26418:26436],
-	2148007953: _HRESULT_name[26436:26454],
-	2148073473: _HRESULT_name[26454:26465],
-	2148073474: _HRESULT_name[26465:26477],
-	2148073475: _HRESULT_name[26477:26488],
-	2148073476: _HRESULT_name[26488:26499],
-	2148073477: _HRESULT_name[26499:26511],
-	2148073478: _HRESULT_name[26511:26528],
-	2148073479: _HRESULT_name[26528:26539],
-	2148073480: _HRESULT_name[26539:26552],
-	2148073481: _HRESULT_name[26552:26565],
-	2148073482: _HRESULT_name[26565:26577],
-	2148073483: _HRESULT_name[26577:26594],
-	2148073484: _HRESULT_name[26594:26612],
-	2148073485: _HRESULT_name[26612:26622],
-	2148073486: _HRESULT_name[26622:26635],
-	2148073487: _HRESULT_name[26635:26645],
-	2148073488: _HRESULT_name[26645:26653],
-	2148073489: _HRESULT_name[26653:26666],
-	2148073490: _HRESULT_name[26666:26684],
-	2148073491: _HRESULT_name[26684:26700],
-	2148073492: _HRESULT_name[26700:26717],
-	2148073493: _HRESULT_name[26717:26735],
-	2148073494: _HRESULT_name[26735:26749],
-	2148073495: _HRESULT_name[26749:26770],
-	2148073496: _HRESULT_name[26770:26793],
-	2148073497: _HRESULT_name[26793:26811],
-	2148073498: _HRESULT_name[26811:26831],
-	2148073499: _HRESULT_name[26831:26853],
-	2148073500: _HRESULT_name[26853:26875],
-	2148073501: _HRESULT_name[26875:26896],
-	2148073502: _HRESULT_name[26896:26918],
-	2148073503: _HRESULT_name[26918:26938],
-	2148073504: _HRESULT_name[26938:26946],
-	2148073505: _HRESULT_name[26946:26957],
-	2148077569: _HRESULT_name[26957:26974],
-	2148077570: _HRESULT_name[26974:26994],
-	2148077571: _HRESULT_name[26994:27012],
-	2148077572: _HRESULT_name[27012:27036],
-	2148077573: _HRESULT_name[27036:27063],
-	2148077574: _HRESULT_name[27063:27088],
-	2148077575: _HRESULT_name[27088:27106],
-	2148077576: _HRESULT_name[27106:27127],
-	2148077577: _HRESULT_name[27127:27152],
-	2148077578: _HRESULT_name[27152:27173],
-	2148077579: _HRESULT_name[27173:27200],
-	2148077580: _HRESULT_name[27200:27220],
-	2148077581: _HRESULT_name[27220:27247],
-	2148077582: _HRESULT_name[27247:27271],
-	2148077583: _HRESULT_name[27271:27297],
-	2148077584: _HRESULT_name[27297:27325],
-	2148077585: _HRESULT_name[27325:27357],
-	2148081665: _HRESULT_name[27357:27372],
-	2148081666: _HRESULT_name[27372:27390],
-	2148081667: _HRESULT_name[27390:27408],
-	2148081668: _HRESULT_name[27408:27425],
-	2148081669: _HRESULT_name[27425:27439],
-	2148081670: _HRESULT_name[27439:27458],
-	2148081671: _HRESULT_name[27458:27477],
-	2148081672: _HRESULT_name[27477:27497],
-	2148081673: _HRESULT_name[27497:27513],
-	2148081674: _HRESULT_name[27513:27540],
-	2148081675: _HRESULT_name[27540:27563],
-	2148081676: _HRESULT_name[27563:27586],
-	2148081677: _HRESULT_name[27586:27601],
-	2148081678: _HRESULT_name[27601:27618],
-	2148081679: _HRESULT_name[27618:27639],
-	2148081680: _HRESULT_name[27639:27654],
-	2148081681: _HRESULT_name[27654:27679],
-	2148081682: _HRESULT_name[27679:27706],
-	2148081683: _HRESULT_name[27706:27732],
-	2148081684: _HRESULT_name[27732:27766],
-	2148081696: _HRESULT_name[27766:27796],
-	2148081697: _HRESULT_name[27796:27828],
-	2148081698: _HRESULT_name[27828:27854],
-	2148081699: _HRESULT_name[27854:27881],
-	2148081700: _HRESULT_name[27881:27904],
-	2148081701: _HRESULT_name[27904:27923],
-	2148081702: _HRESULT_name[27923:27948],
-	2148081703: _HRESULT_name[27948:27975],
-	2148081704: _HRESULT_name[27975:28004],
-	2148081705: _HRESULT_name[28004:28032],
-	2148081706: _HRESULT_name[28032:28050],
-	2148081707: _HRESULT_name[28050:28075],
-	2148085760: _HRESULT_name[28075:28092],
-	2148089857: _HRESULT_name[28092:28120],
-	2148089858: _HRESULT_name[28120:28140],
-	2148089859: _HRESULT_name[28140:28167],
-	2148089860: _HRESULT_name[28167:28191],
-	2148093952: _HRESULT_name[28191:28209],
-	2148098049: _HRESULT_name[28209:28229],
-	2148098050: _HRESULT_name[28229:28251],
-	2148098051: _HRESULT_name[28251:28273],
-	2148098052: _HRESULT_name[28273:28295],
-	2148098053: _HRESULT_name[28295:28313],
-	2148098064: _HRESULT_name[28313:28331],
-	2148098073: _HRESULT_name[28331:28356],
-	2148098078: _HRESULT_name[28356:28382],
-	2148204545: _HRESULT_name[28382:28406],
-	2148204546: _HRESULT_name[28406:28428],
-	2148204547: _HRESULT_name[28428:28456],
-	2148204548: _HRESULT_name[28456:28483],
-	2148204549: _HRESULT_name[28483:28498],
-	2148204550: _HRESULT_name[28498:28513],
-	2148204551: _HRESULT_name[28513:28535],
-	2148204552: _HRESULT_name[28535:28550],
-	2148204553: _HRESULT_name[28550:28572],
-	2148204554: _HRESULT_name[28572:28596],
-	2148204555: _HRESULT_name[28596:28619],
-	2148204800: _HRESULT_name[28619:28638],
-	2148204801: _HRESULT_name[28638:28652],
-	2148204802: _HRESULT_name[28652:28680],
-	2148204803: _HRESULT_name[28680:28691],
-	2148204804: _HRESULT_name[28691:28710],
-	2148204805: _HRESULT_name[28710:28725],
-	2148204806: _HRESULT_name[28725:28739],
-	2148204807: _HRESULT_name[28739:28760],
-	2148204808: _HRESULT_name[28760:28776],
-	2148204809: _HRESULT_name[28776:28796],
-	2148204810: _HRESULT_name[28796:28811],
-	2148204811: _HRESULT_name[28811:28823],
-	2148204812: _HRESULT_name[28823:28837],
-	2148204813: _HRESULT_name[28837:28861],
-	2148204814: _HRESULT_name[28861:28886],
-	2148204815: _HRESULT_name[28886:28904],
-	2148204816: _HRESULT_name[28904:28922],
-	2148466688: _HRESULT_name[28922:28951],
-	2148466689: _HRESULT_name[28951:28980],
-	2148466690: _HRESULT_name[28980:29009],
-	2148466691: _HRESULT_name[29009:29031],
-	2148466944: _HRESULT_name[29031:29054],
-	2148466945: _HRESULT_name[29054:29079],
-	2148466946: _HRESULT_name[29079:29101],
-	2148467200: _HRESULT_name[29101:29128],
-	2148467201: _HRESULT_name[29128:29150],
-	2148467202: _HRESULT_name[29150:29173],
-	2148467203: _HRESULT_name[29173:29199],
-	2148467204: _HRESULT_name[29199:29225],
-	2148467205: _HRESULT_name[29225:29253],
-	2148467206: _HRESULT_name[29253:29274],
-	2148467207: _HRESULT_name[29274:29304],
-	2148467208: _HRESULT_name[29304:29334],
-	2148467209: _HRESULT_name[29334:29362],
-	2148467210: _HRESULT_name[29362:29376],
-	2148467211: _HRESULT_name[29376:29399],
-	2148467212: _HRESULT_name[29399:29427],
-	2148467213: _HRESULT_name[29427:29458],
-	2148467214: _HRESULT_name[29458:29479],
-	2148467215: _HRESULT_name[29479:29500],
-	2148467216: _HRESULT_name[29500:29525],
-	2148467217: _HRESULT_name[29525:29551],
-	2148467218: _HRESULT_name[29551:29578],
-	2148467219: _HRESULT_name[29578:29605],
-	2148467220: _HRESULT_name[29605:29624],
-	2148467221: _HRESULT_name[29624:29654],
-	2148467222: _HRESULT_name[29654:29678],
-	2148467223: _HRESULT_name[29678:29709],
-	2148467224: _HRESULT_name[29709:29737],
-	2148467225: _HRESULT_name[29737:29766],
-	2148467226: _HRESULT_name[29766:29801],
-	2148467227: _HRESULT_name[29801:29832],
-	2148467228: _HRESULT_name[29832:29864],
-	2148467229: _HRESULT_name[29864:29897],
-	2148467230: _HRESULT_name[29897:29928],
-	2148467231: _HRESULT_name[29928:29960],
-	2148467232: _HRESULT_name[29960:29987],
-	2148467233: _HRESULT_name[29987:30014],
-	2148467234: _HRESULT_name[30014:30041],
-	2148467235: _HRESULT_name[30041:30070],
-	2148467236: _HRESULT_name[30070:30103],
-	2148467237: _HRESULT_name[30103:30135],
-	2148467238: _HRESULT_name[30135:30169],
-	2148467239: _HRESULT_name[30169:30196],
-	2148467240: _HRESULT_name[30196:30221],
-	2148467241: _HRESULT_name[30221:30243],
-	2148467242: _HRESULT_name[30243:30272],
-	2148467243: _HRESULT_name[30272:30295],
-	2148467244: _HRESULT_name[30295:30324],
-	2148470784: _HRESULT_name[30324:30351],
-}
-
-func (i HRESULT) String() string {
-	if str, ok := _HRESULT_map[i]; ok {
-		return str
-	}
-	return "HRESULT(" + strconv.FormatInt(int64(i), 10) + ")"
-}
@@ -0,0 +1,97 @@
+package hresult
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestHRESULT(t *testing.T) {
+	tests := []struct {
+		name      string
+		hr        HRESULT
+		failed    bool
+		succeeded bool
+		severity  uint32
+		facility  uint32
+		code      uint32
+	}{
+		{"S_OK", S_OK, false, true, SEVERITY_SUCCESS, FACILITY_NULL, 0},
+		{"S_FALSE", S_FALSE, false, true, SEVERITY_SUCCESS, FACILITY_NULL, 1},
+		{"E_FAIL", E_FAIL, true, false, SEVERITY_ERROR, FACILITY_NULL, 0x4005},
+		{"E_INVALIDARG", E_INVALIDARG, true, false, SEVERITY_ERROR, FACILITY_WIN32, 0x57},
+		{"E_UNEXPECTED", E_UNEXPECTED, true, false, SEVERITY_ERROR, FACILITY_NULL, 0xFFFF},
+		{"OLE_S_FIRST", OLE_S_FIRST, false, true, SEVERITY_SUCCESS, FACILITY_ITF, 0},
+		{"REGDB_E_CLASSNOTREG", REGDB_E_CLASSNOTREG, true, false, SEVERITY_ERROR, FACILITY_ITF, 0x154},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if failed := test.hr.Failed(); failed != test.failed {
+				t.Errorf("Failed() = %v, want %v", failed, test.failed)
+			}
+
+			if succeeded := test.hr.Succeeded(); succeeded != test.succeeded {
+				t.Errorf("Succeeded() = %v, want %v", succeeded, test.succeeded)
+			}
+
+			if severity := test.hr.Severity(); severity != test.severity {
+				t.Errorf("Severity() = %v, want %v", severity, test.severity)
+			}
+
+			if facility := test.hr.Facility(); facility != test.facility {
+				t.Errorf("Facility() = %v, want %v", facility, test.facility)
+			}
+
+			if code := test.hr.Code(); code != test.code {
+				t.Errorf("Code() = %#x, want %#x", code, test.code)
+			}
+		})
+	}
+}
+
+func TestFrom(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		want   HRESULT
+		wantOK bool
+	}{
+		{"nil", nil, S_OK, false},
+		{"other", errors.New("other"), S_OK, false},
+		{"HRESULT", E_FAIL, E_FAIL, true},
+		{"wrapped", fmt.Errorf("failed to call: %w", E_NOINTERFACE), E_NOINTERFACE, true},
+		{"wrappedTwice", fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", E_ACCESSDENIED)), E_ACCESSDENIED, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hr, ok := From(test.err)
+			if hr != test.want || ok != test.wantOK {
+				t.Errorf("From() = %v, %v, want %v, %v", hr, ok, test.want, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestErrorsIs(t *testing.T) {
+	err := fmt.Errorf("failed to create the environment: %w", E_ACCESSDENIED)
+
+	tests := []struct {
+		name   string
+		target error
+		want   bool
+	}{
+		{"same", E_ACCESSDENIED, true},
+		{"other", E_FAIL, false},
+		{"sameCode", HRESULT(0x00070005), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if is := errors.Is(err, test.target); is != test.want {
+				t.Errorf("errors.Is(%v, %v) = %v, want %v", err, test.target, is, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+)
+
+func generate(p *pkg) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by namegen. DO NOT EDIT.\n\npackage %s\n", p.name)
+
+	for _, t := range types {
+		fmt.Fprintf(&buf, "\n// The %s codes of winerror.h which aren't declared by hand.\n", t.name)
+		buf.WriteString("const (\n")
+
+		for _, c := range p.consts[t.name] {
+			if !c.generated {
+				continue
+			}
+
+			fmt.Fprintf(&buf, "\t%s %s = %s", c.name, t.name, formatValue(t.name, c.value))
+
+			if c.message != "" {
+				fmt.Fprintf(&buf, " // %s", c.message)
+			}
+
+			buf.WriteString("\n")
+		}
+
+		buf.WriteString(")\n")
+	}
+
+	for _, t := range types {
+		fmt.Fprintf(&buf, "\n// %s maps the %s values to the names of their constants.\n", t.table, t.name)
+		fmt.Fprintf(&buf, "var %s = map[%s]string{\n", t.table, t.name)
+
+		picked := names(p.consts[t.name])
+
+		for _, c := range picked {
+			fmt.Fprintf(&buf, "\t%s: %q,\n", c.name, c.name)
+		}
+
+		buf.WriteString("}\n")
+
+		fmt.Fprintf(&buf, "\n// %s maps the %s values to their descriptions, taken from the comments of their constants\n", t.messages, t.name)
+		buf.WriteString("// and the MessageText of winerror.h.\n")
+		fmt.Fprintf(&buf, "var %s = map[%s]string{\n", t.messages, t.name)
+
+		for _, c := range picked {
+			if c.message != "" {
+				fmt.Fprintf(&buf, "\t%s: %q,\n", c.name, c.message)
+			}
+		}
+
+		buf.WriteString("}\n")
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// formatValue renders the value the way the constants declared by hand do, HRESULTs in 8 hex digits.
+func formatValue(typ string, value uint64) string {
+	if typ == "HRESULT" {
+		return fmt.Sprintf("0x%08X", value)
+	}
+
+	return fmt.Sprintf("0x%x", value)
+}
+
+// names picks a single name per value, in declaration order.
+// The first constant declared with a value names it, unless it only marks the start or the end of a range,
+// e.g. CO_E_FIRST loses to CO_E_NOTINITIALIZED.
+// The message of a value is the first one declared, whichever constant it comes with.
+func names(consts []constant) []constant {
+	var (
+		picked []constant
+		index  = map[uint64]int{}
+	)
+
+	for _, c := range consts {
+		i, ok := index[c.value]
+		if !ok {
+			index[c.value] = len(picked)
+			picked = append(picked, c)
+
+			continue
+		}
+
+		if isRangeMarker(picked[i].name) && !isRangeMarker(c.name) {
+			picked[i].name = c.name
+		}
+
+		if picked[i].message == "" {
+			picked[i].message = c.message
+		}
+	}
+
+	return picked
+}
+
+func isRangeMarker(name string) bool {
+	return strings.HasSuffix(name, "_FIRST") || strings.HasSuffix(name, "_LAST")
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	defineRegexp  = regexp.MustCompile(`^#\s*define\s+(\w+)\s+(.+)$`)
+	commentRegexp = regexp.MustCompile(`//.*$|/\*.*?\*/`)
+
+	// The forms of the values of winerror.h, e.g. _HRESULT_TYPEDEF_(0x80004005L), ((HRESULT)0x00000001L),
+	// __MSABI_LONG(2) in the mingw-w64 header and 2L in the one of the SDK, or (WSABASEERR+4) for the Winsock codes.
+	hresultRegexp = regexp.MustCompile(`^(?:_HRESULT_TYPEDEF_\(|\(\(HRESULT\)\s*)(0[xX][0-9A-Fa-f]+)L?\)+$`)
+	longRegexp    = regexp.MustCompile(`^(?:__MSABI_LONG\((0[xX][0-9A-Fa-f]+|\d+)L?\)|(\d+)L)$`)
+	winsockRegexp = regexp.MustCompile(`^\(WSABASEERR\s*\+\s*(\d+)\)$`)
+	aliasRegexp   = regexp.MustCompile(`^[A-Za-z_]\w*$`)
+	messageRegexp = regexp.MustCompile(`^//\s*MessageText:\s*$`)
+)
+
+// wsaBaseErr is the WSABASEERR the Winsock error codes are defined relative to.
+const wsaBaseErr = 10000
+
+// alias is a code #defined as another one, e.g. #define SCARD_S_SUCCESS NO_ERROR.
+type alias struct {
+	name, target string
+}
+
+// parseHeader reads the HRESULTs and Win32 error codes #defined by winerror.h, in declaration order.
+// The messages come from the MessageText blocks the SDK header has above each code, the defines of the other
+// headers, e.g. the one of mingw-w64, come without one. Aliases of other codes get their values,
+// the defines which aren't codes, e.g. the facilities and the macros, are skipped.
+func parseHeader(r io.Reader) (map[string][]constant, error) {
+	var (
+		consts  = map[string][]constant{}
+		seen    = map[string]bool{}
+		aliases []alias
+		values  = map[string]constant{}
+		kinds   = map[string]string{}
+
+		// message collects the MessageText of the next define.
+		message   []string
+		inMessage bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+
+		if strings.HasPrefix(text, "//") {
+			switch {
+			case messageRegexp.MatchString(text):
+				inMessage, message = true, nil
+			case inMessage:
+				message = append(message, strings.TrimPrefix(text, "//"))
+			}
+
+			continue
+		}
+
+		m := defineRegexp.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+
+		name := m[1]
+		value := strings.TrimSpace(commentRegexp.ReplaceAllString(m[2], ""))
+		c := constant{name: name, message: strings.Join(strings.Fields(strings.Join(message, " ")), " ")}
+
+		inMessage, message = false, nil
+
+		// Some codes are defined in several #if branches, the first one counts.
+		if seen[name] {
+			continue
+		}
+
+		var (
+			kind string
+			err  error
+		)
+
+		switch {
+		case hresultRegexp.MatchString(value):
+			kind = "HRESULT"
+			c.value, err = strconv.ParseUint(hresultRegexp.FindStringSubmatch(value)[1], 0, 32)
+		case longRegexp.MatchString(value):
+			v := longRegexp.FindStringSubmatch(value)
+			c.value, err = strconv.ParseUint(v[1]+v[2], 0, 32)
+
+			// The codes above 0xFFFF can't be Win32 error codes, they're HRESULTs without the typedef.
+			kind = "Win32Error"
+			if c.value > 0xFFFF {
+				kind = "HRESULT"
+			}
+		case winsockRegexp.MatchString(value):
+			kind = "Win32Error"
+			c.value, err = strconv.ParseUint(winsockRegexp.FindStringSubmatch(value)[1], 10, 32)
+			c.value += wsaBaseErr
+		case aliasRegexp.MatchString(value):
+			aliases = append(aliases, alias{name: name, target: value})
+			seen[name] = true
+
+			continue
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", line, name, err)
+		}
+
+		seen[name] = true
+		values[name] = c
+		kinds[name] = kind
+		consts[kind] = append(consts[kind], c)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// The aliases may refer to codes defined further down, or to other aliases, e.g. DNS_ERROR_RCODE_NO_ERROR.
+	for resolved := true; resolved; {
+		resolved = false
+
+		for n, a := range aliases {
+			target, ok := values[a.target]
+			if a.name == "" || !ok {
+				continue
+			}
+
+			c := constant{name: a.name, value: target.value, message: target.message}
+
+			values[a.name] = c
+			kinds[a.name] = kinds[a.target]
+			consts[kinds[a.name]] = append(consts[kinds[a.name]], c)
+			aliases[n].name = ""
+			resolved = true
+		}
+	}
+
+	return consts, nil
+}
@@ -0,0 +1,64 @@
+// Command namegen generates the name tables of the hresult package, which String and Message use
+// to render HRESULTs and Win32 error codes.
+//
+// The tables hold the constants declared by hand, followed by the codes of the checked-in winerror.h
+// which the package doesn't declare, which are written out as constants as well:
+//
+//	go run ./internal/namegen -h winerror.h -o znames.go
+//
+// The messages come from the comments of the constants, or from the MessageText blocks of the header.
+// The tests check that znames.go matches the package and the header.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	var (
+		dir    = flag.String("dir", ".", "the directory of the package to read")
+		header = flag.String("h", "winerror.h", "the header defining the codes, relative to dir")
+		out    = flag.String("o", "znames.go", "the Go file to write")
+	)
+
+	flag.Parse()
+
+	if err := run(*dir, *header, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "namegen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, header, out string) error {
+	pkg, err := parse(dir, out)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(filepath.Join(dir, header))
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	consts, err := parseHeader(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", header, err)
+	}
+
+	if err := pkg.addHeader(consts); err != nil {
+		return fmt.Errorf("%s: %w", header, err)
+	}
+
+	code, err := generate(pkg)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(out, code, 0o644)
+}
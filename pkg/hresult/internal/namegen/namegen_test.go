@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   map[string][]constant
+	}{
+		{
+			"mingw",
+			`#define ERROR_SUCCESS __MSABI_LONG(0)
+#define ERROR_INVALID_FUNCTION __MSABI_LONG(1)
+#define ERROR_HANDLE_EOF __MSABI_LONG(0x26)
+#define E_FAIL _HRESULT_TYPEDEF_(0x80004005L)
+#define S_FALSE ((HRESULT)0x00000001L)`,
+			map[string][]constant{
+				"Win32Error": {{name: "ERROR_SUCCESS"}, {name: "ERROR_INVALID_FUNCTION", value: 1}, {name: "ERROR_HANDLE_EOF", value: 0x26}},
+				"HRESULT":    {{name: "E_FAIL", value: 0x80004005}, {name: "S_FALSE", value: 1}},
+			},
+		},
+		{
+			"sdk",
+			`//
+// MessageId: ERROR_INVALID_FUNCTION
+//
+// MessageText:
+//
+// Incorrect function.
+//
+#define ERROR_INVALID_FUNCTION           1L    // dderror
+
+//
+// MessageId: E_FAIL
+//
+// MessageText:
+//
+// Unspecified
+// error
+//
+#define E_FAIL                           _HRESULT_TYPEDEF_(0x80004005L)`,
+			map[string][]constant{
+				"Win32Error": {{name: "ERROR_INVALID_FUNCTION", value: 1, message: "Incorrect function."}},
+				"HRESULT":    {{name: "E_FAIL", value: 0x80004005, message: "Unspecified error"}},
+			},
+		},
+		{
+			"messageOfTheNextDefineOnly",
+			`// MessageText:
+//
+// First.
+//
+#define ERROR_FIRST 1L
+#define ERROR_SECOND 2L`,
+			map[string][]constant{
+				"Win32Error": {{name: "ERROR_FIRST", value: 1, message: "First."}, {name: "ERROR_SECOND", value: 2}},
+			},
+		},
+		{
+			"hresultWithoutTypedef",
+			`#define NTE_BAD_UID __MSABI_LONG(0x80090001)`,
+			map[string][]constant{
+				"HRESULT": {{name: "NTE_BAD_UID", value: 0x80090001}},
+			},
+		},
+		{
+			"winsock",
+			`#define WSABASEERR 10000
+#define WSAEINTR (WSABASEERR + 4)`,
+			map[string][]constant{
+				"Win32Error": {{name: "WSAEINTR", value: 10004}},
+			},
+		},
+		{
+			"aliases",
+			`#define SEC_E_NOT_SUPPORTED SEC_E_UNSUPPORTED_FUNCTION
+#define SEC_E_UNSUPPORTED_FUNCTION _HRESULT_TYPEDEF_(0x80090302L)
+#define DNS_ERROR_RCODE_NO_ERROR NO_ERROR
+#define NO_ERROR __MSABI_LONG(0)
+#define UNKNOWN_ALIAS NOT_DEFINED`,
+			map[string][]constant{
+				"HRESULT":    {{name: "SEC_E_UNSUPPORTED_FUNCTION", value: 0x80090302}, {name: "SEC_E_NOT_SUPPORTED", value: 0x80090302}},
+				"Win32Error": {{name: "NO_ERROR"}, {name: "DNS_ERROR_RCODE_NO_ERROR"}},
+			},
+		},
+		{
+			"firstDefineWins",
+			`#ifdef RC_INVOKED
+#define E_UNEXPECTED _HRESULT_TYPEDEF_(0x8000FFFFL)
+#else
+#define E_UNEXPECTED _HRESULT_TYPEDEF_(0x8000FFFEL)
+#endif`,
+			map[string][]constant{
+				"HRESULT": {{name: "E_UNEXPECTED", value: 0x8000FFFF}},
+			},
+		},
+		{
+			"skipped",
+			`#ifndef _WINERROR_
+#define _WINERROR_
+#define FACILITY_WIN32 7
+#define SUCCEEDED(hr) (((HRESULT)(hr)) >= 0)
+#define HRESULT_CODE(hr) ((hr) & 0xFFFF)
+#endif`,
+			map[string][]constant{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseHeader(strings.NewReader(test.header))
+			if err != nil {
+				t.Fatalf("parseHeader() = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseHeader() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseHeaderError(t *testing.T) {
+	_, err := parseHeader(strings.NewReader("#define E_TOO_BIG _HRESULT_TYPEDEF_(0x180004005L)"))
+	if err == nil || !strings.Contains(err.Error(), "line 1: E_TOO_BIG") {
+		t.Fatalf("parseHeader() = %v, want an error for E_TOO_BIG on line 1", err)
+	}
+}
+
+func TestAddHeader(t *testing.T) {
+	p := &pkg{
+		consts: map[string][]constant{
+			"HRESULT": {{name: "E_FAIL", value: 0x80004005, message: "Unspecified error"}, {name: "E_ABORT", value: 0x80004004}},
+		},
+		idents: map[string]bool{"E_FAIL": true, "E_ABORT": true, "E_TAKEN": true},
+	}
+
+	err := p.addHeader(map[string][]constant{
+		"HRESULT": {
+			{name: "E_FAIL", value: 0x80004005, message: "From the header"},
+			{name: "E_ABORT", value: 0x80004004, message: "Operation aborted"},
+			{name: "E_TAKEN", value: 0x80004006},
+			{name: "E_NEW", value: 0x80004007, message: "New"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []constant{
+		{name: "E_FAIL", value: 0x80004005, message: "Unspecified error"},
+		{name: "E_ABORT", value: 0x80004004, message: "Operation aborted"},
+		{name: "E_NEW", value: 0x80004007, message: "New", generated: true},
+	}
+
+	if got := p.consts["HRESULT"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("addHeader() = %+v, want %+v", got, want)
+	}
+
+	err = p.addHeader(map[string][]constant{
+		"HRESULT": {{name: "E_FAIL", value: 0x80004006}},
+	})
+	if err == nil || err.Error() != "E_FAIL is 0x80004005, but 0x80004006 in the header" {
+		t.Errorf("addHeader() with another value = %v", err)
+	}
+}
+
+// TestZNames checks that znames.go is what namegen generates from the package and winerror.h.
+func TestZNames(t *testing.T) {
+	dir := filepath.Join("..", "..")
+	out := filepath.Join(dir, "znames.go")
+
+	pkg, err := parse(dir, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header, err := ioutil.ReadFile(filepath.Join(dir, "winerror.h"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	consts, err := parseHeader(bytes.NewReader(header))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := pkg.addHeader(consts); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := generate(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s is out of date, run go generate in pkg/hresult", out)
+	}
+}
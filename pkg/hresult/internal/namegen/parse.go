@@ -0,0 +1,197 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// types are the types whose constants get a name table, in the order of the tables.
+var types = []struct {
+	name     string
+	table    string
+	messages string
+}{
+	{"HRESULT", "hresultNames", "hresultMessages"},
+	{"Win32Error", "win32ErrorNames", "win32ErrorMessages"},
+}
+
+// isTable reports whether the constants of the type get a name table.
+func isTable(typ string) bool {
+	for _, t := range types {
+		if t.name == typ {
+			return true
+		}
+	}
+
+	return false
+}
+
+type (
+	// pkg holds the constants of the package, per type, in declaration order, followed by the ones of winerror.h
+	// which the package doesn't declare.
+	pkg struct {
+		name   string
+		consts map[string][]constant
+		// idents are the names declared at the top level of the package.
+		idents map[string]bool
+	}
+
+	constant struct {
+		name  string
+		value uint64
+		// message is the description of the constant, from its doc or line comment, or from winerror.h.
+		message string
+		// generated tells the constants declared by the generated file, which come from winerror.h.
+		generated bool
+	}
+)
+
+// parse reads the constants of the package in dir, skipping the generated file and the tests.
+func parse(dir, out string) (*pkg, error) {
+	fset := token.NewFileSet()
+
+	filter := func(fi os.FileInfo) bool {
+		return fi.Name() != filepath.Base(out) && !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+
+	pkgs, err := parser.ParseDir(fset, dir, filter, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%s: expected a single package, found %d", dir, len(pkgs))
+	}
+
+	p := &pkg{consts: map[string][]constant{}, idents: map[string]bool{}}
+
+	for name, astPkg := range pkgs {
+		p.name = name
+
+		// Map iteration order is random, the declaration order within a type depends on the file order.
+		fileNames := make([]string, 0, len(astPkg.Files))
+		for fileName := range astPkg.Files {
+			fileNames = append(fileNames, fileName)
+		}
+
+		sort.Strings(fileNames)
+
+		for _, fileName := range fileNames {
+			if err := p.addFile(fset, astPkg.Files[fileName]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return p, nil
+}
+
+func (p *pkg) addFile(fset *token.FileSet, f *ast.File) error {
+	for _, decl := range f.Decls {
+		p.addIdents(decl)
+
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+
+			typ, ok := vs.Type.(*ast.Ident)
+			if !ok || !isTable(typ.Name) || len(vs.Values) != len(vs.Names) {
+				continue
+			}
+
+			for i, name := range vs.Names {
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.INT {
+					return fmt.Errorf("%s: %s: unsupported value", fset.Position(name.Pos()), name.Name)
+				}
+
+				value, err := strconv.ParseUint(lit.Value, 0, 32)
+				if err != nil {
+					return fmt.Errorf("%s: %s: %w", fset.Position(name.Pos()), name.Name, err)
+				}
+
+				p.consts[typ.Name] = append(p.consts[typ.Name], constant{name: name.Name, value: value, message: message(vs)})
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *pkg) addIdents(decl ast.Decl) {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		if decl.Recv == nil {
+			p.idents[decl.Name.Name] = true
+		}
+	case *ast.GenDecl:
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.ValueSpec:
+				for _, name := range spec.Names {
+					p.idents[name.Name] = true
+				}
+			case *ast.TypeSpec:
+				p.idents[spec.Name.Name] = true
+			}
+		}
+	}
+}
+
+// addHeader adds the codes of winerror.h the package doesn't declare, so they're generated.
+// The codes declared by hand must have the value of the header, the ones without a comment get its message.
+func (p *pkg) addHeader(header map[string][]constant) error {
+	for _, t := range types {
+		declared := map[string]int{}
+		for n, c := range p.consts[t.name] {
+			declared[c.name] = n
+		}
+
+		for _, c := range header[t.name] {
+			if n, ok := declared[c.name]; ok {
+				d := &p.consts[t.name][n]
+				if d.value != c.value {
+					return fmt.Errorf("%s is 0x%X, but 0x%X in the header", c.name, d.value, c.value)
+				}
+
+				if d.message == "" {
+					d.message = c.message
+				}
+
+				continue
+			}
+
+			if p.idents[c.name] {
+				continue
+			}
+
+			c.generated = true
+			p.idents[c.name] = true
+			p.consts[t.name] = append(p.consts[t.name], c)
+		}
+	}
+
+	return nil
+}
+
+// message returns the comment of the constant, joined into a single line.
+func message(vs *ast.ValueSpec) string {
+	for _, group := range []*ast.CommentGroup{vs.Doc, vs.Comment} {
+		if text := strings.Join(strings.Fields(group.Text()), " "); text != "" {
+			return text
+		}
+	}
+
+	return ""
+}
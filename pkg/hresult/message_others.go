@@ -0,0 +1,9 @@
+//go:build !windows
+// +build !windows
+
+package hresult
+
+// systemMessage has no message table to look the code up in outside of Windows.
+func systemMessage(code uint32) string {
+	return ""
+}
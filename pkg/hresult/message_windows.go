@@ -0,0 +1,20 @@
+package hresult
+
+import (
+	"strings"
+
+	"golang.org/x/sys/windows"
+)
+
+// systemMessage returns the description Windows has for the code, an HRESULT or a Win32 error code,
+// or an empty string if there's none.
+func systemMessage(code uint32) string {
+	buf := make([]uint16, 512)
+
+	n, err := windows.FormatMessage(windows.FORMAT_MESSAGE_FROM_SYSTEM|windows.FORMAT_MESSAGE_IGNORE_INSERTS, 0, code, 0, buf, nil)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(windows.UTF16ToString(buf[:n]))
+}
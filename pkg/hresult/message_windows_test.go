@@ -0,0 +1,14 @@
+package hresult
+
+import "testing"
+
+func TestSystemMessage(t *testing.T) {
+	// The text depends on the language of Windows, only its presence is checked.
+	if message := systemMessage(uint32(FromWin32(ERROR_FILE_NOT_FOUND))); message == "" {
+		t.Errorf("systemMessage(HRESULT_FROM_WIN32(ERROR_FILE_NOT_FOUND)) is empty")
+	}
+
+	if message := systemMessage(0x8FFF1234); message != "" {
+		t.Errorf("systemMessage(0x8FFF1234) = %q, want an empty string", message)
+	}
+}
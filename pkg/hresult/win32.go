@@ -0,0 +1,885 @@
+package hresult
+
+import "fmt"
+
+// Based on https://github.com/github/VisualStudio/blob/master/tools/Debugging%20Tools%20for%20Windows/winext/manifest/winerror.h#L1
+// Copyright (c) GitHub Inc.
+
+// Win32Error is a Win32 error code, as returned by GetLastError.
+// Win32 failures are reported over COM as HRESULTs with FACILITY_WIN32, see FromWin32.
+type Win32Error uint32
+
+// FromWin32 converts a Win32 error code to an HRESULT with FACILITY_WIN32, like the HRESULT_FROM_WIN32 macro,
+// e.g. ERROR_FILE_NOT_FOUND becomes 0x80070002.
+// ERROR_SUCCESS becomes S_OK, and values which already look like HRESULTs are returned as is.
+func FromWin32(e Win32Error) HRESULT {
+	if int32(e) <= 0 {
+		return HRESULT(e)
+	}
+
+	return HRESULT(uint32(e)&0xFFFF | FACILITY_WIN32<<16 | 0x80000000)
+}
+
+// Win32 returns the Win32 error code of an HRESULT made by FromWin32, and false for the other HRESULTs.
+func (hr HRESULT) Win32() (Win32Error, bool) {
+	if !hr.Failed() || hr.Facility() != FACILITY_WIN32 {
+		return 0, false
+	}
+
+	return Win32Error(hr.Code()), true
+}
+
+// Error makes Win32Error an error, e.g. for the results of the Win32 APIs called along the COM ones.
+func (e Win32Error) Error() string {
+	return e.String()
+}
+
+// String returns the name of the error code, e.g. ERROR_FILE_NOT_FOUND, or its number if it's unknown.
+func (e Win32Error) String() string {
+	if name, ok := win32ErrorNames[e]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Win32Error(%d)", uint32(e))
+}
+
+const (
+	ERROR_SUCCESS                            Win32Error = 0x0 // The operation completed successfully.
+	ERROR_INVALID_FUNCTION                   Win32Error = 0x1 // Incorrect function.
+	ERROR_FILE_NOT_FOUND                     Win32Error = 0x2 // The system cannot find the file specified.
+	ERROR_PATH_NOT_FOUND                     Win32Error = 0x3 // The system cannot find the path specified.
+	ERROR_TOO_MANY_OPEN_FILES                Win32Error = 0x4 // The system cannot open the file.
+	ERROR_ACCESS_DENIED                      Win32Error = 0x5 // Access is denied.
+	ERROR_INVALID_HANDLE                     Win32Error = 0x6 // The handle is invalid.
+	ERROR_ARENA_TRASHED                      Win32Error = 0x7
+	ERROR_NOT_ENOUGH_MEMORY                  Win32Error = 0x8 // Not enough memory resources are available to process this command.
+	ERROR_INVALID_BLOCK                      Win32Error = 0x9
+	ERROR_BAD_ENVIRONMENT                    Win32Error = 0xa
+	ERROR_BAD_FORMAT                         Win32Error = 0xb
+	ERROR_INVALID_ACCESS                     Win32Error = 0xc // The access code is invalid.
+	ERROR_INVALID_DATA                       Win32Error = 0xd // The data is invalid.
+	ERROR_OUTOFMEMORY                        Win32Error = 0xe // Not enough memory resources are available to complete this operation.
+	ERROR_INVALID_DRIVE                      Win32Error = 0xf
+	ERROR_CURRENT_DIRECTORY                  Win32Error = 0x10
+	ERROR_NOT_SAME_DEVICE                    Win32Error = 0x11
+	ERROR_NO_MORE_FILES                      Win32Error = 0x12
+	ERROR_WRITE_PROTECT                      Win32Error = 0x13 // The media is write protected.
+	ERROR_BAD_UNIT                           Win32Error = 0x14
+	ERROR_NOT_READY                          Win32Error = 0x15 // The device is not ready.
+	ERROR_BAD_COMMAND                        Win32Error = 0x16
+	ERROR_CRC                                Win32Error = 0x17
+	ERROR_BAD_LENGTH                         Win32Error = 0x18
+	ERROR_SEEK                               Win32Error = 0x19
+	ERROR_NOT_DOS_DISK                       Win32Error = 0x1a
+	ERROR_SECTOR_NOT_FOUND                   Win32Error = 0x1b
+	ERROR_OUT_OF_PAPER                       Win32Error = 0x1c
+	ERROR_WRITE_FAULT                        Win32Error = 0x1d
+	ERROR_READ_FAULT                         Win32Error = 0x1e
+	ERROR_GEN_FAILURE                        Win32Error = 0x1f // A device attached to the system is not functioning.
+	ERROR_SHARING_VIOLATION                  Win32Error = 0x20 // The process cannot access the file because it is being used by another process.
+	ERROR_LOCK_VIOLATION                     Win32Error = 0x21 // The process cannot access the file because another process has locked a portion of the file.
+	ERROR_WRONG_DISK                         Win32Error = 0x22
+	ERROR_SHARING_BUFFER_EXCEEDED            Win32Error = 0x24
+	ERROR_HANDLE_EOF                         Win32Error = 0x26
+	ERROR_HANDLE_DISK_FULL                   Win32Error = 0x27 // The disk is full.
+	ERROR_NOT_SUPPORTED                      Win32Error = 0x32 // The request is not supported.
+	ERROR_REM_NOT_LIST                       Win32Error = 0x33
+	ERROR_DUP_NAME                           Win32Error = 0x34
+	ERROR_BAD_NETPATH                        Win32Error = 0x35
+	ERROR_NETWORK_BUSY                       Win32Error = 0x36
+	ERROR_DEV_NOT_EXIST                      Win32Error = 0x37
+	ERROR_TOO_MANY_CMDS                      Win32Error = 0x38
+	ERROR_ADAP_HDW_ERR                       Win32Error = 0x39
+	ERROR_BAD_NET_RESP                       Win32Error = 0x3a
+	ERROR_UNEXP_NET_ERR                      Win32Error = 0x3b
+	ERROR_BAD_REM_ADAP                       Win32Error = 0x3c
+	ERROR_PRINTQ_FULL                        Win32Error = 0x3d
+	ERROR_NO_SPOOL_SPACE                     Win32Error = 0x3e
+	ERROR_PRINT_CANCELLED                    Win32Error = 0x3f
+	ERROR_NETNAME_DELETED                    Win32Error = 0x40
+	ERROR_NETWORK_ACCESS_DENIED              Win32Error = 0x41
+	ERROR_BAD_DEV_TYPE                       Win32Error = 0x42
+	ERROR_BAD_NET_NAME                       Win32Error = 0x43
+	ERROR_TOO_MANY_NAMES                     Win32Error = 0x44
+	ERROR_TOO_MANY_SESS                      Win32Error = 0x45
+	ERROR_SHARING_PAUSED                     Win32Error = 0x46
+	ERROR_REQ_NOT_ACCEP                      Win32Error = 0x47
+	ERROR_REDIR_PAUSED                       Win32Error = 0x48
+	ERROR_FILE_EXISTS                        Win32Error = 0x50 // The file exists.
+	ERROR_CANNOT_MAKE                        Win32Error = 0x52
+	ERROR_FAIL_I24                           Win32Error = 0x53
+	ERROR_OUT_OF_STRUCTURES                  Win32Error = 0x54
+	ERROR_ALREADY_ASSIGNED                   Win32Error = 0x55
+	ERROR_INVALID_PASSWORD                   Win32Error = 0x56
+	ERROR_INVALID_PARAMETER                  Win32Error = 0x57 // The parameter is incorrect.
+	ERROR_NET_WRITE_FAULT                    Win32Error = 0x58
+	ERROR_NO_PROC_SLOTS                      Win32Error = 0x59
+	ERROR_TOO_MANY_SEMAPHORES                Win32Error = 0x64
+	ERROR_EXCL_SEM_ALREADY_OWNED             Win32Error = 0x65
+	ERROR_SEM_IS_SET                         Win32Error = 0x66
+	ERROR_TOO_MANY_SEM_REQUESTS              Win32Error = 0x67
+	ERROR_INVALID_AT_INTERRUPT_TIME          Win32Error = 0x68
+	ERROR_SEM_OWNER_DIED                     Win32Error = 0x69
+	ERROR_SEM_USER_LIMIT                     Win32Error = 0x6a
+	ERROR_DISK_CHANGE                        Win32Error = 0x6b
+	ERROR_DRIVE_LOCKED                       Win32Error = 0x6c
+	ERROR_BROKEN_PIPE                        Win32Error = 0x6d // The pipe has been ended.
+	ERROR_OPEN_FAILED                        Win32Error = 0x6e
+	ERROR_BUFFER_OVERFLOW                    Win32Error = 0x6f
+	ERROR_DISK_FULL                          Win32Error = 0x70 // There is not enough space on the disk.
+	ERROR_NO_MORE_SEARCH_HANDLES             Win32Error = 0x71
+	ERROR_INVALID_TARGET_HANDLE              Win32Error = 0x72
+	ERROR_INVALID_CATEGORY                   Win32Error = 0x75
+	ERROR_INVALID_VERIFY_SWITCH              Win32Error = 0x76
+	ERROR_BAD_DRIVER_LEVEL                   Win32Error = 0x77
+	ERROR_CALL_NOT_IMPLEMENTED               Win32Error = 0x78 // This function is not supported on this system.
+	ERROR_SEM_TIMEOUT                        Win32Error = 0x79
+	ERROR_INSUFFICIENT_BUFFER                Win32Error = 0x7a // The data area passed to a system call is too small.
+	ERROR_INVALID_NAME                       Win32Error = 0x7b // The filename, directory name, or volume label syntax is incorrect.
+	ERROR_INVALID_LEVEL                      Win32Error = 0x7c
+	ERROR_NO_VOLUME_LABEL                    Win32Error = 0x7d
+	ERROR_MOD_NOT_FOUND                      Win32Error = 0x7e // The specified module could not be found.
+	ERROR_PROC_NOT_FOUND                     Win32Error = 0x7f // The specified procedure could not be found.
+	ERROR_WAIT_NO_CHILDREN                   Win32Error = 0x80
+	ERROR_CHILD_NOT_COMPLETE                 Win32Error = 0x81
+	ERROR_DIRECT_ACCESS_HANDLE               Win32Error = 0x82
+	ERROR_NEGATIVE_SEEK                      Win32Error = 0x83
+	ERROR_SEEK_ON_DEVICE                     Win32Error = 0x84
+	ERROR_IS_JOIN_TARGET                     Win32Error = 0x85
+	ERROR_IS_JOINED                          Win32Error = 0x86
+	ERROR_IS_SUBSTED                         Win32Error = 0x87
+	ERROR_NOT_JOINED                         Win32Error = 0x88
+	ERROR_NOT_SUBSTED                        Win32Error = 0x89
+	ERROR_JOIN_TO_JOIN                       Win32Error = 0x8a
+	ERROR_SUBST_TO_SUBST                     Win32Error = 0x8b
+	ERROR_JOIN_TO_SUBST                      Win32Error = 0x8c
+	ERROR_SUBST_TO_JOIN                      Win32Error = 0x8d
+	ERROR_BUSY_DRIVE                         Win32Error = 0x8e
+	ERROR_SAME_DRIVE                         Win32Error = 0x8f
+	ERROR_DIR_NOT_ROOT                       Win32Error = 0x90
+	ERROR_DIR_NOT_EMPTY                      Win32Error = 0x91 // The directory is not empty.
+	ERROR_IS_SUBST_PATH                      Win32Error = 0x92
+	ERROR_IS_JOIN_PATH                       Win32Error = 0x93
+	ERROR_PATH_BUSY                          Win32Error = 0x94
+	ERROR_IS_SUBST_TARGET                    Win32Error = 0x95
+	ERROR_SYSTEM_TRACE                       Win32Error = 0x96
+	ERROR_INVALID_EVENT_COUNT                Win32Error = 0x97
+	ERROR_TOO_MANY_MUXWAITERS                Win32Error = 0x98
+	ERROR_INVALID_LIST_FORMAT                Win32Error = 0x99
+	ERROR_LABEL_TOO_LONG                     Win32Error = 0x9a
+	ERROR_TOO_MANY_TCBS                      Win32Error = 0x9b
+	ERROR_SIGNAL_REFUSED                     Win32Error = 0x9c
+	ERROR_DISCARDED                          Win32Error = 0x9d
+	ERROR_NOT_LOCKED                         Win32Error = 0x9e
+	ERROR_BAD_THREADID_ADDR                  Win32Error = 0x9f
+	ERROR_BAD_ARGUMENTS                      Win32Error = 0xa0
+	ERROR_BAD_PATHNAME                       Win32Error = 0xa1 // The specified path is invalid.
+	ERROR_SIGNAL_PENDING                     Win32Error = 0xa2
+	ERROR_MAX_THRDS_REACHED                  Win32Error = 0xa4
+	ERROR_LOCK_FAILED                        Win32Error = 0xa7
+	ERROR_BUSY                               Win32Error = 0xaa // The requested resource is in use.
+	ERROR_CANCEL_VIOLATION                   Win32Error = 0xad
+	ERROR_ATOMIC_LOCKS_NOT_SUPPORTED         Win32Error = 0xae
+	ERROR_INVALID_SEGMENT_NUMBER             Win32Error = 0xb4
+	ERROR_INVALID_ORDINAL                    Win32Error = 0xb6
+	ERROR_ALREADY_EXISTS                     Win32Error = 0xb7 // Cannot create a file when that file already exists.
+	ERROR_INVALID_FLAG_NUMBER                Win32Error = 0xba
+	ERROR_SEM_NOT_FOUND                      Win32Error = 0xbb
+	ERROR_INVALID_STARTING_CODESEG           Win32Error = 0xbc
+	ERROR_INVALID_STACKSEG                   Win32Error = 0xbd
+	ERROR_INVALID_MODULETYPE                 Win32Error = 0xbe
+	ERROR_INVALID_EXE_SIGNATURE              Win32Error = 0xbf
+	ERROR_EXE_MARKED_INVALID                 Win32Error = 0xc0
+	ERROR_BAD_EXE_FORMAT                     Win32Error = 0xc1 // %1 is not a valid Win32 application.
+	ERROR_ITERATED_DATA_EXCEEDS_64k          Win32Error = 0xc2
+	ERROR_INVALID_MINALLOCSIZE               Win32Error = 0xc3
+	ERROR_DYNLINK_FROM_INVALID_RING          Win32Error = 0xc4
+	ERROR_IOPL_NOT_ENABLED                   Win32Error = 0xc5
+	ERROR_INVALID_SEGDPL                     Win32Error = 0xc6
+	ERROR_AUTODATASEG_EXCEEDS_64k            Win32Error = 0xc7
+	ERROR_RING2SEG_MUST_BE_MOVABLE           Win32Error = 0xc8
+	ERROR_RELOC_CHAIN_XEEDS_SEGLIM           Win32Error = 0xc9
+	ERROR_INFLOOP_IN_RELOC_CHAIN             Win32Error = 0xca
+	ERROR_ENVVAR_NOT_FOUND                   Win32Error = 0xcb
+	ERROR_NO_SIGNAL_SENT                     Win32Error = 0xcd
+	ERROR_FILENAME_EXCED_RANGE               Win32Error = 0xce
+	ERROR_RING2_STACK_IN_USE                 Win32Error = 0xcf
+	ERROR_META_EXPANSION_TOO_LONG            Win32Error = 0xd0
+	ERROR_INVALID_SIGNAL_NUMBER              Win32Error = 0xd1
+	ERROR_THREAD_1_INACTIVE                  Win32Error = 0xd2
+	ERROR_LOCKED                             Win32Error = 0xd4
+	ERROR_TOO_MANY_MODULES                   Win32Error = 0xd6
+	ERROR_NESTING_NOT_ALLOWED                Win32Error = 0xd7
+	ERROR_EXE_MACHINE_TYPE_MISMATCH          Win32Error = 0xd8 // This version of %1 is not compatible with the version of Windows you're running. Check your computer's system information and then contact the software publisher.
+	ERROR_BAD_PIPE                           Win32Error = 0xe6
+	ERROR_PIPE_BUSY                          Win32Error = 0xe7 // All pipe instances are busy.
+	ERROR_NO_DATA                            Win32Error = 0xe8
+	ERROR_PIPE_NOT_CONNECTED                 Win32Error = 0xe9
+	ERROR_MORE_DATA                          Win32Error = 0xea // More data is available.
+	ERROR_VC_DISCONNECTED                    Win32Error = 0xf0
+	ERROR_INVALID_EA_NAME                    Win32Error = 0xfe
+	ERROR_EA_LIST_INCONSISTENT               Win32Error = 0xff
+	ERROR_NO_MORE_ITEMS                      Win32Error = 0x103 // No more data is available.
+	ERROR_CANNOT_COPY                        Win32Error = 0x10a
+	ERROR_DIRECTORY                          Win32Error = 0x10b // The directory name is invalid.
+	ERROR_EAS_DIDNT_FIT                      Win32Error = 0x113
+	ERROR_EA_FILE_CORRUPT                    Win32Error = 0x114
+	ERROR_EA_TABLE_FULL                      Win32Error = 0x115
+	ERROR_INVALID_EA_HANDLE                  Win32Error = 0x116
+	ERROR_EAS_NOT_SUPPORTED                  Win32Error = 0x11a
+	ERROR_NOT_OWNER                          Win32Error = 0x120
+	ERROR_TOO_MANY_POSTS                     Win32Error = 0x12a
+	ERROR_PARTIAL_COPY                       Win32Error = 0x12b
+	ERROR_OPLOCK_NOT_GRANTED                 Win32Error = 0x12c
+	ERROR_INVALID_OPLOCK_PROTOCOL            Win32Error = 0x12d
+	ERROR_MR_MID_NOT_FOUND                   Win32Error = 0x13d
+	ERROR_INVALID_ADDRESS                    Win32Error = 0x1e7
+	ERROR_ARITHMETIC_OVERFLOW                Win32Error = 0x216
+	ERROR_PIPE_CONNECTED                     Win32Error = 0x217
+	ERROR_PIPE_LISTENING                     Win32Error = 0x218
+	ERROR_INVALID_IMAGE_HASH                 Win32Error = 0x241 // Windows cannot verify the digital signature for this file. A recent hardware or software change might have installed a file that is signed incorrectly or damaged, or that might be malicious software from an unknown source.
+	ERROR_ELEVATION_REQUIRED                 Win32Error = 0x2e4 // The requested operation requires elevation.
+	ERROR_EA_ACCESS_DENIED                   Win32Error = 0x3e2
+	ERROR_OPERATION_ABORTED                  Win32Error = 0x3e3 // The I/O operation has been aborted because of either a thread exit or an application request.
+	ERROR_IO_INCOMPLETE                      Win32Error = 0x3e4
+	ERROR_IO_PENDING                         Win32Error = 0x3e5
+	ERROR_NOACCESS                           Win32Error = 0x3e6
+	ERROR_SWAPERROR                          Win32Error = 0x3e7
+	ERROR_STACK_OVERFLOW                     Win32Error = 0x3e9
+	ERROR_INVALID_MESSAGE                    Win32Error = 0x3ea
+	ERROR_CAN_NOT_COMPLETE                   Win32Error = 0x3eb
+	ERROR_INVALID_FLAGS                      Win32Error = 0x3ec
+	ERROR_UNRECOGNIZED_VOLUME                Win32Error = 0x3ed
+	ERROR_FILE_INVALID                       Win32Error = 0x3ee
+	ERROR_FULLSCREEN_MODE                    Win32Error = 0x3ef
+	ERROR_NO_TOKEN                           Win32Error = 0x3f0
+	ERROR_BADDB                              Win32Error = 0x3f1
+	ERROR_BADKEY                             Win32Error = 0x3f2
+	ERROR_CANTOPEN                           Win32Error = 0x3f3
+	ERROR_CANTREAD                           Win32Error = 0x3f4
+	ERROR_CANTWRITE                          Win32Error = 0x3f5
+	ERROR_REGISTRY_RECOVERED                 Win32Error = 0x3f6
+	ERROR_REGISTRY_CORRUPT                   Win32Error = 0x3f7
+	ERROR_REGISTRY_IO_FAILED                 Win32Error = 0x3f8
+	ERROR_NOT_REGISTRY_FILE                  Win32Error = 0x3f9
+	ERROR_KEY_DELETED                        Win32Error = 0x3fa
+	ERROR_NO_LOG_SPACE                       Win32Error = 0x3fb
+	ERROR_KEY_HAS_CHILDREN                   Win32Error = 0x3fc
+	ERROR_CHILD_MUST_BE_VOLATILE             Win32Error = 0x3fd
+	ERROR_NOTIFY_ENUM_DIR                    Win32Error = 0x3fe
+	ERROR_DEPENDENT_SERVICES_RUNNING         Win32Error = 0x41b
+	ERROR_INVALID_SERVICE_CONTROL            Win32Error = 0x41c
+	ERROR_SERVICE_REQUEST_TIMEOUT            Win32Error = 0x41d
+	ERROR_SERVICE_NO_THREAD                  Win32Error = 0x41e
+	ERROR_SERVICE_DATABASE_LOCKED            Win32Error = 0x41f
+	ERROR_SERVICE_ALREADY_RUNNING            Win32Error = 0x420
+	ERROR_INVALID_SERVICE_ACCOUNT            Win32Error = 0x421
+	ERROR_SERVICE_DISABLED                   Win32Error = 0x422
+	ERROR_CIRCULAR_DEPENDENCY                Win32Error = 0x423
+	ERROR_SERVICE_DOES_NOT_EXIST             Win32Error = 0x424
+	ERROR_SERVICE_CANNOT_ACCEPT_CTRL         Win32Error = 0x425
+	ERROR_SERVICE_NOT_ACTIVE                 Win32Error = 0x426
+	ERROR_FAILED_SERVICE_CONTROLLER_CONNECT  Win32Error = 0x427
+	ERROR_EXCEPTION_IN_SERVICE               Win32Error = 0x428
+	ERROR_DATABASE_DOES_NOT_EXIST            Win32Error = 0x429
+	ERROR_SERVICE_SPECIFIC_ERROR             Win32Error = 0x42a
+	ERROR_PROCESS_ABORTED                    Win32Error = 0x42b
+	ERROR_SERVICE_DEPENDENCY_FAIL            Win32Error = 0x42c
+	ERROR_SERVICE_LOGON_FAILED               Win32Error = 0x42d
+	ERROR_SERVICE_START_HANG                 Win32Error = 0x42e
+	ERROR_INVALID_SERVICE_LOCK               Win32Error = 0x42f
+	ERROR_SERVICE_MARKED_FOR_DELETE          Win32Error = 0x430
+	ERROR_SERVICE_EXISTS                     Win32Error = 0x431
+	ERROR_ALREADY_RUNNING_LKG                Win32Error = 0x432
+	ERROR_SERVICE_DEPENDENCY_DELETED         Win32Error = 0x433
+	ERROR_BOOT_ALREADY_ACCEPTED              Win32Error = 0x434
+	ERROR_SERVICE_NEVER_STARTED              Win32Error = 0x435
+	ERROR_DUPLICATE_SERVICE_NAME             Win32Error = 0x436
+	ERROR_DIFFERENT_SERVICE_ACCOUNT          Win32Error = 0x437
+	ERROR_CANNOT_DETECT_DRIVER_FAILURE       Win32Error = 0x438
+	ERROR_CANNOT_DETECT_PROCESS_ABORT        Win32Error = 0x439
+	ERROR_NO_RECOVERY_PROGRAM                Win32Error = 0x43a
+	ERROR_END_OF_MEDIA                       Win32Error = 0x44c
+	ERROR_FILEMARK_DETECTED                  Win32Error = 0x44d
+	ERROR_BEGINNING_OF_MEDIA                 Win32Error = 0x44e
+	ERROR_SETMARK_DETECTED                   Win32Error = 0x44f
+	ERROR_NO_DATA_DETECTED                   Win32Error = 0x450
+	ERROR_PARTITION_FAILURE                  Win32Error = 0x451
+	ERROR_INVALID_BLOCK_LENGTH               Win32Error = 0x452
+	ERROR_DEVICE_NOT_PARTITIONED             Win32Error = 0x453
+	ERROR_UNABLE_TO_LOCK_MEDIA               Win32Error = 0x454
+	ERROR_UNABLE_TO_UNLOAD_MEDIA             Win32Error = 0x455
+	ERROR_MEDIA_CHANGED                      Win32Error = 0x456
+	ERROR_BUS_RESET                          Win32Error = 0x457
+	ERROR_NO_MEDIA_IN_DRIVE                  Win32Error = 0x458
+	ERROR_NO_UNICODE_TRANSLATION             Win32Error = 0x459
+	ERROR_DLL_INIT_FAILED                    Win32Error = 0x45a // A dynamic link library (DLL) initialization routine failed.
+	ERROR_SHUTDOWN_IN_PROGRESS               Win32Error = 0x45b
+	ERROR_NO_SHUTDOWN_IN_PROGRESS            Win32Error = 0x45c
+	ERROR_IO_DEVICE                          Win32Error = 0x45d
+	ERROR_SERIAL_NO_DEVICE                   Win32Error = 0x45e
+	ERROR_IRQ_BUSY                           Win32Error = 0x45f
+	ERROR_MORE_WRITES                        Win32Error = 0x460
+	ERROR_COUNTER_TIMEOUT                    Win32Error = 0x461
+	ERROR_FLOPPY_ID_MARK_NOT_FOUND           Win32Error = 0x462
+	ERROR_FLOPPY_WRONG_CYLINDER              Win32Error = 0x463
+	ERROR_FLOPPY_UNKNOWN_ERROR               Win32Error = 0x464
+	ERROR_FLOPPY_BAD_REGISTERS               Win32Error = 0x465
+	ERROR_DISK_RECALIBRATE_FAILED            Win32Error = 0x466
+	ERROR_DISK_OPERATION_FAILED              Win32Error = 0x467
+	ERROR_DISK_RESET_FAILED                  Win32Error = 0x468
+	ERROR_EOM_OVERFLOW                       Win32Error = 0x469
+	ERROR_NOT_ENOUGH_SERVER_MEMORY           Win32Error = 0x46a
+	ERROR_POSSIBLE_DEADLOCK                  Win32Error = 0x46b
+	ERROR_MAPPED_ALIGNMENT                   Win32Error = 0x46c
+	ERROR_SET_POWER_STATE_VETOED             Win32Error = 0x474
+	ERROR_SET_POWER_STATE_FAILED             Win32Error = 0x475
+	ERROR_TOO_MANY_LINKS                     Win32Error = 0x476
+	ERROR_OLD_WIN_VERSION                    Win32Error = 0x47e
+	ERROR_APP_WRONG_OS                       Win32Error = 0x47f
+	ERROR_SINGLE_INSTANCE_APP                Win32Error = 0x480
+	ERROR_RMODE_APP                          Win32Error = 0x481
+	ERROR_INVALID_DLL                        Win32Error = 0x482
+	ERROR_NO_ASSOCIATION                     Win32Error = 0x483
+	ERROR_DDE_FAIL                           Win32Error = 0x484
+	ERROR_DLL_NOT_FOUND                      Win32Error = 0x485
+	ERROR_NO_MORE_USER_HANDLES               Win32Error = 0x486
+	ERROR_MESSAGE_SYNC_ONLY                  Win32Error = 0x487
+	ERROR_SOURCE_ELEMENT_EMPTY               Win32Error = 0x488
+	ERROR_DESTINATION_ELEMENT_FULL           Win32Error = 0x489
+	ERROR_ILLEGAL_ELEMENT_ADDRESS            Win32Error = 0x48a
+	ERROR_MAGAZINE_NOT_PRESENT               Win32Error = 0x48b
+	ERROR_DEVICE_REINITIALIZATION_NEEDED     Win32Error = 0x48c
+	ERROR_DEVICE_REQUIRES_CLEANING           Win32Error = 0x48d
+	ERROR_DEVICE_DOOR_OPEN                   Win32Error = 0x48e
+	ERROR_DEVICE_NOT_CONNECTED               Win32Error = 0x48f
+	ERROR_NOT_FOUND                          Win32Error = 0x490 // Element not found.
+	ERROR_NO_MATCH                           Win32Error = 0x491
+	ERROR_SET_NOT_FOUND                      Win32Error = 0x492
+	ERROR_POINT_NOT_FOUND                    Win32Error = 0x493
+	ERROR_NO_TRACKING_SERVICE                Win32Error = 0x494
+	ERROR_NO_VOLUME_ID                       Win32Error = 0x495
+	ERROR_CONNECTED_OTHER_PASSWORD           Win32Error = 0x83c
+	ERROR_BAD_USERNAME                       Win32Error = 0x89a
+	ERROR_NOT_CONNECTED                      Win32Error = 0x8ca
+	ERROR_OPEN_FILES                         Win32Error = 0x961
+	ERROR_ACTIVE_CONNECTIONS                 Win32Error = 0x962
+	ERROR_DEVICE_IN_USE                      Win32Error = 0x964
+	ERROR_BAD_DEVICE                         Win32Error = 0x4b0
+	ERROR_CONNECTION_UNAVAIL                 Win32Error = 0x4b1
+	ERROR_DEVICE_ALREADY_REMEMBERED          Win32Error = 0x4b2
+	ERROR_NO_NET_OR_BAD_PATH                 Win32Error = 0x4b3
+	ERROR_BAD_PROVIDER                       Win32Error = 0x4b4
+	ERROR_CANNOT_OPEN_PROFILE                Win32Error = 0x4b5
+	ERROR_BAD_PROFILE                        Win32Error = 0x4b6
+	ERROR_NOT_CONTAINER                      Win32Error = 0x4b7
+	ERROR_EXTENDED_ERROR                     Win32Error = 0x4b8
+	ERROR_INVALID_GROUPNAME                  Win32Error = 0x4b9
+	ERROR_INVALID_COMPUTERNAME               Win32Error = 0x4ba
+	ERROR_INVALID_EVENTNAME                  Win32Error = 0x4bb
+	ERROR_INVALID_DOMAINNAME                 Win32Error = 0x4bc
+	ERROR_INVALID_SERVICENAME                Win32Error = 0x4bd
+	ERROR_INVALID_NETNAME                    Win32Error = 0x4be
+	ERROR_INVALID_SHARENAME                  Win32Error = 0x4bf
+	ERROR_INVALID_PASSWORDNAME               Win32Error = 0x4c0
+	ERROR_INVALID_MESSAGENAME                Win32Error = 0x4c1
+	ERROR_INVALID_MESSAGEDEST                Win32Error = 0x4c2
+	ERROR_SESSION_CREDENTIAL_CONFLICT        Win32Error = 0x4c3
+	ERROR_REMOTE_SESSION_LIMIT_EXCEEDED      Win32Error = 0x4c4
+	ERROR_DUP_DOMAINNAME                     Win32Error = 0x4c5
+	ERROR_NO_NETWORK                         Win32Error = 0x4c6
+	ERROR_CANCELLED                          Win32Error = 0x4c7 // The operation was canceled by the user.
+	ERROR_USER_MAPPED_FILE                   Win32Error = 0x4c8
+	ERROR_CONNECTION_REFUSED                 Win32Error = 0x4c9
+	ERROR_GRACEFUL_DISCONNECT                Win32Error = 0x4ca
+	ERROR_ADDRESS_ALREADY_ASSOCIATED         Win32Error = 0x4cb
+	ERROR_ADDRESS_NOT_ASSOCIATED             Win32Error = 0x4cc
+	ERROR_CONNECTION_INVALID                 Win32Error = 0x4cd
+	ERROR_CONNECTION_ACTIVE                  Win32Error = 0x4ce
+	ERROR_NETWORK_UNREACHABLE                Win32Error = 0x4cf
+	ERROR_HOST_UNREACHABLE                   Win32Error = 0x4d0
+	ERROR_PROTOCOL_UNREACHABLE               Win32Error = 0x4d1
+	ERROR_PORT_UNREACHABLE                   Win32Error = 0x4d2
+	ERROR_REQUEST_ABORTED                    Win32Error = 0x4d3
+	ERROR_CONNECTION_ABORTED                 Win32Error = 0x4d4
+	ERROR_RETRY                              Win32Error = 0x4d5
+	ERROR_CONNECTION_COUNT_LIMIT             Win32Error = 0x4d6
+	ERROR_LOGIN_TIME_RESTRICTION             Win32Error = 0x4d7
+	ERROR_LOGIN_WKSTA_RESTRICTION            Win32Error = 0x4d8
+	ERROR_INCORRECT_ADDRESS                  Win32Error = 0x4d9
+	ERROR_ALREADY_REGISTERED                 Win32Error = 0x4da
+	ERROR_SERVICE_NOT_FOUND                  Win32Error = 0x4db
+	ERROR_NOT_AUTHENTICATED                  Win32Error = 0x4dc
+	ERROR_NOT_LOGGED_ON                      Win32Error = 0x4dd
+	ERROR_CONTINUE                           Win32Error = 0x4de
+	ERROR_ALREADY_INITIALIZED                Win32Error = 0x4df
+	ERROR_NO_MORE_DEVICES                    Win32Error = 0x4e0
+	ERROR_NO_SUCH_SITE                       Win32Error = 0x4e1
+	ERROR_DOMAIN_CONTROLLER_EXISTS           Win32Error = 0x4e2
+	ERROR_DS_NOT_INSTALLED                   Win32Error = 0x2008
+	ERROR_NOT_ALL_ASSIGNED                   Win32Error = 0x514
+	ERROR_SOME_NOT_MAPPED                    Win32Error = 0x515
+	ERROR_NO_QUOTAS_FOR_ACCOUNT              Win32Error = 0x516
+	ERROR_LOCAL_USER_SESSION_KEY             Win32Error = 0x517
+	ERROR_NULL_LM_PASSWORD                   Win32Error = 0x518
+	ERROR_UNKNOWN_REVISION                   Win32Error = 0x519
+	ERROR_REVISION_MISMATCH                  Win32Error = 0x51a
+	ERROR_INVALID_OWNER                      Win32Error = 0x51b
+	ERROR_INVALID_PRIMARY_GROUP              Win32Error = 0x51c
+	ERROR_NO_IMPERSONATION_TOKEN             Win32Error = 0x51d
+	ERROR_CANT_DISABLE_MANDATORY             Win32Error = 0x51e
+	ERROR_NO_LOGON_SERVERS                   Win32Error = 0x51f
+	ERROR_NO_SUCH_LOGON_SESSION              Win32Error = 0x520
+	ERROR_NO_SUCH_PRIVILEGE                  Win32Error = 0x521
+	ERROR_PRIVILEGE_NOT_HELD                 Win32Error = 0x522
+	ERROR_INVALID_ACCOUNT_NAME               Win32Error = 0x523
+	ERROR_USER_EXISTS                        Win32Error = 0x524
+	ERROR_NO_SUCH_USER                       Win32Error = 0x525
+	ERROR_GROUP_EXISTS                       Win32Error = 0x526
+	ERROR_NO_SUCH_GROUP                      Win32Error = 0x527
+	ERROR_MEMBER_IN_GROUP                    Win32Error = 0x528
+	ERROR_MEMBER_NOT_IN_GROUP                Win32Error = 0x529
+	ERROR_LAST_ADMIN                         Win32Error = 0x52a
+	ERROR_WRONG_PASSWORD                     Win32Error = 0x52b
+	ERROR_ILL_FORMED_PASSWORD                Win32Error = 0x52c
+	ERROR_PASSWORD_RESTRICTION               Win32Error = 0x52d
+	ERROR_LOGON_FAILURE                      Win32Error = 0x52e
+	ERROR_ACCOUNT_RESTRICTION                Win32Error = 0x52f
+	ERROR_INVALID_LOGON_HOURS                Win32Error = 0x530
+	ERROR_INVALID_WORKSTATION                Win32Error = 0x531
+	ERROR_PASSWORD_EXPIRED                   Win32Error = 0x532
+	ERROR_ACCOUNT_DISABLED                   Win32Error = 0x533
+	ERROR_NONE_MAPPED                        Win32Error = 0x534
+	ERROR_TOO_MANY_LUIDS_REQUESTED           Win32Error = 0x535
+	ERROR_LUIDS_EXHAUSTED                    Win32Error = 0x536
+	ERROR_INVALID_SUB_AUTHORITY              Win32Error = 0x537
+	ERROR_INVALID_ACL                        Win32Error = 0x538
+	ERROR_INVALID_SID                        Win32Error = 0x539
+	ERROR_INVALID_SECURITY_DESCR             Win32Error = 0x53a
+	ERROR_BAD_INHERITANCE_ACL                Win32Error = 0x53c
+	ERROR_SERVER_DISABLED                    Win32Error = 0x53d
+	ERROR_SERVER_NOT_DISABLED                Win32Error = 0x53e
+	ERROR_INVALID_ID_AUTHORITY               Win32Error = 0x53f
+	ERROR_ALLOTTED_SPACE_EXCEEDED            Win32Error = 0x540
+	ERROR_INVALID_GROUP_ATTRIBUTES           Win32Error = 0x541
+	ERROR_BAD_IMPERSONATION_LEVEL            Win32Error = 0x542
+	ERROR_CANT_OPEN_ANONYMOUS                Win32Error = 0x543
+	ERROR_BAD_VALIDATION_CLASS               Win32Error = 0x544
+	ERROR_BAD_TOKEN_TYPE                     Win32Error = 0x545
+	ERROR_NO_SECURITY_ON_OBJECT              Win32Error = 0x546
+	ERROR_CANT_ACCESS_DOMAIN_INFO            Win32Error = 0x547
+	ERROR_INVALID_SERVER_STATE               Win32Error = 0x548
+	ERROR_INVALID_DOMAIN_STATE               Win32Error = 0x549
+	ERROR_INVALID_DOMAIN_ROLE                Win32Error = 0x54a
+	ERROR_NO_SUCH_DOMAIN                     Win32Error = 0x54b
+	ERROR_DOMAIN_EXISTS                      Win32Error = 0x54c
+	ERROR_DOMAIN_LIMIT_EXCEEDED              Win32Error = 0x54d
+	ERROR_INTERNAL_DB_CORRUPTION             Win32Error = 0x54e
+	ERROR_INTERNAL_ERROR                     Win32Error = 0x54f
+	ERROR_GENERIC_NOT_MAPPED                 Win32Error = 0x550
+	ERROR_BAD_DESCRIPTOR_FORMAT              Win32Error = 0x551
+	ERROR_NOT_LOGON_PROCESS                  Win32Error = 0x552
+	ERROR_LOGON_SESSION_EXISTS               Win32Error = 0x553
+	ERROR_NO_SUCH_PACKAGE                    Win32Error = 0x554
+	ERROR_BAD_LOGON_SESSION_STATE            Win32Error = 0x555
+	ERROR_LOGON_SESSION_COLLISION            Win32Error = 0x556
+	ERROR_INVALID_LOGON_TYPE                 Win32Error = 0x557
+	ERROR_CANNOT_IMPERSONATE                 Win32Error = 0x558
+	ERROR_RXACT_INVALID_STATE                Win32Error = 0x559
+	ERROR_RXACT_COMMIT_FAILURE               Win32Error = 0x55a
+	ERROR_SPECIAL_ACCOUNT                    Win32Error = 0x55b
+	ERROR_SPECIAL_GROUP                      Win32Error = 0x55c
+	ERROR_SPECIAL_USER                       Win32Error = 0x55d
+	ERROR_MEMBERS_PRIMARY_GROUP              Win32Error = 0x55e
+	ERROR_TOKEN_ALREADY_IN_USE               Win32Error = 0x55f
+	ERROR_NO_SUCH_ALIAS                      Win32Error = 0x560
+	ERROR_MEMBER_NOT_IN_ALIAS                Win32Error = 0x561
+	ERROR_MEMBER_IN_ALIAS                    Win32Error = 0x562
+	ERROR_ALIAS_EXISTS                       Win32Error = 0x563
+	ERROR_LOGON_NOT_GRANTED                  Win32Error = 0x564
+	ERROR_TOO_MANY_SECRETS                   Win32Error = 0x565
+	ERROR_SECRET_TOO_LONG                    Win32Error = 0x566
+	ERROR_INTERNAL_DB_ERROR                  Win32Error = 0x567
+	ERROR_TOO_MANY_CONTEXT_IDS               Win32Error = 0x568
+	ERROR_LOGON_TYPE_NOT_GRANTED             Win32Error = 0x569
+	ERROR_NT_CROSS_ENCRYPTION_REQUIRED       Win32Error = 0x56a
+	ERROR_NO_SUCH_MEMBER                     Win32Error = 0x56b
+	ERROR_INVALID_MEMBER                     Win32Error = 0x56c
+	ERROR_TOO_MANY_SIDS                      Win32Error = 0x56d
+	ERROR_LM_CROSS_ENCRYPTION_REQUIRED       Win32Error = 0x56e
+	ERROR_NO_INHERITANCE                     Win32Error = 0x56f
+	ERROR_FILE_CORRUPT                       Win32Error = 0x570
+	ERROR_DISK_CORRUPT                       Win32Error = 0x571
+	ERROR_NO_USER_SESSION_KEY                Win32Error = 0x572
+	ERROR_LICENSE_QUOTA_EXCEEDED             Win32Error = 0x573
+	ERROR_INVALID_WINDOW_HANDLE              Win32Error = 0x578 // Invalid window handle.
+	ERROR_INVALID_MENU_HANDLE                Win32Error = 0x579
+	ERROR_INVALID_CURSOR_HANDLE              Win32Error = 0x57a
+	ERROR_INVALID_ACCEL_HANDLE               Win32Error = 0x57b
+	ERROR_INVALID_HOOK_HANDLE                Win32Error = 0x57c
+	ERROR_INVALID_DWP_HANDLE                 Win32Error = 0x57d
+	ERROR_TLW_WITH_WSCHILD                   Win32Error = 0x57e
+	ERROR_CANNOT_FIND_WND_CLASS              Win32Error = 0x57f
+	ERROR_WINDOW_OF_OTHER_THREAD             Win32Error = 0x580
+	ERROR_HOTKEY_ALREADY_REGISTERED          Win32Error = 0x581
+	ERROR_CLASS_ALREADY_EXISTS               Win32Error = 0x582
+	ERROR_CLASS_DOES_NOT_EXIST               Win32Error = 0x583
+	ERROR_CLASS_HAS_WINDOWS                  Win32Error = 0x584
+	ERROR_INVALID_INDEX                      Win32Error = 0x585
+	ERROR_INVALID_ICON_HANDLE                Win32Error = 0x586
+	ERROR_PRIVATE_DIALOG_INDEX               Win32Error = 0x587
+	ERROR_LISTBOX_ID_NOT_FOUND               Win32Error = 0x588
+	ERROR_NO_WILDCARD_CHARACTERS             Win32Error = 0x589
+	ERROR_CLIPBOARD_NOT_OPEN                 Win32Error = 0x58a
+	ERROR_HOTKEY_NOT_REGISTERED              Win32Error = 0x58b
+	ERROR_WINDOW_NOT_DIALOG                  Win32Error = 0x58c
+	ERROR_CONTROL_ID_NOT_FOUND               Win32Error = 0x58d
+	ERROR_INVALID_COMBOBOX_MESSAGE           Win32Error = 0x58e
+	ERROR_WINDOW_NOT_COMBOBOX                Win32Error = 0x58f
+	ERROR_INVALID_EDIT_HEIGHT                Win32Error = 0x590
+	ERROR_DC_NOT_FOUND                       Win32Error = 0x591
+	ERROR_INVALID_HOOK_FILTER                Win32Error = 0x592
+	ERROR_INVALID_FILTER_PROC                Win32Error = 0x593
+	ERROR_HOOK_NEEDS_HMOD                    Win32Error = 0x594
+	ERROR_GLOBAL_ONLY_HOOK                   Win32Error = 0x595
+	ERROR_JOURNAL_HOOK_SET                   Win32Error = 0x596
+	ERROR_HOOK_NOT_INSTALLED                 Win32Error = 0x597
+	ERROR_INVALID_LB_MESSAGE                 Win32Error = 0x598
+	ERROR_SETCOUNT_ON_BAD_LB                 Win32Error = 0x599
+	ERROR_LB_WITHOUT_TABSTOPS                Win32Error = 0x59a
+	ERROR_DESTROY_OBJECT_OF_OTHER_THREAD     Win32Error = 0x59b
+	ERROR_CHILD_WINDOW_MENU                  Win32Error = 0x59c
+	ERROR_NO_SYSTEM_MENU                     Win32Error = 0x59d
+	ERROR_INVALID_MSGBOX_STYLE               Win32Error = 0x59e
+	ERROR_INVALID_SPI_VALUE                  Win32Error = 0x59f
+	ERROR_SCREEN_ALREADY_LOCKED              Win32Error = 0x5a0
+	ERROR_HWNDS_HAVE_DIFF_PARENT             Win32Error = 0x5a1
+	ERROR_NOT_CHILD_WINDOW                   Win32Error = 0x5a2
+	ERROR_INVALID_GW_COMMAND                 Win32Error = 0x5a3
+	ERROR_INVALID_THREAD_ID                  Win32Error = 0x5a4
+	ERROR_NON_MDICHILD_WINDOW                Win32Error = 0x5a5
+	ERROR_POPUP_ALREADY_ACTIVE               Win32Error = 0x5a6
+	ERROR_NO_SCROLLBARS                      Win32Error = 0x5a7
+	ERROR_INVALID_SCROLLBAR_RANGE            Win32Error = 0x5a8
+	ERROR_INVALID_SHOWWIN_COMMAND            Win32Error = 0x5a9
+	ERROR_NO_SYSTEM_RESOURCES                Win32Error = 0x5aa // Insufficient system resources exist to complete the requested service.
+	ERROR_NONPAGED_SYSTEM_RESOURCES          Win32Error = 0x5ab
+	ERROR_PAGED_SYSTEM_RESOURCES             Win32Error = 0x5ac
+	ERROR_WORKING_SET_QUOTA                  Win32Error = 0x5ad
+	ERROR_PAGEFILE_QUOTA                     Win32Error = 0x5ae
+	ERROR_COMMITMENT_LIMIT                   Win32Error = 0x5af
+	ERROR_MENU_ITEM_NOT_FOUND                Win32Error = 0x5b0
+	ERROR_INVALID_KEYBOARD_HANDLE            Win32Error = 0x5b1
+	ERROR_HOOK_TYPE_NOT_ALLOWED              Win32Error = 0x5b2
+	ERROR_REQUIRES_INTERACTIVE_WINDOWSTATION Win32Error = 0x5b3
+	ERROR_TIMEOUT                            Win32Error = 0x5b4 // This operation returned because the timeout period expired.
+	ERROR_INVALID_MONITOR_HANDLE             Win32Error = 0x5b5
+	ERROR_EVENTLOG_FILE_CORRUPT              Win32Error = 0x5dc
+	ERROR_EVENTLOG_CANT_START                Win32Error = 0x5dd
+	ERROR_LOG_FILE_FULL                      Win32Error = 0x5de
+	ERROR_EVENTLOG_FILE_CHANGED              Win32Error = 0x5df
+	ERROR_INSTALL_SERVICE                    Win32Error = 0x641
+	ERROR_INSTALL_USEREXIT                   Win32Error = 0x642
+	ERROR_INSTALL_FAILURE                    Win32Error = 0x643 // Fatal error during installation.
+	ERROR_INSTALL_SUSPEND                    Win32Error = 0x644
+	ERROR_UNKNOWN_PRODUCT                    Win32Error = 0x645
+	ERROR_UNKNOWN_FEATURE                    Win32Error = 0x646
+	ERROR_UNKNOWN_COMPONENT                  Win32Error = 0x647
+	ERROR_UNKNOWN_PROPERTY                   Win32Error = 0x648
+	ERROR_INVALID_HANDLE_STATE               Win32Error = 0x649
+	ERROR_BAD_CONFIGURATION                  Win32Error = 0x64a
+	ERROR_INDEX_ABSENT                       Win32Error = 0x64b
+	ERROR_INSTALL_SOURCE_ABSENT              Win32Error = 0x64c
+	ERROR_BAD_DATABASE_VERSION               Win32Error = 0x64d
+	ERROR_PRODUCT_UNINSTALLED                Win32Error = 0x64e // Product is uninstalled.
+	ERROR_BAD_QUERY_SYNTAX                   Win32Error = 0x64f
+	ERROR_INVALID_FIELD                      Win32Error = 0x650
+	RPC_S_INVALID_STRING_BINDING             Win32Error = 0x6a4
+	RPC_S_WRONG_KIND_OF_BINDING              Win32Error = 0x6a5
+	RPC_S_INVALID_BINDING                    Win32Error = 0x6a6
+	RPC_S_PROTSEQ_NOT_SUPPORTED              Win32Error = 0x6a7
+	RPC_S_INVALID_RPC_PROTSEQ                Win32Error = 0x6a8
+	RPC_S_INVALID_STRING_UUID                Win32Error = 0x6a9
+	RPC_S_INVALID_ENDPOINT_FORMAT            Win32Error = 0x6aa
+	RPC_S_INVALID_NET_ADDR                   Win32Error = 0x6ab
+	RPC_S_NO_ENDPOINT_FOUND                  Win32Error = 0x6ac
+	RPC_S_INVALID_TIMEOUT                    Win32Error = 0x6ad
+	RPC_S_OBJECT_NOT_FOUND                   Win32Error = 0x6ae
+	RPC_S_ALREADY_REGISTERED                 Win32Error = 0x6af
+	RPC_S_TYPE_ALREADY_REGISTERED            Win32Error = 0x6b0
+	RPC_S_ALREADY_LISTENING                  Win32Error = 0x6b1
+	RPC_S_NO_PROTSEQS_REGISTERED             Win32Error = 0x6b2
+	RPC_S_NOT_LISTENING                      Win32Error = 0x6b3
+	RPC_S_UNKNOWN_MGR_TYPE                   Win32Error = 0x6b4
+	RPC_S_UNKNOWN_IF                         Win32Error = 0x6b5
+	RPC_S_NO_BINDINGS                        Win32Error = 0x6b6
+	RPC_S_NO_PROTSEQS                        Win32Error = 0x6b7
+	RPC_S_CANT_CREATE_ENDPOINT               Win32Error = 0x6b8
+	RPC_S_OUT_OF_RESOURCES                   Win32Error = 0x6b9
+	RPC_S_SERVER_UNAVAILABLE                 Win32Error = 0x6ba
+	RPC_S_SERVER_TOO_BUSY                    Win32Error = 0x6bb
+	RPC_S_INVALID_NETWORK_OPTIONS            Win32Error = 0x6bc
+	RPC_S_NO_CALL_ACTIVE                     Win32Error = 0x6bd
+	RPC_S_CALL_FAILED                        Win32Error = 0x6be
+	RPC_S_CALL_FAILED_DNE                    Win32Error = 0x6bf
+	RPC_S_PROTOCOL_ERROR                     Win32Error = 0x6c0
+	RPC_S_UNSUPPORTED_TRANS_SYN              Win32Error = 0x6c2
+	RPC_S_UNSUPPORTED_TYPE                   Win32Error = 0x6c4
+	RPC_S_INVALID_TAG                        Win32Error = 0x6c5
+	RPC_S_INVALID_BOUND                      Win32Error = 0x6c6
+	RPC_S_NO_ENTRY_NAME                      Win32Error = 0x6c7
+	RPC_S_INVALID_NAME_SYNTAX                Win32Error = 0x6c8
+	RPC_S_UNSUPPORTED_NAME_SYNTAX            Win32Error = 0x6c9
+	RPC_S_UUID_NO_ADDRESS                    Win32Error = 0x6cb
+	RPC_S_DUPLICATE_ENDPOINT                 Win32Error = 0x6cc
+	RPC_S_UNKNOWN_AUTHN_TYPE                 Win32Error = 0x6cd
+	RPC_S_MAX_CALLS_TOO_SMALL                Win32Error = 0x6ce
+	RPC_S_STRING_TOO_LONG                    Win32Error = 0x6cf
+	RPC_S_PROTSEQ_NOT_FOUND                  Win32Error = 0x6d0
+	RPC_S_PROCNUM_OUT_OF_RANGE               Win32Error = 0x6d1
+	RPC_S_BINDING_HAS_NO_AUTH                Win32Error = 0x6d2
+	RPC_S_UNKNOWN_AUTHN_SERVICE              Win32Error = 0x6d3
+	RPC_S_UNKNOWN_AUTHN_LEVEL                Win32Error = 0x6d4
+	RPC_S_INVALID_AUTH_IDENTITY              Win32Error = 0x6d5
+	RPC_S_UNKNOWN_AUTHZ_SERVICE              Win32Error = 0x6d6
+	EPT_S_INVALID_ENTRY                      Win32Error = 0x6d7
+	EPT_S_CANT_PERFORM_OP                    Win32Error = 0x6d8
+	EPT_S_NOT_REGISTERED                     Win32Error = 0x6d9
+	RPC_S_NOTHING_TO_EXPORT                  Win32Error = 0x6da
+	RPC_S_INCOMPLETE_NAME                    Win32Error = 0x6db
+	RPC_S_INVALID_VERS_OPTION                Win32Error = 0x6dc
+	RPC_S_NO_MORE_MEMBERS                    Win32Error = 0x6dd
+	RPC_S_NOT_ALL_OBJS_UNEXPORTED            Win32Error = 0x6de
+	RPC_S_INTERFACE_NOT_FOUND                Win32Error = 0x6df
+	RPC_S_ENTRY_ALREADY_EXISTS               Win32Error = 0x6e0
+	RPC_S_ENTRY_NOT_FOUND                    Win32Error = 0x6e1
+	RPC_S_NAME_SERVICE_UNAVAILABLE           Win32Error = 0x6e2
+	RPC_S_INVALID_NAF_ID                     Win32Error = 0x6e3
+	RPC_S_CANNOT_SUPPORT                     Win32Error = 0x6e4
+	RPC_S_NO_CONTEXT_AVAILABLE               Win32Error = 0x6e5
+	RPC_S_INTERNAL_ERROR                     Win32Error = 0x6e6
+	RPC_S_ZERO_DIVIDE                        Win32Error = 0x6e7
+	RPC_S_ADDRESS_ERROR                      Win32Error = 0x6e8
+	RPC_S_FP_DIV_ZERO                        Win32Error = 0x6e9
+	RPC_S_FP_UNDERFLOW                       Win32Error = 0x6ea
+	RPC_S_FP_OVERFLOW                        Win32Error = 0x6eb
+	RPC_X_NO_MORE_ENTRIES                    Win32Error = 0x6ec
+	RPC_X_SS_CHAR_TRANS_OPEN_FAIL            Win32Error = 0x6ed
+	RPC_X_SS_CHAR_TRANS_SHORT_FILE           Win32Error = 0x6ee
+	RPC_X_SS_IN_NULL_CONTEXT                 Win32Error = 0x6ef
+	RPC_X_SS_CONTEXT_DAMAGED                 Win32Error = 0x6f1
+	RPC_X_SS_HANDLES_MISMATCH                Win32Error = 0x6f2
+	RPC_X_SS_CANNOT_GET_CALL_HANDLE          Win32Error = 0x6f3
+	RPC_X_NULL_REF_POINTER                   Win32Error = 0x6f4
+	RPC_X_ENUM_VALUE_OUT_OF_RANGE            Win32Error = 0x6f5
+	RPC_X_BYTE_COUNT_TOO_SMALL               Win32Error = 0x6f6
+	RPC_X_BAD_STUB_DATA                      Win32Error = 0x6f7
+	ERROR_INVALID_USER_BUFFER                Win32Error = 0x6f8
+	ERROR_UNRECOGNIZED_MEDIA                 Win32Error = 0x6f9
+	ERROR_NO_TRUST_LSA_SECRET                Win32Error = 0x6fa
+	ERROR_NO_TRUST_SAM_ACCOUNT               Win32Error = 0x6fb
+	ERROR_TRUSTED_DOMAIN_FAILURE             Win32Error = 0x6fc
+	ERROR_TRUSTED_RELATIONSHIP_FAILURE       Win32Error = 0x6fd
+	ERROR_TRUST_FAILURE                      Win32Error = 0x6fe
+	RPC_S_CALL_IN_PROGRESS                   Win32Error = 0x6ff
+	ERROR_NETLOGON_NOT_STARTED               Win32Error = 0x700
+	ERROR_ACCOUNT_EXPIRED                    Win32Error = 0x701
+	ERROR_REDIRECTOR_HAS_OPEN_HANDLES        Win32Error = 0x702
+	ERROR_PRINTER_DRIVER_ALREADY_INSTALLED   Win32Error = 0x703
+	ERROR_UNKNOWN_PORT                       Win32Error = 0x704
+	ERROR_UNKNOWN_PRINTER_DRIVER             Win32Error = 0x705
+	ERROR_UNKNOWN_PRINTPROCESSOR             Win32Error = 0x706
+	ERROR_INVALID_SEPARATOR_FILE             Win32Error = 0x707
+	ERROR_INVALID_PRIORITY                   Win32Error = 0x708
+	ERROR_INVALID_PRINTER_NAME               Win32Error = 0x709
+	ERROR_PRINTER_ALREADY_EXISTS             Win32Error = 0x70a
+	ERROR_INVALID_PRINTER_COMMAND            Win32Error = 0x70b
+	ERROR_INVALID_DATATYPE                   Win32Error = 0x70c
+	ERROR_INVALID_ENVIRONMENT                Win32Error = 0x70d
+	RPC_S_NO_MORE_BINDINGS                   Win32Error = 0x70e
+	ERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT  Win32Error = 0x70f
+	ERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNT  Win32Error = 0x710
+	ERROR_NOLOGON_SERVER_TRUST_ACCOUNT       Win32Error = 0x711
+	ERROR_DOMAIN_TRUST_INCONSISTENT          Win32Error = 0x712
+	ERROR_SERVER_HAS_OPEN_HANDLES            Win32Error = 0x713
+	ERROR_RESOURCE_DATA_NOT_FOUND            Win32Error = 0x714
+	ERROR_RESOURCE_TYPE_NOT_FOUND            Win32Error = 0x715
+	ERROR_RESOURCE_NAME_NOT_FOUND            Win32Error = 0x716
+	ERROR_RESOURCE_LANG_NOT_FOUND            Win32Error = 0x717
+	ERROR_NOT_ENOUGH_QUOTA                   Win32Error = 0x718
+	RPC_S_NO_INTERFACES                      Win32Error = 0x719
+	RPC_S_CALL_CANCELLED                     Win32Error = 0x71a
+	RPC_S_BINDING_INCOMPLETE                 Win32Error = 0x71b
+	RPC_S_COMM_FAILURE                       Win32Error = 0x71c
+	RPC_S_UNSUPPORTED_AUTHN_LEVEL            Win32Error = 0x71d
+	RPC_S_NO_PRINC_NAME                      Win32Error = 0x71e
+	RPC_S_NOT_RPC_ERROR                      Win32Error = 0x71f
+	RPC_S_UUID_LOCAL_ONLY                    Win32Error = 0x720
+	RPC_S_SEC_PKG_ERROR                      Win32Error = 0x721
+	RPC_S_NOT_CANCELLED                      Win32Error = 0x722
+	RPC_X_INVALID_ES_ACTION                  Win32Error = 0x723
+	RPC_X_WRONG_ES_VERSION                   Win32Error = 0x724
+	RPC_X_WRONG_STUB_VERSION                 Win32Error = 0x725
+	RPC_X_INVALID_PIPE_OBJECT                Win32Error = 0x726
+	RPC_X_WRONG_PIPE_ORDER                   Win32Error = 0x727
+	RPC_X_WRONG_PIPE_VERSION                 Win32Error = 0x728
+	RPC_S_GROUP_MEMBER_NOT_FOUND             Win32Error = 0x76a
+	EPT_S_CANT_CREATE                        Win32Error = 0x76b
+	RPC_S_INVALID_OBJECT                     Win32Error = 0x76c
+	ERROR_INVALID_TIME                       Win32Error = 0x76d
+	ERROR_INVALID_FORM_NAME                  Win32Error = 0x76e
+	ERROR_INVALID_FORM_SIZE                  Win32Error = 0x76f
+	ERROR_ALREADY_WAITING                    Win32Error = 0x770
+	ERROR_PRINTER_DELETED                    Win32Error = 0x771
+	ERROR_INVALID_PRINTER_STATE              Win32Error = 0x772
+	ERROR_PASSWORD_MUST_CHANGE               Win32Error = 0x773
+	ERROR_DOMAIN_CONTROLLER_NOT_FOUND        Win32Error = 0x774
+	ERROR_ACCOUNT_LOCKED_OUT                 Win32Error = 0x775
+	OR_INVALID_OXID                          Win32Error = 0x776
+	OR_INVALID_OID                           Win32Error = 0x777
+	OR_INVALID_SET                           Win32Error = 0x778
+	RPC_S_SEND_INCOMPLETE                    Win32Error = 0x779
+	RPC_S_INVALID_ASYNC_HANDLE               Win32Error = 0x77a
+	RPC_S_INVALID_ASYNC_CALL                 Win32Error = 0x77b
+	RPC_X_PIPE_CLOSED                        Win32Error = 0x77c
+	RPC_X_PIPE_DISCIPLINE_ERROR              Win32Error = 0x77d
+	RPC_X_PIPE_EMPTY                         Win32Error = 0x77e
+	ERROR_NO_SITENAME                        Win32Error = 0x77f
+	ERROR_CANT_ACCESS_FILE                   Win32Error = 0x780
+	ERROR_CANT_RESOLVE_FILENAME              Win32Error = 0x781
+	ERROR_DS_MEMBERSHIP_EVALUATED_LOCALLY    Win32Error = 0x2009
+	ERROR_DS_NO_ATTRIBUTE_OR_VALUE           Win32Error = 0x200a
+	ERROR_DS_INVALID_ATTRIBUTE_SYNTAX        Win32Error = 0x200b
+	ERROR_DS_ATTRIBUTE_TYPE_UNDEFINED        Win32Error = 0x200c
+	ERROR_DS_ATTRIBUTE_OR_VALUE_EXISTS       Win32Error = 0x200d
+	ERROR_DS_BUSY                            Win32Error = 0x200e
+	ERROR_DS_UNAVAILABLE                     Win32Error = 0x200f
+	ERROR_DS_NO_RIDS_ALLOCATED               Win32Error = 0x2010
+	ERROR_DS_NO_MORE_RIDS                    Win32Error = 0x2011
+	ERROR_DS_INCORRECT_ROLE_OWNER            Win32Error = 0x2012
+	ERROR_DS_RIDMGR_INIT_ERROR               Win32Error = 0x2013
+	ERROR_DS_OBJ_CLASS_VIOLATION             Win32Error = 0x2014
+	ERROR_DS_CANT_ON_NON_LEAF                Win32Error = 0x2015
+	ERROR_DS_CANT_ON_RDN                     Win32Error = 0x2016
+	ERROR_DS_CANT_MOD_OBJ_CLASS              Win32Error = 0x2017
+	ERROR_DS_CROSS_DOM_MOVE_ERROR            Win32Error = 0x2018
+	ERROR_DS_GC_NOT_AVAILABLE                Win32Error = 0x2019
+	ERROR_NO_BROWSER_SERVERS_FOUND           Win32Error = 0x17e6
+	ERROR_INVALID_PIXEL_FORMAT               Win32Error = 0x7d0
+	ERROR_BAD_DRIVER                         Win32Error = 0x7d1
+	ERROR_INVALID_WINDOW_STYLE               Win32Error = 0x7d2
+	ERROR_METAFILE_NOT_SUPPORTED             Win32Error = 0x7d3
+	ERROR_TRANSFORM_NOT_SUPPORTED            Win32Error = 0x7d4
+	ERROR_CLIPPING_NOT_SUPPORTED             Win32Error = 0x7d5
+	ERROR_INVALID_CMM                        Win32Error = 0x7da
+	ERROR_INVALID_PROFILE                    Win32Error = 0x7db
+	ERROR_TAG_NOT_FOUND                      Win32Error = 0x7dc
+	ERROR_TAG_NOT_PRESENT                    Win32Error = 0x7dd
+	ERROR_DUPLICATE_TAG                      Win32Error = 0x7de
+	ERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICE Win32Error = 0x7df
+	ERROR_PROFILE_NOT_FOUND                  Win32Error = 0x7e0
+	ERROR_INVALID_COLORSPACE                 Win32Error = 0x7e1
+	ERROR_ICM_NOT_ENABLED                    Win32Error = 0x7e2
+	ERROR_DELETING_ICM_XFORM                 Win32Error = 0x7e3
+	ERROR_INVALID_TRANSFORM                  Win32Error = 0x7e4
+	ERROR_UNKNOWN_PRINT_MONITOR              Win32Error = 0xbb8
+	ERROR_PRINTER_DRIVER_IN_USE              Win32Error = 0xbb9
+	ERROR_SPOOL_FILE_NOT_FOUND               Win32Error = 0xbba
+	ERROR_SPL_NO_STARTDOC                    Win32Error = 0xbbb
+	ERROR_SPL_NO_ADDJOB                      Win32Error = 0xbbc
+	ERROR_PRINT_PROCESSOR_ALREADY_INSTALLED  Win32Error = 0xbbd
+	ERROR_PRINT_MONITOR_ALREADY_INSTALLED    Win32Error = 0xbbe
+	ERROR_INVALID_PRINT_MONITOR              Win32Error = 0xbbf
+	ERROR_PRINT_MONITOR_IN_USE               Win32Error = 0xbc0
+	ERROR_PRINTER_HAS_JOBS_QUEUED            Win32Error = 0xbc1
+	ERROR_SUCCESS_REBOOT_REQUIRED            Win32Error = 0xbc2
+	ERROR_SUCCESS_RESTART_REQUIRED           Win32Error = 0xbc3
+	ERROR_WINS_INTERNAL                      Win32Error = 0xfa0
+	ERROR_CAN_NOT_DEL_LOCAL_WINS             Win32Error = 0xfa1
+	ERROR_STATIC_INIT                        Win32Error = 0xfa2
+	ERROR_INC_BACKUP                         Win32Error = 0xfa3
+	ERROR_FULL_BACKUP                        Win32Error = 0xfa4
+	ERROR_REC_NON_EXISTENT                   Win32Error = 0xfa5
+	ERROR_RPL_NOT_ALLOWED                    Win32Error = 0xfa6
+	ERROR_DHCP_ADDRESS_CONFLICT              Win32Error = 0x1004
+	ERROR_WMI_GUID_NOT_FOUND                 Win32Error = 0x1068
+	ERROR_WMI_INSTANCE_NOT_FOUND             Win32Error = 0x1069
+	ERROR_WMI_ITEMID_NOT_FOUND               Win32Error = 0x106a
+	ERROR_WMI_TRY_AGAIN                      Win32Error = 0x106b
+	ERROR_WMI_DP_NOT_FOUND                   Win32Error = 0x106c
+	ERROR_WMI_UNRESOLVED_INSTANCE_REF        Win32Error = 0x106d
+	ERROR_WMI_ALREADY_ENABLED                Win32Error = 0x106e
+	ERROR_WMI_GUID_DISCONNECTED              Win32Error = 0x106f
+	ERROR_WMI_SERVER_UNAVAILABLE             Win32Error = 0x1070
+	ERROR_WMI_DP_FAILED                      Win32Error = 0x1071
+	ERROR_WMI_INVALID_MOF                    Win32Error = 0x1072
+	ERROR_WMI_INVALID_REGINFO                Win32Error = 0x1073
+	ERROR_INVALID_MEDIA                      Win32Error = 0x10cc
+	ERROR_INVALID_LIBRARY                    Win32Error = 0x10cd
+	ERROR_INVALID_MEDIA_POOL                 Win32Error = 0x10ce
+	ERROR_DRIVE_MEDIA_MISMATCH               Win32Error = 0x10cf
+	ERROR_MEDIA_OFFLINE                      Win32Error = 0x10d0
+	ERROR_LIBRARY_OFFLINE                    Win32Error = 0x10d1
+	ERROR_EMPTY                              Win32Error = 0x10d2
+	ERROR_NOT_EMPTY                          Win32Error = 0x10d3
+	ERROR_MEDIA_UNAVAILABLE                  Win32Error = 0x10d4
+	ERROR_RESOURCE_DISABLED                  Win32Error = 0x10d5
+	ERROR_INVALID_CLEANER                    Win32Error = 0x10d6
+	ERROR_UNABLE_TO_CLEAN                    Win32Error = 0x10d7
+	ERROR_OBJECT_NOT_FOUND                   Win32Error = 0x10d8
+	ERROR_DATABASE_FAILURE                   Win32Error = 0x10d9
+	ERROR_DATABASE_FULL                      Win32Error = 0x10da
+	ERROR_MEDIA_INCOMPATIBLE                 Win32Error = 0x10db
+	ERROR_RESOURCE_NOT_PRESENT               Win32Error = 0x10dc
+	ERROR_INVALID_OPERATION                  Win32Error = 0x10dd
+	ERROR_MEDIA_NOT_AVAILABLE                Win32Error = 0x10de
+	ERROR_DEVICE_NOT_AVAILABLE               Win32Error = 0x10df
+	ERROR_REQUEST_REFUSED                    Win32Error = 0x10e0
+	ERROR_FILE_OFFLINE                       Win32Error = 0x10fe
+	ERROR_REMOTE_STORAGE_NOT_ACTIVE          Win32Error = 0x10ff
+	ERROR_REMOTE_STORAGE_MEDIA_ERROR         Win32Error = 0x1100
+	ERROR_NOT_A_REPARSE_POINT                Win32Error = 0x1126
+	ERROR_REPARSE_ATTRIBUTE_CONFLICT         Win32Error = 0x1127
+	ERROR_DEPENDENT_RESOURCE_EXISTS          Win32Error = 0x1389
+	ERROR_DEPENDENCY_NOT_FOUND               Win32Error = 0x138a
+	ERROR_DEPENDENCY_ALREADY_EXISTS          Win32Error = 0x138b
+	ERROR_RESOURCE_NOT_ONLINE                Win32Error = 0x138c
+	ERROR_HOST_NODE_NOT_AVAILABLE            Win32Error = 0x138d
+	ERROR_RESOURCE_NOT_AVAILABLE             Win32Error = 0x138e
+	ERROR_RESOURCE_NOT_FOUND                 Win32Error = 0x138f
+	ERROR_SHUTDOWN_CLUSTER                   Win32Error = 0x1390
+	ERROR_CANT_EVICT_ACTIVE_NODE             Win32Error = 0x1391
+	ERROR_OBJECT_ALREADY_EXISTS              Win32Error = 0x1392
+	ERROR_OBJECT_IN_LIST                     Win32Error = 0x1393
+	ERROR_GROUP_NOT_AVAILABLE                Win32Error = 0x1394
+	ERROR_GROUP_NOT_FOUND                    Win32Error = 0x1395
+	ERROR_GROUP_NOT_ONLINE                   Win32Error = 0x1396
+	ERROR_HOST_NODE_NOT_RESOURCE_OWNER       Win32Error = 0x1397
+	ERROR_HOST_NODE_NOT_GROUP_OWNER          Win32Error = 0x1398
+	ERROR_RESMON_CREATE_FAILED               Win32Error = 0x1399
+	ERROR_RESMON_ONLINE_FAILED               Win32Error = 0x139a
+	ERROR_RESOURCE_ONLINE                    Win32Error = 0x139b
+	ERROR_QUORUM_RESOURCE                    Win32Error = 0x139c
+	ERROR_NOT_QUORUM_CAPABLE                 Win32Error = 0x139d
+	ERROR_CLUSTER_SHUTTING_DOWN              Win32Error = 0x139e
+	ERROR_INVALID_STATE                      Win32Error = 0x139f // The group or resource is not in the correct state to perform the requested operation.
+	ERROR_RESOURCE_PROPERTIES_STORED         Win32Error = 0x13a0
+	ERROR_NOT_QUORUM_CLASS                   Win32Error = 0x13a1
+	ERROR_CORE_RESOURCE                      Win32Error = 0x13a2
+	ERROR_QUORUM_RESOURCE_ONLINE_FAILED      Win32Error = 0x13a3
+	ERROR_QUORUMLOG_OPEN_FAILED              Win32Error = 0x13a4
+	ERROR_CLUSTERLOG_CORRUPT                 Win32Error = 0x13a5
+	ERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZE  Win32Error = 0x13a6
+	ERROR_CLUSTERLOG_EXCEEDS_MAXSIZE         Win32Error = 0x13a7
+	ERROR_CLUSTERLOG_CHKPOINT_NOT_FOUND      Win32Error = 0x13a8
+	ERROR_CLUSTERLOG_NOT_ENOUGH_SPACE        Win32Error = 0x13a9
+	ERROR_ENCRYPTION_FAILED                  Win32Error = 0x1770
+	ERROR_DECRYPTION_FAILED                  Win32Error = 0x1771
+	ERROR_FILE_ENCRYPTED                     Win32Error = 0x1772
+	ERROR_NO_RECOVERY_POLICY                 Win32Error = 0x1773
+	ERROR_NO_EFS                             Win32Error = 0x1774
+	ERROR_WRONG_EFS                          Win32Error = 0x1775
+	ERROR_NO_USER_KEYS                       Win32Error = 0x1776
+	ERROR_FILE_NOT_ENCRYPTED                 Win32Error = 0x1777
+	ERROR_NOT_EXPORT_FORMAT                  Win32Error = 0x1778
+)
@@ -0,0 +1,68 @@
+package hresult
+
+import "testing"
+
+func TestFromWin32(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Win32Error
+		want HRESULT
+	}{
+		{"ERROR_SUCCESS", ERROR_SUCCESS, S_OK},
+		{"ERROR_FILE_NOT_FOUND", ERROR_FILE_NOT_FOUND, 0x80070002},
+		{"ERROR_ACCESS_DENIED", ERROR_ACCESS_DENIED, E_ACCESSDENIED},
+		{"ERROR_PROC_NOT_FOUND", ERROR_PROC_NOT_FOUND, 0x8007007F},
+		{"alreadyHRESULT", Win32Error(E_FAIL), E_FAIL},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if hr := FromWin32(test.e); hr != test.want {
+				t.Errorf("FromWin32() = %v, want %v", hr, test.want)
+			}
+		})
+	}
+}
+
+func TestWin32(t *testing.T) {
+	tests := []struct {
+		name   string
+		hr     HRESULT
+		want   Win32Error
+		wantOK bool
+	}{
+		{"fromWin32", FromWin32(ERROR_FILE_NOT_FOUND), ERROR_FILE_NOT_FOUND, true},
+		{"E_ACCESSDENIED", E_ACCESSDENIED, ERROR_ACCESS_DENIED, true},
+		{"otherFacility", E_FAIL, 0, false},
+		{"success", 0x00070002, 0, false},
+		{"S_OK", S_OK, 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e, ok := test.hr.Win32()
+			if e != test.want || ok != test.wantOK {
+				t.Errorf("Win32() = %v, %v, want %v, %v", e, ok, test.want, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestWin32ErrorString(t *testing.T) {
+	tests := []struct {
+		name string
+		e    Win32Error
+		want string
+	}{
+		{"known", ERROR_FILE_NOT_FOUND, "ERROR_FILE_NOT_FOUND"},
+		{"unknown", 0xFFFE, "Win32Error(65534)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if s := test.e.String(); s != test.want {
+				t.Errorf("String() = %q, want %q", s, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,7505 @@
+// Code generated by namegen. DO NOT EDIT.
+
+package hresult
+
+// The HRESULT codes of winerror.h which aren't declared by hand.
+const (
+	SEC_E_OK                                                 HRESULT = 0x00000000
+	CO_E_ATTEMPT_TO_CREATE_OUTSIDE_CLIENT_CONTEXT            HRESULT = 0x80004024
+	CO_E_SERVER_PAUSED                                       HRESULT = 0x80004025
+	CO_E_SERVER_NOT_PAUSED                                   HRESULT = 0x80004026
+	CO_E_CLASS_DISABLED                                      HRESULT = 0x80004027
+	CO_E_CLRNOTAVAILABLE                                     HRESULT = 0x80004028
+	CO_E_ASYNC_WORK_REJECTED                                 HRESULT = 0x80004029
+	CO_E_SERVER_INIT_TIMEOUT                                 HRESULT = 0x8000402A
+	CO_E_NO_SECCTX_IN_ACTIVATE                               HRESULT = 0x8000402B
+	CO_E_TRACKER_CONFIG                                      HRESULT = 0x80004030
+	CO_E_THREADPOOL_CONFIG                                   HRESULT = 0x80004031
+	CO_E_SXS_CONFIG                                          HRESULT = 0x80004032
+	CO_E_MALFORMED_SPN                                       HRESULT = 0x80004033
+	REGDB_E_BADTHREADINGMODEL                                HRESULT = 0x80040156
+	CS_E_OBJECT_NOTFOUND                                     HRESULT = 0x80040169
+	CS_E_OBJECT_ALREADY_EXISTS                               HRESULT = 0x8004016A
+	CS_E_INVALID_PATH                                        HRESULT = 0x8004016B
+	CS_E_NETWORK_ERROR                                       HRESULT = 0x8004016C
+	CS_E_ADMIN_LIMIT_EXCEEDED                                HRESULT = 0x8004016D
+	CS_E_SCHEMA_MISMATCH                                     HRESULT = 0x8004016E
+	CS_E_INTERNAL_ERROR                                      HRESULT = 0x8004016F
+	EVENT_E_FIRST                                            HRESULT = 0x80040200
+	EVENT_E_LAST                                             HRESULT = 0x8004021F
+	EVENT_S_FIRST                                            HRESULT = 0x00040200
+	EVENT_S_LAST                                             HRESULT = 0x0004021F
+	EVENT_S_SOME_SUBSCRIBERS_FAILED                          HRESULT = 0x00040200
+	EVENT_E_ALL_SUBSCRIBERS_FAILED                           HRESULT = 0x80040201
+	EVENT_S_NOSUBSCRIBERS                                    HRESULT = 0x00040202
+	EVENT_E_QUERYSYNTAX                                      HRESULT = 0x80040203
+	EVENT_E_QUERYFIELD                                       HRESULT = 0x80040204
+	EVENT_E_INTERNALEXCEPTION                                HRESULT = 0x80040205
+	EVENT_E_INTERNALERROR                                    HRESULT = 0x80040206
+	EVENT_E_INVALID_PER_USER_SID                             HRESULT = 0x80040207
+	EVENT_E_USER_EXCEPTION                                   HRESULT = 0x80040208
+	EVENT_E_TOO_MANY_METHODS                                 HRESULT = 0x80040209
+	EVENT_E_MISSING_EVENTCLASS                               HRESULT = 0x8004020A
+	EVENT_E_NOT_ALL_REMOVED                                  HRESULT = 0x8004020B
+	EVENT_E_COMPLUS_NOT_INSTALLED                            HRESULT = 0x8004020C
+	EVENT_E_CANT_MODIFY_OR_DELETE_UNCONFIGURED_OBJECT        HRESULT = 0x8004020D
+	EVENT_E_CANT_MODIFY_OR_DELETE_CONFIGURED_OBJECT          HRESULT = 0x8004020E
+	EVENT_E_INVALID_EVENT_CLASS_PARTITION                    HRESULT = 0x8004020F
+	EVENT_E_PER_USER_SID_NOT_LOGGED_ON                       HRESULT = 0x80040210
+	XACT_E_ALREADYOTHERSINGLEPHASE                           HRESULT = 0x8004D000
+	XACT_E_CANTRETAIN                                        HRESULT = 0x8004D001
+	XACT_E_COMMITFAILED                                      HRESULT = 0x8004D002
+	XACT_E_COMMITPREVENTED                                   HRESULT = 0x8004D003
+	XACT_E_HEURISTICABORT                                    HRESULT = 0x8004D004
+	XACT_E_HEURISTICCOMMIT                                   HRESULT = 0x8004D005
+	XACT_E_HEURISTICDAMAGE                                   HRESULT = 0x8004D006
+	XACT_E_HEURISTICDANGER                                   HRESULT = 0x8004D007
+	XACT_E_ISOLATIONLEVEL                                    HRESULT = 0x8004D008
+	XACT_E_NOASYNC                                           HRESULT = 0x8004D009
+	XACT_E_NOENLIST                                          HRESULT = 0x8004D00A
+	XACT_E_NOISORETAIN                                       HRESULT = 0x8004D00B
+	XACT_E_NORESOURCE                                        HRESULT = 0x8004D00C
+	XACT_E_NOTCURRENT                                        HRESULT = 0x8004D00D
+	XACT_E_NOTRANSACTION                                     HRESULT = 0x8004D00E
+	XACT_E_NOTSUPPORTED                                      HRESULT = 0x8004D00F
+	XACT_E_UNKNOWNRMGRID                                     HRESULT = 0x8004D010
+	XACT_E_WRONGSTATE                                        HRESULT = 0x8004D011
+	XACT_E_WRONGUOW                                          HRESULT = 0x8004D012
+	XACT_E_XTIONEXISTS                                       HRESULT = 0x8004D013
+	XACT_E_NOIMPORTOBJECT                                    HRESULT = 0x8004D014
+	XACT_E_INVALIDCOOKIE                                     HRESULT = 0x8004D015
+	XACT_E_INDOUBT                                           HRESULT = 0x8004D016
+	XACT_E_NOTIMEOUT                                         HRESULT = 0x8004D017
+	XACT_E_ALREADYINPROGRESS                                 HRESULT = 0x8004D018
+	XACT_E_ABORTED                                           HRESULT = 0x8004D019
+	XACT_E_LOGFULL                                           HRESULT = 0x8004D01A
+	XACT_E_TMNOTAVAILABLE                                    HRESULT = 0x8004D01B
+	XACT_E_CONNECTION_DOWN                                   HRESULT = 0x8004D01C
+	XACT_E_CONNECTION_DENIED                                 HRESULT = 0x8004D01D
+	XACT_E_REENLISTTIMEOUT                                   HRESULT = 0x8004D01E
+	XACT_E_TIP_CONNECT_FAILED                                HRESULT = 0x8004D01F
+	XACT_E_TIP_PROTOCOL_ERROR                                HRESULT = 0x8004D020
+	XACT_E_TIP_PULL_FAILED                                   HRESULT = 0x8004D021
+	XACT_E_DEST_TMNOTAVAILABLE                               HRESULT = 0x8004D022
+	XACT_E_TIP_DISABLED                                      HRESULT = 0x8004D023
+	XACT_E_NETWORK_TX_DISABLED                               HRESULT = 0x8004D024
+	XACT_E_PARTNER_NETWORK_TX_DISABLED                       HRESULT = 0x8004D025
+	XACT_E_XA_TX_DISABLED                                    HRESULT = 0x8004D026
+	XACT_E_UNABLE_TO_READ_DTC_CONFIG                         HRESULT = 0x8004D027
+	XACT_E_UNABLE_TO_LOAD_DTC_PROXY                          HRESULT = 0x8004D028
+	XACT_E_ABORTING                                          HRESULT = 0x8004D029
+	XACT_E_CLERKNOTFOUND                                     HRESULT = 0x8004D080
+	XACT_E_CLERKEXISTS                                       HRESULT = 0x8004D081
+	XACT_E_RECOVERYINPROGRESS                                HRESULT = 0x8004D082
+	XACT_E_TRANSACTIONCLOSED                                 HRESULT = 0x8004D083
+	XACT_E_INVALIDLSN                                        HRESULT = 0x8004D084
+	XACT_E_REPLAYREQUEST                                     HRESULT = 0x8004D085
+	XACT_S_ASYNC                                             HRESULT = 0x0004D000
+	XACT_S_DEFECT                                            HRESULT = 0x0004D001
+	XACT_S_READONLY                                          HRESULT = 0x0004D002
+	XACT_S_SOMENORETAIN                                      HRESULT = 0x0004D003
+	XACT_S_OKINFORM                                          HRESULT = 0x0004D004
+	XACT_S_MADECHANGESCONTENT                                HRESULT = 0x0004D005
+	XACT_S_MADECHANGESINFORM                                 HRESULT = 0x0004D006
+	XACT_S_ALLNORETAIN                                       HRESULT = 0x0004D007
+	XACT_S_ABORTING                                          HRESULT = 0x0004D008
+	XACT_S_SINGLEPHASE                                       HRESULT = 0x0004D009
+	XACT_S_LOCALLY_OK                                        HRESULT = 0x0004D00A
+	XACT_S_LASTRESOURCEMANAGER                               HRESULT = 0x0004D010
+	CONTEXT_E_FIRST                                          HRESULT = 0x8004E000
+	CONTEXT_E_LAST                                           HRESULT = 0x8004E02F
+	CONTEXT_S_FIRST                                          HRESULT = 0x0004E000
+	CONTEXT_S_LAST                                           HRESULT = 0x0004E02F
+	CONTEXT_E_ABORTED                                        HRESULT = 0x8004E002
+	CONTEXT_E_ABORTING                                       HRESULT = 0x8004E003
+	CONTEXT_E_NOCONTEXT                                      HRESULT = 0x8004E004
+	CONTEXT_E_WOULD_DEADLOCK                                 HRESULT = 0x8004E005
+	CONTEXT_E_SYNCH_TIMEOUT                                  HRESULT = 0x8004E006
+	CONTEXT_E_OLDREF                                         HRESULT = 0x8004E007
+	CONTEXT_E_ROLENOTFOUND                                   HRESULT = 0x8004E00C
+	CONTEXT_E_TMNOTAVAILABLE                                 HRESULT = 0x8004E00F
+	CO_E_ACTIVATIONFAILED                                    HRESULT = 0x8004E021
+	CO_E_ACTIVATIONFAILED_EVENTLOGGED                        HRESULT = 0x8004E022
+	CO_E_ACTIVATIONFAILED_CATALOGERROR                       HRESULT = 0x8004E023
+	CO_E_ACTIVATIONFAILED_TIMEOUT                            HRESULT = 0x8004E024
+	CO_E_INITIALIZATIONFAILED                                HRESULT = 0x8004E025
+	CONTEXT_E_NOJIT                                          HRESULT = 0x8004E026
+	CONTEXT_E_NOTRANSACTION                                  HRESULT = 0x8004E027
+	CO_E_THREADINGMODEL_CHANGED                              HRESULT = 0x8004E028
+	CO_E_NOIISINTRINSICS                                     HRESULT = 0x8004E029
+	CO_E_NOCOOKIES                                           HRESULT = 0x8004E02A
+	CO_E_DBERROR                                             HRESULT = 0x8004E02B
+	CO_E_NOTPOOLED                                           HRESULT = 0x8004E02C
+	CO_E_NOTCONSTRUCTED                                      HRESULT = 0x8004E02D
+	CO_E_NOSYNCHRONIZATION                                   HRESULT = 0x8004E02E
+	CO_E_ISOLEVELMISMATCH                                    HRESULT = 0x8004E02F
+	SCHED_S_TASK_READY                                       HRESULT = 0x00041300
+	SCHED_S_TASK_RUNNING                                     HRESULT = 0x00041301
+	SCHED_S_TASK_DISABLED                                    HRESULT = 0x00041302
+	SCHED_S_TASK_HAS_NOT_RUN                                 HRESULT = 0x00041303
+	SCHED_S_TASK_NO_MORE_RUNS                                HRESULT = 0x00041304
+	SCHED_S_TASK_NOT_SCHEDULED                               HRESULT = 0x00041305
+	SCHED_S_TASK_TERMINATED                                  HRESULT = 0x00041306
+	SCHED_S_TASK_NO_VALID_TRIGGERS                           HRESULT = 0x00041307
+	SCHED_S_EVENT_TRIGGER                                    HRESULT = 0x00041308
+	SCHED_E_TRIGGER_NOT_FOUND                                HRESULT = 0x80041309
+	SCHED_E_TASK_NOT_READY                                   HRESULT = 0x8004130A
+	SCHED_E_TASK_NOT_RUNNING                                 HRESULT = 0x8004130B
+	SCHED_E_SERVICE_NOT_INSTALLED                            HRESULT = 0x8004130C
+	SCHED_E_CANNOT_OPEN_TASK                                 HRESULT = 0x8004130D
+	SCHED_E_INVALID_TASK                                     HRESULT = 0x8004130E
+	SCHED_E_ACCOUNT_INFORMATION_NOT_SET                      HRESULT = 0x8004130F
+	SCHED_E_ACCOUNT_NAME_NOT_FOUND                           HRESULT = 0x80041310
+	SCHED_E_ACCOUNT_DBASE_CORRUPT                            HRESULT = 0x80041311
+	SCHED_E_NO_SECURITY_SERVICES                             HRESULT = 0x80041312
+	SCHED_E_UNKNOWN_OBJECT_VERSION                           HRESULT = 0x80041313
+	SCHED_E_UNSUPPORTED_ACCOUNT_OPTION                       HRESULT = 0x80041314
+	SCHED_E_SERVICE_NOT_RUNNING                              HRESULT = 0x80041315
+	CO_S_MACHINENAMENOTFOUND                                 HRESULT = 0x00080013
+	DISP_E_BUFFERTOOSMALL                                    HRESULT = 0x80020013
+	STG_E_DOCFILETOOLARGE                                    HRESULT = 0x80030111
+	STG_E_NOTSIMPLEFORMAT                                    HRESULT = 0x80030112
+	STG_E_STATUS_COPY_PROTECTION_FAILURE                     HRESULT = 0x80030305
+	STG_E_CSS_AUTHENTICATION_FAILURE                         HRESULT = 0x80030306
+	STG_E_CSS_KEY_NOT_PRESENT                                HRESULT = 0x80030307
+	STG_E_CSS_KEY_NOT_ESTABLISHED                            HRESULT = 0x80030308
+	STG_E_CSS_SCRAMBLED_SECTOR                               HRESULT = 0x80030309
+	STG_E_CSS_REGION_MISMATCH                                HRESULT = 0x8003030A
+	STG_E_RESETS_EXHAUSTED                                   HRESULT = 0x8003030B
+	RPC_E_FULLSIC_REQUIRED                                   HRESULT = 0x80010121
+	RPC_E_INVALID_STD_NAME                                   HRESULT = 0x80010122
+	CO_E_CANCEL_DISABLED                                     HRESULT = 0x80010140
+	ERROR_AUDITING_DISABLED                                  HRESULT = 0xC0090001
+	ERROR_ALL_SIDS_FILTERED                                  HRESULT = 0xC0090002
+	NTE_SILENT_CONTEXT                                       HRESULT = 0x80090022
+	NTE_TOKEN_KEYSET_STORAGE_FULL                            HRESULT = 0x80090023
+	NTE_TEMPORARY_PROFILE                                    HRESULT = 0x80090024
+	NTE_FIXEDPARAMETER                                       HRESULT = 0x80090025
+	NTE_INVALID_HANDLE                                       HRESULT = 0x80090026
+	NTE_INVALID_PARAMETER                                    HRESULT = 0x80090027
+	NTE_BUFFER_TOO_SMALL                                     HRESULT = 0x80090028
+	NTE_NOT_SUPPORTED                                        HRESULT = 0x80090029
+	NTE_NO_MORE_ITEMS                                        HRESULT = 0x8009002A
+	NTE_BUFFERS_OVERLAP                                      HRESULT = 0x8009002B
+	NTE_DECRYPTION_FAILURE                                   HRESULT = 0x8009002C
+	NTE_INTERNAL_ERROR                                       HRESULT = 0x8009002D
+	NTE_UI_REQUIRED                                          HRESULT = 0x8009002E
+	NTE_HMAC_NOT_SUPPORTED                                   HRESULT = 0x8009002F
+	NTE_DEVICE_NOT_READY                                     HRESULT = 0x80090030
+	NTE_AUTHENTICATION_IGNORED                               HRESULT = 0x80090031
+	NTE_VALIDATION_FAILED                                    HRESULT = 0x80090032
+	NTE_INCORRECT_PASSWORD                                   HRESULT = 0x80090033
+	NTE_ENCRYPTION_FAILURE                                   HRESULT = 0x80090034
+	NTE_DEVICE_NOT_FOUND                                     HRESULT = 0x80090035
+	NTE_USER_CANCELLED                                       HRESULT = 0x80090036
+	NTE_PASSWORD_CHANGE_REQUIRED                             HRESULT = 0x80090037
+	NTE_NOT_ACTIVE_CONSOLE                                   HRESULT = 0x80090038
+	SEC_E_INSUFFICIENT_MEMORY                                HRESULT = 0x80090300
+	SEC_E_INVALID_HANDLE                                     HRESULT = 0x80090301
+	SEC_E_UNSUPPORTED_FUNCTION                               HRESULT = 0x80090302
+	SEC_E_TARGET_UNKNOWN                                     HRESULT = 0x80090303
+	SEC_E_INTERNAL_ERROR                                     HRESULT = 0x80090304
+	SEC_E_SECPKG_NOT_FOUND                                   HRESULT = 0x80090305
+	SEC_E_NOT_OWNER                                          HRESULT = 0x80090306
+	SEC_E_CANNOT_INSTALL                                     HRESULT = 0x80090307
+	SEC_E_INVALID_TOKEN                                      HRESULT = 0x80090308
+	SEC_E_CANNOT_PACK                                        HRESULT = 0x80090309
+	SEC_E_QOP_NOT_SUPPORTED                                  HRESULT = 0x8009030A
+	SEC_E_NO_IMPERSONATION                                   HRESULT = 0x8009030B
+	SEC_E_LOGON_DENIED                                       HRESULT = 0x8009030C
+	SEC_E_UNKNOWN_CREDENTIALS                                HRESULT = 0x8009030D
+	SEC_E_NO_CREDENTIALS                                     HRESULT = 0x8009030E
+	SEC_E_MESSAGE_ALTERED                                    HRESULT = 0x8009030F
+	SEC_E_OUT_OF_SEQUENCE                                    HRESULT = 0x80090310
+	SEC_E_NO_AUTHENTICATING_AUTHORITY                        HRESULT = 0x80090311
+	SEC_I_CONTINUE_NEEDED                                    HRESULT = 0x00090312
+	SEC_I_COMPLETE_NEEDED                                    HRESULT = 0x00090313
+	SEC_I_COMPLETE_AND_CONTINUE                              HRESULT = 0x00090314
+	SEC_I_LOCAL_LOGON                                        HRESULT = 0x00090315
+	SEC_I_GENERIC_EXTENSION_RECEIVED                         HRESULT = 0x00090316
+	SEC_E_BAD_PKGID                                          HRESULT = 0x80090316
+	SEC_E_CONTEXT_EXPIRED                                    HRESULT = 0x80090317
+	SEC_I_CONTEXT_EXPIRED                                    HRESULT = 0x00090317
+	SEC_E_INCOMPLETE_MESSAGE                                 HRESULT = 0x80090318
+	SEC_E_INCOMPLETE_CREDENTIALS                             HRESULT = 0x80090320
+	SEC_E_BUFFER_TOO_SMALL                                   HRESULT = 0x80090321
+	SEC_I_INCOMPLETE_CREDENTIALS                             HRESULT = 0x00090320
+	SEC_I_RENEGOTIATE                                        HRESULT = 0x00090321
+	SEC_E_WRONG_PRINCIPAL                                    HRESULT = 0x80090322
+	SEC_I_NO_LSA_CONTEXT                                     HRESULT = 0x00090323
+	SEC_E_TIME_SKEW                                          HRESULT = 0x80090324
+	SEC_E_UNTRUSTED_ROOT                                     HRESULT = 0x80090325
+	SEC_E_ILLEGAL_MESSAGE                                    HRESULT = 0x80090326
+	SEC_E_CERT_UNKNOWN                                       HRESULT = 0x80090327
+	SEC_E_CERT_EXPIRED                                       HRESULT = 0x80090328
+	SEC_E_ENCRYPT_FAILURE                                    HRESULT = 0x80090329
+	SEC_E_DECRYPT_FAILURE                                    HRESULT = 0x80090330
+	SEC_E_ALGORITHM_MISMATCH                                 HRESULT = 0x80090331
+	SEC_E_SECURITY_QOS_FAILED                                HRESULT = 0x80090332
+	SEC_E_UNFINISHED_CONTEXT_DELETED                         HRESULT = 0x80090333
+	SEC_E_NO_TGT_REPLY                                       HRESULT = 0x80090334
+	SEC_E_NO_IP_ADDRESSES                                    HRESULT = 0x80090335
+	SEC_E_WRONG_CREDENTIAL_HANDLE                            HRESULT = 0x80090336
+	SEC_E_CRYPTO_SYSTEM_INVALID                              HRESULT = 0x80090337
+	SEC_E_MAX_REFERRALS_EXCEEDED                             HRESULT = 0x80090338
+	SEC_E_MUST_BE_KDC                                        HRESULT = 0x80090339
+	SEC_E_STRONG_CRYPTO_NOT_SUPPORTED                        HRESULT = 0x8009033A
+	SEC_E_TOO_MANY_PRINCIPALS                                HRESULT = 0x8009033B
+	SEC_E_NO_PA_DATA                                         HRESULT = 0x8009033C
+	SEC_E_PKINIT_NAME_MISMATCH                               HRESULT = 0x8009033D
+	SEC_E_SMARTCARD_LOGON_REQUIRED                           HRESULT = 0x8009033E
+	SEC_E_SHUTDOWN_IN_PROGRESS                               HRESULT = 0x8009033F
+	SEC_E_KDC_INVALID_REQUEST                                HRESULT = 0x80090340
+	SEC_E_KDC_UNABLE_TO_REFER                                HRESULT = 0x80090341
+	SEC_E_KDC_UNKNOWN_ETYPE                                  HRESULT = 0x80090342
+	SEC_E_UNSUPPORTED_PREAUTH                                HRESULT = 0x80090343
+	SEC_E_DELEGATION_REQUIRED                                HRESULT = 0x80090345
+	SEC_E_BAD_BINDINGS                                       HRESULT = 0x80090346
+	SEC_E_MULTIPLE_ACCOUNTS                                  HRESULT = 0x80090347
+	SEC_E_NO_KERB_KEY                                        HRESULT = 0x80090348
+	SEC_E_CERT_WRONG_USAGE                                   HRESULT = 0x80090349
+	SEC_E_DOWNGRADE_DETECTED                                 HRESULT = 0x80090350
+	SEC_E_SMARTCARD_CERT_REVOKED                             HRESULT = 0x80090351
+	SEC_E_ISSUING_CA_UNTRUSTED                               HRESULT = 0x80090352
+	SEC_E_REVOCATION_OFFLINE_C                               HRESULT = 0x80090353
+	SEC_E_PKINIT_CLIENT_FAILURE                              HRESULT = 0x80090354
+	SEC_E_SMARTCARD_CERT_EXPIRED                             HRESULT = 0x80090355
+	SEC_E_NO_S4U_PROT_SUPPORT                                HRESULT = 0x80090356
+	SEC_E_CROSSREALM_DELEGATION_FAILURE                      HRESULT = 0x80090357
+	SEC_E_REVOCATION_OFFLINE_KDC                             HRESULT = 0x80090358
+	SEC_E_ISSUING_CA_UNTRUSTED_KDC                           HRESULT = 0x80090359
+	SEC_E_KDC_CERT_EXPIRED                                   HRESULT = 0x8009035A
+	SEC_E_KDC_CERT_REVOKED                                   HRESULT = 0x8009035B
+	SEC_I_SIGNATURE_NEEDED                                   HRESULT = 0x0009035C
+	SEC_E_INVALID_PARAMETER                                  HRESULT = 0x8009035D
+	SEC_E_DELEGATION_POLICY                                  HRESULT = 0x8009035E
+	SEC_E_POLICY_NLTM_ONLY                                   HRESULT = 0x8009035F
+	SEC_I_NO_RENEGOTIATION                                   HRESULT = 0x00090360
+	SEC_E_NO_CONTEXT                                         HRESULT = 0x80090361
+	SEC_E_PKU2U_CERT_FAILURE                                 HRESULT = 0x80090362
+	SEC_E_MUTUAL_AUTH_FAILED                                 HRESULT = 0x80090363
+	SEC_I_MESSAGE_FRAGMENT                                   HRESULT = 0x00090364
+	SEC_E_ONLY_HTTPS_ALLOWED                                 HRESULT = 0x80090365
+	SEC_I_CONTINUE_NEEDED_MESSAGE_OK                         HRESULT = 0x00090366
+	SEC_E_APPLICATION_PROTOCOL_MISMATCH                      HRESULT = 0x80090367
+	SEC_I_ASYNC_CALL_PENDING                                 HRESULT = 0x00090368
+	SEC_E_INVALID_UPN_NAME                                   HRESULT = 0x80090369
+	SEC_E_EXT_BUFFER_TOO_SMALL                               HRESULT = 0x8009036A
+	SEC_E_INSUFFICIENT_BUFFERS                               HRESULT = 0x8009036B
+	CRYPT_I_NEW_PROTECTION_REQUIRED                          HRESULT = 0x00091012
+	CRYPT_E_MISSING_PUBKEY_PARA                              HRESULT = 0x8009202C
+	OSS_MORE_BUF                                             HRESULT = 0x80093001
+	OSS_NEGATIVE_UINTEGER                                    HRESULT = 0x80093002
+	OSS_PDU_RANGE                                            HRESULT = 0x80093003
+	OSS_MORE_INPUT                                           HRESULT = 0x80093004
+	OSS_DATA_ERROR                                           HRESULT = 0x80093005
+	OSS_BAD_ARG                                              HRESULT = 0x80093006
+	OSS_BAD_VERSION                                          HRESULT = 0x80093007
+	OSS_OUT_MEMORY                                           HRESULT = 0x80093008
+	OSS_PDU_MISMATCH                                         HRESULT = 0x80093009
+	OSS_LIMITED                                              HRESULT = 0x8009300A
+	OSS_BAD_PTR                                              HRESULT = 0x8009300B
+	OSS_BAD_TIME                                             HRESULT = 0x8009300C
+	OSS_INDEFINITE_NOT_SUPPORTED                             HRESULT = 0x8009300D
+	OSS_MEM_ERROR                                            HRESULT = 0x8009300E
+	OSS_BAD_TABLE                                            HRESULT = 0x8009300F
+	OSS_TOO_LONG                                             HRESULT = 0x80093010
+	OSS_CONSTRAINT_VIOLATED                                  HRESULT = 0x80093011
+	OSS_FATAL_ERROR                                          HRESULT = 0x80093012
+	OSS_ACCESS_SERIALIZATION_ERROR                           HRESULT = 0x80093013
+	OSS_NULL_TBL                                             HRESULT = 0x80093014
+	OSS_NULL_FCN                                             HRESULT = 0x80093015
+	OSS_BAD_ENCRULES                                         HRESULT = 0x80093016
+	OSS_UNAVAIL_ENCRULES                                     HRESULT = 0x80093017
+	OSS_CANT_OPEN_TRACE_WINDOW                               HRESULT = 0x80093018
+	OSS_UNIMPLEMENTED                                        HRESULT = 0x80093019
+	OSS_OID_DLL_NOT_LINKED                                   HRESULT = 0x8009301A
+	OSS_CANT_OPEN_TRACE_FILE                                 HRESULT = 0x8009301B
+	OSS_TRACE_FILE_ALREADY_OPEN                              HRESULT = 0x8009301C
+	OSS_TABLE_MISMATCH                                       HRESULT = 0x8009301D
+	OSS_TYPE_NOT_SUPPORTED                                   HRESULT = 0x8009301E
+	OSS_REAL_DLL_NOT_LINKED                                  HRESULT = 0x8009301F
+	OSS_REAL_CODE_NOT_LINKED                                 HRESULT = 0x80093020
+	OSS_OUT_OF_RANGE                                         HRESULT = 0x80093021
+	OSS_COPIER_DLL_NOT_LINKED                                HRESULT = 0x80093022
+	OSS_CONSTRAINT_DLL_NOT_LINKED                            HRESULT = 0x80093023
+	OSS_COMPARATOR_DLL_NOT_LINKED                            HRESULT = 0x80093024
+	OSS_COMPARATOR_CODE_NOT_LINKED                           HRESULT = 0x80093025
+	OSS_MEM_MGR_DLL_NOT_LINKED                               HRESULT = 0x80093026
+	OSS_PDV_DLL_NOT_LINKED                                   HRESULT = 0x80093027
+	OSS_PDV_CODE_NOT_LINKED                                  HRESULT = 0x80093028
+	OSS_API_DLL_NOT_LINKED                                   HRESULT = 0x80093029
+	OSS_BERDER_DLL_NOT_LINKED                                HRESULT = 0x8009302A
+	OSS_PER_DLL_NOT_LINKED                                   HRESULT = 0x8009302B
+	OSS_OPEN_TYPE_ERROR                                      HRESULT = 0x8009302C
+	OSS_MUTEX_NOT_CREATED                                    HRESULT = 0x8009302D
+	OSS_CANT_CLOSE_TRACE_FILE                                HRESULT = 0x8009302E
+	CRYPT_E_ASN1_ERROR                                       HRESULT = 0x80093100
+	CRYPT_E_ASN1_INTERNAL                                    HRESULT = 0x80093101
+	CRYPT_E_ASN1_EOD                                         HRESULT = 0x80093102
+	CRYPT_E_ASN1_CORRUPT                                     HRESULT = 0x80093103
+	CRYPT_E_ASN1_LARGE                                       HRESULT = 0x80093104
+	CRYPT_E_ASN1_CONSTRAINT                                  HRESULT = 0x80093105
+	CRYPT_E_ASN1_MEMORY                                      HRESULT = 0x80093106
+	CRYPT_E_ASN1_OVERFLOW                                    HRESULT = 0x80093107
+	CRYPT_E_ASN1_BADPDU                                      HRESULT = 0x80093108
+	CRYPT_E_ASN1_BADARGS                                     HRESULT = 0x80093109
+	CRYPT_E_ASN1_BADREAL                                     HRESULT = 0x8009310A
+	CRYPT_E_ASN1_BADTAG                                      HRESULT = 0x8009310B
+	CRYPT_E_ASN1_CHOICE                                      HRESULT = 0x8009310C
+	CRYPT_E_ASN1_RULE                                        HRESULT = 0x8009310D
+	CRYPT_E_ASN1_UTF8                                        HRESULT = 0x8009310E
+	CRYPT_E_ASN1_PDU_TYPE                                    HRESULT = 0x80093133
+	CRYPT_E_ASN1_NYI                                         HRESULT = 0x80093134
+	CRYPT_E_ASN1_EXTENDED                                    HRESULT = 0x80093201
+	CRYPT_E_ASN1_NOEOD                                       HRESULT = 0x80093202
+	CERTSRV_E_INVALID_CA_CERTIFICATE                         HRESULT = 0x80094005
+	CERTSRV_E_SERVER_SUSPENDED                               HRESULT = 0x80094006
+	CERTSRV_E_ENCODING_LENGTH                                HRESULT = 0x80094007
+	CERTSRV_E_ROLECONFLICT                                   HRESULT = 0x80094008
+	CERTSRV_E_RESTRICTEDOFFICER                              HRESULT = 0x80094009
+	CERTSRV_E_KEY_ARCHIVAL_NOT_CONFIGURED                    HRESULT = 0x8009400A
+	CERTSRV_E_NO_VALID_KRA                                   HRESULT = 0x8009400B
+	CERTSRV_E_BAD_REQUEST_KEY_ARCHIVAL                       HRESULT = 0x8009400C
+	CERTSRV_E_NO_CAADMIN_DEFINED                             HRESULT = 0x8009400D
+	CERTSRV_E_BAD_RENEWAL_CERT_ATTRIBUTE                     HRESULT = 0x8009400E
+	CERTSRV_E_NO_DB_SESSIONS                                 HRESULT = 0x8009400F
+	CERTSRV_E_ALIGNMENT_FAULT                                HRESULT = 0x80094010
+	CERTSRV_E_ENROLL_DENIED                                  HRESULT = 0x80094011
+	CERTSRV_E_TEMPLATE_DENIED                                HRESULT = 0x80094012
+	CERTSRV_E_DOWNLEVEL_DC_SSL_OR_UPGRADE                    HRESULT = 0x80094013
+	CERTSRV_E_UNSUPPORTED_CERT_TYPE                          HRESULT = 0x80094800
+	CERTSRV_E_NO_CERT_TYPE                                   HRESULT = 0x80094801
+	CERTSRV_E_TEMPLATE_CONFLICT                              HRESULT = 0x80094802
+	CERTSRV_E_SUBJECT_ALT_NAME_REQUIRED                      HRESULT = 0x80094803
+	CERTSRV_E_ARCHIVED_KEY_REQUIRED                          HRESULT = 0x80094804
+	CERTSRV_E_SMIME_REQUIRED                                 HRESULT = 0x80094805
+	CERTSRV_E_BAD_RENEWAL_SUBJECT                            HRESULT = 0x80094806
+	CERTSRV_E_BAD_TEMPLATE_VERSION                           HRESULT = 0x80094807
+	CERTSRV_E_TEMPLATE_POLICY_REQUIRED                       HRESULT = 0x80094808
+	CERTSRV_E_SIGNATURE_POLICY_REQUIRED                      HRESULT = 0x80094809
+	CERTSRV_E_SIGNATURE_COUNT                                HRESULT = 0x8009480A
+	CERTSRV_E_SIGNATURE_REJECTED                             HRESULT = 0x8009480B
+	CERTSRV_E_ISSUANCE_POLICY_REQUIRED                       HRESULT = 0x8009480C
+	CERTSRV_E_SUBJECT_UPN_REQUIRED                           HRESULT = 0x8009480D
+	CERTSRV_E_SUBJECT_DIRECTORY_GUID_REQUIRED                HRESULT = 0x8009480E
+	CERTSRV_E_SUBJECT_DNS_REQUIRED                           HRESULT = 0x8009480F
+	CERTSRV_E_ARCHIVED_KEY_UNEXPECTED                        HRESULT = 0x80094810
+	CERTSRV_E_KEY_LENGTH                                     HRESULT = 0x80094811
+	CERTSRV_E_SUBJECT_EMAIL_REQUIRED                         HRESULT = 0x80094812
+	CERTSRV_E_UNKNOWN_CERT_TYPE                              HRESULT = 0x80094813
+	CERTSRV_E_CERT_TYPE_OVERLAP                              HRESULT = 0x80094814
+	XENROLL_E_KEY_NOT_EXPORTABLE                             HRESULT = 0x80095000
+	XENROLL_E_CANNOT_ADD_ROOT_CERT                           HRESULT = 0x80095001
+	XENROLL_E_RESPONSE_KA_HASH_NOT_FOUND                     HRESULT = 0x80095002
+	XENROLL_E_RESPONSE_UNEXPECTED_KA_HASH                    HRESULT = 0x80095003
+	XENROLL_E_RESPONSE_KA_HASH_MISMATCH                      HRESULT = 0x80095004
+	XENROLL_E_KEYSPEC_SMIME_MISMATCH                         HRESULT = 0x80095005
+	MSSIPOTF_E_OUTOFMEMRANGE                                 HRESULT = 0x80097001
+	MSSIPOTF_E_CANTGETOBJECT                                 HRESULT = 0x80097002
+	MSSIPOTF_E_NOHEADTABLE                                   HRESULT = 0x80097003
+	MSSIPOTF_E_BAD_MAGICNUMBER                               HRESULT = 0x80097004
+	MSSIPOTF_E_BAD_OFFSET_TABLE                              HRESULT = 0x80097005
+	MSSIPOTF_E_TABLE_TAGORDER                                HRESULT = 0x80097006
+	MSSIPOTF_E_TABLE_LONGWORD                                HRESULT = 0x80097007
+	MSSIPOTF_E_BAD_FIRST_TABLE_PLACEMENT                     HRESULT = 0x80097008
+	MSSIPOTF_E_TABLES_OVERLAP                                HRESULT = 0x80097009
+	MSSIPOTF_E_TABLE_PADBYTES                                HRESULT = 0x8009700A
+	MSSIPOTF_E_FILETOOSMALL                                  HRESULT = 0x8009700B
+	MSSIPOTF_E_TABLE_CHECKSUM                                HRESULT = 0x8009700C
+	MSSIPOTF_E_FILE_CHECKSUM                                 HRESULT = 0x8009700D
+	MSSIPOTF_E_FAILED_POLICY                                 HRESULT = 0x80097010
+	MSSIPOTF_E_FAILED_HINTS_CHECK                            HRESULT = 0x80097011
+	MSSIPOTF_E_NOT_OPENTYPE                                  HRESULT = 0x80097012
+	MSSIPOTF_E_FILE                                          HRESULT = 0x80097013
+	MSSIPOTF_E_CRYPT                                         HRESULT = 0x80097014
+	MSSIPOTF_E_BADVERSION                                    HRESULT = 0x80097015
+	MSSIPOTF_E_DSIG_STRUCTURE                                HRESULT = 0x80097016
+	MSSIPOTF_E_PCONST_CHECK                                  HRESULT = 0x80097017
+	MSSIPOTF_E_STRUCTURE                                     HRESULT = 0x80097018
+	TRUST_E_EXPLICIT_DISTRUST                                HRESULT = 0x800B0111
+	CERT_E_UNTRUSTEDCA                                       HRESULT = 0x800B0112
+	CERT_E_INVALID_POLICY                                    HRESULT = 0x800B0113
+	CERT_E_INVALID_NAME                                      HRESULT = 0x800B0114
+	SPAPI_E_NO_BACKUP                                        HRESULT = 0x800F0103
+	SPAPI_E_NON_WINDOWS_NT_DRIVER                            HRESULT = 0x800F022D
+	SPAPI_E_NON_WINDOWS_DRIVER                               HRESULT = 0x800F022E
+	SPAPI_E_NO_CATALOG_FOR_OEM_INF                           HRESULT = 0x800F022F
+	SPAPI_E_DEVINSTALL_QUEUE_NONNATIVE                       HRESULT = 0x800F0230
+	SPAPI_E_NOT_DISABLEABLE                                  HRESULT = 0x800F0231
+	SPAPI_E_CANT_REMOVE_DEVINST                              HRESULT = 0x800F0232
+	SPAPI_E_INVALID_TARGET                                   HRESULT = 0x800F0233
+	SPAPI_E_DRIVER_NONNATIVE                                 HRESULT = 0x800F0234
+	SPAPI_E_IN_WOW64                                         HRESULT = 0x800F0235
+	SPAPI_E_SET_SYSTEM_RESTORE_POINT                         HRESULT = 0x800F0236
+	SPAPI_E_INCORRECTLY_COPIED_INF                           HRESULT = 0x800F0237
+	SPAPI_E_SCE_DISABLED                                     HRESULT = 0x800F0238
+	SPAPI_E_UNKNOWN_EXCEPTION                                HRESULT = 0x800F0239
+	SPAPI_E_PNP_REGISTRY_ERROR                               HRESULT = 0x800F023A
+	SPAPI_E_REMOTE_REQUEST_UNSUPPORTED                       HRESULT = 0x800F023B
+	SPAPI_E_NOT_AN_INSTALLED_OEM_INF                         HRESULT = 0x800F023C
+	SPAPI_E_INF_IN_USE_BY_DEVICES                            HRESULT = 0x800F023D
+	SPAPI_E_DI_FUNCTION_OBSOLETE                             HRESULT = 0x800F023E
+	SPAPI_E_NO_AUTHENTICODE_CATALOG                          HRESULT = 0x800F023F
+	SPAPI_E_AUTHENTICODE_DISALLOWED                          HRESULT = 0x800F0240
+	SPAPI_E_AUTHENTICODE_TRUSTED_PUBLISHER                   HRESULT = 0x800F0241
+	SPAPI_E_AUTHENTICODE_TRUST_NOT_ESTABLISHED               HRESULT = 0x800F0242
+	SPAPI_E_AUTHENTICODE_PUBLISHER_NOT_TRUSTED               HRESULT = 0x800F0243
+	SPAPI_E_SIGNATURE_OSATTRIBUTE_MISMATCH                   HRESULT = 0x800F0244
+	SPAPI_E_ONLY_VALIDATE_VIA_AUTHENTICODE                   HRESULT = 0x800F0245
+	SPAPI_E_UNRECOVERABLE_STACK_OVERFLOW                     HRESULT = 0x800F0300
+	SCARD_F_INTERNAL_ERROR                                   HRESULT = 0x80100001
+	SCARD_E_CANCELLED                                        HRESULT = 0x80100002
+	SCARD_E_INVALID_HANDLE                                   HRESULT = 0x80100003
+	SCARD_E_INVALID_PARAMETER                                HRESULT = 0x80100004
+	SCARD_E_INVALID_TARGET                                   HRESULT = 0x80100005
+	SCARD_E_NO_MEMORY                                        HRESULT = 0x80100006
+	SCARD_F_WAITED_TOO_LONG                                  HRESULT = 0x80100007
+	SCARD_E_INSUFFICIENT_BUFFER                              HRESULT = 0x80100008
+	SCARD_E_UNKNOWN_READER                                   HRESULT = 0x80100009
+	SCARD_E_TIMEOUT                                          HRESULT = 0x8010000A
+	SCARD_E_SHARING_VIOLATION                                HRESULT = 0x8010000B
+	SCARD_E_NO_SMARTCARD                                     HRESULT = 0x8010000C
+	SCARD_E_UNKNOWN_CARD                                     HRESULT = 0x8010000D
+	SCARD_E_CANT_DISPOSE                                     HRESULT = 0x8010000E
+	SCARD_E_PROTO_MISMATCH                                   HRESULT = 0x8010000F
+	SCARD_E_NOT_READY                                        HRESULT = 0x80100010
+	SCARD_E_INVALID_VALUE                                    HRESULT = 0x80100011
+	SCARD_E_SYSTEM_CANCELLED                                 HRESULT = 0x80100012
+	SCARD_F_COMM_ERROR                                       HRESULT = 0x80100013
+	SCARD_F_UNKNOWN_ERROR                                    HRESULT = 0x80100014
+	SCARD_E_INVALID_ATR                                      HRESULT = 0x80100015
+	SCARD_E_NOT_TRANSACTED                                   HRESULT = 0x80100016
+	SCARD_E_READER_UNAVAILABLE                               HRESULT = 0x80100017
+	SCARD_P_SHUTDOWN                                         HRESULT = 0x80100018
+	SCARD_E_PCI_TOO_SMALL                                    HRESULT = 0x80100019
+	SCARD_E_READER_UNSUPPORTED                               HRESULT = 0x8010001A
+	SCARD_E_DUPLICATE_READER                                 HRESULT = 0x8010001B
+	SCARD_E_CARD_UNSUPPORTED                                 HRESULT = 0x8010001C
+	SCARD_E_NO_SERVICE                                       HRESULT = 0x8010001D
+	SCARD_E_SERVICE_STOPPED                                  HRESULT = 0x8010001E
+	SCARD_E_UNEXPECTED                                       HRESULT = 0x8010001F
+	SCARD_E_ICC_INSTALLATION                                 HRESULT = 0x80100020
+	SCARD_E_ICC_CREATEORDER                                  HRESULT = 0x80100021
+	SCARD_E_UNSUPPORTED_FEATURE                              HRESULT = 0x80100022
+	SCARD_E_DIR_NOT_FOUND                                    HRESULT = 0x80100023
+	SCARD_E_FILE_NOT_FOUND                                   HRESULT = 0x80100024
+	SCARD_E_NO_DIR                                           HRESULT = 0x80100025
+	SCARD_E_NO_FILE                                          HRESULT = 0x80100026
+	SCARD_E_NO_ACCESS                                        HRESULT = 0x80100027
+	SCARD_E_WRITE_TOO_MANY                                   HRESULT = 0x80100028
+	SCARD_E_BAD_SEEK                                         HRESULT = 0x80100029
+	SCARD_E_INVALID_CHV                                      HRESULT = 0x8010002A
+	SCARD_E_UNKNOWN_RES_MNG                                  HRESULT = 0x8010002B
+	SCARD_E_NO_SUCH_CERTIFICATE                              HRESULT = 0x8010002C
+	SCARD_E_CERTIFICATE_UNAVAILABLE                          HRESULT = 0x8010002D
+	SCARD_E_NO_READERS_AVAILABLE                             HRESULT = 0x8010002E
+	SCARD_E_COMM_DATA_LOST                                   HRESULT = 0x8010002F
+	SCARD_E_NO_KEY_CONTAINER                                 HRESULT = 0x80100030
+	SCARD_E_SERVER_TOO_BUSY                                  HRESULT = 0x80100031
+	SCARD_E_PIN_CACHE_EXPIRED                                HRESULT = 0x80100032
+	SCARD_E_NO_PIN_CACHE                                     HRESULT = 0x80100033
+	SCARD_E_READ_ONLY_CARD                                   HRESULT = 0x80100034
+	SCARD_W_UNSUPPORTED_CARD                                 HRESULT = 0x80100065
+	SCARD_W_UNRESPONSIVE_CARD                                HRESULT = 0x80100066
+	SCARD_W_UNPOWERED_CARD                                   HRESULT = 0x80100067
+	SCARD_W_RESET_CARD                                       HRESULT = 0x80100068
+	SCARD_W_REMOVED_CARD                                     HRESULT = 0x80100069
+	SCARD_W_SECURITY_VIOLATION                               HRESULT = 0x8010006A
+	SCARD_W_WRONG_CHV                                        HRESULT = 0x8010006B
+	SCARD_W_CHV_BLOCKED                                      HRESULT = 0x8010006C
+	SCARD_W_EOF                                              HRESULT = 0x8010006D
+	SCARD_W_CANCELLED_BY_USER                                HRESULT = 0x8010006E
+	SCARD_W_CARD_NOT_AUTHENTICATED                           HRESULT = 0x8010006F
+	SCARD_W_CACHE_ITEM_NOT_FOUND                             HRESULT = 0x80100070
+	SCARD_W_CACHE_ITEM_STALE                                 HRESULT = 0x80100071
+	SCARD_W_CACHE_ITEM_TOO_BIG                               HRESULT = 0x80100072
+	COMADMIN_E_OBJECTERRORS                                  HRESULT = 0x80110401
+	COMADMIN_E_OBJECTINVALID                                 HRESULT = 0x80110402
+	COMADMIN_E_KEYMISSING                                    HRESULT = 0x80110403
+	COMADMIN_E_ALREADYINSTALLED                              HRESULT = 0x80110404
+	COMADMIN_E_APP_FILE_WRITEFAIL                            HRESULT = 0x80110407
+	COMADMIN_E_APP_FILE_READFAIL                             HRESULT = 0x80110408
+	COMADMIN_E_APP_FILE_VERSION                              HRESULT = 0x80110409
+	COMADMIN_E_BADPATH                                       HRESULT = 0x8011040A
+	COMADMIN_E_APPLICATIONEXISTS                             HRESULT = 0x8011040B
+	COMADMIN_E_ROLEEXISTS                                    HRESULT = 0x8011040C
+	COMADMIN_E_CANTCOPYFILE                                  HRESULT = 0x8011040D
+	COMADMIN_E_NOUSER                                        HRESULT = 0x8011040F
+	COMADMIN_E_INVALIDUSERIDS                                HRESULT = 0x80110410
+	COMADMIN_E_NOREGISTRYCLSID                               HRESULT = 0x80110411
+	COMADMIN_E_BADREGISTRYPROGID                             HRESULT = 0x80110412
+	COMADMIN_E_AUTHENTICATIONLEVEL                           HRESULT = 0x80110413
+	COMADMIN_E_USERPASSWDNOTVALID                            HRESULT = 0x80110414
+	COMADMIN_E_CLSIDORIIDMISMATCH                            HRESULT = 0x80110418
+	COMADMIN_E_REMOTEINTERFACE                               HRESULT = 0x80110419
+	COMADMIN_E_DLLREGISTERSERVER                             HRESULT = 0x8011041A
+	COMADMIN_E_NOSERVERSHARE                                 HRESULT = 0x8011041B
+	COMADMIN_E_DLLLOADFAILED                                 HRESULT = 0x8011041D
+	COMADMIN_E_BADREGISTRYLIBID                              HRESULT = 0x8011041E
+	COMADMIN_E_APPDIRNOTFOUND                                HRESULT = 0x8011041F
+	COMADMIN_E_REGISTRARFAILED                               HRESULT = 0x80110423
+	COMADMIN_E_COMPFILE_DOESNOTEXIST                         HRESULT = 0x80110424
+	COMADMIN_E_COMPFILE_LOADDLLFAIL                          HRESULT = 0x80110425
+	COMADMIN_E_COMPFILE_GETCLASSOBJ                          HRESULT = 0x80110426
+	COMADMIN_E_COMPFILE_CLASSNOTAVAIL                        HRESULT = 0x80110427
+	COMADMIN_E_COMPFILE_BADTLB                               HRESULT = 0x80110428
+	COMADMIN_E_COMPFILE_NOTINSTALLABLE                       HRESULT = 0x80110429
+	COMADMIN_E_NOTCHANGEABLE                                 HRESULT = 0x8011042A
+	COMADMIN_E_NOTDELETEABLE                                 HRESULT = 0x8011042B
+	COMADMIN_E_SESSION                                       HRESULT = 0x8011042C
+	COMADMIN_E_COMP_MOVE_LOCKED                              HRESULT = 0x8011042D
+	COMADMIN_E_COMP_MOVE_BAD_DEST                            HRESULT = 0x8011042E
+	COMADMIN_E_REGISTERTLB                                   HRESULT = 0x80110430
+	COMADMIN_E_SYSTEMAPP                                     HRESULT = 0x80110433
+	COMADMIN_E_COMPFILE_NOREGISTRAR                          HRESULT = 0x80110434
+	COMADMIN_E_COREQCOMPINSTALLED                            HRESULT = 0x80110435
+	COMADMIN_E_SERVICENOTINSTALLED                           HRESULT = 0x80110436
+	COMADMIN_E_PROPERTYSAVEFAILED                            HRESULT = 0x80110437
+	COMADMIN_E_OBJECTEXISTS                                  HRESULT = 0x80110438
+	COMADMIN_E_COMPONENTEXISTS                               HRESULT = 0x80110439
+	COMADMIN_E_REGFILE_CORRUPT                               HRESULT = 0x8011043B
+	COMADMIN_E_PROPERTY_OVERFLOW                             HRESULT = 0x8011043C
+	COMADMIN_E_NOTINREGISTRY                                 HRESULT = 0x8011043E
+	COMADMIN_E_OBJECTNOTPOOLABLE                             HRESULT = 0x8011043F
+	COMADMIN_E_APPLID_MATCHES_CLSID                          HRESULT = 0x80110446
+	COMADMIN_E_ROLE_DOES_NOT_EXIST                           HRESULT = 0x80110447
+	COMADMIN_E_START_APP_NEEDS_COMPONENTS                    HRESULT = 0x80110448
+	COMADMIN_E_REQUIRES_DIFFERENT_PLATFORM                   HRESULT = 0x80110449
+	COMADMIN_E_CAN_NOT_EXPORT_APP_PROXY                      HRESULT = 0x8011044A
+	COMADMIN_E_CAN_NOT_START_APP                             HRESULT = 0x8011044B
+	COMADMIN_E_CAN_NOT_EXPORT_SYS_APP                        HRESULT = 0x8011044C
+	COMADMIN_E_CANT_SUBSCRIBE_TO_COMPONENT                   HRESULT = 0x8011044D
+	COMADMIN_E_EVENTCLASS_CANT_BE_SUBSCRIBER                 HRESULT = 0x8011044E
+	COMADMIN_E_LIB_APP_PROXY_INCOMPATIBLE                    HRESULT = 0x8011044F
+	COMADMIN_E_BASE_PARTITION_ONLY                           HRESULT = 0x80110450
+	COMADMIN_E_START_APP_DISABLED                            HRESULT = 0x80110451
+	COMADMIN_E_CAT_DUPLICATE_PARTITION_NAME                  HRESULT = 0x80110457
+	COMADMIN_E_CAT_INVALID_PARTITION_NAME                    HRESULT = 0x80110458
+	COMADMIN_E_CAT_PARTITION_IN_USE                          HRESULT = 0x80110459
+	COMADMIN_E_FILE_PARTITION_DUPLICATE_FILES                HRESULT = 0x8011045A
+	COMADMIN_E_CAT_IMPORTED_COMPONENTS_NOT_ALLOWED           HRESULT = 0x8011045B
+	COMADMIN_E_AMBIGUOUS_APPLICATION_NAME                    HRESULT = 0x8011045C
+	COMADMIN_E_AMBIGUOUS_PARTITION_NAME                      HRESULT = 0x8011045D
+	COMADMIN_E_REGDB_NOTINITIALIZED                          HRESULT = 0x80110472
+	COMADMIN_E_REGDB_NOTOPEN                                 HRESULT = 0x80110473
+	COMADMIN_E_REGDB_SYSTEMERR                               HRESULT = 0x80110474
+	COMADMIN_E_REGDB_ALREADYRUNNING                          HRESULT = 0x80110475
+	COMADMIN_E_MIG_VERSIONNOTSUPPORTED                       HRESULT = 0x80110480
+	COMADMIN_E_MIG_SCHEMANOTFOUND                            HRESULT = 0x80110481
+	COMADMIN_E_CAT_BITNESSMISMATCH                           HRESULT = 0x80110482
+	COMADMIN_E_CAT_UNACCEPTABLEBITNESS                       HRESULT = 0x80110483
+	COMADMIN_E_CAT_WRONGAPPBITNESS                           HRESULT = 0x80110484
+	COMADMIN_E_CAT_PAUSE_RESUME_NOT_SUPPORTED                HRESULT = 0x80110485
+	COMADMIN_E_CAT_SERVERFAULT                               HRESULT = 0x80110486
+	COMQC_E_APPLICATION_NOT_QUEUED                           HRESULT = 0x80110600
+	COMQC_E_NO_QUEUEABLE_INTERFACES                          HRESULT = 0x80110601
+	COMQC_E_QUEUING_SERVICE_NOT_AVAILABLE                    HRESULT = 0x80110602
+	COMQC_E_NO_IPERSISTSTREAM                                HRESULT = 0x80110603
+	COMQC_E_BAD_MESSAGE                                      HRESULT = 0x80110604
+	COMQC_E_UNAUTHENTICATED                                  HRESULT = 0x80110605
+	COMQC_E_UNTRUSTED_ENQUEUER                               HRESULT = 0x80110606
+	MSDTC_E_DUPLICATE_RESOURCE                               HRESULT = 0x80110701
+	COMADMIN_E_OBJECT_PARENT_MISSING                         HRESULT = 0x80110808
+	COMADMIN_E_OBJECT_DOES_NOT_EXIST                         HRESULT = 0x80110809
+	COMADMIN_E_APP_NOT_RUNNING                               HRESULT = 0x8011080A
+	COMADMIN_E_INVALID_PARTITION                             HRESULT = 0x8011080B
+	COMADMIN_E_SVCAPP_NOT_POOLABLE_OR_RECYCLABLE             HRESULT = 0x8011080D
+	COMADMIN_E_USER_IN_SET                                   HRESULT = 0x8011080E
+	COMADMIN_E_CANTRECYCLELIBRARYAPPS                        HRESULT = 0x8011080F
+	COMADMIN_E_CANTRECYCLESERVICEAPPS                        HRESULT = 0x80110811
+	COMADMIN_E_PROCESSALREADYRECYCLED                        HRESULT = 0x80110812
+	COMADMIN_E_PAUSEDPROCESSMAYNOTBERECYCLED                 HRESULT = 0x80110813
+	COMADMIN_E_CANTMAKEINPROCSERVICE                         HRESULT = 0x80110814
+	COMADMIN_E_PROGIDINUSEBYCLSID                            HRESULT = 0x80110815
+	COMADMIN_E_DEFAULT_PARTITION_NOT_IN_SET                  HRESULT = 0x80110816
+	COMADMIN_E_RECYCLEDPROCESSMAYNOTBEPAUSED                 HRESULT = 0x80110817
+	COMADMIN_E_PARTITION_ACCESSDENIED                        HRESULT = 0x80110818
+	COMADMIN_E_PARTITION_MSI_ONLY                            HRESULT = 0x80110819
+	COMADMIN_E_LEGACYCOMPS_NOT_ALLOWED_IN_1_0_FORMAT         HRESULT = 0x8011081A
+	COMADMIN_E_LEGACYCOMPS_NOT_ALLOWED_IN_NONBASE_PARTITIONS HRESULT = 0x8011081B
+	COMADMIN_E_COMP_MOVE_SOURCE                              HRESULT = 0x8011081C
+	COMADMIN_E_COMP_MOVE_DEST                                HRESULT = 0x8011081D
+	COMADMIN_E_COMP_MOVE_PRIVATE                             HRESULT = 0x8011081E
+	COMADMIN_E_BASEPARTITION_REQUIRED_IN_SET                 HRESULT = 0x8011081F
+	COMADMIN_E_CANNOT_ALIAS_EVENTCLASS                       HRESULT = 0x80110820
+	COMADMIN_E_PRIVATE_ACCESSDENIED                          HRESULT = 0x80110821
+	COMADMIN_E_SAFERINVALID                                  HRESULT = 0x80110822
+	COMADMIN_E_REGISTRY_ACCESSDENIED                         HRESULT = 0x80110823
+	COMADMIN_E_PARTITIONS_DISABLED                           HRESULT = 0x80110824
+	DXGI_STATUS_OCCLUDED                                     HRESULT = 0x087A0001
+	DXGI_STATUS_CLIPPED                                      HRESULT = 0x087A0002
+	DXGI_STATUS_NO_REDIRECTION                               HRESULT = 0x087A0004
+	DXGI_STATUS_NO_DESKTOP_ACCESS                            HRESULT = 0x087A0005
+	DXGI_STATUS_GRAPHICS_VIDPN_SOURCE_IN_USE                 HRESULT = 0x087A0006
+	DXGI_STATUS_MODE_CHANGED                                 HRESULT = 0x087A0007
+	DXGI_STATUS_MODE_CHANGE_IN_PROGRESS                      HRESULT = 0x087A0008
+	DXGI_STATUS_UNOCCLUDED                                   HRESULT = 0x087A0009
+	DXGI_STATUS_DDA_WAS_STILL_DRAWING                        HRESULT = 0x087A000A
+	DXGI_STATUS_PRESENT_REQUIRED                             HRESULT = 0x087A002F
+	DXGI_ERROR_INVALID_CALL                                  HRESULT = 0x887A0001
+	DXGI_ERROR_NOT_FOUND                                     HRESULT = 0x887A0002
+	DXGI_ERROR_MORE_DATA                                     HRESULT = 0x887A0003
+	DXGI_ERROR_UNSUPPORTED                                   HRESULT = 0x887A0004
+	DXGI_ERROR_DEVICE_REMOVED                                HRESULT = 0x887A0005
+	DXGI_ERROR_DEVICE_HUNG                                   HRESULT = 0x887A0006
+	DXGI_ERROR_DEVICE_RESET                                  HRESULT = 0x887A0007
+	DXGI_ERROR_WAS_STILL_DRAWING                             HRESULT = 0x887A000A
+	DXGI_ERROR_FRAME_STATISTICS_DISJOINT                     HRESULT = 0x887A000B
+	DXGI_ERROR_GRAPHICS_VIDPN_SOURCE_IN_USE                  HRESULT = 0x887A000C
+	DXGI_ERROR_DRIVER_INTERNAL_ERROR                         HRESULT = 0x887A0020
+	DXGI_ERROR_NONEXCLUSIVE                                  HRESULT = 0x887A0021
+	DXGI_ERROR_NOT_CURRENTLY_AVAILABLE                       HRESULT = 0x887A0022
+	DXGI_ERROR_REMOTE_CLIENT_DISCONNECTED                    HRESULT = 0x887A0023
+	DXGI_ERROR_REMOTE_OUTOFMEMORY                            HRESULT = 0x887A0024
+	DXGI_ERROR_ACCESS_LOST                                   HRESULT = 0x887A0026
+	DXGI_ERROR_WAIT_TIMEOUT                                  HRESULT = 0x887A0027
+	DXGI_ERROR_SESSION_DISCONNECTED                          HRESULT = 0x887A0028
+	DXGI_ERROR_RESTRICT_TO_OUTPUT_STALE                      HRESULT = 0x887A0029
+	DXGI_ERROR_CANNOT_PROTECT_CONTENT                        HRESULT = 0x887A002A
+	DXGI_ERROR_ACCESS_DENIED                                 HRESULT = 0x887A002B
+	DXGI_ERROR_NAME_ALREADY_EXISTS                           HRESULT = 0x887A002C
+	DXGI_ERROR_SDK_COMPONENT_MISSING                         HRESULT = 0x887A002D
+	DXGI_ERROR_NOT_CURRENT                                   HRESULT = 0x887A002E
+	DXGI_ERROR_HW_PROTECTION_OUTOFMEMORY                     HRESULT = 0x887A0030
+	DXGI_ERROR_DYNAMIC_CODE_POLICY_VIOLATION                 HRESULT = 0x887A0031
+	DXGI_ERROR_NON_COMPOSITED_UI                             HRESULT = 0x887A0032
+	DXGI_ERROR_MODE_CHANGE_IN_PROGRESS                       HRESULT = 0x887A0025
+	DXGI_ERROR_CACHE_CORRUPT                                 HRESULT = 0x887A0033
+	DXGI_ERROR_CACHE_FULL                                    HRESULT = 0x887A0034
+	DXGI_ERROR_CACHE_HASH_COLLISION                          HRESULT = 0x887A0035
+	DXGI_ERROR_ALREADY_EXISTS                                HRESULT = 0x887A0036
+	DXGI_DDI_ERR_WASSTILLDRAWING                             HRESULT = 0x887B0001
+	DXGI_DDI_ERR_UNSUPPORTED                                 HRESULT = 0x887B0002
+	DXGI_DDI_ERR_NONEXCLUSIVE                                HRESULT = 0x887B0003
+	D3D10_ERROR_TOO_MANY_UNIQUE_STATE_OBJECTS                HRESULT = 0x88790001
+	D3D10_ERROR_FILE_NOT_FOUND                               HRESULT = 0x88790002
+	D3D11_ERROR_TOO_MANY_UNIQUE_STATE_OBJECTS                HRESULT = 0x887C0001
+	D3D11_ERROR_FILE_NOT_FOUND                               HRESULT = 0x887C0002
+	D3D11_ERROR_TOO_MANY_UNIQUE_VIEW_OBJECTS                 HRESULT = 0x887C0003
+	D3D11_ERROR_DEFERRED_CONTEXT_MAP_WITHOUT_INITIAL_DISCARD HRESULT = 0x887C0004
+	D3D12_ERROR_ADAPTER_NOT_FOUND                            HRESULT = 0x887E0001
+	D3D12_ERROR_DRIVER_VERSION_MISMATCH                      HRESULT = 0x887E0002
+	D2DERR_WRONG_STATE                                       HRESULT = 0x88990001
+	D2DERR_NOT_INITIALIZED                                   HRESULT = 0x88990002
+	D2DERR_UNSUPPORTED_OPERATION                             HRESULT = 0x88990003
+	D2DERR_SCANNER_FAILED                                    HRESULT = 0x88990004
+	D2DERR_SCREEN_ACCESS_DENIED                              HRESULT = 0x88990005
+	D2DERR_DISPLAY_STATE_INVALID                             HRESULT = 0x88990006
+	D2DERR_ZERO_VECTOR                                       HRESULT = 0x88990007
+	D2DERR_INTERNAL_ERROR                                    HRESULT = 0x88990008
+	D2DERR_DISPLAY_FORMAT_NOT_SUPPORTED                      HRESULT = 0x88990009
+	D2DERR_INVALID_CALL                                      HRESULT = 0x8899000A
+	D2DERR_NO_HARDWARE_DEVICE                                HRESULT = 0x8899000B
+	D2DERR_RECREATE_TARGET                                   HRESULT = 0x8899000C
+	D2DERR_TOO_MANY_SHADER_ELEMENTS                          HRESULT = 0x8899000D
+	D2DERR_SHADER_COMPILE_FAILED                             HRESULT = 0x8899000E
+	D2DERR_MAX_TEXTURE_SIZE_EXCEEDED                         HRESULT = 0x8899000F
+	D2DERR_UNSUPPORTED_VERSION                               HRESULT = 0x88990010
+	D2DERR_BAD_NUMBER                                        HRESULT = 0x88990011
+	D2DERR_WRONG_FACTORY                                     HRESULT = 0x88990012
+	D2DERR_LAYER_ALREADY_IN_USE                              HRESULT = 0x88990013
+	D2DERR_POP_CALL_DID_NOT_MATCH_PUSH                       HRESULT = 0x88990014
+	D2DERR_WRONG_RESOURCE_DOMAIN                             HRESULT = 0x88990015
+	D2DERR_PUSH_POP_UNBALANCED                               HRESULT = 0x88990016
+	D2DERR_RENDER_TARGET_HAS_LAYER_OR_CLIPRECT               HRESULT = 0x88990017
+	D2DERR_INCOMPATIBLE_BRUSH_TYPES                          HRESULT = 0x88990018
+	D2DERR_WIN32_ERROR                                       HRESULT = 0x88990019
+	D2DERR_TARGET_NOT_GDI_COMPATIBLE                         HRESULT = 0x8899001A
+	D2DERR_TEXT_EFFECT_IS_WRONG_TYPE                         HRESULT = 0x8899001B
+	D2DERR_TEXT_RENDERER_NOT_RELEASED                        HRESULT = 0x8899001C
+	D2DERR_EXCEEDS_MAX_BITMAP_SIZE                           HRESULT = 0x8899001D
+	D2DERR_INVALID_GRAPH_CONFIGURATION                       HRESULT = 0x8899001E
+	D2DERR_INVALID_INTERNAL_GRAPH_CONFIGURATION              HRESULT = 0x8899001F
+	D2DERR_CYCLIC_GRAPH                                      HRESULT = 0x88990020
+	D2DERR_BITMAP_CANNOT_DRAW                                HRESULT = 0x88990021
+	D2DERR_OUTSTANDING_BITMAP_REFERENCES                     HRESULT = 0x88990022
+	D2DERR_ORIGINAL_TARGET_NOT_BOUND                         HRESULT = 0x88990023
+	D2DERR_INVALID_TARGET                                    HRESULT = 0x88990024
+	D2DERR_BITMAP_BOUND_AS_TARGET                            HRESULT = 0x88990025
+	D2DERR_INSUFFICIENT_DEVICE_CAPABILITIES                  HRESULT = 0x88990026
+	D2DERR_INTERMEDIATE_TOO_LARGE                            HRESULT = 0x88990027
+	D2DERR_EFFECT_IS_NOT_REGISTERED                          HRESULT = 0x88990028
+	D2DERR_INVALID_PROPERTY                                  HRESULT = 0x88990029
+	D2DERR_NO_SUBPROPERTIES                                  HRESULT = 0x8899002A
+	D2DERR_PRINT_JOB_CLOSED                                  HRESULT = 0x8899002B
+	D2DERR_PRINT_FORMAT_NOT_SUPPORTED                        HRESULT = 0x8899002C
+	D2DERR_TOO_MANY_TRANSFORM_INPUTS                         HRESULT = 0x8899002D
+	D2DERR_INVALID_GLYPH_IMAGE                               HRESULT = 0x8899002E
+	TBS_E_INTERNAL_ERROR                                     HRESULT = 0x80284001
+	TBS_E_BAD_PARAMETER                                      HRESULT = 0x80284002
+	TBS_E_INVALID_OUTPUT_POINTER                             HRESULT = 0x80284003
+	TBS_E_INSUFFICIENT_BUFFER                                HRESULT = 0x80284005
+	TBS_E_IOERROR                                            HRESULT = 0x80284006
+	TBS_E_INVALID_CONTEXT_PARAM                              HRESULT = 0x80284007
+	TBS_E_SERVICE_NOT_RUNNING                                HRESULT = 0x80284008
+	TBS_E_TOO_MANY_TBS_CONTEXTS                              HRESULT = 0x80284009
+	TBS_E_SERVICE_START_PENDING                              HRESULT = 0x8028400B
+	TBS_E_BUFFER_TOO_LARGE                                   HRESULT = 0x8028400E
+	TBS_E_TPM_NOT_FOUND                                      HRESULT = 0x8028400F
+	TBS_E_SERVICE_DISABLED                                   HRESULT = 0x80284010
+	TBS_E_DEACTIVATED                                        HRESULT = 0x80284016
+	FWP_E_CALLOUT_NOT_FOUND                                  HRESULT = 0x80320001
+	FWP_E_CONDITION_NOT_FOUND                                HRESULT = 0x80320002
+	FWP_E_FILTER_NOT_FOUND                                   HRESULT = 0x80320003
+	FWP_E_LAYER_NOT_FOUND                                    HRESULT = 0x80320004
+	FWP_E_PROVIDER_NOT_FOUND                                 HRESULT = 0x80320005
+	FWP_E_PROVIDER_CONTEXT_NOT_FOUND                         HRESULT = 0x80320006
+	FWP_E_SUBLAYER_NOT_FOUND                                 HRESULT = 0x80320007
+	FWP_E_NOT_FOUND                                          HRESULT = 0x80320008
+	FWP_E_ALREADY_EXISTS                                     HRESULT = 0x80320009
+	FWP_E_IN_USE                                             HRESULT = 0x8032000A
+	FWP_E_DYNAMIC_SESSION_IN_PROGRESS                        HRESULT = 0x8032000B
+	FWP_E_WRONG_SESSION                                      HRESULT = 0x8032000C
+	FWP_E_NO_TXN_IN_PROGRESS                                 HRESULT = 0x8032000D
+	FWP_E_TXN_IN_PROGRESS                                    HRESULT = 0x8032000E
+	FWP_E_TXN_ABORTED                                        HRESULT = 0x8032000F
+	FWP_E_SESSION_ABORTED                                    HRESULT = 0x80320010
+	FWP_E_INCOMPATIBLE_TXN                                   HRESULT = 0x80320011
+	FWP_E_TIMEOUT                                            HRESULT = 0x80320012
+	FWP_E_NET_EVENTS_DISABLED                                HRESULT = 0x80320013
+	FWP_E_INCOMPATIBLE_LAYER                                 HRESULT = 0x80320014
+	FWP_E_KM_CLIENTS_ONLY                                    HRESULT = 0x80320015
+	FWP_E_LIFETIME_MISMATCH                                  HRESULT = 0x80320016
+	FWP_E_BUILTIN_OBJECT                                     HRESULT = 0x80320017
+	FWP_E_TOO_MANY_CALLOUTS                                  HRESULT = 0x80320018
+	FWP_E_NOTIFICATION_DROPPED                               HRESULT = 0x80320019
+	FWP_E_TRAFFIC_MISMATCH                                   HRESULT = 0x8032001A
+	FWP_E_INCOMPATIBLE_SA_STATE                              HRESULT = 0x8032001B
+	FWP_E_NULL_POINTER                                       HRESULT = 0x8032001C
+	FWP_E_INVALID_ENUMERATOR                                 HRESULT = 0x8032001D
+	FWP_E_INVALID_FLAGS                                      HRESULT = 0x8032001E
+	FWP_E_INVALID_NET_MASK                                   HRESULT = 0x8032001F
+	FWP_E_INVALID_RANGE                                      HRESULT = 0x80320020
+	FWP_E_INVALID_INTERVAL                                   HRESULT = 0x80320021
+	FWP_E_ZERO_LENGTH_ARRAY                                  HRESULT = 0x80320022
+	FWP_E_NULL_DISPLAY_NAME                                  HRESULT = 0x80320023
+	FWP_E_INVALID_ACTION_TYPE                                HRESULT = 0x80320024
+	FWP_E_INVALID_WEIGHT                                     HRESULT = 0x80320025
+	FWP_E_MATCH_TYPE_MISMATCH                                HRESULT = 0x80320026
+	FWP_E_TYPE_MISMATCH                                      HRESULT = 0x80320027
+	FWP_E_OUT_OF_BOUNDS                                      HRESULT = 0x80320028
+	FWP_E_RESERVED                                           HRESULT = 0x80320029
+	FWP_E_DUPLICATE_CONDITION                                HRESULT = 0x8032002A
+	FWP_E_DUPLICATE_KEYMOD                                   HRESULT = 0x8032002B
+	FWP_E_ACTION_INCOMPATIBLE_WITH_LAYER                     HRESULT = 0x8032002C
+	FWP_E_ACTION_INCOMPATIBLE_WITH_SUBLAYER                  HRESULT = 0x8032002D
+	FWP_E_CONTEXT_INCOMPATIBLE_WITH_LAYER                    HRESULT = 0x8032002E
+	FWP_E_CONTEXT_INCOMPATIBLE_WITH_CALLOUT                  HRESULT = 0x8032002F
+	FWP_E_INCOMPATIBLE_AUTH_METHOD                           HRESULT = 0x80320030
+	FWP_E_INCOMPATIBLE_DH_GROUP                              HRESULT = 0x80320031
+	FWP_E_EM_NOT_SUPPORTED                                   HRESULT = 0x80320032
+	FWP_E_NEVER_MATCH                                        HRESULT = 0x80320033
+	FWP_E_PROVIDER_CONTEXT_MISMATCH                          HRESULT = 0x80320034
+	FWP_E_INVALID_PARAMETER                                  HRESULT = 0x80320035
+	FWP_E_TOO_MANY_SUBLAYERS                                 HRESULT = 0x80320036
+	FWP_E_CALLOUT_NOTIFICATION_FAILED                        HRESULT = 0x80320037
+	FWP_E_INVALID_AUTH_TRANSFORM                             HRESULT = 0x80320038
+	FWP_E_INVALID_CIPHER_TRANSFORM                           HRESULT = 0x80320039
+	WINCODEC_ERR_WRONGSTATE                                  HRESULT = 0x88982F04
+	WINCODEC_ERR_VALUEOUTOFRANGE                             HRESULT = 0x88982F05
+	WINCODEC_ERR_UNKNOWNIMAGEFORMAT                          HRESULT = 0x88982F07
+	WINCODEC_ERR_UNSUPPORTEDVERSION                          HRESULT = 0x88982F0B
+	WINCODEC_ERR_NOTINITIALIZED                              HRESULT = 0x88982F0C
+	WINCODEC_ERR_ALREADYLOCKED                               HRESULT = 0x88982F0D
+	WINCODEC_ERR_PROPERTYNOTFOUND                            HRESULT = 0x88982F40
+	WINCODEC_ERR_PROPERTYNOTSUPPORTED                        HRESULT = 0x88982F41
+	WINCODEC_ERR_PROPERTYSIZE                                HRESULT = 0x88982F42
+	WINCODEC_ERR_CODECPRESENT                                HRESULT = 0x88982F43
+	WINCODEC_ERR_CODECNOTHUMBNAIL                            HRESULT = 0x88982F44
+	WINCODEC_ERR_PALETTEUNAVAILABLE                          HRESULT = 0x88982F45
+	WINCODEC_ERR_CODECTOOMANYSCANLINES                       HRESULT = 0x88982F46
+	WINCODEC_ERR_INTERNALERROR                               HRESULT = 0x88982F48
+	WINCODEC_ERR_SOURCERECTDOESNOTMATCHDIMENSIONS            HRESULT = 0x88982F49
+	WINCODEC_ERR_COMPONENTNOTFOUND                           HRESULT = 0x88982F50
+	WINCODEC_ERR_IMAGESIZEOUTOFRANGE                         HRESULT = 0x88982F51
+	WINCODEC_ERR_TOOMUCHMETADATA                             HRESULT = 0x88982F52
+	WINCODEC_ERR_BADIMAGE                                    HRESULT = 0x88982F60
+	WINCODEC_ERR_BADHEADER                                   HRESULT = 0x88982F61
+	WINCODEC_ERR_FRAMEMISSING                                HRESULT = 0x88982F62
+	WINCODEC_ERR_BADMETADATAHEADER                           HRESULT = 0x88982F63
+	WINCODEC_ERR_BADSTREAMDATA                               HRESULT = 0x88982F70
+	WINCODEC_ERR_STREAMWRITE                                 HRESULT = 0x88982F71
+	WINCODEC_ERR_STREAMREAD                                  HRESULT = 0x88982F72
+	WINCODEC_ERR_STREAMNOTAVAILABLE                          HRESULT = 0x88982F73
+	WINCODEC_ERR_UNSUPPORTEDPIXELFORMAT                      HRESULT = 0x88982F80
+	WINCODEC_ERR_UNSUPPORTEDOPERATION                        HRESULT = 0x88982F81
+	WINCODEC_ERR_INVALIDREGISTRATION                         HRESULT = 0x88982F8A
+	WINCODEC_ERR_COMPONENTINITIALIZEFAILURE                  HRESULT = 0x88982F8B
+	WINCODEC_ERR_INSUFFICIENTBUFFER                          HRESULT = 0x88982F8C
+	WINCODEC_ERR_DUPLICATEMETADATAPRESENT                    HRESULT = 0x88982F8D
+	WINCODEC_ERR_PROPERTYUNEXPECTEDTYPE                      HRESULT = 0x88982F8E
+	WINCODEC_ERR_UNEXPECTEDSIZE                              HRESULT = 0x88982F8F
+	WINCODEC_ERR_INVALIDQUERYREQUEST                         HRESULT = 0x88982F90
+	WINCODEC_ERR_UNEXPECTEDMETADATATYPE                      HRESULT = 0x88982F91
+	WINCODEC_ERR_REQUESTONLYVALIDATMETADATAROOT              HRESULT = 0x88982F92
+	WINCODEC_ERR_INVALIDQUERYCHARACTER                       HRESULT = 0x88982F93
+	WINCODEC_ERR_WIN32ERROR                                  HRESULT = 0x88982F94
+	WINCODEC_ERR_INVALIDPROGRESSIVELEVEL                     HRESULT = 0x88982F95
+	WINCODEC_ERR_INVALIDJPEGSCANINDEX                        HRESULT = 0x88982F96
+	NAP_E_INVALID_PACKET                                     HRESULT = 0x80270001
+	NAP_E_MISSING_SOH                                        HRESULT = 0x80270002
+	NAP_E_CONFLICTING_ID                                     HRESULT = 0x80270003
+	NAP_E_NO_CACHED_SOH                                      HRESULT = 0x80270004
+	NAP_E_STILL_BOUND                                        HRESULT = 0x80270005
+	NAP_E_NOT_REGISTERED                                     HRESULT = 0x80270006
+	NAP_E_NOT_INITIALIZED                                    HRESULT = 0x80270007
+	NAP_E_MISMATCHED_ID                                      HRESULT = 0x80270008
+	NAP_E_NOT_PENDING                                        HRESULT = 0x80270009
+	NAP_E_ID_NOT_FOUND                                       HRESULT = 0x8027000A
+	NAP_E_MAXSIZE_TOO_SMALL                                  HRESULT = 0x8027000B
+	NAP_E_SERVICE_NOT_RUNNING                                HRESULT = 0x8027000C
+	NAP_S_CERT_ALREADY_PRESENT                               HRESULT = 0x0027000D
+	NAP_E_ENTITY_DISABLED                                    HRESULT = 0x8027000E
+	NAP_E_NETSH_GROUPPOLICY_ERROR                            HRESULT = 0x8027000F
+	NAP_E_TOO_MANY_CALLS                                     HRESULT = 0x80270010
+	NAP_E_SHV_CONFIG_EXISTED                                 HRESULT = 0x80270011
+	NAP_E_SHV_CONFIG_NOT_FOUND                               HRESULT = 0x80270012
+	NAP_E_SHV_TIMEOUT                                        HRESULT = 0x80270013
+	E_BLUETOOTH_ATT_INVALID_HANDLE                           HRESULT = 0x80650001
+	E_BLUETOOTH_ATT_READ_NOT_PERMITTED                       HRESULT = 0x80650002
+	E_BLUETOOTH_ATT_WRITE_NOT_PERMITTED                      HRESULT = 0x80650003
+	E_BLUETOOTH_ATT_INVALID_PDU                              HRESULT = 0x80650004
+	E_BLUETOOTH_ATT_INSUFFICIENT_AUTHENTICATION              HRESULT = 0x80650005
+	E_BLUETOOTH_ATT_REQUEST_NOT_SUPPORTED                    HRESULT = 0x80650006
+	E_BLUETOOTH_ATT_INVALID_OFFSET                           HRESULT = 0x80650007
+	E_BLUETOOTH_ATT_INSUFFICIENT_AUTHORIZATION               HRESULT = 0x80650008
+	E_BLUETOOTH_ATT_PREPARE_QUEUE_FULL                       HRESULT = 0x80650009
+	E_BLUETOOTH_ATT_ATTRIBUTE_NOT_FOUND                      HRESULT = 0x8065000A
+	E_BLUETOOTH_ATT_ATTRIBUTE_NOT_LONG                       HRESULT = 0x8065000B
+	E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION_KEY_SIZE         HRESULT = 0x8065000C
+	E_BLUETOOTH_ATT_INVALID_ATTRIBUTE_VALUE_LENGTH           HRESULT = 0x8065000D
+	E_BLUETOOTH_ATT_UNLIKELY                                 HRESULT = 0x8065000E
+	E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION                  HRESULT = 0x8065000F
+	E_BLUETOOTH_ATT_UNSUPPORTED_GROUP_TYPE                   HRESULT = 0x80650010
+	E_BLUETOOTH_ATT_INSUFFICIENT_RESOURCES                   HRESULT = 0x80650011
+	E_BLUETOOTH_ATT_UNKNOWN_ERROR                            HRESULT = 0x80651000
+	DWRITE_E_FILEFORMAT                                      HRESULT = 0x88985000
+	DWRITE_E_UNEXPECTED                                      HRESULT = 0x88985001
+	DWRITE_E_NOFONT                                          HRESULT = 0x88985002
+	DWRITE_E_FILENOTFOUND                                    HRESULT = 0x88985003
+	DWRITE_E_FILEACCESS                                      HRESULT = 0x88985004
+	DWRITE_E_FONTCOLLECTIONOBSOLETE                          HRESULT = 0x88985005
+	DWRITE_E_ALREADYREGISTERED                               HRESULT = 0x88985006
+	DWRITE_E_CACHEFORMAT                                     HRESULT = 0x88985007
+	DWRITE_E_CACHEVERSION                                    HRESULT = 0x88985008
+	DWRITE_E_UNSUPPORTEDOPERATION                            HRESULT = 0x88985009
+	DWRITE_E_TEXTRENDERERINCOMPATIBLE                        HRESULT = 0x8898500A
+	DWRITE_E_FLOWDIRECTIONCONFLICTS                          HRESULT = 0x8898500B
+	DWRITE_E_NOCOLOR                                         HRESULT = 0x8898500C
+	DWRITE_E_REMOTEFONT                                      HRESULT = 0x8898500D
+	DWRITE_E_DOWNLOADCANCELLED                               HRESULT = 0x8898500E
+	DWRITE_E_DOWNLOADFAILED                                  HRESULT = 0x8898500F
+	DWRITE_E_TOOMANYDOWNLOADS                                HRESULT = 0x88985010
+	SEC_E_NO_SPM                                             HRESULT = 0x80090304
+	SEC_E_NOT_SUPPORTED                                      HRESULT = 0x80090302
+)
+
+// The Win32Error codes of winerror.h which aren't declared by hand.
+const (
+	NO_ERROR                                                                 Win32Error = 0x0
+	ERROR_DEVICE_SUPPORT_IN_PROGRESS                                         Win32Error = 0xab
+	ERROR_EXE_CANNOT_MODIFY_SIGNED_BINARY                                    Win32Error = 0xd9
+	ERROR_EXE_CANNOT_MODIFY_STRONG_SIGNED_BINARY                             Win32Error = 0xda
+	ERROR_FILE_CHECKED_OUT                                                   Win32Error = 0xdc
+	ERROR_CHECKOUT_REQUIRED                                                  Win32Error = 0xdd
+	ERROR_BAD_FILE_TYPE                                                      Win32Error = 0xde
+	ERROR_FILE_TOO_LARGE                                                     Win32Error = 0xdf
+	ERROR_FORMS_AUTH_REQUIRED                                                Win32Error = 0xe0
+	ERROR_VIRUS_INFECTED                                                     Win32Error = 0xe1
+	ERROR_VIRUS_DELETED                                                      Win32Error = 0xe2
+	ERROR_PIPE_LOCAL                                                         Win32Error = 0xe5
+	ERROR_NO_WORK_DONE                                                       Win32Error = 0xeb
+	WAIT_TIMEOUT                                                             Win32Error = 0x102
+	ERROR_DISK_TOO_FRAGMENTED                                                Win32Error = 0x12e
+	ERROR_DELETE_PENDING                                                     Win32Error = 0x12f
+	ERROR_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING               Win32Error = 0x130
+	ERROR_SHORT_NAMES_NOT_ENABLED_ON_VOLUME                                  Win32Error = 0x131
+	ERROR_SECURITY_STREAM_IS_INCONSISTENT                                    Win32Error = 0x132
+	ERROR_INVALID_LOCK_RANGE                                                 Win32Error = 0x133
+	ERROR_IMAGE_SUBSYSTEM_NOT_PRESENT                                        Win32Error = 0x134
+	ERROR_NOTIFICATION_GUID_ALREADY_DEFINED                                  Win32Error = 0x135
+	ERROR_INVALID_EXCEPTION_HANDLER                                          Win32Error = 0x136
+	ERROR_DUPLICATE_PRIVILEGES                                               Win32Error = 0x137
+	ERROR_NO_RANGES_PROCESSED                                                Win32Error = 0x138
+	ERROR_NOT_ALLOWED_ON_SYSTEM_FILE                                         Win32Error = 0x139
+	ERROR_DISK_RESOURCES_EXHAUSTED                                           Win32Error = 0x13a
+	ERROR_INVALID_TOKEN                                                      Win32Error = 0x13b
+	ERROR_DEVICE_FEATURE_NOT_SUPPORTED                                       Win32Error = 0x13c
+	ERROR_SCOPE_NOT_FOUND                                                    Win32Error = 0x13e
+	ERROR_UNDEFINED_SCOPE                                                    Win32Error = 0x13f
+	ERROR_INVALID_CAP                                                        Win32Error = 0x140
+	ERROR_DEVICE_UNREACHABLE                                                 Win32Error = 0x141
+	ERROR_DEVICE_NO_RESOURCES                                                Win32Error = 0x142
+	ERROR_DATA_CHECKSUM_ERROR                                                Win32Error = 0x143
+	ERROR_INTERMIXED_KERNEL_EA_OPERATION                                     Win32Error = 0x144
+	ERROR_FILE_LEVEL_TRIM_NOT_SUPPORTED                                      Win32Error = 0x146
+	ERROR_OFFSET_ALIGNMENT_VIOLATION                                         Win32Error = 0x147
+	ERROR_INVALID_FIELD_IN_PARAMETER_LIST                                    Win32Error = 0x148
+	ERROR_OPERATION_IN_PROGRESS                                              Win32Error = 0x149
+	ERROR_BAD_DEVICE_PATH                                                    Win32Error = 0x14a
+	ERROR_TOO_MANY_DESCRIPTORS                                               Win32Error = 0x14b
+	ERROR_SCRUB_DATA_DISABLED                                                Win32Error = 0x14c
+	ERROR_NOT_REDUNDANT_STORAGE                                              Win32Error = 0x14d
+	ERROR_RESIDENT_FILE_NOT_SUPPORTED                                        Win32Error = 0x14e
+	ERROR_COMPRESSED_FILE_NOT_SUPPORTED                                      Win32Error = 0x14f
+	ERROR_DIRECTORY_NOT_SUPPORTED                                            Win32Error = 0x150
+	ERROR_NOT_READ_FROM_COPY                                                 Win32Error = 0x151
+	ERROR_FT_WRITE_FAILURE                                                   Win32Error = 0x152
+	ERROR_FT_DI_SCAN_REQUIRED                                                Win32Error = 0x153
+	ERROR_INVALID_KERNEL_INFO_VERSION                                        Win32Error = 0x154
+	ERROR_INVALID_PEP_INFO_VERSION                                           Win32Error = 0x155
+	ERROR_OBJECT_NOT_EXTERNALLY_BACKED                                       Win32Error = 0x156
+	ERROR_EXTERNAL_BACKING_PROVIDER_UNKNOWN                                  Win32Error = 0x157
+	ERROR_COMPRESSION_NOT_BENEFICIAL                                         Win32Error = 0x158
+	ERROR_STORAGE_TOPOLOGY_ID_MISMATCH                                       Win32Error = 0x159
+	ERROR_BLOCKED_BY_PARENTAL_CONTROLS                                       Win32Error = 0x15a
+	ERROR_BLOCK_TOO_MANY_REFERENCES                                          Win32Error = 0x15b
+	ERROR_MARKED_TO_DISALLOW_WRITES                                          Win32Error = 0x15c
+	ERROR_ENCLAVE_FAILURE                                                    Win32Error = 0x15d
+	ERROR_FAIL_NOACTION_REBOOT                                               Win32Error = 0x15e
+	ERROR_FAIL_SHUTDOWN                                                      Win32Error = 0x15f
+	ERROR_FAIL_RESTART                                                       Win32Error = 0x160
+	ERROR_MAX_SESSIONS_REACHED                                               Win32Error = 0x161
+	ERROR_NETWORK_ACCESS_DENIED_EDP                                          Win32Error = 0x162
+	ERROR_DEVICE_HINT_NAME_BUFFER_TOO_SMALL                                  Win32Error = 0x163
+	ERROR_EDP_POLICY_DENIES_OPERATION                                        Win32Error = 0x164
+	ERROR_EDP_DPL_POLICY_CANT_BE_SATISFIED                                   Win32Error = 0x165
+	ERROR_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT                              Win32Error = 0x166
+	ERROR_DEVICE_IN_MAINTENANCE                                              Win32Error = 0x167
+	ERROR_NOT_SUPPORTED_ON_DAX                                               Win32Error = 0x168
+	ERROR_DAX_MAPPING_EXISTS                                                 Win32Error = 0x169
+	ERROR_CLOUD_FILE_PROVIDER_NOT_RUNNING                                    Win32Error = 0x16a
+	ERROR_CLOUD_FILE_METADATA_CORRUPT                                        Win32Error = 0x16b
+	ERROR_CLOUD_FILE_METADATA_TOO_LARGE                                      Win32Error = 0x16c
+	ERROR_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE                                 Win32Error = 0x16d
+	ERROR_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH                         Win32Error = 0x16e
+	ERROR_CHILD_PROCESS_BLOCKED                                              Win32Error = 0x16f
+	ERROR_STORAGE_LOST_DATA_PERSISTENCE                                      Win32Error = 0x170
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE                             Win32Error = 0x171
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT                        Win32Error = 0x172
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_BUSY                                    Win32Error = 0x173
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN                        Win32Error = 0x174
+	ERROR_GDI_HANDLE_LEAK                                                    Win32Error = 0x175
+	ERROR_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS                                 Win32Error = 0x176
+	ERROR_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED                          Win32Error = 0x177
+	ERROR_NOT_A_CLOUD_FILE                                                   Win32Error = 0x178
+	ERROR_CLOUD_FILE_NOT_IN_SYNC                                             Win32Error = 0x179
+	ERROR_CLOUD_FILE_ALREADY_CONNECTED                                       Win32Error = 0x17a
+	ERROR_CLOUD_FILE_NOT_SUPPORTED                                           Win32Error = 0x17b
+	ERROR_CLOUD_FILE_INVALID_REQUEST                                         Win32Error = 0x17c
+	ERROR_CLOUD_FILE_READ_ONLY_VOLUME                                        Win32Error = 0x17d
+	ERROR_CLOUD_FILE_CONNECTED_PROVIDER_ONLY                                 Win32Error = 0x17e
+	ERROR_CLOUD_FILE_VALIDATION_FAILED                                       Win32Error = 0x17f
+	ERROR_SMB1_NOT_AVAILABLE                                                 Win32Error = 0x180
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION                       Win32Error = 0x181
+	ERROR_CLOUD_FILE_AUTHENTICATION_FAILED                                   Win32Error = 0x182
+	ERROR_CLOUD_FILE_INSUFFICIENT_RESOURCES                                  Win32Error = 0x183
+	ERROR_CLOUD_FILE_NETWORK_UNAVAILABLE                                     Win32Error = 0x184
+	ERROR_CLOUD_FILE_UNSUCCESSFUL                                            Win32Error = 0x185
+	ERROR_CLOUD_FILE_NOT_UNDER_SYNC_ROOT                                     Win32Error = 0x186
+	ERROR_CLOUD_FILE_IN_USE                                                  Win32Error = 0x187
+	ERROR_CLOUD_FILE_PINNED                                                  Win32Error = 0x188
+	ERROR_CLOUD_FILE_REQUEST_ABORTED                                         Win32Error = 0x189
+	ERROR_CLOUD_FILE_PROPERTY_CORRUPT                                        Win32Error = 0x18a
+	ERROR_CLOUD_FILE_ACCESS_DENIED                                           Win32Error = 0x18b
+	ERROR_CLOUD_FILE_INCOMPATIBLE_HARDLINKS                                  Win32Error = 0x18c
+	ERROR_CLOUD_FILE_PROPERTY_LOCK_CONFLICT                                  Win32Error = 0x18d
+	ERROR_CLOUD_FILE_REQUEST_CANCELED                                        Win32Error = 0x18e
+	ERROR_EXTERNAL_SYSKEY_NOT_SUPPORTED                                      Win32Error = 0x18f
+	ERROR_THREAD_MODE_ALREADY_BACKGROUND                                     Win32Error = 0x190
+	ERROR_THREAD_MODE_NOT_BACKGROUND                                         Win32Error = 0x191
+	ERROR_PROCESS_MODE_ALREADY_BACKGROUND                                    Win32Error = 0x192
+	ERROR_PROCESS_MODE_NOT_BACKGROUND                                        Win32Error = 0x193
+	ERROR_CLOUD_FILE_PROVIDER_TERMINATED                                     Win32Error = 0x194
+	ERROR_NOT_A_CLOUD_SYNC_ROOT                                              Win32Error = 0x195
+	ERROR_FILE_PROTECTED_UNDER_DPL                                           Win32Error = 0x196
+	ERROR_VOLUME_NOT_CLUSTER_ALIGNED                                         Win32Error = 0x197
+	ERROR_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND                             Win32Error = 0x198
+	ERROR_APPX_FILE_NOT_ENCRYPTED                                            Win32Error = 0x199
+	ERROR_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED                                 Win32Error = 0x19a
+	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET                       Win32Error = 0x19b
+	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE                        Win32Error = 0x19c
+	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER                        Win32Error = 0x19d
+	ERROR_LINUX_SUBSYSTEM_NOT_PRESENT                                        Win32Error = 0x19e
+	ERROR_FT_READ_FAILURE                                                    Win32Error = 0x19f
+	ERROR_STORAGE_RESERVE_ID_INVALID                                         Win32Error = 0x1a0
+	ERROR_STORAGE_RESERVE_DOES_NOT_EXIST                                     Win32Error = 0x1a1
+	ERROR_STORAGE_RESERVE_ALREADY_EXISTS                                     Win32Error = 0x1a2
+	ERROR_STORAGE_RESERVE_NOT_EMPTY                                          Win32Error = 0x1a3
+	ERROR_NOT_A_DAX_VOLUME                                                   Win32Error = 0x1a4
+	ERROR_NOT_DAX_MAPPABLE                                                   Win32Error = 0x1a5
+	ERROR_TIME_SENSITIVE_THREAD                                              Win32Error = 0x1a6
+	ERROR_DPL_NOT_SUPPORTED_FOR_USER                                         Win32Error = 0x1a7
+	ERROR_CASE_DIFFERING_NAMES_IN_DIR                                        Win32Error = 0x1a8
+	ERROR_FILE_NOT_SUPPORTED                                                 Win32Error = 0x1a9
+	ERROR_CLOUD_FILE_REQUEST_TIMEOUT                                         Win32Error = 0x1aa
+	ERROR_NO_TASK_QUEUE                                                      Win32Error = 0x1ab
+	ERROR_SRC_SRV_DLL_LOAD_FAILED                                            Win32Error = 0x1ac
+	ERROR_NOT_SUPPORTED_WITH_BTT                                             Win32Error = 0x1ad
+	ERROR_ENCRYPTION_DISABLED                                                Win32Error = 0x1ae
+	ERROR_ENCRYPTING_METADATA_DISALLOWED                                     Win32Error = 0x1af
+	ERROR_CANT_CLEAR_ENCRYPTION_FLAG                                         Win32Error = 0x1b0
+	ERROR_NO_SUCH_DEVICE                                                     Win32Error = 0x1b1
+	ERROR_CLOUD_FILE_DEHYDRATION_DISALLOWED                                  Win32Error = 0x1b2
+	ERROR_FILE_SNAP_IN_PROGRESS                                              Win32Error = 0x1b3
+	ERROR_FILE_SNAP_USER_SECTION_NOT_SUPPORTED                               Win32Error = 0x1b4
+	ERROR_FILE_SNAP_MODIFY_NOT_SUPPORTED                                     Win32Error = 0x1b5
+	ERROR_FILE_SNAP_IO_NOT_COORDINATED                                       Win32Error = 0x1b6
+	ERROR_FILE_SNAP_UNEXPECTED_ERROR                                         Win32Error = 0x1b7
+	ERROR_FILE_SNAP_INVALID_PARAMETER                                        Win32Error = 0x1b8
+	ERROR_UNSATISFIED_DEPENDENCIES                                           Win32Error = 0x1b9
+	ERROR_CASE_SENSITIVE_PATH                                                Win32Error = 0x1ba
+	ERROR_UNEXPECTED_NTCACHEMANAGER_ERROR                                    Win32Error = 0x1bb
+	ERROR_LINUX_SUBSYSTEM_UPDATE_REQUIRED                                    Win32Error = 0x1bc
+	ERROR_DLP_POLICY_WARNS_AGAINST_OPERATION                                 Win32Error = 0x1bd
+	ERROR_DLP_POLICY_DENIES_OPERATION                                        Win32Error = 0x1be
+	ERROR_SECURITY_DENIES_OPERATION                                          Win32Error = 0x1bf
+	ERROR_UNTRUSTED_MOUNT_POINT                                              Win32Error = 0x1c0
+	ERROR_DLP_POLICY_SILENTLY_FAIL                                           Win32Error = 0x1c1
+	ERROR_CAPAUTHZ_NOT_DEVUNLOCKED                                           Win32Error = 0x1c2
+	ERROR_CAPAUTHZ_CHANGE_TYPE                                               Win32Error = 0x1c3
+	ERROR_CAPAUTHZ_NOT_PROVISIONED                                           Win32Error = 0x1c4
+	ERROR_CAPAUTHZ_NOT_AUTHORIZED                                            Win32Error = 0x1c5
+	ERROR_CAPAUTHZ_NO_POLICY                                                 Win32Error = 0x1c6
+	ERROR_CAPAUTHZ_DB_CORRUPTED                                              Win32Error = 0x1c7
+	ERROR_CAPAUTHZ_SCCD_INVALID_CATALOG                                      Win32Error = 0x1c8
+	ERROR_CAPAUTHZ_SCCD_NO_AUTH_ENTITY                                       Win32Error = 0x1c9
+	ERROR_CAPAUTHZ_SCCD_PARSE_ERROR                                          Win32Error = 0x1ca
+	ERROR_CAPAUTHZ_SCCD_DEV_MODE_REQUIRED                                    Win32Error = 0x1cb
+	ERROR_CAPAUTHZ_SCCD_NO_CAPABILITY_MATCH                                  Win32Error = 0x1cc
+	ERROR_CIMFS_IMAGE_CORRUPT                                                Win32Error = 0x1d6
+	ERROR_CIMFS_IMAGE_VERSION_NOT_SUPPORTED                                  Win32Error = 0x1d7
+	ERROR_STORAGE_STACK_ACCESS_DENIED                                        Win32Error = 0x1d8
+	ERROR_INSUFFICIENT_VIRTUAL_ADDR_RESOURCES                                Win32Error = 0x1d9
+	ERROR_INDEX_OUT_OF_BOUNDS                                                Win32Error = 0x1da
+	ERROR_CLOUD_FILE_US_MESSAGE_TIMEOUT                                      Win32Error = 0x1db
+	ERROR_PNP_QUERY_REMOVE_DEVICE_TIMEOUT                                    Win32Error = 0x1e0
+	ERROR_PNP_QUERY_REMOVE_RELATED_DEVICE_TIMEOUT                            Win32Error = 0x1e1
+	ERROR_PNP_QUERY_REMOVE_UNRELATED_DEVICE_TIMEOUT                          Win32Error = 0x1e2
+	ERROR_DEVICE_HARDWARE_ERROR                                              Win32Error = 0x1e3
+	ERROR_HAS_SYSTEM_CRITICAL_FILES                                          Win32Error = 0x1e8
+	ERROR_ENCRYPTED_FILE_NOT_SUPPORTED                                       Win32Error = 0x1e9
+	ERROR_SPARSE_FILE_NOT_SUPPORTED                                          Win32Error = 0x1ea
+	ERROR_PAGEFILE_NOT_SUPPORTED                                             Win32Error = 0x1eb
+	ERROR_VOLUME_NOT_SUPPORTED                                               Win32Error = 0x1ec
+	ERROR_NOT_SUPPORTED_WITH_BYPASSIO                                        Win32Error = 0x1ed
+	ERROR_NO_BYPASSIO_DRIVER_SUPPORT                                         Win32Error = 0x1ee
+	ERROR_NOT_SUPPORTED_WITH_ENCRYPTION                                      Win32Error = 0x1ef
+	ERROR_NOT_SUPPORTED_WITH_COMPRESSION                                     Win32Error = 0x1f0
+	ERROR_NOT_SUPPORTED_WITH_REPLICATION                                     Win32Error = 0x1f1
+	ERROR_NOT_SUPPORTED_WITH_DEDUPLICATION                                   Win32Error = 0x1f2
+	ERROR_NOT_SUPPORTED_WITH_AUDITING                                        Win32Error = 0x1f3
+	ERROR_USER_PROFILE_LOAD                                                  Win32Error = 0x1f4
+	ERROR_SESSION_KEY_TOO_SHORT                                              Win32Error = 0x1f5
+	ERROR_ACCESS_DENIED_APPDATA                                              Win32Error = 0x1f6
+	ERROR_NOT_SUPPORTED_WITH_MONITORING                                      Win32Error = 0x1f7
+	ERROR_NOT_SUPPORTED_WITH_SNAPSHOT                                        Win32Error = 0x1f8
+	ERROR_NOT_SUPPORTED_WITH_VIRTUALIZATION                                  Win32Error = 0x1f9
+	ERROR_BYPASSIO_FLT_NOT_SUPPORTED                                         Win32Error = 0x1fa
+	ERROR_DEVICE_RESET_REQUIRED                                              Win32Error = 0x1fb
+	ERROR_VOLUME_WRITE_ACCESS_DENIED                                         Win32Error = 0x1fc
+	ERROR_NOT_SUPPORTED_WITH_CACHED_HANDLE                                   Win32Error = 0x1fd
+	ERROR_FS_METADATA_INCONSISTENT                                           Win32Error = 0x1fe
+	ERROR_BLOCK_WEAK_REFERENCE_INVALID                                       Win32Error = 0x1ff
+	ERROR_BLOCK_SOURCE_WEAK_REFERENCE_INVALID                                Win32Error = 0x200
+	ERROR_BLOCK_TARGET_WEAK_REFERENCE_INVALID                                Win32Error = 0x201
+	ERROR_BLOCK_SHARED                                                       Win32Error = 0x202
+	ERROR_VERIFIER_STOP                                                      Win32Error = 0x219
+	ERROR_ABIOS_ERROR                                                        Win32Error = 0x21a
+	ERROR_WX86_WARNING                                                       Win32Error = 0x21b
+	ERROR_WX86_ERROR                                                         Win32Error = 0x21c
+	ERROR_TIMER_NOT_CANCELED                                                 Win32Error = 0x21d
+	ERROR_UNWIND                                                             Win32Error = 0x21e
+	ERROR_BAD_STACK                                                          Win32Error = 0x21f
+	ERROR_INVALID_UNWIND_TARGET                                              Win32Error = 0x220
+	ERROR_INVALID_PORT_ATTRIBUTES                                            Win32Error = 0x221
+	ERROR_PORT_MESSAGE_TOO_LONG                                              Win32Error = 0x222
+	ERROR_INVALID_QUOTA_LOWER                                                Win32Error = 0x223
+	ERROR_DEVICE_ALREADY_ATTACHED                                            Win32Error = 0x224
+	ERROR_INSTRUCTION_MISALIGNMENT                                           Win32Error = 0x225
+	ERROR_PROFILING_NOT_STARTED                                              Win32Error = 0x226
+	ERROR_PROFILING_NOT_STOPPED                                              Win32Error = 0x227
+	ERROR_COULD_NOT_INTERPRET                                                Win32Error = 0x228
+	ERROR_PROFILING_AT_LIMIT                                                 Win32Error = 0x229
+	ERROR_CANT_WAIT                                                          Win32Error = 0x22a
+	ERROR_CANT_TERMINATE_SELF                                                Win32Error = 0x22b
+	ERROR_UNEXPECTED_MM_CREATE_ERR                                           Win32Error = 0x22c
+	ERROR_UNEXPECTED_MM_MAP_ERROR                                            Win32Error = 0x22d
+	ERROR_UNEXPECTED_MM_EXTEND_ERR                                           Win32Error = 0x22e
+	ERROR_BAD_FUNCTION_TABLE                                                 Win32Error = 0x22f
+	ERROR_NO_GUID_TRANSLATION                                                Win32Error = 0x230
+	ERROR_INVALID_LDT_SIZE                                                   Win32Error = 0x231
+	ERROR_INVALID_LDT_OFFSET                                                 Win32Error = 0x233
+	ERROR_INVALID_LDT_DESCRIPTOR                                             Win32Error = 0x234
+	ERROR_TOO_MANY_THREADS                                                   Win32Error = 0x235
+	ERROR_THREAD_NOT_IN_PROCESS                                              Win32Error = 0x236
+	ERROR_PAGEFILE_QUOTA_EXCEEDED                                            Win32Error = 0x237
+	ERROR_LOGON_SERVER_CONFLICT                                              Win32Error = 0x238
+	ERROR_SYNCHRONIZATION_REQUIRED                                           Win32Error = 0x239
+	ERROR_NET_OPEN_FAILED                                                    Win32Error = 0x23a
+	ERROR_IO_PRIVILEGE_FAILED                                                Win32Error = 0x23b
+	ERROR_CONTROL_C_EXIT                                                     Win32Error = 0x23c
+	ERROR_MISSING_SYSTEMFILE                                                 Win32Error = 0x23d
+	ERROR_UNHANDLED_EXCEPTION                                                Win32Error = 0x23e
+	ERROR_APP_INIT_FAILURE                                                   Win32Error = 0x23f
+	ERROR_PAGEFILE_CREATE_FAILED                                             Win32Error = 0x240
+	ERROR_NO_PAGEFILE                                                        Win32Error = 0x242
+	ERROR_ILLEGAL_FLOAT_CONTEXT                                              Win32Error = 0x243
+	ERROR_NO_EVENT_PAIR                                                      Win32Error = 0x244
+	ERROR_DOMAIN_CTRLR_CONFIG_ERROR                                          Win32Error = 0x245
+	ERROR_ILLEGAL_CHARACTER                                                  Win32Error = 0x246
+	ERROR_UNDEFINED_CHARACTER                                                Win32Error = 0x247
+	ERROR_FLOPPY_VOLUME                                                      Win32Error = 0x248
+	ERROR_BIOS_FAILED_TO_CONNECT_INTERRUPT                                   Win32Error = 0x249
+	ERROR_BACKUP_CONTROLLER                                                  Win32Error = 0x24a
+	ERROR_MUTANT_LIMIT_EXCEEDED                                              Win32Error = 0x24b
+	ERROR_FS_DRIVER_REQUIRED                                                 Win32Error = 0x24c
+	ERROR_CANNOT_LOAD_REGISTRY_FILE                                          Win32Error = 0x24d
+	ERROR_DEBUG_ATTACH_FAILED                                                Win32Error = 0x24e
+	ERROR_SYSTEM_PROCESS_TERMINATED                                          Win32Error = 0x24f
+	ERROR_DATA_NOT_ACCEPTED                                                  Win32Error = 0x250
+	ERROR_VDM_HARD_ERROR                                                     Win32Error = 0x251
+	ERROR_DRIVER_CANCEL_TIMEOUT                                              Win32Error = 0x252
+	ERROR_REPLY_MESSAGE_MISMATCH                                             Win32Error = 0x253
+	ERROR_LOST_WRITEBEHIND_DATA                                              Win32Error = 0x254
+	ERROR_CLIENT_SERVER_PARAMETERS_INVALID                                   Win32Error = 0x255
+	ERROR_NOT_TINY_STREAM                                                    Win32Error = 0x256
+	ERROR_STACK_OVERFLOW_READ                                                Win32Error = 0x257
+	ERROR_CONVERT_TO_LARGE                                                   Win32Error = 0x258
+	ERROR_FOUND_OUT_OF_SCOPE                                                 Win32Error = 0x259
+	ERROR_ALLOCATE_BUCKET                                                    Win32Error = 0x25a
+	ERROR_MARSHALL_OVERFLOW                                                  Win32Error = 0x25b
+	ERROR_INVALID_VARIANT                                                    Win32Error = 0x25c
+	ERROR_BAD_COMPRESSION_BUFFER                                             Win32Error = 0x25d
+	ERROR_AUDIT_FAILED                                                       Win32Error = 0x25e
+	ERROR_TIMER_RESOLUTION_NOT_SET                                           Win32Error = 0x25f
+	ERROR_INSUFFICIENT_LOGON_INFO                                            Win32Error = 0x260
+	ERROR_BAD_DLL_ENTRYPOINT                                                 Win32Error = 0x261
+	ERROR_BAD_SERVICE_ENTRYPOINT                                             Win32Error = 0x262
+	ERROR_IP_ADDRESS_CONFLICT1                                               Win32Error = 0x263
+	ERROR_IP_ADDRESS_CONFLICT2                                               Win32Error = 0x264
+	ERROR_REGISTRY_QUOTA_LIMIT                                               Win32Error = 0x265
+	ERROR_NO_CALLBACK_ACTIVE                                                 Win32Error = 0x266
+	ERROR_PWD_TOO_SHORT                                                      Win32Error = 0x267
+	ERROR_PWD_TOO_RECENT                                                     Win32Error = 0x268
+	ERROR_PWD_HISTORY_CONFLICT                                               Win32Error = 0x269
+	ERROR_UNSUPPORTED_COMPRESSION                                            Win32Error = 0x26a
+	ERROR_INVALID_HW_PROFILE                                                 Win32Error = 0x26b
+	ERROR_INVALID_PLUGPLAY_DEVICE_PATH                                       Win32Error = 0x26c
+	ERROR_QUOTA_LIST_INCONSISTENT                                            Win32Error = 0x26d
+	ERROR_EVALUATION_EXPIRATION                                              Win32Error = 0x26e
+	ERROR_ILLEGAL_DLL_RELOCATION                                             Win32Error = 0x26f
+	ERROR_DLL_INIT_FAILED_LOGOFF                                             Win32Error = 0x270
+	ERROR_VALIDATE_CONTINUE                                                  Win32Error = 0x271
+	ERROR_NO_MORE_MATCHES                                                    Win32Error = 0x272
+	ERROR_RANGE_LIST_CONFLICT                                                Win32Error = 0x273
+	ERROR_SERVER_SID_MISMATCH                                                Win32Error = 0x274
+	ERROR_CANT_ENABLE_DENY_ONLY                                              Win32Error = 0x275
+	ERROR_FLOAT_MULTIPLE_FAULTS                                              Win32Error = 0x276
+	ERROR_FLOAT_MULTIPLE_TRAPS                                               Win32Error = 0x277
+	ERROR_NOINTERFACE                                                        Win32Error = 0x278
+	ERROR_DRIVER_FAILED_SLEEP                                                Win32Error = 0x279
+	ERROR_CORRUPT_SYSTEM_FILE                                                Win32Error = 0x27a
+	ERROR_COMMITMENT_MINIMUM                                                 Win32Error = 0x27b
+	ERROR_PNP_RESTART_ENUMERATION                                            Win32Error = 0x27c
+	ERROR_SYSTEM_IMAGE_BAD_SIGNATURE                                         Win32Error = 0x27d
+	ERROR_PNP_REBOOT_REQUIRED                                                Win32Error = 0x27e
+	ERROR_INSUFFICIENT_POWER                                                 Win32Error = 0x27f
+	ERROR_MULTIPLE_FAULT_VIOLATION                                           Win32Error = 0x280
+	ERROR_SYSTEM_SHUTDOWN                                                    Win32Error = 0x281
+	ERROR_PORT_NOT_SET                                                       Win32Error = 0x282
+	ERROR_DS_VERSION_CHECK_FAILURE                                           Win32Error = 0x283
+	ERROR_RANGE_NOT_FOUND                                                    Win32Error = 0x284
+	ERROR_NOT_SAFE_MODE_DRIVER                                               Win32Error = 0x286
+	ERROR_FAILED_DRIVER_ENTRY                                                Win32Error = 0x287
+	ERROR_DEVICE_ENUMERATION_ERROR                                           Win32Error = 0x288
+	ERROR_MOUNT_POINT_NOT_RESOLVED                                           Win32Error = 0x289
+	ERROR_INVALID_DEVICE_OBJECT_PARAMETER                                    Win32Error = 0x28a
+	ERROR_MCA_OCCURED                                                        Win32Error = 0x28b
+	ERROR_DRIVER_DATABASE_ERROR                                              Win32Error = 0x28c
+	ERROR_SYSTEM_HIVE_TOO_LARGE                                              Win32Error = 0x28d
+	ERROR_DRIVER_FAILED_PRIOR_UNLOAD                                         Win32Error = 0x28e
+	ERROR_VOLSNAP_PREPARE_HIBERNATE                                          Win32Error = 0x28f
+	ERROR_HIBERNATION_FAILURE                                                Win32Error = 0x290
+	ERROR_PWD_TOO_LONG                                                       Win32Error = 0x291
+	ERROR_FILE_SYSTEM_LIMITATION                                             Win32Error = 0x299
+	ERROR_ASSERTION_FAILURE                                                  Win32Error = 0x29c
+	ERROR_ACPI_ERROR                                                         Win32Error = 0x29d
+	ERROR_WOW_ASSERTION                                                      Win32Error = 0x29e
+	ERROR_PNP_BAD_MPS_TABLE                                                  Win32Error = 0x29f
+	ERROR_PNP_TRANSLATION_FAILED                                             Win32Error = 0x2a0
+	ERROR_PNP_IRQ_TRANSLATION_FAILED                                         Win32Error = 0x2a1
+	ERROR_PNP_INVALID_ID                                                     Win32Error = 0x2a2
+	ERROR_WAKE_SYSTEM_DEBUGGER                                               Win32Error = 0x2a3
+	ERROR_HANDLES_CLOSED                                                     Win32Error = 0x2a4
+	ERROR_EXTRANEOUS_INFORMATION                                             Win32Error = 0x2a5
+	ERROR_RXACT_COMMIT_NECESSARY                                             Win32Error = 0x2a6
+	ERROR_MEDIA_CHECK                                                        Win32Error = 0x2a7
+	ERROR_GUID_SUBSTITUTION_MADE                                             Win32Error = 0x2a8
+	ERROR_STOPPED_ON_SYMLINK                                                 Win32Error = 0x2a9
+	ERROR_LONGJUMP                                                           Win32Error = 0x2aa
+	ERROR_PLUGPLAY_QUERY_VETOED                                              Win32Error = 0x2ab
+	ERROR_UNWIND_CONSOLIDATE                                                 Win32Error = 0x2ac
+	ERROR_REGISTRY_HIVE_RECOVERED                                            Win32Error = 0x2ad
+	ERROR_DLL_MIGHT_BE_INSECURE                                              Win32Error = 0x2ae
+	ERROR_DLL_MIGHT_BE_INCOMPATIBLE                                          Win32Error = 0x2af
+	ERROR_DBG_EXCEPTION_NOT_HANDLED                                          Win32Error = 0x2b0
+	ERROR_DBG_REPLY_LATER                                                    Win32Error = 0x2b1
+	ERROR_DBG_UNABLE_TO_PROVIDE_HANDLE                                       Win32Error = 0x2b2
+	ERROR_DBG_TERMINATE_THREAD                                               Win32Error = 0x2b3
+	ERROR_DBG_TERMINATE_PROCESS                                              Win32Error = 0x2b4
+	ERROR_DBG_CONTROL_C                                                      Win32Error = 0x2b5
+	ERROR_DBG_PRINTEXCEPTION_C                                               Win32Error = 0x2b6
+	ERROR_DBG_RIPEXCEPTION                                                   Win32Error = 0x2b7
+	ERROR_DBG_CONTROL_BREAK                                                  Win32Error = 0x2b8
+	ERROR_DBG_COMMAND_EXCEPTION                                              Win32Error = 0x2b9
+	ERROR_OBJECT_NAME_EXISTS                                                 Win32Error = 0x2ba
+	ERROR_THREAD_WAS_SUSPENDED                                               Win32Error = 0x2bb
+	ERROR_IMAGE_NOT_AT_BASE                                                  Win32Error = 0x2bc
+	ERROR_RXACT_STATE_CREATED                                                Win32Error = 0x2bd
+	ERROR_SEGMENT_NOTIFICATION                                               Win32Error = 0x2be
+	ERROR_BAD_CURRENT_DIRECTORY                                              Win32Error = 0x2bf
+	ERROR_FT_READ_RECOVERY_FROM_BACKUP                                       Win32Error = 0x2c0
+	ERROR_FT_WRITE_RECOVERY                                                  Win32Error = 0x2c1
+	ERROR_IMAGE_MACHINE_TYPE_MISMATCH                                        Win32Error = 0x2c2
+	ERROR_RECEIVE_PARTIAL                                                    Win32Error = 0x2c3
+	ERROR_RECEIVE_EXPEDITED                                                  Win32Error = 0x2c4
+	ERROR_RECEIVE_PARTIAL_EXPEDITED                                          Win32Error = 0x2c5
+	ERROR_EVENT_DONE                                                         Win32Error = 0x2c6
+	ERROR_EVENT_PENDING                                                      Win32Error = 0x2c7
+	ERROR_CHECKING_FILE_SYSTEM                                               Win32Error = 0x2c8
+	ERROR_FATAL_APP_EXIT                                                     Win32Error = 0x2c9
+	ERROR_PREDEFINED_HANDLE                                                  Win32Error = 0x2ca
+	ERROR_WAS_UNLOCKED                                                       Win32Error = 0x2cb
+	ERROR_SERVICE_NOTIFICATION                                               Win32Error = 0x2cc
+	ERROR_WAS_LOCKED                                                         Win32Error = 0x2cd
+	ERROR_LOG_HARD_ERROR                                                     Win32Error = 0x2ce
+	ERROR_ALREADY_WIN32                                                      Win32Error = 0x2cf
+	ERROR_IMAGE_MACHINE_TYPE_MISMATCH_EXE                                    Win32Error = 0x2d0
+	ERROR_NO_YIELD_PERFORMED                                                 Win32Error = 0x2d1
+	ERROR_TIMER_RESUME_IGNORED                                               Win32Error = 0x2d2
+	ERROR_ARBITRATION_UNHANDLED                                              Win32Error = 0x2d3
+	ERROR_CARDBUS_NOT_SUPPORTED                                              Win32Error = 0x2d4
+	ERROR_MP_PROCESSOR_MISMATCH                                              Win32Error = 0x2d5
+	ERROR_HIBERNATED                                                         Win32Error = 0x2d6
+	ERROR_RESUME_HIBERNATION                                                 Win32Error = 0x2d7
+	ERROR_FIRMWARE_UPDATED                                                   Win32Error = 0x2d8
+	ERROR_DRIVERS_LEAKING_LOCKED_PAGES                                       Win32Error = 0x2d9
+	ERROR_WAKE_SYSTEM                                                        Win32Error = 0x2da
+	ERROR_WAIT_1                                                             Win32Error = 0x2db
+	ERROR_WAIT_2                                                             Win32Error = 0x2dc
+	ERROR_WAIT_3                                                             Win32Error = 0x2dd
+	ERROR_WAIT_63                                                            Win32Error = 0x2de
+	ERROR_ABANDONED_WAIT_0                                                   Win32Error = 0x2df
+	ERROR_ABANDONED_WAIT_63                                                  Win32Error = 0x2e0
+	ERROR_USER_APC                                                           Win32Error = 0x2e1
+	ERROR_KERNEL_APC                                                         Win32Error = 0x2e2
+	ERROR_ALERTED                                                            Win32Error = 0x2e3
+	ERROR_REPARSE                                                            Win32Error = 0x2e5
+	ERROR_OPLOCK_BREAK_IN_PROGRESS                                           Win32Error = 0x2e6
+	ERROR_VOLUME_MOUNTED                                                     Win32Error = 0x2e7
+	ERROR_RXACT_COMMITTED                                                    Win32Error = 0x2e8
+	ERROR_NOTIFY_CLEANUP                                                     Win32Error = 0x2e9
+	ERROR_PRIMARY_TRANSPORT_CONNECT_FAILED                                   Win32Error = 0x2ea
+	ERROR_PAGE_FAULT_TRANSITION                                              Win32Error = 0x2eb
+	ERROR_PAGE_FAULT_DEMAND_ZERO                                             Win32Error = 0x2ec
+	ERROR_PAGE_FAULT_COPY_ON_WRITE                                           Win32Error = 0x2ed
+	ERROR_PAGE_FAULT_GUARD_PAGE                                              Win32Error = 0x2ee
+	ERROR_PAGE_FAULT_PAGING_FILE                                             Win32Error = 0x2ef
+	ERROR_CACHE_PAGE_LOCKED                                                  Win32Error = 0x2f0
+	ERROR_CRASH_DUMP                                                         Win32Error = 0x2f1
+	ERROR_BUFFER_ALL_ZEROS                                                   Win32Error = 0x2f2
+	ERROR_REPARSE_OBJECT                                                     Win32Error = 0x2f3
+	ERROR_RESOURCE_REQUIREMENTS_CHANGED                                      Win32Error = 0x2f4
+	ERROR_TRANSLATION_COMPLETE                                               Win32Error = 0x2f5
+	ERROR_NOTHING_TO_TERMINATE                                               Win32Error = 0x2f6
+	ERROR_PROCESS_NOT_IN_JOB                                                 Win32Error = 0x2f7
+	ERROR_PROCESS_IN_JOB                                                     Win32Error = 0x2f8
+	ERROR_VOLSNAP_HIBERNATE_READY                                            Win32Error = 0x2f9
+	ERROR_FSFILTER_OP_COMPLETED_SUCCESSFULLY                                 Win32Error = 0x2fa
+	ERROR_INTERRUPT_VECTOR_ALREADY_CONNECTED                                 Win32Error = 0x2fb
+	ERROR_INTERRUPT_STILL_CONNECTED                                          Win32Error = 0x2fc
+	ERROR_WAIT_FOR_OPLOCK                                                    Win32Error = 0x2fd
+	ERROR_DBG_EXCEPTION_HANDLED                                              Win32Error = 0x2fe
+	ERROR_DBG_CONTINUE                                                       Win32Error = 0x2ff
+	ERROR_CALLBACK_POP_STACK                                                 Win32Error = 0x300
+	ERROR_COMPRESSION_DISABLED                                               Win32Error = 0x301
+	ERROR_CANTFETCHBACKWARDS                                                 Win32Error = 0x302
+	ERROR_CANTSCROLLBACKWARDS                                                Win32Error = 0x303
+	ERROR_ROWSNOTRELEASED                                                    Win32Error = 0x304
+	ERROR_BAD_ACCESSOR_FLAGS                                                 Win32Error = 0x305
+	ERROR_ERRORS_ENCOUNTERED                                                 Win32Error = 0x306
+	ERROR_NOT_CAPABLE                                                        Win32Error = 0x307
+	ERROR_REQUEST_OUT_OF_SEQUENCE                                            Win32Error = 0x308
+	ERROR_VERSION_PARSE_ERROR                                                Win32Error = 0x309
+	ERROR_BADSTARTPOSITION                                                   Win32Error = 0x30a
+	ERROR_MEMORY_HARDWARE                                                    Win32Error = 0x30b
+	ERROR_DISK_REPAIR_DISABLED                                               Win32Error = 0x30c
+	ERROR_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE            Win32Error = 0x30d
+	ERROR_SYSTEM_POWERSTATE_TRANSITION                                       Win32Error = 0x30e
+	ERROR_SYSTEM_POWERSTATE_COMPLEX_TRANSITION                               Win32Error = 0x30f
+	ERROR_MCA_EXCEPTION                                                      Win32Error = 0x310
+	ERROR_ACCESS_AUDIT_BY_POLICY                                             Win32Error = 0x311
+	ERROR_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY                              Win32Error = 0x312
+	ERROR_ABANDON_HIBERFILE                                                  Win32Error = 0x313
+	ERROR_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED                         Win32Error = 0x314
+	ERROR_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR                         Win32Error = 0x315
+	ERROR_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR                             Win32Error = 0x316
+	ERROR_BAD_MCFG_TABLE                                                     Win32Error = 0x317
+	ERROR_DISK_REPAIR_REDIRECTED                                             Win32Error = 0x318
+	ERROR_DISK_REPAIR_UNSUCCESSFUL                                           Win32Error = 0x319
+	ERROR_CORRUPT_LOG_OVERFULL                                               Win32Error = 0x31a
+	ERROR_CORRUPT_LOG_CORRUPTED                                              Win32Error = 0x31b
+	ERROR_CORRUPT_LOG_UNAVAILABLE                                            Win32Error = 0x31c
+	ERROR_CORRUPT_LOG_DELETED_FULL                                           Win32Error = 0x31d
+	ERROR_CORRUPT_LOG_CLEARED                                                Win32Error = 0x31e
+	ERROR_ORPHAN_NAME_EXHAUSTED                                              Win32Error = 0x31f
+	ERROR_OPLOCK_SWITCHED_TO_NEW_HANDLE                                      Win32Error = 0x320
+	ERROR_CANNOT_GRANT_REQUESTED_OPLOCK                                      Win32Error = 0x321
+	ERROR_CANNOT_BREAK_OPLOCK                                                Win32Error = 0x322
+	ERROR_OPLOCK_HANDLE_CLOSED                                               Win32Error = 0x323
+	ERROR_NO_ACE_CONDITION                                                   Win32Error = 0x324
+	ERROR_INVALID_ACE_CONDITION                                              Win32Error = 0x325
+	ERROR_FILE_HANDLE_REVOKED                                                Win32Error = 0x326
+	ERROR_IMAGE_AT_DIFFERENT_BASE                                            Win32Error = 0x327
+	ERROR_ENCRYPTED_IO_NOT_POSSIBLE                                          Win32Error = 0x328
+	ERROR_FILE_METADATA_OPTIMIZATION_IN_PROGRESS                             Win32Error = 0x329
+	ERROR_QUOTA_ACTIVITY                                                     Win32Error = 0x32a
+	ERROR_HANDLE_REVOKED                                                     Win32Error = 0x32b
+	ERROR_CALLBACK_INVOKE_INLINE                                             Win32Error = 0x32c
+	ERROR_CPU_SET_INVALID                                                    Win32Error = 0x32d
+	ERROR_ENCLAVE_NOT_TERMINATED                                             Win32Error = 0x32e
+	ERROR_ENCLAVE_VIOLATION                                                  Win32Error = 0x32f
+	ERROR_SERVER_TRANSPORT_CONFLICT                                          Win32Error = 0x330
+	ERROR_CERTIFICATE_VALIDATION_PREFERENCE_CONFLICT                         Win32Error = 0x331
+	ERROR_FT_READ_FROM_COPY_FAILURE                                          Win32Error = 0x332
+	ERROR_SECTION_DIRECT_MAP_ONLY                                            Win32Error = 0x333
+	ERROR_SERVICE_NOT_IN_EXE                                                 Win32Error = 0x43b
+	ERROR_NOT_SAFEBOOT_SERVICE                                               Win32Error = 0x43c
+	ERROR_UNABLE_TO_REMOVE_REPLACED                                          Win32Error = 0x497
+	ERROR_UNABLE_TO_MOVE_REPLACEMENT                                         Win32Error = 0x498
+	ERROR_UNABLE_TO_MOVE_REPLACEMENT_2                                       Win32Error = 0x499
+	ERROR_JOURNAL_DELETE_IN_PROGRESS                                         Win32Error = 0x49a
+	ERROR_JOURNAL_NOT_ACTIVE                                                 Win32Error = 0x49b
+	ERROR_POTENTIAL_FILE_FOUND                                               Win32Error = 0x49c
+	ERROR_JOURNAL_ENTRY_DELETED                                              Win32Error = 0x49d
+	ERROR_PARTITION_TERMINATING                                              Win32Error = 0x4a0
+	ERROR_SHUTDOWN_IS_SCHEDULED                                              Win32Error = 0x4a6
+	ERROR_SHUTDOWN_USERS_LOGGED_ON                                           Win32Error = 0x4a7
+	ERROR_SHUTDOWN_DISKS_NOT_IN_MAINTENANCE_MODE                             Win32Error = 0x4a8
+	ERROR_ONLY_IF_CONNECTED                                                  Win32Error = 0x4e3
+	ERROR_OVERRIDE_NOCHANGES                                                 Win32Error = 0x4e4
+	ERROR_BAD_USER_PROFILE                                                   Win32Error = 0x4e5
+	ERROR_NOT_SUPPORTED_ON_SBS                                               Win32Error = 0x4e6
+	ERROR_SERVER_SHUTDOWN_IN_PROGRESS                                        Win32Error = 0x4e7
+	ERROR_HOST_DOWN                                                          Win32Error = 0x4e8
+	ERROR_NON_ACCOUNT_SID                                                    Win32Error = 0x4e9
+	ERROR_NON_DOMAIN_SID                                                     Win32Error = 0x4ea
+	ERROR_APPHELP_BLOCK                                                      Win32Error = 0x4eb
+	ERROR_ACCESS_DISABLED_BY_POLICY                                          Win32Error = 0x4ec
+	ERROR_REG_NAT_CONSUMPTION                                                Win32Error = 0x4ed
+	ERROR_CSCSHARE_OFFLINE                                                   Win32Error = 0x4ee
+	ERROR_PKINIT_FAILURE                                                     Win32Error = 0x4ef
+	ERROR_SMARTCARD_SUBSYSTEM_FAILURE                                        Win32Error = 0x4f0
+	ERROR_DOWNGRADE_DETECTED                                                 Win32Error = 0x4f1
+	ERROR_MACHINE_LOCKED                                                     Win32Error = 0x4f7
+	ERROR_CALLBACK_SUPPLIED_INVALID_DATA                                     Win32Error = 0x4f9
+	ERROR_SYNC_FOREGROUND_REFRESH_REQUIRED                                   Win32Error = 0x4fa
+	ERROR_DRIVER_BLOCKED                                                     Win32Error = 0x4fb
+	ERROR_INVALID_IMPORT_OF_NON_DLL                                          Win32Error = 0x4fc
+	ERROR_ACCESS_DISABLED_WEBBLADE                                           Win32Error = 0x4fd
+	ERROR_ACCESS_DISABLED_WEBBLADE_TAMPER                                    Win32Error = 0x4fe
+	ERROR_RECOVERY_FAILURE                                                   Win32Error = 0x4ff
+	ERROR_ALREADY_FIBER                                                      Win32Error = 0x500
+	ERROR_ALREADY_THREAD                                                     Win32Error = 0x501
+	ERROR_STACK_BUFFER_OVERRUN                                               Win32Error = 0x502
+	ERROR_PARAMETER_QUOTA_EXCEEDED                                           Win32Error = 0x503
+	ERROR_DEBUGGER_INACTIVE                                                  Win32Error = 0x504
+	ERROR_DELAY_LOAD_FAILED                                                  Win32Error = 0x505
+	ERROR_VDM_DISALLOWED                                                     Win32Error = 0x506
+	ERROR_UNIDENTIFIED_ERROR                                                 Win32Error = 0x507
+	ERROR_INVALID_CRUNTIME_PARAMETER                                         Win32Error = 0x508
+	ERROR_BEYOND_VDL                                                         Win32Error = 0x509
+	ERROR_INCOMPATIBLE_SERVICE_SID_TYPE                                      Win32Error = 0x50a
+	ERROR_DRIVER_PROCESS_TERMINATED                                          Win32Error = 0x50b
+	ERROR_IMPLEMENTATION_LIMIT                                               Win32Error = 0x50c
+	ERROR_PROCESS_IS_PROTECTED                                               Win32Error = 0x50d
+	ERROR_SERVICE_NOTIFY_CLIENT_LAGGING                                      Win32Error = 0x50e
+	ERROR_DISK_QUOTA_EXCEEDED                                                Win32Error = 0x50f
+	ERROR_CONTENT_BLOCKED                                                    Win32Error = 0x510
+	ERROR_INCOMPATIBLE_SERVICE_PRIVILEGE                                     Win32Error = 0x511
+	ERROR_APP_HANG                                                           Win32Error = 0x512
+	ERROR_INVALID_LABEL                                                      Win32Error = 0x513
+	ERROR_WRONG_TARGET_NAME                                                  Win32Error = 0x574
+	ERROR_MUTUAL_AUTH_FAILED                                                 Win32Error = 0x575
+	ERROR_TIME_SKEW                                                          Win32Error = 0x576
+	ERROR_CURRENT_DOMAIN_NOT_ALLOWED                                         Win32Error = 0x577
+	ERROR_INCORRECT_SIZE                                                     Win32Error = 0x5b6
+	ERROR_SYMLINK_CLASS_DISABLED                                             Win32Error = 0x5b7
+	ERROR_SYMLINK_NOT_SUPPORTED                                              Win32Error = 0x5b8
+	ERROR_XML_PARSE_ERROR                                                    Win32Error = 0x5b9
+	ERROR_XMLDSIG_ERROR                                                      Win32Error = 0x5ba
+	ERROR_RESTART_APPLICATION                                                Win32Error = 0x5bb
+	ERROR_WRONG_COMPARTMENT                                                  Win32Error = 0x5bc
+	ERROR_AUTHIP_FAILURE                                                     Win32Error = 0x5bd
+	ERROR_NO_NVRAM_RESOURCES                                                 Win32Error = 0x5be
+	ERROR_NOT_GUI_PROCESS                                                    Win32Error = 0x5bf
+	ERROR_CONTAINER_ASSIGNED                                                 Win32Error = 0x5e0
+	ERROR_JOB_NO_CONTAINER                                                   Win32Error = 0x5e1
+	ERROR_INVALID_TASK_NAME                                                  Win32Error = 0x60e
+	ERROR_INVALID_TASK_INDEX                                                 Win32Error = 0x60f
+	ERROR_THREAD_ALREADY_IN_TASK                                             Win32Error = 0x610
+	ERROR_INSTALL_SERVICE_FAILURE                                            Win32Error = 0x641
+	ERROR_INSTALL_PACKAGE_VERSION                                            Win32Error = 0x64d
+	ERROR_DEVICE_REMOVED                                                     Win32Error = 0x651
+	ERROR_INSTALL_ALREADY_RUNNING                                            Win32Error = 0x652
+	ERROR_INSTALL_PACKAGE_OPEN_FAILED                                        Win32Error = 0x653
+	ERROR_INSTALL_PACKAGE_INVALID                                            Win32Error = 0x654
+	ERROR_INSTALL_UI_FAILURE                                                 Win32Error = 0x655
+	ERROR_INSTALL_LOG_FAILURE                                                Win32Error = 0x656
+	ERROR_INSTALL_LANGUAGE_UNSUPPORTED                                       Win32Error = 0x657
+	ERROR_INSTALL_TRANSFORM_FAILURE                                          Win32Error = 0x658
+	ERROR_INSTALL_PACKAGE_REJECTED                                           Win32Error = 0x659
+	ERROR_FUNCTION_NOT_CALLED                                                Win32Error = 0x65a
+	ERROR_FUNCTION_FAILED                                                    Win32Error = 0x65b
+	ERROR_INVALID_TABLE                                                      Win32Error = 0x65c
+	ERROR_DATATYPE_MISMATCH                                                  Win32Error = 0x65d
+	ERROR_UNSUPPORTED_TYPE                                                   Win32Error = 0x65e
+	ERROR_CREATE_FAILED                                                      Win32Error = 0x65f
+	ERROR_INSTALL_TEMP_UNWRITABLE                                            Win32Error = 0x660
+	ERROR_INSTALL_PLATFORM_UNSUPPORTED                                       Win32Error = 0x661
+	ERROR_INSTALL_NOTUSED                                                    Win32Error = 0x662
+	ERROR_PATCH_PACKAGE_OPEN_FAILED                                          Win32Error = 0x663
+	ERROR_PATCH_PACKAGE_INVALID                                              Win32Error = 0x664
+	ERROR_PATCH_PACKAGE_UNSUPPORTED                                          Win32Error = 0x665
+	ERROR_PRODUCT_VERSION                                                    Win32Error = 0x666
+	ERROR_INVALID_COMMAND_LINE                                               Win32Error = 0x667
+	ERROR_INSTALL_REMOTE_DISALLOWED                                          Win32Error = 0x668
+	ERROR_SUCCESS_REBOOT_INITIATED                                           Win32Error = 0x669
+	ERROR_PATCH_TARGET_NOT_FOUND                                             Win32Error = 0x66a
+	ERROR_PATCH_PACKAGE_REJECTED                                             Win32Error = 0x66b
+	ERROR_INSTALL_TRANSFORM_REJECTED                                         Win32Error = 0x66c
+	ERROR_INSTALL_REMOTE_PROHIBITED                                          Win32Error = 0x66d
+	ERROR_PATCH_REMOVAL_UNSUPPORTED                                          Win32Error = 0x66e
+	ERROR_UNKNOWN_PATCH                                                      Win32Error = 0x66f
+	ERROR_PATCH_NO_SEQUENCE                                                  Win32Error = 0x670
+	ERROR_PATCH_REMOVAL_DISALLOWED                                           Win32Error = 0x671
+	ERROR_INVALID_PATCH_XML                                                  Win32Error = 0x672
+	ERROR_PATCH_MANAGED_ADVERTISED_PRODUCT                                   Win32Error = 0x673
+	ERROR_INSTALL_SERVICE_SAFEBOOT                                           Win32Error = 0x674
+	ERROR_FAIL_FAST_EXCEPTION                                                Win32Error = 0x675
+	ERROR_INSTALL_REJECTED                                                   Win32Error = 0x676
+	ERROR_DYNAMIC_CODE_BLOCKED                                               Win32Error = 0x677
+	ERROR_NOT_SAME_OBJECT                                                    Win32Error = 0x678
+	ERROR_STRICT_CFG_VIOLATION                                               Win32Error = 0x679
+	ERROR_SET_CONTEXT_DENIED                                                 Win32Error = 0x67c
+	ERROR_CROSS_PARTITION_VIOLATION                                          Win32Error = 0x67d
+	ERROR_RETURN_ADDRESS_HIJACK_ATTEMPT                                      Win32Error = 0x67e
+	RPC_S_PROXY_ACCESS_DENIED                                                Win32Error = 0x6c1
+	RPC_S_COOKIE_AUTH_FAILED                                                 Win32Error = 0x729
+	RPC_S_DO_NOT_DISTURB                                                     Win32Error = 0x72a
+	RPC_S_SYSTEM_HANDLE_COUNT_EXCEEDED                                       Win32Error = 0x72b
+	RPC_S_SYSTEM_HANDLE_TYPE_MISMATCH                                        Win32Error = 0x72c
+	RPC_S_ENTRY_TYPE_MISMATCH                                                Win32Error = 0x782
+	RPC_S_NOT_ALL_OBJS_EXPORTED                                              Win32Error = 0x783
+	RPC_S_INTERFACE_NOT_EXPORTED                                             Win32Error = 0x784
+	RPC_S_PROFILE_NOT_ADDED                                                  Win32Error = 0x785
+	RPC_S_PRF_ELT_NOT_ADDED                                                  Win32Error = 0x786
+	RPC_S_PRF_ELT_NOT_REMOVED                                                Win32Error = 0x787
+	RPC_S_GRP_ELT_NOT_ADDED                                                  Win32Error = 0x788
+	RPC_S_GRP_ELT_NOT_REMOVED                                                Win32Error = 0x789
+	ERROR_KM_DRIVER_BLOCKED                                                  Win32Error = 0x78a
+	ERROR_CONTEXT_EXPIRED                                                    Win32Error = 0x78b
+	ERROR_PER_USER_TRUST_QUOTA_EXCEEDED                                      Win32Error = 0x78c
+	ERROR_ALL_USER_TRUST_QUOTA_EXCEEDED                                      Win32Error = 0x78d
+	ERROR_USER_DELETE_TRUST_QUOTA_EXCEEDED                                   Win32Error = 0x78e
+	ERROR_AUTHENTICATION_FIREWALL_FAILED                                     Win32Error = 0x78f
+	ERROR_REMOTE_PRINT_CONNECTIONS_BLOCKED                                   Win32Error = 0x790
+	ERROR_NTLM_BLOCKED                                                       Win32Error = 0x791
+	ERROR_PASSWORD_CHANGE_REQUIRED                                           Win32Error = 0x792
+	ERROR_LOST_MODE_LOGON_RESTRICTION                                        Win32Error = 0x793
+	ERROR_COLORSPACE_MISMATCH                                                Win32Error = 0x7e5
+	ERROR_INVALID_COLORINDEX                                                 Win32Error = 0x7e6
+	ERROR_CONNECTED_OTHER_PASSWORD_DEFAULT                                   Win32Error = 0x83d
+	ERROR_PRINTER_NOT_FOUND                                                  Win32Error = 0xbc4
+	ERROR_PRINTER_DRIVER_WARNED                                              Win32Error = 0xbc5
+	ERROR_PRINTER_DRIVER_BLOCKED                                             Win32Error = 0xbc6
+	ERROR_PRINTER_DRIVER_PACKAGE_IN_USE                                      Win32Error = 0xbc7
+	ERROR_CORE_DRIVER_PACKAGE_NOT_FOUND                                      Win32Error = 0xbc8
+	ERROR_FAIL_REBOOT_REQUIRED                                               Win32Error = 0xbc9
+	ERROR_FAIL_REBOOT_INITIATED                                              Win32Error = 0xbca
+	ERROR_PRINTER_DRIVER_DOWNLOAD_NEEDED                                     Win32Error = 0xbcb
+	ERROR_PRINT_JOB_RESTART_REQUIRED                                         Win32Error = 0xbcc
+	ERROR_INVALID_PRINTER_DRIVER_MANIFEST                                    Win32Error = 0xbcd
+	ERROR_PRINTER_NOT_SHAREABLE                                              Win32Error = 0xbce
+	ERROR_SERVER_SERVICE_CALL_REQUIRES_SMB1                                  Win32Error = 0xbcf
+	ERROR_NETWORK_AUTHENTICATION_PROMPT_CANCELED                             Win32Error = 0xbd0
+	ERROR_REQUEST_PAUSED                                                     Win32Error = 0xbea
+	ERROR_APPEXEC_CONDITION_NOT_SATISFIED                                    Win32Error = 0xbf4
+	ERROR_APPEXEC_HANDLE_INVALIDATED                                         Win32Error = 0xbf5
+	ERROR_APPEXEC_INVALID_HOST_GENERATION                                    Win32Error = 0xbf6
+	ERROR_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION                            Win32Error = 0xbf7
+	ERROR_APPEXEC_INVALID_HOST_STATE                                         Win32Error = 0xbf8
+	ERROR_APPEXEC_NO_DONOR                                                   Win32Error = 0xbf9
+	ERROR_APPEXEC_HOST_ID_MISMATCH                                           Win32Error = 0xbfa
+	ERROR_APPEXEC_UNKNOWN_USER                                               Win32Error = 0xbfb
+	ERROR_APPEXEC_APP_COMPAT_BLOCK                                           Win32Error = 0xbfc
+	ERROR_APPEXEC_CALLER_WAIT_TIMEOUT                                        Win32Error = 0xbfd
+	ERROR_APPEXEC_CALLER_WAIT_TIMEOUT_TERMINATION                            Win32Error = 0xbfe
+	ERROR_APPEXEC_CALLER_WAIT_TIMEOUT_LICENSING                              Win32Error = 0xbff
+	ERROR_APPEXEC_CALLER_WAIT_TIMEOUT_RESOURCES                              Win32Error = 0xc00
+	ERROR_VRF_VOLATILE_CFG_AND_IO_ENABLED                                    Win32Error = 0xc08
+	ERROR_VRF_VOLATILE_NOT_STOPPABLE                                         Win32Error = 0xc09
+	ERROR_VRF_VOLATILE_SAFE_MODE                                             Win32Error = 0xc0a
+	ERROR_VRF_VOLATILE_NOT_RUNNABLE_SYSTEM                                   Win32Error = 0xc0b
+	ERROR_VRF_VOLATILE_NOT_SUPPORTED_RULECLASS                               Win32Error = 0xc0c
+	ERROR_VRF_VOLATILE_PROTECTED_DRIVER                                      Win32Error = 0xc0d
+	ERROR_VRF_VOLATILE_NMI_REGISTERED                                        Win32Error = 0xc0e
+	ERROR_VRF_VOLATILE_SETTINGS_CONFLICT                                     Win32Error = 0xc0f
+	ERROR_DIF_IOCALLBACK_NOT_REPLACED                                        Win32Error = 0xc76
+	ERROR_DIF_LIVEDUMP_LIMIT_EXCEEDED                                        Win32Error = 0xc77
+	ERROR_DIF_VOLATILE_SECTION_NOT_LOCKED                                    Win32Error = 0xc78
+	ERROR_DIF_VOLATILE_DRIVER_HOTPATCHED                                     Win32Error = 0xc79
+	ERROR_DIF_VOLATILE_INVALID_INFO                                          Win32Error = 0xc7a
+	ERROR_DIF_VOLATILE_DRIVER_IS_NOT_RUNNING                                 Win32Error = 0xc7b
+	ERROR_DIF_VOLATILE_PLUGIN_IS_NOT_RUNNING                                 Win32Error = 0xc7c
+	ERROR_DIF_VOLATILE_PLUGIN_CHANGE_NOT_ALLOWED                             Win32Error = 0xc7d
+	ERROR_DIF_VOLATILE_NOT_ALLOWED                                           Win32Error = 0xc7e
+	ERROR_DIF_BINDING_API_NOT_FOUND                                          Win32Error = 0xc7f
+	ERROR_IO_REISSUE_AS_CACHED                                               Win32Error = 0xf6e
+	ERROR_WMI_ALREADY_DISABLED                                               Win32Error = 0x1074
+	ERROR_WMI_READ_ONLY                                                      Win32Error = 0x1075
+	ERROR_WMI_SET_FAILURE                                                    Win32Error = 0x1076
+	ERROR_INVALID_DRIVE_OBJECT                                               Win32Error = 0x10e1
+	ERROR_LIBRARY_FULL                                                       Win32Error = 0x10e2
+	ERROR_MEDIUM_NOT_ACCESSIBLE                                              Win32Error = 0x10e3
+	ERROR_UNABLE_TO_LOAD_MEDIUM                                              Win32Error = 0x10e4
+	ERROR_UNABLE_TO_INVENTORY_DRIVE                                          Win32Error = 0x10e5
+	ERROR_UNABLE_TO_INVENTORY_SLOT                                           Win32Error = 0x10e6
+	ERROR_UNABLE_TO_INVENTORY_TRANSPORT                                      Win32Error = 0x10e7
+	ERROR_TRANSPORT_FULL                                                     Win32Error = 0x10e8
+	ERROR_CONTROLLING_IEPORT                                                 Win32Error = 0x10e9
+	ERROR_UNABLE_TO_EJECT_MOUNTED_MEDIA                                      Win32Error = 0x10ea
+	ERROR_CLEANER_SLOT_SET                                                   Win32Error = 0x10eb
+	ERROR_CLEANER_SLOT_NOT_SET                                               Win32Error = 0x10ec
+	ERROR_CLEANER_CARTRIDGE_SPENT                                            Win32Error = 0x10ed
+	ERROR_UNEXPECTED_OMID                                                    Win32Error = 0x10ee
+	ERROR_CANT_DELETE_LAST_ITEM                                              Win32Error = 0x10ef
+	ERROR_MESSAGE_EXCEEDS_MAX_SIZE                                           Win32Error = 0x10f0
+	ERROR_VOLUME_CONTAINS_SYS_FILES                                          Win32Error = 0x10f1
+	ERROR_INDIGENOUS_TYPE                                                    Win32Error = 0x10f2
+	ERROR_NO_SUPPORTING_DRIVES                                               Win32Error = 0x10f3
+	ERROR_CLEANER_CARTRIDGE_INSTALLED                                        Win32Error = 0x10f4
+	ERROR_IEPORT_FULL                                                        Win32Error = 0x10f5
+	ERROR_INVALID_REPARSE_DATA                                               Win32Error = 0x1128
+	ERROR_REPARSE_TAG_INVALID                                                Win32Error = 0x1129
+	ERROR_REPARSE_TAG_MISMATCH                                               Win32Error = 0x112a
+	ERROR_REPARSE_POINT_ENCOUNTERED                                          Win32Error = 0x112b
+	ERROR_APP_DATA_NOT_FOUND                                                 Win32Error = 0x1130
+	ERROR_APP_DATA_EXPIRED                                                   Win32Error = 0x1131
+	ERROR_APP_DATA_CORRUPT                                                   Win32Error = 0x1132
+	ERROR_APP_DATA_LIMIT_EXCEEDED                                            Win32Error = 0x1133
+	ERROR_APP_DATA_REBOOT_REQUIRED                                           Win32Error = 0x1134
+	ERROR_SECUREBOOT_ROLLBACK_DETECTED                                       Win32Error = 0x1144
+	ERROR_SECUREBOOT_POLICY_VIOLATION                                        Win32Error = 0x1145
+	ERROR_SECUREBOOT_INVALID_POLICY                                          Win32Error = 0x1146
+	ERROR_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND                              Win32Error = 0x1147
+	ERROR_SECUREBOOT_POLICY_NOT_SIGNED                                       Win32Error = 0x1148
+	ERROR_SECUREBOOT_NOT_ENABLED                                             Win32Error = 0x1149
+	ERROR_SECUREBOOT_FILE_REPLACED                                           Win32Error = 0x114a
+	ERROR_SECUREBOOT_POLICY_NOT_AUTHORIZED                                   Win32Error = 0x114b
+	ERROR_SECUREBOOT_POLICY_UNKNOWN                                          Win32Error = 0x114c
+	ERROR_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION                      Win32Error = 0x114d
+	ERROR_SECUREBOOT_PLATFORM_ID_MISMATCH                                    Win32Error = 0x114e
+	ERROR_SECUREBOOT_POLICY_ROLLBACK_DETECTED                                Win32Error = 0x114f
+	ERROR_SECUREBOOT_POLICY_UPGRADE_MISMATCH                                 Win32Error = 0x1150
+	ERROR_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING                            Win32Error = 0x1151
+	ERROR_SECUREBOOT_NOT_BASE_POLICY                                         Win32Error = 0x1152
+	ERROR_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY                                 Win32Error = 0x1153
+	ERROR_OFFLOAD_READ_FLT_NOT_SUPPORTED                                     Win32Error = 0x1158
+	ERROR_OFFLOAD_WRITE_FLT_NOT_SUPPORTED                                    Win32Error = 0x1159
+	ERROR_OFFLOAD_READ_FILE_NOT_SUPPORTED                                    Win32Error = 0x115a
+	ERROR_OFFLOAD_WRITE_FILE_NOT_SUPPORTED                                   Win32Error = 0x115b
+	ERROR_ALREADY_HAS_STREAM_ID                                              Win32Error = 0x115c
+	ERROR_SMR_GARBAGE_COLLECTION_REQUIRED                                    Win32Error = 0x115d
+	ERROR_WOF_WIM_HEADER_CORRUPT                                             Win32Error = 0x115e
+	ERROR_WOF_WIM_RESOURCE_TABLE_CORRUPT                                     Win32Error = 0x115f
+	ERROR_WOF_FILE_RESOURCE_TABLE_CORRUPT                                    Win32Error = 0x1160
+	ERROR_OBJECT_IS_IMMUTABLE                                                Win32Error = 0x1161
+	ERROR_VOLUME_NOT_SIS_ENABLED                                             Win32Error = 0x1194
+	ERROR_QUORUM_OWNER_ALIVE                                                 Win32Error = 0x13aa
+	ERROR_NETWORK_NOT_AVAILABLE                                              Win32Error = 0x13ab
+	ERROR_NODE_NOT_AVAILABLE                                                 Win32Error = 0x13ac
+	ERROR_ALL_NODES_NOT_AVAILABLE                                            Win32Error = 0x13ad
+	ERROR_RESOURCE_FAILED                                                    Win32Error = 0x13ae
+	ERROR_CLUSTER_INVALID_NODE                                               Win32Error = 0x13af
+	ERROR_CLUSTER_NODE_EXISTS                                                Win32Error = 0x13b0
+	ERROR_CLUSTER_JOIN_IN_PROGRESS                                           Win32Error = 0x13b1
+	ERROR_CLUSTER_NODE_NOT_FOUND                                             Win32Error = 0x13b2
+	ERROR_CLUSTER_LOCAL_NODE_NOT_FOUND                                       Win32Error = 0x13b3
+	ERROR_CLUSTER_NETWORK_EXISTS                                             Win32Error = 0x13b4
+	ERROR_CLUSTER_NETWORK_NOT_FOUND                                          Win32Error = 0x13b5
+	ERROR_CLUSTER_NETINTERFACE_EXISTS                                        Win32Error = 0x13b6
+	ERROR_CLUSTER_NETINTERFACE_NOT_FOUND                                     Win32Error = 0x13b7
+	ERROR_CLUSTER_INVALID_REQUEST                                            Win32Error = 0x13b8
+	ERROR_CLUSTER_INVALID_NETWORK_PROVIDER                                   Win32Error = 0x13b9
+	ERROR_CLUSTER_NODE_DOWN                                                  Win32Error = 0x13ba
+	ERROR_CLUSTER_NODE_UNREACHABLE                                           Win32Error = 0x13bb
+	ERROR_CLUSTER_NODE_NOT_MEMBER                                            Win32Error = 0x13bc
+	ERROR_CLUSTER_JOIN_NOT_IN_PROGRESS                                       Win32Error = 0x13bd
+	ERROR_CLUSTER_INVALID_NETWORK                                            Win32Error = 0x13be
+	ERROR_CLUSTER_NODE_UP                                                    Win32Error = 0x13c0
+	ERROR_CLUSTER_IPADDR_IN_USE                                              Win32Error = 0x13c1
+	ERROR_CLUSTER_NODE_NOT_PAUSED                                            Win32Error = 0x13c2
+	ERROR_CLUSTER_NO_SECURITY_CONTEXT                                        Win32Error = 0x13c3
+	ERROR_CLUSTER_NETWORK_NOT_INTERNAL                                       Win32Error = 0x13c4
+	ERROR_CLUSTER_NODE_ALREADY_UP                                            Win32Error = 0x13c5
+	ERROR_CLUSTER_NODE_ALREADY_DOWN                                          Win32Error = 0x13c6
+	ERROR_CLUSTER_NETWORK_ALREADY_ONLINE                                     Win32Error = 0x13c7
+	ERROR_CLUSTER_NETWORK_ALREADY_OFFLINE                                    Win32Error = 0x13c8
+	ERROR_CLUSTER_NODE_ALREADY_MEMBER                                        Win32Error = 0x13c9
+	ERROR_CLUSTER_LAST_INTERNAL_NETWORK                                      Win32Error = 0x13ca
+	ERROR_CLUSTER_NETWORK_HAS_DEPENDENTS                                     Win32Error = 0x13cb
+	ERROR_INVALID_OPERATION_ON_QUORUM                                        Win32Error = 0x13cc
+	ERROR_DEPENDENCY_NOT_ALLOWED                                             Win32Error = 0x13cd
+	ERROR_CLUSTER_NODE_PAUSED                                                Win32Error = 0x13ce
+	ERROR_NODE_CANT_HOST_RESOURCE                                            Win32Error = 0x13cf
+	ERROR_CLUSTER_NODE_NOT_READY                                             Win32Error = 0x13d0
+	ERROR_CLUSTER_NODE_SHUTTING_DOWN                                         Win32Error = 0x13d1
+	ERROR_CLUSTER_JOIN_ABORTED                                               Win32Error = 0x13d2
+	ERROR_CLUSTER_INCOMPATIBLE_VERSIONS                                      Win32Error = 0x13d3
+	ERROR_CLUSTER_MAXNUM_OF_RESOURCES_EXCEEDED                               Win32Error = 0x13d4
+	ERROR_CLUSTER_SYSTEM_CONFIG_CHANGED                                      Win32Error = 0x13d5
+	ERROR_CLUSTER_RESOURCE_TYPE_NOT_FOUND                                    Win32Error = 0x13d6
+	ERROR_CLUSTER_RESTYPE_NOT_SUPPORTED                                      Win32Error = 0x13d7
+	ERROR_CLUSTER_RESNAME_NOT_FOUND                                          Win32Error = 0x13d8
+	ERROR_CLUSTER_NO_RPC_PACKAGES_REGISTERED                                 Win32Error = 0x13d9
+	ERROR_CLUSTER_OWNER_NOT_IN_PREFLIST                                      Win32Error = 0x13da
+	ERROR_CLUSTER_DATABASE_SEQMISMATCH                                       Win32Error = 0x13db
+	ERROR_RESMON_INVALID_STATE                                               Win32Error = 0x13dc
+	ERROR_CLUSTER_GUM_NOT_LOCKER                                             Win32Error = 0x13dd
+	ERROR_QUORUM_DISK_NOT_FOUND                                              Win32Error = 0x13de
+	ERROR_DATABASE_BACKUP_CORRUPT                                            Win32Error = 0x13df
+	ERROR_CLUSTER_NODE_ALREADY_HAS_DFS_ROOT                                  Win32Error = 0x13e0
+	ERROR_RESOURCE_PROPERTY_UNCHANGEABLE                                     Win32Error = 0x13e1
+	ERROR_CLUSTER_MEMBERSHIP_INVALID_STATE                                   Win32Error = 0x1702
+	ERROR_CLUSTER_QUORUMLOG_NOT_FOUND                                        Win32Error = 0x1703
+	ERROR_CLUSTER_MEMBERSHIP_HALT                                            Win32Error = 0x1704
+	ERROR_CLUSTER_INSTANCE_ID_MISMATCH                                       Win32Error = 0x1705
+	ERROR_CLUSTER_NETWORK_NOT_FOUND_FOR_IP                                   Win32Error = 0x1706
+	ERROR_CLUSTER_PROPERTY_DATA_TYPE_MISMATCH                                Win32Error = 0x1707
+	ERROR_CLUSTER_EVICT_WITHOUT_CLEANUP                                      Win32Error = 0x1708
+	ERROR_CLUSTER_PARAMETER_MISMATCH                                         Win32Error = 0x1709
+	ERROR_NODE_CANNOT_BE_CLUSTERED                                           Win32Error = 0x170a
+	ERROR_CLUSTER_WRONG_OS_VERSION                                           Win32Error = 0x170b
+	ERROR_CLUSTER_CANT_CREATE_DUP_CLUSTER_NAME                               Win32Error = 0x170c
+	ERROR_CLUSCFG_ALREADY_COMMITTED                                          Win32Error = 0x170d
+	ERROR_CLUSCFG_ROLLBACK_FAILED                                            Win32Error = 0x170e
+	ERROR_CLUSCFG_SYSTEM_DISK_DRIVE_LETTER_CONFLICT                          Win32Error = 0x170f
+	ERROR_CLUSTER_OLD_VERSION                                                Win32Error = 0x1710
+	ERROR_CLUSTER_MISMATCHED_COMPUTER_ACCT_NAME                              Win32Error = 0x1711
+	ERROR_CLUSTER_NO_NET_ADAPTERS                                            Win32Error = 0x1712
+	ERROR_CLUSTER_POISONED                                                   Win32Error = 0x1713
+	ERROR_CLUSTER_GROUP_MOVING                                               Win32Error = 0x1714
+	ERROR_CLUSTER_RESOURCE_TYPE_BUSY                                         Win32Error = 0x1715
+	ERROR_RESOURCE_CALL_TIMED_OUT                                            Win32Error = 0x1716
+	ERROR_INVALID_CLUSTER_IPV6_ADDRESS                                       Win32Error = 0x1717
+	ERROR_CLUSTER_INTERNAL_INVALID_FUNCTION                                  Win32Error = 0x1718
+	ERROR_CLUSTER_PARAMETER_OUT_OF_BOUNDS                                    Win32Error = 0x1719
+	ERROR_CLUSTER_PARTIAL_SEND                                               Win32Error = 0x171a
+	ERROR_CLUSTER_REGISTRY_INVALID_FUNCTION                                  Win32Error = 0x171b
+	ERROR_CLUSTER_INVALID_STRING_TERMINATION                                 Win32Error = 0x171c
+	ERROR_CLUSTER_INVALID_STRING_FORMAT                                      Win32Error = 0x171d
+	ERROR_CLUSTER_DATABASE_TRANSACTION_IN_PROGRESS                           Win32Error = 0x171e
+	ERROR_CLUSTER_DATABASE_TRANSACTION_NOT_IN_PROGRESS                       Win32Error = 0x171f
+	ERROR_CLUSTER_NULL_DATA                                                  Win32Error = 0x1720
+	ERROR_CLUSTER_PARTIAL_READ                                               Win32Error = 0x1721
+	ERROR_CLUSTER_PARTIAL_WRITE                                              Win32Error = 0x1722
+	ERROR_CLUSTER_CANT_DESERIALIZE_DATA                                      Win32Error = 0x1723
+	ERROR_DEPENDENT_RESOURCE_PROPERTY_CONFLICT                               Win32Error = 0x1724
+	ERROR_CLUSTER_NO_QUORUM                                                  Win32Error = 0x1725
+	ERROR_CLUSTER_INVALID_IPV6_NETWORK                                       Win32Error = 0x1726
+	ERROR_CLUSTER_INVALID_IPV6_TUNNEL_NETWORK                                Win32Error = 0x1727
+	ERROR_QUORUM_NOT_ALLOWED_IN_THIS_GROUP                                   Win32Error = 0x1728
+	ERROR_DEPENDENCY_TREE_TOO_COMPLEX                                        Win32Error = 0x1729
+	ERROR_EXCEPTION_IN_RESOURCE_CALL                                         Win32Error = 0x172a
+	ERROR_CLUSTER_RHS_FAILED_INITIALIZATION                                  Win32Error = 0x172b
+	ERROR_CLUSTER_NOT_INSTALLED                                              Win32Error = 0x172c
+	ERROR_CLUSTER_RESOURCES_MUST_BE_ONLINE_ON_THE_SAME_NODE                  Win32Error = 0x172d
+	ERROR_CLUSTER_MAX_NODES_IN_CLUSTER                                       Win32Error = 0x172e
+	ERROR_CLUSTER_TOO_MANY_NODES                                             Win32Error = 0x172f
+	ERROR_CLUSTER_OBJECT_ALREADY_USED                                        Win32Error = 0x1730
+	ERROR_NONCORE_GROUPS_FOUND                                               Win32Error = 0x1731
+	ERROR_FILE_SHARE_RESOURCE_CONFLICT                                       Win32Error = 0x1732
+	ERROR_CLUSTER_EVICT_INVALID_REQUEST                                      Win32Error = 0x1733
+	ERROR_CLUSTER_SINGLETON_RESOURCE                                         Win32Error = 0x1734
+	ERROR_CLUSTER_GROUP_SINGLETON_RESOURCE                                   Win32Error = 0x1735
+	ERROR_CLUSTER_RESOURCE_PROVIDER_FAILED                                   Win32Error = 0x1736
+	ERROR_CLUSTER_RESOURCE_CONFIGURATION_ERROR                               Win32Error = 0x1737
+	ERROR_CLUSTER_GROUP_BUSY                                                 Win32Error = 0x1738
+	ERROR_CLUSTER_NOT_SHARED_VOLUME                                          Win32Error = 0x1739
+	ERROR_CLUSTER_INVALID_SECURITY_DESCRIPTOR                                Win32Error = 0x173a
+	ERROR_CLUSTER_SHARED_VOLUMES_IN_USE                                      Win32Error = 0x173b
+	ERROR_CLUSTER_USE_SHARED_VOLUMES_API                                     Win32Error = 0x173c
+	ERROR_CLUSTER_BACKUP_IN_PROGRESS                                         Win32Error = 0x173d
+	ERROR_NON_CSV_PATH                                                       Win32Error = 0x173e
+	ERROR_CSV_VOLUME_NOT_LOCAL                                               Win32Error = 0x173f
+	ERROR_CLUSTER_WATCHDOG_TERMINATING                                       Win32Error = 0x1740
+	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_INCOMPATIBLE_NODES                    Win32Error = 0x1741
+	ERROR_CLUSTER_INVALID_NODE_WEIGHT                                        Win32Error = 0x1742
+	ERROR_CLUSTER_RESOURCE_VETOED_CALL                                       Win32Error = 0x1743
+	ERROR_RESMON_SYSTEM_RESOURCES_LACKING                                    Win32Error = 0x1744
+	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_DESTINATION   Win32Error = 0x1745
+	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_SOURCE        Win32Error = 0x1746
+	ERROR_CLUSTER_GROUP_QUEUED                                               Win32Error = 0x1747
+	ERROR_CLUSTER_RESOURCE_LOCKED_STATUS                                     Win32Error = 0x1748
+	ERROR_CLUSTER_SHARED_VOLUME_FAILOVER_NOT_ALLOWED                         Win32Error = 0x1749
+	ERROR_CLUSTER_NODE_DRAIN_IN_PROGRESS                                     Win32Error = 0x174a
+	ERROR_CLUSTER_DISK_NOT_CONNECTED                                         Win32Error = 0x174b
+	ERROR_DISK_NOT_CSV_CAPABLE                                               Win32Error = 0x174c
+	ERROR_RESOURCE_NOT_IN_AVAILABLE_STORAGE                                  Win32Error = 0x174d
+	ERROR_CLUSTER_SHARED_VOLUME_REDIRECTED                                   Win32Error = 0x174e
+	ERROR_CLUSTER_SHARED_VOLUME_NOT_REDIRECTED                               Win32Error = 0x174f
+	ERROR_CLUSTER_CANNOT_RETURN_PROPERTIES                                   Win32Error = 0x1750
+	ERROR_CLUSTER_RESOURCE_CONTAINS_UNSUPPORTED_DIFF_AREA_FOR_SHARED_VOLUMES Win32Error = 0x1751
+	ERROR_CLUSTER_RESOURCE_IS_IN_MAINTENANCE_MODE                            Win32Error = 0x1752
+	ERROR_CLUSTER_AFFINITY_CONFLICT                                          Win32Error = 0x1753
+	ERROR_CLUSTER_RESOURCE_IS_REPLICA_VIRTUAL_MACHINE                        Win32Error = 0x1754
+	ERROR_CLUSTER_UPGRADE_INCOMPATIBLE_VERSIONS                              Win32Error = 0x1755
+	ERROR_CLUSTER_UPGRADE_FIX_QUORUM_NOT_SUPPORTED                           Win32Error = 0x1756
+	ERROR_CLUSTER_UPGRADE_RESTART_REQUIRED                                   Win32Error = 0x1757
+	ERROR_CLUSTER_UPGRADE_IN_PROGRESS                                        Win32Error = 0x1758
+	ERROR_CLUSTER_UPGRADE_INCOMPLETE                                         Win32Error = 0x1759
+	ERROR_CLUSTER_NODE_IN_GRACE_PERIOD                                       Win32Error = 0x175a
+	ERROR_CLUSTER_CSV_IO_PAUSE_TIMEOUT                                       Win32Error = 0x175b
+	ERROR_NODE_NOT_ACTIVE_CLUSTER_MEMBER                                     Win32Error = 0x175c
+	ERROR_CLUSTER_RESOURCE_NOT_MONITORED                                     Win32Error = 0x175d
+	ERROR_CLUSTER_RESOURCE_DOES_NOT_SUPPORT_UNMONITORED                      Win32Error = 0x175e
+	ERROR_CLUSTER_RESOURCE_IS_REPLICATED                                     Win32Error = 0x175f
+	ERROR_CLUSTER_NODE_ISOLATED                                              Win32Error = 0x1760
+	ERROR_CLUSTER_NODE_QUARANTINED                                           Win32Error = 0x1761
+	ERROR_CLUSTER_DATABASE_UPDATE_CONDITION_FAILED                           Win32Error = 0x1762
+	ERROR_CLUSTER_SPACE_DEGRADED                                             Win32Error = 0x1763
+	ERROR_CLUSTER_TOKEN_DELEGATION_NOT_SUPPORTED                             Win32Error = 0x1764
+	ERROR_CLUSTER_CSV_INVALID_HANDLE                                         Win32Error = 0x1765
+	ERROR_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR                          Win32Error = 0x1766
+	ERROR_GROUPSET_NOT_AVAILABLE                                             Win32Error = 0x1767
+	ERROR_GROUPSET_NOT_FOUND                                                 Win32Error = 0x1768
+	ERROR_GROUPSET_CANT_PROVIDE                                              Win32Error = 0x1769
+	ERROR_CLUSTER_FAULT_DOMAIN_PARENT_NOT_FOUND                              Win32Error = 0x176a
+	ERROR_CLUSTER_FAULT_DOMAIN_INVALID_HIERARCHY                             Win32Error = 0x176b
+	ERROR_CLUSTER_FAULT_DOMAIN_FAILED_S2D_VALIDATION                         Win32Error = 0x176c
+	ERROR_CLUSTER_FAULT_DOMAIN_S2D_CONNECTIVITY_LOSS                         Win32Error = 0x176d
+	ERROR_CLUSTER_INVALID_INFRASTRUCTURE_FILESERVER_NAME                     Win32Error = 0x176e
+	ERROR_CLUSTERSET_MANAGEMENT_CLUSTER_UNREACHABLE                          Win32Error = 0x176f
+	ERROR_FILE_READ_ONLY                                                     Win32Error = 0x1779
+	ERROR_DIR_EFS_DISALLOWED                                                 Win32Error = 0x177a
+	ERROR_EFS_SERVER_NOT_TRUSTED                                             Win32Error = 0x177b
+	ERROR_BAD_RECOVERY_POLICY                                                Win32Error = 0x177c
+	ERROR_EFS_ALG_BLOB_TOO_BIG                                               Win32Error = 0x177d
+	ERROR_VOLUME_NOT_SUPPORT_EFS                                             Win32Error = 0x177e
+	ERROR_EFS_DISABLED                                                       Win32Error = 0x177f
+	ERROR_EFS_VERSION_NOT_SUPPORT                                            Win32Error = 0x1780
+	ERROR_CS_ENCRYPTION_INVALID_SERVER_RESPONSE                              Win32Error = 0x1781
+	ERROR_CS_ENCRYPTION_UNSUPPORTED_SERVER                                   Win32Error = 0x1782
+	ERROR_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE                              Win32Error = 0x1783
+	ERROR_CS_ENCRYPTION_NEW_ENCRYPTED_FILE                                   Win32Error = 0x1784
+	ERROR_CS_ENCRYPTION_FILE_NOT_CSE                                         Win32Error = 0x1785
+	ERROR_ENCRYPTION_POLICY_DENIES_OPERATION                                 Win32Error = 0x1786
+	ERROR_WIP_ENCRYPTION_FAILED                                              Win32Error = 0x1787
+	SCHED_E_SERVICE_NOT_LOCALSYSTEM                                          Win32Error = 0x1838
+	ERROR_CLUSTER_OBJECT_IS_CLUSTER_SET_VM                                   Win32Error = 0x186a
+	ERROR_LOG_SECTOR_INVALID                                                 Win32Error = 0x19c8
+	ERROR_LOG_SECTOR_PARITY_INVALID                                          Win32Error = 0x19c9
+	ERROR_LOG_SECTOR_REMAPPED                                                Win32Error = 0x19ca
+	ERROR_LOG_BLOCK_INCOMPLETE                                               Win32Error = 0x19cb
+	ERROR_LOG_INVALID_RANGE                                                  Win32Error = 0x19cc
+	ERROR_LOG_BLOCKS_EXHAUSTED                                               Win32Error = 0x19cd
+	ERROR_LOG_READ_CONTEXT_INVALID                                           Win32Error = 0x19ce
+	ERROR_LOG_RESTART_INVALID                                                Win32Error = 0x19cf
+	ERROR_LOG_BLOCK_VERSION                                                  Win32Error = 0x19d0
+	ERROR_LOG_BLOCK_INVALID                                                  Win32Error = 0x19d1
+	ERROR_LOG_READ_MODE_INVALID                                              Win32Error = 0x19d2
+	ERROR_LOG_NO_RESTART                                                     Win32Error = 0x19d3
+	ERROR_LOG_METADATA_CORRUPT                                               Win32Error = 0x19d4
+	ERROR_LOG_METADATA_INVALID                                               Win32Error = 0x19d5
+	ERROR_LOG_METADATA_INCONSISTENT                                          Win32Error = 0x19d6
+	ERROR_LOG_RESERVATION_INVALID                                            Win32Error = 0x19d7
+	ERROR_LOG_CANT_DELETE                                                    Win32Error = 0x19d8
+	ERROR_LOG_CONTAINER_LIMIT_EXCEEDED                                       Win32Error = 0x19d9
+	ERROR_LOG_START_OF_LOG                                                   Win32Error = 0x19da
+	ERROR_LOG_POLICY_ALREADY_INSTALLED                                       Win32Error = 0x19db
+	ERROR_LOG_POLICY_NOT_INSTALLED                                           Win32Error = 0x19dc
+	ERROR_LOG_POLICY_INVALID                                                 Win32Error = 0x19dd
+	ERROR_LOG_POLICY_CONFLICT                                                Win32Error = 0x19de
+	ERROR_LOG_PINNED_ARCHIVE_TAIL                                            Win32Error = 0x19df
+	ERROR_LOG_RECORD_NONEXISTENT                                             Win32Error = 0x19e0
+	ERROR_LOG_RECORDS_RESERVED_INVALID                                       Win32Error = 0x19e1
+	ERROR_LOG_SPACE_RESERVED_INVALID                                         Win32Error = 0x19e2
+	ERROR_LOG_TAIL_INVALID                                                   Win32Error = 0x19e3
+	ERROR_LOG_FULL                                                           Win32Error = 0x19e4
+	ERROR_COULD_NOT_RESIZE_LOG                                               Win32Error = 0x19e5
+	ERROR_LOG_MULTIPLEXED                                                    Win32Error = 0x19e6
+	ERROR_LOG_DEDICATED                                                      Win32Error = 0x19e7
+	ERROR_LOG_ARCHIVE_NOT_IN_PROGRESS                                        Win32Error = 0x19e8
+	ERROR_LOG_ARCHIVE_IN_PROGRESS                                            Win32Error = 0x19e9
+	ERROR_LOG_EPHEMERAL                                                      Win32Error = 0x19ea
+	ERROR_LOG_NOT_ENOUGH_CONTAINERS                                          Win32Error = 0x19eb
+	ERROR_LOG_CLIENT_ALREADY_REGISTERED                                      Win32Error = 0x19ec
+	ERROR_LOG_CLIENT_NOT_REGISTERED                                          Win32Error = 0x19ed
+	ERROR_LOG_FULL_HANDLER_IN_PROGRESS                                       Win32Error = 0x19ee
+	ERROR_LOG_CONTAINER_READ_FAILED                                          Win32Error = 0x19ef
+	ERROR_LOG_CONTAINER_WRITE_FAILED                                         Win32Error = 0x19f0
+	ERROR_LOG_CONTAINER_OPEN_FAILED                                          Win32Error = 0x19f1
+	ERROR_LOG_CONTAINER_STATE_INVALID                                        Win32Error = 0x19f2
+	ERROR_LOG_STATE_INVALID                                                  Win32Error = 0x19f3
+	ERROR_LOG_PINNED                                                         Win32Error = 0x19f4
+	ERROR_LOG_METADATA_FLUSH_FAILED                                          Win32Error = 0x19f5
+	ERROR_LOG_INCONSISTENT_SECURITY                                          Win32Error = 0x19f6
+	ERROR_LOG_APPENDED_FLUSH_FAILED                                          Win32Error = 0x19f7
+	ERROR_LOG_PINNED_RESERVATION                                             Win32Error = 0x19f8
+	ERROR_INVALID_TRANSACTION                                                Win32Error = 0x1a2c
+	ERROR_TRANSACTION_NOT_ACTIVE                                             Win32Error = 0x1a2d
+	ERROR_TRANSACTION_REQUEST_NOT_VALID                                      Win32Error = 0x1a2e
+	ERROR_TRANSACTION_NOT_REQUESTED                                          Win32Error = 0x1a2f
+	ERROR_TRANSACTION_ALREADY_ABORTED                                        Win32Error = 0x1a30
+	ERROR_TRANSACTION_ALREADY_COMMITTED                                      Win32Error = 0x1a31
+	ERROR_TM_INITIALIZATION_FAILED                                           Win32Error = 0x1a32
+	ERROR_RESOURCEMANAGER_READ_ONLY                                          Win32Error = 0x1a33
+	ERROR_TRANSACTION_NOT_JOINED                                             Win32Error = 0x1a34
+	ERROR_TRANSACTION_SUPERIOR_EXISTS                                        Win32Error = 0x1a35
+	ERROR_CRM_PROTOCOL_ALREADY_EXISTS                                        Win32Error = 0x1a36
+	ERROR_TRANSACTION_PROPAGATION_FAILED                                     Win32Error = 0x1a37
+	ERROR_CRM_PROTOCOL_NOT_FOUND                                             Win32Error = 0x1a38
+	ERROR_TRANSACTION_INVALID_MARSHALL_BUFFER                                Win32Error = 0x1a39
+	ERROR_CURRENT_TRANSACTION_NOT_VALID                                      Win32Error = 0x1a3a
+	ERROR_TRANSACTION_NOT_FOUND                                              Win32Error = 0x1a3b
+	ERROR_RESOURCEMANAGER_NOT_FOUND                                          Win32Error = 0x1a3c
+	ERROR_ENLISTMENT_NOT_FOUND                                               Win32Error = 0x1a3d
+	ERROR_TRANSACTIONMANAGER_NOT_FOUND                                       Win32Error = 0x1a3e
+	ERROR_TRANSACTIONMANAGER_NOT_ONLINE                                      Win32Error = 0x1a3f
+	ERROR_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION                         Win32Error = 0x1a40
+	ERROR_TRANSACTION_NOT_ROOT                                               Win32Error = 0x1a41
+	ERROR_TRANSACTION_OBJECT_EXPIRED                                         Win32Error = 0x1a42
+	ERROR_TRANSACTION_RESPONSE_NOT_ENLISTED                                  Win32Error = 0x1a43
+	ERROR_TRANSACTION_RECORD_TOO_LONG                                        Win32Error = 0x1a44
+	ERROR_IMPLICIT_TRANSACTION_NOT_SUPPORTED                                 Win32Error = 0x1a45
+	ERROR_TRANSACTION_INTEGRITY_VIOLATED                                     Win32Error = 0x1a46
+	ERROR_TRANSACTIONMANAGER_IDENTITY_MISMATCH                               Win32Error = 0x1a47
+	ERROR_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT                                   Win32Error = 0x1a48
+	ERROR_TRANSACTION_MUST_WRITETHROUGH                                      Win32Error = 0x1a49
+	ERROR_TRANSACTION_NO_SUPERIOR                                            Win32Error = 0x1a4a
+	ERROR_HEURISTIC_DAMAGE_POSSIBLE                                          Win32Error = 0x1a4b
+	ERROR_TRANSACTIONAL_CONFLICT                                             Win32Error = 0x1a90
+	ERROR_RM_NOT_ACTIVE                                                      Win32Error = 0x1a91
+	ERROR_RM_METADATA_CORRUPT                                                Win32Error = 0x1a92
+	ERROR_DIRECTORY_NOT_RM                                                   Win32Error = 0x1a93
+	ERROR_TRANSACTIONS_UNSUPPORTED_REMOTE                                    Win32Error = 0x1a95
+	ERROR_LOG_RESIZE_INVALID_SIZE                                            Win32Error = 0x1a96
+	ERROR_OBJECT_NO_LONGER_EXISTS                                            Win32Error = 0x1a97
+	ERROR_STREAM_MINIVERSION_NOT_FOUND                                       Win32Error = 0x1a98
+	ERROR_STREAM_MINIVERSION_NOT_VALID                                       Win32Error = 0x1a99
+	ERROR_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION                Win32Error = 0x1a9a
+	ERROR_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT                           Win32Error = 0x1a9b
+	ERROR_CANT_CREATE_MORE_STREAM_MINIVERSIONS                               Win32Error = 0x1a9c
+	ERROR_REMOTE_FILE_VERSION_MISMATCH                                       Win32Error = 0x1a9e
+	ERROR_HANDLE_NO_LONGER_VALID                                             Win32Error = 0x1a9f
+	ERROR_NO_TXF_METADATA                                                    Win32Error = 0x1aa0
+	ERROR_LOG_CORRUPTION_DETECTED                                            Win32Error = 0x1aa1
+	ERROR_CANT_RECOVER_WITH_HANDLE_OPEN                                      Win32Error = 0x1aa2
+	ERROR_RM_DISCONNECTED                                                    Win32Error = 0x1aa3
+	ERROR_ENLISTMENT_NOT_SUPERIOR                                            Win32Error = 0x1aa4
+	ERROR_RECOVERY_NOT_NEEDED                                                Win32Error = 0x1aa5
+	ERROR_RM_ALREADY_STARTED                                                 Win32Error = 0x1aa6
+	ERROR_FILE_IDENTITY_NOT_PERSISTENT                                       Win32Error = 0x1aa7
+	ERROR_CANT_BREAK_TRANSACTIONAL_DEPENDENCY                                Win32Error = 0x1aa8
+	ERROR_CANT_CROSS_RM_BOUNDARY                                             Win32Error = 0x1aa9
+	ERROR_TXF_DIR_NOT_EMPTY                                                  Win32Error = 0x1aaa
+	ERROR_INDOUBT_TRANSACTIONS_EXIST                                         Win32Error = 0x1aab
+	ERROR_TM_VOLATILE                                                        Win32Error = 0x1aac
+	ERROR_ROLLBACK_TIMER_EXPIRED                                             Win32Error = 0x1aad
+	ERROR_TXF_ATTRIBUTE_CORRUPT                                              Win32Error = 0x1aae
+	ERROR_EFS_NOT_ALLOWED_IN_TRANSACTION                                     Win32Error = 0x1aaf
+	ERROR_TRANSACTIONAL_OPEN_NOT_ALLOWED                                     Win32Error = 0x1ab0
+	ERROR_LOG_GROWTH_FAILED                                                  Win32Error = 0x1ab1
+	ERROR_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE                              Win32Error = 0x1ab2
+	ERROR_TXF_METADATA_ALREADY_PRESENT                                       Win32Error = 0x1ab3
+	ERROR_TRANSACTION_SCOPE_CALLBACKS_NOT_SET                                Win32Error = 0x1ab4
+	ERROR_TRANSACTION_REQUIRED_PROMOTION                                     Win32Error = 0x1ab5
+	ERROR_CANNOT_EXECUTE_FILE_IN_TRANSACTION                                 Win32Error = 0x1ab6
+	ERROR_TRANSACTIONS_NOT_FROZEN                                            Win32Error = 0x1ab7
+	ERROR_TRANSACTION_FREEZE_IN_PROGRESS                                     Win32Error = 0x1ab8
+	ERROR_NOT_SNAPSHOT_VOLUME                                                Win32Error = 0x1ab9
+	ERROR_NO_SAVEPOINT_WITH_OPEN_FILES                                       Win32Error = 0x1aba
+	ERROR_DATA_LOST_REPAIR                                                   Win32Error = 0x1abb
+	ERROR_SPARSE_NOT_ALLOWED_IN_TRANSACTION                                  Win32Error = 0x1abc
+	ERROR_TM_IDENTITY_MISMATCH                                               Win32Error = 0x1abd
+	ERROR_FLOATED_SECTION                                                    Win32Error = 0x1abe
+	ERROR_CANNOT_ACCEPT_TRANSACTED_WORK                                      Win32Error = 0x1abf
+	ERROR_CANNOT_ABORT_TRANSACTIONS                                          Win32Error = 0x1ac0
+	ERROR_BAD_CLUSTERS                                                       Win32Error = 0x1ac1
+	ERROR_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION                             Win32Error = 0x1ac2
+	ERROR_VOLUME_DIRTY                                                       Win32Error = 0x1ac3
+	ERROR_NO_LINK_TRACKING_IN_TRANSACTION                                    Win32Error = 0x1ac4
+	ERROR_OPERATION_NOT_SUPPORTED_IN_TRANSACTION                             Win32Error = 0x1ac5
+	ERROR_EXPIRED_HANDLE                                                     Win32Error = 0x1ac6
+	ERROR_TRANSACTION_NOT_ENLISTED                                           Win32Error = 0x1ac7
+	ERROR_CTX_WINSTATION_NAME_INVALID                                        Win32Error = 0x1b59
+	ERROR_CTX_INVALID_PD                                                     Win32Error = 0x1b5a
+	ERROR_CTX_PD_NOT_FOUND                                                   Win32Error = 0x1b5b
+	ERROR_CTX_WD_NOT_FOUND                                                   Win32Error = 0x1b5c
+	ERROR_CTX_CANNOT_MAKE_EVENTLOG_ENTRY                                     Win32Error = 0x1b5d
+	ERROR_CTX_SERVICE_NAME_COLLISION                                         Win32Error = 0x1b5e
+	ERROR_CTX_CLOSE_PENDING                                                  Win32Error = 0x1b5f
+	ERROR_CTX_NO_OUTBUF                                                      Win32Error = 0x1b60
+	ERROR_CTX_MODEM_INF_NOT_FOUND                                            Win32Error = 0x1b61
+	ERROR_CTX_INVALID_MODEMNAME                                              Win32Error = 0x1b62
+	ERROR_CTX_MODEM_RESPONSE_ERROR                                           Win32Error = 0x1b63
+	ERROR_CTX_MODEM_RESPONSE_TIMEOUT                                         Win32Error = 0x1b64
+	ERROR_CTX_MODEM_RESPONSE_NO_CARRIER                                      Win32Error = 0x1b65
+	ERROR_CTX_MODEM_RESPONSE_NO_DIALTONE                                     Win32Error = 0x1b66
+	ERROR_CTX_MODEM_RESPONSE_BUSY                                            Win32Error = 0x1b67
+	ERROR_CTX_MODEM_RESPONSE_VOICE                                           Win32Error = 0x1b68
+	ERROR_CTX_TD_ERROR                                                       Win32Error = 0x1b69
+	ERROR_CTX_WINSTATION_NOT_FOUND                                           Win32Error = 0x1b6e
+	ERROR_CTX_WINSTATION_ALREADY_EXISTS                                      Win32Error = 0x1b6f
+	ERROR_CTX_WINSTATION_BUSY                                                Win32Error = 0x1b70
+	ERROR_CTX_BAD_VIDEO_MODE                                                 Win32Error = 0x1b71
+	ERROR_CTX_GRAPHICS_INVALID                                               Win32Error = 0x1b7b
+	ERROR_CTX_LOGON_DISABLED                                                 Win32Error = 0x1b7d
+	ERROR_CTX_NOT_CONSOLE                                                    Win32Error = 0x1b7e
+	ERROR_CTX_CLIENT_QUERY_TIMEOUT                                           Win32Error = 0x1b80
+	ERROR_CTX_CONSOLE_DISCONNECT                                             Win32Error = 0x1b81
+	ERROR_CTX_CONSOLE_CONNECT                                                Win32Error = 0x1b82
+	ERROR_CTX_SHADOW_DENIED                                                  Win32Error = 0x1b84
+	ERROR_CTX_WINSTATION_ACCESS_DENIED                                       Win32Error = 0x1b85
+	ERROR_CTX_INVALID_WD                                                     Win32Error = 0x1b89
+	ERROR_CTX_SHADOW_INVALID                                                 Win32Error = 0x1b8a
+	ERROR_CTX_SHADOW_DISABLED                                                Win32Error = 0x1b8b
+	ERROR_CTX_CLIENT_LICENSE_IN_USE                                          Win32Error = 0x1b8c
+	ERROR_CTX_CLIENT_LICENSE_NOT_SET                                         Win32Error = 0x1b8d
+	ERROR_CTX_LICENSE_NOT_AVAILABLE                                          Win32Error = 0x1b8e
+	ERROR_CTX_LICENSE_CLIENT_INVALID                                         Win32Error = 0x1b8f
+	ERROR_CTX_LICENSE_EXPIRED                                                Win32Error = 0x1b90
+	ERROR_CTX_SHADOW_NOT_RUNNING                                             Win32Error = 0x1b91
+	ERROR_CTX_SHADOW_ENDED_BY_MODE_CHANGE                                    Win32Error = 0x1b92
+	ERROR_ACTIVATION_COUNT_EXCEEDED                                          Win32Error = 0x1b93
+	ERROR_CTX_WINSTATIONS_DISABLED                                           Win32Error = 0x1b94
+	ERROR_CTX_ENCRYPTION_LEVEL_REQUIRED                                      Win32Error = 0x1b95
+	ERROR_CTX_SESSION_IN_USE                                                 Win32Error = 0x1b96
+	ERROR_CTX_NO_FORCE_LOGOFF                                                Win32Error = 0x1b97
+	ERROR_CTX_ACCOUNT_RESTRICTION                                            Win32Error = 0x1b98
+	ERROR_RDP_PROTOCOL_ERROR                                                 Win32Error = 0x1b99
+	ERROR_CTX_CDM_CONNECT                                                    Win32Error = 0x1b9a
+	ERROR_CTX_CDM_DISCONNECT                                                 Win32Error = 0x1b9b
+	ERROR_CTX_SECURITY_LAYER_ERROR                                           Win32Error = 0x1b9c
+	ERROR_TS_INCOMPATIBLE_SESSIONS                                           Win32Error = 0x1b9d
+	ERROR_TS_VIDEO_SUBSYSTEM_ERROR                                           Win32Error = 0x1b9e
+	FRS_ERR_INVALID_API_SEQUENCE                                             Win32Error = 0x1f41
+	FRS_ERR_STARTING_SERVICE                                                 Win32Error = 0x1f42
+	FRS_ERR_STOPPING_SERVICE                                                 Win32Error = 0x1f43
+	FRS_ERR_INTERNAL_API                                                     Win32Error = 0x1f44
+	FRS_ERR_INTERNAL                                                         Win32Error = 0x1f45
+	FRS_ERR_SERVICE_COMM                                                     Win32Error = 0x1f46
+	FRS_ERR_INSUFFICIENT_PRIV                                                Win32Error = 0x1f47
+	FRS_ERR_AUTHENTICATION                                                   Win32Error = 0x1f48
+	FRS_ERR_PARENT_INSUFFICIENT_PRIV                                         Win32Error = 0x1f49
+	FRS_ERR_PARENT_AUTHENTICATION                                            Win32Error = 0x1f4a
+	FRS_ERR_CHILD_TO_PARENT_COMM                                             Win32Error = 0x1f4b
+	FRS_ERR_PARENT_TO_CHILD_COMM                                             Win32Error = 0x1f4c
+	FRS_ERR_SYSVOL_POPULATE                                                  Win32Error = 0x1f4d
+	FRS_ERR_SYSVOL_POPULATE_TIMEOUT                                          Win32Error = 0x1f4e
+	FRS_ERR_SYSVOL_IS_BUSY                                                   Win32Error = 0x1f4f
+	FRS_ERR_SYSVOL_DEMOTE                                                    Win32Error = 0x1f50
+	FRS_ERR_INVALID_SERVICE_PARAMETER                                        Win32Error = 0x1f51
+	ERROR_SHARED_POLICY                                                      Win32Error = 0x201a
+	ERROR_POLICY_OBJECT_NOT_FOUND                                            Win32Error = 0x201b
+	ERROR_POLICY_ONLY_IN_DS                                                  Win32Error = 0x201c
+	ERROR_PROMOTION_ACTIVE                                                   Win32Error = 0x201d
+	ERROR_NO_PROMOTION_ACTIVE                                                Win32Error = 0x201e
+	ERROR_DS_OPERATIONS_ERROR                                                Win32Error = 0x2020
+	ERROR_DS_PROTOCOL_ERROR                                                  Win32Error = 0x2021
+	ERROR_DS_TIMELIMIT_EXCEEDED                                              Win32Error = 0x2022
+	ERROR_DS_SIZELIMIT_EXCEEDED                                              Win32Error = 0x2023
+	ERROR_DS_ADMIN_LIMIT_EXCEEDED                                            Win32Error = 0x2024
+	ERROR_DS_COMPARE_FALSE                                                   Win32Error = 0x2025
+	ERROR_DS_COMPARE_TRUE                                                    Win32Error = 0x2026
+	ERROR_DS_AUTH_METHOD_NOT_SUPPORTED                                       Win32Error = 0x2027
+	ERROR_DS_STRONG_AUTH_REQUIRED                                            Win32Error = 0x2028
+	ERROR_DS_INAPPROPRIATE_AUTH                                              Win32Error = 0x2029
+	ERROR_DS_AUTH_UNKNOWN                                                    Win32Error = 0x202a
+	ERROR_DS_REFERRAL                                                        Win32Error = 0x202b
+	ERROR_DS_UNAVAILABLE_CRIT_EXTENSION                                      Win32Error = 0x202c
+	ERROR_DS_CONFIDENTIALITY_REQUIRED                                        Win32Error = 0x202d
+	ERROR_DS_INAPPROPRIATE_MATCHING                                          Win32Error = 0x202e
+	ERROR_DS_CONSTRAINT_VIOLATION                                            Win32Error = 0x202f
+	ERROR_DS_NO_SUCH_OBJECT                                                  Win32Error = 0x2030
+	ERROR_DS_ALIAS_PROBLEM                                                   Win32Error = 0x2031
+	ERROR_DS_INVALID_DN_SYNTAX                                               Win32Error = 0x2032
+	ERROR_DS_IS_LEAF                                                         Win32Error = 0x2033
+	ERROR_DS_ALIAS_DEREF_PROBLEM                                             Win32Error = 0x2034
+	ERROR_DS_UNWILLING_TO_PERFORM                                            Win32Error = 0x2035
+	ERROR_DS_LOOP_DETECT                                                     Win32Error = 0x2036
+	ERROR_DS_NAMING_VIOLATION                                                Win32Error = 0x2037
+	ERROR_DS_OBJECT_RESULTS_TOO_LARGE                                        Win32Error = 0x2038
+	ERROR_DS_AFFECTS_MULTIPLE_DSAS                                           Win32Error = 0x2039
+	ERROR_DS_SERVER_DOWN                                                     Win32Error = 0x203a
+	ERROR_DS_LOCAL_ERROR                                                     Win32Error = 0x203b
+	ERROR_DS_ENCODING_ERROR                                                  Win32Error = 0x203c
+	ERROR_DS_DECODING_ERROR                                                  Win32Error = 0x203d
+	ERROR_DS_FILTER_UNKNOWN                                                  Win32Error = 0x203e
+	ERROR_DS_PARAM_ERROR                                                     Win32Error = 0x203f
+	ERROR_DS_NOT_SUPPORTED                                                   Win32Error = 0x2040
+	ERROR_DS_NO_RESULTS_RETURNED                                             Win32Error = 0x2041
+	ERROR_DS_CONTROL_NOT_FOUND                                               Win32Error = 0x2042
+	ERROR_DS_CLIENT_LOOP                                                     Win32Error = 0x2043
+	ERROR_DS_REFERRAL_LIMIT_EXCEEDED                                         Win32Error = 0x2044
+	ERROR_DS_SORT_CONTROL_MISSING                                            Win32Error = 0x2045
+	ERROR_DS_OFFSET_RANGE_ERROR                                              Win32Error = 0x2046
+	ERROR_DS_ROOT_MUST_BE_NC                                                 Win32Error = 0x206d
+	ERROR_DS_ADD_REPLICA_INHIBITED                                           Win32Error = 0x206e
+	ERROR_DS_ATT_NOT_DEF_IN_SCHEMA                                           Win32Error = 0x206f
+	ERROR_DS_MAX_OBJ_SIZE_EXCEEDED                                           Win32Error = 0x2070
+	ERROR_DS_OBJ_STRING_NAME_EXISTS                                          Win32Error = 0x2071
+	ERROR_DS_NO_RDN_DEFINED_IN_SCHEMA                                        Win32Error = 0x2072
+	ERROR_DS_RDN_DOESNT_MATCH_SCHEMA                                         Win32Error = 0x2073
+	ERROR_DS_NO_REQUESTED_ATTS_FOUND                                         Win32Error = 0x2074
+	ERROR_DS_USER_BUFFER_TO_SMALL                                            Win32Error = 0x2075
+	ERROR_DS_ATT_IS_NOT_ON_OBJ                                               Win32Error = 0x2076
+	ERROR_DS_ILLEGAL_MOD_OPERATION                                           Win32Error = 0x2077
+	ERROR_DS_OBJ_TOO_LARGE                                                   Win32Error = 0x2078
+	ERROR_DS_BAD_INSTANCE_TYPE                                               Win32Error = 0x2079
+	ERROR_DS_MASTERDSA_REQUIRED                                              Win32Error = 0x207a
+	ERROR_DS_OBJECT_CLASS_REQUIRED                                           Win32Error = 0x207b
+	ERROR_DS_MISSING_REQUIRED_ATT                                            Win32Error = 0x207c
+	ERROR_DS_ATT_NOT_DEF_FOR_CLASS                                           Win32Error = 0x207d
+	ERROR_DS_ATT_ALREADY_EXISTS                                              Win32Error = 0x207e
+	ERROR_DS_CANT_ADD_ATT_VALUES                                             Win32Error = 0x2080
+	ERROR_DS_SINGLE_VALUE_CONSTRAINT                                         Win32Error = 0x2081
+	ERROR_DS_RANGE_CONSTRAINT                                                Win32Error = 0x2082
+	ERROR_DS_ATT_VAL_ALREADY_EXISTS                                          Win32Error = 0x2083
+	ERROR_DS_CANT_REM_MISSING_ATT                                            Win32Error = 0x2084
+	ERROR_DS_CANT_REM_MISSING_ATT_VAL                                        Win32Error = 0x2085
+	ERROR_DS_ROOT_CANT_BE_SUBREF                                             Win32Error = 0x2086
+	ERROR_DS_NO_CHAINING                                                     Win32Error = 0x2087
+	ERROR_DS_NO_CHAINED_EVAL                                                 Win32Error = 0x2088
+	ERROR_DS_NO_PARENT_OBJECT                                                Win32Error = 0x2089
+	ERROR_DS_PARENT_IS_AN_ALIAS                                              Win32Error = 0x208a
+	ERROR_DS_CANT_MIX_MASTER_AND_REPS                                        Win32Error = 0x208b
+	ERROR_DS_CHILDREN_EXIST                                                  Win32Error = 0x208c
+	ERROR_DS_OBJ_NOT_FOUND                                                   Win32Error = 0x208d
+	ERROR_DS_ALIASED_OBJ_MISSING                                             Win32Error = 0x208e
+	ERROR_DS_BAD_NAME_SYNTAX                                                 Win32Error = 0x208f
+	ERROR_DS_ALIAS_POINTS_TO_ALIAS                                           Win32Error = 0x2090
+	ERROR_DS_CANT_DEREF_ALIAS                                                Win32Error = 0x2091
+	ERROR_DS_OUT_OF_SCOPE                                                    Win32Error = 0x2092
+	ERROR_DS_OBJECT_BEING_REMOVED                                            Win32Error = 0x2093
+	ERROR_DS_CANT_DELETE_DSA_OBJ                                             Win32Error = 0x2094
+	ERROR_DS_GENERIC_ERROR                                                   Win32Error = 0x2095
+	ERROR_DS_DSA_MUST_BE_INT_MASTER                                          Win32Error = 0x2096
+	ERROR_DS_CLASS_NOT_DSA                                                   Win32Error = 0x2097
+	ERROR_DS_INSUFF_ACCESS_RIGHTS                                            Win32Error = 0x2098
+	ERROR_DS_ILLEGAL_SUPERIOR                                                Win32Error = 0x2099
+	ERROR_DS_ATTRIBUTE_OWNED_BY_SAM                                          Win32Error = 0x209a
+	ERROR_DS_NAME_TOO_MANY_PARTS                                             Win32Error = 0x209b
+	ERROR_DS_NAME_TOO_LONG                                                   Win32Error = 0x209c
+	ERROR_DS_NAME_VALUE_TOO_LONG                                             Win32Error = 0x209d
+	ERROR_DS_NAME_UNPARSEABLE                                                Win32Error = 0x209e
+	ERROR_DS_NAME_TYPE_UNKNOWN                                               Win32Error = 0x209f
+	ERROR_DS_NOT_AN_OBJECT                                                   Win32Error = 0x20a0
+	ERROR_DS_SEC_DESC_TOO_SHORT                                              Win32Error = 0x20a1
+	ERROR_DS_SEC_DESC_INVALID                                                Win32Error = 0x20a2
+	ERROR_DS_NO_DELETED_NAME                                                 Win32Error = 0x20a3
+	ERROR_DS_SUBREF_MUST_HAVE_PARENT                                         Win32Error = 0x20a4
+	ERROR_DS_NCNAME_MUST_BE_NC                                               Win32Error = 0x20a5
+	ERROR_DS_CANT_ADD_SYSTEM_ONLY                                            Win32Error = 0x20a6
+	ERROR_DS_CLASS_MUST_BE_CONCRETE                                          Win32Error = 0x20a7
+	ERROR_DS_INVALID_DMD                                                     Win32Error = 0x20a8
+	ERROR_DS_OBJ_GUID_EXISTS                                                 Win32Error = 0x20a9
+	ERROR_DS_NOT_ON_BACKLINK                                                 Win32Error = 0x20aa
+	ERROR_DS_NO_CROSSREF_FOR_NC                                              Win32Error = 0x20ab
+	ERROR_DS_SHUTTING_DOWN                                                   Win32Error = 0x20ac
+	ERROR_DS_UNKNOWN_OPERATION                                               Win32Error = 0x20ad
+	ERROR_DS_INVALID_ROLE_OWNER                                              Win32Error = 0x20ae
+	ERROR_DS_COULDNT_CONTACT_FSMO                                            Win32Error = 0x20af
+	ERROR_DS_CROSS_NC_DN_RENAME                                              Win32Error = 0x20b0
+	ERROR_DS_CANT_MOD_SYSTEM_ONLY                                            Win32Error = 0x20b1
+	ERROR_DS_REPLICATOR_ONLY                                                 Win32Error = 0x20b2
+	ERROR_DS_OBJ_CLASS_NOT_DEFINED                                           Win32Error = 0x20b3
+	ERROR_DS_OBJ_CLASS_NOT_SUBCLASS                                          Win32Error = 0x20b4
+	ERROR_DS_NAME_REFERENCE_INVALID                                          Win32Error = 0x20b5
+	ERROR_DS_CROSS_REF_EXISTS                                                Win32Error = 0x20b6
+	ERROR_DS_CANT_DEL_MASTER_CROSSREF                                        Win32Error = 0x20b7
+	ERROR_DS_SUBTREE_NOTIFY_NOT_NC_HEAD                                      Win32Error = 0x20b8
+	ERROR_DS_NOTIFY_FILTER_TOO_COMPLEX                                       Win32Error = 0x20b9
+	ERROR_DS_DUP_RDN                                                         Win32Error = 0x20ba
+	ERROR_DS_DUP_OID                                                         Win32Error = 0x20bb
+	ERROR_DS_DUP_MAPI_ID                                                     Win32Error = 0x20bc
+	ERROR_DS_DUP_SCHEMA_ID_GUID                                              Win32Error = 0x20bd
+	ERROR_DS_DUP_LDAP_DISPLAY_NAME                                           Win32Error = 0x20be
+	ERROR_DS_SEMANTIC_ATT_TEST                                               Win32Error = 0x20bf
+	ERROR_DS_SYNTAX_MISMATCH                                                 Win32Error = 0x20c0
+	ERROR_DS_EXISTS_IN_MUST_HAVE                                             Win32Error = 0x20c1
+	ERROR_DS_EXISTS_IN_MAY_HAVE                                              Win32Error = 0x20c2
+	ERROR_DS_NONEXISTENT_MAY_HAVE                                            Win32Error = 0x20c3
+	ERROR_DS_NONEXISTENT_MUST_HAVE                                           Win32Error = 0x20c4
+	ERROR_DS_AUX_CLS_TEST_FAIL                                               Win32Error = 0x20c5
+	ERROR_DS_NONEXISTENT_POSS_SUP                                            Win32Error = 0x20c6
+	ERROR_DS_SUB_CLS_TEST_FAIL                                               Win32Error = 0x20c7
+	ERROR_DS_BAD_RDN_ATT_ID_SYNTAX                                           Win32Error = 0x20c8
+	ERROR_DS_EXISTS_IN_AUX_CLS                                               Win32Error = 0x20c9
+	ERROR_DS_EXISTS_IN_SUB_CLS                                               Win32Error = 0x20ca
+	ERROR_DS_EXISTS_IN_POSS_SUP                                              Win32Error = 0x20cb
+	ERROR_DS_RECALCSCHEMA_FAILED                                             Win32Error = 0x20cc
+	ERROR_DS_TREE_DELETE_NOT_FINISHED                                        Win32Error = 0x20cd
+	ERROR_DS_CANT_DELETE                                                     Win32Error = 0x20ce
+	ERROR_DS_ATT_SCHEMA_REQ_ID                                               Win32Error = 0x20cf
+	ERROR_DS_BAD_ATT_SCHEMA_SYNTAX                                           Win32Error = 0x20d0
+	ERROR_DS_CANT_CACHE_ATT                                                  Win32Error = 0x20d1
+	ERROR_DS_CANT_CACHE_CLASS                                                Win32Error = 0x20d2
+	ERROR_DS_CANT_REMOVE_ATT_CACHE                                           Win32Error = 0x20d3
+	ERROR_DS_CANT_REMOVE_CLASS_CACHE                                         Win32Error = 0x20d4
+	ERROR_DS_CANT_RETRIEVE_DN                                                Win32Error = 0x20d5
+	ERROR_DS_MISSING_SUPREF                                                  Win32Error = 0x20d6
+	ERROR_DS_CANT_RETRIEVE_INSTANCE                                          Win32Error = 0x20d7
+	ERROR_DS_CODE_INCONSISTENCY                                              Win32Error = 0x20d8
+	ERROR_DS_DATABASE_ERROR                                                  Win32Error = 0x20d9
+	ERROR_DS_GOVERNSID_MISSING                                               Win32Error = 0x20da
+	ERROR_DS_MISSING_EXPECTED_ATT                                            Win32Error = 0x20db
+	ERROR_DS_NCNAME_MISSING_CR_REF                                           Win32Error = 0x20dc
+	ERROR_DS_SECURITY_CHECKING_ERROR                                         Win32Error = 0x20dd
+	ERROR_DS_SCHEMA_NOT_LOADED                                               Win32Error = 0x20de
+	ERROR_DS_SCHEMA_ALLOC_FAILED                                             Win32Error = 0x20df
+	ERROR_DS_ATT_SCHEMA_REQ_SYNTAX                                           Win32Error = 0x20e0
+	ERROR_DS_GCVERIFY_ERROR                                                  Win32Error = 0x20e1
+	ERROR_DS_DRA_SCHEMA_MISMATCH                                             Win32Error = 0x20e2
+	ERROR_DS_CANT_FIND_DSA_OBJ                                               Win32Error = 0x20e3
+	ERROR_DS_CANT_FIND_EXPECTED_NC                                           Win32Error = 0x20e4
+	ERROR_DS_CANT_FIND_NC_IN_CACHE                                           Win32Error = 0x20e5
+	ERROR_DS_CANT_RETRIEVE_CHILD                                             Win32Error = 0x20e6
+	ERROR_DS_SECURITY_ILLEGAL_MODIFY                                         Win32Error = 0x20e7
+	ERROR_DS_CANT_REPLACE_HIDDEN_REC                                         Win32Error = 0x20e8
+	ERROR_DS_BAD_HIERARCHY_FILE                                              Win32Error = 0x20e9
+	ERROR_DS_BUILD_HIERARCHY_TABLE_FAILED                                    Win32Error = 0x20ea
+	ERROR_DS_CONFIG_PARAM_MISSING                                            Win32Error = 0x20eb
+	ERROR_DS_COUNTING_AB_INDICES_FAILED                                      Win32Error = 0x20ec
+	ERROR_DS_HIERARCHY_TABLE_MALLOC_FAILED                                   Win32Error = 0x20ed
+	ERROR_DS_INTERNAL_FAILURE                                                Win32Error = 0x20ee
+	ERROR_DS_UNKNOWN_ERROR                                                   Win32Error = 0x20ef
+	ERROR_DS_ROOT_REQUIRES_CLASS_TOP                                         Win32Error = 0x20f0
+	ERROR_DS_REFUSING_FSMO_ROLES                                             Win32Error = 0x20f1
+	ERROR_DS_MISSING_FSMO_SETTINGS                                           Win32Error = 0x20f2
+	ERROR_DS_UNABLE_TO_SURRENDER_ROLES                                       Win32Error = 0x20f3
+	ERROR_DS_DRA_GENERIC                                                     Win32Error = 0x20f4
+	ERROR_DS_DRA_INVALID_PARAMETER                                           Win32Error = 0x20f5
+	ERROR_DS_DRA_BUSY                                                        Win32Error = 0x20f6
+	ERROR_DS_DRA_BAD_DN                                                      Win32Error = 0x20f7
+	ERROR_DS_DRA_BAD_NC                                                      Win32Error = 0x20f8
+	ERROR_DS_DRA_DN_EXISTS                                                   Win32Error = 0x20f9
+	ERROR_DS_DRA_INTERNAL_ERROR                                              Win32Error = 0x20fa
+	ERROR_DS_DRA_INCONSISTENT_DIT                                            Win32Error = 0x20fb
+	ERROR_DS_DRA_CONNECTION_FAILED                                           Win32Error = 0x20fc
+	ERROR_DS_DRA_BAD_INSTANCE_TYPE                                           Win32Error = 0x20fd
+	ERROR_DS_DRA_OUT_OF_MEM                                                  Win32Error = 0x20fe
+	ERROR_DS_DRA_MAIL_PROBLEM                                                Win32Error = 0x20ff
+	ERROR_DS_DRA_REF_ALREADY_EXISTS                                          Win32Error = 0x2100
+	ERROR_DS_DRA_REF_NOT_FOUND                                               Win32Error = 0x2101
+	ERROR_DS_DRA_OBJ_IS_REP_SOURCE                                           Win32Error = 0x2102
+	ERROR_DS_DRA_DB_ERROR                                                    Win32Error = 0x2103
+	ERROR_DS_DRA_NO_REPLICA                                                  Win32Error = 0x2104
+	ERROR_DS_DRA_ACCESS_DENIED                                               Win32Error = 0x2105
+	ERROR_DS_DRA_NOT_SUPPORTED                                               Win32Error = 0x2106
+	ERROR_DS_DRA_RPC_CANCELLED                                               Win32Error = 0x2107
+	ERROR_DS_DRA_SOURCE_DISABLED                                             Win32Error = 0x2108
+	ERROR_DS_DRA_SINK_DISABLED                                               Win32Error = 0x2109
+	ERROR_DS_DRA_NAME_COLLISION                                              Win32Error = 0x210a
+	ERROR_DS_DRA_SOURCE_REINSTALLED                                          Win32Error = 0x210b
+	ERROR_DS_DRA_MISSING_PARENT                                              Win32Error = 0x210c
+	ERROR_DS_DRA_PREEMPTED                                                   Win32Error = 0x210d
+	ERROR_DS_DRA_ABANDON_SYNC                                                Win32Error = 0x210e
+	ERROR_DS_DRA_SHUTDOWN                                                    Win32Error = 0x210f
+	ERROR_DS_DRA_INCOMPATIBLE_PARTIAL_SET                                    Win32Error = 0x2110
+	ERROR_DS_DRA_SOURCE_IS_PARTIAL_REPLICA                                   Win32Error = 0x2111
+	ERROR_DS_DRA_EXTN_CONNECTION_FAILED                                      Win32Error = 0x2112
+	ERROR_DS_INSTALL_SCHEMA_MISMATCH                                         Win32Error = 0x2113
+	ERROR_DS_DUP_LINK_ID                                                     Win32Error = 0x2114
+	ERROR_DS_NAME_ERROR_RESOLVING                                            Win32Error = 0x2115
+	ERROR_DS_NAME_ERROR_NOT_FOUND                                            Win32Error = 0x2116
+	ERROR_DS_NAME_ERROR_NOT_UNIQUE                                           Win32Error = 0x2117
+	ERROR_DS_NAME_ERROR_NO_MAPPING                                           Win32Error = 0x2118
+	ERROR_DS_NAME_ERROR_DOMAIN_ONLY                                          Win32Error = 0x2119
+	ERROR_DS_NAME_ERROR_NO_SYNTACTICAL_MAPPING                               Win32Error = 0x211a
+	ERROR_DS_CONSTRUCTED_ATT_MOD                                             Win32Error = 0x211b
+	ERROR_DS_WRONG_OM_OBJ_CLASS                                              Win32Error = 0x211c
+	ERROR_DS_DRA_REPL_PENDING                                                Win32Error = 0x211d
+	ERROR_DS_DS_REQUIRED                                                     Win32Error = 0x211e
+	ERROR_DS_INVALID_LDAP_DISPLAY_NAME                                       Win32Error = 0x211f
+	ERROR_DS_NON_BASE_SEARCH                                                 Win32Error = 0x2120
+	ERROR_DS_CANT_RETRIEVE_ATTS                                              Win32Error = 0x2121
+	ERROR_DS_BACKLINK_WITHOUT_LINK                                           Win32Error = 0x2122
+	ERROR_DS_EPOCH_MISMATCH                                                  Win32Error = 0x2123
+	ERROR_DS_SRC_NAME_MISMATCH                                               Win32Error = 0x2124
+	ERROR_DS_SRC_AND_DST_NC_IDENTICAL                                        Win32Error = 0x2125
+	ERROR_DS_DST_NC_MISMATCH                                                 Win32Error = 0x2126
+	ERROR_DS_NOT_AUTHORITIVE_FOR_DST_NC                                      Win32Error = 0x2127
+	ERROR_DS_SRC_GUID_MISMATCH                                               Win32Error = 0x2128
+	ERROR_DS_CANT_MOVE_DELETED_OBJECT                                        Win32Error = 0x2129
+	ERROR_DS_PDC_OPERATION_IN_PROGRESS                                       Win32Error = 0x212a
+	ERROR_DS_CROSS_DOMAIN_CLEANUP_REQD                                       Win32Error = 0x212b
+	ERROR_DS_ILLEGAL_XDOM_MOVE_OPERATION                                     Win32Error = 0x212c
+	ERROR_DS_CANT_WITH_ACCT_GROUP_MEMBERSHPS                                 Win32Error = 0x212d
+	ERROR_DS_NC_MUST_HAVE_NC_PARENT                                          Win32Error = 0x212e
+	ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE                                       Win32Error = 0x212f
+	ERROR_DS_DST_DOMAIN_NOT_NATIVE                                           Win32Error = 0x2130
+	ERROR_DS_MISSING_INFRASTRUCTURE_CONTAINER                                Win32Error = 0x2131
+	ERROR_DS_CANT_MOVE_ACCOUNT_GROUP                                         Win32Error = 0x2132
+	ERROR_DS_CANT_MOVE_RESOURCE_GROUP                                        Win32Error = 0x2133
+	ERROR_DS_INVALID_SEARCH_FLAG                                             Win32Error = 0x2134
+	ERROR_DS_NO_TREE_DELETE_ABOVE_NC                                         Win32Error = 0x2135
+	ERROR_DS_COULDNT_LOCK_TREE_FOR_DELETE                                    Win32Error = 0x2136
+	ERROR_DS_COULDNT_IDENTIFY_OBJECTS_FOR_TREE_DELETE                        Win32Error = 0x2137
+	ERROR_DS_SAM_INIT_FAILURE                                                Win32Error = 0x2138
+	ERROR_DS_SENSITIVE_GROUP_VIOLATION                                       Win32Error = 0x2139
+	ERROR_DS_CANT_MOD_PRIMARYGROUPID                                         Win32Error = 0x213a
+	ERROR_DS_ILLEGAL_BASE_SCHEMA_MOD                                         Win32Error = 0x213b
+	ERROR_DS_NONSAFE_SCHEMA_CHANGE                                           Win32Error = 0x213c
+	ERROR_DS_SCHEMA_UPDATE_DISALLOWED                                        Win32Error = 0x213d
+	ERROR_DS_CANT_CREATE_UNDER_SCHEMA                                        Win32Error = 0x213e
+	ERROR_DS_INSTALL_NO_SRC_SCH_VERSION                                      Win32Error = 0x213f
+	ERROR_DS_INSTALL_NO_SCH_VERSION_IN_INIFILE                               Win32Error = 0x2140
+	ERROR_DS_INVALID_GROUP_TYPE                                              Win32Error = 0x2141
+	ERROR_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN                              Win32Error = 0x2142
+	ERROR_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN                               Win32Error = 0x2143
+	ERROR_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER                                   Win32Error = 0x2144
+	ERROR_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER                               Win32Error = 0x2145
+	ERROR_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER                                Win32Error = 0x2146
+	ERROR_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER                             Win32Error = 0x2147
+	ERROR_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER                        Win32Error = 0x2148
+	ERROR_DS_HAVE_PRIMARY_MEMBERS                                            Win32Error = 0x2149
+	ERROR_DS_STRING_SD_CONVERSION_FAILED                                     Win32Error = 0x214a
+	ERROR_DS_NAMING_MASTER_GC                                                Win32Error = 0x214b
+	ERROR_DS_DNS_LOOKUP_FAILURE                                              Win32Error = 0x214c
+	ERROR_DS_COULDNT_UPDATE_SPNS                                             Win32Error = 0x214d
+	ERROR_DS_CANT_RETRIEVE_SD                                                Win32Error = 0x214e
+	ERROR_DS_KEY_NOT_UNIQUE                                                  Win32Error = 0x214f
+	ERROR_DS_WRONG_LINKED_ATT_SYNTAX                                         Win32Error = 0x2150
+	ERROR_DS_SAM_NEED_BOOTKEY_PASSWORD                                       Win32Error = 0x2151
+	ERROR_DS_SAM_NEED_BOOTKEY_FLOPPY                                         Win32Error = 0x2152
+	ERROR_DS_CANT_START                                                      Win32Error = 0x2153
+	ERROR_DS_INIT_FAILURE                                                    Win32Error = 0x2154
+	ERROR_DS_NO_PKT_PRIVACY_ON_CONNECTION                                    Win32Error = 0x2155
+	ERROR_DS_SOURCE_DOMAIN_IN_FOREST                                         Win32Error = 0x2156
+	ERROR_DS_DESTINATION_DOMAIN_NOT_IN_FOREST                                Win32Error = 0x2157
+	ERROR_DS_DESTINATION_AUDITING_NOT_ENABLED                                Win32Error = 0x2158
+	ERROR_DS_CANT_FIND_DC_FOR_SRC_DOMAIN                                     Win32Error = 0x2159
+	ERROR_DS_SRC_OBJ_NOT_GROUP_OR_USER                                       Win32Error = 0x215a
+	ERROR_DS_SRC_SID_EXISTS_IN_FOREST                                        Win32Error = 0x215b
+	ERROR_DS_SRC_AND_DST_OBJECT_CLASS_MISMATCH                               Win32Error = 0x215c
+	ERROR_SAM_INIT_FAILURE                                                   Win32Error = 0x215d
+	ERROR_DS_DRA_SCHEMA_INFO_SHIP                                            Win32Error = 0x215e
+	ERROR_DS_DRA_SCHEMA_CONFLICT                                             Win32Error = 0x215f
+	ERROR_DS_DRA_EARLIER_SCHEMA_CONFLICT                                     Win32Error = 0x2160
+	ERROR_DS_DRA_OBJ_NC_MISMATCH                                             Win32Error = 0x2161
+	ERROR_DS_NC_STILL_HAS_DSAS                                               Win32Error = 0x2162
+	ERROR_DS_GC_REQUIRED                                                     Win32Error = 0x2163
+	ERROR_DS_LOCAL_MEMBER_OF_LOCAL_ONLY                                      Win32Error = 0x2164
+	ERROR_DS_NO_FPO_IN_UNIVERSAL_GROUPS                                      Win32Error = 0x2165
+	ERROR_DS_CANT_ADD_TO_GC                                                  Win32Error = 0x2166
+	ERROR_DS_NO_CHECKPOINT_WITH_PDC                                          Win32Error = 0x2167
+	ERROR_DS_SOURCE_AUDITING_NOT_ENABLED                                     Win32Error = 0x2168
+	ERROR_DS_CANT_CREATE_IN_NONDOMAIN_NC                                     Win32Error = 0x2169
+	ERROR_DS_INVALID_NAME_FOR_SPN                                            Win32Error = 0x216a
+	ERROR_DS_FILTER_USES_CONTRUCTED_ATTRS                                    Win32Error = 0x216b
+	ERROR_DS_UNICODEPWD_NOT_IN_QUOTES                                        Win32Error = 0x216c
+	ERROR_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED                                  Win32Error = 0x216d
+	ERROR_DS_MUST_BE_RUN_ON_DST_DC                                           Win32Error = 0x216e
+	ERROR_DS_SRC_DC_MUST_BE_SP4_OR_GREATER                                   Win32Error = 0x216f
+	ERROR_DS_CANT_TREE_DELETE_CRITICAL_OBJ                                   Win32Error = 0x2170
+	ERROR_DS_INIT_FAILURE_CONSOLE                                            Win32Error = 0x2171
+	ERROR_DS_SAM_INIT_FAILURE_CONSOLE                                        Win32Error = 0x2172
+	ERROR_DS_FOREST_VERSION_TOO_HIGH                                         Win32Error = 0x2173
+	ERROR_DS_DOMAIN_VERSION_TOO_HIGH                                         Win32Error = 0x2174
+	ERROR_DS_FOREST_VERSION_TOO_LOW                                          Win32Error = 0x2175
+	ERROR_DS_DOMAIN_VERSION_TOO_LOW                                          Win32Error = 0x2176
+	ERROR_DS_INCOMPATIBLE_VERSION                                            Win32Error = 0x2177
+	ERROR_DS_LOW_DSA_VERSION                                                 Win32Error = 0x2178
+	ERROR_DS_NO_BEHAVIOR_VERSION_IN_MIXEDDOMAIN                              Win32Error = 0x2179
+	ERROR_DS_NOT_SUPPORTED_SORT_ORDER                                        Win32Error = 0x217a
+	ERROR_DS_NAME_NOT_UNIQUE                                                 Win32Error = 0x217b
+	ERROR_DS_MACHINE_ACCOUNT_CREATED_PRENT4                                  Win32Error = 0x217c
+	ERROR_DS_OUT_OF_VERSION_STORE                                            Win32Error = 0x217d
+	ERROR_DS_INCOMPATIBLE_CONTROLS_USED                                      Win32Error = 0x217e
+	ERROR_DS_NO_REF_DOMAIN                                                   Win32Error = 0x217f
+	ERROR_DS_RESERVED_LINK_ID                                                Win32Error = 0x2180
+	ERROR_DS_LINK_ID_NOT_AVAILABLE                                           Win32Error = 0x2181
+	ERROR_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER                                   Win32Error = 0x2182
+	ERROR_DS_MODIFYDN_DISALLOWED_BY_INSTANCE_TYPE                            Win32Error = 0x2183
+	ERROR_DS_NO_OBJECT_MOVE_IN_SCHEMA_NC                                     Win32Error = 0x2184
+	ERROR_DS_MODIFYDN_DISALLOWED_BY_FLAG                                     Win32Error = 0x2185
+	ERROR_DS_MODIFYDN_WRONG_GRANDPARENT                                      Win32Error = 0x2186
+	ERROR_DS_NAME_ERROR_TRUST_REFERRAL                                       Win32Error = 0x2187
+	ERROR_NOT_SUPPORTED_ON_STANDARD_SERVER                                   Win32Error = 0x2188
+	ERROR_DS_CANT_ACCESS_REMOTE_PART_OF_AD                                   Win32Error = 0x2189
+	ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE_V2                                    Win32Error = 0x218a
+	ERROR_DS_THREAD_LIMIT_EXCEEDED                                           Win32Error = 0x218b
+	ERROR_DS_NOT_CLOSEST                                                     Win32Error = 0x218c
+	ERROR_DS_CANT_DERIVE_SPN_WITHOUT_SERVER_REF                              Win32Error = 0x218d
+	ERROR_DS_SINGLE_USER_MODE_FAILED                                         Win32Error = 0x218e
+	ERROR_DS_NTDSCRIPT_SYNTAX_ERROR                                          Win32Error = 0x218f
+	ERROR_DS_NTDSCRIPT_PROCESS_ERROR                                         Win32Error = 0x2190
+	ERROR_DS_DIFFERENT_REPL_EPOCHS                                           Win32Error = 0x2191
+	ERROR_DS_DRS_EXTENSIONS_CHANGED                                          Win32Error = 0x2192
+	ERROR_DS_REPLICA_SET_CHANGE_NOT_ALLOWED_ON_DISABLED_CR                   Win32Error = 0x2193
+	ERROR_DS_NO_MSDS_INTID                                                   Win32Error = 0x2194
+	ERROR_DS_DUP_MSDS_INTID                                                  Win32Error = 0x2195
+	ERROR_DS_EXISTS_IN_RDNATTID                                              Win32Error = 0x2196
+	ERROR_DS_AUTHORIZATION_FAILED                                            Win32Error = 0x2197
+	ERROR_DS_INVALID_SCRIPT                                                  Win32Error = 0x2198
+	ERROR_DS_REMOTE_CROSSREF_OP_FAILED                                       Win32Error = 0x2199
+	ERROR_DS_CROSS_REF_BUSY                                                  Win32Error = 0x219a
+	ERROR_DS_CANT_DERIVE_SPN_FOR_DELETED_DOMAIN                              Win32Error = 0x219b
+	ERROR_DS_CANT_DEMOTE_WITH_WRITEABLE_NC                                   Win32Error = 0x219c
+	ERROR_DS_DUPLICATE_ID_FOUND                                              Win32Error = 0x219d
+	ERROR_DS_INSUFFICIENT_ATTR_TO_CREATE_OBJECT                              Win32Error = 0x219e
+	ERROR_DS_GROUP_CONVERSION_ERROR                                          Win32Error = 0x219f
+	ERROR_DS_CANT_MOVE_APP_BASIC_GROUP                                       Win32Error = 0x21a0
+	ERROR_DS_CANT_MOVE_APP_QUERY_GROUP                                       Win32Error = 0x21a1
+	ERROR_DS_ROLE_NOT_VERIFIED                                               Win32Error = 0x21a2
+	ERROR_DS_WKO_CONTAINER_CANNOT_BE_SPECIAL                                 Win32Error = 0x21a3
+	ERROR_DS_DOMAIN_RENAME_IN_PROGRESS                                       Win32Error = 0x21a4
+	ERROR_DS_EXISTING_AD_CHILD_NC                                            Win32Error = 0x21a5
+	ERROR_DS_REPL_LIFETIME_EXCEEDED                                          Win32Error = 0x21a6
+	ERROR_DS_DISALLOWED_IN_SYSTEM_CONTAINER                                  Win32Error = 0x21a7
+	ERROR_DS_LDAP_SEND_QUEUE_FULL                                            Win32Error = 0x21a8
+	ERROR_DS_DRA_OUT_SCHEDULE_WINDOW                                         Win32Error = 0x21a9
+	ERROR_DS_POLICY_NOT_KNOWN                                                Win32Error = 0x21aa
+	ERROR_NO_SITE_SETTINGS_OBJECT                                            Win32Error = 0x21ab
+	ERROR_NO_SECRETS                                                         Win32Error = 0x21ac
+	ERROR_NO_WRITABLE_DC_FOUND                                               Win32Error = 0x21ad
+	ERROR_DS_NO_SERVER_OBJECT                                                Win32Error = 0x21ae
+	ERROR_DS_NO_NTDSA_OBJECT                                                 Win32Error = 0x21af
+	ERROR_DS_NON_ASQ_SEARCH                                                  Win32Error = 0x21b0
+	ERROR_DS_AUDIT_FAILURE                                                   Win32Error = 0x21b1
+	ERROR_DS_INVALID_SEARCH_FLAG_SUBTREE                                     Win32Error = 0x21b2
+	ERROR_DS_INVALID_SEARCH_FLAG_TUPLE                                       Win32Error = 0x21b3
+	ERROR_DS_HIERARCHY_TABLE_TOO_DEEP                                        Win32Error = 0x21b4
+	ERROR_DS_DRA_CORRUPT_UTD_VECTOR                                          Win32Error = 0x21b5
+	ERROR_DS_DRA_SECRETS_DENIED                                              Win32Error = 0x21b6
+	ERROR_DS_RESERVED_MAPI_ID                                                Win32Error = 0x21b7
+	ERROR_DS_MAPI_ID_NOT_AVAILABLE                                           Win32Error = 0x21b8
+	ERROR_DS_DRA_MISSING_KRBTGT_SECRET                                       Win32Error = 0x21b9
+	ERROR_DS_DOMAIN_NAME_EXISTS_IN_FOREST                                    Win32Error = 0x21ba
+	ERROR_DS_FLAT_NAME_EXISTS_IN_FOREST                                      Win32Error = 0x21bb
+	ERROR_INVALID_USER_PRINCIPAL_NAME                                        Win32Error = 0x21bc
+	ERROR_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS                              Win32Error = 0x21bd
+	ERROR_DS_OID_NOT_FOUND                                                   Win32Error = 0x21be
+	ERROR_DS_DRA_RECYCLED_TARGET                                             Win32Error = 0x21bf
+	ERROR_DS_DISALLOWED_NC_REDIRECT                                          Win32Error = 0x21c0
+	ERROR_DS_HIGH_ADLDS_FFL                                                  Win32Error = 0x21c1
+	ERROR_DS_HIGH_DSA_VERSION                                                Win32Error = 0x21c2
+	ERROR_DS_LOW_ADLDS_FFL                                                   Win32Error = 0x21c3
+	ERROR_DOMAIN_SID_SAME_AS_LOCAL_WORKSTATION                               Win32Error = 0x21c4
+	ERROR_DS_UNDELETE_SAM_VALIDATION_FAILED                                  Win32Error = 0x21c5
+	ERROR_INCORRECT_ACCOUNT_TYPE                                             Win32Error = 0x21c6
+	ERROR_DS_SPN_VALUE_NOT_UNIQUE_IN_FOREST                                  Win32Error = 0x21c7
+	ERROR_DS_UPN_VALUE_NOT_UNIQUE_IN_FOREST                                  Win32Error = 0x21c8
+	ERROR_DS_MISSING_FOREST_TRUST                                            Win32Error = 0x21c9
+	ERROR_DS_VALUE_KEY_NOT_UNIQUE                                            Win32Error = 0x21ca
+	ERROR_WEAK_WHFBKEY_BLOCKED                                               Win32Error = 0x21cb
+	ERROR_DS_PER_ATTRIBUTE_AUTHZ_FAILED_DURING_ADD                           Win32Error = 0x21cc
+	ERROR_LOCAL_POLICY_MODIFICATION_NOT_SUPPORTED                            Win32Error = 0x21cd
+	DNS_ERROR_RCODE_FORMAT_ERROR                                             Win32Error = 0x2329
+	DNS_ERROR_RCODE_SERVER_FAILURE                                           Win32Error = 0x232a
+	DNS_ERROR_RCODE_NAME_ERROR                                               Win32Error = 0x232b
+	DNS_ERROR_RCODE_NOT_IMPLEMENTED                                          Win32Error = 0x232c
+	DNS_ERROR_RCODE_REFUSED                                                  Win32Error = 0x232d
+	DNS_ERROR_RCODE_YXDOMAIN                                                 Win32Error = 0x232e
+	DNS_ERROR_RCODE_YXRRSET                                                  Win32Error = 0x232f
+	DNS_ERROR_RCODE_NXRRSET                                                  Win32Error = 0x2330
+	DNS_ERROR_RCODE_NOTAUTH                                                  Win32Error = 0x2331
+	DNS_ERROR_RCODE_NOTZONE                                                  Win32Error = 0x2332
+	DNS_ERROR_RCODE_BADSIG                                                   Win32Error = 0x2338
+	DNS_ERROR_RCODE_BADKEY                                                   Win32Error = 0x2339
+	DNS_ERROR_RCODE_BADTIME                                                  Win32Error = 0x233a
+	DNS_INFO_NO_RECORDS                                                      Win32Error = 0x251d
+	DNS_ERROR_BAD_PACKET                                                     Win32Error = 0x251e
+	DNS_ERROR_NO_PACKET                                                      Win32Error = 0x251f
+	DNS_ERROR_RCODE                                                          Win32Error = 0x2520
+	DNS_ERROR_UNSECURE_PACKET                                                Win32Error = 0x2521
+	DNS_REQUEST_PENDING                                                      Win32Error = 0x2522
+	DNS_ERROR_INVALID_TYPE                                                   Win32Error = 0x254f
+	DNS_ERROR_INVALID_IP_ADDRESS                                             Win32Error = 0x2550
+	DNS_ERROR_INVALID_PROPERTY                                               Win32Error = 0x2551
+	DNS_ERROR_TRY_AGAIN_LATER                                                Win32Error = 0x2552
+	DNS_ERROR_NOT_UNIQUE                                                     Win32Error = 0x2553
+	DNS_ERROR_NON_RFC_NAME                                                   Win32Error = 0x2554
+	DNS_STATUS_FQDN                                                          Win32Error = 0x2555
+	DNS_STATUS_DOTTED_NAME                                                   Win32Error = 0x2556
+	DNS_STATUS_SINGLE_PART_NAME                                              Win32Error = 0x2557
+	DNS_ERROR_INVALID_NAME_CHAR                                              Win32Error = 0x2558
+	DNS_ERROR_NUMERIC_NAME                                                   Win32Error = 0x2559
+	DNS_ERROR_NOT_ALLOWED_ON_ROOT_SERVER                                     Win32Error = 0x255a
+	DNS_ERROR_NOT_ALLOWED_UNDER_DELEGATION                                   Win32Error = 0x255b
+	DNS_ERROR_CANNOT_FIND_ROOT_HINTS                                         Win32Error = 0x255c
+	DNS_ERROR_INCONSISTENT_ROOT_HINTS                                        Win32Error = 0x255d
+	DNS_ERROR_ZONE_DOES_NOT_EXIST                                            Win32Error = 0x2581
+	DNS_ERROR_NO_ZONE_INFO                                                   Win32Error = 0x2582
+	DNS_ERROR_INVALID_ZONE_OPERATION                                         Win32Error = 0x2583
+	DNS_ERROR_ZONE_CONFIGURATION_ERROR                                       Win32Error = 0x2584
+	DNS_ERROR_ZONE_HAS_NO_SOA_RECORD                                         Win32Error = 0x2585
+	DNS_ERROR_ZONE_HAS_NO_NS_RECORDS                                         Win32Error = 0x2586
+	DNS_ERROR_ZONE_LOCKED                                                    Win32Error = 0x2587
+	DNS_ERROR_ZONE_CREATION_FAILED                                           Win32Error = 0x2588
+	DNS_ERROR_ZONE_ALREADY_EXISTS                                            Win32Error = 0x2589
+	DNS_ERROR_AUTOZONE_ALREADY_EXISTS                                        Win32Error = 0x258a
+	DNS_ERROR_INVALID_ZONE_TYPE                                              Win32Error = 0x258b
+	DNS_ERROR_SECONDARY_REQUIRES_MASTER_IP                                   Win32Error = 0x258c
+	DNS_ERROR_ZONE_NOT_SECONDARY                                             Win32Error = 0x258d
+	DNS_ERROR_NEED_SECONDARY_ADDRESSES                                       Win32Error = 0x258e
+	DNS_ERROR_WINS_INIT_FAILED                                               Win32Error = 0x258f
+	DNS_ERROR_NEED_WINS_SERVERS                                              Win32Error = 0x2590
+	DNS_ERROR_NBSTAT_INIT_FAILED                                             Win32Error = 0x2591
+	DNS_ERROR_SOA_DELETE_INVALID                                             Win32Error = 0x2592
+	DNS_ERROR_FORWARDER_ALREADY_EXISTS                                       Win32Error = 0x2593
+	DNS_ERROR_ZONE_REQUIRES_MASTER_IP                                        Win32Error = 0x2594
+	DNS_ERROR_ZONE_IS_SHUTDOWN                                               Win32Error = 0x2595
+	DNS_ERROR_PRIMARY_REQUIRES_DATAFILE                                      Win32Error = 0x25b3
+	DNS_ERROR_INVALID_DATAFILE_NAME                                          Win32Error = 0x25b4
+	DNS_ERROR_DATAFILE_OPEN_FAILURE                                          Win32Error = 0x25b5
+	DNS_ERROR_FILE_WRITEBACK_FAILED                                          Win32Error = 0x25b6
+	DNS_ERROR_DATAFILE_PARSING                                               Win32Error = 0x25b7
+	DNS_ERROR_RECORD_DOES_NOT_EXIST                                          Win32Error = 0x25e5
+	DNS_ERROR_RECORD_FORMAT                                                  Win32Error = 0x25e6
+	DNS_ERROR_NODE_CREATION_FAILED                                           Win32Error = 0x25e7
+	DNS_ERROR_UNKNOWN_RECORD_TYPE                                            Win32Error = 0x25e8
+	DNS_ERROR_RECORD_TIMED_OUT                                               Win32Error = 0x25e9
+	DNS_ERROR_NAME_NOT_IN_ZONE                                               Win32Error = 0x25ea
+	DNS_ERROR_CNAME_LOOP                                                     Win32Error = 0x25eb
+	DNS_ERROR_NODE_IS_CNAME                                                  Win32Error = 0x25ec
+	DNS_ERROR_CNAME_COLLISION                                                Win32Error = 0x25ed
+	DNS_ERROR_RECORD_ONLY_AT_ZONE_ROOT                                       Win32Error = 0x25ee
+	DNS_ERROR_RECORD_ALREADY_EXISTS                                          Win32Error = 0x25ef
+	DNS_ERROR_SECONDARY_DATA                                                 Win32Error = 0x25f0
+	DNS_ERROR_NO_CREATE_CACHE_DATA                                           Win32Error = 0x25f1
+	DNS_ERROR_NAME_DOES_NOT_EXIST                                            Win32Error = 0x25f2
+	DNS_WARNING_PTR_CREATE_FAILED                                            Win32Error = 0x25f3
+	DNS_WARNING_DOMAIN_UNDELETED                                             Win32Error = 0x25f4
+	DNS_ERROR_DS_UNAVAILABLE                                                 Win32Error = 0x25f5
+	DNS_ERROR_DS_ZONE_ALREADY_EXISTS                                         Win32Error = 0x25f6
+	DNS_ERROR_NO_BOOTFILE_IF_DS_ZONE                                         Win32Error = 0x25f7
+	DNS_INFO_AXFR_COMPLETE                                                   Win32Error = 0x2617
+	DNS_ERROR_AXFR                                                           Win32Error = 0x2618
+	DNS_INFO_ADDED_LOCAL_WINS                                                Win32Error = 0x2619
+	DNS_STATUS_CONTINUE_NEEDED                                               Win32Error = 0x2649
+	DNS_ERROR_NO_TCPIP                                                       Win32Error = 0x267b
+	DNS_ERROR_NO_DNS_SERVERS                                                 Win32Error = 0x267c
+	DNS_ERROR_DP_DOES_NOT_EXIST                                              Win32Error = 0x26ad
+	DNS_ERROR_DP_ALREADY_EXISTS                                              Win32Error = 0x26ae
+	DNS_ERROR_DP_NOT_ENLISTED                                                Win32Error = 0x26af
+	DNS_ERROR_DP_ALREADY_ENLISTED                                            Win32Error = 0x26b0
+	DNS_ERROR_DP_NOT_AVAILABLE                                               Win32Error = 0x26b1
+	DNS_ERROR_DP_FSMO_ERROR                                                  Win32Error = 0x26b2
+	WSAEINTR                                                                 Win32Error = 0x2714
+	WSAEBADF                                                                 Win32Error = 0x2719
+	WSAEACCES                                                                Win32Error = 0x271d
+	WSAEFAULT                                                                Win32Error = 0x271e
+	WSAEINVAL                                                                Win32Error = 0x2726
+	WSAEMFILE                                                                Win32Error = 0x2728
+	WSAEWOULDBLOCK                                                           Win32Error = 0x2733
+	WSAEINPROGRESS                                                           Win32Error = 0x2734
+	WSAEALREADY                                                              Win32Error = 0x2735
+	WSAENOTSOCK                                                              Win32Error = 0x2736
+	WSAEDESTADDRREQ                                                          Win32Error = 0x2737
+	WSAEMSGSIZE                                                              Win32Error = 0x2738
+	WSAEPROTOTYPE                                                            Win32Error = 0x2739
+	WSAENOPROTOOPT                                                           Win32Error = 0x273a
+	WSAEPROTONOSUPPORT                                                       Win32Error = 0x273b
+	WSAESOCKTNOSUPPORT                                                       Win32Error = 0x273c
+	WSAEOPNOTSUPP                                                            Win32Error = 0x273d
+	WSAEPFNOSUPPORT                                                          Win32Error = 0x273e
+	WSAEAFNOSUPPORT                                                          Win32Error = 0x273f
+	WSAEADDRINUSE                                                            Win32Error = 0x2740
+	WSAEADDRNOTAVAIL                                                         Win32Error = 0x2741
+	WSAENETDOWN                                                              Win32Error = 0x2742
+	WSAENETUNREACH                                                           Win32Error = 0x2743
+	WSAENETRESET                                                             Win32Error = 0x2744
+	WSAECONNABORTED                                                          Win32Error = 0x2745
+	WSAECONNRESET                                                            Win32Error = 0x2746
+	WSAENOBUFS                                                               Win32Error = 0x2747
+	WSAEISCONN                                                               Win32Error = 0x2748
+	WSAENOTCONN                                                              Win32Error = 0x2749
+	WSAESHUTDOWN                                                             Win32Error = 0x274a
+	WSAETOOMANYREFS                                                          Win32Error = 0x274b
+	WSAETIMEDOUT                                                             Win32Error = 0x274c
+	WSAECONNREFUSED                                                          Win32Error = 0x274d
+	WSAELOOP                                                                 Win32Error = 0x274e
+	WSAENAMETOOLONG                                                          Win32Error = 0x274f
+	WSAEHOSTDOWN                                                             Win32Error = 0x2750
+	WSAEHOSTUNREACH                                                          Win32Error = 0x2751
+	WSAENOTEMPTY                                                             Win32Error = 0x2752
+	WSAEPROCLIM                                                              Win32Error = 0x2753
+	WSAEUSERS                                                                Win32Error = 0x2754
+	WSAEDQUOT                                                                Win32Error = 0x2755
+	WSAESTALE                                                                Win32Error = 0x2756
+	WSAEREMOTE                                                               Win32Error = 0x2757
+	WSASYSNOTREADY                                                           Win32Error = 0x276b
+	WSAVERNOTSUPPORTED                                                       Win32Error = 0x276c
+	WSANOTINITIALISED                                                        Win32Error = 0x276d
+	WSAEDISCON                                                               Win32Error = 0x2775
+	WSAENOMORE                                                               Win32Error = 0x2776
+	WSAECANCELLED                                                            Win32Error = 0x2777
+	WSAEINVALIDPROCTABLE                                                     Win32Error = 0x2778
+	WSAEINVALIDPROVIDER                                                      Win32Error = 0x2779
+	WSAEPROVIDERFAILEDINIT                                                   Win32Error = 0x277a
+	WSASYSCALLFAILURE                                                        Win32Error = 0x277b
+	WSASERVICE_NOT_FOUND                                                     Win32Error = 0x277c
+	WSATYPE_NOT_FOUND                                                        Win32Error = 0x277d
+	WSA_E_NO_MORE                                                            Win32Error = 0x277e
+	WSA_E_CANCELLED                                                          Win32Error = 0x277f
+	WSAEREFUSED                                                              Win32Error = 0x2780
+	WSAHOST_NOT_FOUND                                                        Win32Error = 0x2af9
+	WSATRY_AGAIN                                                             Win32Error = 0x2afa
+	WSANO_RECOVERY                                                           Win32Error = 0x2afb
+	WSANO_DATA                                                               Win32Error = 0x2afc
+	WSA_QOS_RECEIVERS                                                        Win32Error = 0x2afd
+	WSA_QOS_SENDERS                                                          Win32Error = 0x2afe
+	WSA_QOS_NO_SENDERS                                                       Win32Error = 0x2aff
+	WSA_QOS_NO_RECEIVERS                                                     Win32Error = 0x2b00
+	WSA_QOS_REQUEST_CONFIRMED                                                Win32Error = 0x2b01
+	WSA_QOS_ADMISSION_FAILURE                                                Win32Error = 0x2b02
+	WSA_QOS_POLICY_FAILURE                                                   Win32Error = 0x2b03
+	WSA_QOS_BAD_STYLE                                                        Win32Error = 0x2b04
+	WSA_QOS_BAD_OBJECT                                                       Win32Error = 0x2b05
+	WSA_QOS_TRAFFIC_CTRL_ERROR                                               Win32Error = 0x2b06
+	WSA_QOS_GENERIC_ERROR                                                    Win32Error = 0x2b07
+	WSA_QOS_ESERVICETYPE                                                     Win32Error = 0x2b08
+	WSA_QOS_EFLOWSPEC                                                        Win32Error = 0x2b09
+	WSA_QOS_EPROVSPECBUF                                                     Win32Error = 0x2b0a
+	WSA_QOS_EFILTERSTYLE                                                     Win32Error = 0x2b0b
+	WSA_QOS_EFILTERTYPE                                                      Win32Error = 0x2b0c
+	WSA_QOS_EFILTERCOUNT                                                     Win32Error = 0x2b0d
+	WSA_QOS_EOBJLENGTH                                                       Win32Error = 0x2b0e
+	WSA_QOS_EFLOWCOUNT                                                       Win32Error = 0x2b0f
+	WSA_QOS_EUNKNOWNPSOBJ                                                    Win32Error = 0x2b10
+	WSA_QOS_EPOLICYOBJ                                                       Win32Error = 0x2b11
+	WSA_QOS_EFLOWDESC                                                        Win32Error = 0x2b12
+	WSA_QOS_EPSFLOWSPEC                                                      Win32Error = 0x2b13
+	WSA_QOS_EPSFILTERSPEC                                                    Win32Error = 0x2b14
+	WSA_QOS_ESDMODEOBJ                                                       Win32Error = 0x2b15
+	WSA_QOS_ESHAPERATEOBJ                                                    Win32Error = 0x2b16
+	WSA_QOS_RESERVED_PETYPE                                                  Win32Error = 0x2b17
+	ERROR_SXS_SECTION_NOT_FOUND                                              Win32Error = 0x36b0
+	ERROR_SXS_CANT_GEN_ACTCTX                                                Win32Error = 0x36b1
+	ERROR_SXS_INVALID_ACTCTXDATA_FORMAT                                      Win32Error = 0x36b2
+	ERROR_SXS_ASSEMBLY_NOT_FOUND                                             Win32Error = 0x36b3
+	ERROR_SXS_MANIFEST_FORMAT_ERROR                                          Win32Error = 0x36b4
+	ERROR_SXS_MANIFEST_PARSE_ERROR                                           Win32Error = 0x36b5
+	ERROR_SXS_ACTIVATION_CONTEXT_DISABLED                                    Win32Error = 0x36b6
+	ERROR_SXS_KEY_NOT_FOUND                                                  Win32Error = 0x36b7
+	ERROR_SXS_VERSION_CONFLICT                                               Win32Error = 0x36b8
+	ERROR_SXS_WRONG_SECTION_TYPE                                             Win32Error = 0x36b9
+	ERROR_SXS_THREAD_QUERIES_DISABLED                                        Win32Error = 0x36ba
+	ERROR_SXS_PROCESS_DEFAULT_ALREADY_SET                                    Win32Error = 0x36bb
+	ERROR_SXS_UNKNOWN_ENCODING_GROUP                                         Win32Error = 0x36bc
+	ERROR_SXS_UNKNOWN_ENCODING                                               Win32Error = 0x36bd
+	ERROR_SXS_INVALID_XML_NAMESPACE_URI                                      Win32Error = 0x36be
+	ERROR_SXS_ROOT_MANIFEST_DEPENDENCY_NOT_INSTALLED                         Win32Error = 0x36bf
+	ERROR_SXS_LEAF_MANIFEST_DEPENDENCY_NOT_INSTALLED                         Win32Error = 0x36c0
+	ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE                            Win32Error = 0x36c1
+	ERROR_SXS_MANIFEST_MISSING_REQUIRED_DEFAULT_NAMESPACE                    Win32Error = 0x36c2
+	ERROR_SXS_MANIFEST_INVALID_REQUIRED_DEFAULT_NAMESPACE                    Win32Error = 0x36c3
+	ERROR_SXS_PRIVATE_MANIFEST_CROSS_PATH_WITH_REPARSE_POINT                 Win32Error = 0x36c4
+	ERROR_SXS_DUPLICATE_DLL_NAME                                             Win32Error = 0x36c5
+	ERROR_SXS_DUPLICATE_WINDOWCLASS_NAME                                     Win32Error = 0x36c6
+	ERROR_SXS_DUPLICATE_CLSID                                                Win32Error = 0x36c7
+	ERROR_SXS_DUPLICATE_IID                                                  Win32Error = 0x36c8
+	ERROR_SXS_DUPLICATE_TLBID                                                Win32Error = 0x36c9
+	ERROR_SXS_DUPLICATE_PROGID                                               Win32Error = 0x36ca
+	ERROR_SXS_DUPLICATE_ASSEMBLY_NAME                                        Win32Error = 0x36cb
+	ERROR_SXS_FILE_HASH_MISMATCH                                             Win32Error = 0x36cc
+	ERROR_SXS_POLICY_PARSE_ERROR                                             Win32Error = 0x36cd
+	ERROR_SXS_XML_E_MISSINGQUOTE                                             Win32Error = 0x36ce
+	ERROR_SXS_XML_E_COMMENTSYNTAX                                            Win32Error = 0x36cf
+	ERROR_SXS_XML_E_BADSTARTNAMECHAR                                         Win32Error = 0x36d0
+	ERROR_SXS_XML_E_BADNAMECHAR                                              Win32Error = 0x36d1
+	ERROR_SXS_XML_E_BADCHARINSTRING                                          Win32Error = 0x36d2
+	ERROR_SXS_XML_E_XMLDECLSYNTAX                                            Win32Error = 0x36d3
+	ERROR_SXS_XML_E_BADCHARDATA                                              Win32Error = 0x36d4
+	ERROR_SXS_XML_E_MISSINGWHITESPACE                                        Win32Error = 0x36d5
+	ERROR_SXS_XML_E_EXPECTINGTAGEND                                          Win32Error = 0x36d6
+	ERROR_SXS_XML_E_MISSINGSEMICOLON                                         Win32Error = 0x36d7
+	ERROR_SXS_XML_E_UNBALANCEDPAREN                                          Win32Error = 0x36d8
+	ERROR_SXS_XML_E_INTERNALERROR                                            Win32Error = 0x36d9
+	ERROR_SXS_XML_E_UNEXPECTED_WHITESPACE                                    Win32Error = 0x36da
+	ERROR_SXS_XML_E_INCOMPLETE_ENCODING                                      Win32Error = 0x36db
+	ERROR_SXS_XML_E_MISSING_PAREN                                            Win32Error = 0x36dc
+	ERROR_SXS_XML_E_EXPECTINGCLOSEQUOTE                                      Win32Error = 0x36dd
+	ERROR_SXS_XML_E_MULTIPLE_COLONS                                          Win32Error = 0x36de
+	ERROR_SXS_XML_E_INVALID_DECIMAL                                          Win32Error = 0x36df
+	ERROR_SXS_XML_E_INVALID_HEXIDECIMAL                                      Win32Error = 0x36e0
+	ERROR_SXS_XML_E_INVALID_UNICODE                                          Win32Error = 0x36e1
+	ERROR_SXS_XML_E_WHITESPACEORQUESTIONMARK                                 Win32Error = 0x36e2
+	ERROR_SXS_XML_E_UNEXPECTEDENDTAG                                         Win32Error = 0x36e3
+	ERROR_SXS_XML_E_UNCLOSEDTAG                                              Win32Error = 0x36e4
+	ERROR_SXS_XML_E_DUPLICATEATTRIBUTE                                       Win32Error = 0x36e5
+	ERROR_SXS_XML_E_MULTIPLEROOTS                                            Win32Error = 0x36e6
+	ERROR_SXS_XML_E_INVALIDATROOTLEVEL                                       Win32Error = 0x36e7
+	ERROR_SXS_XML_E_BADXMLDECL                                               Win32Error = 0x36e8
+	ERROR_SXS_XML_E_MISSINGROOT                                              Win32Error = 0x36e9
+	ERROR_SXS_XML_E_UNEXPECTEDEOF                                            Win32Error = 0x36ea
+	ERROR_SXS_XML_E_BADPEREFINSUBSET                                         Win32Error = 0x36eb
+	ERROR_SXS_XML_E_UNCLOSEDSTARTTAG                                         Win32Error = 0x36ec
+	ERROR_SXS_XML_E_UNCLOSEDENDTAG                                           Win32Error = 0x36ed
+	ERROR_SXS_XML_E_UNCLOSEDSTRING                                           Win32Error = 0x36ee
+	ERROR_SXS_XML_E_UNCLOSEDCOMMENT                                          Win32Error = 0x36ef
+	ERROR_SXS_XML_E_UNCLOSEDDECL                                             Win32Error = 0x36f0
+	ERROR_SXS_XML_E_UNCLOSEDCDATA                                            Win32Error = 0x36f1
+	ERROR_SXS_XML_E_RESERVEDNAMESPACE                                        Win32Error = 0x36f2
+	ERROR_SXS_XML_E_INVALIDENCODING                                          Win32Error = 0x36f3
+	ERROR_SXS_XML_E_INVALIDSWITCH                                            Win32Error = 0x36f4
+	ERROR_SXS_XML_E_BADXMLCASE                                               Win32Error = 0x36f5
+	ERROR_SXS_XML_E_INVALID_STANDALONE                                       Win32Error = 0x36f6
+	ERROR_SXS_XML_E_UNEXPECTED_STANDALONE                                    Win32Error = 0x36f7
+	ERROR_SXS_XML_E_INVALID_VERSION                                          Win32Error = 0x36f8
+	ERROR_SXS_XML_E_MISSINGEQUALS                                            Win32Error = 0x36f9
+	ERROR_SXS_PROTECTION_RECOVERY_FAILED                                     Win32Error = 0x36fa
+	ERROR_SXS_PROTECTION_PUBLIC_KEY_TOO_SHORT                                Win32Error = 0x36fb
+	ERROR_SXS_PROTECTION_CATALOG_NOT_VALID                                   Win32Error = 0x36fc
+	ERROR_SXS_UNTRANSLATABLE_HRESULT                                         Win32Error = 0x36fd
+	ERROR_SXS_PROTECTION_CATALOG_FILE_MISSING                                Win32Error = 0x36fe
+	ERROR_SXS_MISSING_ASSEMBLY_IDENTITY_ATTRIBUTE                            Win32Error = 0x36ff
+	ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE_NAME                       Win32Error = 0x3700
+	ERROR_SXS_ASSEMBLY_MISSING                                               Win32Error = 0x3701
+	ERROR_SXS_CORRUPT_ACTIVATION_STACK                                       Win32Error = 0x3702
+	ERROR_SXS_CORRUPTION                                                     Win32Error = 0x3703
+	ERROR_SXS_EARLY_DEACTIVATION                                             Win32Error = 0x3704
+	ERROR_SXS_INVALID_DEACTIVATION                                           Win32Error = 0x3705
+	ERROR_SXS_MULTIPLE_DEACTIVATION                                          Win32Error = 0x3706
+	ERROR_SXS_PROCESS_TERMINATION_REQUESTED                                  Win32Error = 0x3707
+	ERROR_SXS_RELEASE_ACTIVATION_CONTEXT                                     Win32Error = 0x3708
+	ERROR_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY                        Win32Error = 0x3709
+	ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE                               Win32Error = 0x370a
+	ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME                                Win32Error = 0x370b
+	ERROR_SXS_IDENTITY_DUPLICATE_ATTRIBUTE                                   Win32Error = 0x370c
+	ERROR_SXS_IDENTITY_PARSE_ERROR                                           Win32Error = 0x370d
+	ERROR_MALFORMED_SUBSTITUTION_STRING                                      Win32Error = 0x370e
+	ERROR_SXS_INCORRECT_PUBLIC_KEY_TOKEN                                     Win32Error = 0x370f
+	ERROR_UNMAPPED_SUBSTITUTION_STRING                                       Win32Error = 0x3710
+	ERROR_SXS_ASSEMBLY_NOT_LOCKED                                            Win32Error = 0x3711
+	ERROR_SXS_COMPONENT_STORE_CORRUPT                                        Win32Error = 0x3712
+	ERROR_ADVANCED_INSTALLER_FAILED                                          Win32Error = 0x3713
+	ERROR_XML_ENCODING_MISMATCH                                              Win32Error = 0x3714
+	ERROR_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT                  Win32Error = 0x3715
+	ERROR_SXS_IDENTITIES_DIFFERENT                                           Win32Error = 0x3716
+	ERROR_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT                                   Win32Error = 0x3717
+	ERROR_SXS_FILE_NOT_PART_OF_ASSEMBLY                                      Win32Error = 0x3718
+	ERROR_SXS_MANIFEST_TOO_BIG                                               Win32Error = 0x3719
+	ERROR_SXS_SETTING_NOT_REGISTERED                                         Win32Error = 0x371a
+	ERROR_SXS_TRANSACTION_CLOSURE_INCOMPLETE                                 Win32Error = 0x371b
+	ERROR_SMI_PRIMITIVE_INSTALLER_FAILED                                     Win32Error = 0x371c
+	ERROR_GENERIC_COMMAND_FAILED                                             Win32Error = 0x371d
+	ERROR_SXS_FILE_HASH_MISSING                                              Win32Error = 0x371e
+	ERROR_IPSEC_QM_POLICY_EXISTS                                             Win32Error = 0x32c8
+	ERROR_IPSEC_QM_POLICY_NOT_FOUND                                          Win32Error = 0x32c9
+	ERROR_IPSEC_QM_POLICY_IN_USE                                             Win32Error = 0x32ca
+	ERROR_IPSEC_MM_POLICY_EXISTS                                             Win32Error = 0x32cb
+	ERROR_IPSEC_MM_POLICY_NOT_FOUND                                          Win32Error = 0x32cc
+	ERROR_IPSEC_MM_POLICY_IN_USE                                             Win32Error = 0x32cd
+	ERROR_IPSEC_MM_FILTER_EXISTS                                             Win32Error = 0x32ce
+	ERROR_IPSEC_MM_FILTER_NOT_FOUND                                          Win32Error = 0x32cf
+	ERROR_IPSEC_TRANSPORT_FILTER_EXISTS                                      Win32Error = 0x32d0
+	ERROR_IPSEC_TRANSPORT_FILTER_NOT_FOUND                                   Win32Error = 0x32d1
+	ERROR_IPSEC_MM_AUTH_EXISTS                                               Win32Error = 0x32d2
+	ERROR_IPSEC_MM_AUTH_NOT_FOUND                                            Win32Error = 0x32d3
+	ERROR_IPSEC_MM_AUTH_IN_USE                                               Win32Error = 0x32d4
+	ERROR_IPSEC_DEFAULT_MM_POLICY_NOT_FOUND                                  Win32Error = 0x32d5
+	ERROR_IPSEC_DEFAULT_MM_AUTH_NOT_FOUND                                    Win32Error = 0x32d6
+	ERROR_IPSEC_DEFAULT_QM_POLICY_NOT_FOUND                                  Win32Error = 0x32d7
+	ERROR_IPSEC_TUNNEL_FILTER_EXISTS                                         Win32Error = 0x32d8
+	ERROR_IPSEC_TUNNEL_FILTER_NOT_FOUND                                      Win32Error = 0x32d9
+	ERROR_IPSEC_MM_FILTER_PENDING_DELETION                                   Win32Error = 0x32da
+	ERROR_IPSEC_TRANSPORT_FILTER_PENDING_DELETION                            Win32Error = 0x32db
+	ERROR_IPSEC_TUNNEL_FILTER_PENDING_DELETION                               Win32Error = 0x32dc
+	ERROR_IPSEC_MM_POLICY_PENDING_DELETION                                   Win32Error = 0x32dd
+	ERROR_IPSEC_MM_AUTH_PENDING_DELETION                                     Win32Error = 0x32de
+	ERROR_IPSEC_QM_POLICY_PENDING_DELETION                                   Win32Error = 0x32df
+	WARNING_IPSEC_MM_POLICY_PRUNED                                           Win32Error = 0x32e0
+	WARNING_IPSEC_QM_POLICY_PRUNED                                           Win32Error = 0x32e1
+	ERROR_IPSEC_IKE_NEG_STATUS_BEGIN                                         Win32Error = 0x35e8
+	ERROR_IPSEC_IKE_AUTH_FAIL                                                Win32Error = 0x35e9
+	ERROR_IPSEC_IKE_ATTRIB_FAIL                                              Win32Error = 0x35ea
+	ERROR_IPSEC_IKE_NEGOTIATION_PENDING                                      Win32Error = 0x35eb
+	ERROR_IPSEC_IKE_GENERAL_PROCESSING_ERROR                                 Win32Error = 0x35ec
+	ERROR_IPSEC_IKE_TIMED_OUT                                                Win32Error = 0x35ed
+	ERROR_IPSEC_IKE_NO_CERT                                                  Win32Error = 0x35ee
+	ERROR_IPSEC_IKE_SA_DELETED                                               Win32Error = 0x35ef
+	ERROR_IPSEC_IKE_SA_REAPED                                                Win32Error = 0x35f0
+	ERROR_IPSEC_IKE_MM_ACQUIRE_DROP                                          Win32Error = 0x35f1
+	ERROR_IPSEC_IKE_QM_ACQUIRE_DROP                                          Win32Error = 0x35f2
+	ERROR_IPSEC_IKE_QUEUE_DROP_MM                                            Win32Error = 0x35f3
+	ERROR_IPSEC_IKE_QUEUE_DROP_NO_MM                                         Win32Error = 0x35f4
+	ERROR_IPSEC_IKE_DROP_NO_RESPONSE                                         Win32Error = 0x35f5
+	ERROR_IPSEC_IKE_MM_DELAY_DROP                                            Win32Error = 0x35f6
+	ERROR_IPSEC_IKE_QM_DELAY_DROP                                            Win32Error = 0x35f7
+	ERROR_IPSEC_IKE_ERROR                                                    Win32Error = 0x35f8
+	ERROR_IPSEC_IKE_CRL_FAILED                                               Win32Error = 0x35f9
+	ERROR_IPSEC_IKE_INVALID_KEY_USAGE                                        Win32Error = 0x35fa
+	ERROR_IPSEC_IKE_INVALID_CERT_TYPE                                        Win32Error = 0x35fb
+	ERROR_IPSEC_IKE_NO_PRIVATE_KEY                                           Win32Error = 0x35fc
+	ERROR_IPSEC_IKE_DH_FAIL                                                  Win32Error = 0x35fe
+	ERROR_IPSEC_IKE_INVALID_HEADER                                           Win32Error = 0x3600
+	ERROR_IPSEC_IKE_NO_POLICY                                                Win32Error = 0x3601
+	ERROR_IPSEC_IKE_INVALID_SIGNATURE                                        Win32Error = 0x3602
+	ERROR_IPSEC_IKE_KERBEROS_ERROR                                           Win32Error = 0x3603
+	ERROR_IPSEC_IKE_NO_PUBLIC_KEY                                            Win32Error = 0x3604
+	ERROR_IPSEC_IKE_PROCESS_ERR                                              Win32Error = 0x3605
+	ERROR_IPSEC_IKE_PROCESS_ERR_SA                                           Win32Error = 0x3606
+	ERROR_IPSEC_IKE_PROCESS_ERR_PROP                                         Win32Error = 0x3607
+	ERROR_IPSEC_IKE_PROCESS_ERR_TRANS                                        Win32Error = 0x3608
+	ERROR_IPSEC_IKE_PROCESS_ERR_KE                                           Win32Error = 0x3609
+	ERROR_IPSEC_IKE_PROCESS_ERR_ID                                           Win32Error = 0x360a
+	ERROR_IPSEC_IKE_PROCESS_ERR_CERT                                         Win32Error = 0x360b
+	ERROR_IPSEC_IKE_PROCESS_ERR_CERT_REQ                                     Win32Error = 0x360c
+	ERROR_IPSEC_IKE_PROCESS_ERR_HASH                                         Win32Error = 0x360d
+	ERROR_IPSEC_IKE_PROCESS_ERR_SIG                                          Win32Error = 0x360e
+	ERROR_IPSEC_IKE_PROCESS_ERR_NONCE                                        Win32Error = 0x360f
+	ERROR_IPSEC_IKE_PROCESS_ERR_NOTIFY                                       Win32Error = 0x3610
+	ERROR_IPSEC_IKE_PROCESS_ERR_DELETE                                       Win32Error = 0x3611
+	ERROR_IPSEC_IKE_PROCESS_ERR_VENDOR                                       Win32Error = 0x3612
+	ERROR_IPSEC_IKE_INVALID_PAYLOAD                                          Win32Error = 0x3613
+	ERROR_IPSEC_IKE_LOAD_SOFT_SA                                             Win32Error = 0x3614
+	ERROR_IPSEC_IKE_SOFT_SA_TORN_DOWN                                        Win32Error = 0x3615
+	ERROR_IPSEC_IKE_INVALID_COOKIE                                           Win32Error = 0x3616
+	ERROR_IPSEC_IKE_NO_PEER_CERT                                             Win32Error = 0x3617
+	ERROR_IPSEC_IKE_PEER_CRL_FAILED                                          Win32Error = 0x3618
+	ERROR_IPSEC_IKE_POLICY_CHANGE                                            Win32Error = 0x3619
+	ERROR_IPSEC_IKE_NO_MM_POLICY                                             Win32Error = 0x361a
+	ERROR_IPSEC_IKE_NOTCBPRIV                                                Win32Error = 0x361b
+	ERROR_IPSEC_IKE_SECLOADFAIL                                              Win32Error = 0x361c
+	ERROR_IPSEC_IKE_FAILSSPINIT                                              Win32Error = 0x361d
+	ERROR_IPSEC_IKE_FAILQUERYSSP                                             Win32Error = 0x361e
+	ERROR_IPSEC_IKE_SRVACQFAIL                                               Win32Error = 0x361f
+	ERROR_IPSEC_IKE_SRVQUERYCRED                                             Win32Error = 0x3620
+	ERROR_IPSEC_IKE_GETSPIFAIL                                               Win32Error = 0x3621
+	ERROR_IPSEC_IKE_INVALID_FILTER                                           Win32Error = 0x3622
+	ERROR_IPSEC_IKE_OUT_OF_MEMORY                                            Win32Error = 0x3623
+	ERROR_IPSEC_IKE_ADD_UPDATE_KEY_FAILED                                    Win32Error = 0x3624
+	ERROR_IPSEC_IKE_INVALID_POLICY                                           Win32Error = 0x3625
+	ERROR_IPSEC_IKE_UNKNOWN_DOI                                              Win32Error = 0x3626
+	ERROR_IPSEC_IKE_INVALID_SITUATION                                        Win32Error = 0x3627
+	ERROR_IPSEC_IKE_DH_FAILURE                                               Win32Error = 0x3628
+	ERROR_IPSEC_IKE_INVALID_GROUP                                            Win32Error = 0x3629
+	ERROR_IPSEC_IKE_ENCRYPT                                                  Win32Error = 0x362a
+	ERROR_IPSEC_IKE_DECRYPT                                                  Win32Error = 0x362b
+	ERROR_IPSEC_IKE_POLICY_MATCH                                             Win32Error = 0x362c
+	ERROR_IPSEC_IKE_UNSUPPORTED_ID                                           Win32Error = 0x362d
+	ERROR_IPSEC_IKE_INVALID_HASH                                             Win32Error = 0x362e
+	ERROR_IPSEC_IKE_INVALID_HASH_ALG                                         Win32Error = 0x362f
+	ERROR_IPSEC_IKE_INVALID_HASH_SIZE                                        Win32Error = 0x3630
+	ERROR_IPSEC_IKE_INVALID_ENCRYPT_ALG                                      Win32Error = 0x3631
+	ERROR_IPSEC_IKE_INVALID_AUTH_ALG                                         Win32Error = 0x3632
+	ERROR_IPSEC_IKE_INVALID_SIG                                              Win32Error = 0x3633
+	ERROR_IPSEC_IKE_LOAD_FAILED                                              Win32Error = 0x3634
+	ERROR_IPSEC_IKE_RPC_DELETE                                               Win32Error = 0x3635
+	ERROR_IPSEC_IKE_BENIGN_REINIT                                            Win32Error = 0x3636
+	ERROR_IPSEC_IKE_INVALID_RESPONDER_LIFETIME_NOTIFY                        Win32Error = 0x3637
+	ERROR_IPSEC_IKE_INVALID_CERT_KEYLEN                                      Win32Error = 0x3639
+	ERROR_IPSEC_IKE_MM_LIMIT                                                 Win32Error = 0x363a
+	ERROR_IPSEC_IKE_NEGOTIATION_DISABLED                                     Win32Error = 0x363b
+	ERROR_IPSEC_IKE_QM_LIMIT                                                 Win32Error = 0x363c
+	ERROR_IPSEC_IKE_MM_EXPIRED                                               Win32Error = 0x363d
+	ERROR_IPSEC_IKE_PEER_MM_ASSUMED_INVALID                                  Win32Error = 0x363e
+	ERROR_IPSEC_IKE_CERT_CHAIN_POLICY_MISMATCH                               Win32Error = 0x363f
+	ERROR_IPSEC_IKE_UNEXPECTED_MESSAGE_ID                                    Win32Error = 0x3640
+	ERROR_IPSEC_IKE_INVALID_AUTH_PAYLOAD                                     Win32Error = 0x3641
+	ERROR_IPSEC_IKE_DOS_COOKIE_SENT                                          Win32Error = 0x3642
+	ERROR_IPSEC_IKE_SHUTTING_DOWN                                            Win32Error = 0x3643
+	ERROR_IPSEC_IKE_CGA_AUTH_FAILED                                          Win32Error = 0x3644
+	ERROR_IPSEC_IKE_PROCESS_ERR_NATOA                                        Win32Error = 0x3645
+	ERROR_IPSEC_IKE_INVALID_MM_FOR_QM                                        Win32Error = 0x3646
+	ERROR_IPSEC_IKE_QM_EXPIRED                                               Win32Error = 0x3647
+	ERROR_IPSEC_IKE_TOO_MANY_FILTERS                                         Win32Error = 0x3648
+	ERROR_IPSEC_IKE_NEG_STATUS_END                                           Win32Error = 0x3649
+	ERROR_IPSEC_IKE_KILL_DUMMY_NAP_TUNNEL                                    Win32Error = 0x364a
+	ERROR_IPSEC_IKE_INNER_IP_ASSIGNMENT_FAILURE                              Win32Error = 0x364b
+	ERROR_IPSEC_IKE_REQUIRE_CP_PAYLOAD_MISSING                               Win32Error = 0x364c
+	ERROR_IPSEC_KEY_MODULE_IMPERSONATION_NEGOTIATION_PENDING                 Win32Error = 0x364d
+	ERROR_IPSEC_IKE_COEXISTENCE_SUPPRESS                                     Win32Error = 0x364e
+	ERROR_IPSEC_IKE_RATELIMIT_DROP                                           Win32Error = 0x364f
+	ERROR_IPSEC_IKE_PEER_DOESNT_SUPPORT_MOBIKE                               Win32Error = 0x3650
+	ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE                                    Win32Error = 0x3651
+	ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_FAILURE                        Win32Error = 0x3652
+	ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE_WITH_OPTIONAL_RETRY                Win32Error = 0x3653
+	ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_AND_CERTMAP_FAILURE            Win32Error = 0x3654
+	ERROR_IPSEC_IKE_NEG_STATUS_EXTENDED_END                                  Win32Error = 0x3655
+	ERROR_IPSEC_BAD_SPI                                                      Win32Error = 0x3656
+	ERROR_IPSEC_SA_LIFETIME_EXPIRED                                          Win32Error = 0x3657
+	ERROR_IPSEC_WRONG_SA                                                     Win32Error = 0x3658
+	ERROR_IPSEC_REPLAY_CHECK_FAILED                                          Win32Error = 0x3659
+	ERROR_IPSEC_INVALID_PACKET                                               Win32Error = 0x365a
+	ERROR_IPSEC_INTEGRITY_CHECK_FAILED                                       Win32Error = 0x365b
+	ERROR_IPSEC_CLEAR_TEXT_DROP                                              Win32Error = 0x365c
+	ERROR_IPSEC_AUTH_FIREWALL_DROP                                           Win32Error = 0x365d
+	ERROR_IPSEC_THROTTLE_DROP                                                Win32Error = 0x365e
+	ERROR_IPSEC_DOSP_BLOCK                                                   Win32Error = 0x3665
+	ERROR_IPSEC_DOSP_RECEIVED_MULTICAST                                      Win32Error = 0x3666
+	ERROR_IPSEC_DOSP_INVALID_PACKET                                          Win32Error = 0x3667
+	ERROR_IPSEC_DOSP_STATE_LOOKUP_FAILED                                     Win32Error = 0x3668
+	ERROR_IPSEC_DOSP_MAX_ENTRIES                                             Win32Error = 0x3669
+	ERROR_IPSEC_DOSP_KEYMOD_NOT_ALLOWED                                      Win32Error = 0x366a
+	ERROR_IPSEC_DOSP_NOT_INSTALLED                                           Win32Error = 0x366b
+	ERROR_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES                             Win32Error = 0x366c
+	ERROR_EVT_INVALID_CHANNEL_PATH                                           Win32Error = 0x3a98
+	ERROR_EVT_INVALID_QUERY                                                  Win32Error = 0x3a99
+	ERROR_EVT_PUBLISHER_METADATA_NOT_FOUND                                   Win32Error = 0x3a9a
+	ERROR_EVT_EVENT_TEMPLATE_NOT_FOUND                                       Win32Error = 0x3a9b
+	ERROR_EVT_INVALID_PUBLISHER_NAME                                         Win32Error = 0x3a9c
+	ERROR_EVT_INVALID_EVENT_DATA                                             Win32Error = 0x3a9d
+	ERROR_EVT_CHANNEL_NOT_FOUND                                              Win32Error = 0x3a9f
+	ERROR_EVT_MALFORMED_XML_TEXT                                             Win32Error = 0x3aa0
+	ERROR_EVT_SUBSCRIPTION_TO_DIRECT_CHANNEL                                 Win32Error = 0x3aa1
+	ERROR_EVT_CONFIGURATION_ERROR                                            Win32Error = 0x3aa2
+	ERROR_EVT_QUERY_RESULT_STALE                                             Win32Error = 0x3aa3
+	ERROR_EVT_QUERY_RESULT_INVALID_POSITION                                  Win32Error = 0x3aa4
+	ERROR_EVT_NON_VALIDATING_MSXML                                           Win32Error = 0x3aa5
+	ERROR_EVT_FILTER_ALREADYSCOPED                                           Win32Error = 0x3aa6
+	ERROR_EVT_FILTER_NOTELTSET                                               Win32Error = 0x3aa7
+	ERROR_EVT_FILTER_INVARG                                                  Win32Error = 0x3aa8
+	ERROR_EVT_FILTER_INVTEST                                                 Win32Error = 0x3aa9
+	ERROR_EVT_FILTER_INVTYPE                                                 Win32Error = 0x3aaa
+	ERROR_EVT_FILTER_PARSEERR                                                Win32Error = 0x3aab
+	ERROR_EVT_FILTER_UNSUPPORTEDOP                                           Win32Error = 0x3aac
+	ERROR_EVT_FILTER_UNEXPECTEDTOKEN                                         Win32Error = 0x3aad
+	ERROR_EVT_INVALID_OPERATION_OVER_ENABLED_DIRECT_CHANNEL                  Win32Error = 0x3aae
+	ERROR_EVT_INVALID_CHANNEL_PROPERTY_VALUE                                 Win32Error = 0x3aaf
+	ERROR_EVT_INVALID_PUBLISHER_PROPERTY_VALUE                               Win32Error = 0x3ab0
+	ERROR_EVT_CHANNEL_CANNOT_ACTIVATE                                        Win32Error = 0x3ab1
+	ERROR_EVT_FILTER_TOO_COMPLEX                                             Win32Error = 0x3ab2
+	ERROR_EVT_MESSAGE_NOT_FOUND                                              Win32Error = 0x3ab3
+	ERROR_EVT_MESSAGE_ID_NOT_FOUND                                           Win32Error = 0x3ab4
+	ERROR_EVT_UNRESOLVED_VALUE_INSERT                                        Win32Error = 0x3ab5
+	ERROR_EVT_UNRESOLVED_PARAMETER_INSERT                                    Win32Error = 0x3ab6
+	ERROR_EVT_MAX_INSERTS_REACHED                                            Win32Error = 0x3ab7
+	ERROR_EVT_EVENT_DEFINITION_NOT_FOUND                                     Win32Error = 0x3ab8
+	ERROR_EVT_MESSAGE_LOCALE_NOT_FOUND                                       Win32Error = 0x3ab9
+	ERROR_EVT_VERSION_TOO_OLD                                                Win32Error = 0x3aba
+	ERROR_EVT_VERSION_TOO_NEW                                                Win32Error = 0x3abb
+	ERROR_EVT_CANNOT_OPEN_CHANNEL_OF_QUERY                                   Win32Error = 0x3abc
+	ERROR_EVT_PUBLISHER_DISABLED                                             Win32Error = 0x3abd
+	ERROR_EVT_FILTER_OUT_OF_RANGE                                            Win32Error = 0x3abe
+	ERROR_EC_SUBSCRIPTION_CANNOT_ACTIVATE                                    Win32Error = 0x3ae8
+	ERROR_EC_LOG_DISABLED                                                    Win32Error = 0x3ae9
+	ERROR_EC_CIRCULAR_FORWARDING                                             Win32Error = 0x3aea
+	ERROR_EC_CREDSTORE_FULL                                                  Win32Error = 0x3aeb
+	ERROR_EC_CRED_NOT_FOUND                                                  Win32Error = 0x3aec
+	ERROR_EC_NO_ACTIVE_CHANNEL                                               Win32Error = 0x3aed
+	ERROR_MUI_FILE_NOT_FOUND                                                 Win32Error = 0x3afc
+	ERROR_MUI_INVALID_FILE                                                   Win32Error = 0x3afd
+	ERROR_MUI_INVALID_RC_CONFIG                                              Win32Error = 0x3afe
+	ERROR_MUI_INVALID_LOCALE_NAME                                            Win32Error = 0x3aff
+	ERROR_MUI_INVALID_ULTIMATEFALLBACK_NAME                                  Win32Error = 0x3b00
+	ERROR_MUI_FILE_NOT_LOADED                                                Win32Error = 0x3b01
+	ERROR_RESOURCE_ENUM_USER_STOP                                            Win32Error = 0x3b02
+	ERROR_MUI_INTLSETTINGS_UILANG_NOT_INSTALLED                              Win32Error = 0x3b03
+	ERROR_MUI_INTLSETTINGS_INVALID_LOCALE_NAME                               Win32Error = 0x3b04
+	ERROR_MRM_RUNTIME_NO_DEFAULT_OR_NEUTRAL_RESOURCE                         Win32Error = 0x3b06
+	ERROR_MRM_INVALID_PRICONFIG                                              Win32Error = 0x3b07
+	ERROR_MRM_INVALID_FILE_TYPE                                              Win32Error = 0x3b08
+	ERROR_MRM_UNKNOWN_QUALIFIER                                              Win32Error = 0x3b09
+	ERROR_MRM_INVALID_QUALIFIER_VALUE                                        Win32Error = 0x3b0a
+	ERROR_MRM_NO_CANDIDATE                                                   Win32Error = 0x3b0b
+	ERROR_MRM_NO_MATCH_OR_DEFAULT_CANDIDATE                                  Win32Error = 0x3b0c
+	ERROR_MRM_RESOURCE_TYPE_MISMATCH                                         Win32Error = 0x3b0d
+	ERROR_MRM_DUPLICATE_MAP_NAME                                             Win32Error = 0x3b0e
+	ERROR_MRM_DUPLICATE_ENTRY                                                Win32Error = 0x3b0f
+	ERROR_MRM_INVALID_RESOURCE_IDENTIFIER                                    Win32Error = 0x3b10
+	ERROR_MRM_FILEPATH_TOO_LONG                                              Win32Error = 0x3b11
+	ERROR_MRM_UNSUPPORTED_DIRECTORY_TYPE                                     Win32Error = 0x3b12
+	ERROR_MRM_INVALID_PRI_FILE                                               Win32Error = 0x3b16
+	ERROR_MRM_NAMED_RESOURCE_NOT_FOUND                                       Win32Error = 0x3b17
+	ERROR_MRM_MAP_NOT_FOUND                                                  Win32Error = 0x3b1f
+	ERROR_MRM_UNSUPPORTED_PROFILE_TYPE                                       Win32Error = 0x3b20
+	ERROR_MRM_INVALID_QUALIFIER_OPERATOR                                     Win32Error = 0x3b21
+	ERROR_MRM_INDETERMINATE_QUALIFIER_VALUE                                  Win32Error = 0x3b22
+	ERROR_MRM_AUTOMERGE_ENABLED                                              Win32Error = 0x3b23
+	ERROR_MRM_TOO_MANY_RESOURCES                                             Win32Error = 0x3b24
+	ERROR_MCA_INVALID_CAPABILITIES_STRING                                    Win32Error = 0x3b60
+	ERROR_MCA_INVALID_VCP_VERSION                                            Win32Error = 0x3b61
+	ERROR_MCA_MONITOR_VIOLATES_MCCS_SPECIFICATION                            Win32Error = 0x3b62
+	ERROR_MCA_MCCS_VERSION_MISMATCH                                          Win32Error = 0x3b63
+	ERROR_MCA_UNSUPPORTED_MCCS_VERSION                                       Win32Error = 0x3b64
+	ERROR_MCA_INTERNAL_ERROR                                                 Win32Error = 0x3b65
+	ERROR_MCA_INVALID_TECHNOLOGY_TYPE_RETURNED                               Win32Error = 0x3b66
+	ERROR_MCA_UNSUPPORTED_COLOR_TEMPERATURE                                  Win32Error = 0x3b67
+	ERROR_AMBIGUOUS_SYSTEM_DEVICE                                            Win32Error = 0x3b92
+	ERROR_SYSTEM_DEVICE_NOT_FOUND                                            Win32Error = 0x3bc3
+	ERROR_HASH_NOT_SUPPORTED                                                 Win32Error = 0x3bc4
+	ERROR_HASH_NOT_PRESENT                                                   Win32Error = 0x3bc5
+	ERROR_SECONDARY_IC_PROVIDER_NOT_REGISTERED                               Win32Error = 0x3bd9
+	ERROR_GPIO_CLIENT_INFORMATION_INVALID                                    Win32Error = 0x3bda
+	ERROR_GPIO_VERSION_NOT_SUPPORTED                                         Win32Error = 0x3bdb
+	ERROR_GPIO_INVALID_REGISTRATION_PACKET                                   Win32Error = 0x3bdc
+	ERROR_GPIO_OPERATION_DENIED                                              Win32Error = 0x3bdd
+	ERROR_GPIO_INCOMPATIBLE_CONNECT_MODE                                     Win32Error = 0x3bde
+	ERROR_GPIO_INTERRUPT_ALREADY_UNMASKED                                    Win32Error = 0x3bdf
+	ERROR_CANNOT_SWITCH_RUNLEVEL                                             Win32Error = 0x3c28
+	ERROR_INVALID_RUNLEVEL_SETTING                                           Win32Error = 0x3c29
+	ERROR_RUNLEVEL_SWITCH_TIMEOUT                                            Win32Error = 0x3c2a
+	ERROR_RUNLEVEL_SWITCH_AGENT_TIMEOUT                                      Win32Error = 0x3c2b
+	ERROR_RUNLEVEL_SWITCH_IN_PROGRESS                                        Win32Error = 0x3c2c
+	ERROR_SERVICES_FAILED_AUTOSTART                                          Win32Error = 0x3c2d
+	ERROR_COM_TASK_STOP_PENDING                                              Win32Error = 0x3c8d
+	ERROR_INSTALL_OPEN_PACKAGE_FAILED                                        Win32Error = 0x3cf0
+	ERROR_INSTALL_PACKAGE_NOT_FOUND                                          Win32Error = 0x3cf1
+	ERROR_INSTALL_INVALID_PACKAGE                                            Win32Error = 0x3cf2
+	ERROR_INSTALL_RESOLVE_DEPENDENCY_FAILED                                  Win32Error = 0x3cf3
+	ERROR_INSTALL_OUT_OF_DISK_SPACE                                          Win32Error = 0x3cf4
+	ERROR_INSTALL_NETWORK_FAILURE                                            Win32Error = 0x3cf5
+	ERROR_INSTALL_REGISTRATION_FAILURE                                       Win32Error = 0x3cf6
+	ERROR_INSTALL_DEREGISTRATION_FAILURE                                     Win32Error = 0x3cf7
+	ERROR_INSTALL_CANCEL                                                     Win32Error = 0x3cf8
+	ERROR_INSTALL_FAILED                                                     Win32Error = 0x3cf9
+	ERROR_REMOVE_FAILED                                                      Win32Error = 0x3cfa
+	ERROR_PACKAGE_ALREADY_EXISTS                                             Win32Error = 0x3cfb
+	ERROR_NEEDS_REMEDIATION                                                  Win32Error = 0x3cfc
+	ERROR_INSTALL_PREREQUISITE_FAILED                                        Win32Error = 0x3cfd
+	ERROR_PACKAGE_REPOSITORY_CORRUPTED                                       Win32Error = 0x3cfe
+	ERROR_INSTALL_POLICY_FAILURE                                             Win32Error = 0x3cff
+	ERROR_PACKAGE_UPDATING                                                   Win32Error = 0x3d00
+	ERROR_DEPLOYMENT_BLOCKED_BY_POLICY                                       Win32Error = 0x3d01
+	ERROR_PACKAGES_IN_USE                                                    Win32Error = 0x3d02
+	ERROR_RECOVERY_FILE_CORRUPT                                              Win32Error = 0x3d03
+	ERROR_INVALID_STAGED_SIGNATURE                                           Win32Error = 0x3d04
+	ERROR_DELETING_EXISTING_APPLICATIONDATA_STORE_FAILED                     Win32Error = 0x3d05
+	ERROR_INSTALL_PACKAGE_DOWNGRADE                                          Win32Error = 0x3d06
+	ERROR_SYSTEM_NEEDS_REMEDIATION                                           Win32Error = 0x3d07
+	ERROR_APPX_INTEGRITY_FAILURE_CLR_NGEN                                    Win32Error = 0x3d08
+	ERROR_RESILIENCY_FILE_CORRUPT                                            Win32Error = 0x3d09
+	ERROR_INSTALL_FIREWALL_SERVICE_NOT_RUNNING                               Win32Error = 0x3d0a
+	APPMODEL_ERROR_NO_PACKAGE                                                Win32Error = 0x3d54
+	APPMODEL_ERROR_PACKAGE_RUNTIME_CORRUPT                                   Win32Error = 0x3d55
+	APPMODEL_ERROR_PACKAGE_IDENTITY_CORRUPT                                  Win32Error = 0x3d56
+	APPMODEL_ERROR_NO_APPLICATION                                            Win32Error = 0x3d57
+	ERROR_STATE_LOAD_STORE_FAILED                                            Win32Error = 0x3db8
+	ERROR_STATE_GET_VERSION_FAILED                                           Win32Error = 0x3db9
+	ERROR_STATE_SET_VERSION_FAILED                                           Win32Error = 0x3dba
+	ERROR_STATE_STRUCTURED_RESET_FAILED                                      Win32Error = 0x3dbb
+	ERROR_STATE_OPEN_CONTAINER_FAILED                                        Win32Error = 0x3dbc
+	ERROR_STATE_CREATE_CONTAINER_FAILED                                      Win32Error = 0x3dbd
+	ERROR_STATE_DELETE_CONTAINER_FAILED                                      Win32Error = 0x3dbe
+	ERROR_STATE_READ_SETTING_FAILED                                          Win32Error = 0x3dbf
+	ERROR_STATE_WRITE_SETTING_FAILED                                         Win32Error = 0x3dc0
+	ERROR_STATE_DELETE_SETTING_FAILED                                        Win32Error = 0x3dc1
+	ERROR_STATE_QUERY_SETTING_FAILED                                         Win32Error = 0x3dc2
+	ERROR_STATE_READ_COMPOSITE_SETTING_FAILED                                Win32Error = 0x3dc3
+	ERROR_STATE_WRITE_COMPOSITE_SETTING_FAILED                               Win32Error = 0x3dc4
+	ERROR_STATE_ENUMERATE_CONTAINER_FAILED                                   Win32Error = 0x3dc5
+	ERROR_STATE_ENUMERATE_SETTINGS_FAILED                                    Win32Error = 0x3dc6
+	ERROR_STATE_COMPOSITE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED                  Win32Error = 0x3dc7
+	ERROR_STATE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED                            Win32Error = 0x3dc8
+	ERROR_STATE_SETTING_NAME_SIZE_LIMIT_EXCEEDED                             Win32Error = 0x3dc9
+	ERROR_STATE_CONTAINER_NAME_SIZE_LIMIT_EXCEEDED                           Win32Error = 0x3dca
+	ERROR_API_UNAVAILABLE                                                    Win32Error = 0x3de1
+	DS_S_SUCCESS                                                             Win32Error = 0x0
+	DNS_ERROR_RCODE_NO_ERROR                                                 Win32Error = 0x0
+	DNS_ERROR_RCODE_LAST                                                     Win32Error = 0x233a
+	DNS_STATUS_PACKET_UNSECURE                                               Win32Error = 0x2521
+	DNS_ERROR_NO_MEMORY                                                      Win32Error = 0xe
+	DNS_ERROR_INVALID_NAME                                                   Win32Error = 0x7b
+	DNS_ERROR_INVALID_DATA                                                   Win32Error = 0xd
+	WSA_QOS_EUNKOWNPSOBJ                                                     Win32Error = 0x2b10
+	SCARD_S_SUCCESS                                                          Win32Error = 0x0
+)
+
+// hresultNames maps the HRESULT values to the names of their constants.
+var hresultNames = map[HRESULT]string{
+	E_UNEXPECTED:                                  "E_UNEXPECTED",
+	E_NOTIMPL:                                     "E_NOTIMPL",
+	E_OUTOFMEMORY:                                 "E_OUTOFMEMORY",
+	E_INVALIDARG:                                  "E_INVALIDARG",
+	E_NOINTERFACE:                                 "E_NOINTERFACE",
+	E_POINTER:                                     "E_POINTER",
+	E_HANDLE:                                      "E_HANDLE",
+	E_ABORT:                                       "E_ABORT",
+	E_FAIL:                                        "E_FAIL",
+	E_ACCESSDENIED:                                "E_ACCESSDENIED",
+	E_PENDING:                                     "E_PENDING",
+	E_BOUNDS:                                      "E_BOUNDS",
+	E_CHANGED_STATE:                               "E_CHANGED_STATE",
+	E_ILLEGAL_STATE_CHANGE:                        "E_ILLEGAL_STATE_CHANGE",
+	E_ILLEGAL_METHOD_CALL:                         "E_ILLEGAL_METHOD_CALL",
+	E_STRING_NOT_NULL_TERMINATED:                  "E_STRING_NOT_NULL_TERMINATED",
+	E_ILLEGAL_DELEGATE_ASSIGNMENT:                 "E_ILLEGAL_DELEGATE_ASSIGNMENT",
+	E_NOT_SUFFICIENT_BUFFER:                       "E_NOT_SUFFICIENT_BUFFER",
+	E_NOT_SET:                                     "E_NOT_SET",
+	E_NOT_VALID_STATE:                             "E_NOT_VALID_STATE",
+	E_UAC_DISABLED:                                "E_UAC_DISABLED",
+	E_APPLICATION_ACTIVATION_TIMED_OUT:            "E_APPLICATION_ACTIVATION_TIMED_OUT",
+	E_APPLICATION_ACTIVATION_EXEC_FAILURE:         "E_APPLICATION_ACTIVATION_EXEC_FAILURE",
+	CO_E_INIT_TLS:                                 "CO_E_INIT_TLS",
+	CO_E_INIT_SHARED_ALLOCATOR:                    "CO_E_INIT_SHARED_ALLOCATOR",
+	CO_E_INIT_MEMORY_ALLOCATOR:                    "CO_E_INIT_MEMORY_ALLOCATOR",
+	CO_E_INIT_CLASS_CACHE:                         "CO_E_INIT_CLASS_CACHE",
+	CO_E_INIT_RPC_CHANNEL:                         "CO_E_INIT_RPC_CHANNEL",
+	CO_E_INIT_TLS_SET_CHANNEL_CONTROL:             "CO_E_INIT_TLS_SET_CHANNEL_CONTROL",
+	CO_E_INIT_TLS_CHANNEL_CONTROL:                 "CO_E_INIT_TLS_CHANNEL_CONTROL",
+	CO_E_INIT_UNACCEPTED_USER_ALLOCATOR:           "CO_E_INIT_UNACCEPTED_USER_ALLOCATOR",
+	CO_E_INIT_SCM_MUTEX_EXISTS:                    "CO_E_INIT_SCM_MUTEX_EXISTS",
+	CO_E_INIT_SCM_FILE_MAPPING_EXISTS:             "CO_E_INIT_SCM_FILE_MAPPING_EXISTS",
+	CO_E_INIT_SCM_MAP_VIEW_OF_FILE:                "CO_E_INIT_SCM_MAP_VIEW_OF_FILE",
+	CO_E_INIT_SCM_EXEC_FAILURE:                    "CO_E_INIT_SCM_EXEC_FAILURE",
+	CO_E_INIT_ONLY_SINGLE_THREADED:                "CO_E_INIT_ONLY_SINGLE_THREADED",
+	CO_E_CANT_REMOTE:                              "CO_E_CANT_REMOTE",
+	CO_E_BAD_SERVER_NAME:                          "CO_E_BAD_SERVER_NAME",
+	CO_E_WRONG_SERVER_IDENTITY:                    "CO_E_WRONG_SERVER_IDENTITY",
+	CO_E_OLE1DDE_DISABLED:                         "CO_E_OLE1DDE_DISABLED",
+	CO_E_RUNAS_SYNTAX:                             "CO_E_RUNAS_SYNTAX",
+	CO_E_CREATEPROCESS_FAILURE:                    "CO_E_CREATEPROCESS_FAILURE",
+	CO_E_RUNAS_CREATEPROCESS_FAILURE:              "CO_E_RUNAS_CREATEPROCESS_FAILURE",
+	CO_E_RUNAS_LOGON_FAILURE:                      "CO_E_RUNAS_LOGON_FAILURE",
+	CO_E_LAUNCH_PERMSSION_DENIED:                  "CO_E_LAUNCH_PERMSSION_DENIED",
+	CO_E_START_SERVICE_FAILURE:                    "CO_E_START_SERVICE_FAILURE",
+	CO_E_REMOTE_COMMUNICATION_FAILURE:             "CO_E_REMOTE_COMMUNICATION_FAILURE",
+	CO_E_SERVER_START_TIMEOUT:                     "CO_E_SERVER_START_TIMEOUT",
+	CO_E_CLSREG_INCONSISTENT:                      "CO_E_CLSREG_INCONSISTENT",
+	CO_E_IIDREG_INCONSISTENT:                      "CO_E_IIDREG_INCONSISTENT",
+	CO_E_NOT_SUPPORTED:                            "CO_E_NOT_SUPPORTED",
+	CO_E_RELOAD_DLL:                               "CO_E_RELOAD_DLL",
+	CO_E_MSI_ERROR:                                "CO_E_MSI_ERROR",
+	S_OK:                                          "S_OK",
+	S_FALSE:                                       "S_FALSE",
+	OLE_E_OLEVERB:                                 "OLE_E_OLEVERB",
+	OLE_E_LAST:                                    "OLE_E_LAST",
+	OLE_S_USEREG:                                  "OLE_S_USEREG",
+	OLE_S_LAST:                                    "OLE_S_LAST",
+	OLE_E_ADVF:                                    "OLE_E_ADVF",
+	OLE_E_ENUM_NOMORE:                             "OLE_E_ENUM_NOMORE",
+	OLE_E_ADVISENOTSUPPORTED:                      "OLE_E_ADVISENOTSUPPORTED",
+	OLE_E_NOCONNECTION:                            "OLE_E_NOCONNECTION",
+	OLE_E_NOTRUNNING:                              "OLE_E_NOTRUNNING",
+	OLE_E_NOCACHE:                                 "OLE_E_NOCACHE",
+	OLE_E_BLANK:                                   "OLE_E_BLANK",
+	OLE_E_CLASSDIFF:                               "OLE_E_CLASSDIFF",
+	OLE_E_CANT_GETMONIKER:                         "OLE_E_CANT_GETMONIKER",
+	OLE_E_CANT_BINDTOSOURCE:                       "OLE_E_CANT_BINDTOSOURCE",
+	OLE_E_STATIC:                                  "OLE_E_STATIC",
+	OLE_E_PROMPTSAVECANCELLED:                     "OLE_E_PROMPTSAVECANCELLED",
+	OLE_E_INVALIDRECT:                             "OLE_E_INVALIDRECT",
+	OLE_E_WRONGCOMPOBJ:                            "OLE_E_WRONGCOMPOBJ",
+	OLE_E_INVALIDHWND:                             "OLE_E_INVALIDHWND",
+	OLE_E_NOT_INPLACEACTIVE:                       "OLE_E_NOT_INPLACEACTIVE",
+	OLE_E_CANTCONVERT:                             "OLE_E_CANTCONVERT",
+	OLE_E_NOSTORAGE:                               "OLE_E_NOSTORAGE",
+	DV_E_FORMATETC:                                "DV_E_FORMATETC",
+	DV_E_DVTARGETDEVICE:                           "DV_E_DVTARGETDEVICE",
+	DV_E_STGMEDIUM:                                "DV_E_STGMEDIUM",
+	DV_E_STATDATA:                                 "DV_E_STATDATA",
+	DV_E_LINDEX:                                   "DV_E_LINDEX",
+	DV_E_TYMED:                                    "DV_E_TYMED",
+	DV_E_CLIPFORMAT:                               "DV_E_CLIPFORMAT",
+	DV_E_DVASPECT:                                 "DV_E_DVASPECT",
+	DV_E_DVTARGETDEVICE_SIZE:                      "DV_E_DVTARGETDEVICE_SIZE",
+	DV_E_NOIVIEWOBJECT:                            "DV_E_NOIVIEWOBJECT",
+	DRAGDROP_E_NOTREGISTERED:                      "DRAGDROP_E_NOTREGISTERED",
+	DRAGDROP_E_LAST:                               "DRAGDROP_E_LAST",
+	DRAGDROP_S_DROP:                               "DRAGDROP_S_DROP",
+	DRAGDROP_S_LAST:                               "DRAGDROP_S_LAST",
+	DRAGDROP_E_ALREADYREGISTERED:                  "DRAGDROP_E_ALREADYREGISTERED",
+	DRAGDROP_E_INVALIDHWND:                        "DRAGDROP_E_INVALIDHWND",
+	CLASS_E_NOAGGREGATION:                         "CLASS_E_NOAGGREGATION",
+	CLASSFACTORY_E_LAST:                           "CLASSFACTORY_E_LAST",
+	CLASSFACTORY_S_FIRST:                          "CLASSFACTORY_S_FIRST",
+	CLASSFACTORY_S_LAST:                           "CLASSFACTORY_S_LAST",
+	CLASS_E_CLASSNOTAVAILABLE:                     "CLASS_E_CLASSNOTAVAILABLE",
+	CLASS_E_NOTLICENSED:                           "CLASS_E_NOTLICENSED",
+	MARSHAL_E_FIRST:                               "MARSHAL_E_FIRST",
+	MARSHAL_E_LAST:                                "MARSHAL_E_LAST",
+	MARSHAL_S_FIRST:                               "MARSHAL_S_FIRST",
+	MARSHAL_S_LAST:                                "MARSHAL_S_LAST",
+	DATA_E_FIRST:                                  "DATA_E_FIRST",
+	DATA_E_LAST:                                   "DATA_E_LAST",
+	DATA_S_SAMEFORMATETC:                          "DATA_S_SAMEFORMATETC",
+	DATA_S_LAST:                                   "DATA_S_LAST",
+	VIEW_E_DRAW:                                   "VIEW_E_DRAW",
+	VIEW_E_LAST:                                   "VIEW_E_LAST",
+	VIEW_S_ALREADY_FROZEN:                         "VIEW_S_ALREADY_FROZEN",
+	VIEW_S_LAST:                                   "VIEW_S_LAST",
+	REGDB_E_READREGDB:                             "REGDB_E_READREGDB",
+	REGDB_E_LAST:                                  "REGDB_E_LAST",
+	REGDB_S_FIRST:                                 "REGDB_S_FIRST",
+	REGDB_S_LAST:                                  "REGDB_S_LAST",
+	REGDB_E_WRITEREGDB:                            "REGDB_E_WRITEREGDB",
+	REGDB_E_KEYMISSING:                            "REGDB_E_KEYMISSING",
+	REGDB_E_INVALIDVALUE:                          "REGDB_E_INVALIDVALUE",
+	REGDB_E_CLASSNOTREG:                           "REGDB_E_CLASSNOTREG",
+	REGDB_E_IIDNOTREG:                             "REGDB_E_IIDNOTREG",
+	CAT_E_CATIDNOEXIST:                            "CAT_E_CATIDNOEXIST",
+	CAT_E_NODESCRIPTION:                           "CAT_E_NODESCRIPTION",
+	CS_E_PACKAGE_NOTFOUND:                         "CS_E_PACKAGE_NOTFOUND",
+	CS_E_INTERNAL_ERROR:                           "CS_E_INTERNAL_ERROR",
+	CS_E_NOT_DELETABLE:                            "CS_E_NOT_DELETABLE",
+	CS_E_CLASS_NOTFOUND:                           "CS_E_CLASS_NOTFOUND",
+	CS_E_INVALID_VERSION:                          "CS_E_INVALID_VERSION",
+	CS_E_NO_CLASSSTORE:                            "CS_E_NO_CLASSSTORE",
+	CACHE_E_NOCACHE_UPDATED:                       "CACHE_E_NOCACHE_UPDATED",
+	CACHE_E_LAST:                                  "CACHE_E_LAST",
+	CACHE_S_FORMATETC_NOTSUPPORTED:                "CACHE_S_FORMATETC_NOTSUPPORTED",
+	CACHE_S_LAST:                                  "CACHE_S_LAST",
+	OLEOBJ_E_NOVERBS:                              "OLEOBJ_E_NOVERBS",
+	OLEOBJ_E_LAST:                                 "OLEOBJ_E_LAST",
+	OLEOBJ_S_INVALIDVERB:                          "OLEOBJ_S_INVALIDVERB",
+	OLEOBJ_S_LAST:                                 "OLEOBJ_S_LAST",
+	OLEOBJ_E_INVALIDVERB:                          "OLEOBJ_E_INVALIDVERB",
+	CLIENTSITE_E_FIRST:                            "CLIENTSITE_E_FIRST",
+	CLIENTSITE_E_LAST:                             "CLIENTSITE_E_LAST",
+	CLIENTSITE_S_FIRST:                            "CLIENTSITE_S_FIRST",
+	CLIENTSITE_S_LAST:                             "CLIENTSITE_S_LAST",
+	INPLACE_E_NOTUNDOABLE:                         "INPLACE_E_NOTUNDOABLE",
+	INPLACE_E_NOTOOLSPACE:                         "INPLACE_E_NOTOOLSPACE",
+	INPLACE_E_LAST:                                "INPLACE_E_LAST",
+	INPLACE_S_TRUNCATED:                           "INPLACE_S_TRUNCATED",
+	INPLACE_S_LAST:                                "INPLACE_S_LAST",
+	ENUM_E_FIRST:                                  "ENUM_E_FIRST",
+	ENUM_E_LAST:                                   "ENUM_E_LAST",
+	ENUM_S_FIRST:                                  "ENUM_S_FIRST",
+	ENUM_S_LAST:                                   "ENUM_S_LAST",
+	CONVERT10_E_OLESTREAM_GET:                     "CONVERT10_E_OLESTREAM_GET",
+	CONVERT10_E_LAST:                              "CONVERT10_E_LAST",
+	CONVERT10_S_NO_PRESENTATION:                   "CONVERT10_S_NO_PRESENTATION",
+	CONVERT10_S_LAST:                              "CONVERT10_S_LAST",
+	CONVERT10_E_OLESTREAM_PUT:                     "CONVERT10_E_OLESTREAM_PUT",
+	CONVERT10_E_OLESTREAM_FMT:                     "CONVERT10_E_OLESTREAM_FMT",
+	CONVERT10_E_OLESTREAM_BITMAP_TO_DIB:           "CONVERT10_E_OLESTREAM_BITMAP_TO_DIB",
+	CONVERT10_E_STG_FMT:                           "CONVERT10_E_STG_FMT",
+	CONVERT10_E_STG_NO_STD_STREAM:                 "CONVERT10_E_STG_NO_STD_STREAM",
+	CONVERT10_E_STG_DIB_TO_BITMAP:                 "CONVERT10_E_STG_DIB_TO_BITMAP",
+	CLIPBRD_E_CANT_OPEN:                           "CLIPBRD_E_CANT_OPEN",
+	CLIPBRD_E_LAST:                                "CLIPBRD_E_LAST",
+	CLIPBRD_S_FIRST:                               "CLIPBRD_S_FIRST",
+	CLIPBRD_S_LAST:                                "CLIPBRD_S_LAST",
+	CLIPBRD_E_CANT_EMPTY:                          "CLIPBRD_E_CANT_EMPTY",
+	CLIPBRD_E_CANT_SET:                            "CLIPBRD_E_CANT_SET",
+	CLIPBRD_E_BAD_DATA:                            "CLIPBRD_E_BAD_DATA",
+	CLIPBRD_E_CANT_CLOSE:                          "CLIPBRD_E_CANT_CLOSE",
+	MK_E_CONNECTMANUALLY:                          "MK_E_CONNECTMANUALLY",
+	MK_E_ENUMERATION_FAILED:                       "MK_E_ENUMERATION_FAILED",
+	MK_S_FIRST:                                    "MK_S_FIRST",
+	MK_S_LAST:                                     "MK_S_LAST",
+	MK_E_EXCEEDEDDEADLINE:                         "MK_E_EXCEEDEDDEADLINE",
+	MK_E_NEEDGENERIC:                              "MK_E_NEEDGENERIC",
+	MK_E_UNAVAILABLE:                              "MK_E_UNAVAILABLE",
+	MK_E_SYNTAX:                                   "MK_E_SYNTAX",
+	MK_E_NOOBJECT:                                 "MK_E_NOOBJECT",
+	MK_E_INVALIDEXTENSION:                         "MK_E_INVALIDEXTENSION",
+	MK_E_INTERMEDIATEINTERFACENOTSUPPORTED:        "MK_E_INTERMEDIATEINTERFACENOTSUPPORTED",
+	MK_E_NOTBINDABLE:                              "MK_E_NOTBINDABLE",
+	MK_E_NOTBOUND:                                 "MK_E_NOTBOUND",
+	MK_E_CANTOPENFILE:                             "MK_E_CANTOPENFILE",
+	MK_E_MUSTBOTHERUSER:                           "MK_E_MUSTBOTHERUSER",
+	MK_E_NOINVERSE:                                "MK_E_NOINVERSE",
+	MK_E_NOSTORAGE:                                "MK_E_NOSTORAGE",
+	MK_E_NOPREFIX:                                 "MK_E_NOPREFIX",
+	CO_E_NOTINITIALIZED:                           "CO_E_NOTINITIALIZED",
+	CO_E_RELEASED:                                 "CO_E_RELEASED",
+	CO_S_FIRST:                                    "CO_S_FIRST",
+	CO_S_LAST:                                     "CO_S_LAST",
+	CO_E_ALREADYINITIALIZED:                       "CO_E_ALREADYINITIALIZED",
+	CO_E_CANTDETERMINECLASS:                       "CO_E_CANTDETERMINECLASS",
+	CO_E_CLASSSTRING:                              "CO_E_CLASSSTRING",
+	CO_E_IIDSTRING:                                "CO_E_IIDSTRING",
+	CO_E_APPNOTFOUND:                              "CO_E_APPNOTFOUND",
+	CO_E_APPSINGLEUSE:                             "CO_E_APPSINGLEUSE",
+	CO_E_ERRORINAPP:                               "CO_E_ERRORINAPP",
+	CO_E_DLLNOTFOUND:                              "CO_E_DLLNOTFOUND",
+	CO_E_ERRORINDLL:                               "CO_E_ERRORINDLL",
+	CO_E_WRONGOSFORAPP:                            "CO_E_WRONGOSFORAPP",
+	CO_E_OBJNOTREG:                                "CO_E_OBJNOTREG",
+	CO_E_OBJISREG:                                 "CO_E_OBJISREG",
+	CO_E_OBJNOTCONNECTED:                          "CO_E_OBJNOTCONNECTED",
+	CO_E_APPDIDNTREG:                              "CO_E_APPDIDNTREG",
+	CO_E_FAILEDTOIMPERSONATE:                      "CO_E_FAILEDTOIMPERSONATE",
+	CO_E_FAILEDTOGETSECCTX:                        "CO_E_FAILEDTOGETSECCTX",
+	CO_E_FAILEDTOOPENTHREADTOKEN:                  "CO_E_FAILEDTOOPENTHREADTOKEN",
+	CO_E_FAILEDTOGETTOKENINFO:                     "CO_E_FAILEDTOGETTOKENINFO",
+	CO_E_TRUSTEEDOESNTMATCHCLIENT:                 "CO_E_TRUSTEEDOESNTMATCHCLIENT",
+	CO_E_FAILEDTOQUERYCLIENTBLANKET:               "CO_E_FAILEDTOQUERYCLIENTBLANKET",
+	CO_E_FAILEDTOSETDACL:                          "CO_E_FAILEDTOSETDACL",
+	CO_E_ACCESSCHECKFAILED:                        "CO_E_ACCESSCHECKFAILED",
+	CO_E_NETACCESSAPIFAILED:                       "CO_E_NETACCESSAPIFAILED",
+	CO_E_WRONGTRUSTEENAMESYNTAX:                   "CO_E_WRONGTRUSTEENAMESYNTAX",
+	CO_E_INVALIDSID:                               "CO_E_INVALIDSID",
+	CO_E_CONVERSIONFAILED:                         "CO_E_CONVERSIONFAILED",
+	CO_E_NOMATCHINGSIDFOUND:                       "CO_E_NOMATCHINGSIDFOUND",
+	CO_E_LOOKUPACCSIDFAILED:                       "CO_E_LOOKUPACCSIDFAILED",
+	CO_E_NOMATCHINGNAMEFOUND:                      "CO_E_NOMATCHINGNAMEFOUND",
+	CO_E_LOOKUPACCNAMEFAILED:                      "CO_E_LOOKUPACCNAMEFAILED",
+	CO_E_SETSERLHNDLFAILED:                        "CO_E_SETSERLHNDLFAILED",
+	CO_E_FAILEDTOGETWINDIR:                        "CO_E_FAILEDTOGETWINDIR",
+	CO_E_PATHTOOLONG:                              "CO_E_PATHTOOLONG",
+	CO_E_FAILEDTOGENUUID:                          "CO_E_FAILEDTOGENUUID",
+	CO_E_FAILEDTOCREATEFILE:                       "CO_E_FAILEDTOCREATEFILE",
+	CO_E_FAILEDTOCLOSEHANDLE:                      "CO_E_FAILEDTOCLOSEHANDLE",
+	CO_E_EXCEEDSYSACLLIMIT:                        "CO_E_EXCEEDSYSACLLIMIT",
+	CO_E_ACESINWRONGORDER:                         "CO_E_ACESINWRONGORDER",
+	CO_E_INCOMPATIBLESTREAMVERSION:                "CO_E_INCOMPATIBLESTREAMVERSION",
+	CO_E_FAILEDTOOPENPROCESSTOKEN:                 "CO_E_FAILEDTOOPENPROCESSTOKEN",
+	CO_E_DECODEFAILED:                             "CO_E_DECODEFAILED",
+	CO_E_ACNOTINITIALIZED:                         "CO_E_ACNOTINITIALIZED",
+	OLE_S_STATIC:                                  "OLE_S_STATIC",
+	OLE_S_MAC_CLIPFORMAT:                          "OLE_S_MAC_CLIPFORMAT",
+	DRAGDROP_S_CANCEL:                             "DRAGDROP_S_CANCEL",
+	DRAGDROP_S_USEDEFAULTCURSORS:                  "DRAGDROP_S_USEDEFAULTCURSORS",
+	CACHE_S_SAMECACHE:                             "CACHE_S_SAMECACHE",
+	CACHE_S_SOMECACHES_NOTUPDATED:                 "CACHE_S_SOMECACHES_NOTUPDATED",
+	OLEOBJ_S_CANNOT_DOVERB_NOW:                    "OLEOBJ_S_CANNOT_DOVERB_NOW",
+	OLEOBJ_S_INVALIDHWND:                          "OLEOBJ_S_INVALIDHWND",
+	MK_S_REDUCED_TO_SELF:                          "MK_S_REDUCED_TO_SELF",
+	MK_S_ME:                                       "MK_S_ME",
+	MK_S_HIM:                                      "MK_S_HIM",
+	MK_S_US:                                       "MK_S_US",
+	MK_S_MONIKERALREADYREGISTERED:                 "MK_S_MONIKERALREADYREGISTERED",
+	CO_E_CLASS_CREATE_FAILED:                      "CO_E_CLASS_CREATE_FAILED",
+	CO_E_SCM_ERROR:                                "CO_E_SCM_ERROR",
+	CO_E_SCM_RPC_FAILURE:                          "CO_E_SCM_RPC_FAILURE",
+	CO_E_BAD_PATH:                                 "CO_E_BAD_PATH",
+	CO_E_SERVER_EXEC_FAILURE:                      "CO_E_SERVER_EXEC_FAILURE",
+	CO_E_OBJSRV_RPC_FAILURE:                       "CO_E_OBJSRV_RPC_FAILURE",
+	MK_E_NO_NORMALIZED:                            "MK_E_NO_NORMALIZED",
+	CO_E_SERVER_STOPPING:                          "CO_E_SERVER_STOPPING",
+	MEM_E_INVALID_ROOT:                            "MEM_E_INVALID_ROOT",
+	MEM_E_INVALID_LINK:                            "MEM_E_INVALID_LINK",
+	MEM_E_INVALID_SIZE:                            "MEM_E_INVALID_SIZE",
+	CO_S_NOTALLINTERFACES:                         "CO_S_NOTALLINTERFACES",
+	DISP_E_UNKNOWNINTERFACE:                       "DISP_E_UNKNOWNINTERFACE",
+	DISP_E_MEMBERNOTFOUND:                         "DISP_E_MEMBERNOTFOUND",
+	DISP_E_PARAMNOTFOUND:                          "DISP_E_PARAMNOTFOUND",
+	DISP_E_TYPEMISMATCH:                           "DISP_E_TYPEMISMATCH",
+	DISP_E_UNKNOWNNAME:                            "DISP_E_UNKNOWNNAME",
+	DISP_E_NONAMEDARGS:                            "DISP_E_NONAMEDARGS",
+	DISP_E_BADVARTYPE:                             "DISP_E_BADVARTYPE",
+	DISP_E_EXCEPTION:                              "DISP_E_EXCEPTION",
+	DISP_E_OVERFLOW:                               "DISP_E_OVERFLOW",
+	DISP_E_BADINDEX:                               "DISP_E_BADINDEX",
+	DISP_E_UNKNOWNLCID:                            "DISP_E_UNKNOWNLCID",
+	DISP_E_ARRAYISLOCKED:                          "DISP_E_ARRAYISLOCKED",
+	DISP_E_BADPARAMCOUNT:                          "DISP_E_BADPARAMCOUNT",
+	DISP_E_PARAMNOTOPTIONAL:                       "DISP_E_PARAMNOTOPTIONAL",
+	DISP_E_BADCALLEE:                              "DISP_E_BADCALLEE",
+	DISP_E_NOTACOLLECTION:                         "DISP_E_NOTACOLLECTION",
+	DISP_E_DIVBYZERO:                              "DISP_E_DIVBYZERO",
+	TYPE_E_BUFFERTOOSMALL:                         "TYPE_E_BUFFERTOOSMALL",
+	TYPE_E_FIELDNOTFOUND:                          "TYPE_E_FIELDNOTFOUND",
+	TYPE_E_INVDATAREAD:                            "TYPE_E_INVDATAREAD",
+	TYPE_E_UNSUPFORMAT:                            "TYPE_E_UNSUPFORMAT",
+	TYPE_E_REGISTRYACCESS:                         "TYPE_E_REGISTRYACCESS",
+	TYPE_E_LIBNOTREGISTERED:                       "TYPE_E_LIBNOTREGISTERED",
+	TYPE_E_UNDEFINEDTYPE:                          "TYPE_E_UNDEFINEDTYPE",
+	TYPE_E_QUALIFIEDNAMEDISALLOWED:                "TYPE_E_QUALIFIEDNAMEDISALLOWED",
+	TYPE_E_INVALIDSTATE:                           "TYPE_E_INVALIDSTATE",
+	TYPE_E_WRONGTYPEKIND:                          "TYPE_E_WRONGTYPEKIND",
+	TYPE_E_ELEMENTNOTFOUND:                        "TYPE_E_ELEMENTNOTFOUND",
+	TYPE_E_AMBIGUOUSNAME:                          "TYPE_E_AMBIGUOUSNAME",
+	TYPE_E_NAMECONFLICT:                           "TYPE_E_NAMECONFLICT",
+	TYPE_E_UNKNOWNLCID:                            "TYPE_E_UNKNOWNLCID",
+	TYPE_E_DLLFUNCTIONNOTFOUND:                    "TYPE_E_DLLFUNCTIONNOTFOUND",
+	TYPE_E_BADMODULEKIND:                          "TYPE_E_BADMODULEKIND",
+	TYPE_E_SIZETOOBIG:                             "TYPE_E_SIZETOOBIG",
+	TYPE_E_DUPLICATEID:                            "TYPE_E_DUPLICATEID",
+	TYPE_E_INVALIDID:                              "TYPE_E_INVALIDID",
+	TYPE_E_TYPEMISMATCH:                           "TYPE_E_TYPEMISMATCH",
+	TYPE_E_OUTOFBOUNDS:                            "TYPE_E_OUTOFBOUNDS",
+	TYPE_E_IOERROR:                                "TYPE_E_IOERROR",
+	TYPE_E_CANTCREATETMPFILE:                      "TYPE_E_CANTCREATETMPFILE",
+	TYPE_E_CANTLOADLIBRARY:                        "TYPE_E_CANTLOADLIBRARY",
+	TYPE_E_INCONSISTENTPROPFUNCS:                  "TYPE_E_INCONSISTENTPROPFUNCS",
+	TYPE_E_CIRCULARTYPE:                           "TYPE_E_CIRCULARTYPE",
+	STG_E_INVALIDFUNCTION:                         "STG_E_INVALIDFUNCTION",
+	STG_E_FILENOTFOUND:                            "STG_E_FILENOTFOUND",
+	STG_E_PATHNOTFOUND:                            "STG_E_PATHNOTFOUND",
+	STG_E_TOOMANYOPENFILES:                        "STG_E_TOOMANYOPENFILES",
+	STG_E_ACCESSDENIED:                            "STG_E_ACCESSDENIED",
+	STG_E_INVALIDHANDLE:                           "STG_E_INVALIDHANDLE",
+	STG_E_INSUFFICIENTMEMORY:                      "STG_E_INSUFFICIENTMEMORY",
+	STG_E_INVALIDPOINTER:                          "STG_E_INVALIDPOINTER",
+	STG_E_NOMOREFILES:                             "STG_E_NOMOREFILES",
+	STG_E_DISKISWRITEPROTECTED:                    "STG_E_DISKISWRITEPROTECTED",
+	STG_E_SEEKERROR:                               "STG_E_SEEKERROR",
+	STG_E_WRITEFAULT:                              "STG_E_WRITEFAULT",
+	STG_E_READFAULT:                               "STG_E_READFAULT",
+	STG_E_SHAREVIOLATION:                          "STG_E_SHAREVIOLATION",
+	STG_E_LOCKVIOLATION:                           "STG_E_LOCKVIOLATION",
+	STG_E_FILEALREADYEXISTS:                       "STG_E_FILEALREADYEXISTS",
+	STG_E_INVALIDPARAMETER:                        "STG_E_INVALIDPARAMETER",
+	STG_E_MEDIUMFULL:                              "STG_E_MEDIUMFULL",
+	STG_E_PROPSETMISMATCHED:                       "STG_E_PROPSETMISMATCHED",
+	STG_E_ABNORMALAPIEXIT:                         "STG_E_ABNORMALAPIEXIT",
+	STG_E_INVALIDHEADER:                           "STG_E_INVALIDHEADER",
+	STG_E_INVALIDNAME:                             "STG_E_INVALIDNAME",
+	STG_E_UNKNOWN:                                 "STG_E_UNKNOWN",
+	STG_E_UNIMPLEMENTEDFUNCTION:                   "STG_E_UNIMPLEMENTEDFUNCTION",
+	STG_E_INVALIDFLAG:                             "STG_E_INVALIDFLAG",
+	STG_E_INUSE:                                   "STG_E_INUSE",
+	STG_E_NOTCURRENT:                              "STG_E_NOTCURRENT",
+	STG_E_REVERTED:                                "STG_E_REVERTED",
+	STG_E_CANTSAVE:                                "STG_E_CANTSAVE",
+	STG_E_OLDFORMAT:                               "STG_E_OLDFORMAT",
+	STG_E_OLDDLL:                                  "STG_E_OLDDLL",
+	STG_E_SHAREREQUIRED:                           "STG_E_SHAREREQUIRED",
+	STG_E_NOTFILEBASEDSTORAGE:                     "STG_E_NOTFILEBASEDSTORAGE",
+	STG_E_EXTANTMARSHALLINGS:                      "STG_E_EXTANTMARSHALLINGS",
+	STG_E_DOCFILECORRUPT:                          "STG_E_DOCFILECORRUPT",
+	STG_E_BADBASEADDRESS:                          "STG_E_BADBASEADDRESS",
+	STG_E_INCOMPLETE:                              "STG_E_INCOMPLETE",
+	STG_E_TERMINATED:                              "STG_E_TERMINATED",
+	STG_S_CONVERTED:                               "STG_S_CONVERTED",
+	STG_S_BLOCK:                                   "STG_S_BLOCK",
+	STG_S_RETRYNOW:                                "STG_S_RETRYNOW",
+	STG_S_MONITORING:                              "STG_S_MONITORING",
+	STG_S_MULTIPLEOPENS:                           "STG_S_MULTIPLEOPENS",
+	STG_S_CONSOLIDATIONFAILED:                     "STG_S_CONSOLIDATIONFAILED",
+	STG_S_CANNOTCONSOLIDATE:                       "STG_S_CANNOTCONSOLIDATE",
+	RPC_E_CALL_REJECTED:                           "RPC_E_CALL_REJECTED",
+	RPC_E_CALL_CANCELED:                           "RPC_E_CALL_CANCELED",
+	RPC_E_CANTPOST_INSENDCALL:                     "RPC_E_CANTPOST_INSENDCALL",
+	RPC_E_CANTCALLOUT_INASYNCCALL:                 "RPC_E_CANTCALLOUT_INASYNCCALL",
+	RPC_E_CANTCALLOUT_INEXTERNALCALL:              "RPC_E_CANTCALLOUT_INEXTERNALCALL",
+	RPC_E_CONNECTION_TERMINATED:                   "RPC_E_CONNECTION_TERMINATED",
+	RPC_E_SERVER_DIED:                             "RPC_E_SERVER_DIED",
+	RPC_E_CLIENT_DIED:                             "RPC_E_CLIENT_DIED",
+	RPC_E_INVALID_DATAPACKET:                      "RPC_E_INVALID_DATAPACKET",
+	RPC_E_CANTTRANSMIT_CALL:                       "RPC_E_CANTTRANSMIT_CALL",
+	RPC_E_CLIENT_CANTMARSHAL_DATA:                 "RPC_E_CLIENT_CANTMARSHAL_DATA",
+	RPC_E_CLIENT_CANTUNMARSHAL_DATA:               "RPC_E_CLIENT_CANTUNMARSHAL_DATA",
+	RPC_E_SERVER_CANTMARSHAL_DATA:                 "RPC_E_SERVER_CANTMARSHAL_DATA",
+	RPC_E_SERVER_CANTUNMARSHAL_DATA:               "RPC_E_SERVER_CANTUNMARSHAL_DATA",
+	RPC_E_INVALID_DATA:                            "RPC_E_INVALID_DATA",
+	RPC_E_INVALID_PARAMETER:                       "RPC_E_INVALID_PARAMETER",
+	RPC_E_CANTCALLOUT_AGAIN:                       "RPC_E_CANTCALLOUT_AGAIN",
+	RPC_E_SERVER_DIED_DNE:                         "RPC_E_SERVER_DIED_DNE",
+	RPC_E_SYS_CALL_FAILED:                         "RPC_E_SYS_CALL_FAILED",
+	RPC_E_OUT_OF_RESOURCES:                        "RPC_E_OUT_OF_RESOURCES",
+	RPC_E_ATTEMPTED_MULTITHREAD:                   "RPC_E_ATTEMPTED_MULTITHREAD",
+	RPC_E_NOT_REGISTERED:                          "RPC_E_NOT_REGISTERED",
+	RPC_E_FAULT:                                   "RPC_E_FAULT",
+	RPC_E_SERVERFAULT:                             "RPC_E_SERVERFAULT",
+	RPC_E_CHANGED_MODE:                            "RPC_E_CHANGED_MODE",
+	RPC_E_INVALIDMETHOD:                           "RPC_E_INVALIDMETHOD",
+	RPC_E_DISCONNECTED:                            "RPC_E_DISCONNECTED",
+	RPC_E_RETRY:                                   "RPC_E_RETRY",
+	RPC_E_SERVERCALL_RETRYLATER:                   "RPC_E_SERVERCALL_RETRYLATER",
+	RPC_E_SERVERCALL_REJECTED:                     "RPC_E_SERVERCALL_REJECTED",
+	RPC_E_INVALID_CALLDATA:                        "RPC_E_INVALID_CALLDATA",
+	RPC_E_CANTCALLOUT_ININPUTSYNCCALL:             "RPC_E_CANTCALLOUT_ININPUTSYNCCALL",
+	RPC_E_WRONG_THREAD:                            "RPC_E_WRONG_THREAD",
+	RPC_E_THREAD_NOT_INIT:                         "RPC_E_THREAD_NOT_INIT",
+	RPC_E_VERSION_MISMATCH:                        "RPC_E_VERSION_MISMATCH",
+	RPC_E_INVALID_HEADER:                          "RPC_E_INVALID_HEADER",
+	RPC_E_INVALID_EXTENSION:                       "RPC_E_INVALID_EXTENSION",
+	RPC_E_INVALID_IPID:                            "RPC_E_INVALID_IPID",
+	RPC_E_INVALID_OBJECT:                          "RPC_E_INVALID_OBJECT",
+	RPC_S_CALLPENDING:                             "RPC_S_CALLPENDING",
+	RPC_S_WAITONTIMER:                             "RPC_S_WAITONTIMER",
+	RPC_E_CALL_COMPLETE:                           "RPC_E_CALL_COMPLETE",
+	RPC_E_UNSECURE_CALL:                           "RPC_E_UNSECURE_CALL",
+	RPC_E_TOO_LATE:                                "RPC_E_TOO_LATE",
+	RPC_E_NO_GOOD_SECURITY_PACKAGES:               "RPC_E_NO_GOOD_SECURITY_PACKAGES",
+	RPC_E_ACCESS_DENIED:                           "RPC_E_ACCESS_DENIED",
+	RPC_E_REMOTE_DISABLED:                         "RPC_E_REMOTE_DISABLED",
+	RPC_E_INVALID_OBJREF:                          "RPC_E_INVALID_OBJREF",
+	RPC_E_NO_CONTEXT:                              "RPC_E_NO_CONTEXT",
+	RPC_E_TIMEOUT:                                 "RPC_E_TIMEOUT",
+	RPC_E_NO_SYNC:                                 "RPC_E_NO_SYNC",
+	RPC_E_UNEXPECTED:                              "RPC_E_UNEXPECTED",
+	NTE_BAD_UID:                                   "NTE_BAD_UID",
+	NTE_BAD_HASH:                                  "NTE_BAD_HASH",
+	NTE_BAD_KEY:                                   "NTE_BAD_KEY",
+	NTE_BAD_LEN:                                   "NTE_BAD_LEN",
+	NTE_BAD_DATA:                                  "NTE_BAD_DATA",
+	NTE_BAD_SIGNATURE:                             "NTE_BAD_SIGNATURE",
+	NTE_BAD_VER:                                   "NTE_BAD_VER",
+	NTE_BAD_ALGID:                                 "NTE_BAD_ALGID",
+	NTE_BAD_FLAGS:                                 "NTE_BAD_FLAGS",
+	NTE_BAD_TYPE:                                  "NTE_BAD_TYPE",
+	NTE_BAD_KEY_STATE:                             "NTE_BAD_KEY_STATE",
+	NTE_BAD_HASH_STATE:                            "NTE_BAD_HASH_STATE",
+	NTE_NO_KEY:                                    "NTE_NO_KEY",
+	NTE_NO_MEMORY:                                 "NTE_NO_MEMORY",
+	NTE_EXISTS:                                    "NTE_EXISTS",
+	NTE_PERM:                                      "NTE_PERM",
+	NTE_NOT_FOUND:                                 "NTE_NOT_FOUND",
+	NTE_DOUBLE_ENCRYPT:                            "NTE_DOUBLE_ENCRYPT",
+	NTE_BAD_PROVIDER:                              "NTE_BAD_PROVIDER",
+	NTE_BAD_PROV_TYPE:                             "NTE_BAD_PROV_TYPE",
+	NTE_BAD_PUBLIC_KEY:                            "NTE_BAD_PUBLIC_KEY",
+	NTE_BAD_KEYSET:                                "NTE_BAD_KEYSET",
+	NTE_PROV_TYPE_NOT_DEF:                         "NTE_PROV_TYPE_NOT_DEF",
+	NTE_PROV_TYPE_ENTRY_BAD:                       "NTE_PROV_TYPE_ENTRY_BAD",
+	NTE_KEYSET_NOT_DEF:                            "NTE_KEYSET_NOT_DEF",
+	NTE_KEYSET_ENTRY_BAD:                          "NTE_KEYSET_ENTRY_BAD",
+	NTE_PROV_TYPE_NO_MATCH:                        "NTE_PROV_TYPE_NO_MATCH",
+	NTE_SIGNATURE_FILE_BAD:                        "NTE_SIGNATURE_FILE_BAD",
+	NTE_PROVIDER_DLL_FAIL:                         "NTE_PROVIDER_DLL_FAIL",
+	NTE_PROV_DLL_NOT_FOUND:                        "NTE_PROV_DLL_NOT_FOUND",
+	NTE_BAD_KEYSET_PARAM:                          "NTE_BAD_KEYSET_PARAM",
+	NTE_FAIL:                                      "NTE_FAIL",
+	NTE_SYS_ERR:                                   "NTE_SYS_ERR",
+	CRYPT_E_MSG_ERROR:                             "CRYPT_E_MSG_ERROR",
+	CRYPT_E_UNKNOWN_ALGO:                          "CRYPT_E_UNKNOWN_ALGO",
+	CRYPT_E_OID_FORMAT:                            "CRYPT_E_OID_FORMAT",
+	CRYPT_E_INVALID_MSG_TYPE:                      "CRYPT_E_INVALID_MSG_TYPE",
+	CRYPT_E_UNEXPECTED_ENCODING:                   "CRYPT_E_UNEXPECTED_ENCODING",
+	CRYPT_E_AUTH_ATTR_MISSING:                     "CRYPT_E_AUTH_ATTR_MISSING",
+	CRYPT_E_HASH_VALUE:                            "CRYPT_E_HASH_VALUE",
+	CRYPT_E_INVALID_INDEX:                         "CRYPT_E_INVALID_INDEX",
+	CRYPT_E_ALREADY_DECRYPTED:                     "CRYPT_E_ALREADY_DECRYPTED",
+	CRYPT_E_NOT_DECRYPTED:                         "CRYPT_E_NOT_DECRYPTED",
+	CRYPT_E_RECIPIENT_NOT_FOUND:                   "CRYPT_E_RECIPIENT_NOT_FOUND",
+	CRYPT_E_CONTROL_TYPE:                          "CRYPT_E_CONTROL_TYPE",
+	CRYPT_E_ISSUER_SERIALNUMBER:                   "CRYPT_E_ISSUER_SERIALNUMBER",
+	CRYPT_E_SIGNER_NOT_FOUND:                      "CRYPT_E_SIGNER_NOT_FOUND",
+	CRYPT_E_ATTRIBUTES_MISSING:                    "CRYPT_E_ATTRIBUTES_MISSING",
+	CRYPT_E_STREAM_MSG_NOT_READY:                  "CRYPT_E_STREAM_MSG_NOT_READY",
+	CRYPT_E_STREAM_INSUFFICIENT_DATA:              "CRYPT_E_STREAM_INSUFFICIENT_DATA",
+	CRYPT_E_BAD_LEN:                               "CRYPT_E_BAD_LEN",
+	CRYPT_E_BAD_ENCODE:                            "CRYPT_E_BAD_ENCODE",
+	CRYPT_E_FILE_ERROR:                            "CRYPT_E_FILE_ERROR",
+	CRYPT_E_NOT_FOUND:                             "CRYPT_E_NOT_FOUND",
+	CRYPT_E_EXISTS:                                "CRYPT_E_EXISTS",
+	CRYPT_E_NO_PROVIDER:                           "CRYPT_E_NO_PROVIDER",
+	CRYPT_E_SELF_SIGNED:                           "CRYPT_E_SELF_SIGNED",
+	CRYPT_E_DELETED_PREV:                          "CRYPT_E_DELETED_PREV",
+	CRYPT_E_NO_MATCH:                              "CRYPT_E_NO_MATCH",
+	CRYPT_E_UNEXPECTED_MSG_TYPE:                   "CRYPT_E_UNEXPECTED_MSG_TYPE",
+	CRYPT_E_NO_KEY_PROPERTY:                       "CRYPT_E_NO_KEY_PROPERTY",
+	CRYPT_E_NO_DECRYPT_CERT:                       "CRYPT_E_NO_DECRYPT_CERT",
+	CRYPT_E_BAD_MSG:                               "CRYPT_E_BAD_MSG",
+	CRYPT_E_NO_SIGNER:                             "CRYPT_E_NO_SIGNER",
+	CRYPT_E_PENDING_CLOSE:                         "CRYPT_E_PENDING_CLOSE",
+	CRYPT_E_REVOKED:                               "CRYPT_E_REVOKED",
+	CRYPT_E_NO_REVOCATION_DLL:                     "CRYPT_E_NO_REVOCATION_DLL",
+	CRYPT_E_NO_REVOCATION_CHECK:                   "CRYPT_E_NO_REVOCATION_CHECK",
+	CRYPT_E_REVOCATION_OFFLINE:                    "CRYPT_E_REVOCATION_OFFLINE",
+	CRYPT_E_NOT_IN_REVOCATION_DATABASE:            "CRYPT_E_NOT_IN_REVOCATION_DATABASE",
+	CRYPT_E_INVALID_NUMERIC_STRING:                "CRYPT_E_INVALID_NUMERIC_STRING",
+	CRYPT_E_INVALID_PRINTABLE_STRING:              "CRYPT_E_INVALID_PRINTABLE_STRING",
+	CRYPT_E_INVALID_IA5_STRING:                    "CRYPT_E_INVALID_IA5_STRING",
+	CRYPT_E_INVALID_X500_STRING:                   "CRYPT_E_INVALID_X500_STRING",
+	CRYPT_E_NOT_CHAR_STRING:                       "CRYPT_E_NOT_CHAR_STRING",
+	CRYPT_E_FILERESIZED:                           "CRYPT_E_FILERESIZED",
+	CRYPT_E_SECURITY_SETTINGS:                     "CRYPT_E_SECURITY_SETTINGS",
+	CRYPT_E_NO_VERIFY_USAGE_DLL:                   "CRYPT_E_NO_VERIFY_USAGE_DLL",
+	CRYPT_E_NO_VERIFY_USAGE_CHECK:                 "CRYPT_E_NO_VERIFY_USAGE_CHECK",
+	CRYPT_E_VERIFY_USAGE_OFFLINE:                  "CRYPT_E_VERIFY_USAGE_OFFLINE",
+	CRYPT_E_NOT_IN_CTL:                            "CRYPT_E_NOT_IN_CTL",
+	CRYPT_E_NO_TRUSTED_SIGNER:                     "CRYPT_E_NO_TRUSTED_SIGNER",
+	CRYPT_E_OSS_ERROR:                             "CRYPT_E_OSS_ERROR",
+	CERTSRV_E_BAD_REQUESTSUBJECT:                  "CERTSRV_E_BAD_REQUESTSUBJECT",
+	CERTSRV_E_NO_REQUEST:                          "CERTSRV_E_NO_REQUEST",
+	CERTSRV_E_BAD_REQUESTSTATUS:                   "CERTSRV_E_BAD_REQUESTSTATUS",
+	CERTSRV_E_PROPERTY_EMPTY:                      "CERTSRV_E_PROPERTY_EMPTY",
+	CERTDB_E_JET_ERROR:                            "CERTDB_E_JET_ERROR",
+	TRUST_E_SYSTEM_ERROR:                          "TRUST_E_SYSTEM_ERROR",
+	TRUST_E_NO_SIGNER_CERT:                        "TRUST_E_NO_SIGNER_CERT",
+	TRUST_E_COUNTER_SIGNER:                        "TRUST_E_COUNTER_SIGNER",
+	TRUST_E_CERT_SIGNATURE:                        "TRUST_E_CERT_SIGNATURE",
+	TRUST_E_TIME_STAMP:                            "TRUST_E_TIME_STAMP",
+	TRUST_E_BAD_DIGEST:                            "TRUST_E_BAD_DIGEST",
+	TRUST_E_BASIC_CONSTRAINTS:                     "TRUST_E_BASIC_CONSTRAINTS",
+	TRUST_E_FINANCIAL_CRITERIA:                    "TRUST_E_FINANCIAL_CRITERIA",
+	TRUST_E_PROVIDER_UNKNOWN:                      "TRUST_E_PROVIDER_UNKNOWN",
+	TRUST_E_ACTION_UNKNOWN:                        "TRUST_E_ACTION_UNKNOWN",
+	TRUST_E_SUBJECT_FORM_UNKNOWN:                  "TRUST_E_SUBJECT_FORM_UNKNOWN",
+	TRUST_E_SUBJECT_NOT_TRUSTED:                   "TRUST_E_SUBJECT_NOT_TRUSTED",
+	DIGSIG_E_ENCODE:                               "DIGSIG_E_ENCODE",
+	DIGSIG_E_DECODE:                               "DIGSIG_E_DECODE",
+	DIGSIG_E_EXTENSIBILITY:                        "DIGSIG_E_EXTENSIBILITY",
+	DIGSIG_E_CRYPTO:                               "DIGSIG_E_CRYPTO",
+	PERSIST_E_SIZEDEFINITE:                        "PERSIST_E_SIZEDEFINITE",
+	PERSIST_E_SIZEINDEFINITE:                      "PERSIST_E_SIZEINDEFINITE",
+	PERSIST_E_NOTSELFSIZING:                       "PERSIST_E_NOTSELFSIZING",
+	TRUST_E_NOSIGNATURE:                           "TRUST_E_NOSIGNATURE",
+	CERT_E_EXPIRED:                                "CERT_E_EXPIRED",
+	CERT_E_VALIDITYPERIODNESTING:                  "CERT_E_VALIDITYPERIODNESTING",
+	CERT_E_ROLE:                                   "CERT_E_ROLE",
+	CERT_E_PATHLENCONST:                           "CERT_E_PATHLENCONST",
+	CERT_E_CRITICAL:                               "CERT_E_CRITICAL",
+	CERT_E_PURPOSE:                                "CERT_E_PURPOSE",
+	CERT_E_ISSUERCHAINING:                         "CERT_E_ISSUERCHAINING",
+	CERT_E_MALFORMED:                              "CERT_E_MALFORMED",
+	CERT_E_UNTRUSTEDROOT:                          "CERT_E_UNTRUSTEDROOT",
+	CERT_E_CHAINING:                               "CERT_E_CHAINING",
+	TRUST_E_FAIL:                                  "TRUST_E_FAIL",
+	CERT_E_REVOKED:                                "CERT_E_REVOKED",
+	CERT_E_UNTRUSTEDTESTROOT:                      "CERT_E_UNTRUSTEDTESTROOT",
+	CERT_E_REVOCATION_FAILURE:                     "CERT_E_REVOCATION_FAILURE",
+	CERT_E_CN_NO_MATCH:                            "CERT_E_CN_NO_MATCH",
+	CERT_E_WRONG_USAGE:                            "CERT_E_WRONG_USAGE",
+	SPAPI_E_EXPECTED_SECTION_NAME:                 "SPAPI_E_EXPECTED_SECTION_NAME",
+	SPAPI_E_BAD_SECTION_NAME_LINE:                 "SPAPI_E_BAD_SECTION_NAME_LINE",
+	SPAPI_E_SECTION_NAME_TOO_LONG:                 "SPAPI_E_SECTION_NAME_TOO_LONG",
+	SPAPI_E_GENERAL_SYNTAX:                        "SPAPI_E_GENERAL_SYNTAX",
+	SPAPI_E_WRONG_INF_STYLE:                       "SPAPI_E_WRONG_INF_STYLE",
+	SPAPI_E_SECTION_NOT_FOUND:                     "SPAPI_E_SECTION_NOT_FOUND",
+	SPAPI_E_LINE_NOT_FOUND:                        "SPAPI_E_LINE_NOT_FOUND",
+	SPAPI_E_NO_ASSOCIATED_CLASS:                   "SPAPI_E_NO_ASSOCIATED_CLASS",
+	SPAPI_E_CLASS_MISMATCH:                        "SPAPI_E_CLASS_MISMATCH",
+	SPAPI_E_DUPLICATE_FOUND:                       "SPAPI_E_DUPLICATE_FOUND",
+	SPAPI_E_NO_DRIVER_SELECTED:                    "SPAPI_E_NO_DRIVER_SELECTED",
+	SPAPI_E_KEY_DOES_NOT_EXIST:                    "SPAPI_E_KEY_DOES_NOT_EXIST",
+	SPAPI_E_INVALID_DEVINST_NAME:                  "SPAPI_E_INVALID_DEVINST_NAME",
+	SPAPI_E_INVALID_CLASS:                         "SPAPI_E_INVALID_CLASS",
+	SPAPI_E_DEVINST_ALREADY_EXISTS:                "SPAPI_E_DEVINST_ALREADY_EXISTS",
+	SPAPI_E_DEVINFO_NOT_REGISTERED:                "SPAPI_E_DEVINFO_NOT_REGISTERED",
+	SPAPI_E_INVALID_REG_PROPERTY:                  "SPAPI_E_INVALID_REG_PROPERTY",
+	SPAPI_E_NO_INF:                                "SPAPI_E_NO_INF",
+	SPAPI_E_NO_SUCH_DEVINST:                       "SPAPI_E_NO_SUCH_DEVINST",
+	SPAPI_E_CANT_LOAD_CLASS_ICON:                  "SPAPI_E_CANT_LOAD_CLASS_ICON",
+	SPAPI_E_INVALID_CLASS_INSTALLER:               "SPAPI_E_INVALID_CLASS_INSTALLER",
+	SPAPI_E_DI_DO_DEFAULT:                         "SPAPI_E_DI_DO_DEFAULT",
+	SPAPI_E_DI_NOFILECOPY:                         "SPAPI_E_DI_NOFILECOPY",
+	SPAPI_E_INVALID_HWPROFILE:                     "SPAPI_E_INVALID_HWPROFILE",
+	SPAPI_E_NO_DEVICE_SELECTED:                    "SPAPI_E_NO_DEVICE_SELECTED",
+	SPAPI_E_DEVINFO_LIST_LOCKED:                   "SPAPI_E_DEVINFO_LIST_LOCKED",
+	SPAPI_E_DEVINFO_DATA_LOCKED:                   "SPAPI_E_DEVINFO_DATA_LOCKED",
+	SPAPI_E_DI_BAD_PATH:                           "SPAPI_E_DI_BAD_PATH",
+	SPAPI_E_NO_CLASSINSTALL_PARAMS:                "SPAPI_E_NO_CLASSINSTALL_PARAMS",
+	SPAPI_E_FILEQUEUE_LOCKED:                      "SPAPI_E_FILEQUEUE_LOCKED",
+	SPAPI_E_BAD_SERVICE_INSTALLSECT:               "SPAPI_E_BAD_SERVICE_INSTALLSECT",
+	SPAPI_E_NO_CLASS_DRIVER_LIST:                  "SPAPI_E_NO_CLASS_DRIVER_LIST",
+	SPAPI_E_NO_ASSOCIATED_SERVICE:                 "SPAPI_E_NO_ASSOCIATED_SERVICE",
+	SPAPI_E_NO_DEFAULT_DEVICE_INTERFACE:           "SPAPI_E_NO_DEFAULT_DEVICE_INTERFACE",
+	SPAPI_E_DEVICE_INTERFACE_ACTIVE:               "SPAPI_E_DEVICE_INTERFACE_ACTIVE",
+	SPAPI_E_DEVICE_INTERFACE_REMOVED:              "SPAPI_E_DEVICE_INTERFACE_REMOVED",
+	SPAPI_E_BAD_INTERFACE_INSTALLSECT:             "SPAPI_E_BAD_INTERFACE_INSTALLSECT",
+	SPAPI_E_NO_SUCH_INTERFACE_CLASS:               "SPAPI_E_NO_SUCH_INTERFACE_CLASS",
+	SPAPI_E_INVALID_REFERENCE_STRING:              "SPAPI_E_INVALID_REFERENCE_STRING",
+	SPAPI_E_INVALID_MACHINENAME:                   "SPAPI_E_INVALID_MACHINENAME",
+	SPAPI_E_REMOTE_COMM_FAILURE:                   "SPAPI_E_REMOTE_COMM_FAILURE",
+	SPAPI_E_MACHINE_UNAVAILABLE:                   "SPAPI_E_MACHINE_UNAVAILABLE",
+	SPAPI_E_NO_CONFIGMGR_SERVICES:                 "SPAPI_E_NO_CONFIGMGR_SERVICES",
+	SPAPI_E_INVALID_PROPPAGE_PROVIDER:             "SPAPI_E_INVALID_PROPPAGE_PROVIDER",
+	SPAPI_E_NO_SUCH_DEVICE_INTERFACE:              "SPAPI_E_NO_SUCH_DEVICE_INTERFACE",
+	SPAPI_E_DI_POSTPROCESSING_REQUIRED:            "SPAPI_E_DI_POSTPROCESSING_REQUIRED",
+	SPAPI_E_INVALID_COINSTALLER:                   "SPAPI_E_INVALID_COINSTALLER",
+	SPAPI_E_NO_COMPAT_DRIVERS:                     "SPAPI_E_NO_COMPAT_DRIVERS",
+	SPAPI_E_NO_DEVICE_ICON:                        "SPAPI_E_NO_DEVICE_ICON",
+	SPAPI_E_INVALID_INF_LOGCONFIG:                 "SPAPI_E_INVALID_INF_LOGCONFIG",
+	SPAPI_E_DI_DONT_INSTALL:                       "SPAPI_E_DI_DONT_INSTALL",
+	SPAPI_E_INVALID_FILTER_DRIVER:                 "SPAPI_E_INVALID_FILTER_DRIVER",
+	SPAPI_E_ERROR_NOT_INSTALLED:                   "SPAPI_E_ERROR_NOT_INSTALLED",
+	CO_E_ATTEMPT_TO_CREATE_OUTSIDE_CLIENT_CONTEXT: "CO_E_ATTEMPT_TO_CREATE_OUTSIDE_CLIENT_CONTEXT",
+	CO_E_SERVER_PAUSED:                            "CO_E_SERVER_PAUSED",
+	CO_E_SERVER_NOT_PAUSED:                        "CO_E_SERVER_NOT_PAUSED",
+	CO_E_CLASS_DISABLED:                           "CO_E_CLASS_DISABLED",
+	CO_E_CLRNOTAVAILABLE:                          "CO_E_CLRNOTAVAILABLE",
+	CO_E_ASYNC_WORK_REJECTED:                      "CO_E_ASYNC_WORK_REJECTED",
+	CO_E_SERVER_INIT_TIMEOUT:                      "CO_E_SERVER_INIT_TIMEOUT",
+	CO_E_NO_SECCTX_IN_ACTIVATE:                    "CO_E_NO_SECCTX_IN_ACTIVATE",
+	CO_E_TRACKER_CONFIG:                           "CO_E_TRACKER_CONFIG",
+	CO_E_THREADPOOL_CONFIG:                        "CO_E_THREADPOOL_CONFIG",
+	CO_E_SXS_CONFIG:                               "CO_E_SXS_CONFIG",
+	CO_E_MALFORMED_SPN:                            "CO_E_MALFORMED_SPN",
+	REGDB_E_BADTHREADINGMODEL:                     "REGDB_E_BADTHREADINGMODEL",
+	CS_E_OBJECT_NOTFOUND:                          "CS_E_OBJECT_NOTFOUND",
+	CS_E_OBJECT_ALREADY_EXISTS:                    "CS_E_OBJECT_ALREADY_EXISTS",
+	CS_E_INVALID_PATH:                             "CS_E_INVALID_PATH",
+	CS_E_NETWORK_ERROR:                            "CS_E_NETWORK_ERROR",
+	CS_E_ADMIN_LIMIT_EXCEEDED:                     "CS_E_ADMIN_LIMIT_EXCEEDED",
+	CS_E_SCHEMA_MISMATCH:                          "CS_E_SCHEMA_MISMATCH",
+	EVENT_E_FIRST:                                 "EVENT_E_FIRST",
+	EVENT_E_LAST:                                  "EVENT_E_LAST",
+	EVENT_S_SOME_SUBSCRIBERS_FAILED:               "EVENT_S_SOME_SUBSCRIBERS_FAILED",
+	EVENT_S_LAST:                                  "EVENT_S_LAST",
+	EVENT_E_ALL_SUBSCRIBERS_FAILED:                "EVENT_E_ALL_SUBSCRIBERS_FAILED",
+	EVENT_S_NOSUBSCRIBERS:                         "EVENT_S_NOSUBSCRIBERS",
+	EVENT_E_QUERYSYNTAX:                           "EVENT_E_QUERYSYNTAX",
+	EVENT_E_QUERYFIELD:                            "EVENT_E_QUERYFIELD",
+	EVENT_E_INTERNALEXCEPTION:                     "EVENT_E_INTERNALEXCEPTION",
+	EVENT_E_INTERNALERROR:                         "EVENT_E_INTERNALERROR",
+	EVENT_E_INVALID_PER_USER_SID:                  "EVENT_E_INVALID_PER_USER_SID",
+	EVENT_E_USER_EXCEPTION:                        "EVENT_E_USER_EXCEPTION",
+	EVENT_E_TOO_MANY_METHODS:                      "EVENT_E_TOO_MANY_METHODS",
+	EVENT_E_MISSING_EVENTCLASS:                    "EVENT_E_MISSING_EVENTCLASS",
+	EVENT_E_NOT_ALL_REMOVED:                       "EVENT_E_NOT_ALL_REMOVED",
+	EVENT_E_COMPLUS_NOT_INSTALLED:                 "EVENT_E_COMPLUS_NOT_INSTALLED",
+	EVENT_E_CANT_MODIFY_OR_DELETE_UNCONFIGURED_OBJECT:        "EVENT_E_CANT_MODIFY_OR_DELETE_UNCONFIGURED_OBJECT",
+	EVENT_E_CANT_MODIFY_OR_DELETE_CONFIGURED_OBJECT:          "EVENT_E_CANT_MODIFY_OR_DELETE_CONFIGURED_OBJECT",
+	EVENT_E_INVALID_EVENT_CLASS_PARTITION:                    "EVENT_E_INVALID_EVENT_CLASS_PARTITION",
+	EVENT_E_PER_USER_SID_NOT_LOGGED_ON:                       "EVENT_E_PER_USER_SID_NOT_LOGGED_ON",
+	XACT_E_ALREADYOTHERSINGLEPHASE:                           "XACT_E_ALREADYOTHERSINGLEPHASE",
+	XACT_E_CANTRETAIN:                                        "XACT_E_CANTRETAIN",
+	XACT_E_COMMITFAILED:                                      "XACT_E_COMMITFAILED",
+	XACT_E_COMMITPREVENTED:                                   "XACT_E_COMMITPREVENTED",
+	XACT_E_HEURISTICABORT:                                    "XACT_E_HEURISTICABORT",
+	XACT_E_HEURISTICCOMMIT:                                   "XACT_E_HEURISTICCOMMIT",
+	XACT_E_HEURISTICDAMAGE:                                   "XACT_E_HEURISTICDAMAGE",
+	XACT_E_HEURISTICDANGER:                                   "XACT_E_HEURISTICDANGER",
+	XACT_E_ISOLATIONLEVEL:                                    "XACT_E_ISOLATIONLEVEL",
+	XACT_E_NOASYNC:                                           "XACT_E_NOASYNC",
+	XACT_E_NOENLIST:                                          "XACT_E_NOENLIST",
+	XACT_E_NOISORETAIN:                                       "XACT_E_NOISORETAIN",
+	XACT_E_NORESOURCE:                                        "XACT_E_NORESOURCE",
+	XACT_E_NOTCURRENT:                                        "XACT_E_NOTCURRENT",
+	XACT_E_NOTRANSACTION:                                     "XACT_E_NOTRANSACTION",
+	XACT_E_NOTSUPPORTED:                                      "XACT_E_NOTSUPPORTED",
+	XACT_E_UNKNOWNRMGRID:                                     "XACT_E_UNKNOWNRMGRID",
+	XACT_E_WRONGSTATE:                                        "XACT_E_WRONGSTATE",
+	XACT_E_WRONGUOW:                                          "XACT_E_WRONGUOW",
+	XACT_E_XTIONEXISTS:                                       "XACT_E_XTIONEXISTS",
+	XACT_E_NOIMPORTOBJECT:                                    "XACT_E_NOIMPORTOBJECT",
+	XACT_E_INVALIDCOOKIE:                                     "XACT_E_INVALIDCOOKIE",
+	XACT_E_INDOUBT:                                           "XACT_E_INDOUBT",
+	XACT_E_NOTIMEOUT:                                         "XACT_E_NOTIMEOUT",
+	XACT_E_ALREADYINPROGRESS:                                 "XACT_E_ALREADYINPROGRESS",
+	XACT_E_ABORTED:                                           "XACT_E_ABORTED",
+	XACT_E_LOGFULL:                                           "XACT_E_LOGFULL",
+	XACT_E_TMNOTAVAILABLE:                                    "XACT_E_TMNOTAVAILABLE",
+	XACT_E_CONNECTION_DOWN:                                   "XACT_E_CONNECTION_DOWN",
+	XACT_E_CONNECTION_DENIED:                                 "XACT_E_CONNECTION_DENIED",
+	XACT_E_REENLISTTIMEOUT:                                   "XACT_E_REENLISTTIMEOUT",
+	XACT_E_TIP_CONNECT_FAILED:                                "XACT_E_TIP_CONNECT_FAILED",
+	XACT_E_TIP_PROTOCOL_ERROR:                                "XACT_E_TIP_PROTOCOL_ERROR",
+	XACT_E_TIP_PULL_FAILED:                                   "XACT_E_TIP_PULL_FAILED",
+	XACT_E_DEST_TMNOTAVAILABLE:                               "XACT_E_DEST_TMNOTAVAILABLE",
+	XACT_E_TIP_DISABLED:                                      "XACT_E_TIP_DISABLED",
+	XACT_E_NETWORK_TX_DISABLED:                               "XACT_E_NETWORK_TX_DISABLED",
+	XACT_E_PARTNER_NETWORK_TX_DISABLED:                       "XACT_E_PARTNER_NETWORK_TX_DISABLED",
+	XACT_E_XA_TX_DISABLED:                                    "XACT_E_XA_TX_DISABLED",
+	XACT_E_UNABLE_TO_READ_DTC_CONFIG:                         "XACT_E_UNABLE_TO_READ_DTC_CONFIG",
+	XACT_E_UNABLE_TO_LOAD_DTC_PROXY:                          "XACT_E_UNABLE_TO_LOAD_DTC_PROXY",
+	XACT_E_ABORTING:                                          "XACT_E_ABORTING",
+	XACT_E_CLERKNOTFOUND:                                     "XACT_E_CLERKNOTFOUND",
+	XACT_E_CLERKEXISTS:                                       "XACT_E_CLERKEXISTS",
+	XACT_E_RECOVERYINPROGRESS:                                "XACT_E_RECOVERYINPROGRESS",
+	XACT_E_TRANSACTIONCLOSED:                                 "XACT_E_TRANSACTIONCLOSED",
+	XACT_E_INVALIDLSN:                                        "XACT_E_INVALIDLSN",
+	XACT_E_REPLAYREQUEST:                                     "XACT_E_REPLAYREQUEST",
+	XACT_S_ASYNC:                                             "XACT_S_ASYNC",
+	XACT_S_DEFECT:                                            "XACT_S_DEFECT",
+	XACT_S_READONLY:                                          "XACT_S_READONLY",
+	XACT_S_SOMENORETAIN:                                      "XACT_S_SOMENORETAIN",
+	XACT_S_OKINFORM:                                          "XACT_S_OKINFORM",
+	XACT_S_MADECHANGESCONTENT:                                "XACT_S_MADECHANGESCONTENT",
+	XACT_S_MADECHANGESINFORM:                                 "XACT_S_MADECHANGESINFORM",
+	XACT_S_ALLNORETAIN:                                       "XACT_S_ALLNORETAIN",
+	XACT_S_ABORTING:                                          "XACT_S_ABORTING",
+	XACT_S_SINGLEPHASE:                                       "XACT_S_SINGLEPHASE",
+	XACT_S_LOCALLY_OK:                                        "XACT_S_LOCALLY_OK",
+	XACT_S_LASTRESOURCEMANAGER:                               "XACT_S_LASTRESOURCEMANAGER",
+	CONTEXT_E_FIRST:                                          "CONTEXT_E_FIRST",
+	CO_E_ISOLEVELMISMATCH:                                    "CO_E_ISOLEVELMISMATCH",
+	CONTEXT_S_FIRST:                                          "CONTEXT_S_FIRST",
+	CONTEXT_S_LAST:                                           "CONTEXT_S_LAST",
+	CONTEXT_E_ABORTED:                                        "CONTEXT_E_ABORTED",
+	CONTEXT_E_ABORTING:                                       "CONTEXT_E_ABORTING",
+	CONTEXT_E_NOCONTEXT:                                      "CONTEXT_E_NOCONTEXT",
+	CONTEXT_E_WOULD_DEADLOCK:                                 "CONTEXT_E_WOULD_DEADLOCK",
+	CONTEXT_E_SYNCH_TIMEOUT:                                  "CONTEXT_E_SYNCH_TIMEOUT",
+	CONTEXT_E_OLDREF:                                         "CONTEXT_E_OLDREF",
+	CONTEXT_E_ROLENOTFOUND:                                   "CONTEXT_E_ROLENOTFOUND",
+	CONTEXT_E_TMNOTAVAILABLE:                                 "CONTEXT_E_TMNOTAVAILABLE",
+	CO_E_ACTIVATIONFAILED:                                    "CO_E_ACTIVATIONFAILED",
+	CO_E_ACTIVATIONFAILED_EVENTLOGGED:                        "CO_E_ACTIVATIONFAILED_EVENTLOGGED",
+	CO_E_ACTIVATIONFAILED_CATALOGERROR:                       "CO_E_ACTIVATIONFAILED_CATALOGERROR",
+	CO_E_ACTIVATIONFAILED_TIMEOUT:                            "CO_E_ACTIVATIONFAILED_TIMEOUT",
+	CO_E_INITIALIZATIONFAILED:                                "CO_E_INITIALIZATIONFAILED",
+	CONTEXT_E_NOJIT:                                          "CONTEXT_E_NOJIT",
+	CONTEXT_E_NOTRANSACTION:                                  "CONTEXT_E_NOTRANSACTION",
+	CO_E_THREADINGMODEL_CHANGED:                              "CO_E_THREADINGMODEL_CHANGED",
+	CO_E_NOIISINTRINSICS:                                     "CO_E_NOIISINTRINSICS",
+	CO_E_NOCOOKIES:                                           "CO_E_NOCOOKIES",
+	CO_E_DBERROR:                                             "CO_E_DBERROR",
+	CO_E_NOTPOOLED:                                           "CO_E_NOTPOOLED",
+	CO_E_NOTCONSTRUCTED:                                      "CO_E_NOTCONSTRUCTED",
+	CO_E_NOSYNCHRONIZATION:                                   "CO_E_NOSYNCHRONIZATION",
+	SCHED_S_TASK_READY:                                       "SCHED_S_TASK_READY",
+	SCHED_S_TASK_RUNNING:                                     "SCHED_S_TASK_RUNNING",
+	SCHED_S_TASK_DISABLED:                                    "SCHED_S_TASK_DISABLED",
+	SCHED_S_TASK_HAS_NOT_RUN:                                 "SCHED_S_TASK_HAS_NOT_RUN",
+	SCHED_S_TASK_NO_MORE_RUNS:                                "SCHED_S_TASK_NO_MORE_RUNS",
+	SCHED_S_TASK_NOT_SCHEDULED:                               "SCHED_S_TASK_NOT_SCHEDULED",
+	SCHED_S_TASK_TERMINATED:                                  "SCHED_S_TASK_TERMINATED",
+	SCHED_S_TASK_NO_VALID_TRIGGERS:                           "SCHED_S_TASK_NO_VALID_TRIGGERS",
+	SCHED_S_EVENT_TRIGGER:                                    "SCHED_S_EVENT_TRIGGER",
+	SCHED_E_TRIGGER_NOT_FOUND:                                "SCHED_E_TRIGGER_NOT_FOUND",
+	SCHED_E_TASK_NOT_READY:                                   "SCHED_E_TASK_NOT_READY",
+	SCHED_E_TASK_NOT_RUNNING:                                 "SCHED_E_TASK_NOT_RUNNING",
+	SCHED_E_SERVICE_NOT_INSTALLED:                            "SCHED_E_SERVICE_NOT_INSTALLED",
+	SCHED_E_CANNOT_OPEN_TASK:                                 "SCHED_E_CANNOT_OPEN_TASK",
+	SCHED_E_INVALID_TASK:                                     "SCHED_E_INVALID_TASK",
+	SCHED_E_ACCOUNT_INFORMATION_NOT_SET:                      "SCHED_E_ACCOUNT_INFORMATION_NOT_SET",
+	SCHED_E_ACCOUNT_NAME_NOT_FOUND:                           "SCHED_E_ACCOUNT_NAME_NOT_FOUND",
+	SCHED_E_ACCOUNT_DBASE_CORRUPT:                            "SCHED_E_ACCOUNT_DBASE_CORRUPT",
+	SCHED_E_NO_SECURITY_SERVICES:                             "SCHED_E_NO_SECURITY_SERVICES",
+	SCHED_E_UNKNOWN_OBJECT_VERSION:                           "SCHED_E_UNKNOWN_OBJECT_VERSION",
+	SCHED_E_UNSUPPORTED_ACCOUNT_OPTION:                       "SCHED_E_UNSUPPORTED_ACCOUNT_OPTION",
+	SCHED_E_SERVICE_NOT_RUNNING:                              "SCHED_E_SERVICE_NOT_RUNNING",
+	CO_S_MACHINENAMENOTFOUND:                                 "CO_S_MACHINENAMENOTFOUND",
+	DISP_E_BUFFERTOOSMALL:                                    "DISP_E_BUFFERTOOSMALL",
+	STG_E_DOCFILETOOLARGE:                                    "STG_E_DOCFILETOOLARGE",
+	STG_E_NOTSIMPLEFORMAT:                                    "STG_E_NOTSIMPLEFORMAT",
+	STG_E_STATUS_COPY_PROTECTION_FAILURE:                     "STG_E_STATUS_COPY_PROTECTION_FAILURE",
+	STG_E_CSS_AUTHENTICATION_FAILURE:                         "STG_E_CSS_AUTHENTICATION_FAILURE",
+	STG_E_CSS_KEY_NOT_PRESENT:                                "STG_E_CSS_KEY_NOT_PRESENT",
+	STG_E_CSS_KEY_NOT_ESTABLISHED:                            "STG_E_CSS_KEY_NOT_ESTABLISHED",
+	STG_E_CSS_SCRAMBLED_SECTOR:                               "STG_E_CSS_SCRAMBLED_SECTOR",
+	STG_E_CSS_REGION_MISMATCH:                                "STG_E_CSS_REGION_MISMATCH",
+	STG_E_RESETS_EXHAUSTED:                                   "STG_E_RESETS_EXHAUSTED",
+	RPC_E_FULLSIC_REQUIRED:                                   "RPC_E_FULLSIC_REQUIRED",
+	RPC_E_INVALID_STD_NAME:                                   "RPC_E_INVALID_STD_NAME",
+	CO_E_CANCEL_DISABLED:                                     "CO_E_CANCEL_DISABLED",
+	ERROR_AUDITING_DISABLED:                                  "ERROR_AUDITING_DISABLED",
+	ERROR_ALL_SIDS_FILTERED:                                  "ERROR_ALL_SIDS_FILTERED",
+	NTE_SILENT_CONTEXT:                                       "NTE_SILENT_CONTEXT",
+	NTE_TOKEN_KEYSET_STORAGE_FULL:                            "NTE_TOKEN_KEYSET_STORAGE_FULL",
+	NTE_TEMPORARY_PROFILE:                                    "NTE_TEMPORARY_PROFILE",
+	NTE_FIXEDPARAMETER:                                       "NTE_FIXEDPARAMETER",
+	NTE_INVALID_HANDLE:                                       "NTE_INVALID_HANDLE",
+	NTE_INVALID_PARAMETER:                                    "NTE_INVALID_PARAMETER",
+	NTE_BUFFER_TOO_SMALL:                                     "NTE_BUFFER_TOO_SMALL",
+	NTE_NOT_SUPPORTED:                                        "NTE_NOT_SUPPORTED",
+	NTE_NO_MORE_ITEMS:                                        "NTE_NO_MORE_ITEMS",
+	NTE_BUFFERS_OVERLAP:                                      "NTE_BUFFERS_OVERLAP",
+	NTE_DECRYPTION_FAILURE:                                   "NTE_DECRYPTION_FAILURE",
+	NTE_INTERNAL_ERROR:                                       "NTE_INTERNAL_ERROR",
+	NTE_UI_REQUIRED:                                          "NTE_UI_REQUIRED",
+	NTE_HMAC_NOT_SUPPORTED:                                   "NTE_HMAC_NOT_SUPPORTED",
+	NTE_DEVICE_NOT_READY:                                     "NTE_DEVICE_NOT_READY",
+	NTE_AUTHENTICATION_IGNORED:                               "NTE_AUTHENTICATION_IGNORED",
+	NTE_VALIDATION_FAILED:                                    "NTE_VALIDATION_FAILED",
+	NTE_INCORRECT_PASSWORD:                                   "NTE_INCORRECT_PASSWORD",
+	NTE_ENCRYPTION_FAILURE:                                   "NTE_ENCRYPTION_FAILURE",
+	NTE_DEVICE_NOT_FOUND:                                     "NTE_DEVICE_NOT_FOUND",
+	NTE_USER_CANCELLED:                                       "NTE_USER_CANCELLED",
+	NTE_PASSWORD_CHANGE_REQUIRED:                             "NTE_PASSWORD_CHANGE_REQUIRED",
+	NTE_NOT_ACTIVE_CONSOLE:                                   "NTE_NOT_ACTIVE_CONSOLE",
+	SEC_E_INSUFFICIENT_MEMORY:                                "SEC_E_INSUFFICIENT_MEMORY",
+	SEC_E_INVALID_HANDLE:                                     "SEC_E_INVALID_HANDLE",
+	SEC_E_UNSUPPORTED_FUNCTION:                               "SEC_E_UNSUPPORTED_FUNCTION",
+	SEC_E_TARGET_UNKNOWN:                                     "SEC_E_TARGET_UNKNOWN",
+	SEC_E_INTERNAL_ERROR:                                     "SEC_E_INTERNAL_ERROR",
+	SEC_E_SECPKG_NOT_FOUND:                                   "SEC_E_SECPKG_NOT_FOUND",
+	SEC_E_NOT_OWNER:                                          "SEC_E_NOT_OWNER",
+	SEC_E_CANNOT_INSTALL:                                     "SEC_E_CANNOT_INSTALL",
+	SEC_E_INVALID_TOKEN:                                      "SEC_E_INVALID_TOKEN",
+	SEC_E_CANNOT_PACK:                                        "SEC_E_CANNOT_PACK",
+	SEC_E_QOP_NOT_SUPPORTED:                                  "SEC_E_QOP_NOT_SUPPORTED",
+	SEC_E_NO_IMPERSONATION:                                   "SEC_E_NO_IMPERSONATION",
+	SEC_E_LOGON_DENIED:                                       "SEC_E_LOGON_DENIED",
+	SEC_E_UNKNOWN_CREDENTIALS:                                "SEC_E_UNKNOWN_CREDENTIALS",
+	SEC_E_NO_CREDENTIALS:                                     "SEC_E_NO_CREDENTIALS",
+	SEC_E_MESSAGE_ALTERED:                                    "SEC_E_MESSAGE_ALTERED",
+	SEC_E_OUT_OF_SEQUENCE:                                    "SEC_E_OUT_OF_SEQUENCE",
+	SEC_E_NO_AUTHENTICATING_AUTHORITY:                        "SEC_E_NO_AUTHENTICATING_AUTHORITY",
+	SEC_I_CONTINUE_NEEDED:                                    "SEC_I_CONTINUE_NEEDED",
+	SEC_I_COMPLETE_NEEDED:                                    "SEC_I_COMPLETE_NEEDED",
+	SEC_I_COMPLETE_AND_CONTINUE:                              "SEC_I_COMPLETE_AND_CONTINUE",
+	SEC_I_LOCAL_LOGON:                                        "SEC_I_LOCAL_LOGON",
+	SEC_I_GENERIC_EXTENSION_RECEIVED:                         "SEC_I_GENERIC_EXTENSION_RECEIVED",
+	SEC_E_BAD_PKGID:                                          "SEC_E_BAD_PKGID",
+	SEC_E_CONTEXT_EXPIRED:                                    "SEC_E_CONTEXT_EXPIRED",
+	SEC_I_CONTEXT_EXPIRED:                                    "SEC_I_CONTEXT_EXPIRED",
+	SEC_E_INCOMPLETE_MESSAGE:                                 "SEC_E_INCOMPLETE_MESSAGE",
+	SEC_E_INCOMPLETE_CREDENTIALS:                             "SEC_E_INCOMPLETE_CREDENTIALS",
+	SEC_E_BUFFER_TOO_SMALL:                                   "SEC_E_BUFFER_TOO_SMALL",
+	SEC_I_INCOMPLETE_CREDENTIALS:                             "SEC_I_INCOMPLETE_CREDENTIALS",
+	SEC_I_RENEGOTIATE:                                        "SEC_I_RENEGOTIATE",
+	SEC_E_WRONG_PRINCIPAL:                                    "SEC_E_WRONG_PRINCIPAL",
+	SEC_I_NO_LSA_CONTEXT:                                     "SEC_I_NO_LSA_CONTEXT",
+	SEC_E_TIME_SKEW:                                          "SEC_E_TIME_SKEW",
+	SEC_E_UNTRUSTED_ROOT:                                     "SEC_E_UNTRUSTED_ROOT",
+	SEC_E_ILLEGAL_MESSAGE:                                    "SEC_E_ILLEGAL_MESSAGE",
+	SEC_E_CERT_UNKNOWN:                                       "SEC_E_CERT_UNKNOWN",
+	SEC_E_CERT_EXPIRED:                                       "SEC_E_CERT_EXPIRED",
+	SEC_E_ENCRYPT_FAILURE:                                    "SEC_E_ENCRYPT_FAILURE",
+	SEC_E_DECRYPT_FAILURE:                                    "SEC_E_DECRYPT_FAILURE",
+	SEC_E_ALGORITHM_MISMATCH:                                 "SEC_E_ALGORITHM_MISMATCH",
+	SEC_E_SECURITY_QOS_FAILED:                                "SEC_E_SECURITY_QOS_FAILED",
+	SEC_E_UNFINISHED_CONTEXT_DELETED:                         "SEC_E_UNFINISHED_CONTEXT_DELETED",
+	SEC_E_NO_TGT_REPLY:                                       "SEC_E_NO_TGT_REPLY",
+	SEC_E_NO_IP_ADDRESSES:                                    "SEC_E_NO_IP_ADDRESSES",
+	SEC_E_WRONG_CREDENTIAL_HANDLE:                            "SEC_E_WRONG_CREDENTIAL_HANDLE",
+	SEC_E_CRYPTO_SYSTEM_INVALID:                              "SEC_E_CRYPTO_SYSTEM_INVALID",
+	SEC_E_MAX_REFERRALS_EXCEEDED:                             "SEC_E_MAX_REFERRALS_EXCEEDED",
+	SEC_E_MUST_BE_KDC:                                        "SEC_E_MUST_BE_KDC",
+	SEC_E_STRONG_CRYPTO_NOT_SUPPORTED:                        "SEC_E_STRONG_CRYPTO_NOT_SUPPORTED",
+	SEC_E_TOO_MANY_PRINCIPALS:                                "SEC_E_TOO_MANY_PRINCIPALS",
+	SEC_E_NO_PA_DATA:                                         "SEC_E_NO_PA_DATA",
+	SEC_E_PKINIT_NAME_MISMATCH:                               "SEC_E_PKINIT_NAME_MISMATCH",
+	SEC_E_SMARTCARD_LOGON_REQUIRED:                           "SEC_E_SMARTCARD_LOGON_REQUIRED",
+	SEC_E_SHUTDOWN_IN_PROGRESS:                               "SEC_E_SHUTDOWN_IN_PROGRESS",
+	SEC_E_KDC_INVALID_REQUEST:                                "SEC_E_KDC_INVALID_REQUEST",
+	SEC_E_KDC_UNABLE_TO_REFER:                                "SEC_E_KDC_UNABLE_TO_REFER",
+	SEC_E_KDC_UNKNOWN_ETYPE:                                  "SEC_E_KDC_UNKNOWN_ETYPE",
+	SEC_E_UNSUPPORTED_PREAUTH:                                "SEC_E_UNSUPPORTED_PREAUTH",
+	SEC_E_DELEGATION_REQUIRED:                                "SEC_E_DELEGATION_REQUIRED",
+	SEC_E_BAD_BINDINGS:                                       "SEC_E_BAD_BINDINGS",
+	SEC_E_MULTIPLE_ACCOUNTS:                                  "SEC_E_MULTIPLE_ACCOUNTS",
+	SEC_E_NO_KERB_KEY:                                        "SEC_E_NO_KERB_KEY",
+	SEC_E_CERT_WRONG_USAGE:                                   "SEC_E_CERT_WRONG_USAGE",
+	SEC_E_DOWNGRADE_DETECTED:                                 "SEC_E_DOWNGRADE_DETECTED",
+	SEC_E_SMARTCARD_CERT_REVOKED:                             "SEC_E_SMARTCARD_CERT_REVOKED",
+	SEC_E_ISSUING_CA_UNTRUSTED:                               "SEC_E_ISSUING_CA_UNTRUSTED",
+	SEC_E_REVOCATION_OFFLINE_C:                               "SEC_E_REVOCATION_OFFLINE_C",
+	SEC_E_PKINIT_CLIENT_FAILURE:                              "SEC_E_PKINIT_CLIENT_FAILURE",
+	SEC_E_SMARTCARD_CERT_EXPIRED:                             "SEC_E_SMARTCARD_CERT_EXPIRED",
+	SEC_E_NO_S4U_PROT_SUPPORT:                                "SEC_E_NO_S4U_PROT_SUPPORT",
+	SEC_E_CROSSREALM_DELEGATION_FAILURE:                      "SEC_E_CROSSREALM_DELEGATION_FAILURE",
+	SEC_E_REVOCATION_OFFLINE_KDC:                             "SEC_E_REVOCATION_OFFLINE_KDC",
+	SEC_E_ISSUING_CA_UNTRUSTED_KDC:                           "SEC_E_ISSUING_CA_UNTRUSTED_KDC",
+	SEC_E_KDC_CERT_EXPIRED:                                   "SEC_E_KDC_CERT_EXPIRED",
+	SEC_E_KDC_CERT_REVOKED:                                   "SEC_E_KDC_CERT_REVOKED",
+	SEC_I_SIGNATURE_NEEDED:                                   "SEC_I_SIGNATURE_NEEDED",
+	SEC_E_INVALID_PARAMETER:                                  "SEC_E_INVALID_PARAMETER",
+	SEC_E_DELEGATION_POLICY:                                  "SEC_E_DELEGATION_POLICY",
+	SEC_E_POLICY_NLTM_ONLY:                                   "SEC_E_POLICY_NLTM_ONLY",
+	SEC_I_NO_RENEGOTIATION:                                   "SEC_I_NO_RENEGOTIATION",
+	SEC_E_NO_CONTEXT:                                         "SEC_E_NO_CONTEXT",
+	SEC_E_PKU2U_CERT_FAILURE:                                 "SEC_E_PKU2U_CERT_FAILURE",
+	SEC_E_MUTUAL_AUTH_FAILED:                                 "SEC_E_MUTUAL_AUTH_FAILED",
+	SEC_I_MESSAGE_FRAGMENT:                                   "SEC_I_MESSAGE_FRAGMENT",
+	SEC_E_ONLY_HTTPS_ALLOWED:                                 "SEC_E_ONLY_HTTPS_ALLOWED",
+	SEC_I_CONTINUE_NEEDED_MESSAGE_OK:                         "SEC_I_CONTINUE_NEEDED_MESSAGE_OK",
+	SEC_E_APPLICATION_PROTOCOL_MISMATCH:                      "SEC_E_APPLICATION_PROTOCOL_MISMATCH",
+	SEC_I_ASYNC_CALL_PENDING:                                 "SEC_I_ASYNC_CALL_PENDING",
+	SEC_E_INVALID_UPN_NAME:                                   "SEC_E_INVALID_UPN_NAME",
+	SEC_E_EXT_BUFFER_TOO_SMALL:                               "SEC_E_EXT_BUFFER_TOO_SMALL",
+	SEC_E_INSUFFICIENT_BUFFERS:                               "SEC_E_INSUFFICIENT_BUFFERS",
+	CRYPT_I_NEW_PROTECTION_REQUIRED:                          "CRYPT_I_NEW_PROTECTION_REQUIRED",
+	CRYPT_E_MISSING_PUBKEY_PARA:                              "CRYPT_E_MISSING_PUBKEY_PARA",
+	OSS_MORE_BUF:                                             "OSS_MORE_BUF",
+	OSS_NEGATIVE_UINTEGER:                                    "OSS_NEGATIVE_UINTEGER",
+	OSS_PDU_RANGE:                                            "OSS_PDU_RANGE",
+	OSS_MORE_INPUT:                                           "OSS_MORE_INPUT",
+	OSS_DATA_ERROR:                                           "OSS_DATA_ERROR",
+	OSS_BAD_ARG:                                              "OSS_BAD_ARG",
+	OSS_BAD_VERSION:                                          "OSS_BAD_VERSION",
+	OSS_OUT_MEMORY:                                           "OSS_OUT_MEMORY",
+	OSS_PDU_MISMATCH:                                         "OSS_PDU_MISMATCH",
+	OSS_LIMITED:                                              "OSS_LIMITED",
+	OSS_BAD_PTR:                                              "OSS_BAD_PTR",
+	OSS_BAD_TIME:                                             "OSS_BAD_TIME",
+	OSS_INDEFINITE_NOT_SUPPORTED:                             "OSS_INDEFINITE_NOT_SUPPORTED",
+	OSS_MEM_ERROR:                                            "OSS_MEM_ERROR",
+	OSS_BAD_TABLE:                                            "OSS_BAD_TABLE",
+	OSS_TOO_LONG:                                             "OSS_TOO_LONG",
+	OSS_CONSTRAINT_VIOLATED:                                  "OSS_CONSTRAINT_VIOLATED",
+	OSS_FATAL_ERROR:                                          "OSS_FATAL_ERROR",
+	OSS_ACCESS_SERIALIZATION_ERROR:                           "OSS_ACCESS_SERIALIZATION_ERROR",
+	OSS_NULL_TBL:                                             "OSS_NULL_TBL",
+	OSS_NULL_FCN:                                             "OSS_NULL_FCN",
+	OSS_BAD_ENCRULES:                                         "OSS_BAD_ENCRULES",
+	OSS_UNAVAIL_ENCRULES:                                     "OSS_UNAVAIL_ENCRULES",
+	OSS_CANT_OPEN_TRACE_WINDOW:                               "OSS_CANT_OPEN_TRACE_WINDOW",
+	OSS_UNIMPLEMENTED:                                        "OSS_UNIMPLEMENTED",
+	OSS_OID_DLL_NOT_LINKED:                                   "OSS_OID_DLL_NOT_LINKED",
+	OSS_CANT_OPEN_TRACE_FILE:                                 "OSS_CANT_OPEN_TRACE_FILE",
+	OSS_TRACE_FILE_ALREADY_OPEN:                              "OSS_TRACE_FILE_ALREADY_OPEN",
+	OSS_TABLE_MISMATCH:                                       "OSS_TABLE_MISMATCH",
+	OSS_TYPE_NOT_SUPPORTED:                                   "OSS_TYPE_NOT_SUPPORTED",
+	OSS_REAL_DLL_NOT_LINKED:                                  "OSS_REAL_DLL_NOT_LINKED",
+	OSS_REAL_CODE_NOT_LINKED:                                 "OSS_REAL_CODE_NOT_LINKED",
+	OSS_OUT_OF_RANGE:                                         "OSS_OUT_OF_RANGE",
+	OSS_COPIER_DLL_NOT_LINKED:                                "OSS_COPIER_DLL_NOT_LINKED",
+	OSS_CONSTRAINT_DLL_NOT_LINKED:                            "OSS_CONSTRAINT_DLL_NOT_LINKED",
+	OSS_COMPARATOR_DLL_NOT_LINKED:                            "OSS_COMPARATOR_DLL_NOT_LINKED",
+	OSS_COMPARATOR_CODE_NOT_LINKED:                           "OSS_COMPARATOR_CODE_NOT_LINKED",
+	OSS_MEM_MGR_DLL_NOT_LINKED:                               "OSS_MEM_MGR_DLL_NOT_LINKED",
+	OSS_PDV_DLL_NOT_LINKED:                                   "OSS_PDV_DLL_NOT_LINKED",
+	OSS_PDV_CODE_NOT_LINKED:                                  "OSS_PDV_CODE_NOT_LINKED",
+	OSS_API_DLL_NOT_LINKED:                                   "OSS_API_DLL_NOT_LINKED",
+	OSS_BERDER_DLL_NOT_LINKED:                                "OSS_BERDER_DLL_NOT_LINKED",
+	OSS_PER_DLL_NOT_LINKED:                                   "OSS_PER_DLL_NOT_LINKED",
+	OSS_OPEN_TYPE_ERROR:                                      "OSS_OPEN_TYPE_ERROR",
+	OSS_MUTEX_NOT_CREATED:                                    "OSS_MUTEX_NOT_CREATED",
+	OSS_CANT_CLOSE_TRACE_FILE:                                "OSS_CANT_CLOSE_TRACE_FILE",
+	CRYPT_E_ASN1_ERROR:                                       "CRYPT_E_ASN1_ERROR",
+	CRYPT_E_ASN1_INTERNAL:                                    "CRYPT_E_ASN1_INTERNAL",
+	CRYPT_E_ASN1_EOD:                                         "CRYPT_E_ASN1_EOD",
+	CRYPT_E_ASN1_CORRUPT:                                     "CRYPT_E_ASN1_CORRUPT",
+	CRYPT_E_ASN1_LARGE:                                       "CRYPT_E_ASN1_LARGE",
+	CRYPT_E_ASN1_CONSTRAINT:                                  "CRYPT_E_ASN1_CONSTRAINT",
+	CRYPT_E_ASN1_MEMORY:                                      "CRYPT_E_ASN1_MEMORY",
+	CRYPT_E_ASN1_OVERFLOW:                                    "CRYPT_E_ASN1_OVERFLOW",
+	CRYPT_E_ASN1_BADPDU:                                      "CRYPT_E_ASN1_BADPDU",
+	CRYPT_E_ASN1_BADARGS:                                     "CRYPT_E_ASN1_BADARGS",
+	CRYPT_E_ASN1_BADREAL:                                     "CRYPT_E_ASN1_BADREAL",
+	CRYPT_E_ASN1_BADTAG:                                      "CRYPT_E_ASN1_BADTAG",
+	CRYPT_E_ASN1_CHOICE:                                      "CRYPT_E_ASN1_CHOICE",
+	CRYPT_E_ASN1_RULE:                                        "CRYPT_E_ASN1_RULE",
+	CRYPT_E_ASN1_UTF8:                                        "CRYPT_E_ASN1_UTF8",
+	CRYPT_E_ASN1_PDU_TYPE:                                    "CRYPT_E_ASN1_PDU_TYPE",
+	CRYPT_E_ASN1_NYI:                                         "CRYPT_E_ASN1_NYI",
+	CRYPT_E_ASN1_EXTENDED:                                    "CRYPT_E_ASN1_EXTENDED",
+	CRYPT_E_ASN1_NOEOD:                                       "CRYPT_E_ASN1_NOEOD",
+	CERTSRV_E_INVALID_CA_CERTIFICATE:                         "CERTSRV_E_INVALID_CA_CERTIFICATE",
+	CERTSRV_E_SERVER_SUSPENDED:                               "CERTSRV_E_SERVER_SUSPENDED",
+	CERTSRV_E_ENCODING_LENGTH:                                "CERTSRV_E_ENCODING_LENGTH",
+	CERTSRV_E_ROLECONFLICT:                                   "CERTSRV_E_ROLECONFLICT",
+	CERTSRV_E_RESTRICTEDOFFICER:                              "CERTSRV_E_RESTRICTEDOFFICER",
+	CERTSRV_E_KEY_ARCHIVAL_NOT_CONFIGURED:                    "CERTSRV_E_KEY_ARCHIVAL_NOT_CONFIGURED",
+	CERTSRV_E_NO_VALID_KRA:                                   "CERTSRV_E_NO_VALID_KRA",
+	CERTSRV_E_BAD_REQUEST_KEY_ARCHIVAL:                       "CERTSRV_E_BAD_REQUEST_KEY_ARCHIVAL",
+	CERTSRV_E_NO_CAADMIN_DEFINED:                             "CERTSRV_E_NO_CAADMIN_DEFINED",
+	CERTSRV_E_BAD_RENEWAL_CERT_ATTRIBUTE:                     "CERTSRV_E_BAD_RENEWAL_CERT_ATTRIBUTE",
+	CERTSRV_E_NO_DB_SESSIONS:                                 "CERTSRV_E_NO_DB_SESSIONS",
+	CERTSRV_E_ALIGNMENT_FAULT:                                "CERTSRV_E_ALIGNMENT_FAULT",
+	CERTSRV_E_ENROLL_DENIED:                                  "CERTSRV_E_ENROLL_DENIED",
+	CERTSRV_E_TEMPLATE_DENIED:                                "CERTSRV_E_TEMPLATE_DENIED",
+	CERTSRV_E_DOWNLEVEL_DC_SSL_OR_UPGRADE:                    "CERTSRV_E_DOWNLEVEL_DC_SSL_OR_UPGRADE",
+	CERTSRV_E_UNSUPPORTED_CERT_TYPE:                          "CERTSRV_E_UNSUPPORTED_CERT_TYPE",
+	CERTSRV_E_NO_CERT_TYPE:                                   "CERTSRV_E_NO_CERT_TYPE",
+	CERTSRV_E_TEMPLATE_CONFLICT:                              "CERTSRV_E_TEMPLATE_CONFLICT",
+	CERTSRV_E_SUBJECT_ALT_NAME_REQUIRED:                      "CERTSRV_E_SUBJECT_ALT_NAME_REQUIRED",
+	CERTSRV_E_ARCHIVED_KEY_REQUIRED:                          "CERTSRV_E_ARCHIVED_KEY_REQUIRED",
+	CERTSRV_E_SMIME_REQUIRED:                                 "CERTSRV_E_SMIME_REQUIRED",
+	CERTSRV_E_BAD_RENEWAL_SUBJECT:                            "CERTSRV_E_BAD_RENEWAL_SUBJECT",
+	CERTSRV_E_BAD_TEMPLATE_VERSION:                           "CERTSRV_E_BAD_TEMPLATE_VERSION",
+	CERTSRV_E_TEMPLATE_POLICY_REQUIRED:                       "CERTSRV_E_TEMPLATE_POLICY_REQUIRED",
+	CERTSRV_E_SIGNATURE_POLICY_REQUIRED:                      "CERTSRV_E_SIGNATURE_POLICY_REQUIRED",
+	CERTSRV_E_SIGNATURE_COUNT:                                "CERTSRV_E_SIGNATURE_COUNT",
+	CERTSRV_E_SIGNATURE_REJECTED:                             "CERTSRV_E_SIGNATURE_REJECTED",
+	CERTSRV_E_ISSUANCE_POLICY_REQUIRED:                       "CERTSRV_E_ISSUANCE_POLICY_REQUIRED",
+	CERTSRV_E_SUBJECT_UPN_REQUIRED:                           "CERTSRV_E_SUBJECT_UPN_REQUIRED",
+	CERTSRV_E_SUBJECT_DIRECTORY_GUID_REQUIRED:                "CERTSRV_E_SUBJECT_DIRECTORY_GUID_REQUIRED",
+	CERTSRV_E_SUBJECT_DNS_REQUIRED:                           "CERTSRV_E_SUBJECT_DNS_REQUIRED",
+	CERTSRV_E_ARCHIVED_KEY_UNEXPECTED:                        "CERTSRV_E_ARCHIVED_KEY_UNEXPECTED",
+	CERTSRV_E_KEY_LENGTH:                                     "CERTSRV_E_KEY_LENGTH",
+	CERTSRV_E_SUBJECT_EMAIL_REQUIRED:                         "CERTSRV_E_SUBJECT_EMAIL_REQUIRED",
+	CERTSRV_E_UNKNOWN_CERT_TYPE:                              "CERTSRV_E_UNKNOWN_CERT_TYPE",
+	CERTSRV_E_CERT_TYPE_OVERLAP:                              "CERTSRV_E_CERT_TYPE_OVERLAP",
+	XENROLL_E_CANNOT_ADD_ROOT_CERT:                           "XENROLL_E_CANNOT_ADD_ROOT_CERT",
+	XENROLL_E_RESPONSE_KA_HASH_NOT_FOUND:                     "XENROLL_E_RESPONSE_KA_HASH_NOT_FOUND",
+	XENROLL_E_RESPONSE_UNEXPECTED_KA_HASH:                    "XENROLL_E_RESPONSE_UNEXPECTED_KA_HASH",
+	XENROLL_E_RESPONSE_KA_HASH_MISMATCH:                      "XENROLL_E_RESPONSE_KA_HASH_MISMATCH",
+	XENROLL_E_KEYSPEC_SMIME_MISMATCH:                         "XENROLL_E_KEYSPEC_SMIME_MISMATCH",
+	MSSIPOTF_E_OUTOFMEMRANGE:                                 "MSSIPOTF_E_OUTOFMEMRANGE",
+	MSSIPOTF_E_CANTGETOBJECT:                                 "MSSIPOTF_E_CANTGETOBJECT",
+	MSSIPOTF_E_NOHEADTABLE:                                   "MSSIPOTF_E_NOHEADTABLE",
+	MSSIPOTF_E_BAD_MAGICNUMBER:                               "MSSIPOTF_E_BAD_MAGICNUMBER",
+	MSSIPOTF_E_BAD_OFFSET_TABLE:                              "MSSIPOTF_E_BAD_OFFSET_TABLE",
+	MSSIPOTF_E_TABLE_TAGORDER:                                "MSSIPOTF_E_TABLE_TAGORDER",
+	MSSIPOTF_E_TABLE_LONGWORD:                                "MSSIPOTF_E_TABLE_LONGWORD",
+	MSSIPOTF_E_BAD_FIRST_TABLE_PLACEMENT:                     "MSSIPOTF_E_BAD_FIRST_TABLE_PLACEMENT",
+	MSSIPOTF_E_TABLES_OVERLAP:                                "MSSIPOTF_E_TABLES_OVERLAP",
+	MSSIPOTF_E_TABLE_PADBYTES:                                "MSSIPOTF_E_TABLE_PADBYTES",
+	MSSIPOTF_E_FILETOOSMALL:                                  "MSSIPOTF_E_FILETOOSMALL",
+	MSSIPOTF_E_TABLE_CHECKSUM:                                "MSSIPOTF_E_TABLE_CHECKSUM",
+	MSSIPOTF_E_FILE_CHECKSUM:                                 "MSSIPOTF_E_FILE_CHECKSUM",
+	MSSIPOTF_E_FAILED_POLICY:                                 "MSSIPOTF_E_FAILED_POLICY",
+	MSSIPOTF_E_FAILED_HINTS_CHECK:                            "MSSIPOTF_E_FAILED_HINTS_CHECK",
+	MSSIPOTF_E_NOT_OPENTYPE:                                  "MSSIPOTF_E_NOT_OPENTYPE",
+	MSSIPOTF_E_FILE:                                          "MSSIPOTF_E_FILE",
+	MSSIPOTF_E_CRYPT:                                         "MSSIPOTF_E_CRYPT",
+	MSSIPOTF_E_BADVERSION:                                    "MSSIPOTF_E_BADVERSION",
+	MSSIPOTF_E_DSIG_STRUCTURE:                                "MSSIPOTF_E_DSIG_STRUCTURE",
+	MSSIPOTF_E_PCONST_CHECK:                                  "MSSIPOTF_E_PCONST_CHECK",
+	MSSIPOTF_E_STRUCTURE:                                     "MSSIPOTF_E_STRUCTURE",
+	TRUST_E_EXPLICIT_DISTRUST:                                "TRUST_E_EXPLICIT_DISTRUST",
+	CERT_E_UNTRUSTEDCA:                                       "CERT_E_UNTRUSTEDCA",
+	CERT_E_INVALID_POLICY:                                    "CERT_E_INVALID_POLICY",
+	CERT_E_INVALID_NAME:                                      "CERT_E_INVALID_NAME",
+	SPAPI_E_NO_BACKUP:                                        "SPAPI_E_NO_BACKUP",
+	SPAPI_E_NON_WINDOWS_NT_DRIVER:                            "SPAPI_E_NON_WINDOWS_NT_DRIVER",
+	SPAPI_E_NON_WINDOWS_DRIVER:                               "SPAPI_E_NON_WINDOWS_DRIVER",
+	SPAPI_E_NO_CATALOG_FOR_OEM_INF:                           "SPAPI_E_NO_CATALOG_FOR_OEM_INF",
+	SPAPI_E_DEVINSTALL_QUEUE_NONNATIVE:                       "SPAPI_E_DEVINSTALL_QUEUE_NONNATIVE",
+	SPAPI_E_NOT_DISABLEABLE:                                  "SPAPI_E_NOT_DISABLEABLE",
+	SPAPI_E_CANT_REMOVE_DEVINST:                              "SPAPI_E_CANT_REMOVE_DEVINST",
+	SPAPI_E_INVALID_TARGET:                                   "SPAPI_E_INVALID_TARGET",
+	SPAPI_E_DRIVER_NONNATIVE:                                 "SPAPI_E_DRIVER_NONNATIVE",
+	SPAPI_E_IN_WOW64:                                         "SPAPI_E_IN_WOW64",
+	SPAPI_E_SET_SYSTEM_RESTORE_POINT:                         "SPAPI_E_SET_SYSTEM_RESTORE_POINT",
+	SPAPI_E_INCORRECTLY_COPIED_INF:                           "SPAPI_E_INCORRECTLY_COPIED_INF",
+	SPAPI_E_SCE_DISABLED:                                     "SPAPI_E_SCE_DISABLED",
+	SPAPI_E_UNKNOWN_EXCEPTION:                                "SPAPI_E_UNKNOWN_EXCEPTION",
+	SPAPI_E_PNP_REGISTRY_ERROR:                               "SPAPI_E_PNP_REGISTRY_ERROR",
+	SPAPI_E_REMOTE_REQUEST_UNSUPPORTED:                       "SPAPI_E_REMOTE_REQUEST_UNSUPPORTED",
+	SPAPI_E_NOT_AN_INSTALLED_OEM_INF:                         "SPAPI_E_NOT_AN_INSTALLED_OEM_INF",
+	SPAPI_E_INF_IN_USE_BY_DEVICES:                            "SPAPI_E_INF_IN_USE_BY_DEVICES",
+	SPAPI_E_DI_FUNCTION_OBSOLETE:                             "SPAPI_E_DI_FUNCTION_OBSOLETE",
+	SPAPI_E_NO_AUTHENTICODE_CATALOG:                          "SPAPI_E_NO_AUTHENTICODE_CATALOG",
+	SPAPI_E_AUTHENTICODE_DISALLOWED:                          "SPAPI_E_AUTHENTICODE_DISALLOWED",
+	SPAPI_E_AUTHENTICODE_TRUSTED_PUBLISHER:                   "SPAPI_E_AUTHENTICODE_TRUSTED_PUBLISHER",
+	SPAPI_E_AUTHENTICODE_TRUST_NOT_ESTABLISHED:               "SPAPI_E_AUTHENTICODE_TRUST_NOT_ESTABLISHED",
+	SPAPI_E_AUTHENTICODE_PUBLISHER_NOT_TRUSTED:               "SPAPI_E_AUTHENTICODE_PUBLISHER_NOT_TRUSTED",
+	SPAPI_E_SIGNATURE_OSATTRIBUTE_MISMATCH:                   "SPAPI_E_SIGNATURE_OSATTRIBUTE_MISMATCH",
+	SPAPI_E_ONLY_VALIDATE_VIA_AUTHENTICODE:                   "SPAPI_E_ONLY_VALIDATE_VIA_AUTHENTICODE",
+	SPAPI_E_UNRECOVERABLE_STACK_OVERFLOW:                     "SPAPI_E_UNRECOVERABLE_STACK_OVERFLOW",
+	SCARD_F_INTERNAL_ERROR:                                   "SCARD_F_INTERNAL_ERROR",
+	SCARD_E_CANCELLED:                                        "SCARD_E_CANCELLED",
+	SCARD_E_INVALID_HANDLE:                                   "SCARD_E_INVALID_HANDLE",
+	SCARD_E_INVALID_PARAMETER:                                "SCARD_E_INVALID_PARAMETER",
+	SCARD_E_INVALID_TARGET:                                   "SCARD_E_INVALID_TARGET",
+	SCARD_E_NO_MEMORY:                                        "SCARD_E_NO_MEMORY",
+	SCARD_F_WAITED_TOO_LONG:                                  "SCARD_F_WAITED_TOO_LONG",
+	SCARD_E_INSUFFICIENT_BUFFER:                              "SCARD_E_INSUFFICIENT_BUFFER",
+	SCARD_E_UNKNOWN_READER:                                   "SCARD_E_UNKNOWN_READER",
+	SCARD_E_TIMEOUT:                                          "SCARD_E_TIMEOUT",
+	SCARD_E_SHARING_VIOLATION:                                "SCARD_E_SHARING_VIOLATION",
+	SCARD_E_NO_SMARTCARD:                                     "SCARD_E_NO_SMARTCARD",
+	SCARD_E_UNKNOWN_CARD:                                     "SCARD_E_UNKNOWN_CARD",
+	SCARD_E_CANT_DISPOSE:                                     "SCARD_E_CANT_DISPOSE",
+	SCARD_E_PROTO_MISMATCH:                                   "SCARD_E_PROTO_MISMATCH",
+	SCARD_E_NOT_READY:                                        "SCARD_E_NOT_READY",
+	SCARD_E_INVALID_VALUE:                                    "SCARD_E_INVALID_VALUE",
+	SCARD_E_SYSTEM_CANCELLED:                                 "SCARD_E_SYSTEM_CANCELLED",
+	SCARD_F_COMM_ERROR:                                       "SCARD_F_COMM_ERROR",
+	SCARD_F_UNKNOWN_ERROR:                                    "SCARD_F_UNKNOWN_ERROR",
+	SCARD_E_INVALID_ATR:                                      "SCARD_E_INVALID_ATR",
+	SCARD_E_NOT_TRANSACTED:                                   "SCARD_E_NOT_TRANSACTED",
+	SCARD_E_READER_UNAVAILABLE:                               "SCARD_E_READER_UNAVAILABLE",
+	SCARD_P_SHUTDOWN:                                         "SCARD_P_SHUTDOWN",
+	SCARD_E_PCI_TOO_SMALL:                                    "SCARD_E_PCI_TOO_SMALL",
+	SCARD_E_READER_UNSUPPORTED:                               "SCARD_E_READER_UNSUPPORTED",
+	SCARD_E_DUPLICATE_READER:                                 "SCARD_E_DUPLICATE_READER",
+	SCARD_E_CARD_UNSUPPORTED:                                 "SCARD_E_CARD_UNSUPPORTED",
+	SCARD_E_NO_SERVICE:                                       "SCARD_E_NO_SERVICE",
+	SCARD_E_SERVICE_STOPPED:                                  "SCARD_E_SERVICE_STOPPED",
+	SCARD_E_UNEXPECTED:                                       "SCARD_E_UNEXPECTED",
+	SCARD_E_ICC_INSTALLATION:                                 "SCARD_E_ICC_INSTALLATION",
+	SCARD_E_ICC_CREATEORDER:                                  "SCARD_E_ICC_CREATEORDER",
+	SCARD_E_UNSUPPORTED_FEATURE:                              "SCARD_E_UNSUPPORTED_FEATURE",
+	SCARD_E_DIR_NOT_FOUND:                                    "SCARD_E_DIR_NOT_FOUND",
+	SCARD_E_FILE_NOT_FOUND:                                   "SCARD_E_FILE_NOT_FOUND",
+	SCARD_E_NO_DIR:                                           "SCARD_E_NO_DIR",
+	SCARD_E_NO_FILE:                                          "SCARD_E_NO_FILE",
+	SCARD_E_NO_ACCESS:                                        "SCARD_E_NO_ACCESS",
+	SCARD_E_WRITE_TOO_MANY:                                   "SCARD_E_WRITE_TOO_MANY",
+	SCARD_E_BAD_SEEK:                                         "SCARD_E_BAD_SEEK",
+	SCARD_E_INVALID_CHV:                                      "SCARD_E_INVALID_CHV",
+	SCARD_E_UNKNOWN_RES_MNG:                                  "SCARD_E_UNKNOWN_RES_MNG",
+	SCARD_E_NO_SUCH_CERTIFICATE:                              "SCARD_E_NO_SUCH_CERTIFICATE",
+	SCARD_E_CERTIFICATE_UNAVAILABLE:                          "SCARD_E_CERTIFICATE_UNAVAILABLE",
+	SCARD_E_NO_READERS_AVAILABLE:                             "SCARD_E_NO_READERS_AVAILABLE",
+	SCARD_E_COMM_DATA_LOST:                                   "SCARD_E_COMM_DATA_LOST",
+	SCARD_E_NO_KEY_CONTAINER:                                 "SCARD_E_NO_KEY_CONTAINER",
+	SCARD_E_SERVER_TOO_BUSY:                                  "SCARD_E_SERVER_TOO_BUSY",
+	SCARD_E_PIN_CACHE_EXPIRED:                                "SCARD_E_PIN_CACHE_EXPIRED",
+	SCARD_E_NO_PIN_CACHE:                                     "SCARD_E_NO_PIN_CACHE",
+	SCARD_E_READ_ONLY_CARD:                                   "SCARD_E_READ_ONLY_CARD",
+	SCARD_W_UNSUPPORTED_CARD:                                 "SCARD_W_UNSUPPORTED_CARD",
+	SCARD_W_UNRESPONSIVE_CARD:                                "SCARD_W_UNRESPONSIVE_CARD",
+	SCARD_W_UNPOWERED_CARD:                                   "SCARD_W_UNPOWERED_CARD",
+	SCARD_W_RESET_CARD:                                       "SCARD_W_RESET_CARD",
+	SCARD_W_REMOVED_CARD:                                     "SCARD_W_REMOVED_CARD",
+	SCARD_W_SECURITY_VIOLATION:                               "SCARD_W_SECURITY_VIOLATION",
+	SCARD_W_WRONG_CHV:                                        "SCARD_W_WRONG_CHV",
+	SCARD_W_CHV_BLOCKED:                                      "SCARD_W_CHV_BLOCKED",
+	SCARD_W_EOF:                                              "SCARD_W_EOF",
+	SCARD_W_CANCELLED_BY_USER:                                "SCARD_W_CANCELLED_BY_USER",
+	SCARD_W_CARD_NOT_AUTHENTICATED:                           "SCARD_W_CARD_NOT_AUTHENTICATED",
+	SCARD_W_CACHE_ITEM_NOT_FOUND:                             "SCARD_W_CACHE_ITEM_NOT_FOUND",
+	SCARD_W_CACHE_ITEM_STALE:                                 "SCARD_W_CACHE_ITEM_STALE",
+	SCARD_W_CACHE_ITEM_TOO_BIG:                               "SCARD_W_CACHE_ITEM_TOO_BIG",
+	COMADMIN_E_OBJECTERRORS:                                  "COMADMIN_E_OBJECTERRORS",
+	COMADMIN_E_OBJECTINVALID:                                 "COMADMIN_E_OBJECTINVALID",
+	COMADMIN_E_KEYMISSING:                                    "COMADMIN_E_KEYMISSING",
+	COMADMIN_E_ALREADYINSTALLED:                              "COMADMIN_E_ALREADYINSTALLED",
+	COMADMIN_E_APP_FILE_WRITEFAIL:                            "COMADMIN_E_APP_FILE_WRITEFAIL",
+	COMADMIN_E_APP_FILE_READFAIL:                             "COMADMIN_E_APP_FILE_READFAIL",
+	COMADMIN_E_APP_FILE_VERSION:                              "COMADMIN_E_APP_FILE_VERSION",
+	COMADMIN_E_BADPATH:                                       "COMADMIN_E_BADPATH",
+	COMADMIN_E_APPLICATIONEXISTS:                             "COMADMIN_E_APPLICATIONEXISTS",
+	COMADMIN_E_ROLEEXISTS:                                    "COMADMIN_E_ROLEEXISTS",
+	COMADMIN_E_CANTCOPYFILE:                                  "COMADMIN_E_CANTCOPYFILE",
+	COMADMIN_E_NOUSER:                                        "COMADMIN_E_NOUSER",
+	COMADMIN_E_INVALIDUSERIDS:                                "COMADMIN_E_INVALIDUSERIDS",
+	COMADMIN_E_NOREGISTRYCLSID:                               "COMADMIN_E_NOREGISTRYCLSID",
+	COMADMIN_E_BADREGISTRYPROGID:                             "COMADMIN_E_BADREGISTRYPROGID",
+	COMADMIN_E_AUTHENTICATIONLEVEL:                           "COMADMIN_E_AUTHENTICATIONLEVEL",
+	COMADMIN_E_USERPASSWDNOTVALID:                            "COMADMIN_E_USERPASSWDNOTVALID",
+	COMADMIN_E_CLSIDORIIDMISMATCH:                            "COMADMIN_E_CLSIDORIIDMISMATCH",
+	COMADMIN_E_REMOTEINTERFACE:                               "COMADMIN_E_REMOTEINTERFACE",
+	COMADMIN_E_DLLREGISTERSERVER:                             "COMADMIN_E_DLLREGISTERSERVER",
+	COMADMIN_E_NOSERVERSHARE:                                 "COMADMIN_E_NOSERVERSHARE",
+	COMADMIN_E_DLLLOADFAILED:                                 "COMADMIN_E_DLLLOADFAILED",
+	COMADMIN_E_BADREGISTRYLIBID:                              "COMADMIN_E_BADREGISTRYLIBID",
+	COMADMIN_E_APPDIRNOTFOUND:                                "COMADMIN_E_APPDIRNOTFOUND",
+	COMADMIN_E_REGISTRARFAILED:                               "COMADMIN_E_REGISTRARFAILED",
+	COMADMIN_E_COMPFILE_DOESNOTEXIST:                         "COMADMIN_E_COMPFILE_DOESNOTEXIST",
+	COMADMIN_E_COMPFILE_LOADDLLFAIL:                          "COMADMIN_E_COMPFILE_LOADDLLFAIL",
+	COMADMIN_E_COMPFILE_GETCLASSOBJ:                          "COMADMIN_E_COMPFILE_GETCLASSOBJ",
+	COMADMIN_E_COMPFILE_CLASSNOTAVAIL:                        "COMADMIN_E_COMPFILE_CLASSNOTAVAIL",
+	COMADMIN_E_COMPFILE_BADTLB:                               "COMADMIN_E_COMPFILE_BADTLB",
+	COMADMIN_E_COMPFILE_NOTINSTALLABLE:                       "COMADMIN_E_COMPFILE_NOTINSTALLABLE",
+	COMADMIN_E_NOTCHANGEABLE:                                 "COMADMIN_E_NOTCHANGEABLE",
+	COMADMIN_E_NOTDELETEABLE:                                 "COMADMIN_E_NOTDELETEABLE",
+	COMADMIN_E_SESSION:                                       "COMADMIN_E_SESSION",
+	COMADMIN_E_COMP_MOVE_LOCKED:                              "COMADMIN_E_COMP_MOVE_LOCKED",
+	COMADMIN_E_COMP_MOVE_BAD_DEST:                            "COMADMIN_E_COMP_MOVE_BAD_DEST",
+	COMADMIN_E_REGISTERTLB:                                   "COMADMIN_E_REGISTERTLB",
+	COMADMIN_E_SYSTEMAPP:                                     "COMADMIN_E_SYSTEMAPP",
+	COMADMIN_E_COMPFILE_NOREGISTRAR:                          "COMADMIN_E_COMPFILE_NOREGISTRAR",
+	COMADMIN_E_COREQCOMPINSTALLED:                            "COMADMIN_E_COREQCOMPINSTALLED",
+	COMADMIN_E_SERVICENOTINSTALLED:                           "COMADMIN_E_SERVICENOTINSTALLED",
+	COMADMIN_E_PROPERTYSAVEFAILED:                            "COMADMIN_E_PROPERTYSAVEFAILED",
+	COMADMIN_E_OBJECTEXISTS:                                  "COMADMIN_E_OBJECTEXISTS",
+	COMADMIN_E_COMPONENTEXISTS:                               "COMADMIN_E_COMPONENTEXISTS",
+	COMADMIN_E_REGFILE_CORRUPT:                               "COMADMIN_E_REGFILE_CORRUPT",
+	COMADMIN_E_PROPERTY_OVERFLOW:                             "COMADMIN_E_PROPERTY_OVERFLOW",
+	COMADMIN_E_NOTINREGISTRY:                                 "COMADMIN_E_NOTINREGISTRY",
+	COMADMIN_E_OBJECTNOTPOOLABLE:                             "COMADMIN_E_OBJECTNOTPOOLABLE",
+	COMADMIN_E_APPLID_MATCHES_CLSID:                          "COMADMIN_E_APPLID_MATCHES_CLSID",
+	COMADMIN_E_ROLE_DOES_NOT_EXIST:                           "COMADMIN_E_ROLE_DOES_NOT_EXIST",
+	COMADMIN_E_START_APP_NEEDS_COMPONENTS:                    "COMADMIN_E_START_APP_NEEDS_COMPONENTS",
+	COMADMIN_E_REQUIRES_DIFFERENT_PLATFORM:                   "COMADMIN_E_REQUIRES_DIFFERENT_PLATFORM",
+	COMADMIN_E_CAN_NOT_EXPORT_APP_PROXY:                      "COMADMIN_E_CAN_NOT_EXPORT_APP_PROXY",
+	COMADMIN_E_CAN_NOT_START_APP:                             "COMADMIN_E_CAN_NOT_START_APP",
+	COMADMIN_E_CAN_NOT_EXPORT_SYS_APP:                        "COMADMIN_E_CAN_NOT_EXPORT_SYS_APP",
+	COMADMIN_E_CANT_SUBSCRIBE_TO_COMPONENT:                   "COMADMIN_E_CANT_SUBSCRIBE_TO_COMPONENT",
+	COMADMIN_E_EVENTCLASS_CANT_BE_SUBSCRIBER:                 "COMADMIN_E_EVENTCLASS_CANT_BE_SUBSCRIBER",
+	COMADMIN_E_LIB_APP_PROXY_INCOMPATIBLE:                    "COMADMIN_E_LIB_APP_PROXY_INCOMPATIBLE",
+	COMADMIN_E_BASE_PARTITION_ONLY:                           "COMADMIN_E_BASE_PARTITION_ONLY",
+	COMADMIN_E_START_APP_DISABLED:                            "COMADMIN_E_START_APP_DISABLED",
+	COMADMIN_E_CAT_DUPLICATE_PARTITION_NAME:                  "COMADMIN_E_CAT_DUPLICATE_PARTITION_NAME",
+	COMADMIN_E_CAT_INVALID_PARTITION_NAME:                    "COMADMIN_E_CAT_INVALID_PARTITION_NAME",
+	COMADMIN_E_CAT_PARTITION_IN_USE:                          "COMADMIN_E_CAT_PARTITION_IN_USE",
+	COMADMIN_E_FILE_PARTITION_DUPLICATE_FILES:                "COMADMIN_E_FILE_PARTITION_DUPLICATE_FILES",
+	COMADMIN_E_CAT_IMPORTED_COMPONENTS_NOT_ALLOWED:           "COMADMIN_E_CAT_IMPORTED_COMPONENTS_NOT_ALLOWED",
+	COMADMIN_E_AMBIGUOUS_APPLICATION_NAME:                    "COMADMIN_E_AMBIGUOUS_APPLICATION_NAME",
+	COMADMIN_E_AMBIGUOUS_PARTITION_NAME:                      "COMADMIN_E_AMBIGUOUS_PARTITION_NAME",
+	COMADMIN_E_REGDB_NOTINITIALIZED:                          "COMADMIN_E_REGDB_NOTINITIALIZED",
+	COMADMIN_E_REGDB_NOTOPEN:                                 "COMADMIN_E_REGDB_NOTOPEN",
+	COMADMIN_E_REGDB_SYSTEMERR:                               "COMADMIN_E_REGDB_SYSTEMERR",
+	COMADMIN_E_REGDB_ALREADYRUNNING:                          "COMADMIN_E_REGDB_ALREADYRUNNING",
+	COMADMIN_E_MIG_VERSIONNOTSUPPORTED:                       "COMADMIN_E_MIG_VERSIONNOTSUPPORTED",
+	COMADMIN_E_MIG_SCHEMANOTFOUND:                            "COMADMIN_E_MIG_SCHEMANOTFOUND",
+	COMADMIN_E_CAT_BITNESSMISMATCH:                           "COMADMIN_E_CAT_BITNESSMISMATCH",
+	COMADMIN_E_CAT_UNACCEPTABLEBITNESS:                       "COMADMIN_E_CAT_UNACCEPTABLEBITNESS",
+	COMADMIN_E_CAT_WRONGAPPBITNESS:                           "COMADMIN_E_CAT_WRONGAPPBITNESS",
+	COMADMIN_E_CAT_PAUSE_RESUME_NOT_SUPPORTED:                "COMADMIN_E_CAT_PAUSE_RESUME_NOT_SUPPORTED",
+	COMADMIN_E_CAT_SERVERFAULT:                               "COMADMIN_E_CAT_SERVERFAULT",
+	COMQC_E_APPLICATION_NOT_QUEUED:                           "COMQC_E_APPLICATION_NOT_QUEUED",
+	COMQC_E_NO_QUEUEABLE_INTERFACES:                          "COMQC_E_NO_QUEUEABLE_INTERFACES",
+	COMQC_E_QUEUING_SERVICE_NOT_AVAILABLE:                    "COMQC_E_QUEUING_SERVICE_NOT_AVAILABLE",
+	COMQC_E_NO_IPERSISTSTREAM:                                "COMQC_E_NO_IPERSISTSTREAM",
+	COMQC_E_BAD_MESSAGE:                                      "COMQC_E_BAD_MESSAGE",
+	COMQC_E_UNAUTHENTICATED:                                  "COMQC_E_UNAUTHENTICATED",
+	COMQC_E_UNTRUSTED_ENQUEUER:                               "COMQC_E_UNTRUSTED_ENQUEUER",
+	MSDTC_E_DUPLICATE_RESOURCE:                               "MSDTC_E_DUPLICATE_RESOURCE",
+	COMADMIN_E_OBJECT_PARENT_MISSING:                         "COMADMIN_E_OBJECT_PARENT_MISSING",
+	COMADMIN_E_OBJECT_DOES_NOT_EXIST:                         "COMADMIN_E_OBJECT_DOES_NOT_EXIST",
+	COMADMIN_E_APP_NOT_RUNNING:                               "COMADMIN_E_APP_NOT_RUNNING",
+	COMADMIN_E_INVALID_PARTITION:                             "COMADMIN_E_INVALID_PARTITION",
+	COMADMIN_E_SVCAPP_NOT_POOLABLE_OR_RECYCLABLE:             "COMADMIN_E_SVCAPP_NOT_POOLABLE_OR_RECYCLABLE",
+	COMADMIN_E_USER_IN_SET:                                   "COMADMIN_E_USER_IN_SET",
+	COMADMIN_E_CANTRECYCLELIBRARYAPPS:                        "COMADMIN_E_CANTRECYCLELIBRARYAPPS",
+	COMADMIN_E_CANTRECYCLESERVICEAPPS:                        "COMADMIN_E_CANTRECYCLESERVICEAPPS",
+	COMADMIN_E_PROCESSALREADYRECYCLED:                        "COMADMIN_E_PROCESSALREADYRECYCLED",
+	COMADMIN_E_PAUSEDPROCESSMAYNOTBERECYCLED:                 "COMADMIN_E_PAUSEDPROCESSMAYNOTBERECYCLED",
+	COMADMIN_E_CANTMAKEINPROCSERVICE:                         "COMADMIN_E_CANTMAKEINPROCSERVICE",
+	COMADMIN_E_PROGIDINUSEBYCLSID:                            "COMADMIN_E_PROGIDINUSEBYCLSID",
+	COMADMIN_E_DEFAULT_PARTITION_NOT_IN_SET:                  "COMADMIN_E_DEFAULT_PARTITION_NOT_IN_SET",
+	COMADMIN_E_RECYCLEDPROCESSMAYNOTBEPAUSED:                 "COMADMIN_E_RECYCLEDPROCESSMAYNOTBEPAUSED",
+	COMADMIN_E_PARTITION_ACCESSDENIED:                        "COMADMIN_E_PARTITION_ACCESSDENIED",
+	COMADMIN_E_PARTITION_MSI_ONLY:                            "COMADMIN_E_PARTITION_MSI_ONLY",
+	COMADMIN_E_LEGACYCOMPS_NOT_ALLOWED_IN_1_0_FORMAT:         "COMADMIN_E_LEGACYCOMPS_NOT_ALLOWED_IN_1_0_FORMAT",
+	COMADMIN_E_LEGACYCOMPS_NOT_ALLOWED_IN_NONBASE_PARTITIONS: "COMADMIN_E_LEGACYCOMPS_NOT_ALLOWED_IN_NONBASE_PARTITIONS",
+	COMADMIN_E_COMP_MOVE_SOURCE:                              "COMADMIN_E_COMP_MOVE_SOURCE",
+	COMADMIN_E_COMP_MOVE_DEST:                                "COMADMIN_E_COMP_MOVE_DEST",
+	COMADMIN_E_COMP_MOVE_PRIVATE:                             "COMADMIN_E_COMP_MOVE_PRIVATE",
+	COMADMIN_E_BASEPARTITION_REQUIRED_IN_SET:                 "COMADMIN_E_BASEPARTITION_REQUIRED_IN_SET",
+	COMADMIN_E_CANNOT_ALIAS_EVENTCLASS:                       "COMADMIN_E_CANNOT_ALIAS_EVENTCLASS",
+	COMADMIN_E_PRIVATE_ACCESSDENIED:                          "COMADMIN_E_PRIVATE_ACCESSDENIED",
+	COMADMIN_E_SAFERINVALID:                                  "COMADMIN_E_SAFERINVALID",
+	COMADMIN_E_REGISTRY_ACCESSDENIED:                         "COMADMIN_E_REGISTRY_ACCESSDENIED",
+	COMADMIN_E_PARTITIONS_DISABLED:                           "COMADMIN_E_PARTITIONS_DISABLED",
+	DXGI_STATUS_OCCLUDED:                                     "DXGI_STATUS_OCCLUDED",
+	DXGI_STATUS_CLIPPED:                                      "DXGI_STATUS_CLIPPED",
+	DXGI_STATUS_NO_REDIRECTION:                               "DXGI_STATUS_NO_REDIRECTION",
+	DXGI_STATUS_NO_DESKTOP_ACCESS:                            "DXGI_STATUS_NO_DESKTOP_ACCESS",
+	DXGI_STATUS_GRAPHICS_VIDPN_SOURCE_IN_USE:                 "DXGI_STATUS_GRAPHICS_VIDPN_SOURCE_IN_USE",
+	DXGI_STATUS_MODE_CHANGED:                                 "DXGI_STATUS_MODE_CHANGED",
+	DXGI_STATUS_MODE_CHANGE_IN_PROGRESS:                      "DXGI_STATUS_MODE_CHANGE_IN_PROGRESS",
+	DXGI_STATUS_UNOCCLUDED:                                   "DXGI_STATUS_UNOCCLUDED",
+	DXGI_STATUS_DDA_WAS_STILL_DRAWING:                        "DXGI_STATUS_DDA_WAS_STILL_DRAWING",
+	DXGI_STATUS_PRESENT_REQUIRED:                             "DXGI_STATUS_PRESENT_REQUIRED",
+	DXGI_ERROR_INVALID_CALL:                                  "DXGI_ERROR_INVALID_CALL",
+	DXGI_ERROR_NOT_FOUND:                                     "DXGI_ERROR_NOT_FOUND",
+	DXGI_ERROR_MORE_DATA:                                     "DXGI_ERROR_MORE_DATA",
+	DXGI_ERROR_UNSUPPORTED:                                   "DXGI_ERROR_UNSUPPORTED",
+	DXGI_ERROR_DEVICE_REMOVED:                                "DXGI_ERROR_DEVICE_REMOVED",
+	DXGI_ERROR_DEVICE_HUNG:                                   "DXGI_ERROR_DEVICE_HUNG",
+	DXGI_ERROR_DEVICE_RESET:                                  "DXGI_ERROR_DEVICE_RESET",
+	DXGI_ERROR_WAS_STILL_DRAWING:                             "DXGI_ERROR_WAS_STILL_DRAWING",
+	DXGI_ERROR_FRAME_STATISTICS_DISJOINT:                     "DXGI_ERROR_FRAME_STATISTICS_DISJOINT",
+	DXGI_ERROR_GRAPHICS_VIDPN_SOURCE_IN_USE:                  "DXGI_ERROR_GRAPHICS_VIDPN_SOURCE_IN_USE",
+	DXGI_ERROR_DRIVER_INTERNAL_ERROR:                         "DXGI_ERROR_DRIVER_INTERNAL_ERROR",
+	DXGI_ERROR_NONEXCLUSIVE:                                  "DXGI_ERROR_NONEXCLUSIVE",
+	DXGI_ERROR_NOT_CURRENTLY_AVAILABLE:                       "DXGI_ERROR_NOT_CURRENTLY_AVAILABLE",
+	DXGI_ERROR_REMOTE_CLIENT_DISCONNECTED:                    "DXGI_ERROR_REMOTE_CLIENT_DISCONNECTED",
+	DXGI_ERROR_REMOTE_OUTOFMEMORY:                            "DXGI_ERROR_REMOTE_OUTOFMEMORY",
+	DXGI_ERROR_ACCESS_LOST:                                   "DXGI_ERROR_ACCESS_LOST",
+	DXGI_ERROR_WAIT_TIMEOUT:                                  "DXGI_ERROR_WAIT_TIMEOUT",
+	DXGI_ERROR_SESSION_DISCONNECTED:                          "DXGI_ERROR_SESSION_DISCONNECTED",
+	DXGI_ERROR_RESTRICT_TO_OUTPUT_STALE:                      "DXGI_ERROR_RESTRICT_TO_OUTPUT_STALE",
+	DXGI_ERROR_CANNOT_PROTECT_CONTENT:                        "DXGI_ERROR_CANNOT_PROTECT_CONTENT",
+	DXGI_ERROR_ACCESS_DENIED:                                 "DXGI_ERROR_ACCESS_DENIED",
+	DXGI_ERROR_NAME_ALREADY_EXISTS:                           "DXGI_ERROR_NAME_ALREADY_EXISTS",
+	DXGI_ERROR_SDK_COMPONENT_MISSING:                         "DXGI_ERROR_SDK_COMPONENT_MISSING",
+	DXGI_ERROR_NOT_CURRENT:                                   "DXGI_ERROR_NOT_CURRENT",
+	DXGI_ERROR_HW_PROTECTION_OUTOFMEMORY:                     "DXGI_ERROR_HW_PROTECTION_OUTOFMEMORY",
+	DXGI_ERROR_DYNAMIC_CODE_POLICY_VIOLATION:                 "DXGI_ERROR_DYNAMIC_CODE_POLICY_VIOLATION",
+	DXGI_ERROR_NON_COMPOSITED_UI:                             "DXGI_ERROR_NON_COMPOSITED_UI",
+	DXGI_ERROR_MODE_CHANGE_IN_PROGRESS:                       "DXGI_ERROR_MODE_CHANGE_IN_PROGRESS",
+	DXGI_ERROR_CACHE_CORRUPT:                                 "DXGI_ERROR_CACHE_CORRUPT",
+	DXGI_ERROR_CACHE_FULL:                                    "DXGI_ERROR_CACHE_FULL",
+	DXGI_ERROR_CACHE_HASH_COLLISION:                          "DXGI_ERROR_CACHE_HASH_COLLISION",
+	DXGI_ERROR_ALREADY_EXISTS:                                "DXGI_ERROR_ALREADY_EXISTS",
+	DXGI_DDI_ERR_WASSTILLDRAWING:                             "DXGI_DDI_ERR_WASSTILLDRAWING",
+	DXGI_DDI_ERR_UNSUPPORTED:                                 "DXGI_DDI_ERR_UNSUPPORTED",
+	DXGI_DDI_ERR_NONEXCLUSIVE:                                "DXGI_DDI_ERR_NONEXCLUSIVE",
+	D3D10_ERROR_TOO_MANY_UNIQUE_STATE_OBJECTS:                "D3D10_ERROR_TOO_MANY_UNIQUE_STATE_OBJECTS",
+	D3D10_ERROR_FILE_NOT_FOUND:                               "D3D10_ERROR_FILE_NOT_FOUND",
+	D3D11_ERROR_TOO_MANY_UNIQUE_STATE_OBJECTS:                "D3D11_ERROR_TOO_MANY_UNIQUE_STATE_OBJECTS",
+	D3D11_ERROR_FILE_NOT_FOUND:                               "D3D11_ERROR_FILE_NOT_FOUND",
+	D3D11_ERROR_TOO_MANY_UNIQUE_VIEW_OBJECTS:                 "D3D11_ERROR_TOO_MANY_UNIQUE_VIEW_OBJECTS",
+	D3D11_ERROR_DEFERRED_CONTEXT_MAP_WITHOUT_INITIAL_DISCARD: "D3D11_ERROR_DEFERRED_CONTEXT_MAP_WITHOUT_INITIAL_DISCARD",
+	D3D12_ERROR_ADAPTER_NOT_FOUND:                            "D3D12_ERROR_ADAPTER_NOT_FOUND",
+	D3D12_ERROR_DRIVER_VERSION_MISMATCH:                      "D3D12_ERROR_DRIVER_VERSION_MISMATCH",
+	D2DERR_WRONG_STATE:                                       "D2DERR_WRONG_STATE",
+	D2DERR_NOT_INITIALIZED:                                   "D2DERR_NOT_INITIALIZED",
+	D2DERR_UNSUPPORTED_OPERATION:                             "D2DERR_UNSUPPORTED_OPERATION",
+	D2DERR_SCANNER_FAILED:                                    "D2DERR_SCANNER_FAILED",
+	D2DERR_SCREEN_ACCESS_DENIED:                              "D2DERR_SCREEN_ACCESS_DENIED",
+	D2DERR_DISPLAY_STATE_INVALID:                             "D2DERR_DISPLAY_STATE_INVALID",
+	D2DERR_ZERO_VECTOR:                                       "D2DERR_ZERO_VECTOR",
+	D2DERR_INTERNAL_ERROR:                                    "D2DERR_INTERNAL_ERROR",
+	D2DERR_DISPLAY_FORMAT_NOT_SUPPORTED:                      "D2DERR_DISPLAY_FORMAT_NOT_SUPPORTED",
+	D2DERR_INVALID_CALL:                                      "D2DERR_INVALID_CALL",
+	D2DERR_NO_HARDWARE_DEVICE:                                "D2DERR_NO_HARDWARE_DEVICE",
+	D2DERR_RECREATE_TARGET:                                   "D2DERR_RECREATE_TARGET",
+	D2DERR_TOO_MANY_SHADER_ELEMENTS:                          "D2DERR_TOO_MANY_SHADER_ELEMENTS",
+	D2DERR_SHADER_COMPILE_FAILED:                             "D2DERR_SHADER_COMPILE_FAILED",
+	D2DERR_MAX_TEXTURE_SIZE_EXCEEDED:                         "D2DERR_MAX_TEXTURE_SIZE_EXCEEDED",
+	D2DERR_UNSUPPORTED_VERSION:                               "D2DERR_UNSUPPORTED_VERSION",
+	D2DERR_BAD_NUMBER:                                        "D2DERR_BAD_NUMBER",
+	D2DERR_WRONG_FACTORY:                                     "D2DERR_WRONG_FACTORY",
+	D2DERR_LAYER_ALREADY_IN_USE:                              "D2DERR_LAYER_ALREADY_IN_USE",
+	D2DERR_POP_CALL_DID_NOT_MATCH_PUSH:                       "D2DERR_POP_CALL_DID_NOT_MATCH_PUSH",
+	D2DERR_WRONG_RESOURCE_DOMAIN:                             "D2DERR_WRONG_RESOURCE_DOMAIN",
+	D2DERR_PUSH_POP_UNBALANCED:                               "D2DERR_PUSH_POP_UNBALANCED",
+	D2DERR_RENDER_TARGET_HAS_LAYER_OR_CLIPRECT:               "D2DERR_RENDER_TARGET_HAS_LAYER_OR_CLIPRECT",
+	D2DERR_INCOMPATIBLE_BRUSH_TYPES:                          "D2DERR_INCOMPATIBLE_BRUSH_TYPES",
+	D2DERR_WIN32_ERROR:                                       "D2DERR_WIN32_ERROR",
+	D2DERR_TARGET_NOT_GDI_COMPATIBLE:                         "D2DERR_TARGET_NOT_GDI_COMPATIBLE",
+	D2DERR_TEXT_EFFECT_IS_WRONG_TYPE:                         "D2DERR_TEXT_EFFECT_IS_WRONG_TYPE",
+	D2DERR_TEXT_RENDERER_NOT_RELEASED:                        "D2DERR_TEXT_RENDERER_NOT_RELEASED",
+	D2DERR_EXCEEDS_MAX_BITMAP_SIZE:                           "D2DERR_EXCEEDS_MAX_BITMAP_SIZE",
+	D2DERR_INVALID_GRAPH_CONFIGURATION:                       "D2DERR_INVALID_GRAPH_CONFIGURATION",
+	D2DERR_INVALID_INTERNAL_GRAPH_CONFIGURATION:              "D2DERR_INVALID_INTERNAL_GRAPH_CONFIGURATION",
+	D2DERR_CYCLIC_GRAPH:                                      "D2DERR_CYCLIC_GRAPH",
+	D2DERR_BITMAP_CANNOT_DRAW:                                "D2DERR_BITMAP_CANNOT_DRAW",
+	D2DERR_OUTSTANDING_BITMAP_REFERENCES:                     "D2DERR_OUTSTANDING_BITMAP_REFERENCES",
+	D2DERR_ORIGINAL_TARGET_NOT_BOUND:                         "D2DERR_ORIGINAL_TARGET_NOT_BOUND",
+	D2DERR_INVALID_TARGET:                                    "D2DERR_INVALID_TARGET",
+	D2DERR_BITMAP_BOUND_AS_TARGET:                            "D2DERR_BITMAP_BOUND_AS_TARGET",
+	D2DERR_INSUFFICIENT_DEVICE_CAPABILITIES:                  "D2DERR_INSUFFICIENT_DEVICE_CAPABILITIES",
+	D2DERR_INTERMEDIATE_TOO_LARGE:                            "D2DERR_INTERMEDIATE_TOO_LARGE",
+	D2DERR_EFFECT_IS_NOT_REGISTERED:                          "D2DERR_EFFECT_IS_NOT_REGISTERED",
+	D2DERR_INVALID_PROPERTY:                                  "D2DERR_INVALID_PROPERTY",
+	D2DERR_NO_SUBPROPERTIES:                                  "D2DERR_NO_SUBPROPERTIES",
+	D2DERR_PRINT_JOB_CLOSED:                                  "D2DERR_PRINT_JOB_CLOSED",
+	D2DERR_PRINT_FORMAT_NOT_SUPPORTED:                        "D2DERR_PRINT_FORMAT_NOT_SUPPORTED",
+	D2DERR_TOO_MANY_TRANSFORM_INPUTS:                         "D2DERR_TOO_MANY_TRANSFORM_INPUTS",
+	D2DERR_INVALID_GLYPH_IMAGE:                               "D2DERR_INVALID_GLYPH_IMAGE",
+	TBS_E_INTERNAL_ERROR:                                     "TBS_E_INTERNAL_ERROR",
+	TBS_E_BAD_PARAMETER:                                      "TBS_E_BAD_PARAMETER",
+	TBS_E_INVALID_OUTPUT_POINTER:                             "TBS_E_INVALID_OUTPUT_POINTER",
+	TBS_E_INSUFFICIENT_BUFFER:                                "TBS_E_INSUFFICIENT_BUFFER",
+	TBS_E_IOERROR:                                            "TBS_E_IOERROR",
+	TBS_E_INVALID_CONTEXT_PARAM:                              "TBS_E_INVALID_CONTEXT_PARAM",
+	TBS_E_SERVICE_NOT_RUNNING:                                "TBS_E_SERVICE_NOT_RUNNING",
+	TBS_E_TOO_MANY_TBS_CONTEXTS:                              "TBS_E_TOO_MANY_TBS_CONTEXTS",
+	TBS_E_SERVICE_START_PENDING:                              "TBS_E_SERVICE_START_PENDING",
+	TBS_E_BUFFER_TOO_LARGE:                                   "TBS_E_BUFFER_TOO_LARGE",
+	TBS_E_TPM_NOT_FOUND:                                      "TBS_E_TPM_NOT_FOUND",
+	TBS_E_SERVICE_DISABLED:                                   "TBS_E_SERVICE_DISABLED",
+	TBS_E_DEACTIVATED:                                        "TBS_E_DEACTIVATED",
+	FWP_E_CALLOUT_NOT_FOUND:                                  "FWP_E_CALLOUT_NOT_FOUND",
+	FWP_E_CONDITION_NOT_FOUND:                                "FWP_E_CONDITION_NOT_FOUND",
+	FWP_E_FILTER_NOT_FOUND:                                   "FWP_E_FILTER_NOT_FOUND",
+	FWP_E_LAYER_NOT_FOUND:                                    "FWP_E_LAYER_NOT_FOUND",
+	FWP_E_PROVIDER_NOT_FOUND:                                 "FWP_E_PROVIDER_NOT_FOUND",
+	FWP_E_PROVIDER_CONTEXT_NOT_FOUND:                         "FWP_E_PROVIDER_CONTEXT_NOT_FOUND",
+	FWP_E_SUBLAYER_NOT_FOUND:                                 "FWP_E_SUBLAYER_NOT_FOUND",
+	FWP_E_NOT_FOUND:                                          "FWP_E_NOT_FOUND",
+	FWP_E_ALREADY_EXISTS:                                     "FWP_E_ALREADY_EXISTS",
+	FWP_E_IN_USE:                                             "FWP_E_IN_USE",
+	FWP_E_DYNAMIC_SESSION_IN_PROGRESS:                        "FWP_E_DYNAMIC_SESSION_IN_PROGRESS",
+	FWP_E_WRONG_SESSION:                                      "FWP_E_WRONG_SESSION",
+	FWP_E_NO_TXN_IN_PROGRESS:                                 "FWP_E_NO_TXN_IN_PROGRESS",
+	FWP_E_TXN_IN_PROGRESS:                                    "FWP_E_TXN_IN_PROGRESS",
+	FWP_E_TXN_ABORTED:                                        "FWP_E_TXN_ABORTED",
+	FWP_E_SESSION_ABORTED:                                    "FWP_E_SESSION_ABORTED",
+	FWP_E_INCOMPATIBLE_TXN:                                   "FWP_E_INCOMPATIBLE_TXN",
+	FWP_E_TIMEOUT:                                            "FWP_E_TIMEOUT",
+	FWP_E_NET_EVENTS_DISABLED:                                "FWP_E_NET_EVENTS_DISABLED",
+	FWP_E_INCOMPATIBLE_LAYER:                                 "FWP_E_INCOMPATIBLE_LAYER",
+	FWP_E_KM_CLIENTS_ONLY:                                    "FWP_E_KM_CLIENTS_ONLY",
+	FWP_E_LIFETIME_MISMATCH:                                  "FWP_E_LIFETIME_MISMATCH",
+	FWP_E_BUILTIN_OBJECT:                                     "FWP_E_BUILTIN_OBJECT",
+	FWP_E_TOO_MANY_CALLOUTS:                                  "FWP_E_TOO_MANY_CALLOUTS",
+	FWP_E_NOTIFICATION_DROPPED:                               "FWP_E_NOTIFICATION_DROPPED",
+	FWP_E_TRAFFIC_MISMATCH:                                   "FWP_E_TRAFFIC_MISMATCH",
+	FWP_E_INCOMPATIBLE_SA_STATE:                              "FWP_E_INCOMPATIBLE_SA_STATE",
+	FWP_E_NULL_POINTER:                                       "FWP_E_NULL_POINTER",
+	FWP_E_INVALID_ENUMERATOR:                                 "FWP_E_INVALID_ENUMERATOR",
+	FWP_E_INVALID_FLAGS:                                      "FWP_E_INVALID_FLAGS",
+	FWP_E_INVALID_NET_MASK:                                   "FWP_E_INVALID_NET_MASK",
+	FWP_E_INVALID_RANGE:                                      "FWP_E_INVALID_RANGE",
+	FWP_E_INVALID_INTERVAL:                                   "FWP_E_INVALID_INTERVAL",
+	FWP_E_ZERO_LENGTH_ARRAY:                                  "FWP_E_ZERO_LENGTH_ARRAY",
+	FWP_E_NULL_DISPLAY_NAME:                                  "FWP_E_NULL_DISPLAY_NAME",
+	FWP_E_INVALID_ACTION_TYPE:                                "FWP_E_INVALID_ACTION_TYPE",
+	FWP_E_INVALID_WEIGHT:                                     "FWP_E_INVALID_WEIGHT",
+	FWP_E_MATCH_TYPE_MISMATCH:                                "FWP_E_MATCH_TYPE_MISMATCH",
+	FWP_E_TYPE_MISMATCH:                                      "FWP_E_TYPE_MISMATCH",
+	FWP_E_OUT_OF_BOUNDS:                                      "FWP_E_OUT_OF_BOUNDS",
+	FWP_E_RESERVED:                                           "FWP_E_RESERVED",
+	FWP_E_DUPLICATE_CONDITION:                                "FWP_E_DUPLICATE_CONDITION",
+	FWP_E_DUPLICATE_KEYMOD:                                   "FWP_E_DUPLICATE_KEYMOD",
+	FWP_E_ACTION_INCOMPATIBLE_WITH_LAYER:                     "FWP_E_ACTION_INCOMPATIBLE_WITH_LAYER",
+	FWP_E_ACTION_INCOMPATIBLE_WITH_SUBLAYER:                  "FWP_E_ACTION_INCOMPATIBLE_WITH_SUBLAYER",
+	FWP_E_CONTEXT_INCOMPATIBLE_WITH_LAYER:                    "FWP_E_CONTEXT_INCOMPATIBLE_WITH_LAYER",
+	FWP_E_CONTEXT_INCOMPATIBLE_WITH_CALLOUT:                  "FWP_E_CONTEXT_INCOMPATIBLE_WITH_CALLOUT",
+	FWP_E_INCOMPATIBLE_AUTH_METHOD:                           "FWP_E_INCOMPATIBLE_AUTH_METHOD",
+	FWP_E_INCOMPATIBLE_DH_GROUP:                              "FWP_E_INCOMPATIBLE_DH_GROUP",
+	FWP_E_EM_NOT_SUPPORTED:                                   "FWP_E_EM_NOT_SUPPORTED",
+	FWP_E_NEVER_MATCH:                                        "FWP_E_NEVER_MATCH",
+	FWP_E_PROVIDER_CONTEXT_MISMATCH:                          "FWP_E_PROVIDER_CONTEXT_MISMATCH",
+	FWP_E_INVALID_PARAMETER:                                  "FWP_E_INVALID_PARAMETER",
+	FWP_E_TOO_MANY_SUBLAYERS:                                 "FWP_E_TOO_MANY_SUBLAYERS",
+	FWP_E_CALLOUT_NOTIFICATION_FAILED:                        "FWP_E_CALLOUT_NOTIFICATION_FAILED",
+	FWP_E_INVALID_AUTH_TRANSFORM:                             "FWP_E_INVALID_AUTH_TRANSFORM",
+	FWP_E_INVALID_CIPHER_TRANSFORM:                           "FWP_E_INVALID_CIPHER_TRANSFORM",
+	WINCODEC_ERR_WRONGSTATE:                                  "WINCODEC_ERR_WRONGSTATE",
+	WINCODEC_ERR_VALUEOUTOFRANGE:                             "WINCODEC_ERR_VALUEOUTOFRANGE",
+	WINCODEC_ERR_UNKNOWNIMAGEFORMAT:                          "WINCODEC_ERR_UNKNOWNIMAGEFORMAT",
+	WINCODEC_ERR_UNSUPPORTEDVERSION:                          "WINCODEC_ERR_UNSUPPORTEDVERSION",
+	WINCODEC_ERR_NOTINITIALIZED:                              "WINCODEC_ERR_NOTINITIALIZED",
+	WINCODEC_ERR_ALREADYLOCKED:                               "WINCODEC_ERR_ALREADYLOCKED",
+	WINCODEC_ERR_PROPERTYNOTFOUND:                            "WINCODEC_ERR_PROPERTYNOTFOUND",
+	WINCODEC_ERR_PROPERTYNOTSUPPORTED:                        "WINCODEC_ERR_PROPERTYNOTSUPPORTED",
+	WINCODEC_ERR_PROPERTYSIZE:                                "WINCODEC_ERR_PROPERTYSIZE",
+	WINCODEC_ERR_CODECPRESENT:                                "WINCODEC_ERR_CODECPRESENT",
+	WINCODEC_ERR_CODECNOTHUMBNAIL:                            "WINCODEC_ERR_CODECNOTHUMBNAIL",
+	WINCODEC_ERR_PALETTEUNAVAILABLE:                          "WINCODEC_ERR_PALETTEUNAVAILABLE",
+	WINCODEC_ERR_CODECTOOMANYSCANLINES:                       "WINCODEC_ERR_CODECTOOMANYSCANLINES",
+	WINCODEC_ERR_INTERNALERROR:                               "WINCODEC_ERR_INTERNALERROR",
+	WINCODEC_ERR_SOURCERECTDOESNOTMATCHDIMENSIONS:            "WINCODEC_ERR_SOURCERECTDOESNOTMATCHDIMENSIONS",
+	WINCODEC_ERR_COMPONENTNOTFOUND:                           "WINCODEC_ERR_COMPONENTNOTFOUND",
+	WINCODEC_ERR_IMAGESIZEOUTOFRANGE:                         "WINCODEC_ERR_IMAGESIZEOUTOFRANGE",
+	WINCODEC_ERR_TOOMUCHMETADATA:                             "WINCODEC_ERR_TOOMUCHMETADATA",
+	WINCODEC_ERR_BADIMAGE:                                    "WINCODEC_ERR_BADIMAGE",
+	WINCODEC_ERR_BADHEADER:                                   "WINCODEC_ERR_BADHEADER",
+	WINCODEC_ERR_FRAMEMISSING:                                "WINCODEC_ERR_FRAMEMISSING",
+	WINCODEC_ERR_BADMETADATAHEADER:                           "WINCODEC_ERR_BADMETADATAHEADER",
+	WINCODEC_ERR_BADSTREAMDATA:                               "WINCODEC_ERR_BADSTREAMDATA",
+	WINCODEC_ERR_STREAMWRITE:                                 "WINCODEC_ERR_STREAMWRITE",
+	WINCODEC_ERR_STREAMREAD:                                  "WINCODEC_ERR_STREAMREAD",
+	WINCODEC_ERR_STREAMNOTAVAILABLE:                          "WINCODEC_ERR_STREAMNOTAVAILABLE",
+	WINCODEC_ERR_UNSUPPORTEDPIXELFORMAT:                      "WINCODEC_ERR_UNSUPPORTEDPIXELFORMAT",
+	WINCODEC_ERR_UNSUPPORTEDOPERATION:                        "WINCODEC_ERR_UNSUPPORTEDOPERATION",
+	WINCODEC_ERR_INVALIDREGISTRATION:                         "WINCODEC_ERR_INVALIDREGISTRATION",
+	WINCODEC_ERR_COMPONENTINITIALIZEFAILURE:                  "WINCODEC_ERR_COMPONENTINITIALIZEFAILURE",
+	WINCODEC_ERR_INSUFFICIENTBUFFER:                          "WINCODEC_ERR_INSUFFICIENTBUFFER",
+	WINCODEC_ERR_DUPLICATEMETADATAPRESENT:                    "WINCODEC_ERR_DUPLICATEMETADATAPRESENT",
+	WINCODEC_ERR_PROPERTYUNEXPECTEDTYPE:                      "WINCODEC_ERR_PROPERTYUNEXPECTEDTYPE",
+	WINCODEC_ERR_UNEXPECTEDSIZE:                              "WINCODEC_ERR_UNEXPECTEDSIZE",
+	WINCODEC_ERR_INVALIDQUERYREQUEST:                         "WINCODEC_ERR_INVALIDQUERYREQUEST",
+	WINCODEC_ERR_UNEXPECTEDMETADATATYPE:                      "WINCODEC_ERR_UNEXPECTEDMETADATATYPE",
+	WINCODEC_ERR_REQUESTONLYVALIDATMETADATAROOT:              "WINCODEC_ERR_REQUESTONLYVALIDATMETADATAROOT",
+	WINCODEC_ERR_INVALIDQUERYCHARACTER:                       "WINCODEC_ERR_INVALIDQUERYCHARACTER",
+	WINCODEC_ERR_WIN32ERROR:                                  "WINCODEC_ERR_WIN32ERROR",
+	WINCODEC_ERR_INVALIDPROGRESSIVELEVEL:                     "WINCODEC_ERR_INVALIDPROGRESSIVELEVEL",
+	WINCODEC_ERR_INVALIDJPEGSCANINDEX:                        "WINCODEC_ERR_INVALIDJPEGSCANINDEX",
+	NAP_E_INVALID_PACKET:                                     "NAP_E_INVALID_PACKET",
+	NAP_E_MISSING_SOH:                                        "NAP_E_MISSING_SOH",
+	NAP_E_CONFLICTING_ID:                                     "NAP_E_CONFLICTING_ID",
+	NAP_E_NO_CACHED_SOH:                                      "NAP_E_NO_CACHED_SOH",
+	NAP_E_STILL_BOUND:                                        "NAP_E_STILL_BOUND",
+	NAP_E_NOT_REGISTERED:                                     "NAP_E_NOT_REGISTERED",
+	NAP_E_NOT_INITIALIZED:                                    "NAP_E_NOT_INITIALIZED",
+	NAP_E_MISMATCHED_ID:                                      "NAP_E_MISMATCHED_ID",
+	NAP_E_NOT_PENDING:                                        "NAP_E_NOT_PENDING",
+	NAP_E_ID_NOT_FOUND:                                       "NAP_E_ID_NOT_FOUND",
+	NAP_E_MAXSIZE_TOO_SMALL:                                  "NAP_E_MAXSIZE_TOO_SMALL",
+	NAP_E_SERVICE_NOT_RUNNING:                                "NAP_E_SERVICE_NOT_RUNNING",
+	NAP_S_CERT_ALREADY_PRESENT:                               "NAP_S_CERT_ALREADY_PRESENT",
+	NAP_E_ENTITY_DISABLED:                                    "NAP_E_ENTITY_DISABLED",
+	NAP_E_NETSH_GROUPPOLICY_ERROR:                            "NAP_E_NETSH_GROUPPOLICY_ERROR",
+	NAP_E_TOO_MANY_CALLS:                                     "NAP_E_TOO_MANY_CALLS",
+	NAP_E_SHV_CONFIG_EXISTED:                                 "NAP_E_SHV_CONFIG_EXISTED",
+	NAP_E_SHV_CONFIG_NOT_FOUND:                               "NAP_E_SHV_CONFIG_NOT_FOUND",
+	NAP_E_SHV_TIMEOUT:                                        "NAP_E_SHV_TIMEOUT",
+	E_BLUETOOTH_ATT_INVALID_HANDLE:                           "E_BLUETOOTH_ATT_INVALID_HANDLE",
+	E_BLUETOOTH_ATT_READ_NOT_PERMITTED:                       "E_BLUETOOTH_ATT_READ_NOT_PERMITTED",
+	E_BLUETOOTH_ATT_WRITE_NOT_PERMITTED:                      "E_BLUETOOTH_ATT_WRITE_NOT_PERMITTED",
+	E_BLUETOOTH_ATT_INVALID_PDU:                              "E_BLUETOOTH_ATT_INVALID_PDU",
+	E_BLUETOOTH_ATT_INSUFFICIENT_AUTHENTICATION:              "E_BLUETOOTH_ATT_INSUFFICIENT_AUTHENTICATION",
+	E_BLUETOOTH_ATT_REQUEST_NOT_SUPPORTED:                    "E_BLUETOOTH_ATT_REQUEST_NOT_SUPPORTED",
+	E_BLUETOOTH_ATT_INVALID_OFFSET:                           "E_BLUETOOTH_ATT_INVALID_OFFSET",
+	E_BLUETOOTH_ATT_INSUFFICIENT_AUTHORIZATION:               "E_BLUETOOTH_ATT_INSUFFICIENT_AUTHORIZATION",
+	E_BLUETOOTH_ATT_PREPARE_QUEUE_FULL:                       "E_BLUETOOTH_ATT_PREPARE_QUEUE_FULL",
+	E_BLUETOOTH_ATT_ATTRIBUTE_NOT_FOUND:                      "E_BLUETOOTH_ATT_ATTRIBUTE_NOT_FOUND",
+	E_BLUETOOTH_ATT_ATTRIBUTE_NOT_LONG:                       "E_BLUETOOTH_ATT_ATTRIBUTE_NOT_LONG",
+	E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION_KEY_SIZE:         "E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION_KEY_SIZE",
+	E_BLUETOOTH_ATT_INVALID_ATTRIBUTE_VALUE_LENGTH:           "E_BLUETOOTH_ATT_INVALID_ATTRIBUTE_VALUE_LENGTH",
+	E_BLUETOOTH_ATT_UNLIKELY:                                 "E_BLUETOOTH_ATT_UNLIKELY",
+	E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION:                  "E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION",
+	E_BLUETOOTH_ATT_UNSUPPORTED_GROUP_TYPE:                   "E_BLUETOOTH_ATT_UNSUPPORTED_GROUP_TYPE",
+	E_BLUETOOTH_ATT_INSUFFICIENT_RESOURCES:                   "E_BLUETOOTH_ATT_INSUFFICIENT_RESOURCES",
+	E_BLUETOOTH_ATT_UNKNOWN_ERROR:                            "E_BLUETOOTH_ATT_UNKNOWN_ERROR",
+	DWRITE_E_FILEFORMAT:                                      "DWRITE_E_FILEFORMAT",
+	DWRITE_E_UNEXPECTED:                                      "DWRITE_E_UNEXPECTED",
+	DWRITE_E_NOFONT:                                          "DWRITE_E_NOFONT",
+	DWRITE_E_FILENOTFOUND:                                    "DWRITE_E_FILENOTFOUND",
+	DWRITE_E_FILEACCESS:                                      "DWRITE_E_FILEACCESS",
+	DWRITE_E_FONTCOLLECTIONOBSOLETE:                          "DWRITE_E_FONTCOLLECTIONOBSOLETE",
+	DWRITE_E_ALREADYREGISTERED:                               "DWRITE_E_ALREADYREGISTERED",
+	DWRITE_E_CACHEFORMAT:                                     "DWRITE_E_CACHEFORMAT",
+	DWRITE_E_CACHEVERSION:                                    "DWRITE_E_CACHEVERSION",
+	DWRITE_E_UNSUPPORTEDOPERATION:                            "DWRITE_E_UNSUPPORTEDOPERATION",
+	DWRITE_E_TEXTRENDERERINCOMPATIBLE:                        "DWRITE_E_TEXTRENDERERINCOMPATIBLE",
+	DWRITE_E_FLOWDIRECTIONCONFLICTS:                          "DWRITE_E_FLOWDIRECTIONCONFLICTS",
+	DWRITE_E_NOCOLOR:                                         "DWRITE_E_NOCOLOR",
+	DWRITE_E_REMOTEFONT:                                      "DWRITE_E_REMOTEFONT",
+	DWRITE_E_DOWNLOADCANCELLED:                               "DWRITE_E_DOWNLOADCANCELLED",
+	DWRITE_E_DOWNLOADFAILED:                                  "DWRITE_E_DOWNLOADFAILED",
+	DWRITE_E_TOOMANYDOWNLOADS:                                "DWRITE_E_TOOMANYDOWNLOADS",
+}
+
+// hresultMessages maps the HRESULT values to their descriptions, taken from the comments of their constants
+// and the MessageText of winerror.h.
+var hresultMessages = map[HRESULT]string{
+	E_UNEXPECTED:                  "Catastrophic failure",
+	E_NOTIMPL:                     "Not implemented",
+	E_OUTOFMEMORY:                 "Ran out of memory",
+	E_INVALIDARG:                  "One or more arguments are invalid",
+	E_NOINTERFACE:                 "No such interface supported",
+	E_POINTER:                     "Invalid pointer",
+	E_HANDLE:                      "Invalid handle",
+	E_ABORT:                       "Operation aborted",
+	E_FAIL:                        "Unspecified error",
+	E_ACCESSDENIED:                "General access denied error",
+	E_PENDING:                     "The data necessary to complete this operation is not yet available.",
+	E_BOUNDS:                      "The operation attempted to access data outside the valid range",
+	E_CHANGED_STATE:               "A concurrent or interleaved operation changed the state of the object, invalidating this operation.",
+	E_ILLEGAL_STATE_CHANGE:        "An illegal state change was requested.",
+	E_ILLEGAL_METHOD_CALL:         "A method was called at an unexpected time.",
+	E_STRING_NOT_NULL_TERMINATED:  "String not null terminated.",
+	E_ILLEGAL_DELEGATE_ASSIGNMENT: "A delegate was assigned when not allowed.",
+	E_NOT_SUFFICIENT_BUFFER:       "The data area passed to a system call is too small.",
+	E_NOT_SET:                     "Element not found.",
+	E_NOT_VALID_STATE:             "The group or resource is not in the correct state to perform the requested operation.",
+	CLASS_E_NOAGGREGATION:         "Class does not support aggregation (or class object is remote)",
+	REGDB_E_CLASSNOTREG:           "Class not registered",
+	CO_E_NOTINITIALIZED:           "CoInitialize has not been called.",
+	CO_E_ALREADYINITIALIZED:       "CoInitialize has already been called.",
+	RPC_E_CALL_REJECTED:           "Call was rejected by callee.",
+	RPC_E_SERVER_DIED:             "The remote procedure call failed and did not execute.",
+	RPC_E_SERVERFAULT:             "The server threw an exception.",
+	RPC_E_CHANGED_MODE:            "Cannot change thread mode after it is set.",
+	RPC_E_DISCONNECTED:            "The object invoked has disconnected from its clients.",
+	RPC_E_WRONG_THREAD:            "The application called an interface that was marshalled for a different thread.",
+}
+
+// win32ErrorNames maps the Win32Error values to the names of their constants.
+var win32ErrorNames = map[Win32Error]string{
+	ERROR_SUCCESS:                                "ERROR_SUCCESS",
+	ERROR_INVALID_FUNCTION:                       "ERROR_INVALID_FUNCTION",
+	ERROR_FILE_NOT_FOUND:                         "ERROR_FILE_NOT_FOUND",
+	ERROR_PATH_NOT_FOUND:                         "ERROR_PATH_NOT_FOUND",
+	ERROR_TOO_MANY_OPEN_FILES:                    "ERROR_TOO_MANY_OPEN_FILES",
+	ERROR_ACCESS_DENIED:                          "ERROR_ACCESS_DENIED",
+	ERROR_INVALID_HANDLE:                         "ERROR_INVALID_HANDLE",
+	ERROR_ARENA_TRASHED:                          "ERROR_ARENA_TRASHED",
+	ERROR_NOT_ENOUGH_MEMORY:                      "ERROR_NOT_ENOUGH_MEMORY",
+	ERROR_INVALID_BLOCK:                          "ERROR_INVALID_BLOCK",
+	ERROR_BAD_ENVIRONMENT:                        "ERROR_BAD_ENVIRONMENT",
+	ERROR_BAD_FORMAT:                             "ERROR_BAD_FORMAT",
+	ERROR_INVALID_ACCESS:                         "ERROR_INVALID_ACCESS",
+	ERROR_INVALID_DATA:                           "ERROR_INVALID_DATA",
+	ERROR_OUTOFMEMORY:                            "ERROR_OUTOFMEMORY",
+	ERROR_INVALID_DRIVE:                          "ERROR_INVALID_DRIVE",
+	ERROR_CURRENT_DIRECTORY:                      "ERROR_CURRENT_DIRECTORY",
+	ERROR_NOT_SAME_DEVICE:                        "ERROR_NOT_SAME_DEVICE",
+	ERROR_NO_MORE_FILES:                          "ERROR_NO_MORE_FILES",
+	ERROR_WRITE_PROTECT:                          "ERROR_WRITE_PROTECT",
+	ERROR_BAD_UNIT:                               "ERROR_BAD_UNIT",
+	ERROR_NOT_READY:                              "ERROR_NOT_READY",
+	ERROR_BAD_COMMAND:                            "ERROR_BAD_COMMAND",
+	ERROR_CRC:                                    "ERROR_CRC",
+	ERROR_BAD_LENGTH:                             "ERROR_BAD_LENGTH",
+	ERROR_SEEK:                                   "ERROR_SEEK",
+	ERROR_NOT_DOS_DISK:                           "ERROR_NOT_DOS_DISK",
+	ERROR_SECTOR_NOT_FOUND:                       "ERROR_SECTOR_NOT_FOUND",
+	ERROR_OUT_OF_PAPER:                           "ERROR_OUT_OF_PAPER",
+	ERROR_WRITE_FAULT:                            "ERROR_WRITE_FAULT",
+	ERROR_READ_FAULT:                             "ERROR_READ_FAULT",
+	ERROR_GEN_FAILURE:                            "ERROR_GEN_FAILURE",
+	ERROR_SHARING_VIOLATION:                      "ERROR_SHARING_VIOLATION",
+	ERROR_LOCK_VIOLATION:                         "ERROR_LOCK_VIOLATION",
+	ERROR_WRONG_DISK:                             "ERROR_WRONG_DISK",
+	ERROR_SHARING_BUFFER_EXCEEDED:                "ERROR_SHARING_BUFFER_EXCEEDED",
+	ERROR_HANDLE_EOF:                             "ERROR_HANDLE_EOF",
+	ERROR_HANDLE_DISK_FULL:                       "ERROR_HANDLE_DISK_FULL",
+	ERROR_NOT_SUPPORTED:                          "ERROR_NOT_SUPPORTED",
+	ERROR_REM_NOT_LIST:                           "ERROR_REM_NOT_LIST",
+	ERROR_DUP_NAME:                               "ERROR_DUP_NAME",
+	ERROR_BAD_NETPATH:                            "ERROR_BAD_NETPATH",
+	ERROR_NETWORK_BUSY:                           "ERROR_NETWORK_BUSY",
+	ERROR_DEV_NOT_EXIST:                          "ERROR_DEV_NOT_EXIST",
+	ERROR_TOO_MANY_CMDS:                          "ERROR_TOO_MANY_CMDS",
+	ERROR_ADAP_HDW_ERR:                           "ERROR_ADAP_HDW_ERR",
+	ERROR_BAD_NET_RESP:                           "ERROR_BAD_NET_RESP",
+	ERROR_UNEXP_NET_ERR:                          "ERROR_UNEXP_NET_ERR",
+	ERROR_BAD_REM_ADAP:                           "ERROR_BAD_REM_ADAP",
+	ERROR_PRINTQ_FULL:                            "ERROR_PRINTQ_FULL",
+	ERROR_NO_SPOOL_SPACE:                         "ERROR_NO_SPOOL_SPACE",
+	ERROR_PRINT_CANCELLED:                        "ERROR_PRINT_CANCELLED",
+	ERROR_NETNAME_DELETED:                        "ERROR_NETNAME_DELETED",
+	ERROR_NETWORK_ACCESS_DENIED:                  "ERROR_NETWORK_ACCESS_DENIED",
+	ERROR_BAD_DEV_TYPE:                           "ERROR_BAD_DEV_TYPE",
+	ERROR_BAD_NET_NAME:                           "ERROR_BAD_NET_NAME",
+	ERROR_TOO_MANY_NAMES:                         "ERROR_TOO_MANY_NAMES",
+	ERROR_TOO_MANY_SESS:                          "ERROR_TOO_MANY_SESS",
+	ERROR_SHARING_PAUSED:                         "ERROR_SHARING_PAUSED",
+	ERROR_REQ_NOT_ACCEP:                          "ERROR_REQ_NOT_ACCEP",
+	ERROR_REDIR_PAUSED:                           "ERROR_REDIR_PAUSED",
+	ERROR_FILE_EXISTS:                            "ERROR_FILE_EXISTS",
+	ERROR_CANNOT_MAKE:                            "ERROR_CANNOT_MAKE",
+	ERROR_FAIL_I24:                               "ERROR_FAIL_I24",
+	ERROR_OUT_OF_STRUCTURES:                      "ERROR_OUT_OF_STRUCTURES",
+	ERROR_ALREADY_ASSIGNED:                       "ERROR_ALREADY_ASSIGNED",
+	ERROR_INVALID_PASSWORD:                       "ERROR_INVALID_PASSWORD",
+	ERROR_INVALID_PARAMETER:                      "ERROR_INVALID_PARAMETER",
+	ERROR_NET_WRITE_FAULT:                        "ERROR_NET_WRITE_FAULT",
+	ERROR_NO_PROC_SLOTS:                          "ERROR_NO_PROC_SLOTS",
+	ERROR_TOO_MANY_SEMAPHORES:                    "ERROR_TOO_MANY_SEMAPHORES",
+	ERROR_EXCL_SEM_ALREADY_OWNED:                 "ERROR_EXCL_SEM_ALREADY_OWNED",
+	ERROR_SEM_IS_SET:                             "ERROR_SEM_IS_SET",
+	ERROR_TOO_MANY_SEM_REQUESTS:                  "ERROR_TOO_MANY_SEM_REQUESTS",
+	ERROR_INVALID_AT_INTERRUPT_TIME:              "ERROR_INVALID_AT_INTERRUPT_TIME",
+	ERROR_SEM_OWNER_DIED:                         "ERROR_SEM_OWNER_DIED",
+	ERROR_SEM_USER_LIMIT:                         "ERROR_SEM_USER_LIMIT",
+	ERROR_DISK_CHANGE:                            "ERROR_DISK_CHANGE",
+	ERROR_DRIVE_LOCKED:                           "ERROR_DRIVE_LOCKED",
+	ERROR_BROKEN_PIPE:                            "ERROR_BROKEN_PIPE",
+	ERROR_OPEN_FAILED:                            "ERROR_OPEN_FAILED",
+	ERROR_BUFFER_OVERFLOW:                        "ERROR_BUFFER_OVERFLOW",
+	ERROR_DISK_FULL:                              "ERROR_DISK_FULL",
+	ERROR_NO_MORE_SEARCH_HANDLES:                 "ERROR_NO_MORE_SEARCH_HANDLES",
+	ERROR_INVALID_TARGET_HANDLE:                  "ERROR_INVALID_TARGET_HANDLE",
+	ERROR_INVALID_CATEGORY:                       "ERROR_INVALID_CATEGORY",
+	ERROR_INVALID_VERIFY_SWITCH:                  "ERROR_INVALID_VERIFY_SWITCH",
+	ERROR_BAD_DRIVER_LEVEL:                       "ERROR_BAD_DRIVER_LEVEL",
+	ERROR_CALL_NOT_IMPLEMENTED:                   "ERROR_CALL_NOT_IMPLEMENTED",
+	ERROR_SEM_TIMEOUT:                            "ERROR_SEM_TIMEOUT",
+	ERROR_INSUFFICIENT_BUFFER:                    "ERROR_INSUFFICIENT_BUFFER",
+	ERROR_INVALID_NAME:                           "ERROR_INVALID_NAME",
+	ERROR_INVALID_LEVEL:                          "ERROR_INVALID_LEVEL",
+	ERROR_NO_VOLUME_LABEL:                        "ERROR_NO_VOLUME_LABEL",
+	ERROR_MOD_NOT_FOUND:                          "ERROR_MOD_NOT_FOUND",
+	ERROR_PROC_NOT_FOUND:                         "ERROR_PROC_NOT_FOUND",
+	ERROR_WAIT_NO_CHILDREN:                       "ERROR_WAIT_NO_CHILDREN",
+	ERROR_CHILD_NOT_COMPLETE:                     "ERROR_CHILD_NOT_COMPLETE",
+	ERROR_DIRECT_ACCESS_HANDLE:                   "ERROR_DIRECT_ACCESS_HANDLE",
+	ERROR_NEGATIVE_SEEK:                          "ERROR_NEGATIVE_SEEK",
+	ERROR_SEEK_ON_DEVICE:                         "ERROR_SEEK_ON_DEVICE",
+	ERROR_IS_JOIN_TARGET:                         "ERROR_IS_JOIN_TARGET",
+	ERROR_IS_JOINED:                              "ERROR_IS_JOINED",
+	ERROR_IS_SUBSTED:                             "ERROR_IS_SUBSTED",
+	ERROR_NOT_JOINED:                             "ERROR_NOT_JOINED",
+	ERROR_NOT_SUBSTED:                            "ERROR_NOT_SUBSTED",
+	ERROR_JOIN_TO_JOIN:                           "ERROR_JOIN_TO_JOIN",
+	ERROR_SUBST_TO_SUBST:                         "ERROR_SUBST_TO_SUBST",
+	ERROR_JOIN_TO_SUBST:                          "ERROR_JOIN_TO_SUBST",
+	ERROR_SUBST_TO_JOIN:                          "ERROR_SUBST_TO_JOIN",
+	ERROR_BUSY_DRIVE:                             "ERROR_BUSY_DRIVE",
+	ERROR_SAME_DRIVE:                             "ERROR_SAME_DRIVE",
+	ERROR_DIR_NOT_ROOT:                           "ERROR_DIR_NOT_ROOT",
+	ERROR_DIR_NOT_EMPTY:                          "ERROR_DIR_NOT_EMPTY",
+	ERROR_IS_SUBST_PATH:                          "ERROR_IS_SUBST_PATH",
+	ERROR_IS_JOIN_PATH:                           "ERROR_IS_JOIN_PATH",
+	ERROR_PATH_BUSY:                              "ERROR_PATH_BUSY",
+	ERROR_IS_SUBST_TARGET:                        "ERROR_IS_SUBST_TARGET",
+	ERROR_SYSTEM_TRACE:                           "ERROR_SYSTEM_TRACE",
+	ERROR_INVALID_EVENT_COUNT:                    "ERROR_INVALID_EVENT_COUNT",
+	ERROR_TOO_MANY_MUXWAITERS:                    "ERROR_TOO_MANY_MUXWAITERS",
+	ERROR_INVALID_LIST_FORMAT:                    "ERROR_INVALID_LIST_FORMAT",
+	ERROR_LABEL_TOO_LONG:                         "ERROR_LABEL_TOO_LONG",
+	ERROR_TOO_MANY_TCBS:                          "ERROR_TOO_MANY_TCBS",
+	ERROR_SIGNAL_REFUSED:                         "ERROR_SIGNAL_REFUSED",
+	ERROR_DISCARDED:                              "ERROR_DISCARDED",
+	ERROR_NOT_LOCKED:                             "ERROR_NOT_LOCKED",
+	ERROR_BAD_THREADID_ADDR:                      "ERROR_BAD_THREADID_ADDR",
+	ERROR_BAD_ARGUMENTS:                          "ERROR_BAD_ARGUMENTS",
+	ERROR_BAD_PATHNAME:                           "ERROR_BAD_PATHNAME",
+	ERROR_SIGNAL_PENDING:                         "ERROR_SIGNAL_PENDING",
+	ERROR_MAX_THRDS_REACHED:                      "ERROR_MAX_THRDS_REACHED",
+	ERROR_LOCK_FAILED:                            "ERROR_LOCK_FAILED",
+	ERROR_BUSY:                                   "ERROR_BUSY",
+	ERROR_CANCEL_VIOLATION:                       "ERROR_CANCEL_VIOLATION",
+	ERROR_ATOMIC_LOCKS_NOT_SUPPORTED:             "ERROR_ATOMIC_LOCKS_NOT_SUPPORTED",
+	ERROR_INVALID_SEGMENT_NUMBER:                 "ERROR_INVALID_SEGMENT_NUMBER",
+	ERROR_INVALID_ORDINAL:                        "ERROR_INVALID_ORDINAL",
+	ERROR_ALREADY_EXISTS:                         "ERROR_ALREADY_EXISTS",
+	ERROR_INVALID_FLAG_NUMBER:                    "ERROR_INVALID_FLAG_NUMBER",
+	ERROR_SEM_NOT_FOUND:                          "ERROR_SEM_NOT_FOUND",
+	ERROR_INVALID_STARTING_CODESEG:               "ERROR_INVALID_STARTING_CODESEG",
+	ERROR_INVALID_STACKSEG:                       "ERROR_INVALID_STACKSEG",
+	ERROR_INVALID_MODULETYPE:                     "ERROR_INVALID_MODULETYPE",
+	ERROR_INVALID_EXE_SIGNATURE:                  "ERROR_INVALID_EXE_SIGNATURE",
+	ERROR_EXE_MARKED_INVALID:                     "ERROR_EXE_MARKED_INVALID",
+	ERROR_BAD_EXE_FORMAT:                         "ERROR_BAD_EXE_FORMAT",
+	ERROR_ITERATED_DATA_EXCEEDS_64k:              "ERROR_ITERATED_DATA_EXCEEDS_64k",
+	ERROR_INVALID_MINALLOCSIZE:                   "ERROR_INVALID_MINALLOCSIZE",
+	ERROR_DYNLINK_FROM_INVALID_RING:              "ERROR_DYNLINK_FROM_INVALID_RING",
+	ERROR_IOPL_NOT_ENABLED:                       "ERROR_IOPL_NOT_ENABLED",
+	ERROR_INVALID_SEGDPL:                         "ERROR_INVALID_SEGDPL",
+	ERROR_AUTODATASEG_EXCEEDS_64k:                "ERROR_AUTODATASEG_EXCEEDS_64k",
+	ERROR_RING2SEG_MUST_BE_MOVABLE:               "ERROR_RING2SEG_MUST_BE_MOVABLE",
+	ERROR_RELOC_CHAIN_XEEDS_SEGLIM:               "ERROR_RELOC_CHAIN_XEEDS_SEGLIM",
+	ERROR_INFLOOP_IN_RELOC_CHAIN:                 "ERROR_INFLOOP_IN_RELOC_CHAIN",
+	ERROR_ENVVAR_NOT_FOUND:                       "ERROR_ENVVAR_NOT_FOUND",
+	ERROR_NO_SIGNAL_SENT:                         "ERROR_NO_SIGNAL_SENT",
+	ERROR_FILENAME_EXCED_RANGE:                   "ERROR_FILENAME_EXCED_RANGE",
+	ERROR_RING2_STACK_IN_USE:                     "ERROR_RING2_STACK_IN_USE",
+	ERROR_META_EXPANSION_TOO_LONG:                "ERROR_META_EXPANSION_TOO_LONG",
+	ERROR_INVALID_SIGNAL_NUMBER:                  "ERROR_INVALID_SIGNAL_NUMBER",
+	ERROR_THREAD_1_INACTIVE:                      "ERROR_THREAD_1_INACTIVE",
+	ERROR_LOCKED:                                 "ERROR_LOCKED",
+	ERROR_TOO_MANY_MODULES:                       "ERROR_TOO_MANY_MODULES",
+	ERROR_NESTING_NOT_ALLOWED:                    "ERROR_NESTING_NOT_ALLOWED",
+	ERROR_EXE_MACHINE_TYPE_MISMATCH:              "ERROR_EXE_MACHINE_TYPE_MISMATCH",
+	ERROR_BAD_PIPE:                               "ERROR_BAD_PIPE",
+	ERROR_PIPE_BUSY:                              "ERROR_PIPE_BUSY",
+	ERROR_NO_DATA:                                "ERROR_NO_DATA",
+	ERROR_PIPE_NOT_CONNECTED:                     "ERROR_PIPE_NOT_CONNECTED",
+	ERROR_MORE_DATA:                              "ERROR_MORE_DATA",
+	ERROR_VC_DISCONNECTED:                        "ERROR_VC_DISCONNECTED",
+	ERROR_INVALID_EA_NAME:                        "ERROR_INVALID_EA_NAME",
+	ERROR_EA_LIST_INCONSISTENT:                   "ERROR_EA_LIST_INCONSISTENT",
+	ERROR_NO_MORE_ITEMS:                          "ERROR_NO_MORE_ITEMS",
+	ERROR_CANNOT_COPY:                            "ERROR_CANNOT_COPY",
+	ERROR_DIRECTORY:                              "ERROR_DIRECTORY",
+	ERROR_EAS_DIDNT_FIT:                          "ERROR_EAS_DIDNT_FIT",
+	ERROR_EA_FILE_CORRUPT:                        "ERROR_EA_FILE_CORRUPT",
+	ERROR_EA_TABLE_FULL:                          "ERROR_EA_TABLE_FULL",
+	ERROR_INVALID_EA_HANDLE:                      "ERROR_INVALID_EA_HANDLE",
+	ERROR_EAS_NOT_SUPPORTED:                      "ERROR_EAS_NOT_SUPPORTED",
+	ERROR_NOT_OWNER:                              "ERROR_NOT_OWNER",
+	ERROR_TOO_MANY_POSTS:                         "ERROR_TOO_MANY_POSTS",
+	ERROR_PARTIAL_COPY:                           "ERROR_PARTIAL_COPY",
+	ERROR_OPLOCK_NOT_GRANTED:                     "ERROR_OPLOCK_NOT_GRANTED",
+	ERROR_INVALID_OPLOCK_PROTOCOL:                "ERROR_INVALID_OPLOCK_PROTOCOL",
+	ERROR_MR_MID_NOT_FOUND:                       "ERROR_MR_MID_NOT_FOUND",
+	ERROR_INVALID_ADDRESS:                        "ERROR_INVALID_ADDRESS",
+	ERROR_ARITHMETIC_OVERFLOW:                    "ERROR_ARITHMETIC_OVERFLOW",
+	ERROR_PIPE_CONNECTED:                         "ERROR_PIPE_CONNECTED",
+	ERROR_PIPE_LISTENING:                         "ERROR_PIPE_LISTENING",
+	ERROR_INVALID_IMAGE_HASH:                     "ERROR_INVALID_IMAGE_HASH",
+	ERROR_ELEVATION_REQUIRED:                     "ERROR_ELEVATION_REQUIRED",
+	ERROR_EA_ACCESS_DENIED:                       "ERROR_EA_ACCESS_DENIED",
+	ERROR_OPERATION_ABORTED:                      "ERROR_OPERATION_ABORTED",
+	ERROR_IO_INCOMPLETE:                          "ERROR_IO_INCOMPLETE",
+	ERROR_IO_PENDING:                             "ERROR_IO_PENDING",
+	ERROR_NOACCESS:                               "ERROR_NOACCESS",
+	ERROR_SWAPERROR:                              "ERROR_SWAPERROR",
+	ERROR_STACK_OVERFLOW:                         "ERROR_STACK_OVERFLOW",
+	ERROR_INVALID_MESSAGE:                        "ERROR_INVALID_MESSAGE",
+	ERROR_CAN_NOT_COMPLETE:                       "ERROR_CAN_NOT_COMPLETE",
+	ERROR_INVALID_FLAGS:                          "ERROR_INVALID_FLAGS",
+	ERROR_UNRECOGNIZED_VOLUME:                    "ERROR_UNRECOGNIZED_VOLUME",
+	ERROR_FILE_INVALID:                           "ERROR_FILE_INVALID",
+	ERROR_FULLSCREEN_MODE:                        "ERROR_FULLSCREEN_MODE",
+	ERROR_NO_TOKEN:                               "ERROR_NO_TOKEN",
+	ERROR_BADDB:                                  "ERROR_BADDB",
+	ERROR_BADKEY:                                 "ERROR_BADKEY",
+	ERROR_CANTOPEN:                               "ERROR_CANTOPEN",
+	ERROR_CANTREAD:                               "ERROR_CANTREAD",
+	ERROR_CANTWRITE:                              "ERROR_CANTWRITE",
+	ERROR_REGISTRY_RECOVERED:                     "ERROR_REGISTRY_RECOVERED",
+	ERROR_REGISTRY_CORRUPT:                       "ERROR_REGISTRY_CORRUPT",
+	ERROR_REGISTRY_IO_FAILED:                     "ERROR_REGISTRY_IO_FAILED",
+	ERROR_NOT_REGISTRY_FILE:                      "ERROR_NOT_REGISTRY_FILE",
+	ERROR_KEY_DELETED:                            "ERROR_KEY_DELETED",
+	ERROR_NO_LOG_SPACE:                           "ERROR_NO_LOG_SPACE",
+	ERROR_KEY_HAS_CHILDREN:                       "ERROR_KEY_HAS_CHILDREN",
+	ERROR_CHILD_MUST_BE_VOLATILE:                 "ERROR_CHILD_MUST_BE_VOLATILE",
+	ERROR_NOTIFY_ENUM_DIR:                        "ERROR_NOTIFY_ENUM_DIR",
+	ERROR_DEPENDENT_SERVICES_RUNNING:             "ERROR_DEPENDENT_SERVICES_RUNNING",
+	ERROR_INVALID_SERVICE_CONTROL:                "ERROR_INVALID_SERVICE_CONTROL",
+	ERROR_SERVICE_REQUEST_TIMEOUT:                "ERROR_SERVICE_REQUEST_TIMEOUT",
+	ERROR_SERVICE_NO_THREAD:                      "ERROR_SERVICE_NO_THREAD",
+	ERROR_SERVICE_DATABASE_LOCKED:                "ERROR_SERVICE_DATABASE_LOCKED",
+	ERROR_SERVICE_ALREADY_RUNNING:                "ERROR_SERVICE_ALREADY_RUNNING",
+	ERROR_INVALID_SERVICE_ACCOUNT:                "ERROR_INVALID_SERVICE_ACCOUNT",
+	ERROR_SERVICE_DISABLED:                       "ERROR_SERVICE_DISABLED",
+	ERROR_CIRCULAR_DEPENDENCY:                    "ERROR_CIRCULAR_DEPENDENCY",
+	ERROR_SERVICE_DOES_NOT_EXIST:                 "ERROR_SERVICE_DOES_NOT_EXIST",
+	ERROR_SERVICE_CANNOT_ACCEPT_CTRL:             "ERROR_SERVICE_CANNOT_ACCEPT_CTRL",
+	ERROR_SERVICE_NOT_ACTIVE:                     "ERROR_SERVICE_NOT_ACTIVE",
+	ERROR_FAILED_SERVICE_CONTROLLER_CONNECT:      "ERROR_FAILED_SERVICE_CONTROLLER_CONNECT",
+	ERROR_EXCEPTION_IN_SERVICE:                   "ERROR_EXCEPTION_IN_SERVICE",
+	ERROR_DATABASE_DOES_NOT_EXIST:                "ERROR_DATABASE_DOES_NOT_EXIST",
+	ERROR_SERVICE_SPECIFIC_ERROR:                 "ERROR_SERVICE_SPECIFIC_ERROR",
+	ERROR_PROCESS_ABORTED:                        "ERROR_PROCESS_ABORTED",
+	ERROR_SERVICE_DEPENDENCY_FAIL:                "ERROR_SERVICE_DEPENDENCY_FAIL",
+	ERROR_SERVICE_LOGON_FAILED:                   "ERROR_SERVICE_LOGON_FAILED",
+	ERROR_SERVICE_START_HANG:                     "ERROR_SERVICE_START_HANG",
+	ERROR_INVALID_SERVICE_LOCK:                   "ERROR_INVALID_SERVICE_LOCK",
+	ERROR_SERVICE_MARKED_FOR_DELETE:              "ERROR_SERVICE_MARKED_FOR_DELETE",
+	ERROR_SERVICE_EXISTS:                         "ERROR_SERVICE_EXISTS",
+	ERROR_ALREADY_RUNNING_LKG:                    "ERROR_ALREADY_RUNNING_LKG",
+	ERROR_SERVICE_DEPENDENCY_DELETED:             "ERROR_SERVICE_DEPENDENCY_DELETED",
+	ERROR_BOOT_ALREADY_ACCEPTED:                  "ERROR_BOOT_ALREADY_ACCEPTED",
+	ERROR_SERVICE_NEVER_STARTED:                  "ERROR_SERVICE_NEVER_STARTED",
+	ERROR_DUPLICATE_SERVICE_NAME:                 "ERROR_DUPLICATE_SERVICE_NAME",
+	ERROR_DIFFERENT_SERVICE_ACCOUNT:              "ERROR_DIFFERENT_SERVICE_ACCOUNT",
+	ERROR_CANNOT_DETECT_DRIVER_FAILURE:           "ERROR_CANNOT_DETECT_DRIVER_FAILURE",
+	ERROR_CANNOT_DETECT_PROCESS_ABORT:            "ERROR_CANNOT_DETECT_PROCESS_ABORT",
+	ERROR_NO_RECOVERY_PROGRAM:                    "ERROR_NO_RECOVERY_PROGRAM",
+	ERROR_END_OF_MEDIA:                           "ERROR_END_OF_MEDIA",
+	ERROR_FILEMARK_DETECTED:                      "ERROR_FILEMARK_DETECTED",
+	ERROR_BEGINNING_OF_MEDIA:                     "ERROR_BEGINNING_OF_MEDIA",
+	ERROR_SETMARK_DETECTED:                       "ERROR_SETMARK_DETECTED",
+	ERROR_NO_DATA_DETECTED:                       "ERROR_NO_DATA_DETECTED",
+	ERROR_PARTITION_FAILURE:                      "ERROR_PARTITION_FAILURE",
+	ERROR_INVALID_BLOCK_LENGTH:                   "ERROR_INVALID_BLOCK_LENGTH",
+	ERROR_DEVICE_NOT_PARTITIONED:                 "ERROR_DEVICE_NOT_PARTITIONED",
+	ERROR_UNABLE_TO_LOCK_MEDIA:                   "ERROR_UNABLE_TO_LOCK_MEDIA",
+	ERROR_UNABLE_TO_UNLOAD_MEDIA:                 "ERROR_UNABLE_TO_UNLOAD_MEDIA",
+	ERROR_MEDIA_CHANGED:                          "ERROR_MEDIA_CHANGED",
+	ERROR_BUS_RESET:                              "ERROR_BUS_RESET",
+	ERROR_NO_MEDIA_IN_DRIVE:                      "ERROR_NO_MEDIA_IN_DRIVE",
+	ERROR_NO_UNICODE_TRANSLATION:                 "ERROR_NO_UNICODE_TRANSLATION",
+	ERROR_DLL_INIT_FAILED:                        "ERROR_DLL_INIT_FAILED",
+	ERROR_SHUTDOWN_IN_PROGRESS:                   "ERROR_SHUTDOWN_IN_PROGRESS",
+	ERROR_NO_SHUTDOWN_IN_PROGRESS:                "ERROR_NO_SHUTDOWN_IN_PROGRESS",
+	ERROR_IO_DEVICE:                              "ERROR_IO_DEVICE",
+	ERROR_SERIAL_NO_DEVICE:                       "ERROR_SERIAL_NO_DEVICE",
+	ERROR_IRQ_BUSY:                               "ERROR_IRQ_BUSY",
+	ERROR_MORE_WRITES:                            "ERROR_MORE_WRITES",
+	ERROR_COUNTER_TIMEOUT:                        "ERROR_COUNTER_TIMEOUT",
+	ERROR_FLOPPY_ID_MARK_NOT_FOUND:               "ERROR_FLOPPY_ID_MARK_NOT_FOUND",
+	ERROR_FLOPPY_WRONG_CYLINDER:                  "ERROR_FLOPPY_WRONG_CYLINDER",
+	ERROR_FLOPPY_UNKNOWN_ERROR:                   "ERROR_FLOPPY_UNKNOWN_ERROR",
+	ERROR_FLOPPY_BAD_REGISTERS:                   "ERROR_FLOPPY_BAD_REGISTERS",
+	ERROR_DISK_RECALIBRATE_FAILED:                "ERROR_DISK_RECALIBRATE_FAILED",
+	ERROR_DISK_OPERATION_FAILED:                  "ERROR_DISK_OPERATION_FAILED",
+	ERROR_DISK_RESET_FAILED:                      "ERROR_DISK_RESET_FAILED",
+	ERROR_EOM_OVERFLOW:                           "ERROR_EOM_OVERFLOW",
+	ERROR_NOT_ENOUGH_SERVER_MEMORY:               "ERROR_NOT_ENOUGH_SERVER_MEMORY",
+	ERROR_POSSIBLE_DEADLOCK:                      "ERROR_POSSIBLE_DEADLOCK",
+	ERROR_MAPPED_ALIGNMENT:                       "ERROR_MAPPED_ALIGNMENT",
+	ERROR_SET_POWER_STATE_VETOED:                 "ERROR_SET_POWER_STATE_VETOED",
+	ERROR_SET_POWER_STATE_FAILED:                 "ERROR_SET_POWER_STATE_FAILED",
+	ERROR_TOO_MANY_LINKS:                         "ERROR_TOO_MANY_LINKS",
+	ERROR_OLD_WIN_VERSION:                        "ERROR_OLD_WIN_VERSION",
+	ERROR_APP_WRONG_OS:                           "ERROR_APP_WRONG_OS",
+	ERROR_SINGLE_INSTANCE_APP:                    "ERROR_SINGLE_INSTANCE_APP",
+	ERROR_RMODE_APP:                              "ERROR_RMODE_APP",
+	ERROR_INVALID_DLL:                            "ERROR_INVALID_DLL",
+	ERROR_NO_ASSOCIATION:                         "ERROR_NO_ASSOCIATION",
+	ERROR_DDE_FAIL:                               "ERROR_DDE_FAIL",
+	ERROR_DLL_NOT_FOUND:                          "ERROR_DLL_NOT_FOUND",
+	ERROR_NO_MORE_USER_HANDLES:                   "ERROR_NO_MORE_USER_HANDLES",
+	ERROR_MESSAGE_SYNC_ONLY:                      "ERROR_MESSAGE_SYNC_ONLY",
+	ERROR_SOURCE_ELEMENT_EMPTY:                   "ERROR_SOURCE_ELEMENT_EMPTY",
+	ERROR_DESTINATION_ELEMENT_FULL:               "ERROR_DESTINATION_ELEMENT_FULL",
+	ERROR_ILLEGAL_ELEMENT_ADDRESS:                "ERROR_ILLEGAL_ELEMENT_ADDRESS",
+	ERROR_MAGAZINE_NOT_PRESENT:                   "ERROR_MAGAZINE_NOT_PRESENT",
+	ERROR_DEVICE_REINITIALIZATION_NEEDED:         "ERROR_DEVICE_REINITIALIZATION_NEEDED",
+	ERROR_DEVICE_REQUIRES_CLEANING:               "ERROR_DEVICE_REQUIRES_CLEANING",
+	ERROR_DEVICE_DOOR_OPEN:                       "ERROR_DEVICE_DOOR_OPEN",
+	ERROR_DEVICE_NOT_CONNECTED:                   "ERROR_DEVICE_NOT_CONNECTED",
+	ERROR_NOT_FOUND:                              "ERROR_NOT_FOUND",
+	ERROR_NO_MATCH:                               "ERROR_NO_MATCH",
+	ERROR_SET_NOT_FOUND:                          "ERROR_SET_NOT_FOUND",
+	ERROR_POINT_NOT_FOUND:                        "ERROR_POINT_NOT_FOUND",
+	ERROR_NO_TRACKING_SERVICE:                    "ERROR_NO_TRACKING_SERVICE",
+	ERROR_NO_VOLUME_ID:                           "ERROR_NO_VOLUME_ID",
+	ERROR_CONNECTED_OTHER_PASSWORD:               "ERROR_CONNECTED_OTHER_PASSWORD",
+	ERROR_BAD_USERNAME:                           "ERROR_BAD_USERNAME",
+	ERROR_NOT_CONNECTED:                          "ERROR_NOT_CONNECTED",
+	ERROR_OPEN_FILES:                             "ERROR_OPEN_FILES",
+	ERROR_ACTIVE_CONNECTIONS:                     "ERROR_ACTIVE_CONNECTIONS",
+	ERROR_DEVICE_IN_USE:                          "ERROR_DEVICE_IN_USE",
+	ERROR_BAD_DEVICE:                             "ERROR_BAD_DEVICE",
+	ERROR_CONNECTION_UNAVAIL:                     "ERROR_CONNECTION_UNAVAIL",
+	ERROR_DEVICE_ALREADY_REMEMBERED:              "ERROR_DEVICE_ALREADY_REMEMBERED",
+	ERROR_NO_NET_OR_BAD_PATH:                     "ERROR_NO_NET_OR_BAD_PATH",
+	ERROR_BAD_PROVIDER:                           "ERROR_BAD_PROVIDER",
+	ERROR_CANNOT_OPEN_PROFILE:                    "ERROR_CANNOT_OPEN_PROFILE",
+	ERROR_BAD_PROFILE:                            "ERROR_BAD_PROFILE",
+	ERROR_NOT_CONTAINER:                          "ERROR_NOT_CONTAINER",
+	ERROR_EXTENDED_ERROR:                         "ERROR_EXTENDED_ERROR",
+	ERROR_INVALID_GROUPNAME:                      "ERROR_INVALID_GROUPNAME",
+	ERROR_INVALID_COMPUTERNAME:                   "ERROR_INVALID_COMPUTERNAME",
+	ERROR_INVALID_EVENTNAME:                      "ERROR_INVALID_EVENTNAME",
+	ERROR_INVALID_DOMAINNAME:                     "ERROR_INVALID_DOMAINNAME",
+	ERROR_INVALID_SERVICENAME:                    "ERROR_INVALID_SERVICENAME",
+	ERROR_INVALID_NETNAME:                        "ERROR_INVALID_NETNAME",
+	ERROR_INVALID_SHARENAME:                      "ERROR_INVALID_SHARENAME",
+	ERROR_INVALID_PASSWORDNAME:                   "ERROR_INVALID_PASSWORDNAME",
+	ERROR_INVALID_MESSAGENAME:                    "ERROR_INVALID_MESSAGENAME",
+	ERROR_INVALID_MESSAGEDEST:                    "ERROR_INVALID_MESSAGEDEST",
+	ERROR_SESSION_CREDENTIAL_CONFLICT:            "ERROR_SESSION_CREDENTIAL_CONFLICT",
+	ERROR_REMOTE_SESSION_LIMIT_EXCEEDED:          "ERROR_REMOTE_SESSION_LIMIT_EXCEEDED",
+	ERROR_DUP_DOMAINNAME:                         "ERROR_DUP_DOMAINNAME",
+	ERROR_NO_NETWORK:                             "ERROR_NO_NETWORK",
+	ERROR_CANCELLED:                              "ERROR_CANCELLED",
+	ERROR_USER_MAPPED_FILE:                       "ERROR_USER_MAPPED_FILE",
+	ERROR_CONNECTION_REFUSED:                     "ERROR_CONNECTION_REFUSED",
+	ERROR_GRACEFUL_DISCONNECT:                    "ERROR_GRACEFUL_DISCONNECT",
+	ERROR_ADDRESS_ALREADY_ASSOCIATED:             "ERROR_ADDRESS_ALREADY_ASSOCIATED",
+	ERROR_ADDRESS_NOT_ASSOCIATED:                 "ERROR_ADDRESS_NOT_ASSOCIATED",
+	ERROR_CONNECTION_INVALID:                     "ERROR_CONNECTION_INVALID",
+	ERROR_CONNECTION_ACTIVE:                      "ERROR_CONNECTION_ACTIVE",
+	ERROR_NETWORK_UNREACHABLE:                    "ERROR_NETWORK_UNREACHABLE",
+	ERROR_HOST_UNREACHABLE:                       "ERROR_HOST_UNREACHABLE",
+	ERROR_PROTOCOL_UNREACHABLE:                   "ERROR_PROTOCOL_UNREACHABLE",
+	ERROR_PORT_UNREACHABLE:                       "ERROR_PORT_UNREACHABLE",
+	ERROR_REQUEST_ABORTED:                        "ERROR_REQUEST_ABORTED",
+	ERROR_CONNECTION_ABORTED:                     "ERROR_CONNECTION_ABORTED",
+	ERROR_RETRY:                                  "ERROR_RETRY",
+	ERROR_CONNECTION_COUNT_LIMIT:                 "ERROR_CONNECTION_COUNT_LIMIT",
+	ERROR_LOGIN_TIME_RESTRICTION:                 "ERROR_LOGIN_TIME_RESTRICTION",
+	ERROR_LOGIN_WKSTA_RESTRICTION:                "ERROR_LOGIN_WKSTA_RESTRICTION",
+	ERROR_INCORRECT_ADDRESS:                      "ERROR_INCORRECT_ADDRESS",
+	ERROR_ALREADY_REGISTERED:                     "ERROR_ALREADY_REGISTERED",
+	ERROR_SERVICE_NOT_FOUND:                      "ERROR_SERVICE_NOT_FOUND",
+	ERROR_NOT_AUTHENTICATED:                      "ERROR_NOT_AUTHENTICATED",
+	ERROR_NOT_LOGGED_ON:                          "ERROR_NOT_LOGGED_ON",
+	ERROR_CONTINUE:                               "ERROR_CONTINUE",
+	ERROR_ALREADY_INITIALIZED:                    "ERROR_ALREADY_INITIALIZED",
+	ERROR_NO_MORE_DEVICES:                        "ERROR_NO_MORE_DEVICES",
+	ERROR_NO_SUCH_SITE:                           "ERROR_NO_SUCH_SITE",
+	ERROR_DOMAIN_CONTROLLER_EXISTS:               "ERROR_DOMAIN_CONTROLLER_EXISTS",
+	ERROR_DS_NOT_INSTALLED:                       "ERROR_DS_NOT_INSTALLED",
+	ERROR_NOT_ALL_ASSIGNED:                       "ERROR_NOT_ALL_ASSIGNED",
+	ERROR_SOME_NOT_MAPPED:                        "ERROR_SOME_NOT_MAPPED",
+	ERROR_NO_QUOTAS_FOR_ACCOUNT:                  "ERROR_NO_QUOTAS_FOR_ACCOUNT",
+	ERROR_LOCAL_USER_SESSION_KEY:                 "ERROR_LOCAL_USER_SESSION_KEY",
+	ERROR_NULL_LM_PASSWORD:                       "ERROR_NULL_LM_PASSWORD",
+	ERROR_UNKNOWN_REVISION:                       "ERROR_UNKNOWN_REVISION",
+	ERROR_REVISION_MISMATCH:                      "ERROR_REVISION_MISMATCH",
+	ERROR_INVALID_OWNER:                          "ERROR_INVALID_OWNER",
+	ERROR_INVALID_PRIMARY_GROUP:                  "ERROR_INVALID_PRIMARY_GROUP",
+	ERROR_NO_IMPERSONATION_TOKEN:                 "ERROR_NO_IMPERSONATION_TOKEN",
+	ERROR_CANT_DISABLE_MANDATORY:                 "ERROR_CANT_DISABLE_MANDATORY",
+	ERROR_NO_LOGON_SERVERS:                       "ERROR_NO_LOGON_SERVERS",
+	ERROR_NO_SUCH_LOGON_SESSION:                  "ERROR_NO_SUCH_LOGON_SESSION",
+	ERROR_NO_SUCH_PRIVILEGE:                      "ERROR_NO_SUCH_PRIVILEGE",
+	ERROR_PRIVILEGE_NOT_HELD:                     "ERROR_PRIVILEGE_NOT_HELD",
+	ERROR_INVALID_ACCOUNT_NAME:                   "ERROR_INVALID_ACCOUNT_NAME",
+	ERROR_USER_EXISTS:                            "ERROR_USER_EXISTS",
+	ERROR_NO_SUCH_USER:                           "ERROR_NO_SUCH_USER",
+	ERROR_GROUP_EXISTS:                           "ERROR_GROUP_EXISTS",
+	ERROR_NO_SUCH_GROUP:                          "ERROR_NO_SUCH_GROUP",
+	ERROR_MEMBER_IN_GROUP:                        "ERROR_MEMBER_IN_GROUP",
+	ERROR_MEMBER_NOT_IN_GROUP:                    "ERROR_MEMBER_NOT_IN_GROUP",
+	ERROR_LAST_ADMIN:                             "ERROR_LAST_ADMIN",
+	ERROR_WRONG_PASSWORD:                         "ERROR_WRONG_PASSWORD",
+	ERROR_ILL_FORMED_PASSWORD:                    "ERROR_ILL_FORMED_PASSWORD",
+	ERROR_PASSWORD_RESTRICTION:                   "ERROR_PASSWORD_RESTRICTION",
+	ERROR_LOGON_FAILURE:                          "ERROR_LOGON_FAILURE",
+	ERROR_ACCOUNT_RESTRICTION:                    "ERROR_ACCOUNT_RESTRICTION",
+	ERROR_INVALID_LOGON_HOURS:                    "ERROR_INVALID_LOGON_HOURS",
+	ERROR_INVALID_WORKSTATION:                    "ERROR_INVALID_WORKSTATION",
+	ERROR_PASSWORD_EXPIRED:                       "ERROR_PASSWORD_EXPIRED",
+	ERROR_ACCOUNT_DISABLED:                       "ERROR_ACCOUNT_DISABLED",
+	ERROR_NONE_MAPPED:                            "ERROR_NONE_MAPPED",
+	ERROR_TOO_MANY_LUIDS_REQUESTED:               "ERROR_TOO_MANY_LUIDS_REQUESTED",
+	ERROR_LUIDS_EXHAUSTED:                        "ERROR_LUIDS_EXHAUSTED",
+	ERROR_INVALID_SUB_AUTHORITY:                  "ERROR_INVALID_SUB_AUTHORITY",
+	ERROR_INVALID_ACL:                            "ERROR_INVALID_ACL",
+	ERROR_INVALID_SID:                            "ERROR_INVALID_SID",
+	ERROR_INVALID_SECURITY_DESCR:                 "ERROR_INVALID_SECURITY_DESCR",
+	ERROR_BAD_INHERITANCE_ACL:                    "ERROR_BAD_INHERITANCE_ACL",
+	ERROR_SERVER_DISABLED:                        "ERROR_SERVER_DISABLED",
+	ERROR_SERVER_NOT_DISABLED:                    "ERROR_SERVER_NOT_DISABLED",
+	ERROR_INVALID_ID_AUTHORITY:                   "ERROR_INVALID_ID_AUTHORITY",
+	ERROR_ALLOTTED_SPACE_EXCEEDED:                "ERROR_ALLOTTED_SPACE_EXCEEDED",
+	ERROR_INVALID_GROUP_ATTRIBUTES:               "ERROR_INVALID_GROUP_ATTRIBUTES",
+	ERROR_BAD_IMPERSONATION_LEVEL:                "ERROR_BAD_IMPERSONATION_LEVEL",
+	ERROR_CANT_OPEN_ANONYMOUS:                    "ERROR_CANT_OPEN_ANONYMOUS",
+	ERROR_BAD_VALIDATION_CLASS:                   "ERROR_BAD_VALIDATION_CLASS",
+	ERROR_BAD_TOKEN_TYPE:                         "ERROR_BAD_TOKEN_TYPE",
+	ERROR_NO_SECURITY_ON_OBJECT:                  "ERROR_NO_SECURITY_ON_OBJECT",
+	ERROR_CANT_ACCESS_DOMAIN_INFO:                "ERROR_CANT_ACCESS_DOMAIN_INFO",
+	ERROR_INVALID_SERVER_STATE:                   "ERROR_INVALID_SERVER_STATE",
+	ERROR_INVALID_DOMAIN_STATE:                   "ERROR_INVALID_DOMAIN_STATE",
+	ERROR_INVALID_DOMAIN_ROLE:                    "ERROR_INVALID_DOMAIN_ROLE",
+	ERROR_NO_SUCH_DOMAIN:                         "ERROR_NO_SUCH_DOMAIN",
+	ERROR_DOMAIN_EXISTS:                          "ERROR_DOMAIN_EXISTS",
+	ERROR_DOMAIN_LIMIT_EXCEEDED:                  "ERROR_DOMAIN_LIMIT_EXCEEDED",
+	ERROR_INTERNAL_DB_CORRUPTION:                 "ERROR_INTERNAL_DB_CORRUPTION",
+	ERROR_INTERNAL_ERROR:                         "ERROR_INTERNAL_ERROR",
+	ERROR_GENERIC_NOT_MAPPED:                     "ERROR_GENERIC_NOT_MAPPED",
+	ERROR_BAD_DESCRIPTOR_FORMAT:                  "ERROR_BAD_DESCRIPTOR_FORMAT",
+	ERROR_NOT_LOGON_PROCESS:                      "ERROR_NOT_LOGON_PROCESS",
+	ERROR_LOGON_SESSION_EXISTS:                   "ERROR_LOGON_SESSION_EXISTS",
+	ERROR_NO_SUCH_PACKAGE:                        "ERROR_NO_SUCH_PACKAGE",
+	ERROR_BAD_LOGON_SESSION_STATE:                "ERROR_BAD_LOGON_SESSION_STATE",
+	ERROR_LOGON_SESSION_COLLISION:                "ERROR_LOGON_SESSION_COLLISION",
+	ERROR_INVALID_LOGON_TYPE:                     "ERROR_INVALID_LOGON_TYPE",
+	ERROR_CANNOT_IMPERSONATE:                     "ERROR_CANNOT_IMPERSONATE",
+	ERROR_RXACT_INVALID_STATE:                    "ERROR_RXACT_INVALID_STATE",
+	ERROR_RXACT_COMMIT_FAILURE:                   "ERROR_RXACT_COMMIT_FAILURE",
+	ERROR_SPECIAL_ACCOUNT:                        "ERROR_SPECIAL_ACCOUNT",
+	ERROR_SPECIAL_GROUP:                          "ERROR_SPECIAL_GROUP",
+	ERROR_SPECIAL_USER:                           "ERROR_SPECIAL_USER",
+	ERROR_MEMBERS_PRIMARY_GROUP:                  "ERROR_MEMBERS_PRIMARY_GROUP",
+	ERROR_TOKEN_ALREADY_IN_USE:                   "ERROR_TOKEN_ALREADY_IN_USE",
+	ERROR_NO_SUCH_ALIAS:                          "ERROR_NO_SUCH_ALIAS",
+	ERROR_MEMBER_NOT_IN_ALIAS:                    "ERROR_MEMBER_NOT_IN_ALIAS",
+	ERROR_MEMBER_IN_ALIAS:                        "ERROR_MEMBER_IN_ALIAS",
+	ERROR_ALIAS_EXISTS:                           "ERROR_ALIAS_EXISTS",
+	ERROR_LOGON_NOT_GRANTED:                      "ERROR_LOGON_NOT_GRANTED",
+	ERROR_TOO_MANY_SECRETS:                       "ERROR_TOO_MANY_SECRETS",
+	ERROR_SECRET_TOO_LONG:                        "ERROR_SECRET_TOO_LONG",
+	ERROR_INTERNAL_DB_ERROR:                      "ERROR_INTERNAL_DB_ERROR",
+	ERROR_TOO_MANY_CONTEXT_IDS:                   "ERROR_TOO_MANY_CONTEXT_IDS",
+	ERROR_LOGON_TYPE_NOT_GRANTED:                 "ERROR_LOGON_TYPE_NOT_GRANTED",
+	ERROR_NT_CROSS_ENCRYPTION_REQUIRED:           "ERROR_NT_CROSS_ENCRYPTION_REQUIRED",
+	ERROR_NO_SUCH_MEMBER:                         "ERROR_NO_SUCH_MEMBER",
+	ERROR_INVALID_MEMBER:                         "ERROR_INVALID_MEMBER",
+	ERROR_TOO_MANY_SIDS:                          "ERROR_TOO_MANY_SIDS",
+	ERROR_LM_CROSS_ENCRYPTION_REQUIRED:           "ERROR_LM_CROSS_ENCRYPTION_REQUIRED",
+	ERROR_NO_INHERITANCE:                         "ERROR_NO_INHERITANCE",
+	ERROR_FILE_CORRUPT:                           "ERROR_FILE_CORRUPT",
+	ERROR_DISK_CORRUPT:                           "ERROR_DISK_CORRUPT",
+	ERROR_NO_USER_SESSION_KEY:                    "ERROR_NO_USER_SESSION_KEY",
+	ERROR_LICENSE_QUOTA_EXCEEDED:                 "ERROR_LICENSE_QUOTA_EXCEEDED",
+	ERROR_INVALID_WINDOW_HANDLE:                  "ERROR_INVALID_WINDOW_HANDLE",
+	ERROR_INVALID_MENU_HANDLE:                    "ERROR_INVALID_MENU_HANDLE",
+	ERROR_INVALID_CURSOR_HANDLE:                  "ERROR_INVALID_CURSOR_HANDLE",
+	ERROR_INVALID_ACCEL_HANDLE:                   "ERROR_INVALID_ACCEL_HANDLE",
+	ERROR_INVALID_HOOK_HANDLE:                    "ERROR_INVALID_HOOK_HANDLE",
+	ERROR_INVALID_DWP_HANDLE:                     "ERROR_INVALID_DWP_HANDLE",
+	ERROR_TLW_WITH_WSCHILD:                       "ERROR_TLW_WITH_WSCHILD",
+	ERROR_CANNOT_FIND_WND_CLASS:                  "ERROR_CANNOT_FIND_WND_CLASS",
+	ERROR_WINDOW_OF_OTHER_THREAD:                 "ERROR_WINDOW_OF_OTHER_THREAD",
+	ERROR_HOTKEY_ALREADY_REGISTERED:              "ERROR_HOTKEY_ALREADY_REGISTERED",
+	ERROR_CLASS_ALREADY_EXISTS:                   "ERROR_CLASS_ALREADY_EXISTS",
+	ERROR_CLASS_DOES_NOT_EXIST:                   "ERROR_CLASS_DOES_NOT_EXIST",
+	ERROR_CLASS_HAS_WINDOWS:                      "ERROR_CLASS_HAS_WINDOWS",
+	ERROR_INVALID_INDEX:                          "ERROR_INVALID_INDEX",
+	ERROR_INVALID_ICON_HANDLE:                    "ERROR_INVALID_ICON_HANDLE",
+	ERROR_PRIVATE_DIALOG_INDEX:                   "ERROR_PRIVATE_DIALOG_INDEX",
+	ERROR_LISTBOX_ID_NOT_FOUND:                   "ERROR_LISTBOX_ID_NOT_FOUND",
+	ERROR_NO_WILDCARD_CHARACTERS:                 "ERROR_NO_WILDCARD_CHARACTERS",
+	ERROR_CLIPBOARD_NOT_OPEN:                     "ERROR_CLIPBOARD_NOT_OPEN",
+	ERROR_HOTKEY_NOT_REGISTERED:                  "ERROR_HOTKEY_NOT_REGISTERED",
+	ERROR_WINDOW_NOT_DIALOG:                      "ERROR_WINDOW_NOT_DIALOG",
+	ERROR_CONTROL_ID_NOT_FOUND:                   "ERROR_CONTROL_ID_NOT_FOUND",
+	ERROR_INVALID_COMBOBOX_MESSAGE:               "ERROR_INVALID_COMBOBOX_MESSAGE",
+	ERROR_WINDOW_NOT_COMBOBOX:                    "ERROR_WINDOW_NOT_COMBOBOX",
+	ERROR_INVALID_EDIT_HEIGHT:                    "ERROR_INVALID_EDIT_HEIGHT",
+	ERROR_DC_NOT_FOUND:                           "ERROR_DC_NOT_FOUND",
+	ERROR_INVALID_HOOK_FILTER:                    "ERROR_INVALID_HOOK_FILTER",
+	ERROR_INVALID_FILTER_PROC:                    "ERROR_INVALID_FILTER_PROC",
+	ERROR_HOOK_NEEDS_HMOD:                        "ERROR_HOOK_NEEDS_HMOD",
+	ERROR_GLOBAL_ONLY_HOOK:                       "ERROR_GLOBAL_ONLY_HOOK",
+	ERROR_JOURNAL_HOOK_SET:                       "ERROR_JOURNAL_HOOK_SET",
+	ERROR_HOOK_NOT_INSTALLED:                     "ERROR_HOOK_NOT_INSTALLED",
+	ERROR_INVALID_LB_MESSAGE:                     "ERROR_INVALID_LB_MESSAGE",
+	ERROR_SETCOUNT_ON_BAD_LB:                     "ERROR_SETCOUNT_ON_BAD_LB",
+	ERROR_LB_WITHOUT_TABSTOPS:                    "ERROR_LB_WITHOUT_TABSTOPS",
+	ERROR_DESTROY_OBJECT_OF_OTHER_THREAD:         "ERROR_DESTROY_OBJECT_OF_OTHER_THREAD",
+	ERROR_CHILD_WINDOW_MENU:                      "ERROR_CHILD_WINDOW_MENU",
+	ERROR_NO_SYSTEM_MENU:                         "ERROR_NO_SYSTEM_MENU",
+	ERROR_INVALID_MSGBOX_STYLE:                   "ERROR_INVALID_MSGBOX_STYLE",
+	ERROR_INVALID_SPI_VALUE:                      "ERROR_INVALID_SPI_VALUE",
+	ERROR_SCREEN_ALREADY_LOCKED:                  "ERROR_SCREEN_ALREADY_LOCKED",
+	ERROR_HWNDS_HAVE_DIFF_PARENT:                 "ERROR_HWNDS_HAVE_DIFF_PARENT",
+	ERROR_NOT_CHILD_WINDOW:                       "ERROR_NOT_CHILD_WINDOW",
+	ERROR_INVALID_GW_COMMAND:                     "ERROR_INVALID_GW_COMMAND",
+	ERROR_INVALID_THREAD_ID:                      "ERROR_INVALID_THREAD_ID",
+	ERROR_NON_MDICHILD_WINDOW:                    "ERROR_NON_MDICHILD_WINDOW",
+	ERROR_POPUP_ALREADY_ACTIVE:                   "ERROR_POPUP_ALREADY_ACTIVE",
+	ERROR_NO_SCROLLBARS:                          "ERROR_NO_SCROLLBARS",
+	ERROR_INVALID_SCROLLBAR_RANGE:                "ERROR_INVALID_SCROLLBAR_RANGE",
+	ERROR_INVALID_SHOWWIN_COMMAND:                "ERROR_INVALID_SHOWWIN_COMMAND",
+	ERROR_NO_SYSTEM_RESOURCES:                    "ERROR_NO_SYSTEM_RESOURCES",
+	ERROR_NONPAGED_SYSTEM_RESOURCES:              "ERROR_NONPAGED_SYSTEM_RESOURCES",
+	ERROR_PAGED_SYSTEM_RESOURCES:                 "ERROR_PAGED_SYSTEM_RESOURCES",
+	ERROR_WORKING_SET_QUOTA:                      "ERROR_WORKING_SET_QUOTA",
+	ERROR_PAGEFILE_QUOTA:                         "ERROR_PAGEFILE_QUOTA",
+	ERROR_COMMITMENT_LIMIT:                       "ERROR_COMMITMENT_LIMIT",
+	ERROR_MENU_ITEM_NOT_FOUND:                    "ERROR_MENU_ITEM_NOT_FOUND",
+	ERROR_INVALID_KEYBOARD_HANDLE:                "ERROR_INVALID_KEYBOARD_HANDLE",
+	ERROR_HOOK_TYPE_NOT_ALLOWED:                  "ERROR_HOOK_TYPE_NOT_ALLOWED",
+	ERROR_REQUIRES_INTERACTIVE_WINDOWSTATION:     "ERROR_REQUIRES_INTERACTIVE_WINDOWSTATION",
+	ERROR_TIMEOUT:                                "ERROR_TIMEOUT",
+	ERROR_INVALID_MONITOR_HANDLE:                 "ERROR_INVALID_MONITOR_HANDLE",
+	ERROR_EVENTLOG_FILE_CORRUPT:                  "ERROR_EVENTLOG_FILE_CORRUPT",
+	ERROR_EVENTLOG_CANT_START:                    "ERROR_EVENTLOG_CANT_START",
+	ERROR_LOG_FILE_FULL:                          "ERROR_LOG_FILE_FULL",
+	ERROR_EVENTLOG_FILE_CHANGED:                  "ERROR_EVENTLOG_FILE_CHANGED",
+	ERROR_INSTALL_SERVICE:                        "ERROR_INSTALL_SERVICE",
+	ERROR_INSTALL_USEREXIT:                       "ERROR_INSTALL_USEREXIT",
+	ERROR_INSTALL_FAILURE:                        "ERROR_INSTALL_FAILURE",
+	ERROR_INSTALL_SUSPEND:                        "ERROR_INSTALL_SUSPEND",
+	ERROR_UNKNOWN_PRODUCT:                        "ERROR_UNKNOWN_PRODUCT",
+	ERROR_UNKNOWN_FEATURE:                        "ERROR_UNKNOWN_FEATURE",
+	ERROR_UNKNOWN_COMPONENT:                      "ERROR_UNKNOWN_COMPONENT",
+	ERROR_UNKNOWN_PROPERTY:                       "ERROR_UNKNOWN_PROPERTY",
+	ERROR_INVALID_HANDLE_STATE:                   "ERROR_INVALID_HANDLE_STATE",
+	ERROR_BAD_CONFIGURATION:                      "ERROR_BAD_CONFIGURATION",
+	ERROR_INDEX_ABSENT:                           "ERROR_INDEX_ABSENT",
+	ERROR_INSTALL_SOURCE_ABSENT:                  "ERROR_INSTALL_SOURCE_ABSENT",
+	ERROR_BAD_DATABASE_VERSION:                   "ERROR_BAD_DATABASE_VERSION",
+	ERROR_PRODUCT_UNINSTALLED:                    "ERROR_PRODUCT_UNINSTALLED",
+	ERROR_BAD_QUERY_SYNTAX:                       "ERROR_BAD_QUERY_SYNTAX",
+	ERROR_INVALID_FIELD:                          "ERROR_INVALID_FIELD",
+	RPC_S_INVALID_STRING_BINDING:                 "RPC_S_INVALID_STRING_BINDING",
+	RPC_S_WRONG_KIND_OF_BINDING:                  "RPC_S_WRONG_KIND_OF_BINDING",
+	RPC_S_INVALID_BINDING:                        "RPC_S_INVALID_BINDING",
+	RPC_S_PROTSEQ_NOT_SUPPORTED:                  "RPC_S_PROTSEQ_NOT_SUPPORTED",
+	RPC_S_INVALID_RPC_PROTSEQ:                    "RPC_S_INVALID_RPC_PROTSEQ",
+	RPC_S_INVALID_STRING_UUID:                    "RPC_S_INVALID_STRING_UUID",
+	RPC_S_INVALID_ENDPOINT_FORMAT:                "RPC_S_INVALID_ENDPOINT_FORMAT",
+	RPC_S_INVALID_NET_ADDR:                       "RPC_S_INVALID_NET_ADDR",
+	RPC_S_NO_ENDPOINT_FOUND:                      "RPC_S_NO_ENDPOINT_FOUND",
+	RPC_S_INVALID_TIMEOUT:                        "RPC_S_INVALID_TIMEOUT",
+	RPC_S_OBJECT_NOT_FOUND:                       "RPC_S_OBJECT_NOT_FOUND",
+	RPC_S_ALREADY_REGISTERED:                     "RPC_S_ALREADY_REGISTERED",
+	RPC_S_TYPE_ALREADY_REGISTERED:                "RPC_S_TYPE_ALREADY_REGISTERED",
+	RPC_S_ALREADY_LISTENING:                      "RPC_S_ALREADY_LISTENING",
+	RPC_S_NO_PROTSEQS_REGISTERED:                 "RPC_S_NO_PROTSEQS_REGISTERED",
+	RPC_S_NOT_LISTENING:                          "RPC_S_NOT_LISTENING",
+	RPC_S_UNKNOWN_MGR_TYPE:                       "RPC_S_UNKNOWN_MGR_TYPE",
+	RPC_S_UNKNOWN_IF:                             "RPC_S_UNKNOWN_IF",
+	RPC_S_NO_BINDINGS:                            "RPC_S_NO_BINDINGS",
+	RPC_S_NO_PROTSEQS:                            "RPC_S_NO_PROTSEQS",
+	RPC_S_CANT_CREATE_ENDPOINT:                   "RPC_S_CANT_CREATE_ENDPOINT",
+	RPC_S_OUT_OF_RESOURCES:                       "RPC_S_OUT_OF_RESOURCES",
+	RPC_S_SERVER_UNAVAILABLE:                     "RPC_S_SERVER_UNAVAILABLE",
+	RPC_S_SERVER_TOO_BUSY:                        "RPC_S_SERVER_TOO_BUSY",
+	RPC_S_INVALID_NETWORK_OPTIONS:                "RPC_S_INVALID_NETWORK_OPTIONS",
+	RPC_S_NO_CALL_ACTIVE:                         "RPC_S_NO_CALL_ACTIVE",
+	RPC_S_CALL_FAILED:                            "RPC_S_CALL_FAILED",
+	RPC_S_CALL_FAILED_DNE:                        "RPC_S_CALL_FAILED_DNE",
+	RPC_S_PROTOCOL_ERROR:                         "RPC_S_PROTOCOL_ERROR",
+	RPC_S_UNSUPPORTED_TRANS_SYN:                  "RPC_S_UNSUPPORTED_TRANS_SYN",
+	RPC_S_UNSUPPORTED_TYPE:                       "RPC_S_UNSUPPORTED_TYPE",
+	RPC_S_INVALID_TAG:                            "RPC_S_INVALID_TAG",
+	RPC_S_INVALID_BOUND:                          "RPC_S_INVALID_BOUND",
+	RPC_S_NO_ENTRY_NAME:                          "RPC_S_NO_ENTRY_NAME",
+	RPC_S_INVALID_NAME_SYNTAX:                    "RPC_S_INVALID_NAME_SYNTAX",
+	RPC_S_UNSUPPORTED_NAME_SYNTAX:                "RPC_S_UNSUPPORTED_NAME_SYNTAX",
+	RPC_S_UUID_NO_ADDRESS:                        "RPC_S_UUID_NO_ADDRESS",
+	RPC_S_DUPLICATE_ENDPOINT:                     "RPC_S_DUPLICATE_ENDPOINT",
+	RPC_S_UNKNOWN_AUTHN_TYPE:                     "RPC_S_UNKNOWN_AUTHN_TYPE",
+	RPC_S_MAX_CALLS_TOO_SMALL:                    "RPC_S_MAX_CALLS_TOO_SMALL",
+	RPC_S_STRING_TOO_LONG:                        "RPC_S_STRING_TOO_LONG",
+	RPC_S_PROTSEQ_NOT_FOUND:                      "RPC_S_PROTSEQ_NOT_FOUND",
+	RPC_S_PROCNUM_OUT_OF_RANGE:                   "RPC_S_PROCNUM_OUT_OF_RANGE",
+	RPC_S_BINDING_HAS_NO_AUTH:                    "RPC_S_BINDING_HAS_NO_AUTH",
+	RPC_S_UNKNOWN_AUTHN_SERVICE:                  "RPC_S_UNKNOWN_AUTHN_SERVICE",
+	RPC_S_UNKNOWN_AUTHN_LEVEL:                    "RPC_S_UNKNOWN_AUTHN_LEVEL",
+	RPC_S_INVALID_AUTH_IDENTITY:                  "RPC_S_INVALID_AUTH_IDENTITY",
+	RPC_S_UNKNOWN_AUTHZ_SERVICE:                  "RPC_S_UNKNOWN_AUTHZ_SERVICE",
+	EPT_S_INVALID_ENTRY:                          "EPT_S_INVALID_ENTRY",
+	EPT_S_CANT_PERFORM_OP:                        "EPT_S_CANT_PERFORM_OP",
+	EPT_S_NOT_REGISTERED:                         "EPT_S_NOT_REGISTERED",
+	RPC_S_NOTHING_TO_EXPORT:                      "RPC_S_NOTHING_TO_EXPORT",
+	RPC_S_INCOMPLETE_NAME:                        "RPC_S_INCOMPLETE_NAME",
+	RPC_S_INVALID_VERS_OPTION:                    "RPC_S_INVALID_VERS_OPTION",
+	RPC_S_NO_MORE_MEMBERS:                        "RPC_S_NO_MORE_MEMBERS",
+	RPC_S_NOT_ALL_OBJS_UNEXPORTED:                "RPC_S_NOT_ALL_OBJS_UNEXPORTED",
+	RPC_S_INTERFACE_NOT_FOUND:                    "RPC_S_INTERFACE_NOT_FOUND",
+	RPC_S_ENTRY_ALREADY_EXISTS:                   "RPC_S_ENTRY_ALREADY_EXISTS",
+	RPC_S_ENTRY_NOT_FOUND:                        "RPC_S_ENTRY_NOT_FOUND",
+	RPC_S_NAME_SERVICE_UNAVAILABLE:               "RPC_S_NAME_SERVICE_UNAVAILABLE",
+	RPC_S_INVALID_NAF_ID:                         "RPC_S_INVALID_NAF_ID",
+	RPC_S_CANNOT_SUPPORT:                         "RPC_S_CANNOT_SUPPORT",
+	RPC_S_NO_CONTEXT_AVAILABLE:                   "RPC_S_NO_CONTEXT_AVAILABLE",
+	RPC_S_INTERNAL_ERROR:                         "RPC_S_INTERNAL_ERROR",
+	RPC_S_ZERO_DIVIDE:                            "RPC_S_ZERO_DIVIDE",
+	RPC_S_ADDRESS_ERROR:                          "RPC_S_ADDRESS_ERROR",
+	RPC_S_FP_DIV_ZERO:                            "RPC_S_FP_DIV_ZERO",
+	RPC_S_FP_UNDERFLOW:                           "RPC_S_FP_UNDERFLOW",
+	RPC_S_FP_OVERFLOW:                            "RPC_S_FP_OVERFLOW",
+	RPC_X_NO_MORE_ENTRIES:                        "RPC_X_NO_MORE_ENTRIES",
+	RPC_X_SS_CHAR_TRANS_OPEN_FAIL:                "RPC_X_SS_CHAR_TRANS_OPEN_FAIL",
+	RPC_X_SS_CHAR_TRANS_SHORT_FILE:               "RPC_X_SS_CHAR_TRANS_SHORT_FILE",
+	RPC_X_SS_IN_NULL_CONTEXT:                     "RPC_X_SS_IN_NULL_CONTEXT",
+	RPC_X_SS_CONTEXT_DAMAGED:                     "RPC_X_SS_CONTEXT_DAMAGED",
+	RPC_X_SS_HANDLES_MISMATCH:                    "RPC_X_SS_HANDLES_MISMATCH",
+	RPC_X_SS_CANNOT_GET_CALL_HANDLE:              "RPC_X_SS_CANNOT_GET_CALL_HANDLE",
+	RPC_X_NULL_REF_POINTER:                       "RPC_X_NULL_REF_POINTER",
+	RPC_X_ENUM_VALUE_OUT_OF_RANGE:                "RPC_X_ENUM_VALUE_OUT_OF_RANGE",
+	RPC_X_BYTE_COUNT_TOO_SMALL:                   "RPC_X_BYTE_COUNT_TOO_SMALL",
+	RPC_X_BAD_STUB_DATA:                          "RPC_X_BAD_STUB_DATA",
+	ERROR_INVALID_USER_BUFFER:                    "ERROR_INVALID_USER_BUFFER",
+	ERROR_UNRECOGNIZED_MEDIA:                     "ERROR_UNRECOGNIZED_MEDIA",
+	ERROR_NO_TRUST_LSA_SECRET:                    "ERROR_NO_TRUST_LSA_SECRET",
+	ERROR_NO_TRUST_SAM_ACCOUNT:                   "ERROR_NO_TRUST_SAM_ACCOUNT",
+	ERROR_TRUSTED_DOMAIN_FAILURE:                 "ERROR_TRUSTED_DOMAIN_FAILURE",
+	ERROR_TRUSTED_RELATIONSHIP_FAILURE:           "ERROR_TRUSTED_RELATIONSHIP_FAILURE",
+	ERROR_TRUST_FAILURE:                          "ERROR_TRUST_FAILURE",
+	RPC_S_CALL_IN_PROGRESS:                       "RPC_S_CALL_IN_PROGRESS",
+	ERROR_NETLOGON_NOT_STARTED:                   "ERROR_NETLOGON_NOT_STARTED",
+	ERROR_ACCOUNT_EXPIRED:                        "ERROR_ACCOUNT_EXPIRED",
+	ERROR_REDIRECTOR_HAS_OPEN_HANDLES:            "ERROR_REDIRECTOR_HAS_OPEN_HANDLES",
+	ERROR_PRINTER_DRIVER_ALREADY_INSTALLED:       "ERROR_PRINTER_DRIVER_ALREADY_INSTALLED",
+	ERROR_UNKNOWN_PORT:                           "ERROR_UNKNOWN_PORT",
+	ERROR_UNKNOWN_PRINTER_DRIVER:                 "ERROR_UNKNOWN_PRINTER_DRIVER",
+	ERROR_UNKNOWN_PRINTPROCESSOR:                 "ERROR_UNKNOWN_PRINTPROCESSOR",
+	ERROR_INVALID_SEPARATOR_FILE:                 "ERROR_INVALID_SEPARATOR_FILE",
+	ERROR_INVALID_PRIORITY:                       "ERROR_INVALID_PRIORITY",
+	ERROR_INVALID_PRINTER_NAME:                   "ERROR_INVALID_PRINTER_NAME",
+	ERROR_PRINTER_ALREADY_EXISTS:                 "ERROR_PRINTER_ALREADY_EXISTS",
+	ERROR_INVALID_PRINTER_COMMAND:                "ERROR_INVALID_PRINTER_COMMAND",
+	ERROR_INVALID_DATATYPE:                       "ERROR_INVALID_DATATYPE",
+	ERROR_INVALID_ENVIRONMENT:                    "ERROR_INVALID_ENVIRONMENT",
+	RPC_S_NO_MORE_BINDINGS:                       "RPC_S_NO_MORE_BINDINGS",
+	ERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT:      "ERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT",
+	ERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNT:      "ERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNT",
+	ERROR_NOLOGON_SERVER_TRUST_ACCOUNT:           "ERROR_NOLOGON_SERVER_TRUST_ACCOUNT",
+	ERROR_DOMAIN_TRUST_INCONSISTENT:              "ERROR_DOMAIN_TRUST_INCONSISTENT",
+	ERROR_SERVER_HAS_OPEN_HANDLES:                "ERROR_SERVER_HAS_OPEN_HANDLES",
+	ERROR_RESOURCE_DATA_NOT_FOUND:                "ERROR_RESOURCE_DATA_NOT_FOUND",
+	ERROR_RESOURCE_TYPE_NOT_FOUND:                "ERROR_RESOURCE_TYPE_NOT_FOUND",
+	ERROR_RESOURCE_NAME_NOT_FOUND:                "ERROR_RESOURCE_NAME_NOT_FOUND",
+	ERROR_RESOURCE_LANG_NOT_FOUND:                "ERROR_RESOURCE_LANG_NOT_FOUND",
+	ERROR_NOT_ENOUGH_QUOTA:                       "ERROR_NOT_ENOUGH_QUOTA",
+	RPC_S_NO_INTERFACES:                          "RPC_S_NO_INTERFACES",
+	RPC_S_CALL_CANCELLED:                         "RPC_S_CALL_CANCELLED",
+	RPC_S_BINDING_INCOMPLETE:                     "RPC_S_BINDING_INCOMPLETE",
+	RPC_S_COMM_FAILURE:                           "RPC_S_COMM_FAILURE",
+	RPC_S_UNSUPPORTED_AUTHN_LEVEL:                "RPC_S_UNSUPPORTED_AUTHN_LEVEL",
+	RPC_S_NO_PRINC_NAME:                          "RPC_S_NO_PRINC_NAME",
+	RPC_S_NOT_RPC_ERROR:                          "RPC_S_NOT_RPC_ERROR",
+	RPC_S_UUID_LOCAL_ONLY:                        "RPC_S_UUID_LOCAL_ONLY",
+	RPC_S_SEC_PKG_ERROR:                          "RPC_S_SEC_PKG_ERROR",
+	RPC_S_NOT_CANCELLED:                          "RPC_S_NOT_CANCELLED",
+	RPC_X_INVALID_ES_ACTION:                      "RPC_X_INVALID_ES_ACTION",
+	RPC_X_WRONG_ES_VERSION:                       "RPC_X_WRONG_ES_VERSION",
+	RPC_X_WRONG_STUB_VERSION:                     "RPC_X_WRONG_STUB_VERSION",
+	RPC_X_INVALID_PIPE_OBJECT:                    "RPC_X_INVALID_PIPE_OBJECT",
+	RPC_X_WRONG_PIPE_ORDER:                       "RPC_X_WRONG_PIPE_ORDER",
+	RPC_X_WRONG_PIPE_VERSION:                     "RPC_X_WRONG_PIPE_VERSION",
+	RPC_S_GROUP_MEMBER_NOT_FOUND:                 "RPC_S_GROUP_MEMBER_NOT_FOUND",
+	EPT_S_CANT_CREATE:                            "EPT_S_CANT_CREATE",
+	RPC_S_INVALID_OBJECT:                         "RPC_S_INVALID_OBJECT",
+	ERROR_INVALID_TIME:                           "ERROR_INVALID_TIME",
+	ERROR_INVALID_FORM_NAME:                      "ERROR_INVALID_FORM_NAME",
+	ERROR_INVALID_FORM_SIZE:                      "ERROR_INVALID_FORM_SIZE",
+	ERROR_ALREADY_WAITING:                        "ERROR_ALREADY_WAITING",
+	ERROR_PRINTER_DELETED:                        "ERROR_PRINTER_DELETED",
+	ERROR_INVALID_PRINTER_STATE:                  "ERROR_INVALID_PRINTER_STATE",
+	ERROR_PASSWORD_MUST_CHANGE:                   "ERROR_PASSWORD_MUST_CHANGE",
+	ERROR_DOMAIN_CONTROLLER_NOT_FOUND:            "ERROR_DOMAIN_CONTROLLER_NOT_FOUND",
+	ERROR_ACCOUNT_LOCKED_OUT:                     "ERROR_ACCOUNT_LOCKED_OUT",
+	OR_INVALID_OXID:                              "OR_INVALID_OXID",
+	OR_INVALID_OID:                               "OR_INVALID_OID",
+	OR_INVALID_SET:                               "OR_INVALID_SET",
+	RPC_S_SEND_INCOMPLETE:                        "RPC_S_SEND_INCOMPLETE",
+	RPC_S_INVALID_ASYNC_HANDLE:                   "RPC_S_INVALID_ASYNC_HANDLE",
+	RPC_S_INVALID_ASYNC_CALL:                     "RPC_S_INVALID_ASYNC_CALL",
+	RPC_X_PIPE_CLOSED:                            "RPC_X_PIPE_CLOSED",
+	RPC_X_PIPE_DISCIPLINE_ERROR:                  "RPC_X_PIPE_DISCIPLINE_ERROR",
+	RPC_X_PIPE_EMPTY:                             "RPC_X_PIPE_EMPTY",
+	ERROR_NO_SITENAME:                            "ERROR_NO_SITENAME",
+	ERROR_CANT_ACCESS_FILE:                       "ERROR_CANT_ACCESS_FILE",
+	ERROR_CANT_RESOLVE_FILENAME:                  "ERROR_CANT_RESOLVE_FILENAME",
+	ERROR_DS_MEMBERSHIP_EVALUATED_LOCALLY:        "ERROR_DS_MEMBERSHIP_EVALUATED_LOCALLY",
+	ERROR_DS_NO_ATTRIBUTE_OR_VALUE:               "ERROR_DS_NO_ATTRIBUTE_OR_VALUE",
+	ERROR_DS_INVALID_ATTRIBUTE_SYNTAX:            "ERROR_DS_INVALID_ATTRIBUTE_SYNTAX",
+	ERROR_DS_ATTRIBUTE_TYPE_UNDEFINED:            "ERROR_DS_ATTRIBUTE_TYPE_UNDEFINED",
+	ERROR_DS_ATTRIBUTE_OR_VALUE_EXISTS:           "ERROR_DS_ATTRIBUTE_OR_VALUE_EXISTS",
+	ERROR_DS_BUSY:                                "ERROR_DS_BUSY",
+	ERROR_DS_UNAVAILABLE:                         "ERROR_DS_UNAVAILABLE",
+	ERROR_DS_NO_RIDS_ALLOCATED:                   "ERROR_DS_NO_RIDS_ALLOCATED",
+	ERROR_DS_NO_MORE_RIDS:                        "ERROR_DS_NO_MORE_RIDS",
+	ERROR_DS_INCORRECT_ROLE_OWNER:                "ERROR_DS_INCORRECT_ROLE_OWNER",
+	ERROR_DS_RIDMGR_INIT_ERROR:                   "ERROR_DS_RIDMGR_INIT_ERROR",
+	ERROR_DS_OBJ_CLASS_VIOLATION:                 "ERROR_DS_OBJ_CLASS_VIOLATION",
+	ERROR_DS_CANT_ON_NON_LEAF:                    "ERROR_DS_CANT_ON_NON_LEAF",
+	ERROR_DS_CANT_ON_RDN:                         "ERROR_DS_CANT_ON_RDN",
+	ERROR_DS_CANT_MOD_OBJ_CLASS:                  "ERROR_DS_CANT_MOD_OBJ_CLASS",
+	ERROR_DS_CROSS_DOM_MOVE_ERROR:                "ERROR_DS_CROSS_DOM_MOVE_ERROR",
+	ERROR_DS_GC_NOT_AVAILABLE:                    "ERROR_DS_GC_NOT_AVAILABLE",
+	ERROR_NO_BROWSER_SERVERS_FOUND:               "ERROR_NO_BROWSER_SERVERS_FOUND",
+	ERROR_INVALID_PIXEL_FORMAT:                   "ERROR_INVALID_PIXEL_FORMAT",
+	ERROR_BAD_DRIVER:                             "ERROR_BAD_DRIVER",
+	ERROR_INVALID_WINDOW_STYLE:                   "ERROR_INVALID_WINDOW_STYLE",
+	ERROR_METAFILE_NOT_SUPPORTED:                 "ERROR_METAFILE_NOT_SUPPORTED",
+	ERROR_TRANSFORM_NOT_SUPPORTED:                "ERROR_TRANSFORM_NOT_SUPPORTED",
+	ERROR_CLIPPING_NOT_SUPPORTED:                 "ERROR_CLIPPING_NOT_SUPPORTED",
+	ERROR_INVALID_CMM:                            "ERROR_INVALID_CMM",
+	ERROR_INVALID_PROFILE:                        "ERROR_INVALID_PROFILE",
+	ERROR_TAG_NOT_FOUND:                          "ERROR_TAG_NOT_FOUND",
+	ERROR_TAG_NOT_PRESENT:                        "ERROR_TAG_NOT_PRESENT",
+	ERROR_DUPLICATE_TAG:                          "ERROR_DUPLICATE_TAG",
+	ERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICE:     "ERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICE",
+	ERROR_PROFILE_NOT_FOUND:                      "ERROR_PROFILE_NOT_FOUND",
+	ERROR_INVALID_COLORSPACE:                     "ERROR_INVALID_COLORSPACE",
+	ERROR_ICM_NOT_ENABLED:                        "ERROR_ICM_NOT_ENABLED",
+	ERROR_DELETING_ICM_XFORM:                     "ERROR_DELETING_ICM_XFORM",
+	ERROR_INVALID_TRANSFORM:                      "ERROR_INVALID_TRANSFORM",
+	ERROR_UNKNOWN_PRINT_MONITOR:                  "ERROR_UNKNOWN_PRINT_MONITOR",
+	ERROR_PRINTER_DRIVER_IN_USE:                  "ERROR_PRINTER_DRIVER_IN_USE",
+	ERROR_SPOOL_FILE_NOT_FOUND:                   "ERROR_SPOOL_FILE_NOT_FOUND",
+	ERROR_SPL_NO_STARTDOC:                        "ERROR_SPL_NO_STARTDOC",
+	ERROR_SPL_NO_ADDJOB:                          "ERROR_SPL_NO_ADDJOB",
+	ERROR_PRINT_PROCESSOR_ALREADY_INSTALLED:      "ERROR_PRINT_PROCESSOR_ALREADY_INSTALLED",
+	ERROR_PRINT_MONITOR_ALREADY_INSTALLED:        "ERROR_PRINT_MONITOR_ALREADY_INSTALLED",
+	ERROR_INVALID_PRINT_MONITOR:                  "ERROR_INVALID_PRINT_MONITOR",
+	ERROR_PRINT_MONITOR_IN_USE:                   "ERROR_PRINT_MONITOR_IN_USE",
+	ERROR_PRINTER_HAS_JOBS_QUEUED:                "ERROR_PRINTER_HAS_JOBS_QUEUED",
+	ERROR_SUCCESS_REBOOT_REQUIRED:                "ERROR_SUCCESS_REBOOT_REQUIRED",
+	ERROR_SUCCESS_RESTART_REQUIRED:               "ERROR_SUCCESS_RESTART_REQUIRED",
+	ERROR_WINS_INTERNAL:                          "ERROR_WINS_INTERNAL",
+	ERROR_CAN_NOT_DEL_LOCAL_WINS:                 "ERROR_CAN_NOT_DEL_LOCAL_WINS",
+	ERROR_STATIC_INIT:                            "ERROR_STATIC_INIT",
+	ERROR_INC_BACKUP:                             "ERROR_INC_BACKUP",
+	ERROR_FULL_BACKUP:                            "ERROR_FULL_BACKUP",
+	ERROR_REC_NON_EXISTENT:                       "ERROR_REC_NON_EXISTENT",
+	ERROR_RPL_NOT_ALLOWED:                        "ERROR_RPL_NOT_ALLOWED",
+	ERROR_DHCP_ADDRESS_CONFLICT:                  "ERROR_DHCP_ADDRESS_CONFLICT",
+	ERROR_WMI_GUID_NOT_FOUND:                     "ERROR_WMI_GUID_NOT_FOUND",
+	ERROR_WMI_INSTANCE_NOT_FOUND:                 "ERROR_WMI_INSTANCE_NOT_FOUND",
+	ERROR_WMI_ITEMID_NOT_FOUND:                   "ERROR_WMI_ITEMID_NOT_FOUND",
+	ERROR_WMI_TRY_AGAIN:                          "ERROR_WMI_TRY_AGAIN",
+	ERROR_WMI_DP_NOT_FOUND:                       "ERROR_WMI_DP_NOT_FOUND",
+	ERROR_WMI_UNRESOLVED_INSTANCE_REF:            "ERROR_WMI_UNRESOLVED_INSTANCE_REF",
+	ERROR_WMI_ALREADY_ENABLED:                    "ERROR_WMI_ALREADY_ENABLED",
+	ERROR_WMI_GUID_DISCONNECTED:                  "ERROR_WMI_GUID_DISCONNECTED",
+	ERROR_WMI_SERVER_UNAVAILABLE:                 "ERROR_WMI_SERVER_UNAVAILABLE",
+	ERROR_WMI_DP_FAILED:                          "ERROR_WMI_DP_FAILED",
+	ERROR_WMI_INVALID_MOF:                        "ERROR_WMI_INVALID_MOF",
+	ERROR_WMI_INVALID_REGINFO:                    "ERROR_WMI_INVALID_REGINFO",
+	ERROR_INVALID_MEDIA:                          "ERROR_INVALID_MEDIA",
+	ERROR_INVALID_LIBRARY:                        "ERROR_INVALID_LIBRARY",
+	ERROR_INVALID_MEDIA_POOL:                     "ERROR_INVALID_MEDIA_POOL",
+	ERROR_DRIVE_MEDIA_MISMATCH:                   "ERROR_DRIVE_MEDIA_MISMATCH",
+	ERROR_MEDIA_OFFLINE:                          "ERROR_MEDIA_OFFLINE",
+	ERROR_LIBRARY_OFFLINE:                        "ERROR_LIBRARY_OFFLINE",
+	ERROR_EMPTY:                                  "ERROR_EMPTY",
+	ERROR_NOT_EMPTY:                              "ERROR_NOT_EMPTY",
+	ERROR_MEDIA_UNAVAILABLE:                      "ERROR_MEDIA_UNAVAILABLE",
+	ERROR_RESOURCE_DISABLED:                      "ERROR_RESOURCE_DISABLED",
+	ERROR_INVALID_CLEANER:                        "ERROR_INVALID_CLEANER",
+	ERROR_UNABLE_TO_CLEAN:                        "ERROR_UNABLE_TO_CLEAN",
+	ERROR_OBJECT_NOT_FOUND:                       "ERROR_OBJECT_NOT_FOUND",
+	ERROR_DATABASE_FAILURE:                       "ERROR_DATABASE_FAILURE",
+	ERROR_DATABASE_FULL:                          "ERROR_DATABASE_FULL",
+	ERROR_MEDIA_INCOMPATIBLE:                     "ERROR_MEDIA_INCOMPATIBLE",
+	ERROR_RESOURCE_NOT_PRESENT:                   "ERROR_RESOURCE_NOT_PRESENT",
+	ERROR_INVALID_OPERATION:                      "ERROR_INVALID_OPERATION",
+	ERROR_MEDIA_NOT_AVAILABLE:                    "ERROR_MEDIA_NOT_AVAILABLE",
+	ERROR_DEVICE_NOT_AVAILABLE:                   "ERROR_DEVICE_NOT_AVAILABLE",
+	ERROR_REQUEST_REFUSED:                        "ERROR_REQUEST_REFUSED",
+	ERROR_FILE_OFFLINE:                           "ERROR_FILE_OFFLINE",
+	ERROR_REMOTE_STORAGE_NOT_ACTIVE:              "ERROR_REMOTE_STORAGE_NOT_ACTIVE",
+	ERROR_REMOTE_STORAGE_MEDIA_ERROR:             "ERROR_REMOTE_STORAGE_MEDIA_ERROR",
+	ERROR_NOT_A_REPARSE_POINT:                    "ERROR_NOT_A_REPARSE_POINT",
+	ERROR_REPARSE_ATTRIBUTE_CONFLICT:             "ERROR_REPARSE_ATTRIBUTE_CONFLICT",
+	ERROR_DEPENDENT_RESOURCE_EXISTS:              "ERROR_DEPENDENT_RESOURCE_EXISTS",
+	ERROR_DEPENDENCY_NOT_FOUND:                   "ERROR_DEPENDENCY_NOT_FOUND",
+	ERROR_DEPENDENCY_ALREADY_EXISTS:              "ERROR_DEPENDENCY_ALREADY_EXISTS",
+	ERROR_RESOURCE_NOT_ONLINE:                    "ERROR_RESOURCE_NOT_ONLINE",
+	ERROR_HOST_NODE_NOT_AVAILABLE:                "ERROR_HOST_NODE_NOT_AVAILABLE",
+	ERROR_RESOURCE_NOT_AVAILABLE:                 "ERROR_RESOURCE_NOT_AVAILABLE",
+	ERROR_RESOURCE_NOT_FOUND:                     "ERROR_RESOURCE_NOT_FOUND",
+	ERROR_SHUTDOWN_CLUSTER:                       "ERROR_SHUTDOWN_CLUSTER",
+	ERROR_CANT_EVICT_ACTIVE_NODE:                 "ERROR_CANT_EVICT_ACTIVE_NODE",
+	ERROR_OBJECT_ALREADY_EXISTS:                  "ERROR_OBJECT_ALREADY_EXISTS",
+	ERROR_OBJECT_IN_LIST:                         "ERROR_OBJECT_IN_LIST",
+	ERROR_GROUP_NOT_AVAILABLE:                    "ERROR_GROUP_NOT_AVAILABLE",
+	ERROR_GROUP_NOT_FOUND:                        "ERROR_GROUP_NOT_FOUND",
+	ERROR_GROUP_NOT_ONLINE:                       "ERROR_GROUP_NOT_ONLINE",
+	ERROR_HOST_NODE_NOT_RESOURCE_OWNER:           "ERROR_HOST_NODE_NOT_RESOURCE_OWNER",
+	ERROR_HOST_NODE_NOT_GROUP_OWNER:              "ERROR_HOST_NODE_NOT_GROUP_OWNER",
+	ERROR_RESMON_CREATE_FAILED:                   "ERROR_RESMON_CREATE_FAILED",
+	ERROR_RESMON_ONLINE_FAILED:                   "ERROR_RESMON_ONLINE_FAILED",
+	ERROR_RESOURCE_ONLINE:                        "ERROR_RESOURCE_ONLINE",
+	ERROR_QUORUM_RESOURCE:                        "ERROR_QUORUM_RESOURCE",
+	ERROR_NOT_QUORUM_CAPABLE:                     "ERROR_NOT_QUORUM_CAPABLE",
+	ERROR_CLUSTER_SHUTTING_DOWN:                  "ERROR_CLUSTER_SHUTTING_DOWN",
+	ERROR_INVALID_STATE:                          "ERROR_INVALID_STATE",
+	ERROR_RESOURCE_PROPERTIES_STORED:             "ERROR_RESOURCE_PROPERTIES_STORED",
+	ERROR_NOT_QUORUM_CLASS:                       "ERROR_NOT_QUORUM_CLASS",
+	ERROR_CORE_RESOURCE:                          "ERROR_CORE_RESOURCE",
+	ERROR_QUORUM_RESOURCE_ONLINE_FAILED:          "ERROR_QUORUM_RESOURCE_ONLINE_FAILED",
+	ERROR_QUORUMLOG_OPEN_FAILED:                  "ERROR_QUORUMLOG_OPEN_FAILED",
+	ERROR_CLUSTERLOG_CORRUPT:                     "ERROR_CLUSTERLOG_CORRUPT",
+	ERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZE:      "ERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZE",
+	ERROR_CLUSTERLOG_EXCEEDS_MAXSIZE:             "ERROR_CLUSTERLOG_EXCEEDS_MAXSIZE",
+	ERROR_CLUSTERLOG_CHKPOINT_NOT_FOUND:          "ERROR_CLUSTERLOG_CHKPOINT_NOT_FOUND",
+	ERROR_CLUSTERLOG_NOT_ENOUGH_SPACE:            "ERROR_CLUSTERLOG_NOT_ENOUGH_SPACE",
+	ERROR_ENCRYPTION_FAILED:                      "ERROR_ENCRYPTION_FAILED",
+	ERROR_DECRYPTION_FAILED:                      "ERROR_DECRYPTION_FAILED",
+	ERROR_FILE_ENCRYPTED:                         "ERROR_FILE_ENCRYPTED",
+	ERROR_NO_RECOVERY_POLICY:                     "ERROR_NO_RECOVERY_POLICY",
+	ERROR_NO_EFS:                                 "ERROR_NO_EFS",
+	ERROR_WRONG_EFS:                              "ERROR_WRONG_EFS",
+	ERROR_NO_USER_KEYS:                           "ERROR_NO_USER_KEYS",
+	ERROR_FILE_NOT_ENCRYPTED:                     "ERROR_FILE_NOT_ENCRYPTED",
+	ERROR_NOT_EXPORT_FORMAT:                      "ERROR_NOT_EXPORT_FORMAT",
+	ERROR_DEVICE_SUPPORT_IN_PROGRESS:             "ERROR_DEVICE_SUPPORT_IN_PROGRESS",
+	ERROR_EXE_CANNOT_MODIFY_SIGNED_BINARY:        "ERROR_EXE_CANNOT_MODIFY_SIGNED_BINARY",
+	ERROR_EXE_CANNOT_MODIFY_STRONG_SIGNED_BINARY: "ERROR_EXE_CANNOT_MODIFY_STRONG_SIGNED_BINARY",
+	ERROR_FILE_CHECKED_OUT:                       "ERROR_FILE_CHECKED_OUT",
+	ERROR_CHECKOUT_REQUIRED:                      "ERROR_CHECKOUT_REQUIRED",
+	ERROR_BAD_FILE_TYPE:                          "ERROR_BAD_FILE_TYPE",
+	ERROR_FILE_TOO_LARGE:                         "ERROR_FILE_TOO_LARGE",
+	ERROR_FORMS_AUTH_REQUIRED:                    "ERROR_FORMS_AUTH_REQUIRED",
+	ERROR_VIRUS_INFECTED:                         "ERROR_VIRUS_INFECTED",
+	ERROR_VIRUS_DELETED:                          "ERROR_VIRUS_DELETED",
+	ERROR_PIPE_LOCAL:                             "ERROR_PIPE_LOCAL",
+	ERROR_NO_WORK_DONE:                           "ERROR_NO_WORK_DONE",
+	WAIT_TIMEOUT:                                 "WAIT_TIMEOUT",
+	ERROR_DISK_TOO_FRAGMENTED:                    "ERROR_DISK_TOO_FRAGMENTED",
+	ERROR_DELETE_PENDING:                         "ERROR_DELETE_PENDING",
+	ERROR_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING:             "ERROR_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING",
+	ERROR_SHORT_NAMES_NOT_ENABLED_ON_VOLUME:                                "ERROR_SHORT_NAMES_NOT_ENABLED_ON_VOLUME",
+	ERROR_SECURITY_STREAM_IS_INCONSISTENT:                                  "ERROR_SECURITY_STREAM_IS_INCONSISTENT",
+	ERROR_INVALID_LOCK_RANGE:                                               "ERROR_INVALID_LOCK_RANGE",
+	ERROR_IMAGE_SUBSYSTEM_NOT_PRESENT:                                      "ERROR_IMAGE_SUBSYSTEM_NOT_PRESENT",
+	ERROR_NOTIFICATION_GUID_ALREADY_DEFINED:                                "ERROR_NOTIFICATION_GUID_ALREADY_DEFINED",
+	ERROR_INVALID_EXCEPTION_HANDLER:                                        "ERROR_INVALID_EXCEPTION_HANDLER",
+	ERROR_DUPLICATE_PRIVILEGES:                                             "ERROR_DUPLICATE_PRIVILEGES",
+	ERROR_NO_RANGES_PROCESSED:                                              "ERROR_NO_RANGES_PROCESSED",
+	ERROR_NOT_ALLOWED_ON_SYSTEM_FILE:                                       "ERROR_NOT_ALLOWED_ON_SYSTEM_FILE",
+	ERROR_DISK_RESOURCES_EXHAUSTED:                                         "ERROR_DISK_RESOURCES_EXHAUSTED",
+	ERROR_INVALID_TOKEN:                                                    "ERROR_INVALID_TOKEN",
+	ERROR_DEVICE_FEATURE_NOT_SUPPORTED:                                     "ERROR_DEVICE_FEATURE_NOT_SUPPORTED",
+	ERROR_SCOPE_NOT_FOUND:                                                  "ERROR_SCOPE_NOT_FOUND",
+	ERROR_UNDEFINED_SCOPE:                                                  "ERROR_UNDEFINED_SCOPE",
+	ERROR_INVALID_CAP:                                                      "ERROR_INVALID_CAP",
+	ERROR_DEVICE_UNREACHABLE:                                               "ERROR_DEVICE_UNREACHABLE",
+	ERROR_DEVICE_NO_RESOURCES:                                              "ERROR_DEVICE_NO_RESOURCES",
+	ERROR_DATA_CHECKSUM_ERROR:                                              "ERROR_DATA_CHECKSUM_ERROR",
+	ERROR_INTERMIXED_KERNEL_EA_OPERATION:                                   "ERROR_INTERMIXED_KERNEL_EA_OPERATION",
+	ERROR_FILE_LEVEL_TRIM_NOT_SUPPORTED:                                    "ERROR_FILE_LEVEL_TRIM_NOT_SUPPORTED",
+	ERROR_OFFSET_ALIGNMENT_VIOLATION:                                       "ERROR_OFFSET_ALIGNMENT_VIOLATION",
+	ERROR_INVALID_FIELD_IN_PARAMETER_LIST:                                  "ERROR_INVALID_FIELD_IN_PARAMETER_LIST",
+	ERROR_OPERATION_IN_PROGRESS:                                            "ERROR_OPERATION_IN_PROGRESS",
+	ERROR_BAD_DEVICE_PATH:                                                  "ERROR_BAD_DEVICE_PATH",
+	ERROR_TOO_MANY_DESCRIPTORS:                                             "ERROR_TOO_MANY_DESCRIPTORS",
+	ERROR_SCRUB_DATA_DISABLED:                                              "ERROR_SCRUB_DATA_DISABLED",
+	ERROR_NOT_REDUNDANT_STORAGE:                                            "ERROR_NOT_REDUNDANT_STORAGE",
+	ERROR_RESIDENT_FILE_NOT_SUPPORTED:                                      "ERROR_RESIDENT_FILE_NOT_SUPPORTED",
+	ERROR_COMPRESSED_FILE_NOT_SUPPORTED:                                    "ERROR_COMPRESSED_FILE_NOT_SUPPORTED",
+	ERROR_DIRECTORY_NOT_SUPPORTED:                                          "ERROR_DIRECTORY_NOT_SUPPORTED",
+	ERROR_NOT_READ_FROM_COPY:                                               "ERROR_NOT_READ_FROM_COPY",
+	ERROR_FT_WRITE_FAILURE:                                                 "ERROR_FT_WRITE_FAILURE",
+	ERROR_FT_DI_SCAN_REQUIRED:                                              "ERROR_FT_DI_SCAN_REQUIRED",
+	ERROR_INVALID_KERNEL_INFO_VERSION:                                      "ERROR_INVALID_KERNEL_INFO_VERSION",
+	ERROR_INVALID_PEP_INFO_VERSION:                                         "ERROR_INVALID_PEP_INFO_VERSION",
+	ERROR_OBJECT_NOT_EXTERNALLY_BACKED:                                     "ERROR_OBJECT_NOT_EXTERNALLY_BACKED",
+	ERROR_EXTERNAL_BACKING_PROVIDER_UNKNOWN:                                "ERROR_EXTERNAL_BACKING_PROVIDER_UNKNOWN",
+	ERROR_COMPRESSION_NOT_BENEFICIAL:                                       "ERROR_COMPRESSION_NOT_BENEFICIAL",
+	ERROR_STORAGE_TOPOLOGY_ID_MISMATCH:                                     "ERROR_STORAGE_TOPOLOGY_ID_MISMATCH",
+	ERROR_BLOCKED_BY_PARENTAL_CONTROLS:                                     "ERROR_BLOCKED_BY_PARENTAL_CONTROLS",
+	ERROR_BLOCK_TOO_MANY_REFERENCES:                                        "ERROR_BLOCK_TOO_MANY_REFERENCES",
+	ERROR_MARKED_TO_DISALLOW_WRITES:                                        "ERROR_MARKED_TO_DISALLOW_WRITES",
+	ERROR_ENCLAVE_FAILURE:                                                  "ERROR_ENCLAVE_FAILURE",
+	ERROR_FAIL_NOACTION_REBOOT:                                             "ERROR_FAIL_NOACTION_REBOOT",
+	ERROR_FAIL_SHUTDOWN:                                                    "ERROR_FAIL_SHUTDOWN",
+	ERROR_FAIL_RESTART:                                                     "ERROR_FAIL_RESTART",
+	ERROR_MAX_SESSIONS_REACHED:                                             "ERROR_MAX_SESSIONS_REACHED",
+	ERROR_NETWORK_ACCESS_DENIED_EDP:                                        "ERROR_NETWORK_ACCESS_DENIED_EDP",
+	ERROR_DEVICE_HINT_NAME_BUFFER_TOO_SMALL:                                "ERROR_DEVICE_HINT_NAME_BUFFER_TOO_SMALL",
+	ERROR_EDP_POLICY_DENIES_OPERATION:                                      "ERROR_EDP_POLICY_DENIES_OPERATION",
+	ERROR_EDP_DPL_POLICY_CANT_BE_SATISFIED:                                 "ERROR_EDP_DPL_POLICY_CANT_BE_SATISFIED",
+	ERROR_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT:                            "ERROR_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT",
+	ERROR_DEVICE_IN_MAINTENANCE:                                            "ERROR_DEVICE_IN_MAINTENANCE",
+	ERROR_NOT_SUPPORTED_ON_DAX:                                             "ERROR_NOT_SUPPORTED_ON_DAX",
+	ERROR_DAX_MAPPING_EXISTS:                                               "ERROR_DAX_MAPPING_EXISTS",
+	ERROR_CLOUD_FILE_PROVIDER_NOT_RUNNING:                                  "ERROR_CLOUD_FILE_PROVIDER_NOT_RUNNING",
+	ERROR_CLOUD_FILE_METADATA_CORRUPT:                                      "ERROR_CLOUD_FILE_METADATA_CORRUPT",
+	ERROR_CLOUD_FILE_METADATA_TOO_LARGE:                                    "ERROR_CLOUD_FILE_METADATA_TOO_LARGE",
+	ERROR_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE:                               "ERROR_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE",
+	ERROR_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH:                       "ERROR_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH",
+	ERROR_CHILD_PROCESS_BLOCKED:                                            "ERROR_CHILD_PROCESS_BLOCKED",
+	ERROR_STORAGE_LOST_DATA_PERSISTENCE:                                    "ERROR_STORAGE_LOST_DATA_PERSISTENCE",
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE:                           "ERROR_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE",
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT:                      "ERROR_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT",
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_BUSY:                                  "ERROR_FILE_SYSTEM_VIRTUALIZATION_BUSY",
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN:                      "ERROR_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN",
+	ERROR_GDI_HANDLE_LEAK:                                                  "ERROR_GDI_HANDLE_LEAK",
+	ERROR_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS:                               "ERROR_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS",
+	ERROR_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED:                        "ERROR_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED",
+	ERROR_NOT_A_CLOUD_FILE:                                                 "ERROR_NOT_A_CLOUD_FILE",
+	ERROR_CLOUD_FILE_NOT_IN_SYNC:                                           "ERROR_CLOUD_FILE_NOT_IN_SYNC",
+	ERROR_CLOUD_FILE_ALREADY_CONNECTED:                                     "ERROR_CLOUD_FILE_ALREADY_CONNECTED",
+	ERROR_CLOUD_FILE_NOT_SUPPORTED:                                         "ERROR_CLOUD_FILE_NOT_SUPPORTED",
+	ERROR_CLOUD_FILE_INVALID_REQUEST:                                       "ERROR_CLOUD_FILE_INVALID_REQUEST",
+	ERROR_CLOUD_FILE_READ_ONLY_VOLUME:                                      "ERROR_CLOUD_FILE_READ_ONLY_VOLUME",
+	ERROR_CLOUD_FILE_CONNECTED_PROVIDER_ONLY:                               "ERROR_CLOUD_FILE_CONNECTED_PROVIDER_ONLY",
+	ERROR_CLOUD_FILE_VALIDATION_FAILED:                                     "ERROR_CLOUD_FILE_VALIDATION_FAILED",
+	ERROR_SMB1_NOT_AVAILABLE:                                               "ERROR_SMB1_NOT_AVAILABLE",
+	ERROR_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION:                     "ERROR_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION",
+	ERROR_CLOUD_FILE_AUTHENTICATION_FAILED:                                 "ERROR_CLOUD_FILE_AUTHENTICATION_FAILED",
+	ERROR_CLOUD_FILE_INSUFFICIENT_RESOURCES:                                "ERROR_CLOUD_FILE_INSUFFICIENT_RESOURCES",
+	ERROR_CLOUD_FILE_NETWORK_UNAVAILABLE:                                   "ERROR_CLOUD_FILE_NETWORK_UNAVAILABLE",
+	ERROR_CLOUD_FILE_UNSUCCESSFUL:                                          "ERROR_CLOUD_FILE_UNSUCCESSFUL",
+	ERROR_CLOUD_FILE_NOT_UNDER_SYNC_ROOT:                                   "ERROR_CLOUD_FILE_NOT_UNDER_SYNC_ROOT",
+	ERROR_CLOUD_FILE_IN_USE:                                                "ERROR_CLOUD_FILE_IN_USE",
+	ERROR_CLOUD_FILE_PINNED:                                                "ERROR_CLOUD_FILE_PINNED",
+	ERROR_CLOUD_FILE_REQUEST_ABORTED:                                       "ERROR_CLOUD_FILE_REQUEST_ABORTED",
+	ERROR_CLOUD_FILE_PROPERTY_CORRUPT:                                      "ERROR_CLOUD_FILE_PROPERTY_CORRUPT",
+	ERROR_CLOUD_FILE_ACCESS_DENIED:                                         "ERROR_CLOUD_FILE_ACCESS_DENIED",
+	ERROR_CLOUD_FILE_INCOMPATIBLE_HARDLINKS:                                "ERROR_CLOUD_FILE_INCOMPATIBLE_HARDLINKS",
+	ERROR_CLOUD_FILE_PROPERTY_LOCK_CONFLICT:                                "ERROR_CLOUD_FILE_PROPERTY_LOCK_CONFLICT",
+	ERROR_CLOUD_FILE_REQUEST_CANCELED:                                      "ERROR_CLOUD_FILE_REQUEST_CANCELED",
+	ERROR_EXTERNAL_SYSKEY_NOT_SUPPORTED:                                    "ERROR_EXTERNAL_SYSKEY_NOT_SUPPORTED",
+	ERROR_THREAD_MODE_ALREADY_BACKGROUND:                                   "ERROR_THREAD_MODE_ALREADY_BACKGROUND",
+	ERROR_THREAD_MODE_NOT_BACKGROUND:                                       "ERROR_THREAD_MODE_NOT_BACKGROUND",
+	ERROR_PROCESS_MODE_ALREADY_BACKGROUND:                                  "ERROR_PROCESS_MODE_ALREADY_BACKGROUND",
+	ERROR_PROCESS_MODE_NOT_BACKGROUND:                                      "ERROR_PROCESS_MODE_NOT_BACKGROUND",
+	ERROR_CLOUD_FILE_PROVIDER_TERMINATED:                                   "ERROR_CLOUD_FILE_PROVIDER_TERMINATED",
+	ERROR_NOT_A_CLOUD_SYNC_ROOT:                                            "ERROR_NOT_A_CLOUD_SYNC_ROOT",
+	ERROR_FILE_PROTECTED_UNDER_DPL:                                         "ERROR_FILE_PROTECTED_UNDER_DPL",
+	ERROR_VOLUME_NOT_CLUSTER_ALIGNED:                                       "ERROR_VOLUME_NOT_CLUSTER_ALIGNED",
+	ERROR_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND:                           "ERROR_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND",
+	ERROR_APPX_FILE_NOT_ENCRYPTED:                                          "ERROR_APPX_FILE_NOT_ENCRYPTED",
+	ERROR_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED:                               "ERROR_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED",
+	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET:                     "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET",
+	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE:                      "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE",
+	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER:                      "ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER",
+	ERROR_LINUX_SUBSYSTEM_NOT_PRESENT:                                      "ERROR_LINUX_SUBSYSTEM_NOT_PRESENT",
+	ERROR_FT_READ_FAILURE:                                                  "ERROR_FT_READ_FAILURE",
+	ERROR_STORAGE_RESERVE_ID_INVALID:                                       "ERROR_STORAGE_RESERVE_ID_INVALID",
+	ERROR_STORAGE_RESERVE_DOES_NOT_EXIST:                                   "ERROR_STORAGE_RESERVE_DOES_NOT_EXIST",
+	ERROR_STORAGE_RESERVE_ALREADY_EXISTS:                                   "ERROR_STORAGE_RESERVE_ALREADY_EXISTS",
+	ERROR_STORAGE_RESERVE_NOT_EMPTY:                                        "ERROR_STORAGE_RESERVE_NOT_EMPTY",
+	ERROR_NOT_A_DAX_VOLUME:                                                 "ERROR_NOT_A_DAX_VOLUME",
+	ERROR_NOT_DAX_MAPPABLE:                                                 "ERROR_NOT_DAX_MAPPABLE",
+	ERROR_TIME_SENSITIVE_THREAD:                                            "ERROR_TIME_SENSITIVE_THREAD",
+	ERROR_DPL_NOT_SUPPORTED_FOR_USER:                                       "ERROR_DPL_NOT_SUPPORTED_FOR_USER",
+	ERROR_CASE_DIFFERING_NAMES_IN_DIR:                                      "ERROR_CASE_DIFFERING_NAMES_IN_DIR",
+	ERROR_FILE_NOT_SUPPORTED:                                               "ERROR_FILE_NOT_SUPPORTED",
+	ERROR_CLOUD_FILE_REQUEST_TIMEOUT:                                       "ERROR_CLOUD_FILE_REQUEST_TIMEOUT",
+	ERROR_NO_TASK_QUEUE:                                                    "ERROR_NO_TASK_QUEUE",
+	ERROR_SRC_SRV_DLL_LOAD_FAILED:                                          "ERROR_SRC_SRV_DLL_LOAD_FAILED",
+	ERROR_NOT_SUPPORTED_WITH_BTT:                                           "ERROR_NOT_SUPPORTED_WITH_BTT",
+	ERROR_ENCRYPTION_DISABLED:                                              "ERROR_ENCRYPTION_DISABLED",
+	ERROR_ENCRYPTING_METADATA_DISALLOWED:                                   "ERROR_ENCRYPTING_METADATA_DISALLOWED",
+	ERROR_CANT_CLEAR_ENCRYPTION_FLAG:                                       "ERROR_CANT_CLEAR_ENCRYPTION_FLAG",
+	ERROR_NO_SUCH_DEVICE:                                                   "ERROR_NO_SUCH_DEVICE",
+	ERROR_CLOUD_FILE_DEHYDRATION_DISALLOWED:                                "ERROR_CLOUD_FILE_DEHYDRATION_DISALLOWED",
+	ERROR_FILE_SNAP_IN_PROGRESS:                                            "ERROR_FILE_SNAP_IN_PROGRESS",
+	ERROR_FILE_SNAP_USER_SECTION_NOT_SUPPORTED:                             "ERROR_FILE_SNAP_USER_SECTION_NOT_SUPPORTED",
+	ERROR_FILE_SNAP_MODIFY_NOT_SUPPORTED:                                   "ERROR_FILE_SNAP_MODIFY_NOT_SUPPORTED",
+	ERROR_FILE_SNAP_IO_NOT_COORDINATED:                                     "ERROR_FILE_SNAP_IO_NOT_COORDINATED",
+	ERROR_FILE_SNAP_UNEXPECTED_ERROR:                                       "ERROR_FILE_SNAP_UNEXPECTED_ERROR",
+	ERROR_FILE_SNAP_INVALID_PARAMETER:                                      "ERROR_FILE_SNAP_INVALID_PARAMETER",
+	ERROR_UNSATISFIED_DEPENDENCIES:                                         "ERROR_UNSATISFIED_DEPENDENCIES",
+	ERROR_CASE_SENSITIVE_PATH:                                              "ERROR_CASE_SENSITIVE_PATH",
+	ERROR_UNEXPECTED_NTCACHEMANAGER_ERROR:                                  "ERROR_UNEXPECTED_NTCACHEMANAGER_ERROR",
+	ERROR_LINUX_SUBSYSTEM_UPDATE_REQUIRED:                                  "ERROR_LINUX_SUBSYSTEM_UPDATE_REQUIRED",
+	ERROR_DLP_POLICY_WARNS_AGAINST_OPERATION:                               "ERROR_DLP_POLICY_WARNS_AGAINST_OPERATION",
+	ERROR_DLP_POLICY_DENIES_OPERATION:                                      "ERROR_DLP_POLICY_DENIES_OPERATION",
+	ERROR_SECURITY_DENIES_OPERATION:                                        "ERROR_SECURITY_DENIES_OPERATION",
+	ERROR_UNTRUSTED_MOUNT_POINT:                                            "ERROR_UNTRUSTED_MOUNT_POINT",
+	ERROR_DLP_POLICY_SILENTLY_FAIL:                                         "ERROR_DLP_POLICY_SILENTLY_FAIL",
+	ERROR_CAPAUTHZ_NOT_DEVUNLOCKED:                                         "ERROR_CAPAUTHZ_NOT_DEVUNLOCKED",
+	ERROR_CAPAUTHZ_CHANGE_TYPE:                                             "ERROR_CAPAUTHZ_CHANGE_TYPE",
+	ERROR_CAPAUTHZ_NOT_PROVISIONED:                                         "ERROR_CAPAUTHZ_NOT_PROVISIONED",
+	ERROR_CAPAUTHZ_NOT_AUTHORIZED:                                          "ERROR_CAPAUTHZ_NOT_AUTHORIZED",
+	ERROR_CAPAUTHZ_NO_POLICY:                                               "ERROR_CAPAUTHZ_NO_POLICY",
+	ERROR_CAPAUTHZ_DB_CORRUPTED:                                            "ERROR_CAPAUTHZ_DB_CORRUPTED",
+	ERROR_CAPAUTHZ_SCCD_INVALID_CATALOG:                                    "ERROR_CAPAUTHZ_SCCD_INVALID_CATALOG",
+	ERROR_CAPAUTHZ_SCCD_NO_AUTH_ENTITY:                                     "ERROR_CAPAUTHZ_SCCD_NO_AUTH_ENTITY",
+	ERROR_CAPAUTHZ_SCCD_PARSE_ERROR:                                        "ERROR_CAPAUTHZ_SCCD_PARSE_ERROR",
+	ERROR_CAPAUTHZ_SCCD_DEV_MODE_REQUIRED:                                  "ERROR_CAPAUTHZ_SCCD_DEV_MODE_REQUIRED",
+	ERROR_CAPAUTHZ_SCCD_NO_CAPABILITY_MATCH:                                "ERROR_CAPAUTHZ_SCCD_NO_CAPABILITY_MATCH",
+	ERROR_CIMFS_IMAGE_CORRUPT:                                              "ERROR_CIMFS_IMAGE_CORRUPT",
+	ERROR_CIMFS_IMAGE_VERSION_NOT_SUPPORTED:                                "ERROR_CIMFS_IMAGE_VERSION_NOT_SUPPORTED",
+	ERROR_STORAGE_STACK_ACCESS_DENIED:                                      "ERROR_STORAGE_STACK_ACCESS_DENIED",
+	ERROR_INSUFFICIENT_VIRTUAL_ADDR_RESOURCES:                              "ERROR_INSUFFICIENT_VIRTUAL_ADDR_RESOURCES",
+	ERROR_INDEX_OUT_OF_BOUNDS:                                              "ERROR_INDEX_OUT_OF_BOUNDS",
+	ERROR_CLOUD_FILE_US_MESSAGE_TIMEOUT:                                    "ERROR_CLOUD_FILE_US_MESSAGE_TIMEOUT",
+	ERROR_PNP_QUERY_REMOVE_DEVICE_TIMEOUT:                                  "ERROR_PNP_QUERY_REMOVE_DEVICE_TIMEOUT",
+	ERROR_PNP_QUERY_REMOVE_RELATED_DEVICE_TIMEOUT:                          "ERROR_PNP_QUERY_REMOVE_RELATED_DEVICE_TIMEOUT",
+	ERROR_PNP_QUERY_REMOVE_UNRELATED_DEVICE_TIMEOUT:                        "ERROR_PNP_QUERY_REMOVE_UNRELATED_DEVICE_TIMEOUT",
+	ERROR_DEVICE_HARDWARE_ERROR:                                            "ERROR_DEVICE_HARDWARE_ERROR",
+	ERROR_HAS_SYSTEM_CRITICAL_FILES:                                        "ERROR_HAS_SYSTEM_CRITICAL_FILES",
+	ERROR_ENCRYPTED_FILE_NOT_SUPPORTED:                                     "ERROR_ENCRYPTED_FILE_NOT_SUPPORTED",
+	ERROR_SPARSE_FILE_NOT_SUPPORTED:                                        "ERROR_SPARSE_FILE_NOT_SUPPORTED",
+	ERROR_PAGEFILE_NOT_SUPPORTED:                                           "ERROR_PAGEFILE_NOT_SUPPORTED",
+	ERROR_VOLUME_NOT_SUPPORTED:                                             "ERROR_VOLUME_NOT_SUPPORTED",
+	ERROR_NOT_SUPPORTED_WITH_BYPASSIO:                                      "ERROR_NOT_SUPPORTED_WITH_BYPASSIO",
+	ERROR_NO_BYPASSIO_DRIVER_SUPPORT:                                       "ERROR_NO_BYPASSIO_DRIVER_SUPPORT",
+	ERROR_NOT_SUPPORTED_WITH_ENCRYPTION:                                    "ERROR_NOT_SUPPORTED_WITH_ENCRYPTION",
+	ERROR_NOT_SUPPORTED_WITH_COMPRESSION:                                   "ERROR_NOT_SUPPORTED_WITH_COMPRESSION",
+	ERROR_NOT_SUPPORTED_WITH_REPLICATION:                                   "ERROR_NOT_SUPPORTED_WITH_REPLICATION",
+	ERROR_NOT_SUPPORTED_WITH_DEDUPLICATION:                                 "ERROR_NOT_SUPPORTED_WITH_DEDUPLICATION",
+	ERROR_NOT_SUPPORTED_WITH_AUDITING:                                      "ERROR_NOT_SUPPORTED_WITH_AUDITING",
+	ERROR_USER_PROFILE_LOAD:                                                "ERROR_USER_PROFILE_LOAD",
+	ERROR_SESSION_KEY_TOO_SHORT:                                            "ERROR_SESSION_KEY_TOO_SHORT",
+	ERROR_ACCESS_DENIED_APPDATA:                                            "ERROR_ACCESS_DENIED_APPDATA",
+	ERROR_NOT_SUPPORTED_WITH_MONITORING:                                    "ERROR_NOT_SUPPORTED_WITH_MONITORING",
+	ERROR_NOT_SUPPORTED_WITH_SNAPSHOT:                                      "ERROR_NOT_SUPPORTED_WITH_SNAPSHOT",
+	ERROR_NOT_SUPPORTED_WITH_VIRTUALIZATION:                                "ERROR_NOT_SUPPORTED_WITH_VIRTUALIZATION",
+	ERROR_BYPASSIO_FLT_NOT_SUPPORTED:                                       "ERROR_BYPASSIO_FLT_NOT_SUPPORTED",
+	ERROR_DEVICE_RESET_REQUIRED:                                            "ERROR_DEVICE_RESET_REQUIRED",
+	ERROR_VOLUME_WRITE_ACCESS_DENIED:                                       "ERROR_VOLUME_WRITE_ACCESS_DENIED",
+	ERROR_NOT_SUPPORTED_WITH_CACHED_HANDLE:                                 "ERROR_NOT_SUPPORTED_WITH_CACHED_HANDLE",
+	ERROR_FS_METADATA_INCONSISTENT:                                         "ERROR_FS_METADATA_INCONSISTENT",
+	ERROR_BLOCK_WEAK_REFERENCE_INVALID:                                     "ERROR_BLOCK_WEAK_REFERENCE_INVALID",
+	ERROR_BLOCK_SOURCE_WEAK_REFERENCE_INVALID:                              "ERROR_BLOCK_SOURCE_WEAK_REFERENCE_INVALID",
+	ERROR_BLOCK_TARGET_WEAK_REFERENCE_INVALID:                              "ERROR_BLOCK_TARGET_WEAK_REFERENCE_INVALID",
+	ERROR_BLOCK_SHARED:                                                     "ERROR_BLOCK_SHARED",
+	ERROR_VERIFIER_STOP:                                                    "ERROR_VERIFIER_STOP",
+	ERROR_ABIOS_ERROR:                                                      "ERROR_ABIOS_ERROR",
+	ERROR_WX86_WARNING:                                                     "ERROR_WX86_WARNING",
+	ERROR_WX86_ERROR:                                                       "ERROR_WX86_ERROR",
+	ERROR_TIMER_NOT_CANCELED:                                               "ERROR_TIMER_NOT_CANCELED",
+	ERROR_UNWIND:                                                           "ERROR_UNWIND",
+	ERROR_BAD_STACK:                                                        "ERROR_BAD_STACK",
+	ERROR_INVALID_UNWIND_TARGET:                                            "ERROR_INVALID_UNWIND_TARGET",
+	ERROR_INVALID_PORT_ATTRIBUTES:                                          "ERROR_INVALID_PORT_ATTRIBUTES",
+	ERROR_PORT_MESSAGE_TOO_LONG:                                            "ERROR_PORT_MESSAGE_TOO_LONG",
+	ERROR_INVALID_QUOTA_LOWER:                                              "ERROR_INVALID_QUOTA_LOWER",
+	ERROR_DEVICE_ALREADY_ATTACHED:                                          "ERROR_DEVICE_ALREADY_ATTACHED",
+	ERROR_INSTRUCTION_MISALIGNMENT:                                         "ERROR_INSTRUCTION_MISALIGNMENT",
+	ERROR_PROFILING_NOT_STARTED:                                            "ERROR_PROFILING_NOT_STARTED",
+	ERROR_PROFILING_NOT_STOPPED:                                            "ERROR_PROFILING_NOT_STOPPED",
+	ERROR_COULD_NOT_INTERPRET:                                              "ERROR_COULD_NOT_INTERPRET",
+	ERROR_PROFILING_AT_LIMIT:                                               "ERROR_PROFILING_AT_LIMIT",
+	ERROR_CANT_WAIT:                                                        "ERROR_CANT_WAIT",
+	ERROR_CANT_TERMINATE_SELF:                                              "ERROR_CANT_TERMINATE_SELF",
+	ERROR_UNEXPECTED_MM_CREATE_ERR:                                         "ERROR_UNEXPECTED_MM_CREATE_ERR",
+	ERROR_UNEXPECTED_MM_MAP_ERROR:                                          "ERROR_UNEXPECTED_MM_MAP_ERROR",
+	ERROR_UNEXPECTED_MM_EXTEND_ERR:                                         "ERROR_UNEXPECTED_MM_EXTEND_ERR",
+	ERROR_BAD_FUNCTION_TABLE:                                               "ERROR_BAD_FUNCTION_TABLE",
+	ERROR_NO_GUID_TRANSLATION:                                              "ERROR_NO_GUID_TRANSLATION",
+	ERROR_INVALID_LDT_SIZE:                                                 "ERROR_INVALID_LDT_SIZE",
+	ERROR_INVALID_LDT_OFFSET:                                               "ERROR_INVALID_LDT_OFFSET",
+	ERROR_INVALID_LDT_DESCRIPTOR:                                           "ERROR_INVALID_LDT_DESCRIPTOR",
+	ERROR_TOO_MANY_THREADS:                                                 "ERROR_TOO_MANY_THREADS",
+	ERROR_THREAD_NOT_IN_PROCESS:                                            "ERROR_THREAD_NOT_IN_PROCESS",
+	ERROR_PAGEFILE_QUOTA_EXCEEDED:                                          "ERROR_PAGEFILE_QUOTA_EXCEEDED",
+	ERROR_LOGON_SERVER_CONFLICT:                                            "ERROR_LOGON_SERVER_CONFLICT",
+	ERROR_SYNCHRONIZATION_REQUIRED:                                         "ERROR_SYNCHRONIZATION_REQUIRED",
+	ERROR_NET_OPEN_FAILED:                                                  "ERROR_NET_OPEN_FAILED",
+	ERROR_IO_PRIVILEGE_FAILED:                                              "ERROR_IO_PRIVILEGE_FAILED",
+	ERROR_CONTROL_C_EXIT:                                                   "ERROR_CONTROL_C_EXIT",
+	ERROR_MISSING_SYSTEMFILE:                                               "ERROR_MISSING_SYSTEMFILE",
+	ERROR_UNHANDLED_EXCEPTION:                                              "ERROR_UNHANDLED_EXCEPTION",
+	ERROR_APP_INIT_FAILURE:                                                 "ERROR_APP_INIT_FAILURE",
+	ERROR_PAGEFILE_CREATE_FAILED:                                           "ERROR_PAGEFILE_CREATE_FAILED",
+	ERROR_NO_PAGEFILE:                                                      "ERROR_NO_PAGEFILE",
+	ERROR_ILLEGAL_FLOAT_CONTEXT:                                            "ERROR_ILLEGAL_FLOAT_CONTEXT",
+	ERROR_NO_EVENT_PAIR:                                                    "ERROR_NO_EVENT_PAIR",
+	ERROR_DOMAIN_CTRLR_CONFIG_ERROR:                                        "ERROR_DOMAIN_CTRLR_CONFIG_ERROR",
+	ERROR_ILLEGAL_CHARACTER:                                                "ERROR_ILLEGAL_CHARACTER",
+	ERROR_UNDEFINED_CHARACTER:                                              "ERROR_UNDEFINED_CHARACTER",
+	ERROR_FLOPPY_VOLUME:                                                    "ERROR_FLOPPY_VOLUME",
+	ERROR_BIOS_FAILED_TO_CONNECT_INTERRUPT:                                 "ERROR_BIOS_FAILED_TO_CONNECT_INTERRUPT",
+	ERROR_BACKUP_CONTROLLER:                                                "ERROR_BACKUP_CONTROLLER",
+	ERROR_MUTANT_LIMIT_EXCEEDED:                                            "ERROR_MUTANT_LIMIT_EXCEEDED",
+	ERROR_FS_DRIVER_REQUIRED:                                               "ERROR_FS_DRIVER_REQUIRED",
+	ERROR_CANNOT_LOAD_REGISTRY_FILE:                                        "ERROR_CANNOT_LOAD_REGISTRY_FILE",
+	ERROR_DEBUG_ATTACH_FAILED:                                              "ERROR_DEBUG_ATTACH_FAILED",
+	ERROR_SYSTEM_PROCESS_TERMINATED:                                        "ERROR_SYSTEM_PROCESS_TERMINATED",
+	ERROR_DATA_NOT_ACCEPTED:                                                "ERROR_DATA_NOT_ACCEPTED",
+	ERROR_VDM_HARD_ERROR:                                                   "ERROR_VDM_HARD_ERROR",
+	ERROR_DRIVER_CANCEL_TIMEOUT:                                            "ERROR_DRIVER_CANCEL_TIMEOUT",
+	ERROR_REPLY_MESSAGE_MISMATCH:                                           "ERROR_REPLY_MESSAGE_MISMATCH",
+	ERROR_LOST_WRITEBEHIND_DATA:                                            "ERROR_LOST_WRITEBEHIND_DATA",
+	ERROR_CLIENT_SERVER_PARAMETERS_INVALID:                                 "ERROR_CLIENT_SERVER_PARAMETERS_INVALID",
+	ERROR_NOT_TINY_STREAM:                                                  "ERROR_NOT_TINY_STREAM",
+	ERROR_STACK_OVERFLOW_READ:                                              "ERROR_STACK_OVERFLOW_READ",
+	ERROR_CONVERT_TO_LARGE:                                                 "ERROR_CONVERT_TO_LARGE",
+	ERROR_FOUND_OUT_OF_SCOPE:                                               "ERROR_FOUND_OUT_OF_SCOPE",
+	ERROR_ALLOCATE_BUCKET:                                                  "ERROR_ALLOCATE_BUCKET",
+	ERROR_MARSHALL_OVERFLOW:                                                "ERROR_MARSHALL_OVERFLOW",
+	ERROR_INVALID_VARIANT:                                                  "ERROR_INVALID_VARIANT",
+	ERROR_BAD_COMPRESSION_BUFFER:                                           "ERROR_BAD_COMPRESSION_BUFFER",
+	ERROR_AUDIT_FAILED:                                                     "ERROR_AUDIT_FAILED",
+	ERROR_TIMER_RESOLUTION_NOT_SET:                                         "ERROR_TIMER_RESOLUTION_NOT_SET",
+	ERROR_INSUFFICIENT_LOGON_INFO:                                          "ERROR_INSUFFICIENT_LOGON_INFO",
+	ERROR_BAD_DLL_ENTRYPOINT:                                               "ERROR_BAD_DLL_ENTRYPOINT",
+	ERROR_BAD_SERVICE_ENTRYPOINT:                                           "ERROR_BAD_SERVICE_ENTRYPOINT",
+	ERROR_IP_ADDRESS_CONFLICT1:                                             "ERROR_IP_ADDRESS_CONFLICT1",
+	ERROR_IP_ADDRESS_CONFLICT2:                                             "ERROR_IP_ADDRESS_CONFLICT2",
+	ERROR_REGISTRY_QUOTA_LIMIT:                                             "ERROR_REGISTRY_QUOTA_LIMIT",
+	ERROR_NO_CALLBACK_ACTIVE:                                               "ERROR_NO_CALLBACK_ACTIVE",
+	ERROR_PWD_TOO_SHORT:                                                    "ERROR_PWD_TOO_SHORT",
+	ERROR_PWD_TOO_RECENT:                                                   "ERROR_PWD_TOO_RECENT",
+	ERROR_PWD_HISTORY_CONFLICT:                                             "ERROR_PWD_HISTORY_CONFLICT",
+	ERROR_UNSUPPORTED_COMPRESSION:                                          "ERROR_UNSUPPORTED_COMPRESSION",
+	ERROR_INVALID_HW_PROFILE:                                               "ERROR_INVALID_HW_PROFILE",
+	ERROR_INVALID_PLUGPLAY_DEVICE_PATH:                                     "ERROR_INVALID_PLUGPLAY_DEVICE_PATH",
+	ERROR_QUOTA_LIST_INCONSISTENT:                                          "ERROR_QUOTA_LIST_INCONSISTENT",
+	ERROR_EVALUATION_EXPIRATION:                                            "ERROR_EVALUATION_EXPIRATION",
+	ERROR_ILLEGAL_DLL_RELOCATION:                                           "ERROR_ILLEGAL_DLL_RELOCATION",
+	ERROR_DLL_INIT_FAILED_LOGOFF:                                           "ERROR_DLL_INIT_FAILED_LOGOFF",
+	ERROR_VALIDATE_CONTINUE:                                                "ERROR_VALIDATE_CONTINUE",
+	ERROR_NO_MORE_MATCHES:                                                  "ERROR_NO_MORE_MATCHES",
+	ERROR_RANGE_LIST_CONFLICT:                                              "ERROR_RANGE_LIST_CONFLICT",
+	ERROR_SERVER_SID_MISMATCH:                                              "ERROR_SERVER_SID_MISMATCH",
+	ERROR_CANT_ENABLE_DENY_ONLY:                                            "ERROR_CANT_ENABLE_DENY_ONLY",
+	ERROR_FLOAT_MULTIPLE_FAULTS:                                            "ERROR_FLOAT_MULTIPLE_FAULTS",
+	ERROR_FLOAT_MULTIPLE_TRAPS:                                             "ERROR_FLOAT_MULTIPLE_TRAPS",
+	ERROR_NOINTERFACE:                                                      "ERROR_NOINTERFACE",
+	ERROR_DRIVER_FAILED_SLEEP:                                              "ERROR_DRIVER_FAILED_SLEEP",
+	ERROR_CORRUPT_SYSTEM_FILE:                                              "ERROR_CORRUPT_SYSTEM_FILE",
+	ERROR_COMMITMENT_MINIMUM:                                               "ERROR_COMMITMENT_MINIMUM",
+	ERROR_PNP_RESTART_ENUMERATION:                                          "ERROR_PNP_RESTART_ENUMERATION",
+	ERROR_SYSTEM_IMAGE_BAD_SIGNATURE:                                       "ERROR_SYSTEM_IMAGE_BAD_SIGNATURE",
+	ERROR_PNP_REBOOT_REQUIRED:                                              "ERROR_PNP_REBOOT_REQUIRED",
+	ERROR_INSUFFICIENT_POWER:                                               "ERROR_INSUFFICIENT_POWER",
+	ERROR_MULTIPLE_FAULT_VIOLATION:                                         "ERROR_MULTIPLE_FAULT_VIOLATION",
+	ERROR_SYSTEM_SHUTDOWN:                                                  "ERROR_SYSTEM_SHUTDOWN",
+	ERROR_PORT_NOT_SET:                                                     "ERROR_PORT_NOT_SET",
+	ERROR_DS_VERSION_CHECK_FAILURE:                                         "ERROR_DS_VERSION_CHECK_FAILURE",
+	ERROR_RANGE_NOT_FOUND:                                                  "ERROR_RANGE_NOT_FOUND",
+	ERROR_NOT_SAFE_MODE_DRIVER:                                             "ERROR_NOT_SAFE_MODE_DRIVER",
+	ERROR_FAILED_DRIVER_ENTRY:                                              "ERROR_FAILED_DRIVER_ENTRY",
+	ERROR_DEVICE_ENUMERATION_ERROR:                                         "ERROR_DEVICE_ENUMERATION_ERROR",
+	ERROR_MOUNT_POINT_NOT_RESOLVED:                                         "ERROR_MOUNT_POINT_NOT_RESOLVED",
+	ERROR_INVALID_DEVICE_OBJECT_PARAMETER:                                  "ERROR_INVALID_DEVICE_OBJECT_PARAMETER",
+	ERROR_MCA_OCCURED:                                                      "ERROR_MCA_OCCURED",
+	ERROR_DRIVER_DATABASE_ERROR:                                            "ERROR_DRIVER_DATABASE_ERROR",
+	ERROR_SYSTEM_HIVE_TOO_LARGE:                                            "ERROR_SYSTEM_HIVE_TOO_LARGE",
+	ERROR_DRIVER_FAILED_PRIOR_UNLOAD:                                       "ERROR_DRIVER_FAILED_PRIOR_UNLOAD",
+	ERROR_VOLSNAP_PREPARE_HIBERNATE:                                        "ERROR_VOLSNAP_PREPARE_HIBERNATE",
+	ERROR_HIBERNATION_FAILURE:                                              "ERROR_HIBERNATION_FAILURE",
+	ERROR_PWD_TOO_LONG:                                                     "ERROR_PWD_TOO_LONG",
+	ERROR_FILE_SYSTEM_LIMITATION:                                           "ERROR_FILE_SYSTEM_LIMITATION",
+	ERROR_ASSERTION_FAILURE:                                                "ERROR_ASSERTION_FAILURE",
+	ERROR_ACPI_ERROR:                                                       "ERROR_ACPI_ERROR",
+	ERROR_WOW_ASSERTION:                                                    "ERROR_WOW_ASSERTION",
+	ERROR_PNP_BAD_MPS_TABLE:                                                "ERROR_PNP_BAD_MPS_TABLE",
+	ERROR_PNP_TRANSLATION_FAILED:                                           "ERROR_PNP_TRANSLATION_FAILED",
+	ERROR_PNP_IRQ_TRANSLATION_FAILED:                                       "ERROR_PNP_IRQ_TRANSLATION_FAILED",
+	ERROR_PNP_INVALID_ID:                                                   "ERROR_PNP_INVALID_ID",
+	ERROR_WAKE_SYSTEM_DEBUGGER:                                             "ERROR_WAKE_SYSTEM_DEBUGGER",
+	ERROR_HANDLES_CLOSED:                                                   "ERROR_HANDLES_CLOSED",
+	ERROR_EXTRANEOUS_INFORMATION:                                           "ERROR_EXTRANEOUS_INFORMATION",
+	ERROR_RXACT_COMMIT_NECESSARY:                                           "ERROR_RXACT_COMMIT_NECESSARY",
+	ERROR_MEDIA_CHECK:                                                      "ERROR_MEDIA_CHECK",
+	ERROR_GUID_SUBSTITUTION_MADE:                                           "ERROR_GUID_SUBSTITUTION_MADE",
+	ERROR_STOPPED_ON_SYMLINK:                                               "ERROR_STOPPED_ON_SYMLINK",
+	ERROR_LONGJUMP:                                                         "ERROR_LONGJUMP",
+	ERROR_PLUGPLAY_QUERY_VETOED:                                            "ERROR_PLUGPLAY_QUERY_VETOED",
+	ERROR_UNWIND_CONSOLIDATE:                                               "ERROR_UNWIND_CONSOLIDATE",
+	ERROR_REGISTRY_HIVE_RECOVERED:                                          "ERROR_REGISTRY_HIVE_RECOVERED",
+	ERROR_DLL_MIGHT_BE_INSECURE:                                            "ERROR_DLL_MIGHT_BE_INSECURE",
+	ERROR_DLL_MIGHT_BE_INCOMPATIBLE:                                        "ERROR_DLL_MIGHT_BE_INCOMPATIBLE",
+	ERROR_DBG_EXCEPTION_NOT_HANDLED:                                        "ERROR_DBG_EXCEPTION_NOT_HANDLED",
+	ERROR_DBG_REPLY_LATER:                                                  "ERROR_DBG_REPLY_LATER",
+	ERROR_DBG_UNABLE_TO_PROVIDE_HANDLE:                                     "ERROR_DBG_UNABLE_TO_PROVIDE_HANDLE",
+	ERROR_DBG_TERMINATE_THREAD:                                             "ERROR_DBG_TERMINATE_THREAD",
+	ERROR_DBG_TERMINATE_PROCESS:                                            "ERROR_DBG_TERMINATE_PROCESS",
+	ERROR_DBG_CONTROL_C:                                                    "ERROR_DBG_CONTROL_C",
+	ERROR_DBG_PRINTEXCEPTION_C:                                             "ERROR_DBG_PRINTEXCEPTION_C",
+	ERROR_DBG_RIPEXCEPTION:                                                 "ERROR_DBG_RIPEXCEPTION",
+	ERROR_DBG_CONTROL_BREAK:                                                "ERROR_DBG_CONTROL_BREAK",
+	ERROR_DBG_COMMAND_EXCEPTION:                                            "ERROR_DBG_COMMAND_EXCEPTION",
+	ERROR_OBJECT_NAME_EXISTS:                                               "ERROR_OBJECT_NAME_EXISTS",
+	ERROR_THREAD_WAS_SUSPENDED:                                             "ERROR_THREAD_WAS_SUSPENDED",
+	ERROR_IMAGE_NOT_AT_BASE:                                                "ERROR_IMAGE_NOT_AT_BASE",
+	ERROR_RXACT_STATE_CREATED:                                              "ERROR_RXACT_STATE_CREATED",
+	ERROR_SEGMENT_NOTIFICATION:                                             "ERROR_SEGMENT_NOTIFICATION",
+	ERROR_BAD_CURRENT_DIRECTORY:                                            "ERROR_BAD_CURRENT_DIRECTORY",
+	ERROR_FT_READ_RECOVERY_FROM_BACKUP:                                     "ERROR_FT_READ_RECOVERY_FROM_BACKUP",
+	ERROR_FT_WRITE_RECOVERY:                                                "ERROR_FT_WRITE_RECOVERY",
+	ERROR_IMAGE_MACHINE_TYPE_MISMATCH:                                      "ERROR_IMAGE_MACHINE_TYPE_MISMATCH",
+	ERROR_RECEIVE_PARTIAL:                                                  "ERROR_RECEIVE_PARTIAL",
+	ERROR_RECEIVE_EXPEDITED:                                                "ERROR_RECEIVE_EXPEDITED",
+	ERROR_RECEIVE_PARTIAL_EXPEDITED:                                        "ERROR_RECEIVE_PARTIAL_EXPEDITED",
+	ERROR_EVENT_DONE:                                                       "ERROR_EVENT_DONE",
+	ERROR_EVENT_PENDING:                                                    "ERROR_EVENT_PENDING",
+	ERROR_CHECKING_FILE_SYSTEM:                                             "ERROR_CHECKING_FILE_SYSTEM",
+	ERROR_FATAL_APP_EXIT:                                                   "ERROR_FATAL_APP_EXIT",
+	ERROR_PREDEFINED_HANDLE:                                                "ERROR_PREDEFINED_HANDLE",
+	ERROR_WAS_UNLOCKED:                                                     "ERROR_WAS_UNLOCKED",
+	ERROR_SERVICE_NOTIFICATION:                                             "ERROR_SERVICE_NOTIFICATION",
+	ERROR_WAS_LOCKED:                                                       "ERROR_WAS_LOCKED",
+	ERROR_LOG_HARD_ERROR:                                                   "ERROR_LOG_HARD_ERROR",
+	ERROR_ALREADY_WIN32:                                                    "ERROR_ALREADY_WIN32",
+	ERROR_IMAGE_MACHINE_TYPE_MISMATCH_EXE:                                  "ERROR_IMAGE_MACHINE_TYPE_MISMATCH_EXE",
+	ERROR_NO_YIELD_PERFORMED:                                               "ERROR_NO_YIELD_PERFORMED",
+	ERROR_TIMER_RESUME_IGNORED:                                             "ERROR_TIMER_RESUME_IGNORED",
+	ERROR_ARBITRATION_UNHANDLED:                                            "ERROR_ARBITRATION_UNHANDLED",
+	ERROR_CARDBUS_NOT_SUPPORTED:                                            "ERROR_CARDBUS_NOT_SUPPORTED",
+	ERROR_MP_PROCESSOR_MISMATCH:                                            "ERROR_MP_PROCESSOR_MISMATCH",
+	ERROR_HIBERNATED:                                                       "ERROR_HIBERNATED",
+	ERROR_RESUME_HIBERNATION:                                               "ERROR_RESUME_HIBERNATION",
+	ERROR_FIRMWARE_UPDATED:                                                 "ERROR_FIRMWARE_UPDATED",
+	ERROR_DRIVERS_LEAKING_LOCKED_PAGES:                                     "ERROR_DRIVERS_LEAKING_LOCKED_PAGES",
+	ERROR_WAKE_SYSTEM:                                                      "ERROR_WAKE_SYSTEM",
+	ERROR_WAIT_1:                                                           "ERROR_WAIT_1",
+	ERROR_WAIT_2:                                                           "ERROR_WAIT_2",
+	ERROR_WAIT_3:                                                           "ERROR_WAIT_3",
+	ERROR_WAIT_63:                                                          "ERROR_WAIT_63",
+	ERROR_ABANDONED_WAIT_0:                                                 "ERROR_ABANDONED_WAIT_0",
+	ERROR_ABANDONED_WAIT_63:                                                "ERROR_ABANDONED_WAIT_63",
+	ERROR_USER_APC:                                                         "ERROR_USER_APC",
+	ERROR_KERNEL_APC:                                                       "ERROR_KERNEL_APC",
+	ERROR_ALERTED:                                                          "ERROR_ALERTED",
+	ERROR_REPARSE:                                                          "ERROR_REPARSE",
+	ERROR_OPLOCK_BREAK_IN_PROGRESS:                                         "ERROR_OPLOCK_BREAK_IN_PROGRESS",
+	ERROR_VOLUME_MOUNTED:                                                   "ERROR_VOLUME_MOUNTED",
+	ERROR_RXACT_COMMITTED:                                                  "ERROR_RXACT_COMMITTED",
+	ERROR_NOTIFY_CLEANUP:                                                   "ERROR_NOTIFY_CLEANUP",
+	ERROR_PRIMARY_TRANSPORT_CONNECT_FAILED:                                 "ERROR_PRIMARY_TRANSPORT_CONNECT_FAILED",
+	ERROR_PAGE_FAULT_TRANSITION:                                            "ERROR_PAGE_FAULT_TRANSITION",
+	ERROR_PAGE_FAULT_DEMAND_ZERO:                                           "ERROR_PAGE_FAULT_DEMAND_ZERO",
+	ERROR_PAGE_FAULT_COPY_ON_WRITE:                                         "ERROR_PAGE_FAULT_COPY_ON_WRITE",
+	ERROR_PAGE_FAULT_GUARD_PAGE:                                            "ERROR_PAGE_FAULT_GUARD_PAGE",
+	ERROR_PAGE_FAULT_PAGING_FILE:                                           "ERROR_PAGE_FAULT_PAGING_FILE",
+	ERROR_CACHE_PAGE_LOCKED:                                                "ERROR_CACHE_PAGE_LOCKED",
+	ERROR_CRASH_DUMP:                                                       "ERROR_CRASH_DUMP",
+	ERROR_BUFFER_ALL_ZEROS:                                                 "ERROR_BUFFER_ALL_ZEROS",
+	ERROR_REPARSE_OBJECT:                                                   "ERROR_REPARSE_OBJECT",
+	ERROR_RESOURCE_REQUIREMENTS_CHANGED:                                    "ERROR_RESOURCE_REQUIREMENTS_CHANGED",
+	ERROR_TRANSLATION_COMPLETE:                                             "ERROR_TRANSLATION_COMPLETE",
+	ERROR_NOTHING_TO_TERMINATE:                                             "ERROR_NOTHING_TO_TERMINATE",
+	ERROR_PROCESS_NOT_IN_JOB:                                               "ERROR_PROCESS_NOT_IN_JOB",
+	ERROR_PROCESS_IN_JOB:                                                   "ERROR_PROCESS_IN_JOB",
+	ERROR_VOLSNAP_HIBERNATE_READY:                                          "ERROR_VOLSNAP_HIBERNATE_READY",
+	ERROR_FSFILTER_OP_COMPLETED_SUCCESSFULLY:                               "ERROR_FSFILTER_OP_COMPLETED_SUCCESSFULLY",
+	ERROR_INTERRUPT_VECTOR_ALREADY_CONNECTED:                               "ERROR_INTERRUPT_VECTOR_ALREADY_CONNECTED",
+	ERROR_INTERRUPT_STILL_CONNECTED:                                        "ERROR_INTERRUPT_STILL_CONNECTED",
+	ERROR_WAIT_FOR_OPLOCK:                                                  "ERROR_WAIT_FOR_OPLOCK",
+	ERROR_DBG_EXCEPTION_HANDLED:                                            "ERROR_DBG_EXCEPTION_HANDLED",
+	ERROR_DBG_CONTINUE:                                                     "ERROR_DBG_CONTINUE",
+	ERROR_CALLBACK_POP_STACK:                                               "ERROR_CALLBACK_POP_STACK",
+	ERROR_COMPRESSION_DISABLED:                                             "ERROR_COMPRESSION_DISABLED",
+	ERROR_CANTFETCHBACKWARDS:                                               "ERROR_CANTFETCHBACKWARDS",
+	ERROR_CANTSCROLLBACKWARDS:                                              "ERROR_CANTSCROLLBACKWARDS",
+	ERROR_ROWSNOTRELEASED:                                                  "ERROR_ROWSNOTRELEASED",
+	ERROR_BAD_ACCESSOR_FLAGS:                                               "ERROR_BAD_ACCESSOR_FLAGS",
+	ERROR_ERRORS_ENCOUNTERED:                                               "ERROR_ERRORS_ENCOUNTERED",
+	ERROR_NOT_CAPABLE:                                                      "ERROR_NOT_CAPABLE",
+	ERROR_REQUEST_OUT_OF_SEQUENCE:                                          "ERROR_REQUEST_OUT_OF_SEQUENCE",
+	ERROR_VERSION_PARSE_ERROR:                                              "ERROR_VERSION_PARSE_ERROR",
+	ERROR_BADSTARTPOSITION:                                                 "ERROR_BADSTARTPOSITION",
+	ERROR_MEMORY_HARDWARE:                                                  "ERROR_MEMORY_HARDWARE",
+	ERROR_DISK_REPAIR_DISABLED:                                             "ERROR_DISK_REPAIR_DISABLED",
+	ERROR_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE:          "ERROR_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE",
+	ERROR_SYSTEM_POWERSTATE_TRANSITION:                                     "ERROR_SYSTEM_POWERSTATE_TRANSITION",
+	ERROR_SYSTEM_POWERSTATE_COMPLEX_TRANSITION:                             "ERROR_SYSTEM_POWERSTATE_COMPLEX_TRANSITION",
+	ERROR_MCA_EXCEPTION:                                                    "ERROR_MCA_EXCEPTION",
+	ERROR_ACCESS_AUDIT_BY_POLICY:                                           "ERROR_ACCESS_AUDIT_BY_POLICY",
+	ERROR_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY:                            "ERROR_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY",
+	ERROR_ABANDON_HIBERFILE:                                                "ERROR_ABANDON_HIBERFILE",
+	ERROR_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED:                       "ERROR_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED",
+	ERROR_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR:                       "ERROR_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR",
+	ERROR_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR:                           "ERROR_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR",
+	ERROR_BAD_MCFG_TABLE:                                                   "ERROR_BAD_MCFG_TABLE",
+	ERROR_DISK_REPAIR_REDIRECTED:                                           "ERROR_DISK_REPAIR_REDIRECTED",
+	ERROR_DISK_REPAIR_UNSUCCESSFUL:                                         "ERROR_DISK_REPAIR_UNSUCCESSFUL",
+	ERROR_CORRUPT_LOG_OVERFULL:                                             "ERROR_CORRUPT_LOG_OVERFULL",
+	ERROR_CORRUPT_LOG_CORRUPTED:                                            "ERROR_CORRUPT_LOG_CORRUPTED",
+	ERROR_CORRUPT_LOG_UNAVAILABLE:                                          "ERROR_CORRUPT_LOG_UNAVAILABLE",
+	ERROR_CORRUPT_LOG_DELETED_FULL:                                         "ERROR_CORRUPT_LOG_DELETED_FULL",
+	ERROR_CORRUPT_LOG_CLEARED:                                              "ERROR_CORRUPT_LOG_CLEARED",
+	ERROR_ORPHAN_NAME_EXHAUSTED:                                            "ERROR_ORPHAN_NAME_EXHAUSTED",
+	ERROR_OPLOCK_SWITCHED_TO_NEW_HANDLE:                                    "ERROR_OPLOCK_SWITCHED_TO_NEW_HANDLE",
+	ERROR_CANNOT_GRANT_REQUESTED_OPLOCK:                                    "ERROR_CANNOT_GRANT_REQUESTED_OPLOCK",
+	ERROR_CANNOT_BREAK_OPLOCK:                                              "ERROR_CANNOT_BREAK_OPLOCK",
+	ERROR_OPLOCK_HANDLE_CLOSED:                                             "ERROR_OPLOCK_HANDLE_CLOSED",
+	ERROR_NO_ACE_CONDITION:                                                 "ERROR_NO_ACE_CONDITION",
+	ERROR_INVALID_ACE_CONDITION:                                            "ERROR_INVALID_ACE_CONDITION",
+	ERROR_FILE_HANDLE_REVOKED:                                              "ERROR_FILE_HANDLE_REVOKED",
+	ERROR_IMAGE_AT_DIFFERENT_BASE:                                          "ERROR_IMAGE_AT_DIFFERENT_BASE",
+	ERROR_ENCRYPTED_IO_NOT_POSSIBLE:                                        "ERROR_ENCRYPTED_IO_NOT_POSSIBLE",
+	ERROR_FILE_METADATA_OPTIMIZATION_IN_PROGRESS:                           "ERROR_FILE_METADATA_OPTIMIZATION_IN_PROGRESS",
+	ERROR_QUOTA_ACTIVITY:                                                   "ERROR_QUOTA_ACTIVITY",
+	ERROR_HANDLE_REVOKED:                                                   "ERROR_HANDLE_REVOKED",
+	ERROR_CALLBACK_INVOKE_INLINE:                                           "ERROR_CALLBACK_INVOKE_INLINE",
+	ERROR_CPU_SET_INVALID:                                                  "ERROR_CPU_SET_INVALID",
+	ERROR_ENCLAVE_NOT_TERMINATED:                                           "ERROR_ENCLAVE_NOT_TERMINATED",
+	ERROR_ENCLAVE_VIOLATION:                                                "ERROR_ENCLAVE_VIOLATION",
+	ERROR_SERVER_TRANSPORT_CONFLICT:                                        "ERROR_SERVER_TRANSPORT_CONFLICT",
+	ERROR_CERTIFICATE_VALIDATION_PREFERENCE_CONFLICT:                       "ERROR_CERTIFICATE_VALIDATION_PREFERENCE_CONFLICT",
+	ERROR_FT_READ_FROM_COPY_FAILURE:                                        "ERROR_FT_READ_FROM_COPY_FAILURE",
+	ERROR_SECTION_DIRECT_MAP_ONLY:                                          "ERROR_SECTION_DIRECT_MAP_ONLY",
+	ERROR_SERVICE_NOT_IN_EXE:                                               "ERROR_SERVICE_NOT_IN_EXE",
+	ERROR_NOT_SAFEBOOT_SERVICE:                                             "ERROR_NOT_SAFEBOOT_SERVICE",
+	ERROR_UNABLE_TO_REMOVE_REPLACED:                                        "ERROR_UNABLE_TO_REMOVE_REPLACED",
+	ERROR_UNABLE_TO_MOVE_REPLACEMENT:                                       "ERROR_UNABLE_TO_MOVE_REPLACEMENT",
+	ERROR_UNABLE_TO_MOVE_REPLACEMENT_2:                                     "ERROR_UNABLE_TO_MOVE_REPLACEMENT_2",
+	ERROR_JOURNAL_DELETE_IN_PROGRESS:                                       "ERROR_JOURNAL_DELETE_IN_PROGRESS",
+	ERROR_JOURNAL_NOT_ACTIVE:                                               "ERROR_JOURNAL_NOT_ACTIVE",
+	ERROR_POTENTIAL_FILE_FOUND:                                             "ERROR_POTENTIAL_FILE_FOUND",
+	ERROR_JOURNAL_ENTRY_DELETED:                                            "ERROR_JOURNAL_ENTRY_DELETED",
+	ERROR_PARTITION_TERMINATING:                                            "ERROR_PARTITION_TERMINATING",
+	ERROR_SHUTDOWN_IS_SCHEDULED:                                            "ERROR_SHUTDOWN_IS_SCHEDULED",
+	ERROR_SHUTDOWN_USERS_LOGGED_ON:                                         "ERROR_SHUTDOWN_USERS_LOGGED_ON",
+	ERROR_SHUTDOWN_DISKS_NOT_IN_MAINTENANCE_MODE:                           "ERROR_SHUTDOWN_DISKS_NOT_IN_MAINTENANCE_MODE",
+	ERROR_ONLY_IF_CONNECTED:                                                "ERROR_ONLY_IF_CONNECTED",
+	ERROR_OVERRIDE_NOCHANGES:                                               "ERROR_OVERRIDE_NOCHANGES",
+	ERROR_BAD_USER_PROFILE:                                                 "ERROR_BAD_USER_PROFILE",
+	ERROR_NOT_SUPPORTED_ON_SBS:                                             "ERROR_NOT_SUPPORTED_ON_SBS",
+	ERROR_SERVER_SHUTDOWN_IN_PROGRESS:                                      "ERROR_SERVER_SHUTDOWN_IN_PROGRESS",
+	ERROR_HOST_DOWN:                                                        "ERROR_HOST_DOWN",
+	ERROR_NON_ACCOUNT_SID:                                                  "ERROR_NON_ACCOUNT_SID",
+	ERROR_NON_DOMAIN_SID:                                                   "ERROR_NON_DOMAIN_SID",
+	ERROR_APPHELP_BLOCK:                                                    "ERROR_APPHELP_BLOCK",
+	ERROR_ACCESS_DISABLED_BY_POLICY:                                        "ERROR_ACCESS_DISABLED_BY_POLICY",
+	ERROR_REG_NAT_CONSUMPTION:                                              "ERROR_REG_NAT_CONSUMPTION",
+	ERROR_CSCSHARE_OFFLINE:                                                 "ERROR_CSCSHARE_OFFLINE",
+	ERROR_PKINIT_FAILURE:                                                   "ERROR_PKINIT_FAILURE",
+	ERROR_SMARTCARD_SUBSYSTEM_FAILURE:                                      "ERROR_SMARTCARD_SUBSYSTEM_FAILURE",
+	ERROR_DOWNGRADE_DETECTED:                                               "ERROR_DOWNGRADE_DETECTED",
+	ERROR_MACHINE_LOCKED:                                                   "ERROR_MACHINE_LOCKED",
+	ERROR_CALLBACK_SUPPLIED_INVALID_DATA:                                   "ERROR_CALLBACK_SUPPLIED_INVALID_DATA",
+	ERROR_SYNC_FOREGROUND_REFRESH_REQUIRED:                                 "ERROR_SYNC_FOREGROUND_REFRESH_REQUIRED",
+	ERROR_DRIVER_BLOCKED:                                                   "ERROR_DRIVER_BLOCKED",
+	ERROR_INVALID_IMPORT_OF_NON_DLL:                                        "ERROR_INVALID_IMPORT_OF_NON_DLL",
+	ERROR_ACCESS_DISABLED_WEBBLADE:                                         "ERROR_ACCESS_DISABLED_WEBBLADE",
+	ERROR_ACCESS_DISABLED_WEBBLADE_TAMPER:                                  "ERROR_ACCESS_DISABLED_WEBBLADE_TAMPER",
+	ERROR_RECOVERY_FAILURE:                                                 "ERROR_RECOVERY_FAILURE",
+	ERROR_ALREADY_FIBER:                                                    "ERROR_ALREADY_FIBER",
+	ERROR_ALREADY_THREAD:                                                   "ERROR_ALREADY_THREAD",
+	ERROR_STACK_BUFFER_OVERRUN:                                             "ERROR_STACK_BUFFER_OVERRUN",
+	ERROR_PARAMETER_QUOTA_EXCEEDED:                                         "ERROR_PARAMETER_QUOTA_EXCEEDED",
+	ERROR_DEBUGGER_INACTIVE:                                                "ERROR_DEBUGGER_INACTIVE",
+	ERROR_DELAY_LOAD_FAILED:                                                "ERROR_DELAY_LOAD_FAILED",
+	ERROR_VDM_DISALLOWED:                                                   "ERROR_VDM_DISALLOWED",
+	ERROR_UNIDENTIFIED_ERROR:                                               "ERROR_UNIDENTIFIED_ERROR",
+	ERROR_INVALID_CRUNTIME_PARAMETER:                                       "ERROR_INVALID_CRUNTIME_PARAMETER",
+	ERROR_BEYOND_VDL:                                                       "ERROR_BEYOND_VDL",
+	ERROR_INCOMPATIBLE_SERVICE_SID_TYPE:                                    "ERROR_INCOMPATIBLE_SERVICE_SID_TYPE",
+	ERROR_DRIVER_PROCESS_TERMINATED:                                        "ERROR_DRIVER_PROCESS_TERMINATED",
+	ERROR_IMPLEMENTATION_LIMIT:                                             "ERROR_IMPLEMENTATION_LIMIT",
+	ERROR_PROCESS_IS_PROTECTED:                                             "ERROR_PROCESS_IS_PROTECTED",
+	ERROR_SERVICE_NOTIFY_CLIENT_LAGGING:                                    "ERROR_SERVICE_NOTIFY_CLIENT_LAGGING",
+	ERROR_DISK_QUOTA_EXCEEDED:                                              "ERROR_DISK_QUOTA_EXCEEDED",
+	ERROR_CONTENT_BLOCKED:                                                  "ERROR_CONTENT_BLOCKED",
+	ERROR_INCOMPATIBLE_SERVICE_PRIVILEGE:                                   "ERROR_INCOMPATIBLE_SERVICE_PRIVILEGE",
+	ERROR_APP_HANG:                                                         "ERROR_APP_HANG",
+	ERROR_INVALID_LABEL:                                                    "ERROR_INVALID_LABEL",
+	ERROR_WRONG_TARGET_NAME:                                                "ERROR_WRONG_TARGET_NAME",
+	ERROR_MUTUAL_AUTH_FAILED:                                               "ERROR_MUTUAL_AUTH_FAILED",
+	ERROR_TIME_SKEW:                                                        "ERROR_TIME_SKEW",
+	ERROR_CURRENT_DOMAIN_NOT_ALLOWED:                                       "ERROR_CURRENT_DOMAIN_NOT_ALLOWED",
+	ERROR_INCORRECT_SIZE:                                                   "ERROR_INCORRECT_SIZE",
+	ERROR_SYMLINK_CLASS_DISABLED:                                           "ERROR_SYMLINK_CLASS_DISABLED",
+	ERROR_SYMLINK_NOT_SUPPORTED:                                            "ERROR_SYMLINK_NOT_SUPPORTED",
+	ERROR_XML_PARSE_ERROR:                                                  "ERROR_XML_PARSE_ERROR",
+	ERROR_XMLDSIG_ERROR:                                                    "ERROR_XMLDSIG_ERROR",
+	ERROR_RESTART_APPLICATION:                                              "ERROR_RESTART_APPLICATION",
+	ERROR_WRONG_COMPARTMENT:                                                "ERROR_WRONG_COMPARTMENT",
+	ERROR_AUTHIP_FAILURE:                                                   "ERROR_AUTHIP_FAILURE",
+	ERROR_NO_NVRAM_RESOURCES:                                               "ERROR_NO_NVRAM_RESOURCES",
+	ERROR_NOT_GUI_PROCESS:                                                  "ERROR_NOT_GUI_PROCESS",
+	ERROR_CONTAINER_ASSIGNED:                                               "ERROR_CONTAINER_ASSIGNED",
+	ERROR_JOB_NO_CONTAINER:                                                 "ERROR_JOB_NO_CONTAINER",
+	ERROR_INVALID_TASK_NAME:                                                "ERROR_INVALID_TASK_NAME",
+	ERROR_INVALID_TASK_INDEX:                                               "ERROR_INVALID_TASK_INDEX",
+	ERROR_THREAD_ALREADY_IN_TASK:                                           "ERROR_THREAD_ALREADY_IN_TASK",
+	ERROR_DEVICE_REMOVED:                                                   "ERROR_DEVICE_REMOVED",
+	ERROR_INSTALL_ALREADY_RUNNING:                                          "ERROR_INSTALL_ALREADY_RUNNING",
+	ERROR_INSTALL_PACKAGE_OPEN_FAILED:                                      "ERROR_INSTALL_PACKAGE_OPEN_FAILED",
+	ERROR_INSTALL_PACKAGE_INVALID:                                          "ERROR_INSTALL_PACKAGE_INVALID",
+	ERROR_INSTALL_UI_FAILURE:                                               "ERROR_INSTALL_UI_FAILURE",
+	ERROR_INSTALL_LOG_FAILURE:                                              "ERROR_INSTALL_LOG_FAILURE",
+	ERROR_INSTALL_LANGUAGE_UNSUPPORTED:                                     "ERROR_INSTALL_LANGUAGE_UNSUPPORTED",
+	ERROR_INSTALL_TRANSFORM_FAILURE:                                        "ERROR_INSTALL_TRANSFORM_FAILURE",
+	ERROR_INSTALL_PACKAGE_REJECTED:                                         "ERROR_INSTALL_PACKAGE_REJECTED",
+	ERROR_FUNCTION_NOT_CALLED:                                              "ERROR_FUNCTION_NOT_CALLED",
+	ERROR_FUNCTION_FAILED:                                                  "ERROR_FUNCTION_FAILED",
+	ERROR_INVALID_TABLE:                                                    "ERROR_INVALID_TABLE",
+	ERROR_DATATYPE_MISMATCH:                                                "ERROR_DATATYPE_MISMATCH",
+	ERROR_UNSUPPORTED_TYPE:                                                 "ERROR_UNSUPPORTED_TYPE",
+	ERROR_CREATE_FAILED:                                                    "ERROR_CREATE_FAILED",
+	ERROR_INSTALL_TEMP_UNWRITABLE:                                          "ERROR_INSTALL_TEMP_UNWRITABLE",
+	ERROR_INSTALL_PLATFORM_UNSUPPORTED:                                     "ERROR_INSTALL_PLATFORM_UNSUPPORTED",
+	ERROR_INSTALL_NOTUSED:                                                  "ERROR_INSTALL_NOTUSED",
+	ERROR_PATCH_PACKAGE_OPEN_FAILED:                                        "ERROR_PATCH_PACKAGE_OPEN_FAILED",
+	ERROR_PATCH_PACKAGE_INVALID:                                            "ERROR_PATCH_PACKAGE_INVALID",
+	ERROR_PATCH_PACKAGE_UNSUPPORTED:                                        "ERROR_PATCH_PACKAGE_UNSUPPORTED",
+	ERROR_PRODUCT_VERSION:                                                  "ERROR_PRODUCT_VERSION",
+	ERROR_INVALID_COMMAND_LINE:                                             "ERROR_INVALID_COMMAND_LINE",
+	ERROR_INSTALL_REMOTE_DISALLOWED:                                        "ERROR_INSTALL_REMOTE_DISALLOWED",
+	ERROR_SUCCESS_REBOOT_INITIATED:                                         "ERROR_SUCCESS_REBOOT_INITIATED",
+	ERROR_PATCH_TARGET_NOT_FOUND:                                           "ERROR_PATCH_TARGET_NOT_FOUND",
+	ERROR_PATCH_PACKAGE_REJECTED:                                           "ERROR_PATCH_PACKAGE_REJECTED",
+	ERROR_INSTALL_TRANSFORM_REJECTED:                                       "ERROR_INSTALL_TRANSFORM_REJECTED",
+	ERROR_INSTALL_REMOTE_PROHIBITED:                                        "ERROR_INSTALL_REMOTE_PROHIBITED",
+	ERROR_PATCH_REMOVAL_UNSUPPORTED:                                        "ERROR_PATCH_REMOVAL_UNSUPPORTED",
+	ERROR_UNKNOWN_PATCH:                                                    "ERROR_UNKNOWN_PATCH",
+	ERROR_PATCH_NO_SEQUENCE:                                                "ERROR_PATCH_NO_SEQUENCE",
+	ERROR_PATCH_REMOVAL_DISALLOWED:                                         "ERROR_PATCH_REMOVAL_DISALLOWED",
+	ERROR_INVALID_PATCH_XML:                                                "ERROR_INVALID_PATCH_XML",
+	ERROR_PATCH_MANAGED_ADVERTISED_PRODUCT:                                 "ERROR_PATCH_MANAGED_ADVERTISED_PRODUCT",
+	ERROR_INSTALL_SERVICE_SAFEBOOT:                                         "ERROR_INSTALL_SERVICE_SAFEBOOT",
+	ERROR_FAIL_FAST_EXCEPTION:                                              "ERROR_FAIL_FAST_EXCEPTION",
+	ERROR_INSTALL_REJECTED:                                                 "ERROR_INSTALL_REJECTED",
+	ERROR_DYNAMIC_CODE_BLOCKED:                                             "ERROR_DYNAMIC_CODE_BLOCKED",
+	ERROR_NOT_SAME_OBJECT:                                                  "ERROR_NOT_SAME_OBJECT",
+	ERROR_STRICT_CFG_VIOLATION:                                             "ERROR_STRICT_CFG_VIOLATION",
+	ERROR_SET_CONTEXT_DENIED:                                               "ERROR_SET_CONTEXT_DENIED",
+	ERROR_CROSS_PARTITION_VIOLATION:                                        "ERROR_CROSS_PARTITION_VIOLATION",
+	ERROR_RETURN_ADDRESS_HIJACK_ATTEMPT:                                    "ERROR_RETURN_ADDRESS_HIJACK_ATTEMPT",
+	RPC_S_PROXY_ACCESS_DENIED:                                              "RPC_S_PROXY_ACCESS_DENIED",
+	RPC_S_COOKIE_AUTH_FAILED:                                               "RPC_S_COOKIE_AUTH_FAILED",
+	RPC_S_DO_NOT_DISTURB:                                                   "RPC_S_DO_NOT_DISTURB",
+	RPC_S_SYSTEM_HANDLE_COUNT_EXCEEDED:                                     "RPC_S_SYSTEM_HANDLE_COUNT_EXCEEDED",
+	RPC_S_SYSTEM_HANDLE_TYPE_MISMATCH:                                      "RPC_S_SYSTEM_HANDLE_TYPE_MISMATCH",
+	RPC_S_ENTRY_TYPE_MISMATCH:                                              "RPC_S_ENTRY_TYPE_MISMATCH",
+	RPC_S_NOT_ALL_OBJS_EXPORTED:                                            "RPC_S_NOT_ALL_OBJS_EXPORTED",
+	RPC_S_INTERFACE_NOT_EXPORTED:                                           "RPC_S_INTERFACE_NOT_EXPORTED",
+	RPC_S_PROFILE_NOT_ADDED:                                                "RPC_S_PROFILE_NOT_ADDED",
+	RPC_S_PRF_ELT_NOT_ADDED:                                                "RPC_S_PRF_ELT_NOT_ADDED",
+	RPC_S_PRF_ELT_NOT_REMOVED:                                              "RPC_S_PRF_ELT_NOT_REMOVED",
+	RPC_S_GRP_ELT_NOT_ADDED:                                                "RPC_S_GRP_ELT_NOT_ADDED",
+	RPC_S_GRP_ELT_NOT_REMOVED:                                              "RPC_S_GRP_ELT_NOT_REMOVED",
+	ERROR_KM_DRIVER_BLOCKED:                                                "ERROR_KM_DRIVER_BLOCKED",
+	ERROR_CONTEXT_EXPIRED:                                                  "ERROR_CONTEXT_EXPIRED",
+	ERROR_PER_USER_TRUST_QUOTA_EXCEEDED:                                    "ERROR_PER_USER_TRUST_QUOTA_EXCEEDED",
+	ERROR_ALL_USER_TRUST_QUOTA_EXCEEDED:                                    "ERROR_ALL_USER_TRUST_QUOTA_EXCEEDED",
+	ERROR_USER_DELETE_TRUST_QUOTA_EXCEEDED:                                 "ERROR_USER_DELETE_TRUST_QUOTA_EXCEEDED",
+	ERROR_AUTHENTICATION_FIREWALL_FAILED:                                   "ERROR_AUTHENTICATION_FIREWALL_FAILED",
+	ERROR_REMOTE_PRINT_CONNECTIONS_BLOCKED:                                 "ERROR_REMOTE_PRINT_CONNECTIONS_BLOCKED",
+	ERROR_NTLM_BLOCKED:                                                     "ERROR_NTLM_BLOCKED",
+	ERROR_PASSWORD_CHANGE_REQUIRED:                                         "ERROR_PASSWORD_CHANGE_REQUIRED",
+	ERROR_LOST_MODE_LOGON_RESTRICTION:                                      "ERROR_LOST_MODE_LOGON_RESTRICTION",
+	ERROR_COLORSPACE_MISMATCH:                                              "ERROR_COLORSPACE_MISMATCH",
+	ERROR_INVALID_COLORINDEX:                                               "ERROR_INVALID_COLORINDEX",
+	ERROR_CONNECTED_OTHER_PASSWORD_DEFAULT:                                 "ERROR_CONNECTED_OTHER_PASSWORD_DEFAULT",
+	ERROR_PRINTER_NOT_FOUND:                                                "ERROR_PRINTER_NOT_FOUND",
+	ERROR_PRINTER_DRIVER_WARNED:                                            "ERROR_PRINTER_DRIVER_WARNED",
+	ERROR_PRINTER_DRIVER_BLOCKED:                                           "ERROR_PRINTER_DRIVER_BLOCKED",
+	ERROR_PRINTER_DRIVER_PACKAGE_IN_USE:                                    "ERROR_PRINTER_DRIVER_PACKAGE_IN_USE",
+	ERROR_CORE_DRIVER_PACKAGE_NOT_FOUND:                                    "ERROR_CORE_DRIVER_PACKAGE_NOT_FOUND",
+	ERROR_FAIL_REBOOT_REQUIRED:                                             "ERROR_FAIL_REBOOT_REQUIRED",
+	ERROR_FAIL_REBOOT_INITIATED:                                            "ERROR_FAIL_REBOOT_INITIATED",
+	ERROR_PRINTER_DRIVER_DOWNLOAD_NEEDED:                                   "ERROR_PRINTER_DRIVER_DOWNLOAD_NEEDED",
+	ERROR_PRINT_JOB_RESTART_REQUIRED:                                       "ERROR_PRINT_JOB_RESTART_REQUIRED",
+	ERROR_INVALID_PRINTER_DRIVER_MANIFEST:                                  "ERROR_INVALID_PRINTER_DRIVER_MANIFEST",
+	ERROR_PRINTER_NOT_SHAREABLE:                                            "ERROR_PRINTER_NOT_SHAREABLE",
+	ERROR_SERVER_SERVICE_CALL_REQUIRES_SMB1:                                "ERROR_SERVER_SERVICE_CALL_REQUIRES_SMB1",
+	ERROR_NETWORK_AUTHENTICATION_PROMPT_CANCELED:                           "ERROR_NETWORK_AUTHENTICATION_PROMPT_CANCELED",
+	ERROR_REQUEST_PAUSED:                                                   "ERROR_REQUEST_PAUSED",
+	ERROR_APPEXEC_CONDITION_NOT_SATISFIED:                                  "ERROR_APPEXEC_CONDITION_NOT_SATISFIED",
+	ERROR_APPEXEC_HANDLE_INVALIDATED:                                       "ERROR_APPEXEC_HANDLE_INVALIDATED",
+	ERROR_APPEXEC_INVALID_HOST_GENERATION:                                  "ERROR_APPEXEC_INVALID_HOST_GENERATION",
+	ERROR_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION:                          "ERROR_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION",
+	ERROR_APPEXEC_INVALID_HOST_STATE:                                       "ERROR_APPEXEC_INVALID_HOST_STATE",
+	ERROR_APPEXEC_NO_DONOR:                                                 "ERROR_APPEXEC_NO_DONOR",
+	ERROR_APPEXEC_HOST_ID_MISMATCH:                                         "ERROR_APPEXEC_HOST_ID_MISMATCH",
+	ERROR_APPEXEC_UNKNOWN_USER:                                             "ERROR_APPEXEC_UNKNOWN_USER",
+	ERROR_APPEXEC_APP_COMPAT_BLOCK:                                         "ERROR_APPEXEC_APP_COMPAT_BLOCK",
+	ERROR_APPEXEC_CALLER_WAIT_TIMEOUT:                                      "ERROR_APPEXEC_CALLER_WAIT_TIMEOUT",
+	ERROR_APPEXEC_CALLER_WAIT_TIMEOUT_TERMINATION:                          "ERROR_APPEXEC_CALLER_WAIT_TIMEOUT_TERMINATION",
+	ERROR_APPEXEC_CALLER_WAIT_TIMEOUT_LICENSING:                            "ERROR_APPEXEC_CALLER_WAIT_TIMEOUT_LICENSING",
+	ERROR_APPEXEC_CALLER_WAIT_TIMEOUT_RESOURCES:                            "ERROR_APPEXEC_CALLER_WAIT_TIMEOUT_RESOURCES",
+	ERROR_VRF_VOLATILE_CFG_AND_IO_ENABLED:                                  "ERROR_VRF_VOLATILE_CFG_AND_IO_ENABLED",
+	ERROR_VRF_VOLATILE_NOT_STOPPABLE:                                       "ERROR_VRF_VOLATILE_NOT_STOPPABLE",
+	ERROR_VRF_VOLATILE_SAFE_MODE:                                           "ERROR_VRF_VOLATILE_SAFE_MODE",
+	ERROR_VRF_VOLATILE_NOT_RUNNABLE_SYSTEM:                                 "ERROR_VRF_VOLATILE_NOT_RUNNABLE_SYSTEM",
+	ERROR_VRF_VOLATILE_NOT_SUPPORTED_RULECLASS:                             "ERROR_VRF_VOLATILE_NOT_SUPPORTED_RULECLASS",
+	ERROR_VRF_VOLATILE_PROTECTED_DRIVER:                                    "ERROR_VRF_VOLATILE_PROTECTED_DRIVER",
+	ERROR_VRF_VOLATILE_NMI_REGISTERED:                                      "ERROR_VRF_VOLATILE_NMI_REGISTERED",
+	ERROR_VRF_VOLATILE_SETTINGS_CONFLICT:                                   "ERROR_VRF_VOLATILE_SETTINGS_CONFLICT",
+	ERROR_DIF_IOCALLBACK_NOT_REPLACED:                                      "ERROR_DIF_IOCALLBACK_NOT_REPLACED",
+	ERROR_DIF_LIVEDUMP_LIMIT_EXCEEDED:                                      "ERROR_DIF_LIVEDUMP_LIMIT_EXCEEDED",
+	ERROR_DIF_VOLATILE_SECTION_NOT_LOCKED:                                  "ERROR_DIF_VOLATILE_SECTION_NOT_LOCKED",
+	ERROR_DIF_VOLATILE_DRIVER_HOTPATCHED:                                   "ERROR_DIF_VOLATILE_DRIVER_HOTPATCHED",
+	ERROR_DIF_VOLATILE_INVALID_INFO:                                        "ERROR_DIF_VOLATILE_INVALID_INFO",
+	ERROR_DIF_VOLATILE_DRIVER_IS_NOT_RUNNING:                               "ERROR_DIF_VOLATILE_DRIVER_IS_NOT_RUNNING",
+	ERROR_DIF_VOLATILE_PLUGIN_IS_NOT_RUNNING:                               "ERROR_DIF_VOLATILE_PLUGIN_IS_NOT_RUNNING",
+	ERROR_DIF_VOLATILE_PLUGIN_CHANGE_NOT_ALLOWED:                           "ERROR_DIF_VOLATILE_PLUGIN_CHANGE_NOT_ALLOWED",
+	ERROR_DIF_VOLATILE_NOT_ALLOWED:                                         "ERROR_DIF_VOLATILE_NOT_ALLOWED",
+	ERROR_DIF_BINDING_API_NOT_FOUND:                                        "ERROR_DIF_BINDING_API_NOT_FOUND",
+	ERROR_IO_REISSUE_AS_CACHED:                                             "ERROR_IO_REISSUE_AS_CACHED",
+	ERROR_WMI_ALREADY_DISABLED:                                             "ERROR_WMI_ALREADY_DISABLED",
+	ERROR_WMI_READ_ONLY:                                                    "ERROR_WMI_READ_ONLY",
+	ERROR_WMI_SET_FAILURE:                                                  "ERROR_WMI_SET_FAILURE",
+	ERROR_INVALID_DRIVE_OBJECT:                                             "ERROR_INVALID_DRIVE_OBJECT",
+	ERROR_LIBRARY_FULL:                                                     "ERROR_LIBRARY_FULL",
+	ERROR_MEDIUM_NOT_ACCESSIBLE:                                            "ERROR_MEDIUM_NOT_ACCESSIBLE",
+	ERROR_UNABLE_TO_LOAD_MEDIUM:                                            "ERROR_UNABLE_TO_LOAD_MEDIUM",
+	ERROR_UNABLE_TO_INVENTORY_DRIVE:                                        "ERROR_UNABLE_TO_INVENTORY_DRIVE",
+	ERROR_UNABLE_TO_INVENTORY_SLOT:                                         "ERROR_UNABLE_TO_INVENTORY_SLOT",
+	ERROR_UNABLE_TO_INVENTORY_TRANSPORT:                                    "ERROR_UNABLE_TO_INVENTORY_TRANSPORT",
+	ERROR_TRANSPORT_FULL:                                                   "ERROR_TRANSPORT_FULL",
+	ERROR_CONTROLLING_IEPORT:                                               "ERROR_CONTROLLING_IEPORT",
+	ERROR_UNABLE_TO_EJECT_MOUNTED_MEDIA:                                    "ERROR_UNABLE_TO_EJECT_MOUNTED_MEDIA",
+	ERROR_CLEANER_SLOT_SET:                                                 "ERROR_CLEANER_SLOT_SET",
+	ERROR_CLEANER_SLOT_NOT_SET:                                             "ERROR_CLEANER_SLOT_NOT_SET",
+	ERROR_CLEANER_CARTRIDGE_SPENT:                                          "ERROR_CLEANER_CARTRIDGE_SPENT",
+	ERROR_UNEXPECTED_OMID:                                                  "ERROR_UNEXPECTED_OMID",
+	ERROR_CANT_DELETE_LAST_ITEM:                                            "ERROR_CANT_DELETE_LAST_ITEM",
+	ERROR_MESSAGE_EXCEEDS_MAX_SIZE:                                         "ERROR_MESSAGE_EXCEEDS_MAX_SIZE",
+	ERROR_VOLUME_CONTAINS_SYS_FILES:                                        "ERROR_VOLUME_CONTAINS_SYS_FILES",
+	ERROR_INDIGENOUS_TYPE:                                                  "ERROR_INDIGENOUS_TYPE",
+	ERROR_NO_SUPPORTING_DRIVES:                                             "ERROR_NO_SUPPORTING_DRIVES",
+	ERROR_CLEANER_CARTRIDGE_INSTALLED:                                      "ERROR_CLEANER_CARTRIDGE_INSTALLED",
+	ERROR_IEPORT_FULL:                                                      "ERROR_IEPORT_FULL",
+	ERROR_INVALID_REPARSE_DATA:                                             "ERROR_INVALID_REPARSE_DATA",
+	ERROR_REPARSE_TAG_INVALID:                                              "ERROR_REPARSE_TAG_INVALID",
+	ERROR_REPARSE_TAG_MISMATCH:                                             "ERROR_REPARSE_TAG_MISMATCH",
+	ERROR_REPARSE_POINT_ENCOUNTERED:                                        "ERROR_REPARSE_POINT_ENCOUNTERED",
+	ERROR_APP_DATA_NOT_FOUND:                                               "ERROR_APP_DATA_NOT_FOUND",
+	ERROR_APP_DATA_EXPIRED:                                                 "ERROR_APP_DATA_EXPIRED",
+	ERROR_APP_DATA_CORRUPT:                                                 "ERROR_APP_DATA_CORRUPT",
+	ERROR_APP_DATA_LIMIT_EXCEEDED:                                          "ERROR_APP_DATA_LIMIT_EXCEEDED",
+	ERROR_APP_DATA_REBOOT_REQUIRED:                                         "ERROR_APP_DATA_REBOOT_REQUIRED",
+	ERROR_SECUREBOOT_ROLLBACK_DETECTED:                                     "ERROR_SECUREBOOT_ROLLBACK_DETECTED",
+	ERROR_SECUREBOOT_POLICY_VIOLATION:                                      "ERROR_SECUREBOOT_POLICY_VIOLATION",
+	ERROR_SECUREBOOT_INVALID_POLICY:                                        "ERROR_SECUREBOOT_INVALID_POLICY",
+	ERROR_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND:                            "ERROR_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND",
+	ERROR_SECUREBOOT_POLICY_NOT_SIGNED:                                     "ERROR_SECUREBOOT_POLICY_NOT_SIGNED",
+	ERROR_SECUREBOOT_NOT_ENABLED:                                           "ERROR_SECUREBOOT_NOT_ENABLED",
+	ERROR_SECUREBOOT_FILE_REPLACED:                                         "ERROR_SECUREBOOT_FILE_REPLACED",
+	ERROR_SECUREBOOT_POLICY_NOT_AUTHORIZED:                                 "ERROR_SECUREBOOT_POLICY_NOT_AUTHORIZED",
+	ERROR_SECUREBOOT_POLICY_UNKNOWN:                                        "ERROR_SECUREBOOT_POLICY_UNKNOWN",
+	ERROR_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION:                    "ERROR_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION",
+	ERROR_SECUREBOOT_PLATFORM_ID_MISMATCH:                                  "ERROR_SECUREBOOT_PLATFORM_ID_MISMATCH",
+	ERROR_SECUREBOOT_POLICY_ROLLBACK_DETECTED:                              "ERROR_SECUREBOOT_POLICY_ROLLBACK_DETECTED",
+	ERROR_SECUREBOOT_POLICY_UPGRADE_MISMATCH:                               "ERROR_SECUREBOOT_POLICY_UPGRADE_MISMATCH",
+	ERROR_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING:                          "ERROR_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING",
+	ERROR_SECUREBOOT_NOT_BASE_POLICY:                                       "ERROR_SECUREBOOT_NOT_BASE_POLICY",
+	ERROR_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY:                               "ERROR_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY",
+	ERROR_OFFLOAD_READ_FLT_NOT_SUPPORTED:                                   "ERROR_OFFLOAD_READ_FLT_NOT_SUPPORTED",
+	ERROR_OFFLOAD_WRITE_FLT_NOT_SUPPORTED:                                  "ERROR_OFFLOAD_WRITE_FLT_NOT_SUPPORTED",
+	ERROR_OFFLOAD_READ_FILE_NOT_SUPPORTED:                                  "ERROR_OFFLOAD_READ_FILE_NOT_SUPPORTED",
+	ERROR_OFFLOAD_WRITE_FILE_NOT_SUPPORTED:                                 "ERROR_OFFLOAD_WRITE_FILE_NOT_SUPPORTED",
+	ERROR_ALREADY_HAS_STREAM_ID:                                            "ERROR_ALREADY_HAS_STREAM_ID",
+	ERROR_SMR_GARBAGE_COLLECTION_REQUIRED:                                  "ERROR_SMR_GARBAGE_COLLECTION_REQUIRED",
+	ERROR_WOF_WIM_HEADER_CORRUPT:                                           "ERROR_WOF_WIM_HEADER_CORRUPT",
+	ERROR_WOF_WIM_RESOURCE_TABLE_CORRUPT:                                   "ERROR_WOF_WIM_RESOURCE_TABLE_CORRUPT",
+	ERROR_WOF_FILE_RESOURCE_TABLE_CORRUPT:                                  "ERROR_WOF_FILE_RESOURCE_TABLE_CORRUPT",
+	ERROR_OBJECT_IS_IMMUTABLE:                                              "ERROR_OBJECT_IS_IMMUTABLE",
+	ERROR_VOLUME_NOT_SIS_ENABLED:                                           "ERROR_VOLUME_NOT_SIS_ENABLED",
+	ERROR_QUORUM_OWNER_ALIVE:                                               "ERROR_QUORUM_OWNER_ALIVE",
+	ERROR_NETWORK_NOT_AVAILABLE:                                            "ERROR_NETWORK_NOT_AVAILABLE",
+	ERROR_NODE_NOT_AVAILABLE:                                               "ERROR_NODE_NOT_AVAILABLE",
+	ERROR_ALL_NODES_NOT_AVAILABLE:                                          "ERROR_ALL_NODES_NOT_AVAILABLE",
+	ERROR_RESOURCE_FAILED:                                                  "ERROR_RESOURCE_FAILED",
+	ERROR_CLUSTER_INVALID_NODE:                                             "ERROR_CLUSTER_INVALID_NODE",
+	ERROR_CLUSTER_NODE_EXISTS:                                              "ERROR_CLUSTER_NODE_EXISTS",
+	ERROR_CLUSTER_JOIN_IN_PROGRESS:                                         "ERROR_CLUSTER_JOIN_IN_PROGRESS",
+	ERROR_CLUSTER_NODE_NOT_FOUND:                                           "ERROR_CLUSTER_NODE_NOT_FOUND",
+	ERROR_CLUSTER_LOCAL_NODE_NOT_FOUND:                                     "ERROR_CLUSTER_LOCAL_NODE_NOT_FOUND",
+	ERROR_CLUSTER_NETWORK_EXISTS:                                           "ERROR_CLUSTER_NETWORK_EXISTS",
+	ERROR_CLUSTER_NETWORK_NOT_FOUND:                                        "ERROR_CLUSTER_NETWORK_NOT_FOUND",
+	ERROR_CLUSTER_NETINTERFACE_EXISTS:                                      "ERROR_CLUSTER_NETINTERFACE_EXISTS",
+	ERROR_CLUSTER_NETINTERFACE_NOT_FOUND:                                   "ERROR_CLUSTER_NETINTERFACE_NOT_FOUND",
+	ERROR_CLUSTER_INVALID_REQUEST:                                          "ERROR_CLUSTER_INVALID_REQUEST",
+	ERROR_CLUSTER_INVALID_NETWORK_PROVIDER:                                 "ERROR_CLUSTER_INVALID_NETWORK_PROVIDER",
+	ERROR_CLUSTER_NODE_DOWN:                                                "ERROR_CLUSTER_NODE_DOWN",
+	ERROR_CLUSTER_NODE_UNREACHABLE:                                         "ERROR_CLUSTER_NODE_UNREACHABLE",
+	ERROR_CLUSTER_NODE_NOT_MEMBER:                                          "ERROR_CLUSTER_NODE_NOT_MEMBER",
+	ERROR_CLUSTER_JOIN_NOT_IN_PROGRESS:                                     "ERROR_CLUSTER_JOIN_NOT_IN_PROGRESS",
+	ERROR_CLUSTER_INVALID_NETWORK:                                          "ERROR_CLUSTER_INVALID_NETWORK",
+	ERROR_CLUSTER_NODE_UP:                                                  "ERROR_CLUSTER_NODE_UP",
+	ERROR_CLUSTER_IPADDR_IN_USE:                                            "ERROR_CLUSTER_IPADDR_IN_USE",
+	ERROR_CLUSTER_NODE_NOT_PAUSED:                                          "ERROR_CLUSTER_NODE_NOT_PAUSED",
+	ERROR_CLUSTER_NO_SECURITY_CONTEXT:                                      "ERROR_CLUSTER_NO_SECURITY_CONTEXT",
+	ERROR_CLUSTER_NETWORK_NOT_INTERNAL:                                     "ERROR_CLUSTER_NETWORK_NOT_INTERNAL",
+	ERROR_CLUSTER_NODE_ALREADY_UP:                                          "ERROR_CLUSTER_NODE_ALREADY_UP",
+	ERROR_CLUSTER_NODE_ALREADY_DOWN:                                        "ERROR_CLUSTER_NODE_ALREADY_DOWN",
+	ERROR_CLUSTER_NETWORK_ALREADY_ONLINE:                                   "ERROR_CLUSTER_NETWORK_ALREADY_ONLINE",
+	ERROR_CLUSTER_NETWORK_ALREADY_OFFLINE:                                  "ERROR_CLUSTER_NETWORK_ALREADY_OFFLINE",
+	ERROR_CLUSTER_NODE_ALREADY_MEMBER:                                      "ERROR_CLUSTER_NODE_ALREADY_MEMBER",
+	ERROR_CLUSTER_LAST_INTERNAL_NETWORK:                                    "ERROR_CLUSTER_LAST_INTERNAL_NETWORK",
+	ERROR_CLUSTER_NETWORK_HAS_DEPENDENTS:                                   "ERROR_CLUSTER_NETWORK_HAS_DEPENDENTS",
+	ERROR_INVALID_OPERATION_ON_QUORUM:                                      "ERROR_INVALID_OPERATION_ON_QUORUM",
+	ERROR_DEPENDENCY_NOT_ALLOWED:                                           "ERROR_DEPENDENCY_NOT_ALLOWED",
+	ERROR_CLUSTER_NODE_PAUSED:                                              "ERROR_CLUSTER_NODE_PAUSED",
+	ERROR_NODE_CANT_HOST_RESOURCE:                                          "ERROR_NODE_CANT_HOST_RESOURCE",
+	ERROR_CLUSTER_NODE_NOT_READY:                                           "ERROR_CLUSTER_NODE_NOT_READY",
+	ERROR_CLUSTER_NODE_SHUTTING_DOWN:                                       "ERROR_CLUSTER_NODE_SHUTTING_DOWN",
+	ERROR_CLUSTER_JOIN_ABORTED:                                             "ERROR_CLUSTER_JOIN_ABORTED",
+	ERROR_CLUSTER_INCOMPATIBLE_VERSIONS:                                    "ERROR_CLUSTER_INCOMPATIBLE_VERSIONS",
+	ERROR_CLUSTER_MAXNUM_OF_RESOURCES_EXCEEDED:                             "ERROR_CLUSTER_MAXNUM_OF_RESOURCES_EXCEEDED",
+	ERROR_CLUSTER_SYSTEM_CONFIG_CHANGED:                                    "ERROR_CLUSTER_SYSTEM_CONFIG_CHANGED",
+	ERROR_CLUSTER_RESOURCE_TYPE_NOT_FOUND:                                  "ERROR_CLUSTER_RESOURCE_TYPE_NOT_FOUND",
+	ERROR_CLUSTER_RESTYPE_NOT_SUPPORTED:                                    "ERROR_CLUSTER_RESTYPE_NOT_SUPPORTED",
+	ERROR_CLUSTER_RESNAME_NOT_FOUND:                                        "ERROR_CLUSTER_RESNAME_NOT_FOUND",
+	ERROR_CLUSTER_NO_RPC_PACKAGES_REGISTERED:                               "ERROR_CLUSTER_NO_RPC_PACKAGES_REGISTERED",
+	ERROR_CLUSTER_OWNER_NOT_IN_PREFLIST:                                    "ERROR_CLUSTER_OWNER_NOT_IN_PREFLIST",
+	ERROR_CLUSTER_DATABASE_SEQMISMATCH:                                     "ERROR_CLUSTER_DATABASE_SEQMISMATCH",
+	ERROR_RESMON_INVALID_STATE:                                             "ERROR_RESMON_INVALID_STATE",
+	ERROR_CLUSTER_GUM_NOT_LOCKER:                                           "ERROR_CLUSTER_GUM_NOT_LOCKER",
+	ERROR_QUORUM_DISK_NOT_FOUND:                                            "ERROR_QUORUM_DISK_NOT_FOUND",
+	ERROR_DATABASE_BACKUP_CORRUPT:                                          "ERROR_DATABASE_BACKUP_CORRUPT",
+	ERROR_CLUSTER_NODE_ALREADY_HAS_DFS_ROOT:                                "ERROR_CLUSTER_NODE_ALREADY_HAS_DFS_ROOT",
+	ERROR_RESOURCE_PROPERTY_UNCHANGEABLE:                                   "ERROR_RESOURCE_PROPERTY_UNCHANGEABLE",
+	ERROR_CLUSTER_MEMBERSHIP_INVALID_STATE:                                 "ERROR_CLUSTER_MEMBERSHIP_INVALID_STATE",
+	ERROR_CLUSTER_QUORUMLOG_NOT_FOUND:                                      "ERROR_CLUSTER_QUORUMLOG_NOT_FOUND",
+	ERROR_CLUSTER_MEMBERSHIP_HALT:                                          "ERROR_CLUSTER_MEMBERSHIP_HALT",
+	ERROR_CLUSTER_INSTANCE_ID_MISMATCH:                                     "ERROR_CLUSTER_INSTANCE_ID_MISMATCH",
+	ERROR_CLUSTER_NETWORK_NOT_FOUND_FOR_IP:                                 "ERROR_CLUSTER_NETWORK_NOT_FOUND_FOR_IP",
+	ERROR_CLUSTER_PROPERTY_DATA_TYPE_MISMATCH:                              "ERROR_CLUSTER_PROPERTY_DATA_TYPE_MISMATCH",
+	ERROR_CLUSTER_EVICT_WITHOUT_CLEANUP:                                    "ERROR_CLUSTER_EVICT_WITHOUT_CLEANUP",
+	ERROR_CLUSTER_PARAMETER_MISMATCH:                                       "ERROR_CLUSTER_PARAMETER_MISMATCH",
+	ERROR_NODE_CANNOT_BE_CLUSTERED:                                         "ERROR_NODE_CANNOT_BE_CLUSTERED",
+	ERROR_CLUSTER_WRONG_OS_VERSION:                                         "ERROR_CLUSTER_WRONG_OS_VERSION",
+	ERROR_CLUSTER_CANT_CREATE_DUP_CLUSTER_NAME:                             "ERROR_CLUSTER_CANT_CREATE_DUP_CLUSTER_NAME",
+	ERROR_CLUSCFG_ALREADY_COMMITTED:                                        "ERROR_CLUSCFG_ALREADY_COMMITTED",
+	ERROR_CLUSCFG_ROLLBACK_FAILED:                                          "ERROR_CLUSCFG_ROLLBACK_FAILED",
+	ERROR_CLUSCFG_SYSTEM_DISK_DRIVE_LETTER_CONFLICT:                        "ERROR_CLUSCFG_SYSTEM_DISK_DRIVE_LETTER_CONFLICT",
+	ERROR_CLUSTER_OLD_VERSION:                                              "ERROR_CLUSTER_OLD_VERSION",
+	ERROR_CLUSTER_MISMATCHED_COMPUTER_ACCT_NAME:                            "ERROR_CLUSTER_MISMATCHED_COMPUTER_ACCT_NAME",
+	ERROR_CLUSTER_NO_NET_ADAPTERS:                                          "ERROR_CLUSTER_NO_NET_ADAPTERS",
+	ERROR_CLUSTER_POISONED:                                                 "ERROR_CLUSTER_POISONED",
+	ERROR_CLUSTER_GROUP_MOVING:                                             "ERROR_CLUSTER_GROUP_MOVING",
+	ERROR_CLUSTER_RESOURCE_TYPE_BUSY:                                       "ERROR_CLUSTER_RESOURCE_TYPE_BUSY",
+	ERROR_RESOURCE_CALL_TIMED_OUT:                                          "ERROR_RESOURCE_CALL_TIMED_OUT",
+	ERROR_INVALID_CLUSTER_IPV6_ADDRESS:                                     "ERROR_INVALID_CLUSTER_IPV6_ADDRESS",
+	ERROR_CLUSTER_INTERNAL_INVALID_FUNCTION:                                "ERROR_CLUSTER_INTERNAL_INVALID_FUNCTION",
+	ERROR_CLUSTER_PARAMETER_OUT_OF_BOUNDS:                                  "ERROR_CLUSTER_PARAMETER_OUT_OF_BOUNDS",
+	ERROR_CLUSTER_PARTIAL_SEND:                                             "ERROR_CLUSTER_PARTIAL_SEND",
+	ERROR_CLUSTER_REGISTRY_INVALID_FUNCTION:                                "ERROR_CLUSTER_REGISTRY_INVALID_FUNCTION",
+	ERROR_CLUSTER_INVALID_STRING_TERMINATION:                               "ERROR_CLUSTER_INVALID_STRING_TERMINATION",
+	ERROR_CLUSTER_INVALID_STRING_FORMAT:                                    "ERROR_CLUSTER_INVALID_STRING_FORMAT",
+	ERROR_CLUSTER_DATABASE_TRANSACTION_IN_PROGRESS:                         "ERROR_CLUSTER_DATABASE_TRANSACTION_IN_PROGRESS",
+	ERROR_CLUSTER_DATABASE_TRANSACTION_NOT_IN_PROGRESS:                     "ERROR_CLUSTER_DATABASE_TRANSACTION_NOT_IN_PROGRESS",
+	ERROR_CLUSTER_NULL_DATA:                                                "ERROR_CLUSTER_NULL_DATA",
+	ERROR_CLUSTER_PARTIAL_READ:                                             "ERROR_CLUSTER_PARTIAL_READ",
+	ERROR_CLUSTER_PARTIAL_WRITE:                                            "ERROR_CLUSTER_PARTIAL_WRITE",
+	ERROR_CLUSTER_CANT_DESERIALIZE_DATA:                                    "ERROR_CLUSTER_CANT_DESERIALIZE_DATA",
+	ERROR_DEPENDENT_RESOURCE_PROPERTY_CONFLICT:                             "ERROR_DEPENDENT_RESOURCE_PROPERTY_CONFLICT",
+	ERROR_CLUSTER_NO_QUORUM:                                                "ERROR_CLUSTER_NO_QUORUM",
+	ERROR_CLUSTER_INVALID_IPV6_NETWORK:                                     "ERROR_CLUSTER_INVALID_IPV6_NETWORK",
+	ERROR_CLUSTER_INVALID_IPV6_TUNNEL_NETWORK:                              "ERROR_CLUSTER_INVALID_IPV6_TUNNEL_NETWORK",
+	ERROR_QUORUM_NOT_ALLOWED_IN_THIS_GROUP:                                 "ERROR_QUORUM_NOT_ALLOWED_IN_THIS_GROUP",
+	ERROR_DEPENDENCY_TREE_TOO_COMPLEX:                                      "ERROR_DEPENDENCY_TREE_TOO_COMPLEX",
+	ERROR_EXCEPTION_IN_RESOURCE_CALL:                                       "ERROR_EXCEPTION_IN_RESOURCE_CALL",
+	ERROR_CLUSTER_RHS_FAILED_INITIALIZATION:                                "ERROR_CLUSTER_RHS_FAILED_INITIALIZATION",
+	ERROR_CLUSTER_NOT_INSTALLED:                                            "ERROR_CLUSTER_NOT_INSTALLED",
+	ERROR_CLUSTER_RESOURCES_MUST_BE_ONLINE_ON_THE_SAME_NODE:                "ERROR_CLUSTER_RESOURCES_MUST_BE_ONLINE_ON_THE_SAME_NODE",
+	ERROR_CLUSTER_MAX_NODES_IN_CLUSTER:                                     "ERROR_CLUSTER_MAX_NODES_IN_CLUSTER",
+	ERROR_CLUSTER_TOO_MANY_NODES:                                           "ERROR_CLUSTER_TOO_MANY_NODES",
+	ERROR_CLUSTER_OBJECT_ALREADY_USED:                                      "ERROR_CLUSTER_OBJECT_ALREADY_USED",
+	ERROR_NONCORE_GROUPS_FOUND:                                             "ERROR_NONCORE_GROUPS_FOUND",
+	ERROR_FILE_SHARE_RESOURCE_CONFLICT:                                     "ERROR_FILE_SHARE_RESOURCE_CONFLICT",
+	ERROR_CLUSTER_EVICT_INVALID_REQUEST:                                    "ERROR_CLUSTER_EVICT_INVALID_REQUEST",
+	ERROR_CLUSTER_SINGLETON_RESOURCE:                                       "ERROR_CLUSTER_SINGLETON_RESOURCE",
+	ERROR_CLUSTER_GROUP_SINGLETON_RESOURCE:                                 "ERROR_CLUSTER_GROUP_SINGLETON_RESOURCE",
+	ERROR_CLUSTER_RESOURCE_PROVIDER_FAILED:                                 "ERROR_CLUSTER_RESOURCE_PROVIDER_FAILED",
+	ERROR_CLUSTER_RESOURCE_CONFIGURATION_ERROR:                             "ERROR_CLUSTER_RESOURCE_CONFIGURATION_ERROR",
+	ERROR_CLUSTER_GROUP_BUSY:                                               "ERROR_CLUSTER_GROUP_BUSY",
+	ERROR_CLUSTER_NOT_SHARED_VOLUME:                                        "ERROR_CLUSTER_NOT_SHARED_VOLUME",
+	ERROR_CLUSTER_INVALID_SECURITY_DESCRIPTOR:                              "ERROR_CLUSTER_INVALID_SECURITY_DESCRIPTOR",
+	ERROR_CLUSTER_SHARED_VOLUMES_IN_USE:                                    "ERROR_CLUSTER_SHARED_VOLUMES_IN_USE",
+	ERROR_CLUSTER_USE_SHARED_VOLUMES_API:                                   "ERROR_CLUSTER_USE_SHARED_VOLUMES_API",
+	ERROR_CLUSTER_BACKUP_IN_PROGRESS:                                       "ERROR_CLUSTER_BACKUP_IN_PROGRESS",
+	ERROR_NON_CSV_PATH:                                                     "ERROR_NON_CSV_PATH",
+	ERROR_CSV_VOLUME_NOT_LOCAL:                                             "ERROR_CSV_VOLUME_NOT_LOCAL",
+	ERROR_CLUSTER_WATCHDOG_TERMINATING:                                     "ERROR_CLUSTER_WATCHDOG_TERMINATING",
+	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_INCOMPATIBLE_NODES:                  "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_INCOMPATIBLE_NODES",
+	ERROR_CLUSTER_INVALID_NODE_WEIGHT:                                      "ERROR_CLUSTER_INVALID_NODE_WEIGHT",
+	ERROR_CLUSTER_RESOURCE_VETOED_CALL:                                     "ERROR_CLUSTER_RESOURCE_VETOED_CALL",
+	ERROR_RESMON_SYSTEM_RESOURCES_LACKING:                                  "ERROR_RESMON_SYSTEM_RESOURCES_LACKING",
+	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_DESTINATION: "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_DESTINATION",
+	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_SOURCE:      "ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_SOURCE",
+	ERROR_CLUSTER_GROUP_QUEUED:                                             "ERROR_CLUSTER_GROUP_QUEUED",
+	ERROR_CLUSTER_RESOURCE_LOCKED_STATUS:                                   "ERROR_CLUSTER_RESOURCE_LOCKED_STATUS",
+	ERROR_CLUSTER_SHARED_VOLUME_FAILOVER_NOT_ALLOWED:                       "ERROR_CLUSTER_SHARED_VOLUME_FAILOVER_NOT_ALLOWED",
+	ERROR_CLUSTER_NODE_DRAIN_IN_PROGRESS:                                   "ERROR_CLUSTER_NODE_DRAIN_IN_PROGRESS",
+	ERROR_CLUSTER_DISK_NOT_CONNECTED:                                       "ERROR_CLUSTER_DISK_NOT_CONNECTED",
+	ERROR_DISK_NOT_CSV_CAPABLE:                                             "ERROR_DISK_NOT_CSV_CAPABLE",
+	ERROR_RESOURCE_NOT_IN_AVAILABLE_STORAGE:                                "ERROR_RESOURCE_NOT_IN_AVAILABLE_STORAGE",
+	ERROR_CLUSTER_SHARED_VOLUME_REDIRECTED:                                 "ERROR_CLUSTER_SHARED_VOLUME_REDIRECTED",
+	ERROR_CLUSTER_SHARED_VOLUME_NOT_REDIRECTED:                             "ERROR_CLUSTER_SHARED_VOLUME_NOT_REDIRECTED",
+	ERROR_CLUSTER_CANNOT_RETURN_PROPERTIES:                                 "ERROR_CLUSTER_CANNOT_RETURN_PROPERTIES",
+	ERROR_CLUSTER_RESOURCE_CONTAINS_UNSUPPORTED_DIFF_AREA_FOR_SHARED_VOLUMES: "ERROR_CLUSTER_RESOURCE_CONTAINS_UNSUPPORTED_DIFF_AREA_FOR_SHARED_VOLUMES",
+	ERROR_CLUSTER_RESOURCE_IS_IN_MAINTENANCE_MODE:                            "ERROR_CLUSTER_RESOURCE_IS_IN_MAINTENANCE_MODE",
+	ERROR_CLUSTER_AFFINITY_CONFLICT:                                          "ERROR_CLUSTER_AFFINITY_CONFLICT",
+	ERROR_CLUSTER_RESOURCE_IS_REPLICA_VIRTUAL_MACHINE:                        "ERROR_CLUSTER_RESOURCE_IS_REPLICA_VIRTUAL_MACHINE",
+	ERROR_CLUSTER_UPGRADE_INCOMPATIBLE_VERSIONS:                              "ERROR_CLUSTER_UPGRADE_INCOMPATIBLE_VERSIONS",
+	ERROR_CLUSTER_UPGRADE_FIX_QUORUM_NOT_SUPPORTED:                           "ERROR_CLUSTER_UPGRADE_FIX_QUORUM_NOT_SUPPORTED",
+	ERROR_CLUSTER_UPGRADE_RESTART_REQUIRED:                                   "ERROR_CLUSTER_UPGRADE_RESTART_REQUIRED",
+	ERROR_CLUSTER_UPGRADE_IN_PROGRESS:                                        "ERROR_CLUSTER_UPGRADE_IN_PROGRESS",
+	ERROR_CLUSTER_UPGRADE_INCOMPLETE:                                         "ERROR_CLUSTER_UPGRADE_INCOMPLETE",
+	ERROR_CLUSTER_NODE_IN_GRACE_PERIOD:                                       "ERROR_CLUSTER_NODE_IN_GRACE_PERIOD",
+	ERROR_CLUSTER_CSV_IO_PAUSE_TIMEOUT:                                       "ERROR_CLUSTER_CSV_IO_PAUSE_TIMEOUT",
+	ERROR_NODE_NOT_ACTIVE_CLUSTER_MEMBER:                                     "ERROR_NODE_NOT_ACTIVE_CLUSTER_MEMBER",
+	ERROR_CLUSTER_RESOURCE_NOT_MONITORED:                                     "ERROR_CLUSTER_RESOURCE_NOT_MONITORED",
+	ERROR_CLUSTER_RESOURCE_DOES_NOT_SUPPORT_UNMONITORED:                      "ERROR_CLUSTER_RESOURCE_DOES_NOT_SUPPORT_UNMONITORED",
+	ERROR_CLUSTER_RESOURCE_IS_REPLICATED:                                     "ERROR_CLUSTER_RESOURCE_IS_REPLICATED",
+	ERROR_CLUSTER_NODE_ISOLATED:                                              "ERROR_CLUSTER_NODE_ISOLATED",
+	ERROR_CLUSTER_NODE_QUARANTINED:                                           "ERROR_CLUSTER_NODE_QUARANTINED",
+	ERROR_CLUSTER_DATABASE_UPDATE_CONDITION_FAILED:                           "ERROR_CLUSTER_DATABASE_UPDATE_CONDITION_FAILED",
+	ERROR_CLUSTER_SPACE_DEGRADED:                                             "ERROR_CLUSTER_SPACE_DEGRADED",
+	ERROR_CLUSTER_TOKEN_DELEGATION_NOT_SUPPORTED:                             "ERROR_CLUSTER_TOKEN_DELEGATION_NOT_SUPPORTED",
+	ERROR_CLUSTER_CSV_INVALID_HANDLE:                                         "ERROR_CLUSTER_CSV_INVALID_HANDLE",
+	ERROR_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR:                          "ERROR_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR",
+	ERROR_GROUPSET_NOT_AVAILABLE:                                             "ERROR_GROUPSET_NOT_AVAILABLE",
+	ERROR_GROUPSET_NOT_FOUND:                                                 "ERROR_GROUPSET_NOT_FOUND",
+	ERROR_GROUPSET_CANT_PROVIDE:                                              "ERROR_GROUPSET_CANT_PROVIDE",
+	ERROR_CLUSTER_FAULT_DOMAIN_PARENT_NOT_FOUND:                              "ERROR_CLUSTER_FAULT_DOMAIN_PARENT_NOT_FOUND",
+	ERROR_CLUSTER_FAULT_DOMAIN_INVALID_HIERARCHY:                             "ERROR_CLUSTER_FAULT_DOMAIN_INVALID_HIERARCHY",
+	ERROR_CLUSTER_FAULT_DOMAIN_FAILED_S2D_VALIDATION:                         "ERROR_CLUSTER_FAULT_DOMAIN_FAILED_S2D_VALIDATION",
+	ERROR_CLUSTER_FAULT_DOMAIN_S2D_CONNECTIVITY_LOSS:                         "ERROR_CLUSTER_FAULT_DOMAIN_S2D_CONNECTIVITY_LOSS",
+	ERROR_CLUSTER_INVALID_INFRASTRUCTURE_FILESERVER_NAME:                     "ERROR_CLUSTER_INVALID_INFRASTRUCTURE_FILESERVER_NAME",
+	ERROR_CLUSTERSET_MANAGEMENT_CLUSTER_UNREACHABLE:                          "ERROR_CLUSTERSET_MANAGEMENT_CLUSTER_UNREACHABLE",
+	ERROR_FILE_READ_ONLY:                                                     "ERROR_FILE_READ_ONLY",
+	ERROR_DIR_EFS_DISALLOWED:                                                 "ERROR_DIR_EFS_DISALLOWED",
+	ERROR_EFS_SERVER_NOT_TRUSTED:                                             "ERROR_EFS_SERVER_NOT_TRUSTED",
+	ERROR_BAD_RECOVERY_POLICY:                                                "ERROR_BAD_RECOVERY_POLICY",
+	ERROR_EFS_ALG_BLOB_TOO_BIG:                                               "ERROR_EFS_ALG_BLOB_TOO_BIG",
+	ERROR_VOLUME_NOT_SUPPORT_EFS:                                             "ERROR_VOLUME_NOT_SUPPORT_EFS",
+	ERROR_EFS_DISABLED:                                                       "ERROR_EFS_DISABLED",
+	ERROR_EFS_VERSION_NOT_SUPPORT:                                            "ERROR_EFS_VERSION_NOT_SUPPORT",
+	ERROR_CS_ENCRYPTION_INVALID_SERVER_RESPONSE:                              "ERROR_CS_ENCRYPTION_INVALID_SERVER_RESPONSE",
+	ERROR_CS_ENCRYPTION_UNSUPPORTED_SERVER:                                   "ERROR_CS_ENCRYPTION_UNSUPPORTED_SERVER",
+	ERROR_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE:                              "ERROR_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE",
+	ERROR_CS_ENCRYPTION_NEW_ENCRYPTED_FILE:                                   "ERROR_CS_ENCRYPTION_NEW_ENCRYPTED_FILE",
+	ERROR_CS_ENCRYPTION_FILE_NOT_CSE:                                         "ERROR_CS_ENCRYPTION_FILE_NOT_CSE",
+	ERROR_ENCRYPTION_POLICY_DENIES_OPERATION:                                 "ERROR_ENCRYPTION_POLICY_DENIES_OPERATION",
+	ERROR_WIP_ENCRYPTION_FAILED:                                              "ERROR_WIP_ENCRYPTION_FAILED",
+	SCHED_E_SERVICE_NOT_LOCALSYSTEM:                                          "SCHED_E_SERVICE_NOT_LOCALSYSTEM",
+	ERROR_CLUSTER_OBJECT_IS_CLUSTER_SET_VM:                                   "ERROR_CLUSTER_OBJECT_IS_CLUSTER_SET_VM",
+	ERROR_LOG_SECTOR_INVALID:                                                 "ERROR_LOG_SECTOR_INVALID",
+	ERROR_LOG_SECTOR_PARITY_INVALID:                                          "ERROR_LOG_SECTOR_PARITY_INVALID",
+	ERROR_LOG_SECTOR_REMAPPED:                                                "ERROR_LOG_SECTOR_REMAPPED",
+	ERROR_LOG_BLOCK_INCOMPLETE:                                               "ERROR_LOG_BLOCK_INCOMPLETE",
+	ERROR_LOG_INVALID_RANGE:                                                  "ERROR_LOG_INVALID_RANGE",
+	ERROR_LOG_BLOCKS_EXHAUSTED:                                               "ERROR_LOG_BLOCKS_EXHAUSTED",
+	ERROR_LOG_READ_CONTEXT_INVALID:                                           "ERROR_LOG_READ_CONTEXT_INVALID",
+	ERROR_LOG_RESTART_INVALID:                                                "ERROR_LOG_RESTART_INVALID",
+	ERROR_LOG_BLOCK_VERSION:                                                  "ERROR_LOG_BLOCK_VERSION",
+	ERROR_LOG_BLOCK_INVALID:                                                  "ERROR_LOG_BLOCK_INVALID",
+	ERROR_LOG_READ_MODE_INVALID:                                              "ERROR_LOG_READ_MODE_INVALID",
+	ERROR_LOG_NO_RESTART:                                                     "ERROR_LOG_NO_RESTART",
+	ERROR_LOG_METADATA_CORRUPT:                                               "ERROR_LOG_METADATA_CORRUPT",
+	ERROR_LOG_METADATA_INVALID:                                               "ERROR_LOG_METADATA_INVALID",
+	ERROR_LOG_METADATA_INCONSISTENT:                                          "ERROR_LOG_METADATA_INCONSISTENT",
+	ERROR_LOG_RESERVATION_INVALID:                                            "ERROR_LOG_RESERVATION_INVALID",
+	ERROR_LOG_CANT_DELETE:                                                    "ERROR_LOG_CANT_DELETE",
+	ERROR_LOG_CONTAINER_LIMIT_EXCEEDED:                                       "ERROR_LOG_CONTAINER_LIMIT_EXCEEDED",
+	ERROR_LOG_START_OF_LOG:                                                   "ERROR_LOG_START_OF_LOG",
+	ERROR_LOG_POLICY_ALREADY_INSTALLED:                                       "ERROR_LOG_POLICY_ALREADY_INSTALLED",
+	ERROR_LOG_POLICY_NOT_INSTALLED:                                           "ERROR_LOG_POLICY_NOT_INSTALLED",
+	ERROR_LOG_POLICY_INVALID:                                                 "ERROR_LOG_POLICY_INVALID",
+	ERROR_LOG_POLICY_CONFLICT:                                                "ERROR_LOG_POLICY_CONFLICT",
+	ERROR_LOG_PINNED_ARCHIVE_TAIL:                                            "ERROR_LOG_PINNED_ARCHIVE_TAIL",
+	ERROR_LOG_RECORD_NONEXISTENT:                                             "ERROR_LOG_RECORD_NONEXISTENT",
+	ERROR_LOG_RECORDS_RESERVED_INVALID:                                       "ERROR_LOG_RECORDS_RESERVED_INVALID",
+	ERROR_LOG_SPACE_RESERVED_INVALID:                                         "ERROR_LOG_SPACE_RESERVED_INVALID",
+	ERROR_LOG_TAIL_INVALID:                                                   "ERROR_LOG_TAIL_INVALID",
+	ERROR_LOG_FULL:                                                           "ERROR_LOG_FULL",
+	ERROR_COULD_NOT_RESIZE_LOG:                                               "ERROR_COULD_NOT_RESIZE_LOG",
+	ERROR_LOG_MULTIPLEXED:                                                    "ERROR_LOG_MULTIPLEXED",
+	ERROR_LOG_DEDICATED:                                                      "ERROR_LOG_DEDICATED",
+	ERROR_LOG_ARCHIVE_NOT_IN_PROGRESS:                                        "ERROR_LOG_ARCHIVE_NOT_IN_PROGRESS",
+	ERROR_LOG_ARCHIVE_IN_PROGRESS:                                            "ERROR_LOG_ARCHIVE_IN_PROGRESS",
+	ERROR_LOG_EPHEMERAL:                                                      "ERROR_LOG_EPHEMERAL",
+	ERROR_LOG_NOT_ENOUGH_CONTAINERS:                                          "ERROR_LOG_NOT_ENOUGH_CONTAINERS",
+	ERROR_LOG_CLIENT_ALREADY_REGISTERED:                                      "ERROR_LOG_CLIENT_ALREADY_REGISTERED",
+	ERROR_LOG_CLIENT_NOT_REGISTERED:                                          "ERROR_LOG_CLIENT_NOT_REGISTERED",
+	ERROR_LOG_FULL_HANDLER_IN_PROGRESS:                                       "ERROR_LOG_FULL_HANDLER_IN_PROGRESS",
+	ERROR_LOG_CONTAINER_READ_FAILED:                                          "ERROR_LOG_CONTAINER_READ_FAILED",
+	ERROR_LOG_CONTAINER_WRITE_FAILED:                                         "ERROR_LOG_CONTAINER_WRITE_FAILED",
+	ERROR_LOG_CONTAINER_OPEN_FAILED:                                          "ERROR_LOG_CONTAINER_OPEN_FAILED",
+	ERROR_LOG_CONTAINER_STATE_INVALID:                                        "ERROR_LOG_CONTAINER_STATE_INVALID",
+	ERROR_LOG_STATE_INVALID:                                                  "ERROR_LOG_STATE_INVALID",
+	ERROR_LOG_PINNED:                                                         "ERROR_LOG_PINNED",
+	ERROR_LOG_METADATA_FLUSH_FAILED:                                          "ERROR_LOG_METADATA_FLUSH_FAILED",
+	ERROR_LOG_INCONSISTENT_SECURITY:                                          "ERROR_LOG_INCONSISTENT_SECURITY",
+	ERROR_LOG_APPENDED_FLUSH_FAILED:                                          "ERROR_LOG_APPENDED_FLUSH_FAILED",
+	ERROR_LOG_PINNED_RESERVATION:                                             "ERROR_LOG_PINNED_RESERVATION",
+	ERROR_INVALID_TRANSACTION:                                                "ERROR_INVALID_TRANSACTION",
+	ERROR_TRANSACTION_NOT_ACTIVE:                                             "ERROR_TRANSACTION_NOT_ACTIVE",
+	ERROR_TRANSACTION_REQUEST_NOT_VALID:                                      "ERROR_TRANSACTION_REQUEST_NOT_VALID",
+	ERROR_TRANSACTION_NOT_REQUESTED:                                          "ERROR_TRANSACTION_NOT_REQUESTED",
+	ERROR_TRANSACTION_ALREADY_ABORTED:                                        "ERROR_TRANSACTION_ALREADY_ABORTED",
+	ERROR_TRANSACTION_ALREADY_COMMITTED:                                      "ERROR_TRANSACTION_ALREADY_COMMITTED",
+	ERROR_TM_INITIALIZATION_FAILED:                                           "ERROR_TM_INITIALIZATION_FAILED",
+	ERROR_RESOURCEMANAGER_READ_ONLY:                                          "ERROR_RESOURCEMANAGER_READ_ONLY",
+	ERROR_TRANSACTION_NOT_JOINED:                                             "ERROR_TRANSACTION_NOT_JOINED",
+	ERROR_TRANSACTION_SUPERIOR_EXISTS:                                        "ERROR_TRANSACTION_SUPERIOR_EXISTS",
+	ERROR_CRM_PROTOCOL_ALREADY_EXISTS:                                        "ERROR_CRM_PROTOCOL_ALREADY_EXISTS",
+	ERROR_TRANSACTION_PROPAGATION_FAILED:                                     "ERROR_TRANSACTION_PROPAGATION_FAILED",
+	ERROR_CRM_PROTOCOL_NOT_FOUND:                                             "ERROR_CRM_PROTOCOL_NOT_FOUND",
+	ERROR_TRANSACTION_INVALID_MARSHALL_BUFFER:                                "ERROR_TRANSACTION_INVALID_MARSHALL_BUFFER",
+	ERROR_CURRENT_TRANSACTION_NOT_VALID:                                      "ERROR_CURRENT_TRANSACTION_NOT_VALID",
+	ERROR_TRANSACTION_NOT_FOUND:                                              "ERROR_TRANSACTION_NOT_FOUND",
+	ERROR_RESOURCEMANAGER_NOT_FOUND:                                          "ERROR_RESOURCEMANAGER_NOT_FOUND",
+	ERROR_ENLISTMENT_NOT_FOUND:                                               "ERROR_ENLISTMENT_NOT_FOUND",
+	ERROR_TRANSACTIONMANAGER_NOT_FOUND:                                       "ERROR_TRANSACTIONMANAGER_NOT_FOUND",
+	ERROR_TRANSACTIONMANAGER_NOT_ONLINE:                                      "ERROR_TRANSACTIONMANAGER_NOT_ONLINE",
+	ERROR_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION:                         "ERROR_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION",
+	ERROR_TRANSACTION_NOT_ROOT:                                               "ERROR_TRANSACTION_NOT_ROOT",
+	ERROR_TRANSACTION_OBJECT_EXPIRED:                                         "ERROR_TRANSACTION_OBJECT_EXPIRED",
+	ERROR_TRANSACTION_RESPONSE_NOT_ENLISTED:                                  "ERROR_TRANSACTION_RESPONSE_NOT_ENLISTED",
+	ERROR_TRANSACTION_RECORD_TOO_LONG:                                        "ERROR_TRANSACTION_RECORD_TOO_LONG",
+	ERROR_IMPLICIT_TRANSACTION_NOT_SUPPORTED:                                 "ERROR_IMPLICIT_TRANSACTION_NOT_SUPPORTED",
+	ERROR_TRANSACTION_INTEGRITY_VIOLATED:                                     "ERROR_TRANSACTION_INTEGRITY_VIOLATED",
+	ERROR_TRANSACTIONMANAGER_IDENTITY_MISMATCH:                               "ERROR_TRANSACTIONMANAGER_IDENTITY_MISMATCH",
+	ERROR_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT:                                   "ERROR_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT",
+	ERROR_TRANSACTION_MUST_WRITETHROUGH:                                      "ERROR_TRANSACTION_MUST_WRITETHROUGH",
+	ERROR_TRANSACTION_NO_SUPERIOR:                                            "ERROR_TRANSACTION_NO_SUPERIOR",
+	ERROR_HEURISTIC_DAMAGE_POSSIBLE:                                          "ERROR_HEURISTIC_DAMAGE_POSSIBLE",
+	ERROR_TRANSACTIONAL_CONFLICT:                                             "ERROR_TRANSACTIONAL_CONFLICT",
+	ERROR_RM_NOT_ACTIVE:                                                      "ERROR_RM_NOT_ACTIVE",
+	ERROR_RM_METADATA_CORRUPT:                                                "ERROR_RM_METADATA_CORRUPT",
+	ERROR_DIRECTORY_NOT_RM:                                                   "ERROR_DIRECTORY_NOT_RM",
+	ERROR_TRANSACTIONS_UNSUPPORTED_REMOTE:                                    "ERROR_TRANSACTIONS_UNSUPPORTED_REMOTE",
+	ERROR_LOG_RESIZE_INVALID_SIZE:                                            "ERROR_LOG_RESIZE_INVALID_SIZE",
+	ERROR_OBJECT_NO_LONGER_EXISTS:                                            "ERROR_OBJECT_NO_LONGER_EXISTS",
+	ERROR_STREAM_MINIVERSION_NOT_FOUND:                                       "ERROR_STREAM_MINIVERSION_NOT_FOUND",
+	ERROR_STREAM_MINIVERSION_NOT_VALID:                                       "ERROR_STREAM_MINIVERSION_NOT_VALID",
+	ERROR_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION:                "ERROR_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION",
+	ERROR_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT:                           "ERROR_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT",
+	ERROR_CANT_CREATE_MORE_STREAM_MINIVERSIONS:                               "ERROR_CANT_CREATE_MORE_STREAM_MINIVERSIONS",
+	ERROR_REMOTE_FILE_VERSION_MISMATCH:                                       "ERROR_REMOTE_FILE_VERSION_MISMATCH",
+	ERROR_HANDLE_NO_LONGER_VALID:                                             "ERROR_HANDLE_NO_LONGER_VALID",
+	ERROR_NO_TXF_METADATA:                                                    "ERROR_NO_TXF_METADATA",
+	ERROR_LOG_CORRUPTION_DETECTED:                                            "ERROR_LOG_CORRUPTION_DETECTED",
+	ERROR_CANT_RECOVER_WITH_HANDLE_OPEN:                                      "ERROR_CANT_RECOVER_WITH_HANDLE_OPEN",
+	ERROR_RM_DISCONNECTED:                                                    "ERROR_RM_DISCONNECTED",
+	ERROR_ENLISTMENT_NOT_SUPERIOR:                                            "ERROR_ENLISTMENT_NOT_SUPERIOR",
+	ERROR_RECOVERY_NOT_NEEDED:                                                "ERROR_RECOVERY_NOT_NEEDED",
+	ERROR_RM_ALREADY_STARTED:                                                 "ERROR_RM_ALREADY_STARTED",
+	ERROR_FILE_IDENTITY_NOT_PERSISTENT:                                       "ERROR_FILE_IDENTITY_NOT_PERSISTENT",
+	ERROR_CANT_BREAK_TRANSACTIONAL_DEPENDENCY:                                "ERROR_CANT_BREAK_TRANSACTIONAL_DEPENDENCY",
+	ERROR_CANT_CROSS_RM_BOUNDARY:                                             "ERROR_CANT_CROSS_RM_BOUNDARY",
+	ERROR_TXF_DIR_NOT_EMPTY:                                                  "ERROR_TXF_DIR_NOT_EMPTY",
+	ERROR_INDOUBT_TRANSACTIONS_EXIST:                                         "ERROR_INDOUBT_TRANSACTIONS_EXIST",
+	ERROR_TM_VOLATILE:                                                        "ERROR_TM_VOLATILE",
+	ERROR_ROLLBACK_TIMER_EXPIRED:                                             "ERROR_ROLLBACK_TIMER_EXPIRED",
+	ERROR_TXF_ATTRIBUTE_CORRUPT:                                              "ERROR_TXF_ATTRIBUTE_CORRUPT",
+	ERROR_EFS_NOT_ALLOWED_IN_TRANSACTION:                                     "ERROR_EFS_NOT_ALLOWED_IN_TRANSACTION",
+	ERROR_TRANSACTIONAL_OPEN_NOT_ALLOWED:                                     "ERROR_TRANSACTIONAL_OPEN_NOT_ALLOWED",
+	ERROR_LOG_GROWTH_FAILED:                                                  "ERROR_LOG_GROWTH_FAILED",
+	ERROR_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE:                              "ERROR_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE",
+	ERROR_TXF_METADATA_ALREADY_PRESENT:                                       "ERROR_TXF_METADATA_ALREADY_PRESENT",
+	ERROR_TRANSACTION_SCOPE_CALLBACKS_NOT_SET:                                "ERROR_TRANSACTION_SCOPE_CALLBACKS_NOT_SET",
+	ERROR_TRANSACTION_REQUIRED_PROMOTION:                                     "ERROR_TRANSACTION_REQUIRED_PROMOTION",
+	ERROR_CANNOT_EXECUTE_FILE_IN_TRANSACTION:                                 "ERROR_CANNOT_EXECUTE_FILE_IN_TRANSACTION",
+	ERROR_TRANSACTIONS_NOT_FROZEN:                                            "ERROR_TRANSACTIONS_NOT_FROZEN",
+	ERROR_TRANSACTION_FREEZE_IN_PROGRESS:                                     "ERROR_TRANSACTION_FREEZE_IN_PROGRESS",
+	ERROR_NOT_SNAPSHOT_VOLUME:                                                "ERROR_NOT_SNAPSHOT_VOLUME",
+	ERROR_NO_SAVEPOINT_WITH_OPEN_FILES:                                       "ERROR_NO_SAVEPOINT_WITH_OPEN_FILES",
+	ERROR_DATA_LOST_REPAIR:                                                   "ERROR_DATA_LOST_REPAIR",
+	ERROR_SPARSE_NOT_ALLOWED_IN_TRANSACTION:                                  "ERROR_SPARSE_NOT_ALLOWED_IN_TRANSACTION",
+	ERROR_TM_IDENTITY_MISMATCH:                                               "ERROR_TM_IDENTITY_MISMATCH",
+	ERROR_FLOATED_SECTION:                                                    "ERROR_FLOATED_SECTION",
+	ERROR_CANNOT_ACCEPT_TRANSACTED_WORK:                                      "ERROR_CANNOT_ACCEPT_TRANSACTED_WORK",
+	ERROR_CANNOT_ABORT_TRANSACTIONS:                                          "ERROR_CANNOT_ABORT_TRANSACTIONS",
+	ERROR_BAD_CLUSTERS:                                                       "ERROR_BAD_CLUSTERS",
+	ERROR_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION:                             "ERROR_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION",
+	ERROR_VOLUME_DIRTY:                                                       "ERROR_VOLUME_DIRTY",
+	ERROR_NO_LINK_TRACKING_IN_TRANSACTION:                                    "ERROR_NO_LINK_TRACKING_IN_TRANSACTION",
+	ERROR_OPERATION_NOT_SUPPORTED_IN_TRANSACTION:                             "ERROR_OPERATION_NOT_SUPPORTED_IN_TRANSACTION",
+	ERROR_EXPIRED_HANDLE:                                                     "ERROR_EXPIRED_HANDLE",
+	ERROR_TRANSACTION_NOT_ENLISTED:                                           "ERROR_TRANSACTION_NOT_ENLISTED",
+	ERROR_CTX_WINSTATION_NAME_INVALID:                                        "ERROR_CTX_WINSTATION_NAME_INVALID",
+	ERROR_CTX_INVALID_PD:                                                     "ERROR_CTX_INVALID_PD",
+	ERROR_CTX_PD_NOT_FOUND:                                                   "ERROR_CTX_PD_NOT_FOUND",
+	ERROR_CTX_WD_NOT_FOUND:                                                   "ERROR_CTX_WD_NOT_FOUND",
+	ERROR_CTX_CANNOT_MAKE_EVENTLOG_ENTRY:                                     "ERROR_CTX_CANNOT_MAKE_EVENTLOG_ENTRY",
+	ERROR_CTX_SERVICE_NAME_COLLISION:                                         "ERROR_CTX_SERVICE_NAME_COLLISION",
+	ERROR_CTX_CLOSE_PENDING:                                                  "ERROR_CTX_CLOSE_PENDING",
+	ERROR_CTX_NO_OUTBUF:                                                      "ERROR_CTX_NO_OUTBUF",
+	ERROR_CTX_MODEM_INF_NOT_FOUND:                                            "ERROR_CTX_MODEM_INF_NOT_FOUND",
+	ERROR_CTX_INVALID_MODEMNAME:                                              "ERROR_CTX_INVALID_MODEMNAME",
+	ERROR_CTX_MODEM_RESPONSE_ERROR:                                           "ERROR_CTX_MODEM_RESPONSE_ERROR",
+	ERROR_CTX_MODEM_RESPONSE_TIMEOUT:                                         "ERROR_CTX_MODEM_RESPONSE_TIMEOUT",
+	ERROR_CTX_MODEM_RESPONSE_NO_CARRIER:                                      "ERROR_CTX_MODEM_RESPONSE_NO_CARRIER",
+	ERROR_CTX_MODEM_RESPONSE_NO_DIALTONE:                                     "ERROR_CTX_MODEM_RESPONSE_NO_DIALTONE",
+	ERROR_CTX_MODEM_RESPONSE_BUSY:                                            "ERROR_CTX_MODEM_RESPONSE_BUSY",
+	ERROR_CTX_MODEM_RESPONSE_VOICE:                                           "ERROR_CTX_MODEM_RESPONSE_VOICE",
+	ERROR_CTX_TD_ERROR:                                                       "ERROR_CTX_TD_ERROR",
+	ERROR_CTX_WINSTATION_NOT_FOUND:                                           "ERROR_CTX_WINSTATION_NOT_FOUND",
+	ERROR_CTX_WINSTATION_ALREADY_EXISTS:                                      "ERROR_CTX_WINSTATION_ALREADY_EXISTS",
+	ERROR_CTX_WINSTATION_BUSY:                                                "ERROR_CTX_WINSTATION_BUSY",
+	ERROR_CTX_BAD_VIDEO_MODE:                                                 "ERROR_CTX_BAD_VIDEO_MODE",
+	ERROR_CTX_GRAPHICS_INVALID:                                               "ERROR_CTX_GRAPHICS_INVALID",
+	ERROR_CTX_LOGON_DISABLED:                                                 "ERROR_CTX_LOGON_DISABLED",
+	ERROR_CTX_NOT_CONSOLE:                                                    "ERROR_CTX_NOT_CONSOLE",
+	ERROR_CTX_CLIENT_QUERY_TIMEOUT:                                           "ERROR_CTX_CLIENT_QUERY_TIMEOUT",
+	ERROR_CTX_CONSOLE_DISCONNECT:                                             "ERROR_CTX_CONSOLE_DISCONNECT",
+	ERROR_CTX_CONSOLE_CONNECT:                                                "ERROR_CTX_CONSOLE_CONNECT",
+	ERROR_CTX_SHADOW_DENIED:                                                  "ERROR_CTX_SHADOW_DENIED",
+	ERROR_CTX_WINSTATION_ACCESS_DENIED:                                       "ERROR_CTX_WINSTATION_ACCESS_DENIED",
+	ERROR_CTX_INVALID_WD:                                                     "ERROR_CTX_INVALID_WD",
+	ERROR_CTX_SHADOW_INVALID:                                                 "ERROR_CTX_SHADOW_INVALID",
+	ERROR_CTX_SHADOW_DISABLED:                                                "ERROR_CTX_SHADOW_DISABLED",
+	ERROR_CTX_CLIENT_LICENSE_IN_USE:                                          "ERROR_CTX_CLIENT_LICENSE_IN_USE",
+	ERROR_CTX_CLIENT_LICENSE_NOT_SET:                                         "ERROR_CTX_CLIENT_LICENSE_NOT_SET",
+	ERROR_CTX_LICENSE_NOT_AVAILABLE:                                          "ERROR_CTX_LICENSE_NOT_AVAILABLE",
+	ERROR_CTX_LICENSE_CLIENT_INVALID:                                         "ERROR_CTX_LICENSE_CLIENT_INVALID",
+	ERROR_CTX_LICENSE_EXPIRED:                                                "ERROR_CTX_LICENSE_EXPIRED",
+	ERROR_CTX_SHADOW_NOT_RUNNING:                                             "ERROR_CTX_SHADOW_NOT_RUNNING",
+	ERROR_CTX_SHADOW_ENDED_BY_MODE_CHANGE:                                    "ERROR_CTX_SHADOW_ENDED_BY_MODE_CHANGE",
+	ERROR_ACTIVATION_COUNT_EXCEEDED:                                          "ERROR_ACTIVATION_COUNT_EXCEEDED",
+	ERROR_CTX_WINSTATIONS_DISABLED:                                           "ERROR_CTX_WINSTATIONS_DISABLED",
+	ERROR_CTX_ENCRYPTION_LEVEL_REQUIRED:                                      "ERROR_CTX_ENCRYPTION_LEVEL_REQUIRED",
+	ERROR_CTX_SESSION_IN_USE:                                                 "ERROR_CTX_SESSION_IN_USE",
+	ERROR_CTX_NO_FORCE_LOGOFF:                                                "ERROR_CTX_NO_FORCE_LOGOFF",
+	ERROR_CTX_ACCOUNT_RESTRICTION:                                            "ERROR_CTX_ACCOUNT_RESTRICTION",
+	ERROR_RDP_PROTOCOL_ERROR:                                                 "ERROR_RDP_PROTOCOL_ERROR",
+	ERROR_CTX_CDM_CONNECT:                                                    "ERROR_CTX_CDM_CONNECT",
+	ERROR_CTX_CDM_DISCONNECT:                                                 "ERROR_CTX_CDM_DISCONNECT",
+	ERROR_CTX_SECURITY_LAYER_ERROR:                                           "ERROR_CTX_SECURITY_LAYER_ERROR",
+	ERROR_TS_INCOMPATIBLE_SESSIONS:                                           "ERROR_TS_INCOMPATIBLE_SESSIONS",
+	ERROR_TS_VIDEO_SUBSYSTEM_ERROR:                                           "ERROR_TS_VIDEO_SUBSYSTEM_ERROR",
+	FRS_ERR_INVALID_API_SEQUENCE:                                             "FRS_ERR_INVALID_API_SEQUENCE",
+	FRS_ERR_STARTING_SERVICE:                                                 "FRS_ERR_STARTING_SERVICE",
+	FRS_ERR_STOPPING_SERVICE:                                                 "FRS_ERR_STOPPING_SERVICE",
+	FRS_ERR_INTERNAL_API:                                                     "FRS_ERR_INTERNAL_API",
+	FRS_ERR_INTERNAL:                                                         "FRS_ERR_INTERNAL",
+	FRS_ERR_SERVICE_COMM:                                                     "FRS_ERR_SERVICE_COMM",
+	FRS_ERR_INSUFFICIENT_PRIV:                                                "FRS_ERR_INSUFFICIENT_PRIV",
+	FRS_ERR_AUTHENTICATION:                                                   "FRS_ERR_AUTHENTICATION",
+	FRS_ERR_PARENT_INSUFFICIENT_PRIV:                                         "FRS_ERR_PARENT_INSUFFICIENT_PRIV",
+	FRS_ERR_PARENT_AUTHENTICATION:                                            "FRS_ERR_PARENT_AUTHENTICATION",
+	FRS_ERR_CHILD_TO_PARENT_COMM:                                             "FRS_ERR_CHILD_TO_PARENT_COMM",
+	FRS_ERR_PARENT_TO_CHILD_COMM:                                             "FRS_ERR_PARENT_TO_CHILD_COMM",
+	FRS_ERR_SYSVOL_POPULATE:                                                  "FRS_ERR_SYSVOL_POPULATE",
+	FRS_ERR_SYSVOL_POPULATE_TIMEOUT:                                          "FRS_ERR_SYSVOL_POPULATE_TIMEOUT",
+	FRS_ERR_SYSVOL_IS_BUSY:                                                   "FRS_ERR_SYSVOL_IS_BUSY",
+	FRS_ERR_SYSVOL_DEMOTE:                                                    "FRS_ERR_SYSVOL_DEMOTE",
+	FRS_ERR_INVALID_SERVICE_PARAMETER:                                        "FRS_ERR_INVALID_SERVICE_PARAMETER",
+	ERROR_SHARED_POLICY:                                                      "ERROR_SHARED_POLICY",
+	ERROR_POLICY_OBJECT_NOT_FOUND:                                            "ERROR_POLICY_OBJECT_NOT_FOUND",
+	ERROR_POLICY_ONLY_IN_DS:                                                  "ERROR_POLICY_ONLY_IN_DS",
+	ERROR_PROMOTION_ACTIVE:                                                   "ERROR_PROMOTION_ACTIVE",
+	ERROR_NO_PROMOTION_ACTIVE:                                                "ERROR_NO_PROMOTION_ACTIVE",
+	ERROR_DS_OPERATIONS_ERROR:                                                "ERROR_DS_OPERATIONS_ERROR",
+	ERROR_DS_PROTOCOL_ERROR:                                                  "ERROR_DS_PROTOCOL_ERROR",
+	ERROR_DS_TIMELIMIT_EXCEEDED:                                              "ERROR_DS_TIMELIMIT_EXCEEDED",
+	ERROR_DS_SIZELIMIT_EXCEEDED:                                              "ERROR_DS_SIZELIMIT_EXCEEDED",
+	ERROR_DS_ADMIN_LIMIT_EXCEEDED:                                            "ERROR_DS_ADMIN_LIMIT_EXCEEDED",
+	ERROR_DS_COMPARE_FALSE:                                                   "ERROR_DS_COMPARE_FALSE",
+	ERROR_DS_COMPARE_TRUE:                                                    "ERROR_DS_COMPARE_TRUE",
+	ERROR_DS_AUTH_METHOD_NOT_SUPPORTED:                                       "ERROR_DS_AUTH_METHOD_NOT_SUPPORTED",
+	ERROR_DS_STRONG_AUTH_REQUIRED:                                            "ERROR_DS_STRONG_AUTH_REQUIRED",
+	ERROR_DS_INAPPROPRIATE_AUTH:                                              "ERROR_DS_INAPPROPRIATE_AUTH",
+	ERROR_DS_AUTH_UNKNOWN:                                                    "ERROR_DS_AUTH_UNKNOWN",
+	ERROR_DS_REFERRAL:                                                        "ERROR_DS_REFERRAL",
+	ERROR_DS_UNAVAILABLE_CRIT_EXTENSION:                                      "ERROR_DS_UNAVAILABLE_CRIT_EXTENSION",
+	ERROR_DS_CONFIDENTIALITY_REQUIRED:                                        "ERROR_DS_CONFIDENTIALITY_REQUIRED",
+	ERROR_DS_INAPPROPRIATE_MATCHING:                                          "ERROR_DS_INAPPROPRIATE_MATCHING",
+	ERROR_DS_CONSTRAINT_VIOLATION:                                            "ERROR_DS_CONSTRAINT_VIOLATION",
+	ERROR_DS_NO_SUCH_OBJECT:                                                  "ERROR_DS_NO_SUCH_OBJECT",
+	ERROR_DS_ALIAS_PROBLEM:                                                   "ERROR_DS_ALIAS_PROBLEM",
+	ERROR_DS_INVALID_DN_SYNTAX:                                               "ERROR_DS_INVALID_DN_SYNTAX",
+	ERROR_DS_IS_LEAF:                                                         "ERROR_DS_IS_LEAF",
+	ERROR_DS_ALIAS_DEREF_PROBLEM:                                             "ERROR_DS_ALIAS_DEREF_PROBLEM",
+	ERROR_DS_UNWILLING_TO_PERFORM:                                            "ERROR_DS_UNWILLING_TO_PERFORM",
+	ERROR_DS_LOOP_DETECT:                                                     "ERROR_DS_LOOP_DETECT",
+	ERROR_DS_NAMING_VIOLATION:                                                "ERROR_DS_NAMING_VIOLATION",
+	ERROR_DS_OBJECT_RESULTS_TOO_LARGE:                                        "ERROR_DS_OBJECT_RESULTS_TOO_LARGE",
+	ERROR_DS_AFFECTS_MULTIPLE_DSAS:                                           "ERROR_DS_AFFECTS_MULTIPLE_DSAS",
+	ERROR_DS_SERVER_DOWN:                                                     "ERROR_DS_SERVER_DOWN",
+	ERROR_DS_LOCAL_ERROR:                                                     "ERROR_DS_LOCAL_ERROR",
+	ERROR_DS_ENCODING_ERROR:                                                  "ERROR_DS_ENCODING_ERROR",
+	ERROR_DS_DECODING_ERROR:                                                  "ERROR_DS_DECODING_ERROR",
+	ERROR_DS_FILTER_UNKNOWN:                                                  "ERROR_DS_FILTER_UNKNOWN",
+	ERROR_DS_PARAM_ERROR:                                                     "ERROR_DS_PARAM_ERROR",
+	ERROR_DS_NOT_SUPPORTED:                                                   "ERROR_DS_NOT_SUPPORTED",
+	ERROR_DS_NO_RESULTS_RETURNED:                                             "ERROR_DS_NO_RESULTS_RETURNED",
+	ERROR_DS_CONTROL_NOT_FOUND:                                               "ERROR_DS_CONTROL_NOT_FOUND",
+	ERROR_DS_CLIENT_LOOP:                                                     "ERROR_DS_CLIENT_LOOP",
+	ERROR_DS_REFERRAL_LIMIT_EXCEEDED:                                         "ERROR_DS_REFERRAL_LIMIT_EXCEEDED",
+	ERROR_DS_SORT_CONTROL_MISSING:                                            "ERROR_DS_SORT_CONTROL_MISSING",
+	ERROR_DS_OFFSET_RANGE_ERROR:                                              "ERROR_DS_OFFSET_RANGE_ERROR",
+	ERROR_DS_ROOT_MUST_BE_NC:                                                 "ERROR_DS_ROOT_MUST_BE_NC",
+	ERROR_DS_ADD_REPLICA_INHIBITED:                                           "ERROR_DS_ADD_REPLICA_INHIBITED",
+	ERROR_DS_ATT_NOT_DEF_IN_SCHEMA:                                           "ERROR_DS_ATT_NOT_DEF_IN_SCHEMA",
+	ERROR_DS_MAX_OBJ_SIZE_EXCEEDED:                                           "ERROR_DS_MAX_OBJ_SIZE_EXCEEDED",
+	ERROR_DS_OBJ_STRING_NAME_EXISTS:                                          "ERROR_DS_OBJ_STRING_NAME_EXISTS",
+	ERROR_DS_NO_RDN_DEFINED_IN_SCHEMA:                                        "ERROR_DS_NO_RDN_DEFINED_IN_SCHEMA",
+	ERROR_DS_RDN_DOESNT_MATCH_SCHEMA:                                         "ERROR_DS_RDN_DOESNT_MATCH_SCHEMA",
+	ERROR_DS_NO_REQUESTED_ATTS_FOUND:                                         "ERROR_DS_NO_REQUESTED_ATTS_FOUND",
+	ERROR_DS_USER_BUFFER_TO_SMALL:                                            "ERROR_DS_USER_BUFFER_TO_SMALL",
+	ERROR_DS_ATT_IS_NOT_ON_OBJ:                                               "ERROR_DS_ATT_IS_NOT_ON_OBJ",
+	ERROR_DS_ILLEGAL_MOD_OPERATION:                                           "ERROR_DS_ILLEGAL_MOD_OPERATION",
+	ERROR_DS_OBJ_TOO_LARGE:                                                   "ERROR_DS_OBJ_TOO_LARGE",
+	ERROR_DS_BAD_INSTANCE_TYPE:                                               "ERROR_DS_BAD_INSTANCE_TYPE",
+	ERROR_DS_MASTERDSA_REQUIRED:                                              "ERROR_DS_MASTERDSA_REQUIRED",
+	ERROR_DS_OBJECT_CLASS_REQUIRED:                                           "ERROR_DS_OBJECT_CLASS_REQUIRED",
+	ERROR_DS_MISSING_REQUIRED_ATT:                                            "ERROR_DS_MISSING_REQUIRED_ATT",
+	ERROR_DS_ATT_NOT_DEF_FOR_CLASS:                                           "ERROR_DS_ATT_NOT_DEF_FOR_CLASS",
+	ERROR_DS_ATT_ALREADY_EXISTS:                                              "ERROR_DS_ATT_ALREADY_EXISTS",
+	ERROR_DS_CANT_ADD_ATT_VALUES:                                             "ERROR_DS_CANT_ADD_ATT_VALUES",
+	ERROR_DS_SINGLE_VALUE_CONSTRAINT:                                         "ERROR_DS_SINGLE_VALUE_CONSTRAINT",
+	ERROR_DS_RANGE_CONSTRAINT:                                                "ERROR_DS_RANGE_CONSTRAINT",
+	ERROR_DS_ATT_VAL_ALREADY_EXISTS:                                          "ERROR_DS_ATT_VAL_ALREADY_EXISTS",
+	ERROR_DS_CANT_REM_MISSING_ATT:                                            "ERROR_DS_CANT_REM_MISSING_ATT",
+	ERROR_DS_CANT_REM_MISSING_ATT_VAL:                                        "ERROR_DS_CANT_REM_MISSING_ATT_VAL",
+	ERROR_DS_ROOT_CANT_BE_SUBREF:                                             "ERROR_DS_ROOT_CANT_BE_SUBREF",
+	ERROR_DS_NO_CHAINING:                                                     "ERROR_DS_NO_CHAINING",
+	ERROR_DS_NO_CHAINED_EVAL:                                                 "ERROR_DS_NO_CHAINED_EVAL",
+	ERROR_DS_NO_PARENT_OBJECT:                                                "ERROR_DS_NO_PARENT_OBJECT",
+	ERROR_DS_PARENT_IS_AN_ALIAS:                                              "ERROR_DS_PARENT_IS_AN_ALIAS",
+	ERROR_DS_CANT_MIX_MASTER_AND_REPS:                                        "ERROR_DS_CANT_MIX_MASTER_AND_REPS",
+	ERROR_DS_CHILDREN_EXIST:                                                  "ERROR_DS_CHILDREN_EXIST",
+	ERROR_DS_OBJ_NOT_FOUND:                                                   "ERROR_DS_OBJ_NOT_FOUND",
+	ERROR_DS_ALIASED_OBJ_MISSING:                                             "ERROR_DS_ALIASED_OBJ_MISSING",
+	ERROR_DS_BAD_NAME_SYNTAX:                                                 "ERROR_DS_BAD_NAME_SYNTAX",
+	ERROR_DS_ALIAS_POINTS_TO_ALIAS:                                           "ERROR_DS_ALIAS_POINTS_TO_ALIAS",
+	ERROR_DS_CANT_DEREF_ALIAS:                                                "ERROR_DS_CANT_DEREF_ALIAS",
+	ERROR_DS_OUT_OF_SCOPE:                                                    "ERROR_DS_OUT_OF_SCOPE",
+	ERROR_DS_OBJECT_BEING_REMOVED:                                            "ERROR_DS_OBJECT_BEING_REMOVED",
+	ERROR_DS_CANT_DELETE_DSA_OBJ:                                             "ERROR_DS_CANT_DELETE_DSA_OBJ",
+	ERROR_DS_GENERIC_ERROR:                                                   "ERROR_DS_GENERIC_ERROR",
+	ERROR_DS_DSA_MUST_BE_INT_MASTER:                                          "ERROR_DS_DSA_MUST_BE_INT_MASTER",
+	ERROR_DS_CLASS_NOT_DSA:                                                   "ERROR_DS_CLASS_NOT_DSA",
+	ERROR_DS_INSUFF_ACCESS_RIGHTS:                                            "ERROR_DS_INSUFF_ACCESS_RIGHTS",
+	ERROR_DS_ILLEGAL_SUPERIOR:                                                "ERROR_DS_ILLEGAL_SUPERIOR",
+	ERROR_DS_ATTRIBUTE_OWNED_BY_SAM:                                          "ERROR_DS_ATTRIBUTE_OWNED_BY_SAM",
+	ERROR_DS_NAME_TOO_MANY_PARTS:                                             "ERROR_DS_NAME_TOO_MANY_PARTS",
+	ERROR_DS_NAME_TOO_LONG:                                                   "ERROR_DS_NAME_TOO_LONG",
+	ERROR_DS_NAME_VALUE_TOO_LONG:                                             "ERROR_DS_NAME_VALUE_TOO_LONG",
+	ERROR_DS_NAME_UNPARSEABLE:                                                "ERROR_DS_NAME_UNPARSEABLE",
+	ERROR_DS_NAME_TYPE_UNKNOWN:                                               "ERROR_DS_NAME_TYPE_UNKNOWN",
+	ERROR_DS_NOT_AN_OBJECT:                                                   "ERROR_DS_NOT_AN_OBJECT",
+	ERROR_DS_SEC_DESC_TOO_SHORT:                                              "ERROR_DS_SEC_DESC_TOO_SHORT",
+	ERROR_DS_SEC_DESC_INVALID:                                                "ERROR_DS_SEC_DESC_INVALID",
+	ERROR_DS_NO_DELETED_NAME:                                                 "ERROR_DS_NO_DELETED_NAME",
+	ERROR_DS_SUBREF_MUST_HAVE_PARENT:                                         "ERROR_DS_SUBREF_MUST_HAVE_PARENT",
+	ERROR_DS_NCNAME_MUST_BE_NC:                                               "ERROR_DS_NCNAME_MUST_BE_NC",
+	ERROR_DS_CANT_ADD_SYSTEM_ONLY:                                            "ERROR_DS_CANT_ADD_SYSTEM_ONLY",
+	ERROR_DS_CLASS_MUST_BE_CONCRETE:                                          "ERROR_DS_CLASS_MUST_BE_CONCRETE",
+	ERROR_DS_INVALID_DMD:                                                     "ERROR_DS_INVALID_DMD",
+	ERROR_DS_OBJ_GUID_EXISTS:                                                 "ERROR_DS_OBJ_GUID_EXISTS",
+	ERROR_DS_NOT_ON_BACKLINK:                                                 "ERROR_DS_NOT_ON_BACKLINK",
+	ERROR_DS_NO_CROSSREF_FOR_NC:                                              "ERROR_DS_NO_CROSSREF_FOR_NC",
+	ERROR_DS_SHUTTING_DOWN:                                                   "ERROR_DS_SHUTTING_DOWN",
+	ERROR_DS_UNKNOWN_OPERATION:                                               "ERROR_DS_UNKNOWN_OPERATION",
+	ERROR_DS_INVALID_ROLE_OWNER:                                              "ERROR_DS_INVALID_ROLE_OWNER",
+	ERROR_DS_COULDNT_CONTACT_FSMO:                                            "ERROR_DS_COULDNT_CONTACT_FSMO",
+	ERROR_DS_CROSS_NC_DN_RENAME:                                              "ERROR_DS_CROSS_NC_DN_RENAME",
+	ERROR_DS_CANT_MOD_SYSTEM_ONLY:                                            "ERROR_DS_CANT_MOD_SYSTEM_ONLY",
+	ERROR_DS_REPLICATOR_ONLY:                                                 "ERROR_DS_REPLICATOR_ONLY",
+	ERROR_DS_OBJ_CLASS_NOT_DEFINED:                                           "ERROR_DS_OBJ_CLASS_NOT_DEFINED",
+	ERROR_DS_OBJ_CLASS_NOT_SUBCLASS:                                          "ERROR_DS_OBJ_CLASS_NOT_SUBCLASS",
+	ERROR_DS_NAME_REFERENCE_INVALID:                                          "ERROR_DS_NAME_REFERENCE_INVALID",
+	ERROR_DS_CROSS_REF_EXISTS:                                                "ERROR_DS_CROSS_REF_EXISTS",
+	ERROR_DS_CANT_DEL_MASTER_CROSSREF:                                        "ERROR_DS_CANT_DEL_MASTER_CROSSREF",
+	ERROR_DS_SUBTREE_NOTIFY_NOT_NC_HEAD:                                      "ERROR_DS_SUBTREE_NOTIFY_NOT_NC_HEAD",
+	ERROR_DS_NOTIFY_FILTER_TOO_COMPLEX:                                       "ERROR_DS_NOTIFY_FILTER_TOO_COMPLEX",
+	ERROR_DS_DUP_RDN:                                                         "ERROR_DS_DUP_RDN",
+	ERROR_DS_DUP_OID:                                                         "ERROR_DS_DUP_OID",
+	ERROR_DS_DUP_MAPI_ID:                                                     "ERROR_DS_DUP_MAPI_ID",
+	ERROR_DS_DUP_SCHEMA_ID_GUID:                                              "ERROR_DS_DUP_SCHEMA_ID_GUID",
+	ERROR_DS_DUP_LDAP_DISPLAY_NAME:                                           "ERROR_DS_DUP_LDAP_DISPLAY_NAME",
+	ERROR_DS_SEMANTIC_ATT_TEST:                                               "ERROR_DS_SEMANTIC_ATT_TEST",
+	ERROR_DS_SYNTAX_MISMATCH:                                                 "ERROR_DS_SYNTAX_MISMATCH",
+	ERROR_DS_EXISTS_IN_MUST_HAVE:                                             "ERROR_DS_EXISTS_IN_MUST_HAVE",
+	ERROR_DS_EXISTS_IN_MAY_HAVE:                                              "ERROR_DS_EXISTS_IN_MAY_HAVE",
+	ERROR_DS_NONEXISTENT_MAY_HAVE:                                            "ERROR_DS_NONEXISTENT_MAY_HAVE",
+	ERROR_DS_NONEXISTENT_MUST_HAVE:                                           "ERROR_DS_NONEXISTENT_MUST_HAVE",
+	ERROR_DS_AUX_CLS_TEST_FAIL:                                               "ERROR_DS_AUX_CLS_TEST_FAIL",
+	ERROR_DS_NONEXISTENT_POSS_SUP:                                            "ERROR_DS_NONEXISTENT_POSS_SUP",
+	ERROR_DS_SUB_CLS_TEST_FAIL:                                               "ERROR_DS_SUB_CLS_TEST_FAIL",
+	ERROR_DS_BAD_RDN_ATT_ID_SYNTAX:                                           "ERROR_DS_BAD_RDN_ATT_ID_SYNTAX",
+	ERROR_DS_EXISTS_IN_AUX_CLS:                                               "ERROR_DS_EXISTS_IN_AUX_CLS",
+	ERROR_DS_EXISTS_IN_SUB_CLS:                                               "ERROR_DS_EXISTS_IN_SUB_CLS",
+	ERROR_DS_EXISTS_IN_POSS_SUP:                                              "ERROR_DS_EXISTS_IN_POSS_SUP",
+	ERROR_DS_RECALCSCHEMA_FAILED:                                             "ERROR_DS_RECALCSCHEMA_FAILED",
+	ERROR_DS_TREE_DELETE_NOT_FINISHED:                                        "ERROR_DS_TREE_DELETE_NOT_FINISHED",
+	ERROR_DS_CANT_DELETE:                                                     "ERROR_DS_CANT_DELETE",
+	ERROR_DS_ATT_SCHEMA_REQ_ID:                                               "ERROR_DS_ATT_SCHEMA_REQ_ID",
+	ERROR_DS_BAD_ATT_SCHEMA_SYNTAX:                                           "ERROR_DS_BAD_ATT_SCHEMA_SYNTAX",
+	ERROR_DS_CANT_CACHE_ATT:                                                  "ERROR_DS_CANT_CACHE_ATT",
+	ERROR_DS_CANT_CACHE_CLASS:                                                "ERROR_DS_CANT_CACHE_CLASS",
+	ERROR_DS_CANT_REMOVE_ATT_CACHE:                                           "ERROR_DS_CANT_REMOVE_ATT_CACHE",
+	ERROR_DS_CANT_REMOVE_CLASS_CACHE:                                         "ERROR_DS_CANT_REMOVE_CLASS_CACHE",
+	ERROR_DS_CANT_RETRIEVE_DN:                                                "ERROR_DS_CANT_RETRIEVE_DN",
+	ERROR_DS_MISSING_SUPREF:                                                  "ERROR_DS_MISSING_SUPREF",
+	ERROR_DS_CANT_RETRIEVE_INSTANCE:                                          "ERROR_DS_CANT_RETRIEVE_INSTANCE",
+	ERROR_DS_CODE_INCONSISTENCY:                                              "ERROR_DS_CODE_INCONSISTENCY",
+	ERROR_DS_DATABASE_ERROR:                                                  "ERROR_DS_DATABASE_ERROR",
+	ERROR_DS_GOVERNSID_MISSING:                                               "ERROR_DS_GOVERNSID_MISSING",
+	ERROR_DS_MISSING_EXPECTED_ATT:                                            "ERROR_DS_MISSING_EXPECTED_ATT",
+	ERROR_DS_NCNAME_MISSING_CR_REF:                                           "ERROR_DS_NCNAME_MISSING_CR_REF",
+	ERROR_DS_SECURITY_CHECKING_ERROR:                                         "ERROR_DS_SECURITY_CHECKING_ERROR",
+	ERROR_DS_SCHEMA_NOT_LOADED:                                               "ERROR_DS_SCHEMA_NOT_LOADED",
+	ERROR_DS_SCHEMA_ALLOC_FAILED:                                             "ERROR_DS_SCHEMA_ALLOC_FAILED",
+	ERROR_DS_ATT_SCHEMA_REQ_SYNTAX:                                           "ERROR_DS_ATT_SCHEMA_REQ_SYNTAX",
+	ERROR_DS_GCVERIFY_ERROR:                                                  "ERROR_DS_GCVERIFY_ERROR",
+	ERROR_DS_DRA_SCHEMA_MISMATCH:                                             "ERROR_DS_DRA_SCHEMA_MISMATCH",
+	ERROR_DS_CANT_FIND_DSA_OBJ:                                               "ERROR_DS_CANT_FIND_DSA_OBJ",
+	ERROR_DS_CANT_FIND_EXPECTED_NC:                                           "ERROR_DS_CANT_FIND_EXPECTED_NC",
+	ERROR_DS_CANT_FIND_NC_IN_CACHE:                                           "ERROR_DS_CANT_FIND_NC_IN_CACHE",
+	ERROR_DS_CANT_RETRIEVE_CHILD:                                             "ERROR_DS_CANT_RETRIEVE_CHILD",
+	ERROR_DS_SECURITY_ILLEGAL_MODIFY:                                         "ERROR_DS_SECURITY_ILLEGAL_MODIFY",
+	ERROR_DS_CANT_REPLACE_HIDDEN_REC:                                         "ERROR_DS_CANT_REPLACE_HIDDEN_REC",
+	ERROR_DS_BAD_HIERARCHY_FILE:                                              "ERROR_DS_BAD_HIERARCHY_FILE",
+	ERROR_DS_BUILD_HIERARCHY_TABLE_FAILED:                                    "ERROR_DS_BUILD_HIERARCHY_TABLE_FAILED",
+	ERROR_DS_CONFIG_PARAM_MISSING:                                            "ERROR_DS_CONFIG_PARAM_MISSING",
+	ERROR_DS_COUNTING_AB_INDICES_FAILED:                                      "ERROR_DS_COUNTING_AB_INDICES_FAILED",
+	ERROR_DS_HIERARCHY_TABLE_MALLOC_FAILED:                                   "ERROR_DS_HIERARCHY_TABLE_MALLOC_FAILED",
+	ERROR_DS_INTERNAL_FAILURE:                                                "ERROR_DS_INTERNAL_FAILURE",
+	ERROR_DS_UNKNOWN_ERROR:                                                   "ERROR_DS_UNKNOWN_ERROR",
+	ERROR_DS_ROOT_REQUIRES_CLASS_TOP:                                         "ERROR_DS_ROOT_REQUIRES_CLASS_TOP",
+	ERROR_DS_REFUSING_FSMO_ROLES:                                             "ERROR_DS_REFUSING_FSMO_ROLES",
+	ERROR_DS_MISSING_FSMO_SETTINGS:                                           "ERROR_DS_MISSING_FSMO_SETTINGS",
+	ERROR_DS_UNABLE_TO_SURRENDER_ROLES:                                       "ERROR_DS_UNABLE_TO_SURRENDER_ROLES",
+	ERROR_DS_DRA_GENERIC:                                                     "ERROR_DS_DRA_GENERIC",
+	ERROR_DS_DRA_INVALID_PARAMETER:                                           "ERROR_DS_DRA_INVALID_PARAMETER",
+	ERROR_DS_DRA_BUSY:                                                        "ERROR_DS_DRA_BUSY",
+	ERROR_DS_DRA_BAD_DN:                                                      "ERROR_DS_DRA_BAD_DN",
+	ERROR_DS_DRA_BAD_NC:                                                      "ERROR_DS_DRA_BAD_NC",
+	ERROR_DS_DRA_DN_EXISTS:                                                   "ERROR_DS_DRA_DN_EXISTS",
+	ERROR_DS_DRA_INTERNAL_ERROR:                                              "ERROR_DS_DRA_INTERNAL_ERROR",
+	ERROR_DS_DRA_INCONSISTENT_DIT:                                            "ERROR_DS_DRA_INCONSISTENT_DIT",
+	ERROR_DS_DRA_CONNECTION_FAILED:                                           "ERROR_DS_DRA_CONNECTION_FAILED",
+	ERROR_DS_DRA_BAD_INSTANCE_TYPE:                                           "ERROR_DS_DRA_BAD_INSTANCE_TYPE",
+	ERROR_DS_DRA_OUT_OF_MEM:                                                  "ERROR_DS_DRA_OUT_OF_MEM",
+	ERROR_DS_DRA_MAIL_PROBLEM:                                                "ERROR_DS_DRA_MAIL_PROBLEM",
+	ERROR_DS_DRA_REF_ALREADY_EXISTS:                                          "ERROR_DS_DRA_REF_ALREADY_EXISTS",
+	ERROR_DS_DRA_REF_NOT_FOUND:                                               "ERROR_DS_DRA_REF_NOT_FOUND",
+	ERROR_DS_DRA_OBJ_IS_REP_SOURCE:                                           "ERROR_DS_DRA_OBJ_IS_REP_SOURCE",
+	ERROR_DS_DRA_DB_ERROR:                                                    "ERROR_DS_DRA_DB_ERROR",
+	ERROR_DS_DRA_NO_REPLICA:                                                  "ERROR_DS_DRA_NO_REPLICA",
+	ERROR_DS_DRA_ACCESS_DENIED:                                               "ERROR_DS_DRA_ACCESS_DENIED",
+	ERROR_DS_DRA_NOT_SUPPORTED:                                               "ERROR_DS_DRA_NOT_SUPPORTED",
+	ERROR_DS_DRA_RPC_CANCELLED:                                               "ERROR_DS_DRA_RPC_CANCELLED",
+	ERROR_DS_DRA_SOURCE_DISABLED:                                             "ERROR_DS_DRA_SOURCE_DISABLED",
+	ERROR_DS_DRA_SINK_DISABLED:                                               "ERROR_DS_DRA_SINK_DISABLED",
+	ERROR_DS_DRA_NAME_COLLISION:                                              "ERROR_DS_DRA_NAME_COLLISION",
+	ERROR_DS_DRA_SOURCE_REINSTALLED:                                          "ERROR_DS_DRA_SOURCE_REINSTALLED",
+	ERROR_DS_DRA_MISSING_PARENT:                                              "ERROR_DS_DRA_MISSING_PARENT",
+	ERROR_DS_DRA_PREEMPTED:                                                   "ERROR_DS_DRA_PREEMPTED",
+	ERROR_DS_DRA_ABANDON_SYNC:                                                "ERROR_DS_DRA_ABANDON_SYNC",
+	ERROR_DS_DRA_SHUTDOWN:                                                    "ERROR_DS_DRA_SHUTDOWN",
+	ERROR_DS_DRA_INCOMPATIBLE_PARTIAL_SET:                                    "ERROR_DS_DRA_INCOMPATIBLE_PARTIAL_SET",
+	ERROR_DS_DRA_SOURCE_IS_PARTIAL_REPLICA:                                   "ERROR_DS_DRA_SOURCE_IS_PARTIAL_REPLICA",
+	ERROR_DS_DRA_EXTN_CONNECTION_FAILED:                                      "ERROR_DS_DRA_EXTN_CONNECTION_FAILED",
+	ERROR_DS_INSTALL_SCHEMA_MISMATCH:                                         "ERROR_DS_INSTALL_SCHEMA_MISMATCH",
+	ERROR_DS_DUP_LINK_ID:                                                     "ERROR_DS_DUP_LINK_ID",
+	ERROR_DS_NAME_ERROR_RESOLVING:                                            "ERROR_DS_NAME_ERROR_RESOLVING",
+	ERROR_DS_NAME_ERROR_NOT_FOUND:                                            "ERROR_DS_NAME_ERROR_NOT_FOUND",
+	ERROR_DS_NAME_ERROR_NOT_UNIQUE:                                           "ERROR_DS_NAME_ERROR_NOT_UNIQUE",
+	ERROR_DS_NAME_ERROR_NO_MAPPING:                                           "ERROR_DS_NAME_ERROR_NO_MAPPING",
+	ERROR_DS_NAME_ERROR_DOMAIN_ONLY:                                          "ERROR_DS_NAME_ERROR_DOMAIN_ONLY",
+	ERROR_DS_NAME_ERROR_NO_SYNTACTICAL_MAPPING:                               "ERROR_DS_NAME_ERROR_NO_SYNTACTICAL_MAPPING",
+	ERROR_DS_CONSTRUCTED_ATT_MOD:                                             "ERROR_DS_CONSTRUCTED_ATT_MOD",
+	ERROR_DS_WRONG_OM_OBJ_CLASS:                                              "ERROR_DS_WRONG_OM_OBJ_CLASS",
+	ERROR_DS_DRA_REPL_PENDING:                                                "ERROR_DS_DRA_REPL_PENDING",
+	ERROR_DS_DS_REQUIRED:                                                     "ERROR_DS_DS_REQUIRED",
+	ERROR_DS_INVALID_LDAP_DISPLAY_NAME:                                       "ERROR_DS_INVALID_LDAP_DISPLAY_NAME",
+	ERROR_DS_NON_BASE_SEARCH:                                                 "ERROR_DS_NON_BASE_SEARCH",
+	ERROR_DS_CANT_RETRIEVE_ATTS:                                              "ERROR_DS_CANT_RETRIEVE_ATTS",
+	ERROR_DS_BACKLINK_WITHOUT_LINK:                                           "ERROR_DS_BACKLINK_WITHOUT_LINK",
+	ERROR_DS_EPOCH_MISMATCH:                                                  "ERROR_DS_EPOCH_MISMATCH",
+	ERROR_DS_SRC_NAME_MISMATCH:                                               "ERROR_DS_SRC_NAME_MISMATCH",
+	ERROR_DS_SRC_AND_DST_NC_IDENTICAL:                                        "ERROR_DS_SRC_AND_DST_NC_IDENTICAL",
+	ERROR_DS_DST_NC_MISMATCH:                                                 "ERROR_DS_DST_NC_MISMATCH",
+	ERROR_DS_NOT_AUTHORITIVE_FOR_DST_NC:                                      "ERROR_DS_NOT_AUTHORITIVE_FOR_DST_NC",
+	ERROR_DS_SRC_GUID_MISMATCH:                                               "ERROR_DS_SRC_GUID_MISMATCH",
+	ERROR_DS_CANT_MOVE_DELETED_OBJECT:                                        "ERROR_DS_CANT_MOVE_DELETED_OBJECT",
+	ERROR_DS_PDC_OPERATION_IN_PROGRESS:                                       "ERROR_DS_PDC_OPERATION_IN_PROGRESS",
+	ERROR_DS_CROSS_DOMAIN_CLEANUP_REQD:                                       "ERROR_DS_CROSS_DOMAIN_CLEANUP_REQD",
+	ERROR_DS_ILLEGAL_XDOM_MOVE_OPERATION:                                     "ERROR_DS_ILLEGAL_XDOM_MOVE_OPERATION",
+	ERROR_DS_CANT_WITH_ACCT_GROUP_MEMBERSHPS:                                 "ERROR_DS_CANT_WITH_ACCT_GROUP_MEMBERSHPS",
+	ERROR_DS_NC_MUST_HAVE_NC_PARENT:                                          "ERROR_DS_NC_MUST_HAVE_NC_PARENT",
+	ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE:                                       "ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE",
+	ERROR_DS_DST_DOMAIN_NOT_NATIVE:                                           "ERROR_DS_DST_DOMAIN_NOT_NATIVE",
+	ERROR_DS_MISSING_INFRASTRUCTURE_CONTAINER:                                "ERROR_DS_MISSING_INFRASTRUCTURE_CONTAINER",
+	ERROR_DS_CANT_MOVE_ACCOUNT_GROUP:                                         "ERROR_DS_CANT_MOVE_ACCOUNT_GROUP",
+	ERROR_DS_CANT_MOVE_RESOURCE_GROUP:                                        "ERROR_DS_CANT_MOVE_RESOURCE_GROUP",
+	ERROR_DS_INVALID_SEARCH_FLAG:                                             "ERROR_DS_INVALID_SEARCH_FLAG",
+	ERROR_DS_NO_TREE_DELETE_ABOVE_NC:                                         "ERROR_DS_NO_TREE_DELETE_ABOVE_NC",
+	ERROR_DS_COULDNT_LOCK_TREE_FOR_DELETE:                                    "ERROR_DS_COULDNT_LOCK_TREE_FOR_DELETE",
+	ERROR_DS_COULDNT_IDENTIFY_OBJECTS_FOR_TREE_DELETE:                        "ERROR_DS_COULDNT_IDENTIFY_OBJECTS_FOR_TREE_DELETE",
+	ERROR_DS_SAM_INIT_FAILURE:                                                "ERROR_DS_SAM_INIT_FAILURE",
+	ERROR_DS_SENSITIVE_GROUP_VIOLATION:                                       "ERROR_DS_SENSITIVE_GROUP_VIOLATION",
+	ERROR_DS_CANT_MOD_PRIMARYGROUPID:                                         "ERROR_DS_CANT_MOD_PRIMARYGROUPID",
+	ERROR_DS_ILLEGAL_BASE_SCHEMA_MOD:                                         "ERROR_DS_ILLEGAL_BASE_SCHEMA_MOD",
+	ERROR_DS_NONSAFE_SCHEMA_CHANGE:                                           "ERROR_DS_NONSAFE_SCHEMA_CHANGE",
+	ERROR_DS_SCHEMA_UPDATE_DISALLOWED:                                        "ERROR_DS_SCHEMA_UPDATE_DISALLOWED",
+	ERROR_DS_CANT_CREATE_UNDER_SCHEMA:                                        "ERROR_DS_CANT_CREATE_UNDER_SCHEMA",
+	ERROR_DS_INSTALL_NO_SRC_SCH_VERSION:                                      "ERROR_DS_INSTALL_NO_SRC_SCH_VERSION",
+	ERROR_DS_INSTALL_NO_SCH_VERSION_IN_INIFILE:                               "ERROR_DS_INSTALL_NO_SCH_VERSION_IN_INIFILE",
+	ERROR_DS_INVALID_GROUP_TYPE:                                              "ERROR_DS_INVALID_GROUP_TYPE",
+	ERROR_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN:                              "ERROR_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN",
+	ERROR_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN:                               "ERROR_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN",
+	ERROR_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER:                                   "ERROR_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER",
+	ERROR_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER:                               "ERROR_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER",
+	ERROR_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER:                                "ERROR_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER",
+	ERROR_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER:                             "ERROR_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER",
+	ERROR_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER:                        "ERROR_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER",
+	ERROR_DS_HAVE_PRIMARY_MEMBERS:                                            "ERROR_DS_HAVE_PRIMARY_MEMBERS",
+	ERROR_DS_STRING_SD_CONVERSION_FAILED:                                     "ERROR_DS_STRING_SD_CONVERSION_FAILED",
+	ERROR_DS_NAMING_MASTER_GC:                                                "ERROR_DS_NAMING_MASTER_GC",
+	ERROR_DS_DNS_LOOKUP_FAILURE:                                              "ERROR_DS_DNS_LOOKUP_FAILURE",
+	ERROR_DS_COULDNT_UPDATE_SPNS:                                             "ERROR_DS_COULDNT_UPDATE_SPNS",
+	ERROR_DS_CANT_RETRIEVE_SD:                                                "ERROR_DS_CANT_RETRIEVE_SD",
+	ERROR_DS_KEY_NOT_UNIQUE:                                                  "ERROR_DS_KEY_NOT_UNIQUE",
+	ERROR_DS_WRONG_LINKED_ATT_SYNTAX:                                         "ERROR_DS_WRONG_LINKED_ATT_SYNTAX",
+	ERROR_DS_SAM_NEED_BOOTKEY_PASSWORD:                                       "ERROR_DS_SAM_NEED_BOOTKEY_PASSWORD",
+	ERROR_DS_SAM_NEED_BOOTKEY_FLOPPY:                                         "ERROR_DS_SAM_NEED_BOOTKEY_FLOPPY",
+	ERROR_DS_CANT_START:                                                      "ERROR_DS_CANT_START",
+	ERROR_DS_INIT_FAILURE:                                                    "ERROR_DS_INIT_FAILURE",
+	ERROR_DS_NO_PKT_PRIVACY_ON_CONNECTION:                                    "ERROR_DS_NO_PKT_PRIVACY_ON_CONNECTION",
+	ERROR_DS_SOURCE_DOMAIN_IN_FOREST:                                         "ERROR_DS_SOURCE_DOMAIN_IN_FOREST",
+	ERROR_DS_DESTINATION_DOMAIN_NOT_IN_FOREST:                                "ERROR_DS_DESTINATION_DOMAIN_NOT_IN_FOREST",
+	ERROR_DS_DESTINATION_AUDITING_NOT_ENABLED:                                "ERROR_DS_DESTINATION_AUDITING_NOT_ENABLED",
+	ERROR_DS_CANT_FIND_DC_FOR_SRC_DOMAIN:                                     "ERROR_DS_CANT_FIND_DC_FOR_SRC_DOMAIN",
+	ERROR_DS_SRC_OBJ_NOT_GROUP_OR_USER:                                       "ERROR_DS_SRC_OBJ_NOT_GROUP_OR_USER",
+	ERROR_DS_SRC_SID_EXISTS_IN_FOREST:                                        "ERROR_DS_SRC_SID_EXISTS_IN_FOREST",
+	ERROR_DS_SRC_AND_DST_OBJECT_CLASS_MISMATCH:                               "ERROR_DS_SRC_AND_DST_OBJECT_CLASS_MISMATCH",
+	ERROR_SAM_INIT_FAILURE:                                                   "ERROR_SAM_INIT_FAILURE",
+	ERROR_DS_DRA_SCHEMA_INFO_SHIP:                                            "ERROR_DS_DRA_SCHEMA_INFO_SHIP",
+	ERROR_DS_DRA_SCHEMA_CONFLICT:                                             "ERROR_DS_DRA_SCHEMA_CONFLICT",
+	ERROR_DS_DRA_EARLIER_SCHEMA_CONFLICT:                                     "ERROR_DS_DRA_EARLIER_SCHEMA_CONFLICT",
+	ERROR_DS_DRA_OBJ_NC_MISMATCH:                                             "ERROR_DS_DRA_OBJ_NC_MISMATCH",
+	ERROR_DS_NC_STILL_HAS_DSAS:                                               "ERROR_DS_NC_STILL_HAS_DSAS",
+	ERROR_DS_GC_REQUIRED:                                                     "ERROR_DS_GC_REQUIRED",
+	ERROR_DS_LOCAL_MEMBER_OF_LOCAL_ONLY:                                      "ERROR_DS_LOCAL_MEMBER_OF_LOCAL_ONLY",
+	ERROR_DS_NO_FPO_IN_UNIVERSAL_GROUPS:                                      "ERROR_DS_NO_FPO_IN_UNIVERSAL_GROUPS",
+	ERROR_DS_CANT_ADD_TO_GC:                                                  "ERROR_DS_CANT_ADD_TO_GC",
+	ERROR_DS_NO_CHECKPOINT_WITH_PDC:                                          "ERROR_DS_NO_CHECKPOINT_WITH_PDC",
+	ERROR_DS_SOURCE_AUDITING_NOT_ENABLED:                                     "ERROR_DS_SOURCE_AUDITING_NOT_ENABLED",
+	ERROR_DS_CANT_CREATE_IN_NONDOMAIN_NC:                                     "ERROR_DS_CANT_CREATE_IN_NONDOMAIN_NC",
+	ERROR_DS_INVALID_NAME_FOR_SPN:                                            "ERROR_DS_INVALID_NAME_FOR_SPN",
+	ERROR_DS_FILTER_USES_CONTRUCTED_ATTRS:                                    "ERROR_DS_FILTER_USES_CONTRUCTED_ATTRS",
+	ERROR_DS_UNICODEPWD_NOT_IN_QUOTES:                                        "ERROR_DS_UNICODEPWD_NOT_IN_QUOTES",
+	ERROR_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED:                                  "ERROR_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED",
+	ERROR_DS_MUST_BE_RUN_ON_DST_DC:                                           "ERROR_DS_MUST_BE_RUN_ON_DST_DC",
+	ERROR_DS_SRC_DC_MUST_BE_SP4_OR_GREATER:                                   "ERROR_DS_SRC_DC_MUST_BE_SP4_OR_GREATER",
+	ERROR_DS_CANT_TREE_DELETE_CRITICAL_OBJ:                                   "ERROR_DS_CANT_TREE_DELETE_CRITICAL_OBJ",
+	ERROR_DS_INIT_FAILURE_CONSOLE:                                            "ERROR_DS_INIT_FAILURE_CONSOLE",
+	ERROR_DS_SAM_INIT_FAILURE_CONSOLE:                                        "ERROR_DS_SAM_INIT_FAILURE_CONSOLE",
+	ERROR_DS_FOREST_VERSION_TOO_HIGH:                                         "ERROR_DS_FOREST_VERSION_TOO_HIGH",
+	ERROR_DS_DOMAIN_VERSION_TOO_HIGH:                                         "ERROR_DS_DOMAIN_VERSION_TOO_HIGH",
+	ERROR_DS_FOREST_VERSION_TOO_LOW:                                          "ERROR_DS_FOREST_VERSION_TOO_LOW",
+	ERROR_DS_DOMAIN_VERSION_TOO_LOW:                                          "ERROR_DS_DOMAIN_VERSION_TOO_LOW",
+	ERROR_DS_INCOMPATIBLE_VERSION:                                            "ERROR_DS_INCOMPATIBLE_VERSION",
+	ERROR_DS_LOW_DSA_VERSION:                                                 "ERROR_DS_LOW_DSA_VERSION",
+	ERROR_DS_NO_BEHAVIOR_VERSION_IN_MIXEDDOMAIN:                              "ERROR_DS_NO_BEHAVIOR_VERSION_IN_MIXEDDOMAIN",
+	ERROR_DS_NOT_SUPPORTED_SORT_ORDER:                                        "ERROR_DS_NOT_SUPPORTED_SORT_ORDER",
+	ERROR_DS_NAME_NOT_UNIQUE:                                                 "ERROR_DS_NAME_NOT_UNIQUE",
+	ERROR_DS_MACHINE_ACCOUNT_CREATED_PRENT4:                                  "ERROR_DS_MACHINE_ACCOUNT_CREATED_PRENT4",
+	ERROR_DS_OUT_OF_VERSION_STORE:                                            "ERROR_DS_OUT_OF_VERSION_STORE",
+	ERROR_DS_INCOMPATIBLE_CONTROLS_USED:                                      "ERROR_DS_INCOMPATIBLE_CONTROLS_USED",
+	ERROR_DS_NO_REF_DOMAIN:                                                   "ERROR_DS_NO_REF_DOMAIN",
+	ERROR_DS_RESERVED_LINK_ID:                                                "ERROR_DS_RESERVED_LINK_ID",
+	ERROR_DS_LINK_ID_NOT_AVAILABLE:                                           "ERROR_DS_LINK_ID_NOT_AVAILABLE",
+	ERROR_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER:                                   "ERROR_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER",
+	ERROR_DS_MODIFYDN_DISALLOWED_BY_INSTANCE_TYPE:                            "ERROR_DS_MODIFYDN_DISALLOWED_BY_INSTANCE_TYPE",
+	ERROR_DS_NO_OBJECT_MOVE_IN_SCHEMA_NC:                                     "ERROR_DS_NO_OBJECT_MOVE_IN_SCHEMA_NC",
+	ERROR_DS_MODIFYDN_DISALLOWED_BY_FLAG:                                     "ERROR_DS_MODIFYDN_DISALLOWED_BY_FLAG",
+	ERROR_DS_MODIFYDN_WRONG_GRANDPARENT:                                      "ERROR_DS_MODIFYDN_WRONG_GRANDPARENT",
+	ERROR_DS_NAME_ERROR_TRUST_REFERRAL:                                       "ERROR_DS_NAME_ERROR_TRUST_REFERRAL",
+	ERROR_NOT_SUPPORTED_ON_STANDARD_SERVER:                                   "ERROR_NOT_SUPPORTED_ON_STANDARD_SERVER",
+	ERROR_DS_CANT_ACCESS_REMOTE_PART_OF_AD:                                   "ERROR_DS_CANT_ACCESS_REMOTE_PART_OF_AD",
+	ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE_V2:                                    "ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE_V2",
+	ERROR_DS_THREAD_LIMIT_EXCEEDED:                                           "ERROR_DS_THREAD_LIMIT_EXCEEDED",
+	ERROR_DS_NOT_CLOSEST:                                                     "ERROR_DS_NOT_CLOSEST",
+	ERROR_DS_CANT_DERIVE_SPN_WITHOUT_SERVER_REF:                              "ERROR_DS_CANT_DERIVE_SPN_WITHOUT_SERVER_REF",
+	ERROR_DS_SINGLE_USER_MODE_FAILED:                                         "ERROR_DS_SINGLE_USER_MODE_FAILED",
+	ERROR_DS_NTDSCRIPT_SYNTAX_ERROR:                                          "ERROR_DS_NTDSCRIPT_SYNTAX_ERROR",
+	ERROR_DS_NTDSCRIPT_PROCESS_ERROR:                                         "ERROR_DS_NTDSCRIPT_PROCESS_ERROR",
+	ERROR_DS_DIFFERENT_REPL_EPOCHS:                                           "ERROR_DS_DIFFERENT_REPL_EPOCHS",
+	ERROR_DS_DRS_EXTENSIONS_CHANGED:                                          "ERROR_DS_DRS_EXTENSIONS_CHANGED",
+	ERROR_DS_REPLICA_SET_CHANGE_NOT_ALLOWED_ON_DISABLED_CR:                   "ERROR_DS_REPLICA_SET_CHANGE_NOT_ALLOWED_ON_DISABLED_CR",
+	ERROR_DS_NO_MSDS_INTID:                                                   "ERROR_DS_NO_MSDS_INTID",
+	ERROR_DS_DUP_MSDS_INTID:                                                  "ERROR_DS_DUP_MSDS_INTID",
+	ERROR_DS_EXISTS_IN_RDNATTID:                                              "ERROR_DS_EXISTS_IN_RDNATTID",
+	ERROR_DS_AUTHORIZATION_FAILED:                                            "ERROR_DS_AUTHORIZATION_FAILED",
+	ERROR_DS_INVALID_SCRIPT:                                                  "ERROR_DS_INVALID_SCRIPT",
+	ERROR_DS_REMOTE_CROSSREF_OP_FAILED:                                       "ERROR_DS_REMOTE_CROSSREF_OP_FAILED",
+	ERROR_DS_CROSS_REF_BUSY:                                                  "ERROR_DS_CROSS_REF_BUSY",
+	ERROR_DS_CANT_DERIVE_SPN_FOR_DELETED_DOMAIN:                              "ERROR_DS_CANT_DERIVE_SPN_FOR_DELETED_DOMAIN",
+	ERROR_DS_CANT_DEMOTE_WITH_WRITEABLE_NC:                                   "ERROR_DS_CANT_DEMOTE_WITH_WRITEABLE_NC",
+	ERROR_DS_DUPLICATE_ID_FOUND:                                              "ERROR_DS_DUPLICATE_ID_FOUND",
+	ERROR_DS_INSUFFICIENT_ATTR_TO_CREATE_OBJECT:                              "ERROR_DS_INSUFFICIENT_ATTR_TO_CREATE_OBJECT",
+	ERROR_DS_GROUP_CONVERSION_ERROR:                                          "ERROR_DS_GROUP_CONVERSION_ERROR",
+	ERROR_DS_CANT_MOVE_APP_BASIC_GROUP:                                       "ERROR_DS_CANT_MOVE_APP_BASIC_GROUP",
+	ERROR_DS_CANT_MOVE_APP_QUERY_GROUP:                                       "ERROR_DS_CANT_MOVE_APP_QUERY_GROUP",
+	ERROR_DS_ROLE_NOT_VERIFIED:                                               "ERROR_DS_ROLE_NOT_VERIFIED",
+	ERROR_DS_WKO_CONTAINER_CANNOT_BE_SPECIAL:                                 "ERROR_DS_WKO_CONTAINER_CANNOT_BE_SPECIAL",
+	ERROR_DS_DOMAIN_RENAME_IN_PROGRESS:                                       "ERROR_DS_DOMAIN_RENAME_IN_PROGRESS",
+	ERROR_DS_EXISTING_AD_CHILD_NC:                                            "ERROR_DS_EXISTING_AD_CHILD_NC",
+	ERROR_DS_REPL_LIFETIME_EXCEEDED:                                          "ERROR_DS_REPL_LIFETIME_EXCEEDED",
+	ERROR_DS_DISALLOWED_IN_SYSTEM_CONTAINER:                                  "ERROR_DS_DISALLOWED_IN_SYSTEM_CONTAINER",
+	ERROR_DS_LDAP_SEND_QUEUE_FULL:                                            "ERROR_DS_LDAP_SEND_QUEUE_FULL",
+	ERROR_DS_DRA_OUT_SCHEDULE_WINDOW:                                         "ERROR_DS_DRA_OUT_SCHEDULE_WINDOW",
+	ERROR_DS_POLICY_NOT_KNOWN:                                                "ERROR_DS_POLICY_NOT_KNOWN",
+	ERROR_NO_SITE_SETTINGS_OBJECT:                                            "ERROR_NO_SITE_SETTINGS_OBJECT",
+	ERROR_NO_SECRETS:                                                         "ERROR_NO_SECRETS",
+	ERROR_NO_WRITABLE_DC_FOUND:                                               "ERROR_NO_WRITABLE_DC_FOUND",
+	ERROR_DS_NO_SERVER_OBJECT:                                                "ERROR_DS_NO_SERVER_OBJECT",
+	ERROR_DS_NO_NTDSA_OBJECT:                                                 "ERROR_DS_NO_NTDSA_OBJECT",
+	ERROR_DS_NON_ASQ_SEARCH:                                                  "ERROR_DS_NON_ASQ_SEARCH",
+	ERROR_DS_AUDIT_FAILURE:                                                   "ERROR_DS_AUDIT_FAILURE",
+	ERROR_DS_INVALID_SEARCH_FLAG_SUBTREE:                                     "ERROR_DS_INVALID_SEARCH_FLAG_SUBTREE",
+	ERROR_DS_INVALID_SEARCH_FLAG_TUPLE:                                       "ERROR_DS_INVALID_SEARCH_FLAG_TUPLE",
+	ERROR_DS_HIERARCHY_TABLE_TOO_DEEP:                                        "ERROR_DS_HIERARCHY_TABLE_TOO_DEEP",
+	ERROR_DS_DRA_CORRUPT_UTD_VECTOR:                                          "ERROR_DS_DRA_CORRUPT_UTD_VECTOR",
+	ERROR_DS_DRA_SECRETS_DENIED:                                              "ERROR_DS_DRA_SECRETS_DENIED",
+	ERROR_DS_RESERVED_MAPI_ID:                                                "ERROR_DS_RESERVED_MAPI_ID",
+	ERROR_DS_MAPI_ID_NOT_AVAILABLE:                                           "ERROR_DS_MAPI_ID_NOT_AVAILABLE",
+	ERROR_DS_DRA_MISSING_KRBTGT_SECRET:                                       "ERROR_DS_DRA_MISSING_KRBTGT_SECRET",
+	ERROR_DS_DOMAIN_NAME_EXISTS_IN_FOREST:                                    "ERROR_DS_DOMAIN_NAME_EXISTS_IN_FOREST",
+	ERROR_DS_FLAT_NAME_EXISTS_IN_FOREST:                                      "ERROR_DS_FLAT_NAME_EXISTS_IN_FOREST",
+	ERROR_INVALID_USER_PRINCIPAL_NAME:                                        "ERROR_INVALID_USER_PRINCIPAL_NAME",
+	ERROR_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS:                              "ERROR_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS",
+	ERROR_DS_OID_NOT_FOUND:                                                   "ERROR_DS_OID_NOT_FOUND",
+	ERROR_DS_DRA_RECYCLED_TARGET:                                             "ERROR_DS_DRA_RECYCLED_TARGET",
+	ERROR_DS_DISALLOWED_NC_REDIRECT:                                          "ERROR_DS_DISALLOWED_NC_REDIRECT",
+	ERROR_DS_HIGH_ADLDS_FFL:                                                  "ERROR_DS_HIGH_ADLDS_FFL",
+	ERROR_DS_HIGH_DSA_VERSION:                                                "ERROR_DS_HIGH_DSA_VERSION",
+	ERROR_DS_LOW_ADLDS_FFL:                                                   "ERROR_DS_LOW_ADLDS_FFL",
+	ERROR_DOMAIN_SID_SAME_AS_LOCAL_WORKSTATION:                               "ERROR_DOMAIN_SID_SAME_AS_LOCAL_WORKSTATION",
+	ERROR_DS_UNDELETE_SAM_VALIDATION_FAILED:                                  "ERROR_DS_UNDELETE_SAM_VALIDATION_FAILED",
+	ERROR_INCORRECT_ACCOUNT_TYPE:                                             "ERROR_INCORRECT_ACCOUNT_TYPE",
+	ERROR_DS_SPN_VALUE_NOT_UNIQUE_IN_FOREST:                                  "ERROR_DS_SPN_VALUE_NOT_UNIQUE_IN_FOREST",
+	ERROR_DS_UPN_VALUE_NOT_UNIQUE_IN_FOREST:                                  "ERROR_DS_UPN_VALUE_NOT_UNIQUE_IN_FOREST",
+	ERROR_DS_MISSING_FOREST_TRUST:                                            "ERROR_DS_MISSING_FOREST_TRUST",
+	ERROR_DS_VALUE_KEY_NOT_UNIQUE:                                            "ERROR_DS_VALUE_KEY_NOT_UNIQUE",
+	ERROR_WEAK_WHFBKEY_BLOCKED:                                               "ERROR_WEAK_WHFBKEY_BLOCKED",
+	ERROR_DS_PER_ATTRIBUTE_AUTHZ_FAILED_DURING_ADD:                           "ERROR_DS_PER_ATTRIBUTE_AUTHZ_FAILED_DURING_ADD",
+	ERROR_LOCAL_POLICY_MODIFICATION_NOT_SUPPORTED:                            "ERROR_LOCAL_POLICY_MODIFICATION_NOT_SUPPORTED",
+	DNS_ERROR_RCODE_FORMAT_ERROR:                                             "DNS_ERROR_RCODE_FORMAT_ERROR",
+	DNS_ERROR_RCODE_SERVER_FAILURE:                                           "DNS_ERROR_RCODE_SERVER_FAILURE",
+	DNS_ERROR_RCODE_NAME_ERROR:                                               "DNS_ERROR_RCODE_NAME_ERROR",
+	DNS_ERROR_RCODE_NOT_IMPLEMENTED:                                          "DNS_ERROR_RCODE_NOT_IMPLEMENTED",
+	DNS_ERROR_RCODE_REFUSED:                                                  "DNS_ERROR_RCODE_REFUSED",
+	DNS_ERROR_RCODE_YXDOMAIN:                                                 "DNS_ERROR_RCODE_YXDOMAIN",
+	DNS_ERROR_RCODE_YXRRSET:                                                  "DNS_ERROR_RCODE_YXRRSET",
+	DNS_ERROR_RCODE_NXRRSET:                                                  "DNS_ERROR_RCODE_NXRRSET",
+	DNS_ERROR_RCODE_NOTAUTH:                                                  "DNS_ERROR_RCODE_NOTAUTH",
+	DNS_ERROR_RCODE_NOTZONE:                                                  "DNS_ERROR_RCODE_NOTZONE",
+	DNS_ERROR_RCODE_BADSIG:                                                   "DNS_ERROR_RCODE_BADSIG",
+	DNS_ERROR_RCODE_BADKEY:                                                   "DNS_ERROR_RCODE_BADKEY",
+	DNS_ERROR_RCODE_BADTIME:                                                  "DNS_ERROR_RCODE_BADTIME",
+	DNS_INFO_NO_RECORDS:                                                      "DNS_INFO_NO_RECORDS",
+	DNS_ERROR_BAD_PACKET:                                                     "DNS_ERROR_BAD_PACKET",
+	DNS_ERROR_NO_PACKET:                                                      "DNS_ERROR_NO_PACKET",
+	DNS_ERROR_RCODE:                                                          "DNS_ERROR_RCODE",
+	DNS_ERROR_UNSECURE_PACKET:                                                "DNS_ERROR_UNSECURE_PACKET",
+	DNS_REQUEST_PENDING:                                                      "DNS_REQUEST_PENDING",
+	DNS_ERROR_INVALID_TYPE:                                                   "DNS_ERROR_INVALID_TYPE",
+	DNS_ERROR_INVALID_IP_ADDRESS:                                             "DNS_ERROR_INVALID_IP_ADDRESS",
+	DNS_ERROR_INVALID_PROPERTY:                                               "DNS_ERROR_INVALID_PROPERTY",
+	DNS_ERROR_TRY_AGAIN_LATER:                                                "DNS_ERROR_TRY_AGAIN_LATER",
+	DNS_ERROR_NOT_UNIQUE:                                                     "DNS_ERROR_NOT_UNIQUE",
+	DNS_ERROR_NON_RFC_NAME:                                                   "DNS_ERROR_NON_RFC_NAME",
+	DNS_STATUS_FQDN:                                                          "DNS_STATUS_FQDN",
+	DNS_STATUS_DOTTED_NAME:                                                   "DNS_STATUS_DOTTED_NAME",
+	DNS_STATUS_SINGLE_PART_NAME:                                              "DNS_STATUS_SINGLE_PART_NAME",
+	DNS_ERROR_INVALID_NAME_CHAR:                                              "DNS_ERROR_INVALID_NAME_CHAR",
+	DNS_ERROR_NUMERIC_NAME:                                                   "DNS_ERROR_NUMERIC_NAME",
+	DNS_ERROR_NOT_ALLOWED_ON_ROOT_SERVER:                                     "DNS_ERROR_NOT_ALLOWED_ON_ROOT_SERVER",
+	DNS_ERROR_NOT_ALLOWED_UNDER_DELEGATION:                                   "DNS_ERROR_NOT_ALLOWED_UNDER_DELEGATION",
+	DNS_ERROR_CANNOT_FIND_ROOT_HINTS:                                         "DNS_ERROR_CANNOT_FIND_ROOT_HINTS",
+	DNS_ERROR_INCONSISTENT_ROOT_HINTS:                                        "DNS_ERROR_INCONSISTENT_ROOT_HINTS",
+	DNS_ERROR_ZONE_DOES_NOT_EXIST:                                            "DNS_ERROR_ZONE_DOES_NOT_EXIST",
+	DNS_ERROR_NO_ZONE_INFO:                                                   "DNS_ERROR_NO_ZONE_INFO",
+	DNS_ERROR_INVALID_ZONE_OPERATION:                                         "DNS_ERROR_INVALID_ZONE_OPERATION",
+	DNS_ERROR_ZONE_CONFIGURATION_ERROR:                                       "DNS_ERROR_ZONE_CONFIGURATION_ERROR",
+	DNS_ERROR_ZONE_HAS_NO_SOA_RECORD:                                         "DNS_ERROR_ZONE_HAS_NO_SOA_RECORD",
+	DNS_ERROR_ZONE_HAS_NO_NS_RECORDS:                                         "DNS_ERROR_ZONE_HAS_NO_NS_RECORDS",
+	DNS_ERROR_ZONE_LOCKED:                                                    "DNS_ERROR_ZONE_LOCKED",
+	DNS_ERROR_ZONE_CREATION_FAILED:                                           "DNS_ERROR_ZONE_CREATION_FAILED",
+	DNS_ERROR_ZONE_ALREADY_EXISTS:                                            "DNS_ERROR_ZONE_ALREADY_EXISTS",
+	DNS_ERROR_AUTOZONE_ALREADY_EXISTS:                                        "DNS_ERROR_AUTOZONE_ALREADY_EXISTS",
+	DNS_ERROR_INVALID_ZONE_TYPE:                                              "DNS_ERROR_INVALID_ZONE_TYPE",
+	DNS_ERROR_SECONDARY_REQUIRES_MASTER_IP:                                   "DNS_ERROR_SECONDARY_REQUIRES_MASTER_IP",
+	DNS_ERROR_ZONE_NOT_SECONDARY:                                             "DNS_ERROR_ZONE_NOT_SECONDARY",
+	DNS_ERROR_NEED_SECONDARY_ADDRESSES:                                       "DNS_ERROR_NEED_SECONDARY_ADDRESSES",
+	DNS_ERROR_WINS_INIT_FAILED:                                               "DNS_ERROR_WINS_INIT_FAILED",
+	DNS_ERROR_NEED_WINS_SERVERS:                                              "DNS_ERROR_NEED_WINS_SERVERS",
+	DNS_ERROR_NBSTAT_INIT_FAILED:                                             "DNS_ERROR_NBSTAT_INIT_FAILED",
+	DNS_ERROR_SOA_DELETE_INVALID:                                             "DNS_ERROR_SOA_DELETE_INVALID",
+	DNS_ERROR_FORWARDER_ALREADY_EXISTS:                                       "DNS_ERROR_FORWARDER_ALREADY_EXISTS",
+	DNS_ERROR_ZONE_REQUIRES_MASTER_IP:                                        "DNS_ERROR_ZONE_REQUIRES_MASTER_IP",
+	DNS_ERROR_ZONE_IS_SHUTDOWN:                                               "DNS_ERROR_ZONE_IS_SHUTDOWN",
+	DNS_ERROR_PRIMARY_REQUIRES_DATAFILE:                                      "DNS_ERROR_PRIMARY_REQUIRES_DATAFILE",
+	DNS_ERROR_INVALID_DATAFILE_NAME:                                          "DNS_ERROR_INVALID_DATAFILE_NAME",
+	DNS_ERROR_DATAFILE_OPEN_FAILURE:                                          "DNS_ERROR_DATAFILE_OPEN_FAILURE",
+	DNS_ERROR_FILE_WRITEBACK_FAILED:                                          "DNS_ERROR_FILE_WRITEBACK_FAILED",
+	DNS_ERROR_DATAFILE_PARSING:                                               "DNS_ERROR_DATAFILE_PARSING",
+	DNS_ERROR_RECORD_DOES_NOT_EXIST:                                          "DNS_ERROR_RECORD_DOES_NOT_EXIST",
+	DNS_ERROR_RECORD_FORMAT:                                                  "DNS_ERROR_RECORD_FORMAT",
+	DNS_ERROR_NODE_CREATION_FAILED:                                           "DNS_ERROR_NODE_CREATION_FAILED",
+	DNS_ERROR_UNKNOWN_RECORD_TYPE:                                            "DNS_ERROR_UNKNOWN_RECORD_TYPE",
+	DNS_ERROR_RECORD_TIMED_OUT:                                               "DNS_ERROR_RECORD_TIMED_OUT",
+	DNS_ERROR_NAME_NOT_IN_ZONE:                                               "DNS_ERROR_NAME_NOT_IN_ZONE",
+	DNS_ERROR_CNAME_LOOP:                                                     "DNS_ERROR_CNAME_LOOP",
+	DNS_ERROR_NODE_IS_CNAME:                                                  "DNS_ERROR_NODE_IS_CNAME",
+	DNS_ERROR_CNAME_COLLISION:                                                "DNS_ERROR_CNAME_COLLISION",
+	DNS_ERROR_RECORD_ONLY_AT_ZONE_ROOT:                                       "DNS_ERROR_RECORD_ONLY_AT_ZONE_ROOT",
+	DNS_ERROR_RECORD_ALREADY_EXISTS:                                          "DNS_ERROR_RECORD_ALREADY_EXISTS",
+	DNS_ERROR_SECONDARY_DATA:                                                 "DNS_ERROR_SECONDARY_DATA",
+	DNS_ERROR_NO_CREATE_CACHE_DATA:                                           "DNS_ERROR_NO_CREATE_CACHE_DATA",
+	DNS_ERROR_NAME_DOES_NOT_EXIST:                                            "DNS_ERROR_NAME_DOES_NOT_EXIST",
+	DNS_WARNING_PTR_CREATE_FAILED:                                            "DNS_WARNING_PTR_CREATE_FAILED",
+	DNS_WARNING_DOMAIN_UNDELETED:                                             "DNS_WARNING_DOMAIN_UNDELETED",
+	DNS_ERROR_DS_UNAVAILABLE:                                                 "DNS_ERROR_DS_UNAVAILABLE",
+	DNS_ERROR_DS_ZONE_ALREADY_EXISTS:                                         "DNS_ERROR_DS_ZONE_ALREADY_EXISTS",
+	DNS_ERROR_NO_BOOTFILE_IF_DS_ZONE:                                         "DNS_ERROR_NO_BOOTFILE_IF_DS_ZONE",
+	DNS_INFO_AXFR_COMPLETE:                                                   "DNS_INFO_AXFR_COMPLETE",
+	DNS_ERROR_AXFR:                                                           "DNS_ERROR_AXFR",
+	DNS_INFO_ADDED_LOCAL_WINS:                                                "DNS_INFO_ADDED_LOCAL_WINS",
+	DNS_STATUS_CONTINUE_NEEDED:                                               "DNS_STATUS_CONTINUE_NEEDED",
+	DNS_ERROR_NO_TCPIP:                                                       "DNS_ERROR_NO_TCPIP",
+	DNS_ERROR_NO_DNS_SERVERS:                                                 "DNS_ERROR_NO_DNS_SERVERS",
+	DNS_ERROR_DP_DOES_NOT_EXIST:                                              "DNS_ERROR_DP_DOES_NOT_EXIST",
+	DNS_ERROR_DP_ALREADY_EXISTS:                                              "DNS_ERROR_DP_ALREADY_EXISTS",
+	DNS_ERROR_DP_NOT_ENLISTED:                                                "DNS_ERROR_DP_NOT_ENLISTED",
+	DNS_ERROR_DP_ALREADY_ENLISTED:                                            "DNS_ERROR_DP_ALREADY_ENLISTED",
+	DNS_ERROR_DP_NOT_AVAILABLE:                                               "DNS_ERROR_DP_NOT_AVAILABLE",
+	DNS_ERROR_DP_FSMO_ERROR:                                                  "DNS_ERROR_DP_FSMO_ERROR",
+	WSAEINTR:                                                                 "WSAEINTR",
+	WSAEBADF:                                                                 "WSAEBADF",
+	WSAEACCES:                                                                "WSAEACCES",
+	WSAEFAULT:                                                                "WSAEFAULT",
+	WSAEINVAL:                                                                "WSAEINVAL",
+	WSAEMFILE:                                                                "WSAEMFILE",
+	WSAEWOULDBLOCK:                                                           "WSAEWOULDBLOCK",
+	WSAEINPROGRESS:                                                           "WSAEINPROGRESS",
+	WSAEALREADY:                                                              "WSAEALREADY",
+	WSAENOTSOCK:                                                              "WSAENOTSOCK",
+	WSAEDESTADDRREQ:                                                          "WSAEDESTADDRREQ",
+	WSAEMSGSIZE:                                                              "WSAEMSGSIZE",
+	WSAEPROTOTYPE:                                                            "WSAEPROTOTYPE",
+	WSAENOPROTOOPT:                                                           "WSAENOPROTOOPT",
+	WSAEPROTONOSUPPORT:                                                       "WSAEPROTONOSUPPORT",
+	WSAESOCKTNOSUPPORT:                                                       "WSAESOCKTNOSUPPORT",
+	WSAEOPNOTSUPP:                                                            "WSAEOPNOTSUPP",
+	WSAEPFNOSUPPORT:                                                          "WSAEPFNOSUPPORT",
+	WSAEAFNOSUPPORT:                                                          "WSAEAFNOSUPPORT",
+	WSAEADDRINUSE:                                                            "WSAEADDRINUSE",
+	WSAEADDRNOTAVAIL:                                                         "WSAEADDRNOTAVAIL",
+	WSAENETDOWN:                                                              "WSAENETDOWN",
+	WSAENETUNREACH:                                                           "WSAENETUNREACH",
+	WSAENETRESET:                                                             "WSAENETRESET",
+	WSAECONNABORTED:                                                          "WSAECONNABORTED",
+	WSAECONNRESET:                                                            "WSAECONNRESET",
+	WSAENOBUFS:                                                               "WSAENOBUFS",
+	WSAEISCONN:                                                               "WSAEISCONN",
+	WSAENOTCONN:                                                              "WSAENOTCONN",
+	WSAESHUTDOWN:                                                             "WSAESHUTDOWN",
+	WSAETOOMANYREFS:                                                          "WSAETOOMANYREFS",
+	WSAETIMEDOUT:                                                             "WSAETIMEDOUT",
+	WSAECONNREFUSED:                                                          "WSAECONNREFUSED",
+	WSAELOOP:                                                                 "WSAELOOP",
+	WSAENAMETOOLONG:                                                          "WSAENAMETOOLONG",
+	WSAEHOSTDOWN:                                                             "WSAEHOSTDOWN",
+	WSAEHOSTUNREACH:                                                          "WSAEHOSTUNREACH",
+	WSAENOTEMPTY:                                                             "WSAENOTEMPTY",
+	WSAEPROCLIM:                                                              "WSAEPROCLIM",
+	WSAEUSERS:                                                                "WSAEUSERS",
+	WSAEDQUOT:                                                                "WSAEDQUOT",
+	WSAESTALE:                                                                "WSAESTALE",
+	WSAEREMOTE:                                                               "WSAEREMOTE",
+	WSASYSNOTREADY:                                                           "WSASYSNOTREADY",
+	WSAVERNOTSUPPORTED:                                                       "WSAVERNOTSUPPORTED",
+	WSANOTINITIALISED:                                                        "WSANOTINITIALISED",
+	WSAEDISCON:                                                               "WSAEDISCON",
+	WSAENOMORE:                                                               "WSAENOMORE",
+	WSAECANCELLED:                                                            "WSAECANCELLED",
+	WSAEINVALIDPROCTABLE:                                                     "WSAEINVALIDPROCTABLE",
+	WSAEINVALIDPROVIDER:                                                      "WSAEINVALIDPROVIDER",
+	WSAEPROVIDERFAILEDINIT:                                                   "WSAEPROVIDERFAILEDINIT",
+	WSASYSCALLFAILURE:                                                        "WSASYSCALLFAILURE",
+	WSASERVICE_NOT_FOUND:                                                     "WSASERVICE_NOT_FOUND",
+	WSATYPE_NOT_FOUND:                                                        "WSATYPE_NOT_FOUND",
+	WSA_E_NO_MORE:                                                            "WSA_E_NO_MORE",
+	WSA_E_CANCELLED:                                                          "WSA_E_CANCELLED",
+	WSAEREFUSED:                                                              "WSAEREFUSED",
+	WSAHOST_NOT_FOUND:                                                        "WSAHOST_NOT_FOUND",
+	WSATRY_AGAIN:                                                             "WSATRY_AGAIN",
+	WSANO_RECOVERY:                                                           "WSANO_RECOVERY",
+	WSANO_DATA:                                                               "WSANO_DATA",
+	WSA_QOS_RECEIVERS:                                                        "WSA_QOS_RECEIVERS",
+	WSA_QOS_SENDERS:                                                          "WSA_QOS_SENDERS",
+	WSA_QOS_NO_SENDERS:                                                       "WSA_QOS_NO_SENDERS",
+	WSA_QOS_NO_RECEIVERS:                                                     "WSA_QOS_NO_RECEIVERS",
+	WSA_QOS_REQUEST_CONFIRMED:                                                "WSA_QOS_REQUEST_CONFIRMED",
+	WSA_QOS_ADMISSION_FAILURE:                                                "WSA_QOS_ADMISSION_FAILURE",
+	WSA_QOS_POLICY_FAILURE:                                                   "WSA_QOS_POLICY_FAILURE",
+	WSA_QOS_BAD_STYLE:                                                        "WSA_QOS_BAD_STYLE",
+	WSA_QOS_BAD_OBJECT:                                                       "WSA_QOS_BAD_OBJECT",
+	WSA_QOS_TRAFFIC_CTRL_ERROR:                                               "WSA_QOS_TRAFFIC_CTRL_ERROR",
+	WSA_QOS_GENERIC_ERROR:                                                    "WSA_QOS_GENERIC_ERROR",
+	WSA_QOS_ESERVICETYPE:                                                     "WSA_QOS_ESERVICETYPE",
+	WSA_QOS_EFLOWSPEC:                                                        "WSA_QOS_EFLOWSPEC",
+	WSA_QOS_EPROVSPECBUF:                                                     "WSA_QOS_EPROVSPECBUF",
+	WSA_QOS_EFILTERSTYLE:                                                     "WSA_QOS_EFILTERSTYLE",
+	WSA_QOS_EFILTERTYPE:                                                      "WSA_QOS_EFILTERTYPE",
+	WSA_QOS_EFILTERCOUNT:                                                     "WSA_QOS_EFILTERCOUNT",
+	WSA_QOS_EOBJLENGTH:                                                       "WSA_QOS_EOBJLENGTH",
+	WSA_QOS_EFLOWCOUNT:                                                       "WSA_QOS_EFLOWCOUNT",
+	WSA_QOS_EUNKNOWNPSOBJ:                                                    "WSA_QOS_EUNKNOWNPSOBJ",
+	WSA_QOS_EPOLICYOBJ:                                                       "WSA_QOS_EPOLICYOBJ",
+	WSA_QOS_EFLOWDESC:                                                        "WSA_QOS_EFLOWDESC",
+	WSA_QOS_EPSFLOWSPEC:                                                      "WSA_QOS_EPSFLOWSPEC",
+	WSA_QOS_EPSFILTERSPEC:                                                    "WSA_QOS_EPSFILTERSPEC",
+	WSA_QOS_ESDMODEOBJ:                                                       "WSA_QOS_ESDMODEOBJ",
+	WSA_QOS_ESHAPERATEOBJ:                                                    "WSA_QOS_ESHAPERATEOBJ",
+	WSA_QOS_RESERVED_PETYPE:                                                  "WSA_QOS_RESERVED_PETYPE",
+	ERROR_SXS_SECTION_NOT_FOUND:                                              "ERROR_SXS_SECTION_NOT_FOUND",
+	ERROR_SXS_CANT_GEN_ACTCTX:                                                "ERROR_SXS_CANT_GEN_ACTCTX",
+	ERROR_SXS_INVALID_ACTCTXDATA_FORMAT:                                      "ERROR_SXS_INVALID_ACTCTXDATA_FORMAT",
+	ERROR_SXS_ASSEMBLY_NOT_FOUND:                                             "ERROR_SXS_ASSEMBLY_NOT_FOUND",
+	ERROR_SXS_MANIFEST_FORMAT_ERROR:                                          "ERROR_SXS_MANIFEST_FORMAT_ERROR",
+	ERROR_SXS_MANIFEST_PARSE_ERROR:                                           "ERROR_SXS_MANIFEST_PARSE_ERROR",
+	ERROR_SXS_ACTIVATION_CONTEXT_DISABLED:                                    "ERROR_SXS_ACTIVATION_CONTEXT_DISABLED",
+	ERROR_SXS_KEY_NOT_FOUND:                                                  "ERROR_SXS_KEY_NOT_FOUND",
+	ERROR_SXS_VERSION_CONFLICT:                                               "ERROR_SXS_VERSION_CONFLICT",
+	ERROR_SXS_WRONG_SECTION_TYPE:                                             "ERROR_SXS_WRONG_SECTION_TYPE",
+	ERROR_SXS_THREAD_QUERIES_DISABLED:                                        "ERROR_SXS_THREAD_QUERIES_DISABLED",
+	ERROR_SXS_PROCESS_DEFAULT_ALREADY_SET:                                    "ERROR_SXS_PROCESS_DEFAULT_ALREADY_SET",
+	ERROR_SXS_UNKNOWN_ENCODING_GROUP:                                         "ERROR_SXS_UNKNOWN_ENCODING_GROUP",
+	ERROR_SXS_UNKNOWN_ENCODING:                                               "ERROR_SXS_UNKNOWN_ENCODING",
+	ERROR_SXS_INVALID_XML_NAMESPACE_URI:                                      "ERROR_SXS_INVALID_XML_NAMESPACE_URI",
+	ERROR_SXS_ROOT_MANIFEST_DEPENDENCY_NOT_INSTALLED:                         "ERROR_SXS_ROOT_MANIFEST_DEPENDENCY_NOT_INSTALLED",
+	ERROR_SXS_LEAF_MANIFEST_DEPENDENCY_NOT_INSTALLED:                         "ERROR_SXS_LEAF_MANIFEST_DEPENDENCY_NOT_INSTALLED",
+	ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE:                            "ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE",
+	ERROR_SXS_MANIFEST_MISSING_REQUIRED_DEFAULT_NAMESPACE:                    "ERROR_SXS_MANIFEST_MISSING_REQUIRED_DEFAULT_NAMESPACE",
+	ERROR_SXS_MANIFEST_INVALID_REQUIRED_DEFAULT_NAMESPACE:                    "ERROR_SXS_MANIFEST_INVALID_REQUIRED_DEFAULT_NAMESPACE",
+	ERROR_SXS_PRIVATE_MANIFEST_CROSS_PATH_WITH_REPARSE_POINT:                 "ERROR_SXS_PRIVATE_MANIFEST_CROSS_PATH_WITH_REPARSE_POINT",
+	ERROR_SXS_DUPLICATE_DLL_NAME:                                             "ERROR_SXS_DUPLICATE_DLL_NAME",
+	ERROR_SXS_DUPLICATE_WINDOWCLASS_NAME:                                     "ERROR_SXS_DUPLICATE_WINDOWCLASS_NAME",
+	ERROR_SXS_DUPLICATE_CLSID:                                                "ERROR_SXS_DUPLICATE_CLSID",
+	ERROR_SXS_DUPLICATE_IID:                                                  "ERROR_SXS_DUPLICATE_IID",
+	ERROR_SXS_DUPLICATE_TLBID:                                                "ERROR_SXS_DUPLICATE_TLBID",
+	ERROR_SXS_DUPLICATE_PROGID:                                               "ERROR_SXS_DUPLICATE_PROGID",
+	ERROR_SXS_DUPLICATE_ASSEMBLY_NAME:                                        "ERROR_SXS_DUPLICATE_ASSEMBLY_NAME",
+	ERROR_SXS_FILE_HASH_MISMATCH:                                             "ERROR_SXS_FILE_HASH_MISMATCH",
+	ERROR_SXS_POLICY_PARSE_ERROR:                                             "ERROR_SXS_POLICY_PARSE_ERROR",
+	ERROR_SXS_XML_E_MISSINGQUOTE:                                             "ERROR_SXS_XML_E_MISSINGQUOTE",
+	ERROR_SXS_XML_E_COMMENTSYNTAX:                                            "ERROR_SXS_XML_E_COMMENTSYNTAX",
+	ERROR_SXS_XML_E_BADSTARTNAMECHAR:                                         "ERROR_SXS_XML_E_BADSTARTNAMECHAR",
+	ERROR_SXS_XML_E_BADNAMECHAR:                                              "ERROR_SXS_XML_E_BADNAMECHAR",
+	ERROR_SXS_XML_E_BADCHARINSTRING:                                          "ERROR_SXS_XML_E_BADCHARINSTRING",
+	ERROR_SXS_XML_E_XMLDECLSYNTAX:                                            "ERROR_SXS_XML_E_XMLDECLSYNTAX",
+	ERROR_SXS_XML_E_BADCHARDATA:                                              "ERROR_SXS_XML_E_BADCHARDATA",
+	ERROR_SXS_XML_E_MISSINGWHITESPACE:                                        "ERROR_SXS_XML_E_MISSINGWHITESPACE",
+	ERROR_SXS_XML_E_EXPECTINGTAGEND:                                          "ERROR_SXS_XML_E_EXPECTINGTAGEND",
+	ERROR_SXS_XML_E_MISSINGSEMICOLON:                                         "ERROR_SXS_XML_E_MISSINGSEMICOLON",
+	ERROR_SXS_XML_E_UNBALANCEDPAREN:                                          "ERROR_SXS_XML_E_UNBALANCEDPAREN",
+	ERROR_SXS_XML_E_INTERNALERROR:                                            "ERROR_SXS_XML_E_INTERNALERROR",
+	ERROR_SXS_XML_E_UNEXPECTED_WHITESPACE:                                    "ERROR_SXS_XML_E_UNEXPECTED_WHITESPACE",
+	ERROR_SXS_XML_E_INCOMPLETE_ENCODING:                                      "ERROR_SXS_XML_E_INCOMPLETE_ENCODING",
+	ERROR_SXS_XML_E_MISSING_PAREN:                                            "ERROR_SXS_XML_E_MISSING_PAREN",
+	ERROR_SXS_XML_E_EXPECTINGCLOSEQUOTE:                                      "ERROR_SXS_XML_E_EXPECTINGCLOSEQUOTE",
+	ERROR_SXS_XML_E_MULTIPLE_COLONS:                                          "ERROR_SXS_XML_E_MULTIPLE_COLONS",
+	ERROR_SXS_XML_E_INVALID_DECIMAL:                                          "ERROR_SXS_XML_E_INVALID_DECIMAL",
+	ERROR_SXS_XML_E_INVALID_HEXIDECIMAL:                                      "ERROR_SXS_XML_E_INVALID_HEXIDECIMAL",
+	ERROR_SXS_XML_E_INVALID_UNICODE:                                          "ERROR_SXS_XML_E_INVALID_UNICODE",
+	ERROR_SXS_XML_E_WHITESPACEORQUESTIONMARK:                                 "ERROR_SXS_XML_E_WHITESPACEORQUESTIONMARK",
+	ERROR_SXS_XML_E_UNEXPECTEDENDTAG:                                         "ERROR_SXS_XML_E_UNEXPECTEDENDTAG",
+	ERROR_SXS_XML_E_UNCLOSEDTAG:                                              "ERROR_SXS_XML_E_UNCLOSEDTAG",
+	ERROR_SXS_XML_E_DUPLICATEATTRIBUTE:                                       "ERROR_SXS_XML_E_DUPLICATEATTRIBUTE",
+	ERROR_SXS_XML_E_MULTIPLEROOTS:                                            "ERROR_SXS_XML_E_MULTIPLEROOTS",
+	ERROR_SXS_XML_E_INVALIDATROOTLEVEL:                                       "ERROR_SXS_XML_E_INVALIDATROOTLEVEL",
+	ERROR_SXS_XML_E_BADXMLDECL:                                               "ERROR_SXS_XML_E_BADXMLDECL",
+	ERROR_SXS_XML_E_MISSINGROOT:                                              "ERROR_SXS_XML_E_MISSINGROOT",
+	ERROR_SXS_XML_E_UNEXPECTEDEOF:                                            "ERROR_SXS_XML_E_UNEXPECTEDEOF",
+	ERROR_SXS_XML_E_BADPEREFINSUBSET:                                         "ERROR_SXS_XML_E_BADPEREFINSUBSET",
+	ERROR_SXS_XML_E_UNCLOSEDSTARTTAG:                                         "ERROR_SXS_XML_E_UNCLOSEDSTARTTAG",
+	ERROR_SXS_XML_E_UNCLOSEDENDTAG:                                           "ERROR_SXS_XML_E_UNCLOSEDENDTAG",
+	ERROR_SXS_XML_E_UNCLOSEDSTRING:                                           "ERROR_SXS_XML_E_UNCLOSEDSTRING",
+	ERROR_SXS_XML_E_UNCLOSEDCOMMENT:                                          "ERROR_SXS_XML_E_UNCLOSEDCOMMENT",
+	ERROR_SXS_XML_E_UNCLOSEDDECL:                                             "ERROR_SXS_XML_E_UNCLOSEDDECL",
+	ERROR_SXS_XML_E_UNCLOSEDCDATA:                                            "ERROR_SXS_XML_E_UNCLOSEDCDATA",
+	ERROR_SXS_XML_E_RESERVEDNAMESPACE:                                        "ERROR_SXS_XML_E_RESERVEDNAMESPACE",
+	ERROR_SXS_XML_E_INVALIDENCODING:                                          "ERROR_SXS_XML_E_INVALIDENCODING",
+	ERROR_SXS_XML_E_INVALIDSWITCH:                                            "ERROR_SXS_XML_E_INVALIDSWITCH",
+	ERROR_SXS_XML_E_BADXMLCASE:                                               "ERROR_SXS_XML_E_BADXMLCASE",
+	ERROR_SXS_XML_E_INVALID_STANDALONE:                                       "ERROR_SXS_XML_E_INVALID_STANDALONE",
+	ERROR_SXS_XML_E_UNEXPECTED_STANDALONE:                                    "ERROR_SXS_XML_E_UNEXPECTED_STANDALONE",
+	ERROR_SXS_XML_E_INVALID_VERSION:                                          "ERROR_SXS_XML_E_INVALID_VERSION",
+	ERROR_SXS_XML_E_MISSINGEQUALS:                                            "ERROR_SXS_XML_E_MISSINGEQUALS",
+	ERROR_SXS_PROTECTION_RECOVERY_FAILED:                                     "ERROR_SXS_PROTECTION_RECOVERY_FAILED",
+	ERROR_SXS_PROTECTION_PUBLIC_KEY_TOO_SHORT:                                "ERROR_SXS_PROTECTION_PUBLIC_KEY_TOO_SHORT",
+	ERROR_SXS_PROTECTION_CATALOG_NOT_VALID:                                   "ERROR_SXS_PROTECTION_CATALOG_NOT_VALID",
+	ERROR_SXS_UNTRANSLATABLE_HRESULT:                                         "ERROR_SXS_UNTRANSLATABLE_HRESULT",
+	ERROR_SXS_PROTECTION_CATALOG_FILE_MISSING:                                "ERROR_SXS_PROTECTION_CATALOG_FILE_MISSING",
+	ERROR_SXS_MISSING_ASSEMBLY_IDENTITY_ATTRIBUTE:                            "ERROR_SXS_MISSING_ASSEMBLY_IDENTITY_ATTRIBUTE",
+	ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE_NAME:                       "ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE_NAME",
+	ERROR_SXS_ASSEMBLY_MISSING:                                               "ERROR_SXS_ASSEMBLY_MISSING",
+	ERROR_SXS_CORRUPT_ACTIVATION_STACK:                                       "ERROR_SXS_CORRUPT_ACTIVATION_STACK",
+	ERROR_SXS_CORRUPTION:                                                     "ERROR_SXS_CORRUPTION",
+	ERROR_SXS_EARLY_DEACTIVATION:                                             "ERROR_SXS_EARLY_DEACTIVATION",
+	ERROR_SXS_INVALID_DEACTIVATION:                                           "ERROR_SXS_INVALID_DEACTIVATION",
+	ERROR_SXS_MULTIPLE_DEACTIVATION:                                          "ERROR_SXS_MULTIPLE_DEACTIVATION",
+	ERROR_SXS_PROCESS_TERMINATION_REQUESTED:                                  "ERROR_SXS_PROCESS_TERMINATION_REQUESTED",
+	ERROR_SXS_RELEASE_ACTIVATION_CONTEXT:                                     "ERROR_SXS_RELEASE_ACTIVATION_CONTEXT",
+	ERROR_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY:                        "ERROR_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY",
+	ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE:                               "ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE",
+	ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME:                                "ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME",
+	ERROR_SXS_IDENTITY_DUPLICATE_ATTRIBUTE:                                   "ERROR_SXS_IDENTITY_DUPLICATE_ATTRIBUTE",
+	ERROR_SXS_IDENTITY_PARSE_ERROR:                                           "ERROR_SXS_IDENTITY_PARSE_ERROR",
+	ERROR_MALFORMED_SUBSTITUTION_STRING:                                      "ERROR_MALFORMED_SUBSTITUTION_STRING",
+	ERROR_SXS_INCORRECT_PUBLIC_KEY_TOKEN:                                     "ERROR_SXS_INCORRECT_PUBLIC_KEY_TOKEN",
+	ERROR_UNMAPPED_SUBSTITUTION_STRING:                                       "ERROR_UNMAPPED_SUBSTITUTION_STRING",
+	ERROR_SXS_ASSEMBLY_NOT_LOCKED:                                            "ERROR_SXS_ASSEMBLY_NOT_LOCKED",
+	ERROR_SXS_COMPONENT_STORE_CORRUPT:                                        "ERROR_SXS_COMPONENT_STORE_CORRUPT",
+	ERROR_ADVANCED_INSTALLER_FAILED:                                          "ERROR_ADVANCED_INSTALLER_FAILED",
+	ERROR_XML_ENCODING_MISMATCH:                                              "ERROR_XML_ENCODING_MISMATCH",
+	ERROR_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT:                  "ERROR_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT",
+	ERROR_SXS_IDENTITIES_DIFFERENT:                                           "ERROR_SXS_IDENTITIES_DIFFERENT",
+	ERROR_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT:                                   "ERROR_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT",
+	ERROR_SXS_FILE_NOT_PART_OF_ASSEMBLY:                                      "ERROR_SXS_FILE_NOT_PART_OF_ASSEMBLY",
+	ERROR_SXS_MANIFEST_TOO_BIG:                                               "ERROR_SXS_MANIFEST_TOO_BIG",
+	ERROR_SXS_SETTING_NOT_REGISTERED:                                         "ERROR_SXS_SETTING_NOT_REGISTERED",
+	ERROR_SXS_TRANSACTION_CLOSURE_INCOMPLETE:                                 "ERROR_SXS_TRANSACTION_CLOSURE_INCOMPLETE",
+	ERROR_SMI_PRIMITIVE_INSTALLER_FAILED:                                     "ERROR_SMI_PRIMITIVE_INSTALLER_FAILED",
+	ERROR_GENERIC_COMMAND_FAILED:                                             "ERROR_GENERIC_COMMAND_FAILED",
+	ERROR_SXS_FILE_HASH_MISSING:                                              "ERROR_SXS_FILE_HASH_MISSING",
+	ERROR_IPSEC_QM_POLICY_EXISTS:                                             "ERROR_IPSEC_QM_POLICY_EXISTS",
+	ERROR_IPSEC_QM_POLICY_NOT_FOUND:                                          "ERROR_IPSEC_QM_POLICY_NOT_FOUND",
+	ERROR_IPSEC_QM_POLICY_IN_USE:                                             "ERROR_IPSEC_QM_POLICY_IN_USE",
+	ERROR_IPSEC_MM_POLICY_EXISTS:                                             "ERROR_IPSEC_MM_POLICY_EXISTS",
+	ERROR_IPSEC_MM_POLICY_NOT_FOUND:                                          "ERROR_IPSEC_MM_POLICY_NOT_FOUND",
+	ERROR_IPSEC_MM_POLICY_IN_USE:                                             "ERROR_IPSEC_MM_POLICY_IN_USE",
+	ERROR_IPSEC_MM_FILTER_EXISTS:                                             "ERROR_IPSEC_MM_FILTER_EXISTS",
+	ERROR_IPSEC_MM_FILTER_NOT_FOUND:                                          "ERROR_IPSEC_MM_FILTER_NOT_FOUND",
+	ERROR_IPSEC_TRANSPORT_FILTER_EXISTS:                                      "ERROR_IPSEC_TRANSPORT_FILTER_EXISTS",
+	ERROR_IPSEC_TRANSPORT_FILTER_NOT_FOUND:                                   "ERROR_IPSEC_TRANSPORT_FILTER_NOT_FOUND",
+	ERROR_IPSEC_MM_AUTH_EXISTS:                                               "ERROR_IPSEC_MM_AUTH_EXISTS",
+	ERROR_IPSEC_MM_AUTH_NOT_FOUND:                                            "ERROR_IPSEC_MM_AUTH_NOT_FOUND",
+	ERROR_IPSEC_MM_AUTH_IN_USE:                                               "ERROR_IPSEC_MM_AUTH_IN_USE",
+	ERROR_IPSEC_DEFAULT_MM_POLICY_NOT_FOUND:                                  "ERROR_IPSEC_DEFAULT_MM_POLICY_NOT_FOUND",
+	ERROR_IPSEC_DEFAULT_MM_AUTH_NOT_FOUND:                                    "ERROR_IPSEC_DEFAULT_MM_AUTH_NOT_FOUND",
+	ERROR_IPSEC_DEFAULT_QM_POLICY_NOT_FOUND:                                  "ERROR_IPSEC_DEFAULT_QM_POLICY_NOT_FOUND",
+	ERROR_IPSEC_TUNNEL_FILTER_EXISTS:                                         "ERROR_IPSEC_TUNNEL_FILTER_EXISTS",
+	ERROR_IPSEC_TUNNEL_FILTER_NOT_FOUND:                                      "ERROR_IPSEC_TUNNEL_FILTER_NOT_FOUND",
+	ERROR_IPSEC_MM_FILTER_PENDING_DELETION:                                   "ERROR_IPSEC_MM_FILTER_PENDING_DELETION",
+	ERROR_IPSEC_TRANSPORT_FILTER_PENDING_DELETION:                            "ERROR_IPSEC_TRANSPORT_FILTER_PENDING_DELETION",
+	ERROR_IPSEC_TUNNEL_FILTER_PENDING_DELETION:                               "ERROR_IPSEC_TUNNEL_FILTER_PENDING_DELETION",
+	ERROR_IPSEC_MM_POLICY_PENDING_DELETION:                                   "ERROR_IPSEC_MM_POLICY_PENDING_DELETION",
+	ERROR_IPSEC_MM_AUTH_PENDING_DELETION:                                     "ERROR_IPSEC_MM_AUTH_PENDING_DELETION",
+	ERROR_IPSEC_QM_POLICY_PENDING_DELETION:                                   "ERROR_IPSEC_QM_POLICY_PENDING_DELETION",
+	WARNING_IPSEC_MM_POLICY_PRUNED:                                           "WARNING_IPSEC_MM_POLICY_PRUNED",
+	WARNING_IPSEC_QM_POLICY_PRUNED:                                           "WARNING_IPSEC_QM_POLICY_PRUNED",
+	ERROR_IPSEC_IKE_NEG_STATUS_BEGIN:                                         "ERROR_IPSEC_IKE_NEG_STATUS_BEGIN",
+	ERROR_IPSEC_IKE_AUTH_FAIL:                                                "ERROR_IPSEC_IKE_AUTH_FAIL",
+	ERROR_IPSEC_IKE_ATTRIB_FAIL:                                              "ERROR_IPSEC_IKE_ATTRIB_FAIL",
+	ERROR_IPSEC_IKE_NEGOTIATION_PENDING:                                      "ERROR_IPSEC_IKE_NEGOTIATION_PENDING",
+	ERROR_IPSEC_IKE_GENERAL_PROCESSING_ERROR:                                 "ERROR_IPSEC_IKE_GENERAL_PROCESSING_ERROR",
+	ERROR_IPSEC_IKE_TIMED_OUT:                                                "ERROR_IPSEC_IKE_TIMED_OUT",
+	ERROR_IPSEC_IKE_NO_CERT:                                                  "ERROR_IPSEC_IKE_NO_CERT",
+	ERROR_IPSEC_IKE_SA_DELETED:                                               "ERROR_IPSEC_IKE_SA_DELETED",
+	ERROR_IPSEC_IKE_SA_REAPED:                                                "ERROR_IPSEC_IKE_SA_REAPED",
+	ERROR_IPSEC_IKE_MM_ACQUIRE_DROP:                                          "ERROR_IPSEC_IKE_MM_ACQUIRE_DROP",
+	ERROR_IPSEC_IKE_QM_ACQUIRE_DROP:                                          "ERROR_IPSEC_IKE_QM_ACQUIRE_DROP",
+	ERROR_IPSEC_IKE_QUEUE_DROP_MM:                                            "ERROR_IPSEC_IKE_QUEUE_DROP_MM",
+	ERROR_IPSEC_IKE_QUEUE_DROP_NO_MM:                                         "ERROR_IPSEC_IKE_QUEUE_DROP_NO_MM",
+	ERROR_IPSEC_IKE_DROP_NO_RESPONSE:                                         "ERROR_IPSEC_IKE_DROP_NO_RESPONSE",
+	ERROR_IPSEC_IKE_MM_DELAY_DROP:                                            "ERROR_IPSEC_IKE_MM_DELAY_DROP",
+	ERROR_IPSEC_IKE_QM_DELAY_DROP:                                            "ERROR_IPSEC_IKE_QM_DELAY_DROP",
+	ERROR_IPSEC_IKE_ERROR:                                                    "ERROR_IPSEC_IKE_ERROR",
+	ERROR_IPSEC_IKE_CRL_FAILED:                                               "ERROR_IPSEC_IKE_CRL_FAILED",
+	ERROR_IPSEC_IKE_INVALID_KEY_USAGE:                                        "ERROR_IPSEC_IKE_INVALID_KEY_USAGE",
+	ERROR_IPSEC_IKE_INVALID_CERT_TYPE:                                        "ERROR_IPSEC_IKE_INVALID_CERT_TYPE",
+	ERROR_IPSEC_IKE_NO_PRIVATE_KEY:                                           "ERROR_IPSEC_IKE_NO_PRIVATE_KEY",
+	ERROR_IPSEC_IKE_DH_FAIL:                                                  "ERROR_IPSEC_IKE_DH_FAIL",
+	ERROR_IPSEC_IKE_INVALID_HEADER:                                           "ERROR_IPSEC_IKE_INVALID_HEADER",
+	ERROR_IPSEC_IKE_NO_POLICY:                                                "ERROR_IPSEC_IKE_NO_POLICY",
+	ERROR_IPSEC_IKE_INVALID_SIGNATURE:                                        "ERROR_IPSEC_IKE_INVALID_SIGNATURE",
+	ERROR_IPSEC_IKE_KERBEROS_ERROR:                                           "ERROR_IPSEC_IKE_KERBEROS_ERROR",
+	ERROR_IPSEC_IKE_NO_PUBLIC_KEY:                                            "ERROR_IPSEC_IKE_NO_PUBLIC_KEY",
+	ERROR_IPSEC_IKE_PROCESS_ERR:                                              "ERROR_IPSEC_IKE_PROCESS_ERR",
+	ERROR_IPSEC_IKE_PROCESS_ERR_SA:                                           "ERROR_IPSEC_IKE_PROCESS_ERR_SA",
+	ERROR_IPSEC_IKE_PROCESS_ERR_PROP:                                         "ERROR_IPSEC_IKE_PROCESS_ERR_PROP",
+	ERROR_IPSEC_IKE_PROCESS_ERR_TRANS:                                        "ERROR_IPSEC_IKE_PROCESS_ERR_TRANS",
+	ERROR_IPSEC_IKE_PROCESS_ERR_KE:                                           "ERROR_IPSEC_IKE_PROCESS_ERR_KE",
+	ERROR_IPSEC_IKE_PROCESS_ERR_ID:                                           "ERROR_IPSEC_IKE_PROCESS_ERR_ID",
+	ERROR_IPSEC_IKE_PROCESS_ERR_CERT:                                         "ERROR_IPSEC_IKE_PROCESS_ERR_CERT",
+	ERROR_IPSEC_IKE_PROCESS_ERR_CERT_REQ:                                     "ERROR_IPSEC_IKE_PROCESS_ERR_CERT_REQ",
+	ERROR_IPSEC_IKE_PROCESS_ERR_HASH:                                         "ERROR_IPSEC_IKE_PROCESS_ERR_HASH",
+	ERROR_IPSEC_IKE_PROCESS_ERR_SIG:                                          "ERROR_IPSEC_IKE_PROCESS_ERR_SIG",
+	ERROR_IPSEC_IKE_PROCESS_ERR_NONCE:                                        "ERROR_IPSEC_IKE_PROCESS_ERR_NONCE",
+	ERROR_IPSEC_IKE_PROCESS_ERR_NOTIFY:                                       "ERROR_IPSEC_IKE_PROCESS_ERR_NOTIFY",
+	ERROR_IPSEC_IKE_PROCESS_ERR_DELETE:                                       "ERROR_IPSEC_IKE_PROCESS_ERR_DELETE",
+	ERROR_IPSEC_IKE_PROCESS_ERR_VENDOR:                                       "ERROR_IPSEC_IKE_PROCESS_ERR_VENDOR",
+	ERROR_IPSEC_IKE_INVALID_PAYLOAD:                                          "ERROR_IPSEC_IKE_INVALID_PAYLOAD",
+	ERROR_IPSEC_IKE_LOAD_SOFT_SA:                                             "ERROR_IPSEC_IKE_LOAD_SOFT_SA",
+	ERROR_IPSEC_IKE_SOFT_SA_TORN_DOWN:                                        "ERROR_IPSEC_IKE_SOFT_SA_TORN_DOWN",
+	ERROR_IPSEC_IKE_INVALID_COOKIE:                                           "ERROR_IPSEC_IKE_INVALID_COOKIE",
+	ERROR_IPSEC_IKE_NO_PEER_CERT:                                             "ERROR_IPSEC_IKE_NO_PEER_CERT",
+	ERROR_IPSEC_IKE_PEER_CRL_FAILED:                                          "ERROR_IPSEC_IKE_PEER_CRL_FAILED",
+	ERROR_IPSEC_IKE_POLICY_CHANGE:                                            "ERROR_IPSEC_IKE_POLICY_CHANGE",
+	ERROR_IPSEC_IKE_NO_MM_POLICY:                                             "ERROR_IPSEC_IKE_NO_MM_POLICY",
+	ERROR_IPSEC_IKE_NOTCBPRIV:                                                "ERROR_IPSEC_IKE_NOTCBPRIV",
+	ERROR_IPSEC_IKE_SECLOADFAIL:                                              "ERROR_IPSEC_IKE_SECLOADFAIL",
+	ERROR_IPSEC_IKE_FAILSSPINIT:                                              "ERROR_IPSEC_IKE_FAILSSPINIT",
+	ERROR_IPSEC_IKE_FAILQUERYSSP:                                             "ERROR_IPSEC_IKE_FAILQUERYSSP",
+	ERROR_IPSEC_IKE_SRVACQFAIL:                                               "ERROR_IPSEC_IKE_SRVACQFAIL",
+	ERROR_IPSEC_IKE_SRVQUERYCRED:                                             "ERROR_IPSEC_IKE_SRVQUERYCRED",
+	ERROR_IPSEC_IKE_GETSPIFAIL:                                               "ERROR_IPSEC_IKE_GETSPIFAIL",
+	ERROR_IPSEC_IKE_INVALID_FILTER:                                           "ERROR_IPSEC_IKE_INVALID_FILTER",
+	ERROR_IPSEC_IKE_OUT_OF_MEMORY:                                            "ERROR_IPSEC_IKE_OUT_OF_MEMORY",
+	ERROR_IPSEC_IKE_ADD_UPDATE_KEY_FAILED:                                    "ERROR_IPSEC_IKE_ADD_UPDATE_KEY_FAILED",
+	ERROR_IPSEC_IKE_INVALID_POLICY:                                           "ERROR_IPSEC_IKE_INVALID_POLICY",
+	ERROR_IPSEC_IKE_UNKNOWN_DOI:                                              "ERROR_IPSEC_IKE_UNKNOWN_DOI",
+	ERROR_IPSEC_IKE_INVALID_SITUATION:                                        "ERROR_IPSEC_IKE_INVALID_SITUATION",
+	ERROR_IPSEC_IKE_DH_FAILURE:                                               "ERROR_IPSEC_IKE_DH_FAILURE",
+	ERROR_IPSEC_IKE_INVALID_GROUP:                                            "ERROR_IPSEC_IKE_INVALID_GROUP",
+	ERROR_IPSEC_IKE_ENCRYPT:                                                  "ERROR_IPSEC_IKE_ENCRYPT",
+	ERROR_IPSEC_IKE_DECRYPT:                                                  "ERROR_IPSEC_IKE_DECRYPT",
+	ERROR_IPSEC_IKE_POLICY_MATCH:                                             "ERROR_IPSEC_IKE_POLICY_MATCH",
+	ERROR_IPSEC_IKE_UNSUPPORTED_ID:                                           "ERROR_IPSEC_IKE_UNSUPPORTED_ID",
+	ERROR_IPSEC_IKE_INVALID_HASH:                                             "ERROR_IPSEC_IKE_INVALID_HASH",
+	ERROR_IPSEC_IKE_INVALID_HASH_ALG:                                         "ERROR_IPSEC_IKE_INVALID_HASH_ALG",
+	ERROR_IPSEC_IKE_INVALID_HASH_SIZE:                                        "ERROR_IPSEC_IKE_INVALID_HASH_SIZE",
+	ERROR_IPSEC_IKE_INVALID_ENCRYPT_ALG:                                      "ERROR_IPSEC_IKE_INVALID_ENCRYPT_ALG",
+	ERROR_IPSEC_IKE_INVALID_AUTH_ALG:                                         "ERROR_IPSEC_IKE_INVALID_AUTH_ALG",
+	ERROR_IPSEC_IKE_INVALID_SIG:                                              "ERROR_IPSEC_IKE_INVALID_SIG",
+	ERROR_IPSEC_IKE_LOAD_FAILED:                                              "ERROR_IPSEC_IKE_LOAD_FAILED",
+	ERROR_IPSEC_IKE_RPC_DELETE:                                               "ERROR_IPSEC_IKE_RPC_DELETE",
+	ERROR_IPSEC_IKE_BENIGN_REINIT:                                            "ERROR_IPSEC_IKE_BENIGN_REINIT",
+	ERROR_IPSEC_IKE_INVALID_RESPONDER_LIFETIME_NOTIFY:                        "ERROR_IPSEC_IKE_INVALID_RESPONDER_LIFETIME_NOTIFY",
+	ERROR_IPSEC_IKE_INVALID_CERT_KEYLEN:                                      "ERROR_IPSEC_IKE_INVALID_CERT_KEYLEN",
+	ERROR_IPSEC_IKE_MM_LIMIT:                                                 "ERROR_IPSEC_IKE_MM_LIMIT",
+	ERROR_IPSEC_IKE_NEGOTIATION_DISABLED:                                     "ERROR_IPSEC_IKE_NEGOTIATION_DISABLED",
+	ERROR_IPSEC_IKE_QM_LIMIT:                                                 "ERROR_IPSEC_IKE_QM_LIMIT",
+	ERROR_IPSEC_IKE_MM_EXPIRED:                                               "ERROR_IPSEC_IKE_MM_EXPIRED",
+	ERROR_IPSEC_IKE_PEER_MM_ASSUMED_INVALID:                                  "ERROR_IPSEC_IKE_PEER_MM_ASSUMED_INVALID",
+	ERROR_IPSEC_IKE_CERT_CHAIN_POLICY_MISMATCH:                               "ERROR_IPSEC_IKE_CERT_CHAIN_POLICY_MISMATCH",
+	ERROR_IPSEC_IKE_UNEXPECTED_MESSAGE_ID:                                    "ERROR_IPSEC_IKE_UNEXPECTED_MESSAGE_ID",
+	ERROR_IPSEC_IKE_INVALID_AUTH_PAYLOAD:                                     "ERROR_IPSEC_IKE_INVALID_AUTH_PAYLOAD",
+	ERROR_IPSEC_IKE_DOS_COOKIE_SENT:                                          "ERROR_IPSEC_IKE_DOS_COOKIE_SENT",
+	ERROR_IPSEC_IKE_SHUTTING_DOWN:                                            "ERROR_IPSEC_IKE_SHUTTING_DOWN",
+	ERROR_IPSEC_IKE_CGA_AUTH_FAILED:                                          "ERROR_IPSEC_IKE_CGA_AUTH_FAILED",
+	ERROR_IPSEC_IKE_PROCESS_ERR_NATOA:                                        "ERROR_IPSEC_IKE_PROCESS_ERR_NATOA",
+	ERROR_IPSEC_IKE_INVALID_MM_FOR_QM:                                        "ERROR_IPSEC_IKE_INVALID_MM_FOR_QM",
+	ERROR_IPSEC_IKE_QM_EXPIRED:                                               "ERROR_IPSEC_IKE_QM_EXPIRED",
+	ERROR_IPSEC_IKE_TOO_MANY_FILTERS:                                         "ERROR_IPSEC_IKE_TOO_MANY_FILTERS",
+	ERROR_IPSEC_IKE_NEG_STATUS_END:                                           "ERROR_IPSEC_IKE_NEG_STATUS_END",
+	ERROR_IPSEC_IKE_KILL_DUMMY_NAP_TUNNEL:                                    "ERROR_IPSEC_IKE_KILL_DUMMY_NAP_TUNNEL",
+	ERROR_IPSEC_IKE_INNER_IP_ASSIGNMENT_FAILURE:                              "ERROR_IPSEC_IKE_INNER_IP_ASSIGNMENT_FAILURE",
+	ERROR_IPSEC_IKE_REQUIRE_CP_PAYLOAD_MISSING:                               "ERROR_IPSEC_IKE_REQUIRE_CP_PAYLOAD_MISSING",
+	ERROR_IPSEC_KEY_MODULE_IMPERSONATION_NEGOTIATION_PENDING:                 "ERROR_IPSEC_KEY_MODULE_IMPERSONATION_NEGOTIATION_PENDING",
+	ERROR_IPSEC_IKE_COEXISTENCE_SUPPRESS:                                     "ERROR_IPSEC_IKE_COEXISTENCE_SUPPRESS",
+	ERROR_IPSEC_IKE_RATELIMIT_DROP:                                           "ERROR_IPSEC_IKE_RATELIMIT_DROP",
+	ERROR_IPSEC_IKE_PEER_DOESNT_SUPPORT_MOBIKE:                               "ERROR_IPSEC_IKE_PEER_DOESNT_SUPPORT_MOBIKE",
+	ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE:                                    "ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE",
+	ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_FAILURE:                        "ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_FAILURE",
+	ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE_WITH_OPTIONAL_RETRY:                "ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE_WITH_OPTIONAL_RETRY",
+	ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_AND_CERTMAP_FAILURE:            "ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_AND_CERTMAP_FAILURE",
+	ERROR_IPSEC_IKE_NEG_STATUS_EXTENDED_END:                                  "ERROR_IPSEC_IKE_NEG_STATUS_EXTENDED_END",
+	ERROR_IPSEC_BAD_SPI:                                                      "ERROR_IPSEC_BAD_SPI",
+	ERROR_IPSEC_SA_LIFETIME_EXPIRED:                                          "ERROR_IPSEC_SA_LIFETIME_EXPIRED",
+	ERROR_IPSEC_WRONG_SA:                                                     "ERROR_IPSEC_WRONG_SA",
+	ERROR_IPSEC_REPLAY_CHECK_FAILED:                                          "ERROR_IPSEC_REPLAY_CHECK_FAILED",
+	ERROR_IPSEC_INVALID_PACKET:                                               "ERROR_IPSEC_INVALID_PACKET",
+	ERROR_IPSEC_INTEGRITY_CHECK_FAILED:                                       "ERROR_IPSEC_INTEGRITY_CHECK_FAILED",
+	ERROR_IPSEC_CLEAR_TEXT_DROP:                                              "ERROR_IPSEC_CLEAR_TEXT_DROP",
+	ERROR_IPSEC_AUTH_FIREWALL_DROP:                                           "ERROR_IPSEC_AUTH_FIREWALL_DROP",
+	ERROR_IPSEC_THROTTLE_DROP:                                                "ERROR_IPSEC_THROTTLE_DROP",
+	ERROR_IPSEC_DOSP_BLOCK:                                                   "ERROR_IPSEC_DOSP_BLOCK",
+	ERROR_IPSEC_DOSP_RECEIVED_MULTICAST:                                      "ERROR_IPSEC_DOSP_RECEIVED_MULTICAST",
+	ERROR_IPSEC_DOSP_INVALID_PACKET:                                          "ERROR_IPSEC_DOSP_INVALID_PACKET",
+	ERROR_IPSEC_DOSP_STATE_LOOKUP_FAILED:                                     "ERROR_IPSEC_DOSP_STATE_LOOKUP_FAILED",
+	ERROR_IPSEC_DOSP_MAX_ENTRIES:                                             "ERROR_IPSEC_DOSP_MAX_ENTRIES",
+	ERROR_IPSEC_DOSP_KEYMOD_NOT_ALLOWED:                                      "ERROR_IPSEC_DOSP_KEYMOD_NOT_ALLOWED",
+	ERROR_IPSEC_DOSP_NOT_INSTALLED:                                           "ERROR_IPSEC_DOSP_NOT_INSTALLED",
+	ERROR_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES:                             "ERROR_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES",
+	ERROR_EVT_INVALID_CHANNEL_PATH:                                           "ERROR_EVT_INVALID_CHANNEL_PATH",
+	ERROR_EVT_INVALID_QUERY:                                                  "ERROR_EVT_INVALID_QUERY",
+	ERROR_EVT_PUBLISHER_METADATA_NOT_FOUND:                                   "ERROR_EVT_PUBLISHER_METADATA_NOT_FOUND",
+	ERROR_EVT_EVENT_TEMPLATE_NOT_FOUND:                                       "ERROR_EVT_EVENT_TEMPLATE_NOT_FOUND",
+	ERROR_EVT_INVALID_PUBLISHER_NAME:                                         "ERROR_EVT_INVALID_PUBLISHER_NAME",
+	ERROR_EVT_INVALID_EVENT_DATA:                                             "ERROR_EVT_INVALID_EVENT_DATA",
+	ERROR_EVT_CHANNEL_NOT_FOUND:                                              "ERROR_EVT_CHANNEL_NOT_FOUND",
+	ERROR_EVT_MALFORMED_XML_TEXT:                                             "ERROR_EVT_MALFORMED_XML_TEXT",
+	ERROR_EVT_SUBSCRIPTION_TO_DIRECT_CHANNEL:                                 "ERROR_EVT_SUBSCRIPTION_TO_DIRECT_CHANNEL",
+	ERROR_EVT_CONFIGURATION_ERROR:                                            "ERROR_EVT_CONFIGURATION_ERROR",
+	ERROR_EVT_QUERY_RESULT_STALE:                                             "ERROR_EVT_QUERY_RESULT_STALE",
+	ERROR_EVT_QUERY_RESULT_INVALID_POSITION:                                  "ERROR_EVT_QUERY_RESULT_INVALID_POSITION",
+	ERROR_EVT_NON_VALIDATING_MSXML:                                           "ERROR_EVT_NON_VALIDATING_MSXML",
+	ERROR_EVT_FILTER_ALREADYSCOPED:                                           "ERROR_EVT_FILTER_ALREADYSCOPED",
+	ERROR_EVT_FILTER_NOTELTSET:                                               "ERROR_EVT_FILTER_NOTELTSET",
+	ERROR_EVT_FILTER_INVARG:                                                  "ERROR_EVT_FILTER_INVARG",
+	ERROR_EVT_FILTER_INVTEST:                                                 "ERROR_EVT_FILTER_INVTEST",
+	ERROR_EVT_FILTER_INVTYPE:                                                 "ERROR_EVT_FILTER_INVTYPE",
+	ERROR_EVT_FILTER_PARSEERR:                                                "ERROR_EVT_FILTER_PARSEERR",
+	ERROR_EVT_FILTER_UNSUPPORTEDOP:                                           "ERROR_EVT_FILTER_UNSUPPORTEDOP",
+	ERROR_EVT_FILTER_UNEXPECTEDTOKEN:                                         "ERROR_EVT_FILTER_UNEXPECTEDTOKEN",
+	ERROR_EVT_INVALID_OPERATION_OVER_ENABLED_DIRECT_CHANNEL:                  "ERROR_EVT_INVALID_OPERATION_OVER_ENABLED_DIRECT_CHANNEL",
+	ERROR_EVT_INVALID_CHANNEL_PROPERTY_VALUE:                                 "ERROR_EVT_INVALID_CHANNEL_PROPERTY_VALUE",
+	ERROR_EVT_INVALID_PUBLISHER_PROPERTY_VALUE:                               "ERROR_EVT_INVALID_PUBLISHER_PROPERTY_VALUE",
+	ERROR_EVT_CHANNEL_CANNOT_ACTIVATE:                                        "ERROR_EVT_CHANNEL_CANNOT_ACTIVATE",
+	ERROR_EVT_FILTER_TOO_COMPLEX:                                             "ERROR_EVT_FILTER_TOO_COMPLEX",
+	ERROR_EVT_MESSAGE_NOT_FOUND:                                              "ERROR_EVT_MESSAGE_NOT_FOUND",
+	ERROR_EVT_MESSAGE_ID_NOT_FOUND:                                           "ERROR_EVT_MESSAGE_ID_NOT_FOUND",
+	ERROR_EVT_UNRESOLVED_VALUE_INSERT:                                        "ERROR_EVT_UNRESOLVED_VALUE_INSERT",
+	ERROR_EVT_UNRESOLVED_PARAMETER_INSERT:                                    "ERROR_EVT_UNRESOLVED_PARAMETER_INSERT",
+	ERROR_EVT_MAX_INSERTS_REACHED:                                            "ERROR_EVT_MAX_INSERTS_REACHED",
+	ERROR_EVT_EVENT_DEFINITION_NOT_FOUND:                                     "ERROR_EVT_EVENT_DEFINITION_NOT_FOUND",
+	ERROR_EVT_MESSAGE_LOCALE_NOT_FOUND:                                       "ERROR_EVT_MESSAGE_LOCALE_NOT_FOUND",
+	ERROR_EVT_VERSION_TOO_OLD:                                                "ERROR_EVT_VERSION_TOO_OLD",
+	ERROR_EVT_VERSION_TOO_NEW:                                                "ERROR_EVT_VERSION_TOO_NEW",
+	ERROR_EVT_CANNOT_OPEN_CHANNEL_OF_QUERY:                                   "ERROR_EVT_CANNOT_OPEN_CHANNEL_OF_QUERY",
+	ERROR_EVT_PUBLISHER_DISABLED:                                             "ERROR_EVT_PUBLISHER_DISABLED",
+	ERROR_EVT_FILTER_OUT_OF_RANGE:                                            "ERROR_EVT_FILTER_OUT_OF_RANGE",
+	ERROR_EC_SUBSCRIPTION_CANNOT_ACTIVATE:                                    "ERROR_EC_SUBSCRIPTION_CANNOT_ACTIVATE",
+	ERROR_EC_LOG_DISABLED:                                                    "ERROR_EC_LOG_DISABLED",
+	ERROR_EC_CIRCULAR_FORWARDING:                                             "ERROR_EC_CIRCULAR_FORWARDING",
+	ERROR_EC_CREDSTORE_FULL:                                                  "ERROR_EC_CREDSTORE_FULL",
+	ERROR_EC_CRED_NOT_FOUND:                                                  "ERROR_EC_CRED_NOT_FOUND",
+	ERROR_EC_NO_ACTIVE_CHANNEL:                                               "ERROR_EC_NO_ACTIVE_CHANNEL",
+	ERROR_MUI_FILE_NOT_FOUND:                                                 "ERROR_MUI_FILE_NOT_FOUND",
+	ERROR_MUI_INVALID_FILE:                                                   "ERROR_MUI_INVALID_FILE",
+	ERROR_MUI_INVALID_RC_CONFIG:                                              "ERROR_MUI_INVALID_RC_CONFIG",
+	ERROR_MUI_INVALID_LOCALE_NAME:                                            "ERROR_MUI_INVALID_LOCALE_NAME",
+	ERROR_MUI_INVALID_ULTIMATEFALLBACK_NAME:                                  "ERROR_MUI_INVALID_ULTIMATEFALLBACK_NAME",
+	ERROR_MUI_FILE_NOT_LOADED:                                                "ERROR_MUI_FILE_NOT_LOADED",
+	ERROR_RESOURCE_ENUM_USER_STOP:                                            "ERROR_RESOURCE_ENUM_USER_STOP",
+	ERROR_MUI_INTLSETTINGS_UILANG_NOT_INSTALLED:                              "ERROR_MUI_INTLSETTINGS_UILANG_NOT_INSTALLED",
+	ERROR_MUI_INTLSETTINGS_INVALID_LOCALE_NAME:                               "ERROR_MUI_INTLSETTINGS_INVALID_LOCALE_NAME",
+	ERROR_MRM_RUNTIME_NO_DEFAULT_OR_NEUTRAL_RESOURCE:                         "ERROR_MRM_RUNTIME_NO_DEFAULT_OR_NEUTRAL_RESOURCE",
+	ERROR_MRM_INVALID_PRICONFIG:                                              "ERROR_MRM_INVALID_PRICONFIG",
+	ERROR_MRM_INVALID_FILE_TYPE:                                              "ERROR_MRM_INVALID_FILE_TYPE",
+	ERROR_MRM_UNKNOWN_QUALIFIER:                                              "ERROR_MRM_UNKNOWN_QUALIFIER",
+	ERROR_MRM_INVALID_QUALIFIER_VALUE:                                        "ERROR_MRM_INVALID_QUALIFIER_VALUE",
+	ERROR_MRM_NO_CANDIDATE:                                                   "ERROR_MRM_NO_CANDIDATE",
+	ERROR_MRM_NO_MATCH_OR_DEFAULT_CANDIDATE:                                  "ERROR_MRM_NO_MATCH_OR_DEFAULT_CANDIDATE",
+	ERROR_MRM_RESOURCE_TYPE_MISMATCH:                                         "ERROR_MRM_RESOURCE_TYPE_MISMATCH",
+	ERROR_MRM_DUPLICATE_MAP_NAME:                                             "ERROR_MRM_DUPLICATE_MAP_NAME",
+	ERROR_MRM_DUPLICATE_ENTRY:                                                "ERROR_MRM_DUPLICATE_ENTRY",
+	ERROR_MRM_INVALID_RESOURCE_IDENTIFIER:                                    "ERROR_MRM_INVALID_RESOURCE_IDENTIFIER",
+	ERROR_MRM_FILEPATH_TOO_LONG:                                              "ERROR_MRM_FILEPATH_TOO_LONG",
+	ERROR_MRM_UNSUPPORTED_DIRECTORY_TYPE:                                     "ERROR_MRM_UNSUPPORTED_DIRECTORY_TYPE",
+	ERROR_MRM_INVALID_PRI_FILE:                                               "ERROR_MRM_INVALID_PRI_FILE",
+	ERROR_MRM_NAMED_RESOURCE_NOT_FOUND:                                       "ERROR_MRM_NAMED_RESOURCE_NOT_FOUND",
+	ERROR_MRM_MAP_NOT_FOUND:                                                  "ERROR_MRM_MAP_NOT_FOUND",
+	ERROR_MRM_UNSUPPORTED_PROFILE_TYPE:                                       "ERROR_MRM_UNSUPPORTED_PROFILE_TYPE",
+	ERROR_MRM_INVALID_QUALIFIER_OPERATOR:                                     "ERROR_MRM_INVALID_QUALIFIER_OPERATOR",
+	ERROR_MRM_INDETERMINATE_QUALIFIER_VALUE:                                  "ERROR_MRM_INDETERMINATE_QUALIFIER_VALUE",
+	ERROR_MRM_AUTOMERGE_ENABLED:                                              "ERROR_MRM_AUTOMERGE_ENABLED",
+	ERROR_MRM_TOO_MANY_RESOURCES:                                             "ERROR_MRM_TOO_MANY_RESOURCES",
+	ERROR_MCA_INVALID_CAPABILITIES_STRING:                                    "ERROR_MCA_INVALID_CAPABILITIES_STRING",
+	ERROR_MCA_INVALID_VCP_VERSION:                                            "ERROR_MCA_INVALID_VCP_VERSION",
+	ERROR_MCA_MONITOR_VIOLATES_MCCS_SPECIFICATION:                            "ERROR_MCA_MONITOR_VIOLATES_MCCS_SPECIFICATION",
+	ERROR_MCA_MCCS_VERSION_MISMATCH:                                          "ERROR_MCA_MCCS_VERSION_MISMATCH",
+	ERROR_MCA_UNSUPPORTED_MCCS_VERSION:                                       "ERROR_MCA_UNSUPPORTED_MCCS_VERSION",
+	ERROR_MCA_INTERNAL_ERROR:                                                 "ERROR_MCA_INTERNAL_ERROR",
+	ERROR_MCA_INVALID_TECHNOLOGY_TYPE_RETURNED:                               "ERROR_MCA_INVALID_TECHNOLOGY_TYPE_RETURNED",
+	ERROR_MCA_UNSUPPORTED_COLOR_TEMPERATURE:                                  "ERROR_MCA_UNSUPPORTED_COLOR_TEMPERATURE",
+	ERROR_AMBIGUOUS_SYSTEM_DEVICE:                                            "ERROR_AMBIGUOUS_SYSTEM_DEVICE",
+	ERROR_SYSTEM_DEVICE_NOT_FOUND:                                            "ERROR_SYSTEM_DEVICE_NOT_FOUND",
+	ERROR_HASH_NOT_SUPPORTED:                                                 "ERROR_HASH_NOT_SUPPORTED",
+	ERROR_HASH_NOT_PRESENT:                                                   "ERROR_HASH_NOT_PRESENT",
+	ERROR_SECONDARY_IC_PROVIDER_NOT_REGISTERED:                               "ERROR_SECONDARY_IC_PROVIDER_NOT_REGISTERED",
+	ERROR_GPIO_CLIENT_INFORMATION_INVALID:                                    "ERROR_GPIO_CLIENT_INFORMATION_INVALID",
+	ERROR_GPIO_VERSION_NOT_SUPPORTED:                                         "ERROR_GPIO_VERSION_NOT_SUPPORTED",
+	ERROR_GPIO_INVALID_REGISTRATION_PACKET:                                   "ERROR_GPIO_INVALID_REGISTRATION_PACKET",
+	ERROR_GPIO_OPERATION_DENIED:                                              "ERROR_GPIO_OPERATION_DENIED",
+	ERROR_GPIO_INCOMPATIBLE_CONNECT_MODE:                                     "ERROR_GPIO_INCOMPATIBLE_CONNECT_MODE",
+	ERROR_GPIO_INTERRUPT_ALREADY_UNMASKED:                                    "ERROR_GPIO_INTERRUPT_ALREADY_UNMASKED",
+	ERROR_CANNOT_SWITCH_RUNLEVEL:                                             "ERROR_CANNOT_SWITCH_RUNLEVEL",
+	ERROR_INVALID_RUNLEVEL_SETTING:                                           "ERROR_INVALID_RUNLEVEL_SETTING",
+	ERROR_RUNLEVEL_SWITCH_TIMEOUT:                                            "ERROR_RUNLEVEL_SWITCH_TIMEOUT",
+	ERROR_RUNLEVEL_SWITCH_AGENT_TIMEOUT:                                      "ERROR_RUNLEVEL_SWITCH_AGENT_TIMEOUT",
+	ERROR_RUNLEVEL_SWITCH_IN_PROGRESS:                                        "ERROR_RUNLEVEL_SWITCH_IN_PROGRESS",
+	ERROR_SERVICES_FAILED_AUTOSTART:                                          "ERROR_SERVICES_FAILED_AUTOSTART",
+	ERROR_COM_TASK_STOP_PENDING:                                              "ERROR_COM_TASK_STOP_PENDING",
+	ERROR_INSTALL_OPEN_PACKAGE_FAILED:                                        "ERROR_INSTALL_OPEN_PACKAGE_FAILED",
+	ERROR_INSTALL_PACKAGE_NOT_FOUND:                                          "ERROR_INSTALL_PACKAGE_NOT_FOUND",
+	ERROR_INSTALL_INVALID_PACKAGE:                                            "ERROR_INSTALL_INVALID_PACKAGE",
+	ERROR_INSTALL_RESOLVE_DEPENDENCY_FAILED:                                  "ERROR_INSTALL_RESOLVE_DEPENDENCY_FAILED",
+	ERROR_INSTALL_OUT_OF_DISK_SPACE:                                          "ERROR_INSTALL_OUT_OF_DISK_SPACE",
+	ERROR_INSTALL_NETWORK_FAILURE:                                            "ERROR_INSTALL_NETWORK_FAILURE",
+	ERROR_INSTALL_REGISTRATION_FAILURE:                                       "ERROR_INSTALL_REGISTRATION_FAILURE",
+	ERROR_INSTALL_DEREGISTRATION_FAILURE:                                     "ERROR_INSTALL_DEREGISTRATION_FAILURE",
+	ERROR_INSTALL_CANCEL:                                                     "ERROR_INSTALL_CANCEL",
+	ERROR_INSTALL_FAILED:                                                     "ERROR_INSTALL_FAILED",
+	ERROR_REMOVE_FAILED:                                                      "ERROR_REMOVE_FAILED",
+	ERROR_PACKAGE_ALREADY_EXISTS:                                             "ERROR_PACKAGE_ALREADY_EXISTS",
+	ERROR_NEEDS_REMEDIATION:                                                  "ERROR_NEEDS_REMEDIATION",
+	ERROR_INSTALL_PREREQUISITE_FAILED:                                        "ERROR_INSTALL_PREREQUISITE_FAILED",
+	ERROR_PACKAGE_REPOSITORY_CORRUPTED:                                       "ERROR_PACKAGE_REPOSITORY_CORRUPTED",
+	ERROR_INSTALL_POLICY_FAILURE:                                             "ERROR_INSTALL_POLICY_FAILURE",
+	ERROR_PACKAGE_UPDATING:                                                   "ERROR_PACKAGE_UPDATING",
+	ERROR_DEPLOYMENT_BLOCKED_BY_POLICY:                                       "ERROR_DEPLOYMENT_BLOCKED_BY_POLICY",
+	ERROR_PACKAGES_IN_USE:                                                    "ERROR_PACKAGES_IN_USE",
+	ERROR_RECOVERY_FILE_CORRUPT:                                              "ERROR_RECOVERY_FILE_CORRUPT",
+	ERROR_INVALID_STAGED_SIGNATURE:                                           "ERROR_INVALID_STAGED_SIGNATURE",
+	ERROR_DELETING_EXISTING_APPLICATIONDATA_STORE_FAILED:                     "ERROR_DELETING_EXISTING_APPLICATIONDATA_STORE_FAILED",
+	ERROR_INSTALL_PACKAGE_DOWNGRADE:                                          "ERROR_INSTALL_PACKAGE_DOWNGRADE",
+	ERROR_SYSTEM_NEEDS_REMEDIATION:                                           "ERROR_SYSTEM_NEEDS_REMEDIATION",
+	ERROR_APPX_INTEGRITY_FAILURE_CLR_NGEN:                                    "ERROR_APPX_INTEGRITY_FAILURE_CLR_NGEN",
+	ERROR_RESILIENCY_FILE_CORRUPT:                                            "ERROR_RESILIENCY_FILE_CORRUPT",
+	ERROR_INSTALL_FIREWALL_SERVICE_NOT_RUNNING:                               "ERROR_INSTALL_FIREWALL_SERVICE_NOT_RUNNING",
+	APPMODEL_ERROR_NO_PACKAGE:                                                "APPMODEL_ERROR_NO_PACKAGE",
+	APPMODEL_ERROR_PACKAGE_RUNTIME_CORRUPT:                                   "APPMODEL_ERROR_PACKAGE_RUNTIME_CORRUPT",
+	APPMODEL_ERROR_PACKAGE_IDENTITY_CORRUPT:                                  "APPMODEL_ERROR_PACKAGE_IDENTITY_CORRUPT",
+	APPMODEL_ERROR_NO_APPLICATION:                                            "APPMODEL_ERROR_NO_APPLICATION",
+	ERROR_STATE_LOAD_STORE_FAILED:                                            "ERROR_STATE_LOAD_STORE_FAILED",
+	ERROR_STATE_GET_VERSION_FAILED:                                           "ERROR_STATE_GET_VERSION_FAILED",
+	ERROR_STATE_SET_VERSION_FAILED:                                           "ERROR_STATE_SET_VERSION_FAILED",
+	ERROR_STATE_STRUCTURED_RESET_FAILED:                                      "ERROR_STATE_STRUCTURED_RESET_FAILED",
+	ERROR_STATE_OPEN_CONTAINER_FAILED:                                        "ERROR_STATE_OPEN_CONTAINER_FAILED",
+	ERROR_STATE_CREATE_CONTAINER_FAILED:                                      "ERROR_STATE_CREATE_CONTAINER_FAILED",
+	ERROR_STATE_DELETE_CONTAINER_FAILED:                                      "ERROR_STATE_DELETE_CONTAINER_FAILED",
+	ERROR_STATE_READ_SETTING_FAILED:                                          "ERROR_STATE_READ_SETTING_FAILED",
+	ERROR_STATE_WRITE_SETTING_FAILED:                                         "ERROR_STATE_WRITE_SETTING_FAILED",
+	ERROR_STATE_DELETE_SETTING_FAILED:                                        "ERROR_STATE_DELETE_SETTING_FAILED",
+	ERROR_STATE_QUERY_SETTING_FAILED:                                         "ERROR_STATE_QUERY_SETTING_FAILED",
+	ERROR_STATE_READ_COMPOSITE_SETTING_FAILED:                                "ERROR_STATE_READ_COMPOSITE_SETTING_FAILED",
+	ERROR_STATE_WRITE_COMPOSITE_SETTING_FAILED:                               "ERROR_STATE_WRITE_COMPOSITE_SETTING_FAILED",
+	ERROR_STATE_ENUMERATE_CONTAINER_FAILED:                                   "ERROR_STATE_ENUMERATE_CONTAINER_FAILED",
+	ERROR_STATE_ENUMERATE_SETTINGS_FAILED:                                    "ERROR_STATE_ENUMERATE_SETTINGS_FAILED",
+	ERROR_STATE_COMPOSITE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED:                  "ERROR_STATE_COMPOSITE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED",
+	ERROR_STATE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED:                            "ERROR_STATE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED",
+	ERROR_STATE_SETTING_NAME_SIZE_LIMIT_EXCEEDED:                             "ERROR_STATE_SETTING_NAME_SIZE_LIMIT_EXCEEDED",
+	ERROR_STATE_CONTAINER_NAME_SIZE_LIMIT_EXCEEDED:                           "ERROR_STATE_CONTAINER_NAME_SIZE_LIMIT_EXCEEDED",
+	ERROR_API_UNAVAILABLE:                                                    "ERROR_API_UNAVAILABLE",
+}
+
+// win32ErrorMessages maps the Win32Error values to their descriptions, taken from the comments of their constants
+// and the MessageText of winerror.h.
+var win32ErrorMessages = map[Win32Error]string{
+	ERROR_SUCCESS:                   "The operation completed successfully.",
+	ERROR_INVALID_FUNCTION:          "Incorrect function.",
+	ERROR_FILE_NOT_FOUND:            "The system cannot find the file specified.",
+	ERROR_PATH_NOT_FOUND:            "The system cannot find the path specified.",
+	ERROR_TOO_MANY_OPEN_FILES:       "The system cannot open the file.",
+	ERROR_ACCESS_DENIED:             "Access is denied.",
+	ERROR_INVALID_HANDLE:            "The handle is invalid.",
+	ERROR_NOT_ENOUGH_MEMORY:         "Not enough memory resources are available to process this command.",
+	ERROR_INVALID_ACCESS:            "The access code is invalid.",
+	ERROR_INVALID_DATA:              "The data is invalid.",
+	ERROR_OUTOFMEMORY:               "Not enough memory resources are available to complete this operation.",
+	ERROR_WRITE_PROTECT:             "The media is write protected.",
+	ERROR_NOT_READY:                 "The device is not ready.",
+	ERROR_GEN_FAILURE:               "A device attached to the system is not functioning.",
+	ERROR_SHARING_VIOLATION:         "The process cannot access the file because it is being used by another process.",
+	ERROR_LOCK_VIOLATION:            "The process cannot access the file because another process has locked a portion of the file.",
+	ERROR_HANDLE_DISK_FULL:          "The disk is full.",
+	ERROR_NOT_SUPPORTED:             "The request is not supported.",
+	ERROR_FILE_EXISTS:               "The file exists.",
+	ERROR_INVALID_PARAMETER:         "The parameter is incorrect.",
+	ERROR_BROKEN_PIPE:               "The pipe has been ended.",
+	ERROR_DISK_FULL:                 "There is not enough space on the disk.",
+	ERROR_CALL_NOT_IMPLEMENTED:      "This function is not supported on this system.",
+	ERROR_INSUFFICIENT_BUFFER:       "The data area passed to a system call is too small.",
+	ERROR_INVALID_NAME:              "The filename, directory name, or volume label syntax is incorrect.",
+	ERROR_MOD_NOT_FOUND:             "The specified module could not be found.",
+	ERROR_PROC_NOT_FOUND:            "The specified procedure could not be found.",
+	ERROR_DIR_NOT_EMPTY:             "The directory is not empty.",
+	ERROR_BAD_PATHNAME:              "The specified path is invalid.",
+	ERROR_BUSY:                      "The requested resource is in use.",
+	ERROR_ALREADY_EXISTS:            "Cannot create a file when that file already exists.",
+	ERROR_BAD_EXE_FORMAT:            "%1 is not a valid Win32 application.",
+	ERROR_EXE_MACHINE_TYPE_MISMATCH: "This version of %1 is not compatible with the version of Windows you're running. Check your computer's system information and then contact the software publisher.",
+	ERROR_PIPE_BUSY:                 "All pipe instances are busy.",
+	ERROR_MORE_DATA:                 "More data is available.",
+	ERROR_NO_MORE_ITEMS:             "No more data is available.",
+	ERROR_DIRECTORY:                 "The directory name is invalid.",
+	ERROR_INVALID_IMAGE_HASH:        "Windows cannot verify the digital signature for this file. A recent hardware or software change might have installed a file that is signed incorrectly or damaged, or that might be malicious software from an unknown source.",
+	ERROR_ELEVATION_REQUIRED:        "The requested operation requires elevation.",
+	ERROR_OPERATION_ABORTED:         "The I/O operation has been aborted because of either a thread exit or an application request.",
+	ERROR_DLL_INIT_FAILED:           "A dynamic link library (DLL) initialization routine failed.",
+	ERROR_NOT_FOUND:                 "Element not found.",
+	ERROR_CANCELLED:                 "The operation was canceled by the user.",
+	ERROR_INVALID_WINDOW_HANDLE:     "Invalid window handle.",
+	ERROR_NO_SYSTEM_RESOURCES:       "Insufficient system resources exist to complete the requested service.",
+	ERROR_TIMEOUT:                   "This operation returned because the timeout period expired.",
+	ERROR_INSTALL_FAILURE:           "Fatal error during installation.",
+	ERROR_PRODUCT_UNINSTALLED:       "Product is uninstalled.",
+	ERROR_INVALID_STATE:             "The group or resource is not in the correct state to perform the requested operation.",
+}
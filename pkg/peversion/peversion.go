@@ -0,0 +1,180 @@
+// Package peversion reads the version resource of Windows executables and DLLs, i.e. the VS_FIXEDFILEINFO
+// shown in the Details tab of their properties.
+//
+// The file is parsed with debug/pe rather than read with GetFileVersionInfo, which lets webviewloader report
+// the version of the DLL it embeds, and the tests check it against the checked-in copy.
+package peversion
+
+import (
+	"bytes"
+	"debug/pe"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf16"
+)
+
+// ErrNoVersion is returned for the files without a version resource.
+var ErrNoVersion = errors.New("no version resource")
+
+const (
+	rtVersion = 16
+
+	fixedFileInfoSignature = 0xFEEF04BD
+	fixedFileInfoSize      = 52
+	versionInfoKey         = "VS_VERSION_INFO"
+)
+
+// Version is a file or product version, made of four 16-bit numbers.
+type Version struct {
+	Major, Minor, Build, Revision uint16
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", v.Major, v.Minor, v.Build, v.Revision)
+}
+
+// Info holds the versions of the fixed file info.
+type Info struct {
+	FileVersion    Version
+	ProductVersion Version
+}
+
+// Read reads the version resource of the PE file.
+func Read(r io.ReaderAt) (Info, error) {
+	f, err := pe.NewFile(r)
+	if err != nil {
+		return Info{}, err
+	}
+
+	defer f.Close()
+
+	rsrc := f.Section(".rsrc")
+	if rsrc == nil {
+		return Info{}, ErrNoVersion
+	}
+
+	data, err := rsrc.Data()
+	if err != nil {
+		return Info{}, fmt.Errorf("failed to read the resources: %w", err)
+	}
+
+	resource, err := versionResource(data, rsrc.VirtualAddress)
+	if err != nil {
+		return Info{}, err
+	}
+
+	return parseVersionInfo(resource)
+}
+
+// ReadBytes reads the version resource of the PE file held in memory.
+func ReadBytes(b []byte) (Info, error) {
+	return Read(bytes.NewReader(b))
+}
+
+// versionResource walks the type, name and language levels of the resource directory,
+// taking the first name and language of the version type.
+func versionResource(rsrc []byte, rva uint32) ([]byte, error) {
+	offset, err := findEntry(rsrc, 0, rtVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	for level := 0; level < 2; level++ {
+		if offset&0x80000000 == 0 {
+			return nil, errors.New("invalid resource directory")
+		}
+
+		if offset, err = findEntry(rsrc, offset&0x7FFFFFFF, -1); err != nil {
+			return nil, err
+		}
+	}
+
+	if offset&0x80000000 != 0 || int(offset)+16 > len(rsrc) {
+		return nil, errors.New("invalid resource data entry")
+	}
+
+	// IMAGE_RESOURCE_DATA_ENTRY holds the RVA of the data, which lives in the resource section.
+	dataRVA := binary.LittleEndian.Uint32(rsrc[offset:])
+	size := binary.LittleEndian.Uint32(rsrc[offset+4:])
+
+	start := int64(dataRVA) - int64(rva)
+	if start < 0 || start+int64(size) > int64(len(rsrc)) {
+		return nil, errors.New("the version resource is outside of the resource section")
+	}
+
+	return rsrc[start : start+int64(size)], nil
+}
+
+// findEntry returns the OffsetToData of the entry of the IMAGE_RESOURCE_DIRECTORY at offset with the ID,
+// or of its first entry if id is negative.
+func findEntry(rsrc []byte, offset uint32, id int) (uint32, error) {
+	if int(offset)+16 > len(rsrc) {
+		return 0, errors.New("invalid resource directory")
+	}
+
+	named := binary.LittleEndian.Uint16(rsrc[offset+12:])
+	ids := binary.LittleEndian.Uint16(rsrc[offset+14:])
+	entries := rsrc[offset+16:]
+
+	for n := 0; n < int(named)+int(ids); n++ {
+		if len(entries) < 8*(n+1) {
+			return 0, errors.New("invalid resource directory")
+		}
+
+		name := binary.LittleEndian.Uint32(entries[8*n:])
+		data := binary.LittleEndian.Uint32(entries[8*n+4:])
+
+		// The named entries come first, the version type is only looked up by ID.
+		if id < 0 || (name&0x80000000 == 0 && name == uint32(id)) {
+			return data, nil
+		}
+	}
+
+	return 0, ErrNoVersion
+}
+
+// parseVersionInfo reads the VS_FIXEDFILEINFO following the header and the key of VS_VERSIONINFO.
+func parseVersionInfo(b []byte) (Info, error) {
+	keyEnd := 6 + 2*(len(versionInfoKey)+1)
+	if len(b) < keyEnd {
+		return Info{}, errors.New("truncated version resource")
+	}
+
+	key := make([]uint16, len(versionInfoKey))
+	for n := range key {
+		key[n] = binary.LittleEndian.Uint16(b[6+2*n:])
+	}
+
+	if string(utf16.Decode(key)) != versionInfoKey {
+		return Info{}, errors.New("invalid version resource")
+	}
+
+	// The value is aligned on 32 bits.
+	start := (keyEnd + 3) &^ 3
+	valueLength := int(binary.LittleEndian.Uint16(b[2:]))
+
+	if valueLength < fixedFileInfoSize || len(b) < start+fixedFileInfoSize {
+		return Info{}, ErrNoVersion
+	}
+
+	fixed := b[start:]
+	if binary.LittleEndian.Uint32(fixed) != fixedFileInfoSignature {
+		return Info{}, errors.New("invalid fixed file info")
+	}
+
+	return Info{
+		FileVersion:    version(binary.LittleEndian.Uint32(fixed[8:]), binary.LittleEndian.Uint32(fixed[12:])),
+		ProductVersion: version(binary.LittleEndian.Uint32(fixed[16:]), binary.LittleEndian.Uint32(fixed[20:])),
+	}, nil
+}
+
+func version(ms, ls uint32) Version {
+	return Version{
+		Major:    uint16(ms >> 16),
+		Minor:    uint16(ms),
+		Build:    uint16(ls >> 16),
+		Revision: uint16(ls),
+	}
+}
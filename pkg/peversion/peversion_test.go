@@ -0,0 +1,120 @@
+package peversion
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"testing"
+)
+
+// loaderDLL is the WebView2Loader.dll embedded by webviewloader, from the WebView2 SDK 1.0.818.41.
+const (
+	loaderDLL       = "../webviewloader/x64/WebView2Loader.dll"
+	loaderDLLSHA256 = "79d7e45f8631e8d2541d01bfb5a49a3a090be72b3d465389a2d684680fee2e36"
+)
+
+func readLoaderDLL(t *testing.T) []byte {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(loaderDLL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sum := sha256.Sum256(b); hex.EncodeToString(sum[:]) != loaderDLLSHA256 {
+		t.Fatalf("%s has the SHA-256 %x, want %s", loaderDLL, sum, loaderDLLSHA256)
+	}
+
+	return b
+}
+
+func TestReadLoaderDLL(t *testing.T) {
+	info, err := ReadBytes(readLoaderDLL(t))
+	if err != nil {
+		t.Fatalf("ReadBytes() = %v", err)
+	}
+
+	want := Version{1, 0, 818, 41}
+
+	if info.FileVersion != want || info.ProductVersion != want {
+		t.Errorf("ReadBytes() = %+v, want the file and product versions %s", info, want)
+	}
+
+	if s := info.FileVersion.String(); s != "1.0.818.41" {
+		t.Errorf("String() = %q, want 1.0.818.41", s)
+	}
+}
+
+func TestReadCorrupt(t *testing.T) {
+	dll := readLoaderDLL(t)
+
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{"empty", nil},
+		{"notPE", []byte("this is not a PE file")},
+		{"truncatedHeaders", dll[:256]},
+		{"truncatedSections", dll[:len(dll)/2]},
+		{"corruptSignature", corrupt(dll, func(b []byte) {
+			// The PE signature, at the offset stored at 0x3C.
+			b[int(b[0x3C])|int(b[0x3D])<<8] = 'X'
+		})},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if info, err := ReadBytes(test.b); err == nil {
+				t.Fatalf("ReadBytes() = %+v, want an error", info)
+			}
+		})
+	}
+}
+
+func TestReadCorruptVersion(t *testing.T) {
+	dll := readLoaderDLL(t)
+
+	info, err := ReadBytes(corrupt(dll, func(b []byte) {
+		// The signature of VS_FIXEDFILEINFO.
+		copy(b[indexString(t, b, "\xBD\x04\xEF\xFE"):], []byte{0, 0, 0, 0})
+	}))
+
+	if err == nil {
+		t.Fatalf("ReadBytes() with a corrupt fixed file info = %+v, want an error", info)
+	}
+}
+
+func TestReadWithoutResources(t *testing.T) {
+	_, err := ReadBytes(corrupt(readLoaderDLL(t), func(b []byte) {
+		copy(b[indexString(t, b, ".rsrc"):], ".xxxx")
+	}))
+
+	if !errors.Is(err, ErrNoVersion) {
+		t.Fatalf("ReadBytes() without a resource section = %v, want %v", err, ErrNoVersion)
+	}
+}
+
+// corrupt returns a copy of b changed by fn.
+func corrupt(b []byte, fn func(b []byte)) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	fn(c)
+
+	return c
+}
+
+// indexString returns the offset of the first s in b.
+func indexString(t *testing.T, b []byte, s string) int {
+	t.Helper()
+
+	for n := 0; n+len(s) <= len(b); n++ {
+		if string(b[n:n+len(s)]) == s {
+			return n
+		}
+	}
+
+	t.Fatalf("%q not found", s)
+
+	return 0
+}
@@ -0,0 +1,7 @@
+package user32
+
+// The *WindowLongPtrW functions are macros for the *WindowLongW ones on 32-bit Windows, user32.dll doesn't export them.
+const (
+	getWindowLongPtrName = "GetWindowLongW"
+	setWindowLongPtrName = "SetWindowLongW"
+)
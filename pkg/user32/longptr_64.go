@@ -0,0 +1,9 @@
+//go:build !386
+// +build !386
+
+package user32
+
+const (
+	getWindowLongPtrName = "GetWindowLongPtrW"
+	setWindowLongPtrName = "SetWindowLongPtrW"
+)
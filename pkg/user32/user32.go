@@ -11,17 +11,21 @@ import (
 )
 
 const (
-	CW_USEDEFAULT = 0x80000000
+	CW_USEDEFAULT = -0x80000000 // (int)0x80000000, it fits in an int on 32-bit platforms too
 
 	SystemMetricsCxScreen = 0
 	SystemMetricsCyScreen = 1
 	SystemMetricsCxIcon   = 11
 	SystemMetricsCyIcon   = 12
 
+	SystemMetricsCxSizeFrame    = 32
+	SystemMetricsCySizeFrame    = 33
+	SystemMetricsCxPaddedBorder = 92
+
 	GWLStyle = -16
 
 	WSOverlapped       = 0x00000000
-	WSMaximizeBox      = 0x00020000
+	WSMaximizeBox      = 0x00010000
 	WSThickFrame       = 0x00040000
 	WSCaption          = 0x00C00000
 	WSSysMenu          = 0x00080000
@@ -39,7 +43,26 @@ const (
 	WMClose         = 0x0010
 	WMQuit          = 0x0012
 	WMGetMinMaxInfo = 0x0024
+	WMNCCalcSize    = 0x0083
+	WMNCLButtonDown = 0x00A1
 	WMApp           = 0x8000
+
+	PMRemove = 0x0001
+
+	QSAllInput = 0x04FF
+)
+
+// The hit test codes, the results of WM_NCHITTEST and the parts of the frame WM_NCLBUTTONDOWN presses.
+const (
+	HTCaption     = 2
+	HTLeft        = 10
+	HTRight       = 11
+	HTTop         = 12
+	HTTopLeft     = 13
+	HTTopRight    = 14
+	HTBottom      = 15
+	HTBottomLeft  = 16
+	HTBottomRight = 17
 )
 
 const (
@@ -62,24 +85,30 @@ var (
 
 	user32 = windows.NewLazySystemDLL("user32")
 
-	loadImageW        = user32.NewProc("LoadImageW")
-	getSystemMetrics  = user32.NewProc("GetSystemMetrics")
-	registerClassExW  = user32.NewProc("RegisterClassExW")
-	createWindowExW   = user32.NewProc("CreateWindowExW")
-	destroyWindow     = user32.NewProc("DestroyWindow")
-	showWindow        = user32.NewProc("ShowWindow")
-	setFocus          = user32.NewProc("SetFocus")
-	getMessageW       = user32.NewProc("GetMessageW")
-	translateMessage  = user32.NewProc("TranslateMessage")
-	dispatchMessageW  = user32.NewProc("DispatchMessageW")
-	defWindowProcW    = user32.NewProc("DefWindowProcW")
-	getClientRect     = user32.NewProc("GetClientRect")
-	postQuitMessage   = user32.NewProc("PostQuitMessage")
-	setWindowTextW    = user32.NewProc("SetWindowTextW")
-	getWindowLongPtrW = user32.NewProc("GetWindowLongPtrW")
-	setWindowLongPtrW = user32.NewProc("SetWindowLongPtrW")
-	adjustWindowRect  = user32.NewProc("AdjustWindowRect")
-	setWindowPos      = user32.NewProc("SetWindowPos")
+	loadImageW                = user32.NewProc("LoadImageW")
+	getSystemMetrics          = user32.NewProc("GetSystemMetrics")
+	registerClassExW          = user32.NewProc("RegisterClassExW")
+	createWindowExW           = user32.NewProc("CreateWindowExW")
+	destroyWindow             = user32.NewProc("DestroyWindow")
+	showWindow                = user32.NewProc("ShowWindow")
+	setFocus                  = user32.NewProc("SetFocus")
+	getMessageW               = user32.NewProc("GetMessageW")
+	peekMessageW              = user32.NewProc("PeekMessageW")
+	msgWaitForMultipleObjects = user32.NewProc("MsgWaitForMultipleObjects")
+	translateMessage          = user32.NewProc("TranslateMessage")
+	dispatchMessageW          = user32.NewProc("DispatchMessageW")
+	defWindowProcW            = user32.NewProc("DefWindowProcW")
+	getClientRect             = user32.NewProc("GetClientRect")
+	postQuitMessage           = user32.NewProc("PostQuitMessage")
+	postMessageW              = user32.NewProc("PostMessageW")
+	setWindowTextW            = user32.NewProc("SetWindowTextW")
+	getWindowLongPtrW         = user32.NewProc(getWindowLongPtrName)
+	setWindowLongPtrW         = user32.NewProc(setWindowLongPtrName)
+	adjustWindowRect          = user32.NewProc("AdjustWindowRect")
+	setWindowPos              = user32.NewProc("SetWindowPos")
+	getCursorPos              = user32.NewProc("GetCursorPos")
+	releaseCapture            = user32.NewProc("ReleaseCapture")
+	isZoomed                  = user32.NewProc("IsZoomed")
 )
 
 type Msg struct {
@@ -142,6 +171,42 @@ func GetMessageW() (*Msg, error) {
 	return &msg, nil
 }
 
+// PeekMessageW removes a message from the queue without blocking, it returns nil if there are no messages.
+func PeekMessageW() *Msg {
+	var msg Msg
+
+	r, _, _ := peekMessageW.Call(
+		uintptr(unsafe.Pointer(&msg)),
+		0,
+		0,
+		0,
+		PMRemove,
+	)
+
+	if r == 0 {
+		return nil
+	}
+
+	return &msg
+}
+
+// MsgWaitForMultipleObjects waits until there's input in the queue or the timeout elapses.
+func MsgWaitForMultipleObjects(milliseconds uint32) error {
+	r, _, err := msgWaitForMultipleObjects.Call(
+		0,
+		0,
+		0,
+		uintptr(milliseconds),
+		QSAllInput,
+	)
+
+	if uint32(r) == windows.WAIT_FAILED {
+		return err
+	}
+
+	return nil
+}
+
 func TranslateMessage(msg *Msg) error {
 	_, _, err := translateMessage.Call(uintptr(unsafe.Pointer(msg)))
 	if err != nil && !errors.Is(err, errOK) {
@@ -169,6 +234,15 @@ func PostQuitMessage(exitCode int) error {
 	return nil
 }
 
+func PostMessageW(hwnd windows.Handle, msg uint32, wp, lp uintptr) error {
+	r, _, err := postMessageW.Call(uintptr(hwnd), uintptr(msg), wp, lp)
+	if r == 0 {
+		return err
+	}
+
+	return nil
+}
+
 func DestroyWindow(hwnd windows.Handle) error {
 	_, _, err := destroyWindow.Call(uintptr(hwnd))
 	if err != nil && !errors.Is(err, errOK) {
@@ -196,7 +270,7 @@ func RegisterClassExW(wc *WndClassExW) error {
 	return nil
 }
 
-func CreateWindowExW(className, windowName string, x, y, width, height int, hInstance windows.Handle) (windows.Handle, error) {
+func CreateWindowExW(className, windowName string, style uintptr, x, y, width, height int, hInstance windows.Handle) (windows.Handle, error) {
 	class, err := windows.UTF16PtrFromString(className)
 	if err != nil {
 		return 0, fmt.Errorf("invalid className: %w", err)
@@ -211,7 +285,7 @@ func CreateWindowExW(className, windowName string, x, y, width, height int, hIns
 		0,
 		uintptr(unsafe.Pointer(class)),
 		uintptr(unsafe.Pointer(window)),
-		0xCF0000, // WS_OVERLAPPEDWINDOW
+		style,
 		uintptr(x),
 		uintptr(y),
 		uintptr(width),
@@ -337,3 +411,27 @@ func GetClientRect(hwnd windows.Handle) (*Rect, error) {
 
 	return &rect, nil
 }
+
+func GetCursorPos() (*Point, error) {
+	var pt Point
+	_, _, err := getCursorPos.Call(uintptr(unsafe.Pointer(&pt)))
+	if err != nil && !errors.Is(err, errOK) {
+		return nil, err
+	}
+
+	return &pt, nil
+}
+
+func ReleaseCapture() error {
+	_, _, err := releaseCapture.Call()
+	if err != nil && !errors.Is(err, errOK) {
+		return err
+	}
+
+	return nil
+}
+
+func IsZoomed(hwnd windows.Handle) bool {
+	r, _, _ := isZoomed.Call(uintptr(hwnd))
+	return r != 0
+}
@@ -0,0 +1,183 @@
+// Package webresource serves the web resource requests of the WebView with an http.Handler.
+//
+// Serve turns a Request into a server-side http.Request, buffers what the handler writes and returns it as
+// the status, reason phrase, CRLF separated headers and body CreateWebResourceResponse takes.
+// Match implements the wildcards of the resource filters, so a request can be routed to the handler of its filter.
+package webresource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Request is a web resource request as reported by the WebView.
+type Request struct {
+	Method  string
+	URI     string
+	Headers http.Header
+	Body    io.Reader
+}
+
+// Response is a web resource response in the form accepted by CreateWebResourceResponse.
+type Response struct {
+	StatusCode   int
+	ReasonPhrase string
+
+	// Headers holds the headers separated by CRLF.
+	Headers string
+	Body    []byte
+}
+
+// NewHTTPRequest converts a web resource request into a server-side http.Request.
+func NewHTTPRequest(ctx context.Context, r *Request) (*http.Request, error) {
+	method := r.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, r.URI, r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	if r.Headers != nil {
+		req.Header = r.Headers.Clone()
+	}
+
+	req.Host = req.URL.Host
+	req.RequestURI = req.URL.RequestURI()
+	req.RemoteAddr = "127.0.0.1:0"
+
+	if cl := req.Header.Get("Content-Length"); cl != "" {
+		if n, err := strconv.ParseInt(cl, 10, 64); err == nil {
+			req.ContentLength = n
+		}
+	}
+
+	return req, nil
+}
+
+// Serve passes the request to the handler and records its response.
+// A handler that panics gets a 500 Internal Server Error response, whatever it wrote before.
+func Serve(ctx context.Context, h http.Handler, r *Request) (resp *Response, err error) {
+	req, err := NewHTTPRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			resp = &Response{
+				StatusCode:   http.StatusInternalServerError,
+				ReasonPhrase: http.StatusText(http.StatusInternalServerError),
+			}
+		}
+	}()
+
+	rec := newRecorder()
+	h.ServeHTTP(rec, req)
+
+	return rec.response(), nil
+}
+
+// Match reports whether the URI matches a WebView resource filter, where * matches any sequence of characters
+// and ? matches exactly one character.
+func Match(pattern, uri string) bool {
+	p, u := []rune(pattern), []rune(uri)
+
+	// star is the position of the last * in the pattern, and next the position in the URI it's matched up to,
+	// a mismatch after it backtracks there with the * taking one more character.
+	star, next := -1, 0
+	i, j := 0, 0
+
+	for j < len(u) {
+		switch {
+		case i < len(p) && p[i] == '*':
+			star, next = i, j
+			i++
+		case i < len(p) && (p[i] == '?' || p[i] == u[j]):
+			i++
+			j++
+		case star >= 0:
+			next++
+			i, j = star+1, next
+		default:
+			return false
+		}
+	}
+
+	for i < len(p) && p[i] == '*' {
+		i++
+	}
+
+	return i == len(p)
+}
+
+// recorder is a minimal http.ResponseWriter which buffers the response.
+type recorder struct {
+	header      http.Header
+	body        bytes.Buffer
+	status      int
+	wroteHeader bool
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		header: http.Header{},
+		status: http.StatusOK,
+	}
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+
+	r.status = status
+	r.wroteHeader = true
+}
+
+func (r *recorder) Write(p []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(p)
+}
+
+func (r *recorder) response() *Response {
+	body := r.body.Bytes()
+
+	if r.header.Get("Content-Type") == "" && len(body) > 0 {
+		r.header.Set("Content-Type", http.DetectContentType(body))
+	}
+
+	keys := make([]string, 0, len(r.header))
+	for k := range r.header {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var headers []string
+
+	for _, k := range keys {
+		for _, v := range r.header[k] {
+			headers = append(headers, k+": "+v)
+		}
+	}
+
+	return &Response{
+		StatusCode:   r.status,
+		ReasonPhrase: http.StatusText(r.status),
+		Headers:      strings.Join(headers, "\r\n"),
+		Body:         body,
+	}
+}
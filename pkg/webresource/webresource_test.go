@@ -0,0 +1,246 @@
+package webresource
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewHTTPRequest(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Content-Length", "5")
+	headers.Set("X-Test", "a")
+
+	r := &Request{
+		Method:  http.MethodPost,
+		URI:     "https://app.local/api/items?page=2",
+		Headers: headers,
+		Body:    strings.NewReader("hello"),
+	}
+
+	req, err := NewHTTPRequest(context.Background(), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if req.Method != http.MethodPost {
+		t.Errorf("Method = %q, want POST", req.Method)
+	}
+
+	if req.Host != "app.local" || req.RequestURI != "/api/items?page=2" {
+		t.Errorf("Host, RequestURI = %q, %q, want app.local, /api/items?page=2", req.Host, req.RequestURI)
+	}
+
+	if req.ContentLength != 5 {
+		t.Errorf("ContentLength = %d, want 5", req.ContentLength)
+	}
+
+	if req.Header.Get("X-Test") != "a" {
+		t.Errorf("X-Test header = %q, want a", req.Header.Get("X-Test"))
+	}
+
+	// The request headers are copied, the handler can't change the ones of the WebView.
+	req.Header.Set("X-Test", "b")
+	if headers.Get("X-Test") != "a" {
+		t.Error("the request headers aren't copied")
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil || string(body) != "hello" {
+		t.Errorf("Body = %q, %v, want hello", body, err)
+	}
+}
+
+func TestNewHTTPRequestDefaults(t *testing.T) {
+	req, err := NewHTTPRequest(context.Background(), &Request{URI: "https://app.local"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if req.Method != http.MethodGet || req.RequestURI != "/" || req.ContentLength != 0 || len(req.Header) != 0 {
+		t.Errorf("got %s %s with length %d and headers %v, want GET / without a body", req.Method, req.RequestURI, req.ContentLength, req.Header)
+	}
+
+	if _, err := NewHTTPRequest(context.Background(), &Request{URI: "://bad"}); err == nil {
+		t.Error("NewHTTPRequest() accepted an invalid URI")
+	}
+
+	if _, err := NewHTTPRequest(context.Background(), &Request{Method: "BAD METHOD", URI: "https://app.local"}); err == nil {
+		t.Error("NewHTTPRequest() accepted an invalid method")
+	}
+}
+
+func TestServe(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{"text", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, "hello "+r.URL.Path)
+		}},
+		{"html", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, "<!DOCTYPE html><p>hello</p>")
+		}},
+		{"json", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Add("X-B", "2")
+			w.Header().Add("X-A", "1")
+			w.Header().Add("X-A", "3")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"ok":true}`)
+		}},
+		{"notFound", http.NotFound},
+		{"redirect", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Location", "/other")
+			w.WriteHeader(http.StatusFound)
+		}},
+		{"empty", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			w.WriteHeader(http.StatusInternalServerError)
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := Serve(context.Background(), test.handler, &Request{URI: "https://app.local/index.html"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The response is what httptest records, with the headers flattened in order.
+			want := httptest.NewRecorder()
+			test.handler(want, httptest.NewRequest(http.MethodGet, "https://app.local/index.html", nil))
+
+			if resp.StatusCode != want.Code || resp.ReasonPhrase != http.StatusText(want.Code) {
+				t.Errorf("status = %d %s, want %d", resp.StatusCode, resp.ReasonPhrase, want.Code)
+			}
+
+			if string(resp.Body) != want.Body.String() {
+				t.Errorf("body = %q, want %q", resp.Body, want.Body.String())
+			}
+
+			for name, values := range want.Result().Header {
+				for _, v := range values {
+					if !strings.Contains("\r\n"+resp.Headers+"\r\n", "\r\n"+name+": "+v+"\r\n") {
+						t.Errorf("headers %q miss %s: %s", resp.Headers, name, v)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestServeHeaderOrder(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Add("X-B", "2")
+		w.Header().Add("X-A", "1")
+		w.Header().Add("X-A", "3")
+	})
+
+	resp, err := Serve(context.Background(), h, &Request{URI: "https://app.local/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "Content-Type: text/plain\r\nX-A: 1\r\nX-A: 3\r\nX-B: 2"; resp.Headers != want {
+		t.Errorf("Headers = %q, want %q", resp.Headers, want)
+	}
+}
+
+func TestServeRequest(t *testing.T) {
+	var got *http.Request
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := Serve(ctx, h, &Request{Method: http.MethodPut, URI: "https://app.local/a?b=c"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Method != http.MethodPut || got.URL.Path != "/a" || got.URL.Query().Get("b") != "c" {
+		t.Errorf("the handler got %s %s", got.Method, got.URL)
+	}
+
+	cancel()
+
+	if got.Context().Err() == nil {
+		t.Error("the request doesn't carry the context")
+	}
+
+	if _, err := Serve(context.Background(), h, &Request{URI: "://bad"}); err == nil {
+		t.Error("Serve() accepted an invalid URI")
+	}
+}
+
+func TestServePanic(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Partial", "1")
+		_, _ = io.WriteString(w, "partial")
+		panic("boom")
+	})
+
+	resp, err := Serve(context.Background(), h, &Request{URI: "https://app.local/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusInternalServerError || resp.ReasonPhrase != "Internal Server Error" {
+		t.Errorf("status = %d %s, want 500", resp.StatusCode, resp.ReasonPhrase)
+	}
+
+	if resp.Headers != "" || len(resp.Body) != 0 {
+		t.Errorf("the partial response is kept: %q, %q", resp.Headers, resp.Body)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		uri     string
+		match   bool
+	}{
+		{"https://app.local/*", "https://app.local/", true},
+		{"https://app.local/*", "https://app.local/a/b.js", true},
+		{"https://app.local/*", "https://app.localhost/", false},
+		{"https://app.local/*", "http://app.local/", false},
+		{"*", "anything", true},
+		{"*", "", true},
+		{"https://app.local/index.html", "https://app.local/index.html", true},
+		{"https://app.local/index.html", "https://app.local/index.html?a", false},
+		{"*.js", "https://app.local/a.js", true},
+		{"*.js", "https://app.local/a.json", false},
+		{"https://*/api/*", "https://app.local/api/items", true},
+		{"https://*/api/*", "https://app.local/static/api", false},
+		{"*a*a*", "aa", true},
+		{"*a*a*", "a", false},
+		{"ab*ba", "aba", false},
+		{"ab*ba", "abba", true},
+		{"https://app.local/?.js", "https://app.local/a.js", true},
+		{"https://app.local/?.js", "https://app.local/.js", false},
+		{"https://app.local/?.js", "https://app.local/ab.js", false},
+		{"https://app.local/v??/*", "https://app.local/v12/a.js", true},
+		{"https://app.local/v??/*", "https://app.local/v1/a.js", false},
+		{"?", "é", true},
+		{"?", "", false},
+		{"*?", "", false},
+		{"*?", "a", true},
+		{"?*?", "ab", true},
+		{"a?c*d", "abcxxd", true},
+		{"a?c*d", "acxxd", false},
+	}
+
+	for _, test := range tests {
+		if match := Match(test.pattern, test.uri); match != test.match {
+			t.Errorf("Match(%q, %q) = %t, want %t", test.pattern, test.uri, match, test.match)
+		}
+	}
+}
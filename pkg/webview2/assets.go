@@ -0,0 +1,199 @@
+package webview2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"syscall"
+	"unsafe"
+
+	"github.com/mattpodraza/webview2/v2/pkg/com"
+	"github.com/mattpodraza/webview2/v2/pkg/hresult"
+	"github.com/mattpodraza/webview2/v2/pkg/webresource"
+)
+
+type assetHandler struct {
+	pattern string
+	handler http.Handler
+}
+
+// AddAssetHandler answers the requests matching the pattern with the handler, without going through the network.
+// The pattern is a WebView resource filter, where * matches any sequence of characters
+// and ? exactly one, e.g. "https://app.local/*".
+//
+// The handler is called on the UI thread and its response is buffered before being handed to the WebView.
+// If more than one pattern matches a request, the handler added first wins.
+func (b *browser) AddAssetHandler(pattern string, h http.Handler) error {
+	if !b.listeningForAssets {
+		handler := com.NewHandler(com.IID_ICoreWebView2WebResourceRequestedEventHandler, func(_ uintptr, args unsafe.Pointer) uintptr {
+			b.serveAsset((*com.ICoreWebView2WebResourceRequestedEventArgs)(args))
+			return 0
+		})
+
+		if _, err := b.addEventHandler(eventWebResourceRequested, handler); err != nil {
+			return fmt.Errorf("failed to listen for web resource requests: %w", err)
+		}
+
+		b.listeningForAssets = true
+	}
+
+	if err := b.view.AddWebResourceRequestedFilter(pattern, com.COREWEBVIEW2_WEB_RESOURCE_CONTEXT_ALL); err != nil {
+		return fmt.Errorf("failed to add a web resource filter: %w", err)
+	}
+
+	b.assets = append(b.assets, assetHandler{pattern: pattern, handler: h})
+
+	return nil
+}
+
+func (b *browser) serveAsset(args *com.ICoreWebView2WebResourceRequestedEventArgs) {
+	request, err := args.GetRequest()
+	if err != nil || request == nil {
+		return
+	}
+
+	defer release(unsafe.Pointer(request))
+
+	uri, err := request.GetURI()
+	if err != nil {
+		return
+	}
+
+	var handler http.Handler
+
+	for _, asset := range b.assets {
+		if webresource.Match(asset.pattern, uri) {
+			handler = asset.handler
+			break
+		}
+	}
+
+	// Requests matching filters added elsewhere are left alone.
+	if handler == nil {
+		return
+	}
+
+	method, _ := request.GetMethod()
+
+	req := &webresource.Request{
+		Method:  method,
+		URI:     uri,
+		Headers: readRequestHeaders(request),
+		Body:    readRequestContent(request),
+	}
+
+	// A panicking handler gets a 500 response, the panic doesn't unwind into the WebView.
+	resp, err := webresource.Serve(context.Background(), handler, req)
+	if err != nil {
+		resp = &webresource.Response{
+			StatusCode:   http.StatusBadRequest,
+			ReasonPhrase: http.StatusText(http.StatusBadRequest),
+		}
+	}
+
+	response, err := b.createWebResourceResponse(resp)
+	if err != nil {
+		return
+	}
+
+	defer release(unsafe.Pointer(response))
+
+	_ = args.PutResponse(response)
+}
+
+func (b *browser) createWebResourceResponse(resp *webresource.Response) (*com.ICoreWebView2WebResourceResponse, error) {
+	var content *com.IStream
+
+	if len(resp.Body) > 0 {
+		r, _, _ := shlwapiSHCreateMemStream.Call(uintptr(unsafe.Pointer(&resp.Body[0])), uintptr(len(resp.Body)))
+		if r == 0 {
+			return nil, errors.New("failed to create the response stream")
+		}
+
+		content = (*com.IStream)(com.Pointer(r))
+
+		defer release(unsafe.Pointer(content))
+	}
+
+	response, err := b.environment.CreateWebResourceResponse(content, int32(resp.StatusCode), resp.ReasonPhrase, resp.Headers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a web resource response: %w", err)
+	}
+
+	return response, nil
+}
+
+func readRequestHeaders(request *com.ICoreWebView2WebResourceRequest) http.Header {
+	header := http.Header{}
+
+	headers, err := request.GetHeaders()
+	if err != nil || headers == nil {
+		return header
+	}
+
+	defer release(unsafe.Pointer(headers))
+
+	iterator, err := headers.GetIterator()
+	if err != nil || iterator == nil {
+		return header
+	}
+
+	defer release(unsafe.Pointer(iterator))
+
+	for {
+		if hasCurrent, err := iterator.GetHasCurrentHeader(); err != nil || !hasCurrent {
+			break
+		}
+
+		name, value, err := iterator.GetCurrentHeader()
+		if err != nil {
+			break
+		}
+
+		header.Add(name, value)
+
+		if hasNext, err := iterator.MoveNext(); err != nil || !hasNext {
+			break
+		}
+	}
+
+	return header
+}
+
+func readRequestContent(request *com.ICoreWebView2WebResourceRequest) io.Reader {
+	stream, err := request.GetContent()
+	if err != nil || stream == nil {
+		return nil
+	}
+
+	defer release(unsafe.Pointer(stream))
+
+	var content []byte
+
+	buf := make([]byte, 32*1024)
+
+	for {
+		var read uint32
+
+		r, _, _ := syscall.Syscall6(
+			stream.VTBL.Read, 4,
+			uintptr(unsafe.Pointer(stream)),
+			uintptr(unsafe.Pointer(&buf[0])),
+			uintptr(len(buf)),
+			uintptr(unsafe.Pointer(&read)),
+			0, 0,
+		)
+
+		content = append(content, buf[:read]...)
+
+		// S_FALSE is returned once the end of the stream is reached.
+		if hresult.HRESULT(uint32(r)) != hresult.S_OK || read == 0 {
+			break
+		}
+	}
+
+	return bytes.NewReader(content)
+}
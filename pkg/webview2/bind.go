@@ -0,0 +1,143 @@
+package webview2
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/mattpodraza/webview2/v2/pkg/binding"
+	"github.com/mattpodraza/webview2/v2/pkg/com"
+	"github.com/mattpodraza/webview2/v2/pkg/hresult"
+	"golang.org/x/sys/windows"
+)
+
+// bindingScript is the script a binding adds to every document. Its ID arrives once the WebView has added it.
+type bindingScript struct {
+	id      string
+	removed bool
+}
+
+// Bind exposes fn to the page script as window.<name>, which returns a Promise.
+// Arguments and results are passed as JSON, fn may return a value, an error, or both.
+// An error rejects the Promise with its message. Binding a name again replaces the function.
+//
+// Bound functions are called on the UI thread, so they should return quickly.
+func (b *browser) Bind(name string, fn interface{}) error {
+	if !b.config.webMessage {
+		return errors.New("binding functions requires web messages to be enabled")
+	}
+
+	if err := b.bindings.Bind(name, fn); err != nil {
+		return err
+	}
+
+	if err := b.listenForCalls(); err != nil {
+		return fmt.Errorf("failed to listen for web messages: %w", err)
+	}
+
+	if err := b.removeBindingScript(name); err != nil {
+		return err
+	}
+
+	script := binding.Script(name)
+
+	s, err := b.addBindingScript(script)
+	if err != nil {
+		return fmt.Errorf("failed to add the binding script: %w", err)
+	}
+
+	b.bindingScripts[name] = s
+
+	return b.ExecuteScript(script)
+}
+
+// Unbind removes window.<name> from the current document and stops adding it to the new ones.
+func (b *browser) Unbind(name string) error {
+	b.bindings.Unbind(name)
+
+	if err := b.removeBindingScript(name); err != nil {
+		return err
+	}
+
+	return b.ExecuteScript(binding.UnbindScript(name))
+}
+
+// addBindingScript adds the script to every document created from now on, keeping its ID so it can be removed.
+func (b *browser) addBindingScript(script string) (*bindingScript, error) {
+	s := &bindingScript{}
+
+	h := com.NewHandler(com.IID_ICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler, func(errorCode uintptr, id unsafe.Pointer) uintptr {
+		if hresult.HRESULT(uint32(errorCode)).Failed() || id == nil {
+			return 0
+		}
+
+		// The ID is owned by the WebView, so it must not be freed.
+		s.id = windows.UTF16PtrToString((*uint16)(id))
+
+		// The binding was replaced or removed before the script was added.
+		if s.removed && b.view != nil {
+			_ = b.view.RemoveScriptToExecuteOnDocumentCreated(s.id)
+		}
+
+		return 0
+	})
+
+	defer h.Release()
+
+	if err := b.view.AddScriptToExecuteOnDocumentCreated(script, h); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// removeBindingScript removes the script added by the previous binding of the name, if any.
+func (b *browser) removeBindingScript(name string) error {
+	s, ok := b.bindingScripts[name]
+	if !ok {
+		return nil
+	}
+
+	delete(b.bindingScripts, name)
+	s.removed = true
+
+	// Without the ID yet, the script is removed as soon as it's added.
+	if s.id == "" {
+		return nil
+	}
+
+	if err := b.view.RemoveScriptToExecuteOnDocumentCreated(s.id); err != nil {
+		return fmt.Errorf("failed to remove the binding script: %w", err)
+	}
+
+	return nil
+}
+
+func (b *browser) listenForCalls() error {
+	if b.listeningForCalls {
+		return nil
+	}
+
+	_, err := b.OnWebMessage(func(msg WebMessage) {
+		b.handleCall(msg.JSON)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	b.listeningForCalls = true
+
+	return nil
+}
+
+func (b *browser) handleCall(message []byte) {
+	call, ok := binding.ParseCall(message)
+	if !ok {
+		return
+	}
+
+	result, err := b.bindings.Call(call)
+
+	_ = b.ExecuteScript(binding.SettleScript(call.ID, result, err))
+}
@@ -1,15 +1,21 @@
 package webview2
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sync/atomic"
 	"syscall"
+	"time"
 	"unsafe"
 
+	"github.com/mattpodraza/webview2/v2/pkg/binding"
+	"github.com/mattpodraza/webview2/v2/pkg/bootstrapper"
 	"github.com/mattpodraza/webview2/v2/pkg/com"
+	"github.com/mattpodraza/webview2/v2/pkg/envpolicy"
 	"github.com/mattpodraza/webview2/v2/pkg/hresult"
 	"github.com/mattpodraza/webview2/v2/pkg/user32"
 	"golang.org/x/sys/windows"
@@ -17,6 +23,20 @@ import (
 
 type browserConfig struct {
 	initialURL string
+	// navigationTimeout limits the wait for the initial navigation, zero waits as long as it takes.
+	navigationTimeout time.Duration
+
+	// The environment options, the empty ones keep the defaults.
+	// envPolicy decides how they combine with the WEBVIEW2_* environment variables.
+	envPolicy                      EnvPolicy
+	userDataFolder                 string
+	browserExecutableFolder        string
+	additionalBrowserArguments     string
+	language                       string
+	targetCompatibleBrowserVersion string
+
+	// install is used to install the runtime if the environment can't be created because it's missing, unless it's nil.
+	install *InstallOptions
 
 	builtInErrorPage     bool
 	defaultContextMenus  bool
@@ -27,51 +47,158 @@ type browserConfig struct {
 	statusBar            bool
 	webMessage           bool
 	zoomControl          bool
+
+	// setup holds the functions that run once the browser is embedded, before the initial navigation.
+	setup []func(b *browser) error
 }
 
 type browser struct {
 	hwnd windows.Handle
 
-	config     *browserConfig
-	view       *com.ICoreWebView2
-	controller *com.ICoreWebView2Controller
-	settings   *com.ICoreWebView2Settings
+	config      *browserConfig
+	environment *com.ICoreWebView2Environment
+	view        *com.ICoreWebView2
+	controller  *com.ICoreWebView2Controller
+	settings    *com.ICoreWebView2Settings
+
+	handlers map[EventToken]struct{}
+
+	bindings          *binding.Registry
+	bindingScripts    map[string]*bindingScript
+	listeningForCalls bool
+
+	assets             []assetHandler
+	listeningForAssets bool
+
+	// virtualHosts are the origins mapped with SetVirtualHostNameToFolderMapping, e.g. https://app.local.
+	virtualHosts map[string]struct{}
+
+	environmentCompleted int32
+	controllerCompleted  int32
+	embedErr             error
 
-	controllerCompleted int32
+	// dispatchSync runs a function on the UI thread, it's WebView.DispatchSync.
+	dispatchSync func(fn func() error) error
 }
 
 func (wv *WebView) Browser() *browser {
 	return wv.browser
 }
 
+// embed creates the controller of the WebView in its window, and the environment first unless ensureRuntime did.
 func (b *browser) embed(wv *WebView) error {
 	b.hwnd = wv.window.handle
 
-	exePath := make([]uint16, windows.MAX_PATH)
+	if b.environment == nil {
+		if err := b.createEnvironment(wv); err != nil {
+			return err
+		}
+	}
 
-	_, err := windows.GetModuleFileName(windows.Handle(0), &exePath[0], windows.MAX_PATH)
+	atomic.StoreInt32(&b.controllerCompleted, 0)
+
+	h := wv.controllerCompletedHandler()
+	defer h.Release()
+
+	if err := b.environment.CreateCoreWebView2Controller(b.hwnd, h); err != nil {
+		return fmt.Errorf("failed to create the controller: %w", err)
+	}
+
+	if err := b.wait(&b.controllerCompleted); err != nil {
+		return err
+	}
+
+	settings, err := b.view.GetSettings()
 	if err != nil {
-		return fmt.Errorf("failed to get module file name: %w", err)
+		return fmt.Errorf("failed to get webview settings: %w", err)
 	}
 
-	dataPath := filepath.Join(os.Getenv("AppData"), filepath.Base(windows.UTF16ToString(exePath)))
+	b.settings = settings
 
-	r1, _, err := wv.dll.Call(0, uint64(uintptr(unsafe.Pointer(windows.StringToUTF16Ptr(dataPath)))), 0, uint64(wv.environmentCompletedHandler()))
-	hr := hresult.HRESULT(r1)
+	return nil
+}
 
-	if err != nil && err != errOK {
-		return fmt.Errorf("failed to call CreateCoreWebView2EnvironmentWithOptions: %w", err)
+// errRuntimeMissing is returned by CreateCoreWebView2EnvironmentWithOptions when no runtime is installed.
+var errRuntimeMissing = hresult.FromWin32(hresult.ERROR_FILE_NOT_FOUND)
+
+// ensureRuntime creates the environment, before the window, and installs the Evergreen runtime with the bootstrapper
+// if the environment can't be created because it's missing. A fixed version runtime doesn't need the Evergreen one.
+func (wv *WebView) ensureRuntime(ctx context.Context) error {
+	b := wv.browser
+
+	err := b.createEnvironment(wv)
+	if !errors.Is(err, errRuntimeMissing) || b.config.environmentSettings().BrowserExecutableFolder != "" {
+		return err
 	}
 
-	if hr > hresult.S_OK {
-		return fmt.Errorf("failed to call CreateCoreWebView2EnvironmentWithOptions: %s", hr)
+	if err := bootstrapper.Install(ctx, *b.config.install); err != nil {
+		return fmt.Errorf("failed to install the WebView2 runtime: %w", err)
 	}
 
-	for {
-		if atomic.LoadInt32(&b.controllerCompleted) != 0 {
-			break
+	return b.createEnvironment(wv)
+}
+
+// createEnvironment calls CreateCoreWebView2EnvironmentWithOptions and waits until the environment is created.
+func (b *browser) createEnvironment(wv *WebView) error {
+	env := b.config.environmentSettings()
+
+	dataPath, err := userDataFolder(env.UserDataFolder)
+	if err != nil {
+		return err
+	}
+
+	dataPathPtr, err := windows.UTF16PtrFromString(dataPath)
+	if err != nil {
+		return fmt.Errorf("invalid user data folder: %w", err)
+	}
+
+	var browserPathPtr *uint16
+
+	if env.BrowserExecutableFolder != "" {
+		if browserPathPtr, err = windows.UTF16PtrFromString(env.BrowserExecutableFolder); err != nil {
+			return fmt.Errorf("invalid browser executable folder: %w", err)
 		}
+	}
+
+	options := b.config.environmentOptions(env)
+	defer options.Release()
+
+	handler := wv.environmentCompletedHandler()
+	defer handler.Release()
+
+	atomic.StoreInt32(&b.environmentCompleted, 0)
+
+	restoreEnv := envpolicy.Apply(env)
+
+	r1, _, err := wv.dll.Call(
+		uint64(uintptr(unsafe.Pointer(browserPathPtr))),
+		uint64(uintptr(unsafe.Pointer(dataPathPtr))),
+		uint64(uintptr(unsafe.Pointer(options))),
+		uint64(uintptr(unsafe.Pointer(handler))),
+	)
+
+	// The paths live on the Go heap, and the Go loader allocates while it reads them back.
+	runtime.KeepAlive(browserPathPtr)
+	runtime.KeepAlive(dataPathPtr)
 
+	restoreEnv()
+
+	// The HRESULT says why the call failed, the last error may be left over from an earlier call.
+	if hr := hresult.HRESULT(uint32(r1)); hr.Failed() {
+		return fmt.Errorf("failed to call CreateCoreWebView2EnvironmentWithOptions: %w", hr)
+	}
+
+	if err != nil && err != errOK {
+		return fmt.Errorf("failed to call CreateCoreWebView2EnvironmentWithOptions: %w", err)
+	}
+
+	return b.wait(&b.environmentCompleted)
+}
+
+// wait pumps the messages of the thread until a completed handler sets the flag, and returns the error
+// the handler failed with.
+func (b *browser) wait(completed *int32) error {
+	for atomic.LoadInt32(completed) == 0 {
 		msg, err := user32.GetMessageW()
 		if err != nil {
 			return err
@@ -81,32 +208,90 @@ func (b *browser) embed(wv *WebView) error {
 			break
 		}
 
-		err = user32.TranslateMessage(msg)
-		if err != nil {
+		if err := user32.TranslateMessage(msg); err != nil {
 			return err
 		}
 
-		err = user32.DispatchMessageW(msg)
-		if err != nil {
+		if err := user32.DispatchMessageW(msg); err != nil {
 			return err
 		}
 	}
 
-	settings := new(com.ICoreWebView2Settings)
+	err := b.embedErr
+	b.embedErr = nil
 
-	r, _, err := syscall.Syscall(b.view.VTBL.GetSettings, 2, uintptr(unsafe.Pointer(b.view)), uintptr(unsafe.Pointer(&settings)), 0)
-	if !errors.Is(err, errOK) {
-		return err
+	return err
+}
+
+// environmentSettings resolves the environment options with the WEBVIEW2_* environment variables, per the EnvPolicy.
+func (c *browserConfig) environmentSettings() envpolicy.Settings {
+	return envpolicy.Resolve(c.envPolicy, envpolicy.Settings{
+		BrowserExecutableFolder:    c.browserExecutableFolder,
+		UserDataFolder:             c.userDataFolder,
+		AdditionalBrowserArguments: c.additionalBrowserArguments,
+	}, os.LookupEnv)
+}
+
+// userDataFolder returns the folder, which defaults to a folder named after the executable in %AppData%.
+func userDataFolder(folder string) (string, error) {
+	if folder != "" {
+		return folder, nil
 	}
 
-	hr = hresult.HRESULT(r)
-	if hr > hresult.S_OK {
-		return fmt.Errorf("failed to get webview settings: %s", hr)
+	exePath := make([]uint16, windows.MAX_PATH)
+
+	_, err := windows.GetModuleFileName(windows.Handle(0), &exePath[0], windows.MAX_PATH)
+	if err != nil {
+		return "", fmt.Errorf("failed to get module file name: %w", err)
 	}
 
-	b.settings = settings
+	return filepath.Join(os.Getenv("AppData"), filepath.Base(windows.UTF16ToString(exePath))), nil
+}
 
-	return nil
+// environmentOptions creates the options passed to CreateCoreWebView2EnvironmentWithOptions,
+// the caller must release them.
+func (c *browserConfig) environmentOptions(env envpolicy.Settings) *com.EnvironmentOptions {
+	options := com.NewEnvironmentOptions()
+	options.AdditionalBrowserArguments = env.AdditionalBrowserArguments
+	options.Language = c.language
+
+	if c.targetCompatibleBrowserVersion != "" {
+		options.TargetCompatibleBrowserVersion = c.targetCompatibleBrowserVersion
+	}
+
+	return options
+}
+
+// close closes the controller, which shuts the browser process down, and releases the COM objects.
+func (b *browser) close() error {
+	var err error
+
+	if b.controller != nil {
+		if closeErr := b.controller.Close(); closeErr != nil {
+			err = fmt.Errorf("failed to close the controller: %w", closeErr)
+		}
+	}
+
+	for _, obj := range []unsafe.Pointer{
+		unsafe.Pointer(b.settings),
+		unsafe.Pointer(b.view),
+		unsafe.Pointer(b.controller),
+		unsafe.Pointer(b.environment),
+	} {
+		if obj != nil {
+			release(obj)
+		}
+	}
+
+	b.settings = nil
+	b.view = nil
+	b.controller = nil
+	b.environment = nil
+
+	b.handlers = map[EventToken]struct{}{}
+	b.bindingScripts = map[string]*bindingScript{}
+
+	return err
 }
 
 func (b *browser) resize() error {
@@ -119,14 +304,14 @@ func (b *browser) resize() error {
 		return fmt.Errorf("failed to get client rect: %w", err)
 	}
 
-	_, _, err = syscall.Syscall(
-		b.controller.VTBL.PutBounds, 2,
-		uintptr(unsafe.Pointer(b.controller)),
-		uintptr(unsafe.Pointer(bounds)),
-		0,
-	)
+	err = b.controller.PutBounds(com.RECT{
+		Left:   bounds.Left,
+		Top:    bounds.Top,
+		Right:  bounds.Right,
+		Bottom: bounds.Bottom,
+	})
 
-	if !errors.Is(err, errOK) {
+	if err != nil {
 		return fmt.Errorf("failed to put bounds: %w", err)
 	}
 
@@ -134,65 +319,19 @@ func (b *browser) resize() error {
 }
 
 func (b *browser) Navigate(url string) error {
-	_, _, err := syscall.Syscall(
-		b.view.VTBL.Navigate, 3,
-		uintptr(unsafe.Pointer(b.view)),
-		uintptr(unsafe.Pointer(windows.StringToUTF16Ptr(url))),
-		0,
-	)
-
-	if !errors.Is(err, errOK) {
-		return err
-	}
-
-	return nil
+	return b.view.Navigate(url)
 }
 
 func (b *browser) AddScriptToExecuteOnDocumentCreated(script string) error {
-	_, _, err := syscall.Syscall(
-		b.view.VTBL.AddScriptToExecuteOnDocumentCreated, 3,
-		uintptr(unsafe.Pointer(b.view)),
-		uintptr(unsafe.Pointer(windows.StringToUTF16Ptr(script))),
-		0,
-	)
-
-	if !errors.Is(err, errOK) {
-		return err
-	}
-
-	return nil
+	return b.view.AddScriptToExecuteOnDocumentCreated(script, nil)
 }
 
 func (b *browser) ExecuteScript(script string) error {
-	_, _, err := syscall.Syscall(
-		b.view.VTBL.ExecuteScript, 3,
-		uintptr(unsafe.Pointer(b.view)),
-		uintptr(unsafe.Pointer(windows.StringToUTF16Ptr(script))),
-		0,
-	)
-
-	if !errors.Is(err, errOK) {
-		return err
-	}
-
-	return nil
+	return b.view.ExecuteScript(script, nil)
 }
 
-func (b *browser) saveSetting(setter uintptr, enabled bool) error {
-	var flag uintptr = 0
-
-	if enabled {
-		flag = 1
-	}
-
-	_, _, err := syscall.Syscall(
-		setter, 3,
-		uintptr(unsafe.Pointer(b.settings)),
-		flag,
-		0,
-	)
-
-	if !errors.Is(err, errOK) {
+func (b *browser) saveSetting(setter func(bool) error, enabled bool) error {
+	if err := setter(enabled); err != nil {
 		return fmt.Errorf("failed to save a setting: %w", err)
 	}
 
@@ -200,78 +339,107 @@ func (b *browser) saveSetting(setter uintptr, enabled bool) error {
 }
 
 func (b *browser) saveSettings() error {
-	if err := b.saveSetting(b.settings.VTBL.PutIsBuiltInErrorPageEnabled, b.config.builtInErrorPage); err != nil {
+	if err := b.saveSetting(b.settings.PutIsBuiltInErrorPageEnabled, b.config.builtInErrorPage); err != nil {
 		return err
 	}
 
-	if err := b.saveSetting(b.settings.VTBL.PutAreDefaultContextMenusEnabled, b.config.defaultContextMenus); err != nil {
+	if err := b.saveSetting(b.settings.PutAreDefaultContextMenusEnabled, b.config.defaultContextMenus); err != nil {
 		return err
 	}
 
-	if err := b.saveSetting(b.settings.VTBL.PutAreDefaultScriptDialogsEnabled, b.config.defaultScriptDialogs); err != nil {
+	if err := b.saveSetting(b.settings.PutAreDefaultScriptDialogsEnabled, b.config.defaultScriptDialogs); err != nil {
 		return err
 	}
 
-	if err := b.saveSetting(b.settings.VTBL.PutAreDevToolsEnabled, b.config.devtools); err != nil {
+	if err := b.saveSetting(b.settings.PutAreDevToolsEnabled, b.config.devtools); err != nil {
 		return err
 
 	}
 
-	if err := b.saveSetting(b.settings.VTBL.PutAreHostObjectsAllowed, b.config.hostObjects); err != nil {
+	if err := b.saveSetting(b.settings.PutAreHostObjectsAllowed, b.config.hostObjects); err != nil {
 		return err
 	}
 
-	if err := b.saveSetting(b.settings.VTBL.PutIsScriptEnabled, b.config.script); err != nil {
+	if err := b.saveSetting(b.settings.PutIsScriptEnabled, b.config.script); err != nil {
 		return err
 	}
 
-	if err := b.saveSetting(b.settings.VTBL.PutIsStatusBarEnabled, b.config.statusBar); err != nil {
+	if err := b.saveSetting(b.settings.PutIsStatusBarEnabled, b.config.statusBar); err != nil {
 		return err
 
 	}
 
-	if err := b.saveSetting(b.settings.VTBL.PutIsWebMessageEnabled, b.config.webMessage); err != nil {
+	if err := b.saveSetting(b.settings.PutIsWebMessageEnabled, b.config.webMessage); err != nil {
 		return err
 	}
 
-	return b.saveSetting(b.settings.VTBL.PutIsZoomControlEnabled, b.config.zoomControl)
+	return b.saveSetting(b.settings.PutIsZoomControlEnabled, b.config.zoomControl)
 }
 
-func (wv *WebView) environmentCompletedHandler() uintptr {
-	h := &com.ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler{
-		VTBL: &com.ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandlerVTBL{
-			Invoke: windows.NewCallback(func(i uintptr, p uintptr, createdEnvironment *com.ICoreWebView2Environment) uintptr {
-				_, _, _ = syscall.Syscall(createdEnvironment.VTBL.CreateCoreWebView2Controller, 3, uintptr(unsafe.Pointer(createdEnvironment)), uintptr(wv.window.handle), wv.controllerCompletedHandler())
-				return 0
-			}),
-		},
-	}
+func (wv *WebView) environmentCompletedHandler() *com.Handler {
+	return com.NewHandler(com.IID_ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler, func(errorCode uintptr, p unsafe.Pointer) uintptr {
+		if hr := hresult.HRESULT(uint32(errorCode)); hr.Failed() {
+			wv.browser.fail(fmt.Errorf("failed to create the environment: %w", hr))
+			return 0
+		}
 
-	h.VTBL.BasicVTBL = com.NewBasicVTBL(&h.Basic)
-	return uintptr(unsafe.Pointer(h))
+		if p == nil {
+			wv.browser.fail(errors.New("failed to create the environment"))
+			return 0
+		}
+
+		createdEnvironment := (*com.ICoreWebView2Environment)(p)
+
+		_, _, _ = syscall.Syscall(createdEnvironment.VTBL.AddRef, 1, uintptr(p), 0, 0)
+		wv.browser.environment = createdEnvironment
+
+		atomic.StoreInt32(&wv.browser.environmentCompleted, 1)
+
+		return 0
+	})
 }
 
-func (wv *WebView) controllerCompletedHandler() uintptr {
-	h := &com.ICoreWebView2CreateCoreWebView2ControllerCompletedHandler{
-		VTBL: &com.ICoreWebView2CreateCoreWebView2ControllerCompletedHandlerVTBL{
-			Invoke: windows.NewCallback(func(i *com.ICoreWebView2CreateCoreWebView2ControllerCompletedHandler, p uintptr, createdController *com.ICoreWebView2Controller) uintptr {
-				_, _, _ = syscall.Syscall(createdController.VTBL.AddRef, 1, uintptr(unsafe.Pointer(createdController)), 0, 0)
-				wv.browser.controller = createdController
+func (wv *WebView) controllerCompletedHandler() *com.Handler {
+	return com.NewHandler(com.IID_ICoreWebView2CreateCoreWebView2ControllerCompletedHandler, func(errorCode uintptr, p unsafe.Pointer) uintptr {
+		if hr := hresult.HRESULT(uint32(errorCode)); hr.Failed() {
+			wv.browser.fail(fmt.Errorf("failed to create the controller: %w", hr))
+			return 0
+		}
 
-				createdWebView2 := new(com.ICoreWebView2)
+		if p == nil {
+			wv.browser.fail(errors.New("failed to create the controller"))
+			return 0
+		}
 
-				_, _, _ = syscall.Syscall(createdController.VTBL.GetCoreWebView2, 2, uintptr(unsafe.Pointer(createdController)), uintptr(unsafe.Pointer(&createdWebView2)), 0)
-				wv.browser.view = createdWebView2
+		createdController := (*com.ICoreWebView2Controller)(p)
 
-				_, _, _ = syscall.Syscall(wv.browser.view.VTBL.AddRef, 1, uintptr(unsafe.Pointer(wv.browser.view)), 0, 0)
+		_, _, _ = syscall.Syscall(createdController.VTBL.AddRef, 1, uintptr(p), 0, 0)
+		wv.browser.controller = createdController
 
-				atomic.StoreInt32(&wv.browser.controllerCompleted, 1)
+		// GetCoreWebView2 adds a reference for us.
+		createdWebView2, err := createdController.GetCoreWebView2()
+		if err != nil {
+			wv.browser.fail(fmt.Errorf("failed to get the webview: %w", err))
+			return 0
+		}
 
-				return 0
-			}),
-		},
-	}
+		wv.browser.view = createdWebView2
+
+		atomic.StoreInt32(&wv.browser.controllerCompleted, 1)
+
+		return 0
+	})
+}
+
+// fail stops waiting for the environment or the controller, wait returns the error.
+func (b *browser) fail(err error) {
+	b.embedErr = err
+	atomic.StoreInt32(&b.environmentCompleted, 1)
+	atomic.StoreInt32(&b.controllerCompleted, 1)
+}
 
-	h.VTBL.BasicVTBL = com.NewBasicVTBL(&h.Basic)
-	return uintptr(unsafe.Pointer(h))
+// release releases a reference to a COM object owned by the WebView.
+func release(obj unsafe.Pointer) {
+	vtbl := *(**com.BasicVTBL)(obj)
+	_, _, _ = syscall.Syscall(vtbl.Release, 1, uintptr(obj), 0, 0)
 }
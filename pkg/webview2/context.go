@@ -29,3 +29,10 @@ func (wcs *webviewContextStore) get(hwnd windows.Handle) (*WebView, bool) {
 	wv, ok := wcs.store[hwnd]
 	return wv, ok
 }
+
+func (wcs *webviewContextStore) delete(hwnd windows.Handle) {
+	wcs.mu.Lock()
+	defer wcs.mu.Unlock()
+
+	delete(wcs.store, hwnd)
+}
@@ -0,0 +1,73 @@
+package webview2
+
+import (
+	"fmt"
+
+	"github.com/mattpodraza/webview2/v2/pkg/user32"
+	"golang.org/x/sys/windows"
+)
+
+// wmDispatch is posted to the window to run the dispatched functions.
+const wmDispatch = user32.WMApp + 1
+
+// Dispatch schedules fn to run on the UI thread, which is the thread running Run.
+// It's safe to call from any goroutine, and it doesn't wait for fn to run.
+//
+// The browser and window methods must only be called on the UI thread, Dispatch is how other goroutines can use them.
+func (wv *WebView) Dispatch(fn func()) {
+	_ = wv.dispatch(fn)
+}
+
+// DispatchSync runs fn on the UI thread and waits for it to return.
+// If called on the UI thread, fn runs immediately. A panic in fn is returned as an error either way.
+func (wv *WebView) DispatchSync(fn func() error) error {
+	if windows.GetCurrentThreadId() == wv.threadID {
+		return callRecovering(fn)
+	}
+
+	done := make(chan error, 1)
+
+	err := wv.dispatch(func() {
+		done <- callRecovering(fn)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return <-done
+}
+
+// callRecovering calls fn, turning a panic into an error.
+func callRecovering(fn func() error) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("dispatched function panicked: %v", p)
+		}
+	}()
+
+	return fn()
+}
+
+func (wv *WebView) dispatch(fn func()) error {
+	wv.dispatchMu.Lock()
+	wv.dispatchQueue = append(wv.dispatchQueue, fn)
+	wv.dispatchMu.Unlock()
+
+	if err := user32.PostMessageW(wv.window.handle, wmDispatch, 0, 0); err != nil {
+		return fmt.Errorf("failed to post the dispatch message: %w", err)
+	}
+
+	return nil
+}
+
+func (wv *WebView) runDispatched() {
+	wv.dispatchMu.Lock()
+	queue := wv.dispatchQueue
+	wv.dispatchQueue = nil
+	wv.dispatchMu.Unlock()
+
+	for _, fn := range queue {
+		fn()
+	}
+}
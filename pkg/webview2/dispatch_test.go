@@ -0,0 +1,22 @@
+package webview2
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCallRecovering(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	if err := callRecovering(func() error { return nil }); err != nil {
+		t.Errorf("callRecovering() = %v, want nil", err)
+	}
+
+	if err := callRecovering(func() error { return errFailed }); err != errFailed {
+		t.Errorf("callRecovering() = %v, want %v", err, errFailed)
+	}
+
+	if err := callRecovering(func() error { panic("boom") }); err == nil || err.Error() != "dispatched function panicked: boom" {
+		t.Errorf("callRecovering() = %v, want the panic", err)
+	}
+}
@@ -0,0 +1,67 @@
+package webview2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"unsafe"
+
+	"github.com/mattpodraza/webview2/v2/pkg/com"
+	"github.com/mattpodraza/webview2/v2/pkg/hresult"
+	"golang.org/x/sys/windows"
+)
+
+// EvaluateScript executes the script in the top-level document and returns its result encoded as JSON.
+// It keeps processing window messages while waiting, so it must be called on the UI thread.
+//
+// The result is "null" if the script throws or returns undefined.
+func (b *browser) EvaluateScript(ctx context.Context, script string) (json.RawMessage, error) {
+	var (
+		completed bool
+		resultHR  hresult.HRESULT
+		result    string
+	)
+
+	h := com.NewHandler(com.IID_ICoreWebView2ExecuteScriptCompletedHandler, func(errorCode uintptr, resultObjectAsJSON unsafe.Pointer) uintptr {
+		resultHR = hresult.HRESULT(uint32(errorCode))
+
+		// The result is owned by the WebView, so it must not be freed.
+		if resultObjectAsJSON != nil {
+			result = windows.UTF16PtrToString((*uint16)(resultObjectAsJSON))
+		}
+
+		completed = true
+
+		return 0
+	})
+
+	defer h.Release()
+
+	if err := b.view.ExecuteScript(script, h); err != nil {
+		return nil, fmt.Errorf("failed to execute the script: %w", err)
+	}
+
+	if err := pump(ctx, func() bool { return completed }); err != nil {
+		return nil, err
+	}
+
+	if resultHR.Failed() {
+		return nil, fmt.Errorf("failed to execute the script: %w", resultHR)
+	}
+
+	return json.RawMessage(result), nil
+}
+
+// EvaluateScriptInto executes the script like EvaluateScript and decodes its result into v.
+func (b *browser) EvaluateScriptInto(ctx context.Context, script string, v interface{}) error {
+	result, err := b.EvaluateScript(ctx, script)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(result, v); err != nil {
+		return fmt.Errorf("failed to decode the script result: %w", err)
+	}
+
+	return nil
+}
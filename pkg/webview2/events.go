@@ -0,0 +1,82 @@
+package webview2
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mattpodraza/webview2/v2/pkg/com"
+)
+
+type event int
+
+const (
+	eventWebMessageReceived event = iota
+	eventNavigationStarting
+	eventContentLoading
+	eventNavigationCompleted
+	eventFrameNavigationStarting
+	eventFrameNavigationCompleted
+	eventWebResourceRequested
+)
+
+// EventToken identifies a registered event handler, pass it to RemoveEventHandler to unregister the handler.
+type EventToken struct {
+	event event
+	value com.EventRegistrationToken
+}
+
+// eventMethods returns the methods adding and removing the handlers of the event.
+func (b *browser) eventMethods(ev event) (add func(*com.Handler) (com.EventRegistrationToken, error), remove func(com.EventRegistrationToken) error) {
+	switch ev {
+	case eventWebMessageReceived:
+		return b.view.AddWebMessageReceived, b.view.RemoveWebMessageReceived
+	case eventNavigationStarting:
+		return b.view.AddNavigationStarting, b.view.RemoveNavigationStarting
+	case eventContentLoading:
+		return b.view.AddContentLoading, b.view.RemoveContentLoading
+	case eventNavigationCompleted:
+		return b.view.AddNavigationCompleted, b.view.RemoveNavigationCompleted
+	case eventFrameNavigationStarting:
+		return b.view.AddFrameNavigationStarting, b.view.RemoveFrameNavigationStarting
+	case eventFrameNavigationCompleted:
+		return b.view.AddFrameNavigationCompleted, b.view.RemoveFrameNavigationCompleted
+	case eventWebResourceRequested:
+		return b.view.AddWebResourceRequested, b.view.RemoveWebResourceRequested
+	}
+
+	return nil, nil
+}
+
+// addEventHandler registers an event handler, the WebView holds on to it until it's removed.
+func (b *browser) addEventHandler(ev event, handler *com.Handler) (EventToken, error) {
+	defer handler.Release()
+
+	add, _ := b.eventMethods(ev)
+
+	token, err := add(handler)
+	if err != nil {
+		return EventToken{}, fmt.Errorf("failed to add an event handler: %w", err)
+	}
+
+	t := EventToken{event: ev, value: token}
+	b.handlers[t] = struct{}{}
+
+	return t, nil
+}
+
+// RemoveEventHandler unregisters an event handler.
+func (b *browser) RemoveEventHandler(token EventToken) error {
+	if _, ok := b.handlers[token]; !ok {
+		return errors.New("unknown event token")
+	}
+
+	_, remove := b.eventMethods(token.event)
+
+	if err := remove(token.value); err != nil {
+		return fmt.Errorf("failed to remove an event handler: %w", err)
+	}
+
+	delete(b.handlers, token)
+
+	return nil
+}
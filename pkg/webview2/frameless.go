@@ -0,0 +1,226 @@
+package webview2
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/mattpodraza/webview2/v2/pkg/user32"
+	"golang.org/x/sys/windows"
+)
+
+// resizeBorder is the width of the edges which resize a frameless window, in CSS pixels.
+const resizeBorder = 5
+
+// dragScript finds the draggable regions of the page: the elements styled with app-region: drag,
+// or -webkit-app-region: drag, and the ones with a data-webview-drag attribute.
+// app-region: no-drag and data-webview-no-drag carve out the controls, e.g. the buttons of a custom title bar.
+// A press on a draggable region or on the edges of the window is posted to the host, which moves or resizes the window,
+// a double click maximizes or restores it.
+// The WebView covers the whole client area and takes the mouse input, so the window never gets WM_NCHITTEST
+// for the page: the script does the hit testing instead.
+var dragScript = fmt.Sprintf(`(function () {
+	if (window.__webview2_drag) {
+		return;
+	}
+
+	window.__webview2_drag = true;
+
+	var border = %d;
+
+	function region(el) {
+		for (; el && el.nodeType === 1; el = el.parentElement) {
+			if (el.hasAttribute("data-webview-no-drag")) {
+				return false;
+			}
+
+			if (el.hasAttribute("data-webview-drag")) {
+				return true;
+			}
+
+			var style = window.getComputedStyle(el);
+			var value = style.getPropertyValue("app-region") || style.getPropertyValue("-webkit-app-region");
+
+			if (value === "no-drag") {
+				return false;
+			}
+
+			if (value === "drag") {
+				return true;
+			}
+		}
+
+		return false;
+	}
+
+	function edge(e) {
+		if (window.outerWidth >= screen.availWidth && window.outerHeight >= screen.availHeight) {
+			return "";
+		}
+
+		var x = e.clientX, y = e.clientY, w = window.innerWidth, h = window.innerHeight;
+		var v = y < border ? "top" : y >= h - border ? "bottom" : "";
+		var s = x < border ? "left" : x >= w - border ? "right" : "";
+
+		return v && s ? v + "-" + s : v || s;
+	}
+
+	var cursors = {
+		"top": "ns-resize", "bottom": "ns-resize", "left": "ew-resize", "right": "ew-resize",
+		"top-left": "nwse-resize", "bottom-right": "nwse-resize", "top-right": "nesw-resize", "bottom-left": "nesw-resize"
+	};
+
+	var resizing = false;
+
+	document.addEventListener("mousemove", function (e) {
+		var hit = edge(e);
+
+		if (hit) {
+			document.documentElement.style.cursor = cursors[hit];
+			resizing = true;
+		} else if (resizing) {
+			document.documentElement.style.cursor = "";
+			resizing = false;
+		}
+	}, true);
+
+	function post(hit) {
+		window.chrome.webview.postMessage({ __webview2: "drag", hit: hit });
+	}
+
+	document.addEventListener("mousedown", function (e) {
+		if (e.button !== 0) {
+			return;
+		}
+
+		var hit = edge(e) || (region(e.target) ? "caption" : "");
+		if (!hit) {
+			return;
+		}
+
+		e.preventDefault();
+		post(e.detail === 2 && hit === "caption" ? "toggle" : hit);
+	}, true);
+})();
+`, resizeBorder)
+
+// dragHits maps the hits posted by dragScript to the WM_NCHITTEST results the window is dragged with.
+var dragHits = map[string]uintptr{
+	"caption":      user32.HTCaption,
+	"left":         user32.HTLeft,
+	"right":        user32.HTRight,
+	"top":          user32.HTTop,
+	"bottom":       user32.HTBottom,
+	"top-left":     user32.HTTopLeft,
+	"top-right":    user32.HTTopRight,
+	"bottom-left":  user32.HTBottomLeft,
+	"bottom-right": user32.HTBottomRight,
+}
+
+// dragMessage is the web message posted by dragScript.
+type dragMessage struct {
+	Kind string `json:"__webview2"`
+	Hit  string `json:"hit"`
+}
+
+// listenForDrags installs dragScript and moves the window as the page asks. It requires web messages to be enabled.
+func (b *browser) listenForDrags() error {
+	if !b.config.webMessage {
+		return nil
+	}
+
+	_, err := b.OnWebMessage(func(msg WebMessage) {
+		var m dragMessage
+		if err := msg.Decode(&m); err != nil || m.Kind != "drag" {
+			return
+		}
+
+		// Any page, e.g. one the user followed a link to, can post the message, only the application's move the window.
+		if !b.isAppOrigin(msg.Source) {
+			return
+		}
+
+		_ = drag(b.hwnd, m.Hit)
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to listen for web messages: %w", err)
+	}
+
+	if err := b.AddScriptToExecuteOnDocumentCreated(dragScript); err != nil {
+		return fmt.Errorf("failed to add the drag script: %w", err)
+	}
+
+	return b.ExecuteScript(dragScript)
+}
+
+// isAppOrigin tells whether the URI is on the origin of the application: the one of the initial URL,
+// or one of the virtual hosts mapped with SetVirtualHostNameToFolderMapping.
+func (b *browser) isAppOrigin(uri string) bool {
+	o := origin(uri)
+	if o == "" {
+		return false
+	}
+
+	if o == origin(b.config.initialURL) {
+		return true
+	}
+
+	_, ok := b.virtualHosts[o]
+
+	return ok
+}
+
+// origin returns the scheme and the host of the URI, lower-cased and without the default port,
+// or an empty string if it isn't an absolute URI.
+func origin(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme == "" {
+		return ""
+	}
+
+	scheme, host := strings.ToLower(u.Scheme), strings.ToLower(u.Host)
+
+	if port := u.Port(); (scheme == "http" && port == "80") || (scheme == "https" && port == "443") {
+		host = strings.TrimSuffix(host, ":"+port)
+	}
+
+	return scheme + "://" + host
+}
+
+// drag starts moving or resizing the window the way pressing its frame does.
+func drag(hwnd windows.Handle, hit string) error {
+	zoomed := user32.IsZoomed(hwnd)
+
+	if hit == "toggle" {
+		if zoomed {
+			return user32.ShowWindow(hwnd, user32.SW_RESTORE)
+		}
+
+		return user32.ShowWindow(hwnd, user32.SW_SHOWMAXIMIZED)
+	}
+
+	ht, ok := dragHits[hit]
+	if !ok || (zoomed && ht != user32.HTCaption) {
+		return nil
+	}
+
+	pt, err := user32.GetCursorPos()
+	if err != nil {
+		return err
+	}
+
+	// The browser holds the mouse capture, the window only enters the move loop once it's released.
+	_ = user32.ReleaseCapture()
+
+	return user32.PostMessageW(hwnd, user32.WMNCLButtonDown, ht, uintptr(uint16(pt.X))|uintptr(uint16(pt.Y))<<16)
+}
+
+// frameSize returns the size of the resizing border, the part of a maximized window which is off screen.
+func frameSize() (cx, cy int32) {
+	padding, _ := user32.GetSystemMetrics(user32.SystemMetricsCxPaddedBorder)
+	x, _ := user32.GetSystemMetrics(user32.SystemMetricsCxSizeFrame)
+	y, _ := user32.GetSystemMetrics(user32.SystemMetricsCySizeFrame)
+
+	return int32(x + padding), int32(y + padding)
+}
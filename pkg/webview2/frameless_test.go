@@ -0,0 +1,53 @@
+package webview2
+
+import "testing"
+
+func TestOrigin(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want string
+	}{
+		{"https://app.local/index.html", "https://app.local"},
+		{"HTTPS://App.Local/", "https://app.local"},
+		{"https://app.local:443/a", "https://app.local"},
+		{"http://localhost:80/", "http://localhost"},
+		{"http://localhost:8080/", "http://localhost:8080"},
+		{"file:///C:/app/index.html", "file://"},
+		{"about:blank", "about://"},
+		{"/relative", ""},
+		{"", ""},
+	}
+
+	for _, test := range tests {
+		if o := origin(test.uri); o != test.want {
+			t.Errorf("origin(%q) = %q, want %q", test.uri, o, test.want)
+		}
+	}
+}
+
+func TestIsAppOrigin(t *testing.T) {
+	b := &browser{
+		config:       &browserConfig{initialURL: "https://example.com/app/"},
+		virtualHosts: map[string]struct{}{origin("https://app.local"): {}},
+	}
+
+	tests := []struct {
+		source string
+		want   bool
+	}{
+		{"https://example.com/app/index.html", true},
+		{"https://example.com/other", true},
+		{"https://app.local/index.html", true},
+		{"https://evil.example/", false},
+		{"http://example.com/app/", false},
+		{"https://example.com.evil.example/", false},
+		{"https://app.local.evil.example/", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		if is := b.isAppOrigin(test.source); is != test.want {
+			t.Errorf("isAppOrigin(%q) = %t, want %t", test.source, is, test.want)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package webview2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mattpodraza/webview2/v2/pkg/bootstrapper"
+	"github.com/mattpodraza/webview2/v2/pkg/hresult"
+)
+
+const runtimeDownloadURL = bootstrapper.URL
+
+// hints tell what to do about the failures New runs into the most, keyed by the HRESULT the WebView2 loader
+// or runtime reports.
+var hints = map[hresult.HRESULT]string{
+	hresult.FromWin32(hresult.ERROR_FILE_NOT_FOUND):            "The WebView2 runtime isn't installed, install it from " + runtimeDownloadURL + " or use WithEnsureRuntime.",
+	hresult.FromWin32(hresult.ERROR_PATH_NOT_FOUND):            "The browser executable folder or the user data folder doesn't exist, check the WEBVIEW2_* environment variables.",
+	hresult.FromWin32(hresult.ERROR_PRODUCT_UNINSTALLED):       "The WebView2 runtime was uninstalled, reinstall it from " + runtimeDownloadURL + ".",
+	hresult.FromWin32(hresult.ERROR_INVALID_STATE):             "The user data folder is in use by another WebView2 created with different options, use another folder or close the other program.",
+	hresult.FromWin32(hresult.ERROR_SHARING_VIOLATION):         "The user data folder is locked by another process, use another folder or close the other program.",
+	hresult.FromWin32(hresult.ERROR_DISK_FULL):                 "There's no space left for the user data folder, free some disk space.",
+	hresult.FromWin32(hresult.ERROR_BAD_EXE_FORMAT):            "The architecture of the WebView2 runtime doesn't match the one of the program, install the runtime matching the program.",
+	hresult.FromWin32(hresult.ERROR_EXE_MACHINE_TYPE_MISMATCH): "The architecture of the WebView2 runtime doesn't match the one of the program, install the runtime matching the program.",
+	hresult.E_ACCESSDENIED:                                     "The user data folder isn't writable, e.g. because the program is installed under Program Files, pick a writable folder.",
+	hresult.E_NOINTERFACE:                                      "The WebView2 runtime is too old, update it from " + runtimeDownloadURL + ".",
+	hresult.RPC_E_CHANGED_MODE:                                 "COM was initialized as multithreaded on the UI thread, WebView2 needs a single-threaded apartment.",
+	hresult.CO_E_NOTINITIALIZED:                                "COM isn't initialized on the UI thread, New must be called on the thread which imported this package.",
+}
+
+// withHint appends the description of the HRESULT in the chain of err, and a hint on how to fix the failure, if any.
+// The error still matches the HRESULT with errors.Is and hresult.From.
+func withHint(err error) error {
+	hr, ok := hresult.From(err)
+	if !ok {
+		return err
+	}
+
+	var parts []string
+
+	if message := hresult.Message(hr); message != "" {
+		parts = append(parts, strings.TrimSuffix(message, ".")+".")
+	}
+
+	if hint, ok := hints[hr]; ok {
+		parts = append(parts, hint)
+	}
+
+	if len(parts) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("%w: %s", err, strings.Join(parts, " "))
+}
@@ -0,0 +1,297 @@
+package webview2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"github.com/mattpodraza/webview2/v2/pkg/com"
+)
+
+// WebErrorStatus implements https://docs.microsoft.com/en-us/microsoft-edge/webview2/reference/win32/webview2-idl#corewebview2_web_error_status
+type WebErrorStatus int32
+
+const (
+	WebErrorStatusUnknown WebErrorStatus = iota
+	WebErrorStatusCertificateCommonNameIsIncorrect
+	WebErrorStatusCertificateExpired
+	WebErrorStatusClientCertificateContainsErrors
+	WebErrorStatusCertificateRevoked
+	WebErrorStatusCertificateIsInvalid
+	WebErrorStatusServerUnreachable
+	WebErrorStatusTimeout
+	WebErrorStatusErrorHTTPInvalidServerResponse
+	WebErrorStatusConnectionAborted
+	WebErrorStatusConnectionReset
+	WebErrorStatusDisconnected
+	WebErrorStatusCannotConnect
+	WebErrorStatusHostNameNotResolved
+	WebErrorStatusOperationCanceled
+	WebErrorStatusRedirectFailed
+	WebErrorStatusUnexpectedError
+)
+
+var webErrorStatusNames = map[WebErrorStatus]string{
+	WebErrorStatusUnknown:                          "unknown error",
+	WebErrorStatusCertificateCommonNameIsIncorrect: "certificate common name is incorrect",
+	WebErrorStatusCertificateExpired:               "certificate expired",
+	WebErrorStatusClientCertificateContainsErrors:  "client certificate contains errors",
+	WebErrorStatusCertificateRevoked:               "certificate revoked",
+	WebErrorStatusCertificateIsInvalid:             "certificate is invalid",
+	WebErrorStatusServerUnreachable:                "server unreachable",
+	WebErrorStatusTimeout:                          "timeout",
+	WebErrorStatusErrorHTTPInvalidServerResponse:   "invalid HTTP server response",
+	WebErrorStatusConnectionAborted:                "connection aborted",
+	WebErrorStatusConnectionReset:                  "connection reset",
+	WebErrorStatusDisconnected:                     "disconnected",
+	WebErrorStatusCannotConnect:                    "cannot connect",
+	WebErrorStatusHostNameNotResolved:              "host name not resolved",
+	WebErrorStatusOperationCanceled:                "operation canceled",
+	WebErrorStatusRedirectFailed:                   "redirect failed",
+	WebErrorStatusUnexpectedError:                  "unexpected error",
+}
+
+func (s WebErrorStatus) String() string {
+	if name, ok := webErrorStatusNames[s]; ok {
+		return name
+	}
+
+	return "unknown error"
+}
+
+var (
+	ErrNavigationCanceled  = errors.New("navigation canceled")
+	ErrNavigationTimeout   = errors.New("navigation timed out")
+	ErrCertificate         = errors.New("certificate error")
+	ErrConnection          = errors.New("connection error")
+	ErrHostNameNotResolved = errors.New("host name not resolved")
+	ErrInvalidResponse     = errors.New("invalid server response")
+)
+
+// ErrNavigationInterrupted is returned by NavigateAndWait when another navigation completes before the one it waits for.
+var ErrNavigationInterrupted = errors.New("navigation interrupted")
+
+// NavigationError is returned when a navigation doesn't succeed.
+// It matches one of the Err* navigation errors with errors.Is, depending on its status.
+type NavigationError struct {
+	URI    string
+	Status WebErrorStatus
+}
+
+func (e *NavigationError) Error() string {
+	return fmt.Sprintf("navigation to %s failed: %s", e.URI, e.Status)
+}
+
+func (e *NavigationError) Is(target error) bool {
+	switch e.Status {
+	case WebErrorStatusCertificateCommonNameIsIncorrect,
+		WebErrorStatusCertificateExpired,
+		WebErrorStatusClientCertificateContainsErrors,
+		WebErrorStatusCertificateRevoked,
+		WebErrorStatusCertificateIsInvalid:
+		return target == ErrCertificate
+	case WebErrorStatusServerUnreachable,
+		WebErrorStatusConnectionAborted,
+		WebErrorStatusConnectionReset,
+		WebErrorStatusDisconnected,
+		WebErrorStatusCannotConnect:
+		return target == ErrConnection
+	case WebErrorStatusTimeout:
+		return target == ErrNavigationTimeout
+	case WebErrorStatusHostNameNotResolved:
+		return target == ErrHostNameNotResolved
+	case WebErrorStatusOperationCanceled:
+		return target == ErrNavigationCanceled
+	case WebErrorStatusErrorHTTPInvalidServerResponse, WebErrorStatusRedirectFailed:
+		return target == ErrInvalidResponse
+	}
+
+	return false
+}
+
+// NavigationStartingEvent is passed to the NavigationStarting handlers.
+type NavigationStartingEvent struct {
+	URI             string
+	NavigationID    uint64
+	IsUserInitiated bool
+	IsRedirected    bool
+
+	// Cancel can be set by the handler to cancel the navigation.
+	Cancel bool
+}
+
+// ContentLoadingEvent is passed to the ContentLoading handlers.
+type ContentLoadingEvent struct {
+	NavigationID uint64
+	IsErrorPage  bool
+}
+
+// NavigationCompletedEvent is passed to the NavigationCompleted handlers.
+type NavigationCompletedEvent struct {
+	NavigationID   uint64
+	IsSuccess      bool
+	WebErrorStatus WebErrorStatus
+}
+
+// OnNavigationStarting registers a function that's called before the top-level document navigates.
+// The handler can cancel the navigation by setting the Cancel field of the event.
+func (b *browser) OnNavigationStarting(fn func(e *NavigationStartingEvent)) (EventToken, error) {
+	return b.addEventHandler(eventNavigationStarting, newNavigationStartingHandler(fn))
+}
+
+// OnFrameNavigationStarting is like OnNavigationStarting, but for the child frames.
+func (b *browser) OnFrameNavigationStarting(fn func(e *NavigationStartingEvent)) (EventToken, error) {
+	return b.addEventHandler(eventFrameNavigationStarting, newNavigationStartingHandler(fn))
+}
+
+// OnContentLoading registers a function that's called when the new document starts loading.
+func (b *browser) OnContentLoading(fn func(e ContentLoadingEvent)) (EventToken, error) {
+	h := com.NewHandler(com.IID_ICoreWebView2ContentLoadingEventHandler, func(_ uintptr, a unsafe.Pointer) uintptr {
+		args := (*com.ICoreWebView2ContentLoadingEventArgs)(a)
+
+		// The zero values are used for the properties that fail to be read.
+		id, _ := args.GetNavigationID()
+		isErrorPage, _ := args.GetIsErrorPage()
+
+		fn(ContentLoadingEvent{
+			NavigationID: id,
+			IsErrorPage:  isErrorPage,
+		})
+
+		return 0
+	})
+
+	return b.addEventHandler(eventContentLoading, h)
+}
+
+// OnNavigationCompleted registers a function that's called when the top-level document finishes loading.
+func (b *browser) OnNavigationCompleted(fn func(e NavigationCompletedEvent)) (EventToken, error) {
+	return b.addEventHandler(eventNavigationCompleted, newNavigationCompletedHandler(fn))
+}
+
+// OnFrameNavigationCompleted is like OnNavigationCompleted, but for the child frames.
+func (b *browser) OnFrameNavigationCompleted(fn func(e NavigationCompletedEvent)) (EventToken, error) {
+	return b.addEventHandler(eventFrameNavigationCompleted, newNavigationCompletedHandler(fn))
+}
+
+func newNavigationStartingHandler(fn func(*NavigationStartingEvent)) *com.Handler {
+	return com.NewHandler(com.IID_ICoreWebView2NavigationStartingEventHandler, func(_ uintptr, a unsafe.Pointer) uintptr {
+		args := (*com.ICoreWebView2NavigationStartingEventArgs)(a)
+
+		e := &NavigationStartingEvent{}
+		e.URI, _ = args.GetURI()
+		e.NavigationID, _ = args.GetNavigationID()
+		e.IsUserInitiated, _ = args.GetIsUserInitiated()
+		e.IsRedirected, _ = args.GetIsRedirected()
+
+		fn(e)
+
+		if e.Cancel {
+			_ = args.PutCancel(true)
+		}
+
+		return 0
+	})
+}
+
+func newNavigationCompletedHandler(fn func(NavigationCompletedEvent)) *com.Handler {
+	return com.NewHandler(com.IID_ICoreWebView2NavigationCompletedEventHandler, func(_ uintptr, a unsafe.Pointer) uintptr {
+		args := (*com.ICoreWebView2NavigationCompletedEventArgs)(a)
+
+		var e NavigationCompletedEvent
+		e.NavigationID, _ = args.GetNavigationID()
+		e.IsSuccess, _ = args.GetIsSuccess()
+
+		status, _ := args.GetWebErrorStatus()
+		e.WebErrorStatus = WebErrorStatus(status)
+
+		fn(e)
+
+		return 0
+	})
+}
+
+// NavigateAndWait navigates to the URL and waits until the navigation completes.
+// It keeps processing window messages while waiting, so it must be called on the UI thread.
+//
+// The navigation waited for is the first one starting once Navigate is called, it's matched by its ID rather than
+// its URI, which the browser may report re-encoded. If another navigation completes first, e.g. one the page
+// started earlier, ErrNavigationInterrupted is returned. A navigation to a fragment of the current document raises
+// no navigation events, so it returns without waiting. A failed navigation returns a *NavigationError.
+func (b *browser) NavigateAndWait(ctx context.Context, uri string) error {
+	if current, err := b.view.GetSource(); err == nil && sameDocument(current, uri) {
+		return b.Navigate(uri)
+	}
+
+	var (
+		navigating bool
+		started    bool
+		id         uint64
+		completed  *NavigationCompletedEvent
+	)
+
+	startingToken, err := b.OnNavigationStarting(func(e *NavigationStartingEvent) {
+		if navigating && !started {
+			started = true
+			id = e.NavigationID
+		}
+	})
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = b.RemoveEventHandler(startingToken) }()
+
+	completedToken, err := b.OnNavigationCompleted(func(e NavigationCompletedEvent) {
+		if navigating && completed == nil {
+			completed = &e
+		}
+	})
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = b.RemoveEventHandler(completedToken) }()
+
+	navigating = true
+
+	if err := b.Navigate(uri); err != nil {
+		return err
+	}
+
+	if err := pump(ctx, func() bool { return completed != nil }); err != nil {
+		return err
+	}
+
+	if !started || completed.NavigationID != id {
+		return fmt.Errorf("%w: navigation %d completed before the one to %s", ErrNavigationInterrupted, completed.NavigationID, uri)
+	}
+
+	if !completed.IsSuccess {
+		return &NavigationError{URI: uri, Status: completed.WebErrorStatus}
+	}
+
+	return nil
+}
+
+// sameDocument tells whether the requested URI only changes the fragment of the current one,
+// i.e. whether navigating to it stays in the same document.
+func sameDocument(current, requested string) bool {
+	if !strings.Contains(requested, "#") {
+		return false
+	}
+
+	return withoutFragment(current) == withoutFragment(requested)
+}
+
+func withoutFragment(uri string) string {
+	if i := strings.IndexByte(uri, '#'); i >= 0 {
+		return uri[:i]
+	}
+
+	return uri
+}
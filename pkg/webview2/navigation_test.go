@@ -0,0 +1,28 @@
+package webview2
+
+import "testing"
+
+func TestSameDocument(t *testing.T) {
+	tests := []struct {
+		current   string
+		requested string
+		same      bool
+	}{
+		{"https://example.com/a", "https://example.com/a#b", true},
+		{"https://example.com/a#b", "https://example.com/a#c", true},
+		{"https://example.com/a?b=c", "https://example.com/a?b=c#d", true},
+		{"file:///C:/app/index.html", "file:///C:/app/index.html#settings", true},
+		{"https://example.com/a#b", "https://example.com/a", false},
+		{"https://example.com/a", "https://example.com/a", false},
+		{"https://example.com/a", "https://example.com/b#c", false},
+		{"https://example.com/a?b=c", "https://example.com/a?b=d#e", false},
+		{"about:blank", "https://example.com/#a", false},
+		{"", "#a", false},
+	}
+
+	for _, test := range tests {
+		if same := sameDocument(test.current, test.requested); same != test.same {
+			t.Errorf("sameDocument(%q, %q) = %t, want %t", test.current, test.requested, same, test.same)
+		}
+	}
+}
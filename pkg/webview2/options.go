@@ -1,5 +1,13 @@
 package webview2
 
+import (
+	"net/http"
+	"time"
+
+	"github.com/mattpodraza/webview2/v2/pkg/envpolicy"
+	"github.com/mattpodraza/webview2/v2/pkg/webviewloader"
+)
+
 type Option func(*WebView)
 
 func WithSize(width, height int32) Option {
@@ -29,12 +37,48 @@ func WithTitle(title string) Option {
 	}
 }
 
+// WithFrameless drops the Windows frame and title bar, so the page can draw its own.
+// The elements styled with app-region: drag, or marked with a data-webview-drag attribute, move the window
+// like a title bar, and app-region: no-drag or data-webview-no-drag exclude the controls within them.
+// The edges of the page resize the window. Dragging requires web messages, see WithWebMessage,
+// and only works for the pages on the origin of the initial URL or of a virtual host, see SetVirtualHostNameToFolderMapping.
+func WithFrameless(frameless bool) Option {
+	return func(wv *WebView) {
+		wv.window.config.frameless = frameless
+
+		if !frameless {
+			return
+		}
+
+		wv.browser.config.setup = append(wv.browser.config.setup, func(b *browser) error {
+			return b.listenForDrags()
+		})
+	}
+}
+
+// WithWindowStyle sets the style the window is created with, user32.WSOverlappedWindow by default,
+// e.g. user32.WSOverlappedWindow &^ (user32.WSThickFrame | user32.WSMaximizeBox) for a window of a fixed size.
+// WithFrameless keeps the style, a frameless window without WSThickFrame can't be resized from its edges.
+func WithWindowStyle(style uint32) Option {
+	return func(wv *WebView) {
+		wv.window.config.style = style
+	}
+}
+
 func WithURL(url string) Option {
 	return func(wv *WebView) {
 		wv.browser.config.initialURL = url
 	}
 }
 
+// WithNavigationTimeout limits how long New waits for the initial navigation, 30 seconds by default.
+// New fails with ErrNavigationTimeout if the page doesn't load in time. Zero waits until the page loads.
+func WithNavigationTimeout(timeout time.Duration) Option {
+	return func(wv *WebView) {
+		wv.browser.config.navigationTimeout = timeout
+	}
+}
+
 func WithBuiltinErrorPage(enabled bool) Option {
 	return func(wv *WebView) {
 		wv.browser.config.builtInErrorPage = enabled
@@ -88,3 +132,127 @@ func WithZoomControl(enabled bool) Option {
 		wv.browser.config.zoomControl = enabled
 	}
 }
+
+// WithUserDataFolder sets the folder holding the browser data, e.g. cookies and cache.
+// It defaults to a folder named after the executable in %AppData%.
+func WithUserDataFolder(folder string) Option {
+	return func(wv *WebView) {
+		wv.browser.config.userDataFolder = folder
+	}
+}
+
+// WithBrowserExecutableFolder uses the fixed version WebView2 runtime in the folder instead of the installed one.
+func WithBrowserExecutableFolder(folder string) Option {
+	return func(wv *WebView) {
+		wv.browser.config.browserExecutableFolder = folder
+	}
+}
+
+// WithAdditionalBrowserArguments passes command line switches to the browser process, e.g. --disable-gpu.
+func WithAdditionalBrowserArguments(args string) Option {
+	return func(wv *WebView) {
+		wv.browser.config.additionalBrowserArguments = args
+	}
+}
+
+// WithLanguage sets the display language of the browser UI, e.g. the context menus, as a BCP 47 tag like en-US.
+func WithLanguage(language string) Option {
+	return func(wv *WebView) {
+		wv.browser.config.language = language
+	}
+}
+
+// WithTargetCompatibleBrowserVersion sets the oldest WebView2 runtime version the application works with,
+// older runtimes fail to create the environment. It defaults to com.DefaultTargetCompatibleBrowserVersion.
+func WithTargetCompatibleBrowserVersion(version string) Option {
+	return func(wv *WebView) {
+		wv.browser.config.targetCompatibleBrowserVersion = version
+	}
+}
+
+// WithEnsureRuntime creates the environment before the window, and if it fails because the runtime is missing,
+// installs the Evergreen WebView2 runtime with the bootstrapper and tries again. The installation gives up
+// when the context of NewContext is done. Installing takes a while, report the progress with InstallOptions.OnProgress.
+func WithEnsureRuntime(opts InstallOptions) Option {
+	return func(wv *WebView) {
+		wv.browser.config.install = &opts
+	}
+}
+
+// WithLoader picks how the WebView2 loader DLL is loaded, it defaults to webviewloader.Default.
+// Use webviewloader.Extract, Path or System where loading modules from memory isn't welcome.
+func WithLoader(loader webviewloader.Loader) Option {
+	return func(wv *WebView) {
+		wv.loader = loader
+	}
+}
+
+// EnvPolicy controls whether the WEBVIEW2_* environment variables apply, e.g. to point a build at a Canary runtime
+// with WEBVIEW2_RELEASE_CHANNEL_PREFERENCE=1.
+type EnvPolicy = envpolicy.Policy
+
+const (
+	// EnvIgnore only uses the options, the environment variables are hidden from the WebView2 loader.
+	// It's the default.
+	EnvIgnore = envpolicy.Ignore
+	// EnvHonour lets the environment variables win over the options, like the WebView2 loader does on its own.
+	// The additional browser arguments of both are kept.
+	EnvHonour = envpolicy.Honour
+	// EnvOverride lets the options win, the environment variables only fill in the options left empty.
+	// The additional browser arguments of both are kept.
+	EnvOverride = envpolicy.Override
+)
+
+// WithEnvPolicy sets how the WEBVIEW2_* environment variables combine with WithUserDataFolder,
+// WithBrowserExecutableFolder and WithAdditionalBrowserArguments.
+// The loader reads the variables itself, so they're hidden from it, or set, while it creates the environment,
+// and restored right after, see envpolicy.Apply.
+func WithEnvPolicy(policy EnvPolicy) Option {
+	return func(wv *WebView) {
+		wv.browser.config.envPolicy = policy
+	}
+}
+
+// WithNavigationStarting registers a NavigationStarting handler before the initial navigation,
+// so it can also cancel it.
+func WithNavigationStarting(fn func(e *NavigationStartingEvent)) Option {
+	return func(wv *WebView) {
+		wv.browser.config.setup = append(wv.browser.config.setup, func(b *browser) error {
+			_, err := b.OnNavigationStarting(fn)
+			return err
+		})
+	}
+}
+
+// WithNavigationCompleted registers a NavigationCompleted handler before the initial navigation,
+// so it can also tell whether it succeeded.
+func WithNavigationCompleted(fn func(e NavigationCompletedEvent)) Option {
+	return func(wv *WebView) {
+		wv.browser.config.setup = append(wv.browser.config.setup, func(b *browser) error {
+			_, err := b.OnNavigationCompleted(fn)
+			return err
+		})
+	}
+}
+
+// WithAssetHandler answers the requests matching the pattern with an http.Handler, see AddAssetHandler.
+// Combined with WithURL, it allows serving the whole UI from an embed.FS:
+//
+//	webview2.WithAssetHandler("https://app.local/*", http.FileServer(http.FS(assets))),
+//	webview2.WithURL("https://app.local/index.html"),
+func WithAssetHandler(pattern string, h http.Handler) Option {
+	return func(wv *WebView) {
+		wv.browser.config.setup = append(wv.browser.config.setup, func(b *browser) error {
+			return b.AddAssetHandler(pattern, h)
+		})
+	}
+}
+
+// WithVirtualHost serves the files from the folder as https://<host>/, see SetVirtualHostNameToFolderMapping.
+func WithVirtualHost(host, folder string, access AccessKind) Option {
+	return func(wv *WebView) {
+		wv.browser.config.setup = append(wv.browser.config.setup, func(b *browser) error {
+			return b.SetVirtualHostNameToFolderMapping(host, folder, access)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package webview2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/mattpodraza/webview2/v2/pkg/bootstrapper"
+	"github.com/mattpodraza/webview2/v2/pkg/browserversion"
+	"github.com/mattpodraza/webview2/v2/pkg/discovery"
+	"github.com/mattpodraza/webview2/v2/pkg/peversion"
+)
+
+// ErrRuntimeNotFound is returned when no WebView2 runtime is installed.
+var ErrRuntimeNotFound = errors.New("the WebView2 runtime isn't installed")
+
+// RuntimeKind tells the Evergreen runtime, updated by Windows, from a fixed version runtime shipped with the application.
+type RuntimeKind int
+
+const (
+	RuntimeEvergreen RuntimeKind = iota
+	RuntimeFixed
+)
+
+func (k RuntimeKind) String() string {
+	if k == RuntimeFixed {
+		return "fixed"
+	}
+
+	return "evergreen"
+}
+
+// Runtime describes an installed WebView2 runtime.
+type Runtime struct {
+	Kind RuntimeKind
+	// Version is the version of the runtime. Its Channel is empty for the WebView2 runtime,
+	// or "beta", "dev" or "canary" for the preview channels of Edge, which WEBVIEW2_RELEASE_CHANNEL_PREFERENCE can pick.
+	Version browserversion.Version
+	// Path is the folder holding the runtime.
+	Path string
+	// PerUser is set for the runtimes installed for the current user only.
+	PerUser bool
+}
+
+// RuntimeInfo returns the installed Evergreen runtimes New can use, the stable one first, followed by the preview channels.
+// It finds them the way webviewloader.Go does, see the discovery package.
+// It returns ErrRuntimeNotFound when there's none, which is when New fails with HRESULT_FROM_WIN32(ERROR_FILE_NOT_FOUND).
+func RuntimeInfo() ([]Runtime, error) {
+	found, err := discovery.All(discovery.SystemRegistry{}, discovery.OSFileSystem{}, discovery.Options{
+		Arch: discovery.Arch(runtime.GOARCH),
+	})
+
+	if errors.Is(err, discovery.ErrNotFound) {
+		return nil, ErrRuntimeNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	runtimes := make([]Runtime, 0, len(found))
+
+	for _, r := range found {
+		runtimes = append(runtimes, Runtime{
+			Kind:    RuntimeEvergreen,
+			Version: r.Version,
+			Path:    r.Dir,
+			PerUser: r.PerUser,
+		})
+	}
+
+	return runtimes, nil
+}
+
+// InstallOptions configure how EnsureRuntime gets and runs the bootstrapper, e.g. a bundled one
+// or the download URL, and report its progress.
+type InstallOptions = bootstrapper.Options
+
+// EnsureRuntime installs the Evergreen runtime with the bootstrapper unless RuntimeInfo finds one already,
+// and returns the preferred runtime. Installing takes a while, report the progress with InstallOptions.OnProgress.
+func EnsureRuntime(ctx context.Context, opts InstallOptions) (Runtime, error) {
+	runtimes, err := RuntimeInfo()
+	if err == nil {
+		return runtimes[0], nil
+	}
+
+	if !errors.Is(err, ErrRuntimeNotFound) {
+		return Runtime{}, err
+	}
+
+	if err := bootstrapper.Install(ctx, opts); err != nil {
+		return Runtime{}, fmt.Errorf("failed to install the WebView2 runtime: %w", err)
+	}
+
+	runtimes, err = RuntimeInfo()
+	if err != nil {
+		return Runtime{}, fmt.Errorf("the bootstrapper exited without installing the WebView2 runtime: %w", err)
+	}
+
+	return runtimes[0], nil
+}
+
+// FixedRuntimeInfo describes the fixed version runtime in the folder, as passed to WithBrowserExecutableFolder.
+// It returns ErrRuntimeNotFound if the folder doesn't hold a runtime.
+func FixedRuntimeInfo(folder string) (Runtime, error) {
+	found, err := discovery.Find(discovery.SystemRegistry{}, discovery.OSFileSystem{}, discovery.Options{
+		BrowserExecutableFolder: folder,
+		Arch:                    discovery.Arch(runtime.GOARCH),
+	})
+
+	if errors.Is(err, discovery.ErrNotFound) {
+		return Runtime{}, fmt.Errorf("%w in %s", ErrRuntimeNotFound, folder)
+	}
+
+	if err != nil {
+		return Runtime{}, err
+	}
+
+	exe := filepath.Join(found.Dir, "msedgewebview2.exe")
+
+	version, err := fileVersion(exe)
+	if err != nil {
+		return Runtime{}, fmt.Errorf("failed to read the version of %s: %w", exe, err)
+	}
+
+	return Runtime{
+		Kind:    RuntimeFixed,
+		Version: version,
+		Path:    found.Dir,
+	}, nil
+}
+
+// fileVersion reads the file version from the version resource of an executable.
+func fileVersion(path string) (browserversion.Version, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return browserversion.Version{}, err
+	}
+
+	defer f.Close()
+
+	info, err := peversion.Read(f)
+	if err != nil {
+		return browserversion.Version{}, err
+	}
+
+	v := info.FileVersion
+
+	return browserversion.Version{
+		Major: int(v.Major),
+		Minor: int(v.Minor),
+		Build: int(v.Build),
+		Patch: int(v.Revision),
+	}, nil
+}
+
+// BrowserVersion returns the version of the runtime the WebView runs on, which may differ from the installed one
+// until the application restarts after an update.
+func (b *browser) BrowserVersion() (browserversion.Version, error) {
+	if b.environment == nil {
+		return browserversion.Version{}, errors.New("nil environment")
+	}
+
+	s, err := b.environment.GetBrowserVersionString()
+	if err != nil {
+		return browserversion.Version{}, fmt.Errorf("failed to get the browser version: %w", err)
+	}
+
+	return browserversion.Parse(s)
+}
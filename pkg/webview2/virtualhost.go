@@ -0,0 +1,92 @@
+package webview2
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"unsafe"
+
+	"github.com/mattpodraza/webview2/v2/pkg/com"
+	"github.com/mattpodraza/webview2/v2/pkg/hresult"
+)
+
+// AccessKind controls whether other origins can access the resources of a virtual host.
+type AccessKind int32
+
+const (
+	// AccessDeny denies all the other origins.
+	AccessDeny AccessKind = AccessKind(com.COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND_DENY)
+	// AccessAllow allows all the other origins.
+	AccessAllow AccessKind = AccessKind(com.COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND_ALLOW)
+	// AccessDenyCORS allows the other origins, except for CORS requests.
+	AccessDenyCORS AccessKind = AccessKind(com.COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND_DENY_CORS)
+)
+
+// SetVirtualHostNameToFolderMapping serves the files from the folder as https://<host>/, with proper origin
+// semantics for localStorage, fetch and so on, without running an HTTP server.
+// It requires a WebView2 runtime which implements ICoreWebView2_3.
+func (b *browser) SetVirtualHostNameToFolderMapping(host, folder string, access AccessKind) error {
+	view, err := b.view3()
+	if err != nil {
+		return err
+	}
+
+	defer release(unsafe.Pointer(view))
+
+	err = view.SetVirtualHostNameToFolderMapping(host, folder, com.COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND(access))
+	if err != nil {
+		return fmt.Errorf("failed to map the virtual host name: %w", err)
+	}
+
+	if b.virtualHosts == nil {
+		b.virtualHosts = map[string]struct{}{}
+	}
+
+	b.virtualHosts[origin("https://"+host)] = struct{}{}
+
+	return nil
+}
+
+// ClearVirtualHostNameToFolderMapping removes the mapping added with SetVirtualHostNameToFolderMapping.
+func (b *browser) ClearVirtualHostNameToFolderMapping(host string) error {
+	view, err := b.view3()
+	if err != nil {
+		return err
+	}
+
+	defer release(unsafe.Pointer(view))
+
+	if err := view.ClearVirtualHostNameToFolderMapping(host); err != nil {
+		return fmt.Errorf("failed to clear the virtual host name mapping: %w", err)
+	}
+
+	delete(b.virtualHosts, origin("https://"+host))
+
+	return nil
+}
+
+// view3 queries the WebView for ICoreWebView2_3, the caller must release it.
+func (b *browser) view3() (*com.ICoreWebView2_3, error) {
+	var view *com.ICoreWebView2_3
+
+	r, _, err := syscall.Syscall(
+		b.view.VTBL.QueryInterface, 3,
+		uintptr(unsafe.Pointer(b.view)),
+		uintptr(unsafe.Pointer(&com.IID_ICoreWebView2_3)),
+		uintptr(unsafe.Pointer(&view)),
+	)
+
+	if !errors.Is(err, errOK) {
+		return nil, err
+	}
+
+	if hr := hresult.HRESULT(uint32(r)); hr.Failed() {
+		return nil, fmt.Errorf("ICoreWebView2_3 isn't supported, a newer WebView2 runtime is required: %w", hr)
+	}
+
+	if view == nil {
+		return nil, errors.New("ICoreWebView2_3 isn't supported, a newer WebView2 runtime is required")
+	}
+
+	return view, nil
+}
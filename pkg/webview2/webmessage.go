@@ -0,0 +1,85 @@
+package webview2
+
+import (
+	"encoding/json"
+	"fmt"
+	"unsafe"
+
+	"github.com/mattpodraza/webview2/v2/pkg/com"
+)
+
+// WebMessage is a message posted by the page with window.chrome.webview.postMessage.
+type WebMessage struct {
+	// Source is the URI of the document that posted the message.
+	Source string
+
+	// JSON is the message encoded as JSON.
+	JSON json.RawMessage
+
+	// String is the message itself if it was posted as a string, IsString tells if that's the case.
+	String   string
+	IsString bool
+}
+
+// Decode decodes the JSON form of the message into v.
+func (m WebMessage) Decode(v interface{}) error {
+	return json.Unmarshal(m.JSON, v)
+}
+
+// OnWebMessage registers a function that's called with every message posted by the page.
+// It requires web messages to be enabled, see WithWebMessage.
+func (b *browser) OnWebMessage(fn func(msg WebMessage)) (EventToken, error) {
+	h := com.NewHandler(com.IID_ICoreWebView2WebMessageReceivedEventHandler, func(_ uintptr, a unsafe.Pointer) uintptr {
+		args := (*com.ICoreWebView2WebMessageReceivedEventArgs)(a)
+
+		var msg WebMessage
+
+		msg.Source, _ = args.GetSource()
+
+		message, _ := args.GetWebMessageAsJSON()
+		msg.JSON = json.RawMessage(message)
+
+		// TryGetWebMessageAsString fails with E_INVALIDARG when the message isn't a string.
+		if s, err := args.TryGetWebMessageAsString(); err == nil {
+			msg.String = s
+			msg.IsString = true
+		}
+
+		fn(msg)
+
+		return 0
+	})
+
+	return b.addEventHandler(eventWebMessageReceived, h)
+}
+
+// PostMessage encodes v as JSON and posts it to the page, where it's received
+// by the window.chrome.webview "message" event listeners as a parsed object.
+//
+// It's safe to call from any goroutine, the message is posted on the UI thread through DispatchSync.
+func (b *browser) PostMessage(v interface{}) error {
+	message, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode the message: %w", err)
+	}
+
+	return b.dispatchSync(func() error {
+		if err := b.view.PostWebMessageAsJSON(string(message)); err != nil {
+			return fmt.Errorf("failed to post a web message: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// PostString posts a string to the page without any encoding.
+// Like PostMessage, it's safe to call from any goroutine.
+func (b *browser) PostString(s string) error {
+	return b.dispatchSync(func() error {
+		if err := b.view.PostWebMessageAsString(s); err != nil {
+			return fmt.Errorf("failed to post a web message: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,58 @@
+package webview2
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestPostFromGoroutine(t *testing.T) {
+	errDispatched := errors.New("dispatched")
+
+	var (
+		mu         sync.Mutex
+		dispatched int
+	)
+
+	// The browser isn't embedded, the dispatched functions are counted rather than run.
+	b := &browser{
+		dispatchSync: func(fn func() error) error {
+			mu.Lock()
+			dispatched++
+			mu.Unlock()
+
+			return errDispatched
+		},
+	}
+
+	tests := []struct {
+		name string
+		post func() error
+		want error
+	}{
+		{"PostMessage", func() error { return b.PostMessage(map[string]int{"a": 1}) }, errDispatched},
+		{"PostString", func() error { return b.PostString("a") }, errDispatched},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			done := make(chan error)
+
+			go func() {
+				done <- test.post()
+			}()
+
+			if err := <-done; err != test.want {
+				t.Errorf("%s() = %v, want %v", test.name, err, test.want)
+			}
+		})
+	}
+
+	if err := b.PostMessage(make(chan int)); err == nil || errors.Is(err, errDispatched) {
+		t.Errorf("PostMessage() = %v, want an encoding error", err)
+	}
+
+	if dispatched != len(tests) {
+		t.Errorf("dispatched %d functions, want %d", dispatched, len(tests))
+	}
+}
@@ -1,15 +1,19 @@
 package webview2
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
-	"os"
 	"runtime"
+	"sync"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/jchv/go-winloader"
+	"github.com/mattpodraza/webview2/v2/pkg/binding"
+	"github.com/mattpodraza/webview2/v2/pkg/com"
 	"github.com/mattpodraza/webview2/v2/pkg/user32"
 	"github.com/mattpodraza/webview2/v2/pkg/webviewloader"
 	"golang.org/x/sys/windows"
@@ -19,6 +23,9 @@ var (
 	ole32               = windows.NewLazySystemDLL("ole32")
 	ole32CoInitializeEx = ole32.NewProc("CoInitializeEx")
 
+	shlwapi                  = windows.NewLazySystemDLL("shlwapi")
+	shlwapiSHCreateMemStream = shlwapi.NewProc("SHCreateMemStream")
+
 	errOK = syscall.Errno(0)
 )
 
@@ -32,24 +39,44 @@ func init() {
 }
 
 type WebView struct {
-	dll winloader.Proc
+	loader webviewloader.Loader
+	dll    winloader.Proc
 
 	window  *window
 	browser *browser
+
+	// threadID is the UI thread, which created the window and runs the message loop.
+	threadID uint32
+
+	dispatchMu    sync.Mutex
+	dispatchQueue []func()
 }
 
+// New creates the window, embeds the browser and waits for the initial navigation, see NewContext.
 func New(options ...Option) (*WebView, error) {
+	return NewContext(context.Background(), options...)
+}
+
+// NewContext is like New, but it gives up when the context is done, in which case it returns the context error.
+//
+// The initial navigation is waited for up to the timeout set with WithNavigationTimeout,
+// a page that takes longer keeps loading once NewContext returns.
+func NewContext(ctx context.Context, options ...Option) (*WebView, error) {
 	wv := &WebView{
 		window: &window{
 			config: &windowConfig{
 				width:  640,
 				height: 480,
 				title:  "Webview",
+				style:  user32.WSOverlappedWindow,
 			},
 		},
+		loader:   webviewloader.Default(),
+		threadID: windows.GetCurrentThreadId(),
 		browser: &browser{
 			config: &browserConfig{
 				initialURL:           "about:blank",
+				navigationTimeout:    30 * time.Second,
 				builtInErrorPage:     true,
 				defaultContextMenus:  true,
 				defaultScriptDialogs: true,
@@ -60,39 +87,115 @@ func New(options ...Option) (*WebView, error) {
 				webMessage:           true,
 				zoomControl:          true,
 			},
+			handlers:       map[EventToken]struct{}{},
+			bindings:       binding.NewRegistry(),
+			bindingScripts: map[string]*bindingScript{},
 		},
 	}
 
-	for _, s := range []string{"WEBVIEW2_BROWSER_EXECUTABLE_FOLDER", "WEBVIEW2_USER_DATA_FOLDER", "WEBVIEW2_ADDITIONAL_BROWSER_ARGUMENTS", "WEBVIEW2_RELEASE_CHANNEL_PREFERENCE"} {
-		os.Unsetenv(s)
+	wv.browser.dispatchSync = wv.DispatchSync
+
+	for _, option := range options {
+		option(wv)
 	}
 
-	dll, err := webviewloader.New()
+	dll, err := wv.loader.Load()
 	if err != nil {
 		return nil, err
 	}
 
 	wv.dll = dll.Proc("CreateCoreWebView2EnvironmentWithOptions")
+	if wv.dll == nil {
+		return nil, errors.New("the Webview2Loader DLL doesn't export CreateCoreWebView2EnvironmentWithOptions")
+	}
+
+	if wv.browser.config.install != nil {
+		if err := wv.ensureRuntime(ctx); err != nil {
+			return nil, withHint(fmt.Errorf("failed to create the environment: %w", err))
+		}
+	}
 
 	if err := wv.createWindow(); err != nil {
 		return nil, fmt.Errorf("failed to create the window: %w", err)
 	}
 
-	for _, option := range options {
-		option(wv)
+	if err := wv.initializeWindow(); err != nil {
+		return nil, withHint(fmt.Errorf("failed to initialize the window: %w", err))
 	}
 
-	if err := wv.initializeWindow(); err != nil {
-		return nil, fmt.Errorf("failed to initialize the window: %w", err)
+	// A page that fails to load shows the error page, it's not a reason to fail here.
+	// Use WithNavigationCompleted to find out whether the initial navigation succeeded.
+	var navErr *NavigationError
+
+	timeout := wv.browser.config.navigationTimeout
+
+	navCtx, cancel := ctx, context.CancelFunc(func() {})
+	if timeout > 0 {
+		navCtx, cancel = context.WithTimeout(ctx, timeout)
 	}
 
-	if err := wv.browser.Navigate(wv.browser.config.initialURL); err != nil {
-		return nil, fmt.Errorf("failed at the initial navigation: %w", err)
+	defer cancel()
+
+	err = wv.browser.NavigateAndWait(navCtx, wv.browser.config.initialURL)
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		err = fmt.Errorf("%w: the page didn't load within %s", ErrNavigationTimeout, timeout)
+	}
+
+	if err != nil && !errors.As(err, &navErr) {
+		return nil, withHint(fmt.Errorf("failed at the initial navigation: %w", err))
 	}
 
 	return wv, nil
 }
 
+// windowClass is registered once for all the WebViews of the process, they share the window procedure.
+const windowClass = "webview"
+
+var (
+	registerOnce sync.Once
+	registerErr  error
+)
+
+// registerWindowClass registers windowClass the first time it's called, the windows created later reuse it.
+// The class is never unregistered, Windows does it when the process exits.
+func registerWindowClass(hinstance windows.Handle) error {
+	registerOnce.Do(func() {
+		icow, err := user32.GetSystemMetrics(user32.SystemMetricsCxIcon)
+		if err != nil {
+			registerErr = err
+			return
+		}
+
+		icoh, err := user32.GetSystemMetrics(user32.SystemMetricsCyIcon)
+		if err != nil {
+			registerErr = err
+			return
+		}
+
+		icon, err := user32.LoadImageW(hinstance, icow, icoh)
+		if err != nil {
+			registerErr = err
+			return
+		}
+
+		wc := user32.WndClassExW{
+			CBSize:        uint32(unsafe.Sizeof(user32.WndClassExW{})),
+			HInstance:     hinstance,
+			LpszClassName: windows.StringToUTF16Ptr(windowClass),
+			HIcon:         icon,
+			HIconSm:       icon,
+			LpfnWndProc:   windows.NewCallback(wndproc),
+		}
+
+		err = user32.RegisterClassExW(&wc)
+		if err != nil && !errors.Is(err, windows.ERROR_CLASS_ALREADY_EXISTS) {
+			registerErr = fmt.Errorf("failed to register the window class: %w", err)
+		}
+	})
+
+	return registerErr
+}
+
 func (wv *WebView) createWindow() error {
 	var hinstance windows.Handle
 
@@ -101,38 +204,14 @@ func (wv *WebView) createWindow() error {
 		return fmt.Errorf("failed to get the module handle: %w", err)
 	}
 
-	icow, err := user32.GetSystemMetrics(user32.SystemMetricsCxIcon)
-	if err != nil {
-		return err
-	}
-
-	icoh, err := user32.GetSystemMetrics(user32.SystemMetricsCyIcon)
-	if err != nil {
-		return err
-	}
-
-	icon, err := user32.LoadImageW(hinstance, icow, icoh)
-	if err != nil {
-		return err
-	}
-
-	wc := user32.WndClassExW{
-		CBSize:        uint32(unsafe.Sizeof(user32.WndClassExW{})),
-		HInstance:     hinstance,
-		LpszClassName: windows.StringToUTF16Ptr("webview"),
-		HIcon:         icon,
-		HIconSm:       icon,
-		LpfnWndProc:   windows.NewCallback(wndproc),
-	}
-
-	err = user32.RegisterClassExW(&wc)
-	if err != nil {
+	if err := registerWindowClass(hinstance); err != nil {
 		return err
 	}
 
 	wv.window.handle, err = user32.CreateWindowExW(
-		"webview",
+		windowClass,
 		"",
+		uintptr(wv.window.config.style),
 		user32.CW_USEDEFAULT,
 		user32.CW_USEDEFAULT,
 		int(wv.window.config.width),
@@ -182,10 +261,21 @@ func (wv *WebView) initializeWindow() error {
 		return fmt.Errorf("failed to save browser settings: %w", err)
 	}
 
+	for _, setup := range wv.browser.config.setup {
+		if err := setup(wv.browser); err != nil {
+			return fmt.Errorf("failed to set up the browser: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// Terminate stops the message loop, which makes Run return. It's safe to call from any goroutine.
 func (wv *WebView) Terminate() error {
+	if windows.GetCurrentThreadId() != wv.threadID {
+		return wv.dispatch(func() { _ = user32.PostQuitMessage(0) })
+	}
+
 	return user32.PostQuitMessage(0)
 }
 
@@ -194,12 +284,32 @@ func wndproc(hwnd, msg, wp, lp uintptr) uintptr {
 		switch msg {
 		case user32.WMSize:
 			_ = wv.browser.resize()
+		case wmDispatch:
+			wv.runDispatched()
 		case user32.WMClose:
 			_ = user32.DestroyWindow(windows.Handle(hwnd))
 		case user32.WMDestroy:
+			wv.window.destroyed = true
 			_ = wv.Terminate()
+		case user32.WMNCCalcSize:
+			if !wv.window.config.frameless || wp == 0 {
+				r, _ := user32.DefWindowProcW(hwnd, msg, wp, lp)
+				return r
+			}
+
+			// The whole window is client area. A maximized window hangs off the screen by the size of its frame,
+			// which is taken off so the page isn't cut.
+			if user32.IsZoomed(windows.Handle(hwnd)) {
+				rect := (*user32.Rect)(com.Pointer(lp))
+				cx, cy := frameSize()
+
+				rect.Left += cx
+				rect.Top += cy
+				rect.Right -= cx
+				rect.Bottom -= cy
+			}
 		case user32.WMGetMinMaxInfo:
-			lpmmi := (*user32.MinMaxInfo)(unsafe.Pointer(lp))
+			lpmmi := (*user32.MinMaxInfo)(com.Pointer(lp))
 
 			if wv.window.config.maxWidth > 0 && wv.window.config.maxHeight > 0 {
 				maxSize := user32.Point{
@@ -228,7 +338,39 @@ func wndproc(hwnd, msg, wp, lp uintptr) uintptr {
 	return r
 }
 
+// Run runs the message loop until the window is closed or Terminate is called, then it releases the WebView.
 func (wv *WebView) Run() error {
+	return wv.RunContext(context.Background())
+}
+
+// RunContext is like Run, but it also stops when the context is done, in which case it returns the context error.
+// Once it returns, the browser process is shut down and the WebView can't be used anymore.
+func (wv *WebView) RunContext(ctx context.Context) error {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = wv.Terminate()
+		case <-stop:
+		}
+	}()
+
+	err := wv.loop()
+
+	if closeErr := wv.close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to close the webview: %w", closeErr)
+	}
+
+	if err == nil {
+		err = ctx.Err()
+	}
+
+	return err
+}
+
+func (wv *WebView) loop() error {
 	for {
 		msg, err := user32.GetMessageW()
 		if err != nil {
@@ -252,3 +394,62 @@ func (wv *WebView) Run() error {
 		}
 	}
 }
+
+// close shuts the browser down, destroys the window and forgets about it.
+func (wv *WebView) close() error {
+	err := wv.browser.close()
+
+	// Forget about the window first, so that destroying it doesn't post another quit message.
+	webviewContext.delete(wv.window.handle)
+
+	if !wv.window.destroyed {
+		wv.window.destroyed = true
+
+		if destroyErr := user32.DestroyWindow(wv.window.handle); err == nil && destroyErr != nil {
+			err = fmt.Errorf("failed to destroy the window: %w", destroyErr)
+		}
+	}
+
+	// Functions dispatched from now on never run, so run the pending ones to unblock DispatchSync callers.
+	wv.runDispatched()
+
+	return err
+}
+
+// pump processes window messages until done returns true or the context is done.
+// It lets the callers block on an asynchronous WebView operation without stalling the UI thread.
+func pump(ctx context.Context, done func() bool) error {
+	for !done() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err := user32.MsgWaitForMultipleObjects(50); err != nil {
+			return fmt.Errorf("failed to wait for messages: %w", err)
+		}
+
+		for msg := user32.PeekMessageW(); msg != nil; msg = user32.PeekMessageW() {
+			if msg.Message == user32.WMQuit {
+				// Leave the quit message for the main loop.
+				_ = user32.PostQuitMessage(int(msg.WParam))
+				return errors.New("the message loop has quit")
+			}
+
+			if err := user32.TranslateMessage(msg); err != nil {
+				return fmt.Errorf("failed to translate message: %w", err)
+			}
+
+			if err := user32.DispatchMessageW(msg); err != nil {
+				return fmt.Errorf("failed to dispatch message: %w", err)
+			}
+
+			if done() {
+				break
+			}
+		}
+	}
+
+	return nil
+}
@@ -12,11 +12,18 @@ type windowConfig struct {
 	width, height       int32
 	maxWidth, maxHeight int32
 	minWidth, minHeight int32
+
+	// style is the window style passed to CreateWindowExW, see WithWindowStyle.
+	style uint32
+	// frameless drops the Windows frame, the page draws its own title bar, see WithFrameless.
+	frameless bool
 }
 
 type window struct {
 	config *windowConfig
 	handle windows.Handle
+
+	destroyed bool
 }
 
 func (wv *WebView) Window() *window {
@@ -51,6 +58,15 @@ func (w *window) SetTitle(title string) error {
 	return user32.SetWindowTextW(w.handle, title)
 }
 
+// adjust grows the client rect to the window rect, a frameless window has no frame around its client area.
+func (w *window) adjust(rect *user32.Rect) error {
+	if w.config.frameless {
+		return nil
+	}
+
+	return user32.AdjustWindowRec(rect, uintptr(w.config.style), true)
+}
+
 func (w *window) Center() error {
 	sx, err := user32.GetSystemMetrics(user32.SystemMetricsCxScreen)
 	if err != nil {
@@ -69,7 +85,7 @@ func (w *window) Center() error {
 		Bottom: w.config.height,
 	}
 
-	if err := user32.AdjustWindowRec(&rect, user32.WSOverlappedWindow, true); err != nil {
+	if err := w.adjust(&rect); err != nil {
 		return fmt.Errorf("failed to adjust window rect: %w", err)
 	}
 
@@ -100,7 +116,7 @@ func (w *window) SetSize(width, height int32) error {
 		Bottom: height,
 	}
 
-	if err := user32.AdjustWindowRec(&rect, user32.WSOverlappedWindow, true); err != nil {
+	if err := w.adjust(&rect); err != nil {
 		return fmt.Errorf("failed to adjust window rect: %w", err)
 	}
 
@@ -0,0 +1,69 @@
+package webviewloader
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/mattpodraza/webview2/v2/pkg/peversion"
+)
+
+// ErrIntegrity is returned by Memory and Extract when the embedded DLL doesn't match the hash recorded with it.
+var ErrIntegrity = errors.New("the embedded Webview2Loader DLL doesn't match its recorded SHA-256")
+
+var (
+	moduleSum     [sha256.Size]byte
+	moduleSumOnce sync.Once
+)
+
+// moduleHash hashes the embedded DLL once.
+func moduleHash() [sha256.Size]byte {
+	moduleSumOnce.Do(func() {
+		moduleSum = sha256.Sum256(moduleBin)
+	})
+
+	return moduleSum
+}
+
+// SHA256 returns the hex encoded SHA-256 of the embedded DLL.
+// Only windows/amd64 embeds the DLL, on windows/386 and windows/arm64 nothing is embedded and SHA256 returns
+// an empty string.
+func SHA256() string {
+	if moduleBin == nil {
+		return ""
+	}
+
+	s := moduleHash()
+
+	return hex.EncodeToString(s[:])
+}
+
+// Version returns the file version of the embedded DLL, which is the version of the WebView2 SDK it comes from.
+// It fails with ErrNotEmbedded on windows/386 and windows/arm64, where nothing is embedded.
+func Version() (peversion.Version, error) {
+	if moduleBin == nil {
+		return peversion.Version{}, errNotEmbedded()
+	}
+
+	info, err := peversion.ReadBytes(moduleBin)
+	if err != nil {
+		return peversion.Version{}, fmt.Errorf("failed to read the version of the Webview2Loader DLL: %w", err)
+	}
+
+	return info.FileVersion, nil
+}
+
+// verify checks the embedded DLL against the hash recorded when it was updated.
+func verify() error {
+	if moduleBin == nil {
+		return errNotEmbedded()
+	}
+
+	if actual := SHA256(); actual != moduleSHA256 {
+		return fmt.Errorf("%w: got %s, want %s", ErrIntegrity, actual, moduleSHA256)
+	}
+
+	return nil
+}
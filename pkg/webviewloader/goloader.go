@@ -0,0 +1,236 @@
+package webviewloader
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/jchv/go-winloader"
+	"github.com/mattpodraza/webview2/v2/pkg/browserversion"
+	"github.com/mattpodraza/webview2/v2/pkg/com"
+	"github.com/mattpodraza/webview2/v2/pkg/discovery"
+	"github.com/mattpodraza/webview2/v2/pkg/envpolicy"
+	"github.com/mattpodraza/webview2/v2/pkg/hresult"
+	"golang.org/x/sys/windows"
+)
+
+// Go implements CreateCoreWebView2EnvironmentWithOptions in Go, instead of loading WebView2Loader.dll,
+// so it doesn't need the embedded DLL. It's the default on windows/386 and windows/arm64, where no DLL is embedded,
+// pick it with webview2.WithLoader on windows/amd64.
+// It finds the runtime with the discovery package, honouring the WEBVIEW2_* environment variables like the DLL does,
+// and calls CreateWebViewEnvironmentWithOptionsInternal from the EmbeddedBrowserWebView.dll of the runtime.
+// That export isn't documented, a runtime update may change it.
+func Go() Loader {
+	return LoaderFunc(func() (winloader.Module, error) {
+		return goModule{}, nil
+	})
+}
+
+// goModule stands in for WebView2Loader.dll.
+type goModule struct{}
+
+func (goModule) Proc(name string) winloader.Proc {
+	if name == "CreateCoreWebView2EnvironmentWithOptions" {
+		return goProc{}
+	}
+
+	return nil
+}
+
+func (goModule) Ordinal(ordinal uint64) winloader.Proc {
+	return nil
+}
+
+func (goModule) Free() error {
+	return nil
+}
+
+// goProc is CreateCoreWebView2EnvironmentWithOptions.
+type goProc struct{}
+
+var (
+	goProcAddr     uintptr
+	goProcAddrOnce sync.Once
+)
+
+// Call takes the arguments of CreateCoreWebView2EnvironmentWithOptions and returns its HRESULT,
+// along with an *Error telling why it failed.
+func (goProc) Call(a ...uint64) (r1, r2 uint64, lastErr error) {
+	if len(a) != 4 {
+		return uint64(hresult.E_INVALIDARG), 0, &Error{hresult.E_INVALIDARG, errors.New("want 4 arguments")}
+	}
+
+	if err := createEnvironment(uintptr(a[0]), uintptr(a[1]), uintptr(a[2]), uintptr(a[3])); err != nil {
+		return uint64(err.HRESULT), 0, err
+	}
+
+	return uint64(hresult.S_OK), 0, nil
+}
+
+// Addr returns a callback, so the function can be handed over to native code too.
+func (goProc) Addr() uint64 {
+	goProcAddrOnce.Do(func() {
+		goProcAddr = windows.NewCallback(func(browserExecutableFolder, userDataFolder, environmentOptions, environmentCreatedHandler uintptr) uintptr {
+			if err := createEnvironment(browserExecutableFolder, userDataFolder, environmentOptions, environmentCreatedHandler); err != nil {
+				return uintptr(err.HRESULT)
+			}
+
+			return uintptr(hresult.S_OK)
+		})
+	})
+
+	return uint64(goProcAddr)
+}
+
+// Error is returned by the Go loader when it fails to create the environment, with the HRESULT
+// CreateCoreWebView2EnvironmentWithOptions returns for it. errors.Is matches both the HRESULT and the underlying error,
+// e.g. discovery.ErrNotFound along with HRESULT_FROM_WIN32(ERROR_FILE_NOT_FOUND).
+type Error struct {
+	HRESULT hresult.HRESULT
+	Err     error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %v", e.HRESULT, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the target is the HRESULT of the error.
+func (e *Error) Is(target error) bool {
+	hr, ok := target.(hresult.HRESULT)
+	return ok && hr == e.HRESULT
+}
+
+var (
+	clientDLLs   = map[string]*windows.DLL{}
+	clientDLLsMu sync.Mutex
+)
+
+// createEnvironment follows CreateCoreWebView2EnvironmentWithOptions, the pointers are those of its arguments.
+func createEnvironment(browserExecutableFolder, userDataFolder, environmentOptions, environmentCreatedHandler uintptr) *Error {
+	if environmentCreatedHandler == 0 {
+		return &Error{hresult.E_POINTER, errors.New("nil environment created handler")}
+	}
+
+	env := envpolicy.FromEnv(os.LookupEnv)
+
+	opts := discovery.Options{
+		BrowserExecutableFolder: utf16PtrToString(browserExecutableFolder),
+		PreferCanary:            env.ReleaseChannelPreference == "1",
+		Arch:                    discovery.Arch(runtime.GOARCH),
+	}
+
+	// The environment variables win over the arguments, like they do with WebView2Loader.dll.
+	if env.BrowserExecutableFolder != "" {
+		opts.BrowserExecutableFolder = env.BrowserExecutableFolder
+	}
+
+	dataFolder := utf16PtrToString(userDataFolder)
+	if env.UserDataFolder != "" {
+		dataFolder = env.UserDataFolder
+	}
+
+	if dataFolder == "" {
+		exe, err := os.Executable()
+		if err != nil {
+			return &Error{hresult.E_UNEXPECTED, fmt.Errorf("failed to find the executable: %w", err)}
+		}
+
+		dataFolder = filepath.Join(filepath.Dir(exe), filepath.Base(exe)+".WebView2")
+	}
+
+	if environmentOptions != 0 {
+		options := (*com.ICoreWebView2EnvironmentOptions)(com.Pointer(environmentOptions))
+
+		if version, err := options.GetTargetCompatibleBrowserVersion(); err == nil && version != "" {
+			if opts.MinVersion, err = browserversion.Parse(version); err != nil {
+				return &Error{hresult.E_INVALIDARG, fmt.Errorf("invalid target compatible browser version: %w", err)}
+			}
+		}
+
+		if env.AdditionalBrowserArguments != "" {
+			// The variable adds to the arguments of the options, its switches come last so they win.
+			args, err := options.GetAdditionalBrowserArguments()
+			if err != nil {
+				return &Error{hresult.E_INVALIDARG, fmt.Errorf("failed to get the additional browser arguments: %w", err)}
+			}
+
+			if err := options.PutAdditionalBrowserArguments(strings.TrimSpace(args + " " + env.AdditionalBrowserArguments)); err != nil {
+				return &Error{hresult.E_INVALIDARG, fmt.Errorf("failed to put the additional browser arguments: %w", err)}
+			}
+		}
+	}
+
+	rt, err := discovery.Find(discovery.SystemRegistry{}, discovery.OSFileSystem{}, opts)
+	if errors.Is(err, discovery.ErrNotFound) {
+		return &Error{hresult.FromWin32(hresult.ERROR_FILE_NOT_FOUND), err}
+	}
+
+	if err != nil {
+		return &Error{hresult.E_FAIL, err}
+	}
+
+	dll, err := loadClientDLL(rt.ClientDLL)
+	if err != nil {
+		var errno windows.Errno
+		if errors.As(err, &errno) {
+			return &Error{hresult.FromWin32(hresult.Win32Error(errno)), err}
+		}
+
+		return &Error{hresult.E_FAIL, err}
+	}
+
+	proc, err := dll.FindProc("CreateWebViewEnvironmentWithOptionsInternal")
+	if err != nil {
+		return &Error{hresult.FromWin32(hresult.ERROR_PROC_NOT_FOUND), err}
+	}
+
+	dataFolderPtr, err := windows.UTF16PtrFromString(dataFolder)
+	if err != nil {
+		return &Error{hresult.E_INVALIDARG, fmt.Errorf("invalid user data folder: %w", err)}
+	}
+
+	r, _, _ := proc.Call(1, uintptr(rt.Kind), uintptr(unsafe.Pointer(dataFolderPtr)), environmentOptions, environmentCreatedHandler)
+	runtime.KeepAlive(dataFolderPtr)
+
+	if hr := hresult.HRESULT(uint32(r)); hr.Failed() {
+		return &Error{hr, fmt.Errorf("%s failed to create the environment", rt.ClientDLL)}
+	}
+
+	return nil
+}
+
+// loadClientDLL loads EmbeddedBrowserWebView.dll once, the environments it creates live in it.
+func loadClientDLL(path string) (*windows.DLL, error) {
+	clientDLLsMu.Lock()
+	defer clientDLLsMu.Unlock()
+
+	if dll, ok := clientDLLs[path]; ok {
+		return dll, nil
+	}
+
+	dll, err := windows.LoadDLL(path)
+	if err != nil {
+		return nil, err
+	}
+
+	clientDLLs[path] = dll
+
+	return dll, nil
+}
+
+func utf16PtrToString(p uintptr) string {
+	if p == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(windows.UTF16PtrToString((*uint16)(com.Pointer(p))))
+}
@@ -0,0 +1,156 @@
+package webviewloader
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/jchv/go-winloader"
+)
+
+// DLLName is the file name of the WebView2 loader.
+const DLLName = "WebView2Loader.dll"
+
+var (
+	// ErrNotEmbedded is returned by Memory and Extract on the architectures the DLL isn't embedded for,
+	// only windows/amd64 embeds it. Use Go, Path or System instead.
+	ErrNotEmbedded = errors.New("the Webview2Loader DLL isn't embedded for this architecture")
+	// ErrUnsupportedArch is returned by the Default loader on the architectures WebView2 doesn't support.
+	ErrUnsupportedArch = errors.New("WebView2 doesn't support this architecture")
+)
+
+// Loader loads the WebView2 loader DLL, which exports CreateCoreWebView2EnvironmentWithOptions.
+type Loader interface {
+	Load() (winloader.Module, error)
+}
+
+// LoaderFunc adapts a function to the Loader interface.
+type LoaderFunc func() (winloader.Module, error)
+
+// Load calls f.
+func (f LoaderFunc) Load() (winloader.Module, error) {
+	return f()
+}
+
+// Default is the loader used unless another one is picked: Memory on windows/amd64, where the DLL is embedded,
+// and Go on windows/386 and windows/arm64. It fails with ErrUnsupportedArch on the other architectures.
+func Default() Loader {
+	return defaultLoader()
+}
+
+// Memory loads the embedded DLL straight from memory, without touching the disk, once its hash is verified.
+// Some antivirus software flags the modules loaded this way, see Extract for an alternative.
+func Memory() Loader {
+	return LoaderFunc(func() (winloader.Module, error) {
+		if err := verify(); err != nil {
+			return nil, err
+		}
+
+		dll, err := winloader.LoadFromMemory(moduleBin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the Webview2Loader DLL from memory: %w", err)
+		}
+
+		return dll, nil
+	})
+}
+
+// Extract writes the embedded DLL to the folder and loads it with the Windows loader.
+// The file is named after the hash of the DLL, and reused as long as its content matches the hash,
+// so it's written once per version. An empty folder defaults to a webview2 folder in os.UserCacheDir.
+func Extract(dir string) Loader {
+	return LoaderFunc(func() (winloader.Module, error) {
+		if err := verify(); err != nil {
+			return nil, err
+		}
+
+		path, err := extract(dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract the Webview2Loader DLL: %w", err)
+		}
+
+		return Path(path).Load()
+	})
+}
+
+func errNotEmbedded() error {
+	return fmt.Errorf("%w: windows/%s", ErrNotEmbedded, runtime.GOARCH)
+}
+
+func extract(dir string) (string, error) {
+	if dir == "" {
+		cache, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+
+		dir = filepath.Join(cache, "webview2")
+	}
+
+	sum := moduleHash()
+	path := filepath.Join(dir, "WebView2Loader-"+hex.EncodeToString(sum[:8])+".dll")
+
+	if existing, err := ioutil.ReadFile(path); err == nil {
+		if existingSum := sha256.Sum256(existing); bytes.Equal(existingSum[:], sum[:]) {
+			return path, nil
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	// Write to a temporary file first, so a concurrent load never sees half a DLL.
+	tmp, err := ioutil.TempFile(dir, "WebView2Loader-*.tmp")
+	if err != nil {
+		return "", err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(moduleBin); err != nil {
+		tmp.Close()
+		return "", err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		// Another process may hold the existing file loaded, it's fine as long as it's the same DLL.
+		if existing, readErr := ioutil.ReadFile(path); readErr == nil {
+			if existingSum := sha256.Sum256(existing); bytes.Equal(existingSum[:], sum[:]) {
+				return path, nil
+			}
+		}
+
+		return "", err
+	}
+
+	return path, nil
+}
+
+// Path loads the DLL from the path with the Windows loader, e.g. a copy shipped next to the executable.
+func Path(path string) Loader {
+	return LoaderFunc(func() (winloader.Module, error) {
+		dll, err := winloader.LoadFromFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the Webview2Loader DLL from %s: %w", path, err)
+		}
+
+		return dll, nil
+	})
+}
+
+// System loads WebView2Loader.dll with the standard search order of the Windows loader,
+// i.e. the folder of the executable, the system folders and the PATH, e.g. the copy from the WebView2 SDK.
+func System() Loader {
+	return Path(DLLName)
+}
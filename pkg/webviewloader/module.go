@@ -1,16 +1,10 @@
 package webviewloader
 
 import (
-	"fmt"
-
 	"github.com/jchv/go-winloader"
 )
 
+// New loads the DLL with the Default loader.
 func New() (winloader.Module, error) {
-	dll, err := winloader.LoadFromMemory(moduleBin)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load the Webview2Loader DLL from memory: %w", err)
-	}
-
-	return dll, nil
+	return Default().Load()
 }
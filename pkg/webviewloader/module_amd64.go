@@ -4,3 +4,12 @@ import _ "embed"
 
 //go:embed x64/WebView2Loader.dll
 var moduleBin []byte
+
+// moduleSHA256 is the hash of the embedded DLL, from the WebView2 SDK 1.0.818.41.
+// Update it with the DLL, e.g. with sha256sum x64/WebView2Loader.dll.
+const moduleSHA256 = "79d7e45f8631e8d2541d01bfb5a49a3a090be72b3d465389a2d684680fee2e36"
+
+// defaultLoader loads the embedded DLL.
+func defaultLoader() Loader {
+	return Memory()
+}
@@ -0,0 +1,14 @@
+//go:build 386 || arm64
+// +build 386 arm64
+
+package webviewloader
+
+// moduleBin is empty, only the x64 DLL is embedded.
+var moduleBin []byte
+
+const moduleSHA256 = ""
+
+// defaultLoader implements the DLL in Go, the Microsoft WebView2 loader isn't embedded for this architecture.
+func defaultLoader() Loader {
+	return Go()
+}
@@ -0,0 +1,22 @@
+//go:build !amd64 && !386 && !arm64
+// +build !amd64,!386,!arm64
+
+package webviewloader
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/jchv/go-winloader"
+)
+
+var moduleBin []byte
+
+const moduleSHA256 = ""
+
+// defaultLoader fails, the WebView2 runtime doesn't exist for this architecture.
+func defaultLoader() Loader {
+	return LoaderFunc(func() (winloader.Module, error) {
+		return nil, fmt.Errorf("%w: windows/%s", ErrUnsupportedArch, runtime.GOARCH)
+	})
+}
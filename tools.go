@@ -1,7 +0,0 @@
-// +build tools
-
-package tools
-
-import (
-	_ "golang.org/x/tools/cmd/stringer"
-)